@@ -0,0 +1,320 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/biwakonbu/zeus/internal/diagram"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// activity diagram コマンドのフラグ
+var (
+	activityDiagramEngine string
+	activityDiagramOutput string
+)
+
+// activity walk コマンドのフラグ
+var (
+	activityWalkCheck  bool
+	activityWalkRecord bool
+)
+
+var activityCmd = &cobra.Command{
+	Use:   "activity",
+	Short: "アクティビティ操作",
+	Long:  `アクティビティに関する操作を行います。`,
+}
+
+var activityDiagramCmd = &cobra.Command{
+	Use:   "diagram <activity-id>",
+	Short: "アクティビティ図を出力",
+	Long: `アクティビティのノードと遷移からアクティビティ図を出力します。
+
+出力形式:
+  mermaid   - Mermaid flowchart（デフォルト）
+  plantuml  - PlantUML アクティビティ図
+
+decision/merge はひし形、fork/join は同期バー、ガード条件は遷移ラベルとして出力されます。
+
+例:
+  zeus activity diagram act-001
+  zeus activity diagram act-001 --engine plantuml
+  zeus activity diagram act-001 -o flow.md`,
+	Args: cobra.ExactArgs(1),
+	RunE: runActivityDiagram,
+}
+
+var activityWalkCmd = &cobra.Command{
+	Use:   "walk <activity-id>",
+	Short: "アクティビティを検証し、対話的に辿る",
+	Long: `アクティビティのノードと遷移が実行可能かを検証し、initial から対話的に辿ります。
+
+検証内容:
+  initial_count  initial ノードがちょうど 1 つある
+  no_final       initial から final ノードに到達できる
+  unreachable    initial から到達できないノードが無い
+  dead_end       final 以外に出ていく遷移の無いノード（行き止まり）が無い
+  no_exit        final に抜けられないノード（ループ）が無い
+  missing_guard  分岐する decision の遷移にガード条件がある
+
+ウォークスルーでは、遷移が 1 つのノードは自動で進み、分岐では番号で遷移を選びます（q: 終了）。
+
+オプション:
+  --check            検証のみ行い、問題があればエラー終了（対話なし）
+  --record-problems  見つかった行き止まりを Problem として記録（--check では検証で見つかったすべての行き止まり）
+
+例:
+  zeus activity walk act-001
+  zeus activity walk act-001 --check
+  zeus activity walk act-001 --record-problems`,
+	Args: cobra.ExactArgs(1),
+	RunE: runActivityWalk,
+}
+
+func init() {
+	rootCmd.AddCommand(activityCmd)
+	activityCmd.AddCommand(activityDiagramCmd)
+	activityCmd.AddCommand(activityWalkCmd)
+
+	activityDiagramCmd.Flags().StringVar(&activityDiagramEngine, "engine", "mermaid", "出力形式 (mermaid|plantuml)")
+	activityDiagramCmd.Flags().StringVarP(&activityDiagramOutput, "output", "o", "", "出力ファイル（省略時は標準出力）")
+
+	activityWalkCmd.Flags().BoolVar(&activityWalkCheck, "check", false, "検証のみ行う（対話なし）")
+	activityWalkCmd.Flags().BoolVar(&activityWalkRecord, "record-problems", false, "見つかった行き止まりを Problem として記録")
+}
+
+func runActivityDiagram(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+	activityID := args[0]
+
+	format, ok := diagram.ParseFormat(activityDiagramEngine)
+	if !ok {
+		return fmt.Errorf("不明な出力形式: %s (mermaid, plantuml のいずれかを指定してください)", activityDiagramEngine)
+	}
+
+	entity, err := zeus.Get(ctx, "activity", activityID)
+	if err != nil {
+		return fmt.Errorf("アクティビティ取得失敗: %w", err)
+	}
+	act, ok := entity.(*core.ActivityEntity)
+	if !ok {
+		return fmt.Errorf("ActivityEntity への型アサーションに失敗しました")
+	}
+
+	output, err := diagram.Activity(act, format)
+	if err != nil {
+		return err
+	}
+
+	if activityDiagramOutput != "" {
+		if err := os.WriteFile(activityDiagramOutput, []byte(output), 0644); err != nil {
+			return fmt.Errorf("ファイル出力失敗: %w", err)
+		}
+		green := color.New(color.FgGreen).SprintFunc()
+		fmt.Printf("%s アクティビティ図を %s に出力しました。\n", green("✓"), activityDiagramOutput)
+		return nil
+	}
+
+	fmt.Print(output)
+	return nil
+}
+
+func runActivityWalk(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+	activityID := args[0]
+
+	entity, err := zeus.Get(ctx, "activity", activityID)
+	if err != nil {
+		return fmt.Errorf("アクティビティ取得失敗: %w", err)
+	}
+	act, ok := entity.(*core.ActivityEntity)
+	if !ok {
+		return fmt.Errorf("ActivityEntity への型アサーションに失敗しました")
+	}
+	issues := act.CheckExecutable()
+
+	var deadEnds []string
+	if activityWalkCheck {
+		for _, issue := range issues {
+			if issue.Kind == core.ActivityIssueDeadEnd {
+				deadEnds = append(deadEnds, issue.NodeID)
+			}
+		}
+		if format, _ := cmd.Flags().GetString("format"); format == "json" {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(map[string]any{"activity_id": act.ID, "executable": len(issues) == 0, "issues": issues}); err != nil {
+				return err
+			}
+		} else {
+			printActivityIssues(act, issues)
+		}
+	} else {
+		printActivityIssues(act, issues)
+		if len(act.InitialNodes()) != 1 {
+			return fmt.Errorf("initial ノードが 1 つでないため辿れません")
+		}
+		fmt.Println()
+		if deadEnds, err = walkActivity(cmd.OutOrStdout(), bufio.NewReader(cmd.InOrStdin()), act); err != nil {
+			return err
+		}
+	}
+
+	if activityWalkRecord && len(deadEnds) > 0 {
+		if err := recordActivityDeadEnds(cmd, zeus, act.ID, deadEnds); err != nil {
+			return err
+		}
+	}
+	if activityWalkCheck && len(issues) > 0 {
+		return fmt.Errorf("%s に %d 件の問題があります", act.ID, len(issues))
+	}
+	return nil
+}
+
+// printActivityIssues は実行可能性チェックの結果を表示
+func printActivityIssues(act *core.ActivityEntity, issues []core.ActivityIssue) {
+	cyan := color.New(color.FgCyan).SprintFunc()
+	green := color.New(color.FgGreen).SprintFunc()
+	yellow := color.New(color.FgYellow).SprintFunc()
+
+	fmt.Printf("%s %s %s\n", cyan("Activity Walk:"), act.ID, act.Title)
+	fmt.Println("============================================================")
+	if len(issues) == 0 {
+		fmt.Printf("%s 実行可能です（%d ノード, %d 遷移）\n", green("✓"), len(act.Nodes), len(act.Transitions))
+		return
+	}
+	for _, issue := range issues {
+		fmt.Printf("%s [%s] %s\n", yellow("!"), issue.Kind, issue.Message)
+	}
+}
+
+// walkActivity は initial から遷移を辿る。分岐では遷移を選択させる
+// 到達した行き止まり（final 以外で出ていく遷移の無いノード）の ID を返す
+func walkActivity(out io.Writer, reader *bufio.Reader, act *core.ActivityEntity) ([]string, error) {
+	green := color.New(color.FgGreen).SprintFunc()
+	red := color.New(color.FgRed).SprintFunc()
+
+	var deadEnds []string
+	current := act.InitialNodes()[0]
+	// 最後に選択してから通ったノード（選択なしで同じノードに戻ったら抜けられないループ）
+	sinceChoice := map[string]bool{}
+	for {
+		node, ok := act.Node(current)
+		if !ok {
+			return deadEnds, fmt.Errorf("遷移先のノードがありません: %s", current)
+		}
+		fmt.Fprintf(out, "→ %s\n", formatWalkNode(node))
+		if node.Type == core.ActivityNodeTypeFinal {
+			fmt.Fprintf(out, "%s 終了ノードに到達しました\n", green("✓"))
+			return deadEnds, nil
+		}
+		if sinceChoice[current] {
+			fmt.Fprintf(out, "%s %s から抜けられないループです\n", red("[LOOP]"), current)
+			return deadEnds, nil
+		}
+		sinceChoice[current] = true
+
+		outgoing := act.Outgoing(current)
+		switch len(outgoing) {
+		case 0:
+			fmt.Fprintf(out, "%s %s から先に進めません\n", red("[DEAD END]"), current)
+			return append(deadEnds, current), nil
+		case 1:
+			if outgoing[0].Guard != "" {
+				fmt.Fprintf(out, "  %s\n", outgoing[0].Guard)
+			}
+			current = outgoing[0].Target
+			continue
+		}
+
+		for i, trans := range outgoing {
+			target, _ := act.Node(trans.Target)
+			guard := trans.Guard
+			if guard == "" {
+				guard = "(ガードなし)"
+			}
+			fmt.Fprintf(out, "  [%d] %s → %s\n", i+1, guard, formatWalkNode(target))
+		}
+		choice, quit, err := promptWalkChoice(out, reader, len(outgoing))
+		if err != nil || quit {
+			return deadEnds, err
+		}
+		current = outgoing[choice].Target
+		sinceChoice = map[string]bool{}
+	}
+}
+
+// formatWalkNode はウォークスルーで表示するノード（"node-003 action: 入力"）
+func formatWalkNode(node core.ActivityNode) string {
+	if node.Name == "" {
+		return fmt.Sprintf("%s %s", node.ID, node.Type)
+	}
+	return fmt.Sprintf("%s %s: %s", node.ID, node.Type, node.Name)
+}
+
+// promptWalkChoice は遷移の番号の入力を受け付ける
+// 戻り値: 選択インデックス、終了指示、エラー
+func promptWalkChoice(out io.Writer, reader *bufio.Reader, n int) (int, bool, error) {
+	for {
+		fmt.Fprintf(out, "  選択 [1-%d, q: 終了]: ", n)
+		line, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return 0, false, fmt.Errorf("入力の読み込みに失敗: %w", err)
+		}
+		input := strings.TrimSpace(line)
+		if input == "q" {
+			return 0, true, nil
+		}
+		if num, convErr := strconv.Atoi(input); convErr == nil && num >= 1 && num <= n {
+			return num - 1, false, nil
+		}
+		if err == io.EOF {
+			// 入力終端は終了として扱う
+			fmt.Fprintln(out)
+			return 0, true, nil
+		}
+		fmt.Fprintln(out, "  無効な入力です。")
+	}
+}
+
+// recordActivityDeadEnds は行き止まりを Problem として記録
+func recordActivityDeadEnds(cmd *cobra.Command, zeus *core.Zeus, activityID string, deadEnds []string) error {
+	ctx := getContext(cmd)
+
+	var created []string
+	record := func(tx *core.Zeus) error {
+		var err error
+		created, err = tx.RecordActivityDeadEnds(ctx, activityID, deadEnds)
+		return err
+	}
+	if isDryRun(cmd) {
+		changes, err := zeus.DryRun(ctx, record)
+		if err != nil {
+			return fmt.Errorf("Problem の記録に失敗: %w", err)
+		}
+		return printFileChanges(cmd, changes)
+	}
+	if err := mutate(cmd, zeus, record); err != nil {
+		return fmt.Errorf("Problem の記録に失敗: %w", err)
+	}
+
+	green := color.New(color.FgGreen).SprintFunc()
+	if len(created) == 0 {
+		fmt.Println("行き止まりはすべて記録済みです。")
+		return nil
+	}
+	for _, id := range created {
+		fmt.Printf("%s Recorded problem %s\n", green("✓"), id)
+	}
+	return nil
+}
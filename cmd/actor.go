@@ -0,0 +1,243 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var actorCmd = &cobra.Command{
+	Use:   "actor",
+	Short: "アクターのカタログを管理",
+	Long: `actors.yaml のアクターを追加・一覧・更新・削除します。
+
+アクターの種別は human, system, time, device, external のいずれかです。
+一覧では、各アクターを参照している UseCase を表示します。
+UseCase から参照されているアクターは削除できません（--force で UseCase の参照を外して削除）。
+
+例:
+  zeus actor add "管理者" --type human --description "システム管理者"
+  zeus actor list
+  zeus actor update actor-1a2b3c4d --type system
+  zeus actor remove actor-1a2b3c4d
+  zeus actor remove actor-1a2b3c4d --force`,
+}
+
+var actorAddCmd = &cobra.Command{
+	Use:   "add <name>",
+	Short: "アクターを追加",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runActorAdd,
+}
+
+var actorListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "アクターを参照している UseCase の数とともに一覧表示",
+	Args:  cobra.NoArgs,
+	RunE:  runActorList,
+}
+
+var actorUpdateCmd = &cobra.Command{
+	Use:   "update <actor-id>",
+	Short: "アクターの名前・種別・説明を更新",
+	Long: `アクターの名前・種別・説明を更新します。
+
+--revision を指定した場合は、取得後に変更されていれば更新しません（zeus update と同じ）。`,
+	Args: cobra.ExactArgs(1),
+	RunE: runActorUpdate,
+}
+
+var actorRemoveCmd = &cobra.Command{
+	Use:   "remove <actor-id>",
+	Short: "アクターを削除",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runActorRemove,
+}
+
+var (
+	actorAddType        string
+	actorAddDescription string
+	actorUpdateTitle    string
+	actorUpdateType     string
+	actorUpdateDesc     string
+	actorUpdateRevision string
+	actorRemoveForce    bool
+)
+
+func init() {
+	rootCmd.AddCommand(actorCmd)
+	actorCmd.AddCommand(actorAddCmd)
+	actorCmd.AddCommand(actorListCmd)
+	actorCmd.AddCommand(actorUpdateCmd)
+	actorCmd.AddCommand(actorRemoveCmd)
+
+	actorAddCmd.Flags().StringVar(&actorAddType, "type", string(core.ActorTypeHuman), "アクターの種別（human, system, time, device, external）")
+	actorAddCmd.Flags().StringVar(&actorAddDescription, "description", "", "説明")
+	actorUpdateCmd.Flags().StringVar(&actorUpdateTitle, "title", "", "名前")
+	actorUpdateCmd.Flags().StringVar(&actorUpdateType, "type", "", "アクターの種別（human, system, time, device, external）")
+	actorUpdateCmd.Flags().StringVar(&actorUpdateDesc, "description", "", "説明")
+	actorUpdateCmd.Flags().StringVar(&actorUpdateRevision, "revision", "", "更新元のリビジョン（省略時は現在のリビジョン）")
+	actorRemoveCmd.Flags().BoolVar(&actorRemoveForce, "force", false, "UseCase から参照されていても、参照を外して削除")
+}
+
+func runActorAdd(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	t, err := core.ParseActorType(actorAddType)
+	if err != nil {
+		return err
+	}
+	opts := []core.EntityOption{core.WithActorType(t)}
+	if actorAddDescription != "" {
+		opts = append(opts, core.WithActorDescription(actorAddDescription))
+	}
+
+	var result *core.AddResult
+	err = mutate(cmd, zeus, func(tx *core.Zeus) error {
+		var err error
+		result, err = tx.Add(ctx, "actor", args[0], opts...)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("アクターの追加に失敗: %w", err)
+	}
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		return printJSONResult(result)
+	}
+	if result.NeedsApproval {
+		printQueuedForApproval(fmt.Sprintf("actor '%s' の追加", args[0]), result.ApprovalID)
+		return nil
+	}
+	green := color.New(color.FgGreen).SprintFunc()
+	fmt.Printf("%s Added actor: %s (ID: %s, type: %s)\n", green("✓"), args[0], result.ID, t)
+	return nil
+}
+
+func runActorList(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	catalog, err := zeus.ActorCatalog(ctx)
+	if err != nil {
+		return fmt.Errorf("アクターの取得に失敗: %w", err)
+	}
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(catalog)
+	}
+
+	cyan := color.New(color.FgCyan).SprintFunc()
+	yellow := color.New(color.FgYellow).SprintFunc()
+	fmt.Printf("%s (%d items)\n", cyan("Actors"), len(catalog))
+	fmt.Println("═══════════════════════════════════════════════════════════")
+	if len(catalog) == 0 {
+		fmt.Println("アクターはありません。")
+		return nil
+	}
+	for _, a := range catalog {
+		usage := fmt.Sprintf("%d usecases", len(a.UseCases))
+		if len(a.UseCases) == 0 {
+			usage = yellow("unused")
+		}
+		fmt.Printf("%-14s %-9s %s (%s)\n", a.ID, a.Type, a.Title, usage)
+		for _, uc := range a.UseCases {
+			fmt.Printf("    - %s\n", uc)
+		}
+	}
+	return nil
+}
+
+func runActorUpdate(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+	id := args[0]
+
+	fields := map[string]any{}
+	if cmd.Flags().Changed("title") {
+		fields["title"] = actorUpdateTitle
+	}
+	if cmd.Flags().Changed("type") {
+		t, err := core.ParseActorType(actorUpdateType)
+		if err != nil {
+			return err
+		}
+		fields["type"] = string(t)
+	}
+	if cmd.Flags().Changed("description") {
+		fields["description"] = actorUpdateDesc
+	}
+	if len(fields) == 0 {
+		return fmt.Errorf("--title, --type, --description のいずれかを指定してください")
+	}
+
+	revision := actorUpdateRevision
+	if revision == "" {
+		var err error
+		if revision, err = zeus.EntityRevision(ctx, "actor", id); err != nil {
+			return fmt.Errorf("アクターの取得に失敗: %w", err)
+		}
+	}
+
+	var approvalID string
+	err := mutate(cmd, zeus, func(tx *core.Zeus) error {
+		_, err := tx.UpdateEntity(ctx, "actor", id, fields, revision)
+		return queuedForApproval(err, &approvalID)
+	})
+	if err != nil {
+		return fmt.Errorf("アクターの更新に失敗: %w", err)
+	}
+	if approvalID != "" {
+		printQueuedForApproval(fmt.Sprintf("actor %s の更新", id), approvalID)
+		return nil
+	}
+	green := color.New(color.FgGreen).SprintFunc()
+	fmt.Printf("%s Updated actor %s\n", green("✓"), id)
+	return nil
+}
+
+func runActorRemove(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+	id := args[0]
+
+	var approvalID string
+	remove := func(tx *core.Zeus) error {
+		return queuedForApproval(tx.RemoveActor(ctx, id, actorRemoveForce), &approvalID)
+	}
+
+	var err error
+	if isDryRun(cmd) {
+		var changes []core.FileChange
+		if changes, err = zeus.DryRun(ctx, remove); err == nil {
+			return printFileChanges(cmd, changes)
+		}
+	} else {
+		err = mutate(cmd, zeus, remove)
+	}
+	var inUse *core.ActorInUseError
+	if errors.As(err, &inUse) {
+		red := color.New(color.FgRed).SprintFunc()
+		fmt.Printf("%s %s は %d 件の UseCase から参照されています\n", red("[IN USE]"), id, len(inUse.UseCases))
+		for _, uc := range inUse.UseCases {
+			fmt.Printf("    - %s\n", uc)
+		}
+		return fmt.Errorf("アクターを削除できません（--force で UseCase の参照を外して削除）")
+	}
+	if err != nil {
+		return fmt.Errorf("アクターの削除に失敗: %w", err)
+	}
+	if approvalID != "" {
+		printQueuedForApproval(fmt.Sprintf("actor %s の削除", id), approvalID)
+		return nil
+	}
+	green := color.New(color.FgGreen).SprintFunc()
+	fmt.Printf("%s Removed actor %s\n", green("✓"), id)
+	return nil
+}
@@ -23,11 +23,18 @@ var (
 	// Objective 用
 	addGoals []string
 
+	// Objective / Activity 用（優先度スコア）
+	addRICE string
+	addWSJF string
+
+	// Objective / Activity 用（コスト）
+	addCost string
+
 	// Objective/UseCase 参照用
 	addObjectiveID string
 
-	// Consideration 用
-	addDueDate string // Consideration 専用
+	// Consideration / Release 用
+	addDueDate string // Consideration の期限日、Release の目標日
 
 	// Decision 用
 	addConsiderationID string
@@ -41,6 +48,8 @@ var (
 	// Risk 用
 	addProbability string
 	addImpact      string
+	addCostImpact  string
+	addDelayImpact string
 
 	// Constraint 用
 	addCategory      string
@@ -60,6 +69,13 @@ var (
 
 	// Activity 用（Task/Activity 統合）
 	addActivityUseCaseID string
+
+	// Container / Component 用
+	addTechnology  string
+	addContainerID string
+
+	// Release 用
+	addInclude []string
 )
 
 var addCmd = &cobra.Command{
@@ -81,18 +97,33 @@ var addCmd = &cobra.Command{
   usecase       UML ユースケース
   subsystem     UML サブシステム（ユースケース分類）
   activity      アクティビティ（作業単位 + プロセス可視化）
+  statemachine  UML ステートマシン（状態と遷移）
+  container     C4 コンテナ（アプリケーション・データストア等）
+  component     C4 コンポーネント（コンテナ内部の構成要素）
+  release       リリース（バージョン）
 
 共通オプション:
   --description  説明
-  --owner        オーナー
+  --owner        オーナー（メンバー名簿がある場合は ID または表示名）
   --tags         タグ（カンマ区切り）
 
 Activity 用オプション:
   --usecase     紐づく UseCase の ID
 
+Objective / Activity 用オプション（優先度スコア、zeus prioritize で順位付け）:
+  --rice        RICE の評価値（例: reach=500,impact=2,confidence=80,effort=3）
+  --wsjf        WSJF の評価値（例: business_value=8,time_criticality=5,risk_reduction=3,job_size=5）
+
+Objective / Activity 用オプション（コスト、zeus budget で予算対実績を表示）:
+  --cost        予算・契約済み・実績（例: budgeted=1000000,committed=200000,actual=300000、省略したキーは 0）
+
+StateMachine 用オプション:
+  --usecase     紐づく UseCase の ID
+
 Vision 用オプション:
   --statement         ビジョンステートメント
   --success-criteria  成功基準（カンマ区切り）
+  --rationale         変更理由（vision/history の版に記録）
 
 Consideration 用オプション:
   --objective     紐づく Objective の ID
@@ -111,6 +142,8 @@ Problem 用オプション:
 Risk 用オプション:
   --probability   発生確率（high, medium, low）
   --impact        影響度（critical, high, medium, low）
+  --cost-impact   発生した場合のコストの三点見積もり（min=N,likely=N,max=N）
+  --delay-impact  発生した場合の遅延日数の三点見積もり（min=N,likely=N,max=N）
   --objective     紐づく Objective の ID
 
 Assumption 用オプション:
@@ -137,20 +170,38 @@ UseCase 用オプション:
 Subsystem 用オプション:
   --description   説明
 
+Release 用オプション:
+  --due           目標日（YYYY-MM-DD）
+  --include       含める UseCase / Activity と判定に使う Quality の ID（カンマ区切り、uc-/act-/qual- で判別）
+
+Container 用オプション:
+  --technology    技術スタック
+  --subsystem     所属サブシステムの ID
+
+Component 用オプション:
+  --container     所属コンテナの ID（必須）
+  --technology    技術スタック
+
 例:
   zeus add vision "AI駆動PM" --statement "AIと人間が協調するPM"
+  zeus add vision "AI駆動PM" --statement "AIとチームが協調するPM" --rationale "対象をチームに拡大"
   zeus add objective "認証システム実装"
   zeus add consideration "認証方式の選択" --objective obj-001
   zeus add decision "JWT認証を採用" --consideration con-001 --selected-opt-id opt-1 --selected-title "JWT" --rationale "セキュリティと拡張性"
   zeus add problem "パフォーマンス問題" --severity high --objective obj-001
   zeus add risk "外部API依存" --probability medium --impact high
+  zeus add risk "ベンダー遅延" --probability high --impact high --delay-impact min=2,likely=5,max=15
   zeus add assumption "ユーザー数1000人以下" --objective obj-001
   zeus add constraint "外部DB不使用" --category technical --non-negotiable
   zeus add quality "コードカバレッジ" --objective obj-001 --metric "coverage:80:%" --metric "performance:100:ms"
   zeus add actor "管理者" --type human
   zeus add usecase "ログイン" --objective obj-001 --actor actor-001 --actor-role primary --subsystem sub-core
   zeus add subsystem "認証システム" --description "ユーザー認証関連のユースケース"
-  zeus add activity "API設計" --usecase uc-setup`,
+  zeus add activity "API設計" --usecase uc-setup
+  zeus add statemachine "注文ライフサイクル" --usecase uc-order
+  zeus add container "API サーバー" --technology Go --subsystem sub-core
+  zeus add component "認証ハンドラー" --container ctr-api --technology Go
+  zeus add release v1.2 --due 2026-12-01 --include uc-login,act-001,qual-001`,
 	Args: cobra.ExactArgs(2),
 	RunE: runAdd,
 }
@@ -174,13 +225,13 @@ func init() {
 	addCmd.Flags().StringVar(&addObjectiveID, "objective", "", "紐づく Objective の ID")
 
 	// Consideration 用フラグ
-	addCmd.Flags().StringVar(&addDueDate, "due", "", "期限日（Consideration 用）")
+	addCmd.Flags().StringVar(&addDueDate, "due", "", "期限日（Consideration 用）、目標日（Release 用）")
 
 	// Decision 用フラグ
 	addCmd.Flags().StringVar(&addConsiderationID, "consideration", "", "紐づく Consideration の ID")
 	addCmd.Flags().StringVar(&addSelectedOptID, "selected-opt-id", "", "選択した Option の ID")
 	addCmd.Flags().StringVar(&addSelectedTitle, "selected-title", "", "選択した Option のタイトル")
-	addCmd.Flags().StringVar(&addRationale, "rationale", "", "選択理由")
+	addCmd.Flags().StringVar(&addRationale, "rationale", "", "選択理由（Decision）、変更理由（Vision、版の履歴に記録）")
 
 	// Problem 用フラグ
 	addCmd.Flags().StringVar(&addSeverity, "severity", "", "深刻度（critical, high, medium, low）")
@@ -188,6 +239,8 @@ func init() {
 	// Risk 用フラグ
 	addCmd.Flags().StringVar(&addProbability, "probability", "", "発生確率（high, medium, low）")
 	addCmd.Flags().StringVar(&addImpact, "impact", "", "影響度（critical, high, medium, low）")
+	addCmd.Flags().StringVar(&addCostImpact, "cost-impact", "", "発生した場合のコストの三点見積もり（min=N,likely=N,max=N）")
+	addCmd.Flags().StringVar(&addDelayImpact, "delay-impact", "", "発生した場合の遅延日数の三点見積もり（min=N,likely=N,max=N）")
 
 	// Constraint 用フラグ
 	addCmd.Flags().StringVar(&addCategory, "category", "", "カテゴリ（technical, business, legal, resource）")
@@ -207,6 +260,20 @@ func init() {
 
 	// Activity 用フラグ（Task/Activity 統合）
 	addCmd.Flags().StringVar(&addActivityUseCaseID, "usecase", "", "紐づく UseCase の ID")
+
+	// Objective / Activity 用フラグ（優先度スコア）
+	addCmd.Flags().StringVar(&addRICE, "rice", "", "RICE の評価値（reach=N,impact=N,confidence=0-100,effort=N）")
+	addCmd.Flags().StringVar(&addWSJF, "wsjf", "", "WSJF の評価値（business_value=N,time_criticality=N,risk_reduction=N,job_size=N）")
+
+	// Objective / Activity 用フラグ（コスト）
+	addCmd.Flags().StringVar(&addCost, "cost", "", "予算・契約済み・実績（budgeted=N,committed=N,actual=N）")
+
+	// Container / Component 用フラグ
+	addCmd.Flags().StringVar(&addTechnology, "technology", "", "技術スタック（Container / Component 用）")
+	addCmd.Flags().StringVar(&addContainerID, "container", "", "所属コンテナの ID（Component 用）")
+
+	// Release 用フラグ
+	addCmd.Flags().StringSliceVar(&addInclude, "include", nil, "含める UseCase / Activity / Quality の ID（カンマ区切り、Release 用）")
 }
 
 func runAdd(cmd *cobra.Command, args []string) error {
@@ -216,10 +283,53 @@ func runAdd(cmd *cobra.Command, args []string) error {
 
 	zeus := getZeus(cmd)
 
+	// メンバー名簿がある場合はオーナーを正規の ID に変換
+	owner, err := zeus.ResolvePerson(ctx, addOwner)
+	if err != nil {
+		return fmt.Errorf("--owner: %w", err)
+	}
+	addOwner = owner
+
 	// オプションを構築（エンティティタイプに応じて）
 	opts := buildAddOptions(entity)
+	scoreOpts, err := buildScoreOptions(entity)
+	if err != nil {
+		return err
+	}
+	opts = append(opts, scoreOpts...)
+	costOpts, err := buildCostOptions(entity)
+	if err != nil {
+		return err
+	}
+	opts = append(opts, costOpts...)
+	estimateOpts, err := buildRiskEstimateOptions(entity)
+	if err != nil {
+		return err
+	}
+	opts = append(opts, estimateOpts...)
+	releaseOpts, err := buildReleaseIncludeOptions(entity)
+	if err != nil {
+		return err
+	}
+	opts = append(opts, releaseOpts...)
 
-	result, err := zeus.Add(ctx, entity, name, opts...)
+	if isDryRun(cmd) {
+		changes, err := zeus.DryRun(ctx, func(tx *core.Zeus) error {
+			_, err := tx.Add(ctx, entity, name, opts...)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return printFileChanges(cmd, changes)
+	}
+
+	var result *core.AddResult
+	err = mutate(cmd, zeus, func(tx *core.Zeus) error {
+		var err error
+		result, err = tx.Add(ctx, entity, name, opts...)
+		return err
+	})
 	if err != nil {
 		return err
 	}
@@ -280,6 +390,14 @@ func buildAddOptions(entity string) []core.EntityOption {
 		opts = buildSubsystemOptions()
 	case "activity":
 		opts = buildActivityOptions()
+	case "statemachine":
+		opts = buildStateMachineOptions()
+	case "container":
+		opts = buildContainerOptions()
+	case "component":
+		opts = buildComponentOptions()
+	case "release":
+		opts = buildReleaseOptions()
 	}
 
 	return opts
@@ -301,6 +419,9 @@ func buildVisionOptions() []core.EntityOption {
 	if len(addTags) > 0 {
 		opts = append(opts, core.WithVisionTags(addTags))
 	}
+	if addRationale != "" {
+		opts = append(opts, core.WithVisionRationale(addRationale))
+	}
 
 	return opts
 }
@@ -325,6 +446,75 @@ func buildObjectiveOptions() []core.EntityOption {
 	return opts
 }
 
+// buildScoreOptions は --rice / --wsjf から優先度スコアのオプションを構築（Objective / Activity のみ）
+func buildScoreOptions(entity string) ([]core.EntityOption, error) {
+	if addRICE == "" && addWSJF == "" {
+		return nil, nil
+	}
+	if entity != "objective" && entity != "activity" {
+		return nil, fmt.Errorf("--rice / --wsjf は objective と activity でのみ指定できます")
+	}
+
+	var opts []core.EntityOption
+	if addRICE != "" {
+		rice, err := core.ParseRICEScore(addRICE)
+		if err != nil {
+			return nil, fmt.Errorf("--rice: %w", err)
+		}
+		opts = append(opts, core.WithRICEScore(rice))
+	}
+	if addWSJF != "" {
+		wsjf, err := core.ParseWSJFScore(addWSJF)
+		if err != nil {
+			return nil, fmt.Errorf("--wsjf: %w", err)
+		}
+		opts = append(opts, core.WithWSJFScore(wsjf))
+	}
+	return opts, nil
+}
+
+// buildCostOptions は --cost からコストのオプションを構築（Objective / Activity のみ）
+func buildCostOptions(entity string) ([]core.EntityOption, error) {
+	if addCost == "" {
+		return nil, nil
+	}
+	if entity != "objective" && entity != "activity" {
+		return nil, fmt.Errorf("--cost は objective と activity でのみ指定できます")
+	}
+	cost, err := core.ParseCost(addCost)
+	if err != nil {
+		return nil, fmt.Errorf("--cost: %w", err)
+	}
+	return []core.EntityOption{core.WithCost(cost)}, nil
+}
+
+// buildRiskEstimateOptions は --cost-impact / --delay-impact から影響の見積もりのオプションを構築（Risk のみ）
+func buildRiskEstimateOptions(entity string) ([]core.EntityOption, error) {
+	if addCostImpact == "" && addDelayImpact == "" {
+		return nil, nil
+	}
+	if entity != "risk" {
+		return nil, fmt.Errorf("--cost-impact / --delay-impact は risk でのみ指定できます")
+	}
+
+	var opts []core.EntityOption
+	if addCostImpact != "" {
+		estimate, err := core.ParseRiskEstimate("cost_impact", addCostImpact)
+		if err != nil {
+			return nil, fmt.Errorf("--cost-impact: %w", err)
+		}
+		opts = append(opts, core.WithRiskCostImpact(estimate))
+	}
+	if addDelayImpact != "" {
+		estimate, err := core.ParseRiskEstimate("delay_impact", addDelayImpact)
+		if err != nil {
+			return nil, fmt.Errorf("--delay-impact: %w", err)
+		}
+		opts = append(opts, core.WithRiskDelayImpact(estimate))
+	}
+	return opts, nil
+}
+
 // buildConsiderationOptions は Consideration 用オプションを構築
 func buildConsiderationOptions() []core.EntityOption {
 	var opts []core.EntityOption
@@ -532,7 +722,7 @@ func buildActorOptions() []core.EntityOption {
 		case "external":
 			actorType = core.ActorTypeExternal
 		default:
-			actorType = core.ActorTypeHuman
+			actorType = core.ActorType(addActorType) // 不正な種別は Validate でエラーにする
 		}
 		opts = append(opts, core.WithActorType(actorType))
 	}
@@ -676,3 +866,121 @@ func parseMetrics(metricStrs []string) []core.QualityMetric {
 
 	return metrics
 }
+
+// buildStateMachineOptions は StateMachine 用オプションを構築
+// 状態と遷移は statemachines/sm-NNN.yaml を直接編集して定義する
+func buildStateMachineOptions() []core.EntityOption {
+	var opts []core.EntityOption
+
+	// UseCase 参照（Activity と共通の --usecase フラグ）
+	if addActivityUseCaseID != "" {
+		opts = append(opts, core.WithStateMachineUseCase(addActivityUseCaseID))
+	}
+	if addDescription != "" {
+		opts = append(opts, core.WithStateMachineDescription(addDescription))
+	}
+	if addOwner != "" {
+		opts = append(opts, core.WithStateMachineOwner(addOwner))
+	}
+	if len(addTags) > 0 {
+		opts = append(opts, core.WithStateMachineTags(addTags))
+	}
+
+	return opts
+}
+
+// buildContainerOptions は Container 用オプションを構築
+func buildContainerOptions() []core.EntityOption {
+	var opts []core.EntityOption
+
+	if addDescription != "" {
+		opts = append(opts, core.WithContainerDescription(addDescription))
+	}
+	if addTechnology != "" {
+		opts = append(opts, core.WithContainerTechnology(addTechnology))
+	}
+	if addSubsystemID != "" {
+		opts = append(opts, core.WithContainerSubsystem(addSubsystemID))
+	}
+	if addOwner != "" {
+		opts = append(opts, core.WithContainerOwner(addOwner))
+	}
+	if len(addTags) > 0 {
+		opts = append(opts, core.WithContainerTags(addTags))
+	}
+
+	return opts
+}
+
+// buildComponentOptions は Component 用オプションを構築
+func buildComponentOptions() []core.EntityOption {
+	var opts []core.EntityOption
+
+	if addContainerID != "" {
+		opts = append(opts, core.WithComponentContainer(addContainerID))
+	}
+	if addDescription != "" {
+		opts = append(opts, core.WithComponentDescription(addDescription))
+	}
+	if addTechnology != "" {
+		opts = append(opts, core.WithComponentTechnology(addTechnology))
+	}
+	if addOwner != "" {
+		opts = append(opts, core.WithComponentOwner(addOwner))
+	}
+	if len(addTags) > 0 {
+		opts = append(opts, core.WithComponentTags(addTags))
+	}
+
+	return opts
+}
+
+// buildReleaseOptions は Release 用オプションを構築
+func buildReleaseOptions() []core.EntityOption {
+	var opts []core.EntityOption
+
+	if addDueDate != "" {
+		opts = append(opts, core.WithReleaseTargetDate(addDueDate))
+	}
+	if addDescription != "" {
+		opts = append(opts, core.WithReleaseDescription(addDescription))
+	}
+	if addOwner != "" {
+		opts = append(opts, core.WithReleaseOwner(addOwner))
+	}
+	if len(addTags) > 0 {
+		opts = append(opts, core.WithReleaseTags(addTags))
+	}
+
+	return opts
+}
+
+// buildReleaseIncludeOptions は --include の ID を種別（UseCase / Activity / Quality）ごとに振り分ける（Release のみ）
+func buildReleaseIncludeOptions(entity string) ([]core.EntityOption, error) {
+	if len(addInclude) == 0 {
+		return nil, nil
+	}
+	if entity != "release" {
+		return nil, fmt.Errorf("--include は release でのみ指定できます")
+	}
+
+	var usecases, activities, qualities []string
+	for _, id := range addInclude {
+		entityType, _ := core.EntityTypeFromID(id)
+		switch entityType {
+		case "usecase":
+			usecases = append(usecases, id)
+		case "activity":
+			activities = append(activities, id)
+		case "quality":
+			qualities = append(qualities, id)
+		default:
+			return nil, fmt.Errorf("--include: UseCase / Activity / Quality の ID を指定してください: %s", id)
+		}
+	}
+	return []core.EntityOption{
+		core.WithReleaseUseCases(usecases),
+		core.WithReleaseActivities(activities),
+		core.WithReleaseQualities(qualities),
+	}, nil
+}
@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var adoptCmd = &cobra.Command{
+	Use:   "adopt",
+	Short: "孤立した Activity に親 UseCase を割り当て",
+	Long: `UseCase に紐づいていない（孤立した）Activity に対して親 UseCase の候補を提案し、
+受け入れた候補を usecase_id として適用します。
+
+候補のスコアはアフィニティ計算の重みを用いて、UseCase タイトルとの類似度（親子）と
+その UseCase に既に属する Activity との類似度（兄弟）から算出します。
+
+モード:
+  (デフォルト) - 対話モード。Activity ごとに候補番号を入力して適用
+                 （Enter/s: スキップ, q: 終了）
+  --auto       - 最有力候補のスコアが --min-score 以上かつ同点がない場合に自動適用
+
+例:
+  zeus adopt                        # 対話モード
+  zeus adopt --auto                 # ヒューリスティックで自動適用
+  zeus adopt --auto --dry-run       # 適用内容の確認のみ
+  zeus adopt --auto --min-score 0.5`,
+	RunE: runAdopt,
+}
+
+var (
+	adoptAuto       bool
+	adoptMinScore   float64
+	adoptCandidates int
+)
+
+func init() {
+	rootCmd.AddCommand(adoptCmd)
+	adoptCmd.Flags().BoolVar(&adoptAuto, "auto", false, "ヒューリスティックで最有力候補を自動適用")
+	adoptCmd.Flags().Float64Var(&adoptMinScore, "min-score", 0.3, "--auto で適用する最小スコア（0.0-1.0）")
+	adoptCmd.Flags().IntVar(&adoptCandidates, "candidates", 3, "Activity ごとに表示する候補数")
+}
+
+func runAdopt(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+	dryRun := isDryRun(cmd)
+
+	proposals, err := zeus.SuggestAdoptions(ctx, adoptCandidates)
+	if err != nil {
+		return fmt.Errorf("候補の算出に失敗: %w", err)
+	}
+
+	cyan := color.New(color.FgCyan).SprintFunc()
+	green := color.New(color.FgGreen).SprintFunc()
+	yellow := color.New(color.FgYellow).SprintFunc()
+
+	fmt.Println(cyan("Zeus Adopt"))
+	fmt.Println("============================================================")
+	if len(proposals) == 0 {
+		fmt.Println("[INFO] 孤立した Activity はありません。")
+		return nil
+	}
+	fmt.Printf("孤立した Activity: %d 件\n\n", len(proposals))
+
+	reader := bufio.NewReader(cmd.InOrStdin())
+	adopted, skipped := 0, 0
+
+	for _, proposal := range proposals {
+		fmt.Printf("%s %s\n", proposal.ActivityID, proposal.ActivityTitle)
+		if len(proposal.Candidates) == 0 {
+			fmt.Println("  候補なし")
+			fmt.Println()
+			skipped++
+			continue
+		}
+		printAdoptionCandidates(proposal)
+
+		var parentID string
+		if adoptAuto {
+			best, ok := proposal.Best()
+			if !ok || best.Score < adoptMinScore {
+				fmt.Println(yellow("  → スキップ（確度不足）"))
+				fmt.Println()
+				skipped++
+				continue
+			}
+			parentID = best.ParentID
+		} else {
+			choice, quit, err := promptAdoptionChoice(cmd.OutOrStdout(), reader, len(proposal.Candidates))
+			if err != nil {
+				return err
+			}
+			if quit {
+				break
+			}
+			if choice < 0 {
+				fmt.Println()
+				skipped++
+				continue
+			}
+			parentID = proposal.Candidates[choice].ParentID
+		}
+
+		if dryRun {
+			fmt.Printf("  → %s に紐づけ予定（dry-run）\n\n", parentID)
+			adopted++
+			continue
+		}
+		var approvalID string
+		if err := queuedForApproval(zeus.AdoptActivity(ctx, proposal.ActivityID, parentID), &approvalID); err != nil {
+			return fmt.Errorf("%s の紐づけに失敗: %w", proposal.ActivityID, err)
+		}
+		if approvalID != "" {
+			printQueuedForApproval(proposal.ActivityID+" の紐づけ", approvalID)
+			fmt.Println()
+			adopted++
+			continue
+		}
+		fmt.Printf("  %s %s に紐づけました\n\n", green("✓"), parentID)
+		adopted++
+	}
+
+	fmt.Println("============================================================")
+	if dryRun {
+		fmt.Printf("適用予定: %d 件, スキップ: %d 件\n", adopted, skipped)
+	} else {
+		fmt.Printf("適用: %d 件, スキップ: %d 件\n", adopted, skipped)
+	}
+	return nil
+}
+
+// printAdoptionCandidates は候補一覧を番号付きで表示
+func printAdoptionCandidates(proposal analysis.AdoptionProposal) {
+	for i, c := range proposal.Candidates {
+		fmt.Printf("  [%d] %s %s (score: %.2f) - %s\n",
+			i+1, c.ParentID, c.ParentTitle, c.Score, strings.Join(c.Reasons, ", "))
+	}
+}
+
+// promptAdoptionChoice は候補番号の入力を受け付ける
+// 戻り値: 選択インデックス（-1 はスキップ）、終了指示、エラー
+func promptAdoptionChoice(out io.Writer, reader *bufio.Reader, n int) (int, bool, error) {
+	for {
+		fmt.Fprintf(out, "  選択 [1-%d, Enter/s: スキップ, q: 終了]: ", n)
+		line, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return 0, false, fmt.Errorf("入力の読み込みに失敗: %w", err)
+		}
+		input := strings.TrimSpace(line)
+
+		switch {
+		case input == "q":
+			return 0, true, nil
+		case input == "" || input == "s":
+			if err == io.EOF && input == "" {
+				// 入力終端は終了として扱う
+				fmt.Fprintln(out)
+				return 0, true, nil
+			}
+			return -1, false, nil
+		}
+		if num, convErr := strconv.Atoi(input); convErr == nil && num >= 1 && num <= n {
+			return num - 1, false, nil
+		}
+		if err == io.EOF {
+			return 0, true, nil
+		}
+		fmt.Fprintln(out, "  無効な入力です。")
+	}
+}
@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var affinityCmd = &cobra.Command{
+	Use:   "affinity",
+	Short: "アフィニティクラスタ操作",
+	Long: `エンティティ間のアフィニティ（関連性）から算出したクラスタを扱います。
+クラスタは Objective ごとに構成され、親子・参照関係で直結する Quality / Risk を含みます。
+
+重みやクラスタの閾値は zeus.yaml の analysis.affinity で設定できます（--min-score はそれより優先）。
+計算はワーカープールで並列に行います（analysis.affinity.workers、0 で CPU 数）。
+大規模プロジェクトでは --approximate（analysis.affinity.approximate）で、max_siblings を超える兄弟グループを
+タイトル順に並べて一定件数ずつのバケットに分け、バケット内のペアのみを計算します。`,
+}
+
+var affinityClustersCmd = &cobra.Command{
+	Use:   "clusters",
+	Short: "アフィニティクラスタを一覧表示",
+	Long: `アフィニティ計算で得られたクラスタとメンバーを一覧表示します。
+
+例:
+  zeus affinity clusters
+  zeus affinity clusters --min-score 0.5`,
+	RunE: runAffinityClusters,
+}
+
+var affinityApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "クラスタをタググルーピングとして永続化",
+	Long: `受け入れたクラスタのメンバーに共通タグを付与し、グルーピングとして保存します。
+タグは Objective では tags、Quality / Risk / Activity では metadata.tags に追加されます。
+既に同じタグを持つエンティティは変更しません。
+
+オプション:
+  --cluster <id>  適用するクラスタ ID（必須、zeus affinity clusters で確認）
+  --tag <name>    付与するタグ（省略時はクラスタ ID）
+  --dry-run       書き込まずに適用内容のみ表示
+
+例:
+  zeus affinity apply --cluster cluster-obj-001
+  zeus affinity apply --cluster cluster-obj-001 --tag checkout
+  zeus affinity apply --cluster cluster-obj-001 --dry-run`,
+	RunE: runAffinityApply,
+}
+
+var (
+	affinityMinScore float64
+	affinityCluster  string
+	affinityTag      string
+)
+
+func init() {
+	rootCmd.AddCommand(affinityCmd)
+	affinityCmd.AddCommand(affinityClustersCmd)
+	affinityCmd.AddCommand(affinityApplyCmd)
+
+	affinityCmd.PersistentFlags().Float64Var(&affinityMinScore, "min-score", 0.0, "クラスタに含める関連の最小スコア（0.0-1.0、zeus.yaml の設定より優先）")
+	affinityCmd.PersistentFlags().Bool("approximate", false, "大きな兄弟グループをタイトル順でバケットに分けて近似計算（大規模プロジェクト向け）")
+	affinityApplyCmd.Flags().StringVar(&affinityCluster, "cluster", "", "適用するクラスタ ID")
+	affinityApplyCmd.Flags().StringVar(&affinityTag, "tag", "", "付与するタグ（省略時はクラスタ ID）")
+	_ = affinityApplyCmd.MarkFlagRequired("cluster")
+}
+
+// affinityOptions は zeus.yaml の analysis.affinity 設定にフラグを反映したオプションを構築
+func affinityOptions(ctx context.Context, cmd *cobra.Command, zeus *core.Zeus) (analysis.AffinityOptions, error) {
+	options, err := zeus.AffinityOptions(ctx)
+	if err != nil {
+		return options, fmt.Errorf("アフィニティ設定エラー: %w", err)
+	}
+	if cmd.Flags().Changed("min-score") {
+		options.MinScore = affinityMinScore
+		if err := options.Validate(); err != nil {
+			return options, fmt.Errorf("--min-score が不正です: %w", err)
+		}
+	}
+	if cmd.Flags().Changed("approximate") {
+		options.Approximate, _ = cmd.Flags().GetBool("approximate")
+	}
+	return options, nil
+}
+
+func runAffinityClusters(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	options, err := affinityOptions(ctx, cmd, zeus)
+	if err != nil {
+		return err
+	}
+	result, err := zeus.CalculateAffinity(ctx, options)
+	if err != nil {
+		return fmt.Errorf("アフィニティ計算失敗: %w", err)
+	}
+
+	cyan := color.New(color.FgCyan).SprintFunc()
+	fmt.Println(cyan("Zeus Affinity Clusters"))
+	fmt.Println("============================================================")
+	if len(result.Clusters) == 0 {
+		fmt.Println("[INFO] クラスタはありません。")
+		return nil
+	}
+	for _, c := range result.Clusters {
+		fmt.Printf("%s %s (%d)\n", c.ID, c.Name, len(c.Members))
+		fmt.Printf("  %s\n", strings.Join(c.Members, ", "))
+	}
+	return nil
+}
+
+func runAffinityApply(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+	dryRun := isDryRun(cmd)
+
+	options, err := affinityOptions(ctx, cmd, zeus)
+	if err != nil {
+		return err
+	}
+	result, err := zeus.ApplyAffinityCluster(ctx, affinityCluster, affinityTag, options, dryRun)
+	if err != nil {
+		return fmt.Errorf("クラスタ適用失敗: %w", err)
+	}
+
+	green := color.New(color.FgGreen).SprintFunc()
+	yellow := color.New(color.FgYellow).SprintFunc()
+
+	for _, id := range result.Tagged {
+		fmt.Printf("  %s %s\n", green("✓"), id)
+	}
+	for _, id := range result.Unchanged {
+		fmt.Printf("  - %s (タグ付与済み)\n", id)
+	}
+	for _, id := range result.Skipped {
+		fmt.Printf("  %s %s (タグを持てない種別)\n", yellow("!"), id)
+	}
+
+	if dryRun {
+		fmt.Printf("タグ '%s' を %d 件に付与予定（dry-run）\n", result.Tag, len(result.Tagged))
+	} else {
+		fmt.Printf("%s タグ '%s' を %d 件に付与しました。\n", green("[SUCCESS]"), result.Tag, len(result.Tagged))
+	}
+	return nil
+}
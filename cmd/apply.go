@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 
+	"github.com/biwakonbu/zeus/internal/core"
 	"github.com/spf13/cobra"
 )
 
@@ -17,20 +18,19 @@ var applyCmd = &cobra.Command{
 }
 
 var (
-	applyAll    bool
-	applyDryRun bool
+	applyAll bool
 )
 
 func init() {
 	rootCmd.AddCommand(applyCmd)
 
 	applyCmd.Flags().BoolVar(&applyAll, "all", false, "すべての保留中の提案を適用")
-	applyCmd.Flags().BoolVar(&applyDryRun, "dry-run", false, "実際には適用せずに表示のみ")
 }
 
 func runApply(cmd *cobra.Command, args []string) error {
 	ctx := getContext(cmd)
 	zeus := getZeus(cmd)
+	dryRun := isDryRun(cmd)
 
 	// 引数検証
 	if len(args) > 1 {
@@ -51,12 +51,22 @@ func runApply(cmd *cobra.Command, args []string) error {
 	}
 
 	// 提案を適用
-	result, err := zeus.ApplySuggestion(ctx, suggestionID, applyAll, applyDryRun)
+	var result *core.ApplyResult
+	var err error
+	if dryRun {
+		result, err = zeus.ApplySuggestion(ctx, suggestionID, applyAll, true)
+	} else {
+		err = mutate(cmd, zeus, func(tx *core.Zeus) error {
+			var err error
+			result, err = tx.ApplySuggestion(ctx, suggestionID, applyAll, false)
+			return err
+		})
+	}
 	if err != nil {
 		return fmt.Errorf("提案適用失敗: %w", err)
 	}
 
-	if applyDryRun {
+	if dryRun {
 		fmt.Println("\n[DRY-RUN] 実際には適用されません")
 	}
 
@@ -32,6 +32,9 @@ func runApprove(cmd *cobra.Command, args []string) error {
 	if result.Success {
 		green := color.New(color.FgGreen).SprintFunc()
 		fmt.Printf("%s Approved: %s\n", green("✓"), result.ID)
+		if result.EntityID != "" {
+			fmt.Printf("   反映したエンティティ: %s\n", result.EntityID)
+		}
 	}
 
 	return nil
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/biwakonbu/zeus/internal/diagram"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// architecture コマンドのフラグ
+var (
+	architectureDiagramOutput string
+	architectureLinkLabel     string
+	architectureLinkTech      string
+)
+
+var architectureCmd = &cobra.Command{
+	Use:   "architecture",
+	Short: "アーキテクチャ（Container / Component）操作",
+	Long: `C4 スタイルのアーキテクチャモデルに関する操作を行います。
+
+Container / Component は 'zeus add container' / 'zeus add component' で追加します。`,
+}
+
+var architectureDiagramCmd = &cobra.Command{
+	Use:   "diagram",
+	Short: "アーキテクチャ図を出力",
+	Long: `サブシステム・コンテナ・コンポーネントと関係から Mermaid flowchart を出力します。
+
+サブシステムとコンポーネントを持つコンテナは境界（subgraph）として表現されます。
+
+例:
+  zeus architecture diagram
+  zeus architecture diagram -o architecture.md`,
+	Args: cobra.NoArgs,
+	RunE: runArchitectureDiagram,
+}
+
+var architectureLinkCmd = &cobra.Command{
+	Use:   "link <source-id> <target-id>",
+	Short: "Container / Component 間の関係を追加",
+	Long: `Container / Component 間に関係（C4 の Rel）を追加します。
+
+例:
+  zeus architecture link ctr-web ctr-api --label "API 呼び出し" --technology "HTTPS/JSON"
+  zeus architecture link cmp-auth ctr-db --label "ユーザー参照"`,
+	Args: cobra.ExactArgs(2),
+	RunE: runArchitectureLink,
+}
+
+func init() {
+	rootCmd.AddCommand(architectureCmd)
+	architectureCmd.AddCommand(architectureDiagramCmd)
+	architectureCmd.AddCommand(architectureLinkCmd)
+
+	architectureDiagramCmd.Flags().StringVarP(&architectureDiagramOutput, "output", "o", "", "出力ファイル（省略時は標準出力）")
+	architectureLinkCmd.Flags().StringVar(&architectureLinkLabel, "label", "", "関係の説明")
+	architectureLinkCmd.Flags().StringVar(&architectureLinkTech, "technology", "", "通信手段（例: HTTPS/JSON）")
+}
+
+func runArchitectureDiagram(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	containerHandler, componentHandler, err := getArchitectureHandlers(zeus)
+	if err != nil {
+		return err
+	}
+
+	subsystems, err := zeus.Subsystems().ListAll(ctx)
+	if err != nil {
+		return fmt.Errorf("サブシステム取得失敗: %w", err)
+	}
+	containers, err := containerHandler.ListAll(ctx)
+	if err != nil {
+		return fmt.Errorf("コンテナ取得失敗: %w", err)
+	}
+	components, err := componentHandler.ListAll(ctx)
+	if err != nil {
+		return fmt.Errorf("コンポーネント取得失敗: %w", err)
+	}
+
+	output := diagram.ArchitectureMermaid(diagram.Architecture{
+		Subsystems: subsystems,
+		Containers: containers,
+		Components: components,
+	})
+
+	if architectureDiagramOutput != "" {
+		if err := os.WriteFile(architectureDiagramOutput, []byte(output), 0644); err != nil {
+			return fmt.Errorf("ファイル出力失敗: %w", err)
+		}
+		green := color.New(color.FgGreen).SprintFunc()
+		fmt.Printf("%s アーキテクチャ図を %s に出力しました。\n", green("✓"), architectureDiagramOutput)
+		return nil
+	}
+
+	fmt.Print(output)
+	return nil
+}
+
+func runArchitectureLink(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+	sourceID := args[0]
+	targetID := args[1]
+
+	containerHandler, componentHandler, err := getArchitectureHandlers(zeus)
+	if err != nil {
+		return err
+	}
+
+	relation := core.ArchitectureRelation{
+		TargetID:   targetID,
+		Label:      architectureLinkLabel,
+		Technology: architectureLinkTech,
+	}
+
+	switch {
+	case strings.HasPrefix(sourceID, "ctr-"):
+		err = containerHandler.AddRelation(ctx, sourceID, relation)
+	case strings.HasPrefix(sourceID, "cmp-"):
+		err = componentHandler.AddRelation(ctx, sourceID, relation)
+	default:
+		return fmt.Errorf("関係元は Container (ctr-) または Component (cmp-) を指定してください: %s", sourceID)
+	}
+	if err != nil {
+		return fmt.Errorf("関係追加失敗: %w", err)
+	}
+
+	green := color.New(color.FgGreen).SprintFunc()
+	fmt.Printf("%s Linked %s -> %s\n", green("✓"), sourceID, targetID)
+	return nil
+}
+
+// getArchitectureHandlers は Registry から Container / Component ハンドラーを取得
+func getArchitectureHandlers(zeus *core.Zeus) (*core.ContainerHandler, *core.ComponentHandler, error) {
+	handler, ok := zeus.GetRegistry().Get("container")
+	if !ok {
+		return nil, nil, fmt.Errorf("container ハンドラーが見つかりません")
+	}
+	containerHandler, ok := handler.(*core.ContainerHandler)
+	if !ok {
+		return nil, nil, fmt.Errorf("containerHandler への型アサーションに失敗しました")
+	}
+
+	handler, ok = zeus.GetRegistry().Get("component")
+	if !ok {
+		return nil, nil, fmt.Errorf("component ハンドラーが見つかりません")
+	}
+	componentHandler, ok := handler.(*core.ComponentHandler)
+	if !ok {
+		return nil, nil, fmt.Errorf("componentHandler への型アサーションに失敗しました")
+	}
+
+	return containerHandler, componentHandler, nil
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "監査ログを表示",
+	Long: `add / update / delete / approve による変更操作の監査ログ（.zeus/logs/audit.jsonl）を表示します。
+
+各操作には操作者（--as、環境変数 ZEUS_ACTOR、OS のユーザー名）が記録され、
+--agent を指定して実行した操作にはエージェント名も記録されます。
+zeus.yaml の agents のガードレールで拒否した操作は denied として記録されます。
+
+例:
+  zeus audit
+  zeus audit -n 50 --by claude
+  zeus audit --format json`,
+	RunE: runAudit,
+}
+
+var (
+	auditLimit int
+	auditBy    string
+)
+
+func init() {
+	rootCmd.AddCommand(auditCmd)
+	auditCmd.Flags().IntVarP(&auditLimit, "limit", "n", 20, "表示件数")
+	auditCmd.Flags().StringVar(&auditBy, "by", "", "操作者またはエージェント名で絞り込み")
+}
+
+func runAudit(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	trail, err := zeus.AuditTrail(ctx, auditBy, auditLimit)
+	if err != nil {
+		return fmt.Errorf("監査ログの取得に失敗: %w", err)
+	}
+
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(trail)
+	}
+
+	cyan := color.New(color.FgCyan).SprintFunc()
+	red := color.New(color.FgRed).SprintFunc()
+	yellow := color.New(color.FgYellow).SprintFunc()
+	fmt.Println(cyan("Audit Log"))
+	fmt.Println("═══════════════════════════════════════════════════════════")
+	if len(trail) == 0 {
+		fmt.Println("No audit records.")
+		return nil
+	}
+	for _, r := range trail {
+		actor := formatActor(r.Actor, r.Agent)
+		result := r.Result
+		switch r.Result {
+		case core.AuditResultDenied:
+			result = red(r.Result)
+		case core.AuditResultQueued:
+			result = yellow(r.Result)
+		}
+		fmt.Printf("%s  %-20s %-6s %s %s  %s", r.Time, actor, r.Op, r.Entity, r.ID, result)
+		if r.ApprovalID != "" {
+			fmt.Printf(" (%s)", r.ApprovalID)
+		}
+		fmt.Println()
+		if r.Reason != "" {
+			fmt.Printf("    %s\n", r.Reason)
+		}
+	}
+	return nil
+}
+
+// formatActor は操作者とエージェントを表示用にまとめる（例: alice (agent: claude)）
+func formatActor(actor, agent string) string {
+	switch {
+	case actor == "" && agent == "":
+		return "-"
+	case agent == "":
+		return actor
+	case actor == "":
+		return "(agent: " + agent + ")"
+	}
+	return actor + " (agent: " + agent + ")"
+}
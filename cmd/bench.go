@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+var benchCmd = &cobra.Command{
+	Use:   "bench",
+	Short: "合成プロジェクトで分析パイプラインの性能を計測",
+	Long: `指定した規模の合成プロジェクトを一時ディレクトリに生成し、グラフ構築・WBS（階層出力）・
+アフィニティ・タイムラインの計算時間とアロケーションを計測します。
+カレントディレクトリのプロジェクトは読み書きしません。
+
+同じ --activities と --seed からは常に同じプロジェクトを生成するため、結果を -o で JSON に保存し、
+次のリリースで --compare に渡すと性能の劣化（--threshold を超える増加）を検出できます。
+劣化があった場合は終了コード 1 で終了します。
+
+計測対象: graph.unified, graph.relation, graph.dependency, wbs, affinity, timeline
+
+例:
+  zeus bench
+  zeus bench --activities 20000 --runs 5 -o bench-v1.2.0.json
+  zeus bench --compare bench-v1.2.0.json --threshold 0.1
+  zeus bench --only affinity --cpuprofile affinity.prof`,
+	RunE: runBench,
+}
+
+func init() {
+	rootCmd.AddCommand(benchCmd)
+	benchCmd.Flags().Int("activities", core.DefaultBenchActivities, "生成する Activity 数（他のエンティティ数はこれに比例）")
+	benchCmd.Flags().Int("runs", 3, "処理ごとの計測回数")
+	benchCmd.Flags().Int64("seed", 1, "合成プロジェクトの乱数シード")
+	benchCmd.Flags().StringSlice("only", nil, "計測する処理（カンマ区切り）")
+	benchCmd.Flags().String("dir", "", "合成プロジェクトを生成して残すディレクトリ（省略時は一時ディレクトリに生成して削除）")
+	benchCmd.Flags().StringP("output", "o", "", "結果を JSON で保存するファイル")
+	benchCmd.Flags().String("compare", "", "比較する基準の結果（zeus bench -o で保存した JSON）")
+	benchCmd.Flags().Float64("threshold", 0.2, "劣化とみなす増加率（0.2 で 20%）")
+	benchCmd.Flags().String("cpuprofile", "", "計測中の CPU プロファイルを書き出すファイル（go tool pprof 用）")
+	benchCmd.Flags().String("memprofile", "", "計測後のヒーププロファイルを書き出すファイル（go tool pprof 用）")
+}
+
+func runBench(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	activities, _ := cmd.Flags().GetInt("activities")
+	runs, _ := cmd.Flags().GetInt("runs")
+	seed, _ := cmd.Flags().GetInt64("seed")
+	only, _ := cmd.Flags().GetStringSlice("only")
+	dir, _ := cmd.Flags().GetString("dir")
+	output, _ := cmd.Flags().GetString("output")
+	comparePath, _ := cmd.Flags().GetString("compare")
+	threshold, _ := cmd.Flags().GetFloat64("threshold")
+	cpuProfile, _ := cmd.Flags().GetString("cpuprofile")
+	memProfile, _ := cmd.Flags().GetString("memprofile")
+	format, _ := cmd.Flags().GetString("format")
+
+	if activities <= 0 || runs <= 0 {
+		return fmt.Errorf("--activities と --runs は 1 以上を指定してください")
+	}
+	var baseline *core.BenchReport
+	if comparePath != "" {
+		data, err := os.ReadFile(comparePath)
+		if err != nil {
+			return fmt.Errorf("ファイル読み込み失敗: %w", err)
+		}
+		if err := json.Unmarshal(data, &baseline); err != nil {
+			return fmt.Errorf("基準の結果の読み込みに失敗: %w", err)
+		}
+	}
+
+	if dir == "" {
+		tmp, err := os.MkdirTemp("", "zeus-bench-*")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmp)
+		dir = tmp
+	}
+	size := core.NewBenchSize(activities)
+	start := time.Now()
+	zeus, err := core.GenerateBenchProject(ctx, dir, size, seed)
+	if err != nil {
+		return fmt.Errorf("合成プロジェクトの生成に失敗: %w", err)
+	}
+	// 進捗は標準エラー出力に書き、--format json の出力を汚さない
+	fmt.Fprintf(os.Stderr, "Generated %d activities in %s (%s)\n", size.Activities, time.Since(start).Round(time.Millisecond), dir)
+
+	if cpuProfile != "" {
+		f, err := os.Create(cpuProfile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if err := pprof.StartCPUProfile(f); err != nil {
+			return err
+		}
+	}
+	results, err := core.RunBench(ctx, zeus, only, runs)
+	if cpuProfile != "" {
+		pprof.StopCPUProfile()
+	}
+	if err != nil {
+		return fmt.Errorf("計測に失敗: %w", err)
+	}
+	if memProfile != "" {
+		if err := writeHeapProfile(memProfile); err != nil {
+			return err
+		}
+	}
+
+	report := core.NewBenchReport(appVersion, size, seed, results)
+	if output != "" {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(output, append(data, '\n'), 0644); err != nil {
+			return fmt.Errorf("結果の保存に失敗: %w", err)
+		}
+	}
+	var comparisons []core.BenchComparison
+	if baseline != nil {
+		comparisons = core.CompareBench(baseline, report, threshold)
+	}
+
+	if format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(struct {
+			*core.BenchReport
+			Comparisons []core.BenchComparison `json:"comparisons,omitempty"`
+		}{report, comparisons}); err != nil {
+			return err
+		}
+	} else {
+		printBenchReport(report, baseline, comparisons)
+		if output != "" {
+			fmt.Printf("\nSaved: %s\n", output)
+		}
+	}
+
+	regressions := 0
+	for _, c := range comparisons {
+		if c.Regression {
+			regressions++
+		}
+	}
+	if regressions > 0 {
+		return fmt.Errorf("%d 件の性能劣化を検出しました（threshold: %.0f%%）", regressions, threshold*100)
+	}
+	return nil
+}
+
+// writeHeapProfile はヒーププロファイルを書き出す
+func writeHeapProfile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	runtime.GC()
+	return pprof.WriteHeapProfile(f)
+}
+
+// printBenchReport はベンチマークの結果と基準との比較を表示
+func printBenchReport(report *core.BenchReport, baseline *core.BenchReport, comparisons []core.BenchComparison) {
+	green := color.New(color.FgGreen).SprintFunc()
+	red := color.New(color.FgRed).SprintFunc()
+
+	fmt.Println("═══════════════════════════════════════════════════════════")
+	fmt.Println("Zeus Bench")
+	fmt.Println("═══════════════════════════════════════════════════════════")
+	fmt.Printf("zeus %s | %s %s/%s | CPUs: %d | seed: %d\n",
+		report.ZeusVersion, report.GoVersion, report.OS, report.Arch, report.CPUs, report.Seed)
+	s := report.Size
+	fmt.Printf("Objectives: %d | UseCases: %d | Activities: %d | Considerations: %d | Decisions: %d | Risks: %d\n",
+		s.Objectives, s.UseCases, s.Activities, s.Considerations, s.Decisions, s.Risks)
+	fmt.Println("───────────────────────────────────────────────────────────")
+	fmt.Printf("%-18s %12s %12s %12s %12s\n", "NAME", "TIME/OP", "MIN", "ALLOCS/OP", "BYTES/OP")
+	for _, r := range report.Results {
+		fmt.Printf("%-18s %12s %12s %12d %12s\n", r.Name,
+			time.Duration(r.NsPerOp).Round(time.Microsecond), time.Duration(r.MinNsPerOp).Round(time.Microsecond),
+			r.AllocsPerOp, formatBenchBytes(r.BytesPerOp))
+	}
+
+	if baseline == nil {
+		return
+	}
+	fmt.Println("───────────────────────────────────────────────────────────")
+	fmt.Printf("Compared with zeus %s (%s)\n", baseline.ZeusVersion, baseline.CreatedAt)
+	if baseline.Size != report.Size || baseline.Seed != report.Seed {
+		fmt.Printf("%s 基準と規模・シードが異なるため、結果は比較できない可能性があります\n", red("!"))
+	}
+	for _, c := range comparisons {
+		mark := green("✓")
+		if c.Regression {
+			mark = red("✗")
+		}
+		fmt.Printf("  %s %-18s %-14s %+7.1f%%\n", mark, c.Name, c.Metric, c.Change*100)
+	}
+}
+
+// formatBenchBytes はバイト数を KiB・MiB 単位で表示
+func formatBenchBytes(n uint64) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MiB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KiB", float64(n)/(1<<10))
+	}
+	return fmt.Sprintf("%d B", n)
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var budgetCmd = &cobra.Command{
+	Use:   "budget",
+	Short: "予算対実績を表示",
+	Long: `Objective と Activity のコスト（予算・契約済み・実績）を集計し、予算対実績を表示します。
+
+コストは zeus add の --cost、または zeus update の --set cost.actual=300000 などで設定します。
+Activity のコストは UseCase 経由で Objective に集計します（Objective 自身のコストは
+Activity に割り当てない費用として合算）。実績 + 契約済みが予算を超えた項目は超過として警告します。
+金額の通貨は zeus.yaml の costs.currency で設定します。
+
+例:
+  zeus budget
+  zeus budget --format json`,
+	Args: cobra.NoArgs,
+	RunE: runBudget,
+}
+
+func init() {
+	rootCmd.AddCommand(budgetCmd)
+}
+
+func runBudget(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	budget, err := zeus.Budget(ctx)
+	if err != nil {
+		return err
+	}
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(budget)
+	}
+
+	if len(budget.Objectives) == 0 && len(budget.Unassigned) == 0 {
+		fmt.Println("コストが設定された Objective / Activity はありません（zeus add --cost で設定）")
+		return nil
+	}
+	amount := func(v float64) string { return core.FormatAmount(v, budget.Currency) }
+	printLine := func(indent string, line core.BudgetLine) {
+		mark := color.GreenString("✓")
+		if line.Overrun {
+			mark = color.RedString("!")
+		}
+		fmt.Printf("%s%s %s %s\n", indent, mark, line.ID, line.Title)
+		fmt.Printf("%s    予算 %s / 契約済み %s / 実績 %s / 残 %s (%d%%)\n", indent,
+			amount(line.Total.Budgeted), amount(line.Total.Committed), amount(line.Total.Actual), amount(line.Variance), line.Utilization)
+	}
+
+	cyan := color.New(color.FgCyan).SprintFunc()
+	fmt.Println(cyan("Budget vs Actual"))
+	fmt.Println("═══════════════════════════════════════════════════════════")
+	for _, obj := range budget.Objectives {
+		printLine("", obj)
+		for _, act := range obj.Activities {
+			printLine("    ", act)
+		}
+	}
+	if len(budget.Unassigned) > 0 {
+		fmt.Println()
+		fmt.Println("Objective 未割り当て:")
+		for _, act := range budget.Unassigned {
+			printLine("", act)
+		}
+	}
+	fmt.Println("───────────────────────────────────────────────────────────")
+	fmt.Printf("合計: 予算 %s / 契約済み %s / 実績 %s / 残 %s\n",
+		amount(budget.Total.Budgeted), amount(budget.Total.Committed), amount(budget.Total.Actual), amount(budget.Variance))
+	if len(budget.Overruns) > 0 {
+		fmt.Println()
+		fmt.Println(color.YellowString("予算超過 (%d):", len(budget.Overruns)))
+		for _, line := range budget.Overruns {
+			fmt.Printf("  - %s %s: %s 超過\n", line.ID, line.Title, amount(-line.Variance))
+		}
+	}
+	fmt.Println("═══════════════════════════════════════════════════════════")
+	return nil
+}
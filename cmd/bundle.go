@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+var bundleCmd = &cobra.Command{
+	Use:   "bundle",
+	Short: "プロジェクト全体を 1 ファイルで書き出し・取り込み",
+	Long: `.zeus 全体（エンティティ・zeus.yaml・スナップショット・ログ・承認など）を
+1 つのバンドル（tar.gz）として書き出し・取り込みます。
+別のマシンへの移行や、サポート担当者との再現可能な状態の共有に使います。
+
+バンドル先頭の manifest.json に形式のバージョンとファイルごとの SHA-256 を記録し、
+取り込み時にすべて照合してから展開します。
+
+例:
+  zeus bundle export project.zeusbundle
+  zeus bundle import project.zeusbundle --dir ../restored
+  zeus bundle import project.zeusbundle --dry-run`,
+}
+
+var bundleExportCmd = &cobra.Command{
+	Use:   "export <file>",
+	Short: "プロジェクトをバンドルに書き出し",
+	Long: `.zeus 全体をバンドル（tar.gz）に書き出します。<file> に - を指定すると標準出力に書き出します。
+ロックファイルなどの一時ファイルは含めません。
+zeus.yaml の認証情報（server.api_token、notifications.email.password、連携のトークンなど）は消去して書き出します。
+同じ環境への移行などで含める必要がある場合は --include-secrets を指定します。
+--anonymize を指定すると zeus export --anonymize と同じ匿名化したコピーを書き出します（ログは含めません）。`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBundleExport,
+}
+
+var bundleImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "バンドルからプロジェクトを復元",
+	Long: `バンドルを検証してプロジェクトの .zeus として展開します。<file> に - を指定すると標準入力から読み込みます。
+
+既にプロジェクトがある場合は --force で置き換えます（既存の .zeus は .zeus.backup-YYYYMMDD-HHMMSS に退避）。
+--dry-run ではバンドルの検証のみ行い、書き込みません。`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBundleImport,
+}
+
+func init() {
+	rootCmd.AddCommand(bundleCmd)
+	bundleCmd.AddCommand(bundleExportCmd)
+	bundleCmd.AddCommand(bundleImportCmd)
+
+	addAnonymizeFlag(bundleExportCmd)
+	bundleExportCmd.Flags().Bool("include-secrets", false, "zeus.yaml の認証情報（トークン・パスワード）を消去せずに含める")
+	bundleImportCmd.Flags().String("dir", ".", "取り込み先のプロジェクトディレクトリ")
+	bundleImportCmd.Flags().Bool("force", false, "既存の .zeus を退避して置き換える")
+}
+
+func runBundleExport(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus, cleanup, err := anonymizedZeus(cmd, getZeus(cmd))
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	output := args[0]
+	includeSecrets, _ := cmd.Flags().GetBool("include-secrets")
+	opts := core.BundleExportOptions{ZeusVersion: appVersion, IncludeSecrets: includeSecrets}
+
+	if output == "-" {
+		_, err := zeus.ExportBundle(ctx, os.Stdout, opts)
+		return err
+	}
+	// 書き出しに失敗した場合に不完全なファイルを残さないよう、一時ファイルに書いてから置き換える
+	tmp, err := os.CreateTemp(filepath.Dir(output), ".zeusbundle-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	manifest, err := zeus.ExportBundle(ctx, tmp, opts)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("バンドルの書き出しに失敗: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), output); err != nil {
+		return err
+	}
+
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		return printBundleManifest(manifest)
+	}
+	green := color.New(color.FgGreen).SprintFunc()
+	fmt.Printf("%s Bundle exported: %s\n", green("✓"), output)
+	fmt.Printf("  Project: %s (%s)\n", manifest.ProjectName, manifest.ProjectID)
+	fmt.Printf("  Files: %d | Format: %s v%d\n", len(manifest.Files), manifest.Format, manifest.Version)
+	if manifest.SecretsRedacted {
+		fmt.Println("  Secrets in zeus.yaml were redacted (use --include-secrets to keep them)")
+	}
+	return nil
+}
+
+func runBundleImport(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	dir, _ := cmd.Flags().GetString("dir")
+	force, _ := cmd.Flags().GetBool("force")
+	zeus := getZeus(cmd)
+	if cmd.Flags().Changed("dir") {
+		zeus = newProjectZeus(cmd, dir)
+	}
+
+	var input io.Reader = os.Stdin
+	if args[0] != "-" {
+		file, err := os.Open(args[0])
+		if err != nil {
+			return fmt.Errorf("ファイル読み込み失敗: %w", err)
+		}
+		defer file.Close()
+		input = file
+	}
+
+	result, err := zeus.ImportBundle(ctx, input, core.BundleImportOptions{Force: force, DryRun: isDryRun(cmd)})
+	if err != nil {
+		return fmt.Errorf("取り込み失敗: %w", err)
+	}
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		return printBundleManifest(result)
+	}
+
+	manifest := result.Manifest
+	green := color.New(color.FgGreen).SprintFunc()
+	yellow := color.New(color.FgYellow).SprintFunc()
+	fmt.Printf("  Project: %s (%s)\n", manifest.ProjectName, manifest.ProjectID)
+	fmt.Printf("  Created: %s", manifest.CreatedAt)
+	if manifest.ZeusVersion != "" {
+		fmt.Printf(" (zeus %s)", manifest.ZeusVersion)
+	}
+	fmt.Println()
+	fmt.Printf("  Files: %d | Format: %s v%d\n", len(manifest.Files), manifest.Format, manifest.Version)
+	if manifest.SecretsRedacted {
+		fmt.Println("  Secrets in zeus.yaml were redacted on export; set them again if needed")
+	}
+	if manifest.SchemaVersion != core.SchemaVersion {
+		fmt.Printf("  %s スキーマのバージョンが異なります（bundle: %s, zeus: %s）。取り込み後に zeus doctor で確認してください\n",
+			yellow("!"), manifest.SchemaVersion, core.SchemaVersion)
+	}
+	if result.DryRun {
+		fmt.Printf("%s バンドルは有効です（dry-run）\n", yellow("[DRY-RUN]"))
+		return nil
+	}
+	if result.Backup != "" {
+		fmt.Printf("  Backup: %s\n", result.Backup)
+	}
+	fmt.Printf("%s Bundle imported: %s\n", green("✓"), zeus.ZeusPath)
+	return nil
+}
+
+// printBundleManifest はバンドルのマニフェスト・取り込み結果を JSON で出力
+func printBundleManifest(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
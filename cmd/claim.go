@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var claimCmd = &cobra.Command{
+	Use:   "claim [activity-id]",
+	Short: "Activity をワーカーに割り当てる（作業キュー）",
+	Long: `空いている Activity をワーカーに割り当て、有効期間付きのリースを発行します。
+
+複数の AI エージェントが同時に作業を取得しても、同じ Activity が二重に割り当てられることはありません。
+Activity を省略した場合は、廃止されていない Activity を優先度スコア（zeus prioritize）の高い順に選びます。
+作業中は 'zeus claim heartbeat' でリースを延長し、完了・中断したら 'zeus claim release' で解放します。
+期限が切れたリースは自動的に解放され、別のワーカーに割り当てられます。
+
+ワーカー名を省略した場合は --agent、--as（操作者）の順に使用します。
+
+例:
+  zeus --agent claude-1 claim
+  zeus claim act-1a2b3c4d --worker alice --ttl 30m
+  zeus claim heartbeat lease-9f8e7d6c
+  zeus claim release lease-9f8e7d6c
+  zeus claim list`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runClaim,
+}
+
+var claimHeartbeatCmd = &cobra.Command{
+	Use:   "heartbeat <lease-id>",
+	Short: "リースの期限を延長",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runClaimHeartbeat,
+}
+
+var claimReleaseCmd = &cobra.Command{
+	Use:   "release <lease-id>",
+	Short: "リースを解放",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runClaimRelease,
+}
+
+var claimListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "有効なリースを一覧表示",
+	Args:  cobra.NoArgs,
+	RunE:  runClaimList,
+}
+
+var (
+	claimWorker       string
+	claimLeaseTTL     time.Duration
+	claimHeartbeatTTL time.Duration
+)
+
+func init() {
+	rootCmd.AddCommand(claimCmd)
+	claimCmd.AddCommand(claimHeartbeatCmd)
+	claimCmd.AddCommand(claimReleaseCmd)
+	claimCmd.AddCommand(claimListCmd)
+	claimCmd.Flags().StringVar(&claimWorker, "worker", "", "ワーカー名（省略時は --agent、--as）")
+	claimCmd.Flags().DurationVar(&claimLeaseTTL, "ttl", core.DefaultClaimTTL, "リースの有効期間")
+	claimHeartbeatCmd.Flags().DurationVar(&claimHeartbeatTTL, "ttl", 0, "延長する有効期間（省略時は割り当て時の有効期間）")
+}
+
+func runClaim(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	worker := claimWorker
+	if worker == "" {
+		worker = zeus.Agent()
+	}
+	if worker == "" {
+		worker = zeus.Actor()
+	}
+	req := core.ClaimRequest{Worker: worker, TTL: claimLeaseTTL}
+	if len(args) > 0 {
+		req.ActivityID = args[0]
+	}
+
+	claim, err := zeus.ClaimWork(ctx, req, time.Now())
+	if err != nil {
+		return fmt.Errorf("作業の割り当てに失敗: %w", err)
+	}
+	return printClaim(cmd, fmt.Sprintf("%s を %s に割り当てました", claim.ActivityID, claim.Worker), claim)
+}
+
+func runClaimHeartbeat(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	claim, err := zeus.RenewClaim(ctx, args[0], claimHeartbeatTTL, time.Now())
+	if err != nil {
+		return fmt.Errorf("リースの延長に失敗: %w", err)
+	}
+	return printClaim(cmd, fmt.Sprintf("%s（%s）のリースを延長しました", claim.ActivityID, claim.Worker), claim)
+}
+
+func runClaimRelease(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	if err := zeus.ReleaseClaim(ctx, args[0], time.Now()); err != nil {
+		return fmt.Errorf("リースの解放に失敗: %w", err)
+	}
+	green := color.New(color.FgGreen).SprintFunc()
+	fmt.Printf("%s リース %s を解放しました\n", green("✓"), args[0])
+	return nil
+}
+
+func runClaimList(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	claims, err := zeus.Claims(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("リースの取得に失敗: %w", err)
+	}
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(claims)
+	}
+
+	cyan := color.New(color.FgCyan).SprintFunc()
+	fmt.Printf("%s (%d items)\n", cyan("Work Claims"), len(claims))
+	fmt.Println("═══════════════════════════════════════════════════════════")
+	if len(claims) == 0 {
+		fmt.Println("有効なリースはありません。")
+		return nil
+	}
+	for _, c := range claims {
+		fmt.Printf("%s  %-14s %-16s %s (expires %s)\n", c.LeaseID, c.ActivityID, c.Worker, c.Title, c.ExpiresAt)
+	}
+	return nil
+}
+
+// printClaim はリースを表示
+func printClaim(cmd *cobra.Command, message string, claim *core.WorkClaim) error {
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(claim)
+	}
+	green := color.New(color.FgGreen).SprintFunc()
+	fmt.Printf("%s %s\n", green("✓"), message)
+	fmt.Printf("   タイトル: %s\n", claim.Title)
+	fmt.Printf("   リース:   %s（期限: %s）\n", claim.LeaseID, claim.ExpiresAt)
+	return nil
+}
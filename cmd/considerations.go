@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var considerationsCmd = &cobra.Command{
+	Use:   "considerations",
+	Short: "Consideration（検討事項）を操作",
+	Long:  `Consideration（検討事項）の期限超過のエスカレーションなどを行います。一覧は 'zeus list considerations' で表示します。`,
+}
+
+var considerationsEscalateCmd = &cobra.Command{
+	Use:   "escalate",
+	Short: "期限を過ぎた Consideration をエスカレーション",
+	Long: `期限（due_date）を過ぎた open の Consideration をエスカレーションします。
+
+初めて期限を過ぎたものに escalated_at を記録し、escalation イベントを .zeus/logs/events.jsonl に
+追記します。zeus.yaml の escalation.considerations.auto_problem が有効な場合は、期限から grace_days を
+過ぎたものに Problem を 1 件だけ作成します。ダッシュボードも起動時と 1 時間ごとに同じ処理を行います。
+
+例:
+  zeus considerations escalate --dry-run   # 変更されるファイルのみ表示
+  zeus considerations escalate`,
+	RunE: runConsiderationsEscalate,
+}
+
+func init() {
+	rootCmd.AddCommand(considerationsCmd)
+	considerationsCmd.AddCommand(considerationsEscalateCmd)
+}
+
+func runConsiderationsEscalate(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+	now := time.Now()
+
+	if isDryRun(cmd) {
+		changes, err := zeus.DryRun(ctx, func(tx *core.Zeus) error {
+			_, err := tx.EscalateConsiderations(ctx, now)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("エスカレーション失敗: %w", err)
+		}
+		return printFileChanges(cmd, changes)
+	}
+
+	var result *core.ConsiderationEscalationResult
+	err := mutate(cmd, zeus, func(tx *core.Zeus) error {
+		var err error
+		result, err = tx.EscalateConsiderations(ctx, now)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("エスカレーション失敗: %w", err)
+	}
+	recordEscalations(ctx, zeus, result)
+
+	red := color.New(color.FgRed).SprintFunc()
+	green := color.New(color.FgGreen).SprintFunc()
+	for _, e := range result.Escalated {
+		fmt.Printf("%s [%s] %s overdue by %d days (due %s)\n", red("!"), e.ID, e.Title, e.DaysOverdue, e.DueDate)
+	}
+	for _, p := range result.Problems {
+		fmt.Printf("%s %s の期限超過を %s として記録しました\n", red("!"), p.ID, p.EscalatedTo)
+	}
+	fmt.Printf("%s %d overdue, %d escalated, %d problem(s) created\n", green("[SUCCESS]"), len(result.Overdue), len(result.Escalated), len(result.Problems))
+	return nil
+}
+
+// recordEscalations は新たにエスカレーションした Consideration と作成した Problem をイベントログに記録（zeus events tail で確認）
+func recordEscalations(ctx context.Context, zeus *core.Zeus, result *core.ConsiderationEscalationResult) {
+	log := zeus.EventLog()
+	for _, e := range append(result.Escalated, result.Problems...) {
+		_, _ = log.Append(ctx, core.EscalationEventType, e)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 
+	"github.com/biwakonbu/zeus/internal/core"
 	"github.com/biwakonbu/zeus/internal/dashboard"
 )
 
@@ -29,11 +30,40 @@ var dashboardCmd = &cobra.Command{
 デフォルトでブラウザが自動的に開きます。
 
 開発モード（--dev）では CORS が有効になり、
-Vite Dev Server からの API リクエストを受け付けます。`,
+Vite Dev Server からの API リクエストを受け付けます。
+
+zeus.yaml の server セクションで CORS 許可オリジン、API トークン、
+レート制限を設定できます（トークンは環境変数 ZEUS_API_TOKEN でも指定可）:
+
+  server:
+    allowed_origins: ["http://localhost:5173"]
+    api_token: "secret"
+    rate_limit: 120
+
+reports セクションの schedules を設定すると、起動中に cron 式に従って
+レポートを .zeus/reports/ に生成します:
+
+  reports:
+    schedules:
+      - name: weekly
+        cron: "0 9 * * 1"
+        format: markdown
+
+--project（複数指定可、"名前=パス" または "パス"）または --workspace で
+複数のプロジェクトを 1 つのサーバーで表示します。先頭のプロジェクトが既定となり、
+他のプロジェクトは API の ?project=<名前> で選択します（一覧は /api/projects）。
+server セクションは既定のプロジェクトの設定を使用します。ワークスペースファイル:
+
+  projects:
+    - name: api
+      path: ../api
+    - path: ../web      # name 省略時はディレクトリ名`,
 	Example: `  zeus dashboard
   zeus dashboard --port 3000
   zeus dashboard --no-open
-  zeus dashboard --dev --port 8080`,
+  zeus dashboard --dev --port 8080
+  zeus dashboard --project ../api --project web=../frontend
+  zeus dashboard --workspace zeus-workspace.yaml`,
 	RunE: runDashboard,
 }
 
@@ -42,16 +72,29 @@ func init() {
 	dashboardCmd.Flags().IntP("port", "p", 8080, "ポート番号")
 	dashboardCmd.Flags().Bool("no-open", false, "ブラウザを自動で開かない")
 	dashboardCmd.Flags().Bool("dev", false, "開発モード（CORS 有効）")
+	dashboardCmd.Flags().StringArray("project", nil, "表示するプロジェクト（\"名前=パス\" または \"パス\"、複数指定可。先頭が既定）")
+	dashboardCmd.Flags().String("workspace", "", "表示するプロジェクトを列挙したワークスペースファイル")
 }
 
 func runDashboard(cmd *cobra.Command, args []string) error {
 	ctx := getContext(cmd)
-	zeus := getZeus(cmd)
 
 	port, _ := cmd.Flags().GetInt("port")
 	noOpen, _ := cmd.Flags().GetBool("no-open")
 	devMode, _ := cmd.Flags().GetBool("dev")
 
+	projects, err := dashboardProjects(cmd)
+	if err != nil {
+		return err
+	}
+	zeus := getZeus(cmd)
+	if len(projects) > 0 {
+		zeus = newProjectZeus(cmd, projects[0].Path)
+		if _, err := zeus.LoadConfig(ctx); err != nil {
+			return fmt.Errorf("プロジェクト %s (%s): %w", projects[0].Name, projects[0].Path, err)
+		}
+	}
+
 	cyan := color.New(color.FgCyan).SprintFunc()
 	green := color.New(color.FgGreen).SprintFunc()
 	yellow := color.New(color.FgYellow).SprintFunc()
@@ -59,6 +102,42 @@ func runDashboard(cmd *cobra.Command, args []string) error {
 	// サーバー作成（開発モード対応）
 	server := dashboard.NewServerWithDevMode(zeus, port, devMode)
 
+	// zeus.yaml の server セクションを適用（トークンは環境変数 ZEUS_API_TOKEN を優先）
+	var settings core.ServerSettings
+	if config, err := zeus.LoadConfig(ctx); err == nil {
+		settings = config.Server
+	}
+	if token := os.Getenv("ZEUS_API_TOKEN"); token != "" {
+		settings.APIToken = token
+	}
+	server.SetServerSettings(settings)
+	server.SetVersion(appVersion)
+
+	// zeus.yaml の reports.schedules を定期レポートとして登録
+	schedules, err := setReportSchedules(ctx, server, zeus)
+	if err != nil {
+		return err
+	}
+
+	// 追加のプロジェクトを登録（各プロジェクトの reports.schedules も登録）
+	if len(projects) > 0 {
+		server.SetProjectName(projects[0].Name)
+	}
+	for i := 1; i < len(projects); i++ {
+		p := projects[i]
+		pz := newProjectZeus(cmd, p.Path)
+		if _, err := pz.LoadConfig(ctx); err != nil {
+			return fmt.Errorf("プロジェクト %s (%s): %w", p.Name, p.Path, err)
+		}
+		project, err := server.AddProject(p.Name, pz)
+		if err != nil {
+			return err
+		}
+		if _, err := setReportSchedules(ctx, project, pz); err != nil {
+			return fmt.Errorf("プロジェクト %s: %w", p.Name, err)
+		}
+	}
+
 	// サーバー起動
 	fmt.Println(cyan("Zeus Dashboard"))
 	fmt.Println("═══════════════════════════════════════════════════════════")
@@ -69,6 +148,23 @@ func runDashboard(cmd *cobra.Command, args []string) error {
 		fmt.Println("Mode: Production")
 	}
 
+	if settings.APIToken != "" {
+		fmt.Println("API Auth: token required for /api/*")
+	}
+	if settings.RateLimit > 0 {
+		fmt.Printf("Rate Limit: %d req/min per client\n", settings.RateLimit)
+	}
+	for _, s := range schedules {
+		fmt.Printf("Scheduled Report: %s (%s)\n", s.Name, s.Cron)
+	}
+	for i, p := range projects {
+		if i == 0 {
+			fmt.Printf("Project: %s (%s, default)\n", p.Name, p.Path)
+		} else {
+			fmt.Printf("Project: %s (%s, ?project=%s)\n", p.Name, p.Path, p.Name)
+		}
+	}
+
 	fmt.Printf("Starting server on port %d...\n", port)
 
 	if err := server.Start(ctx); err != nil {
@@ -113,6 +209,48 @@ func runDashboard(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// dashboardProjects は --workspace と --project で指定したプロジェクト（未指定の場合は空）
+func dashboardProjects(cmd *cobra.Command) ([]core.WorkspaceProject, error) {
+	ws := &core.Workspace{}
+	if path, _ := cmd.Flags().GetString("workspace"); path != "" {
+		loaded, err := core.LoadWorkspace(path)
+		if err != nil {
+			return nil, err
+		}
+		ws = loaded
+	}
+	specs, _ := cmd.Flags().GetStringArray("project")
+	for _, spec := range specs {
+		ws.Projects = append(ws.Projects, core.ParseWorkspaceProject(spec))
+	}
+	if len(ws.Projects) == 0 {
+		return nil, nil
+	}
+	if err := ws.Validate(); err != nil {
+		return nil, err
+	}
+	return ws.Projects, nil
+}
+
+// newProjectZeus は path のプロジェクトの Zeus を作成（--as / --agent を引き継ぐ）
+func newProjectZeus(cmd *cobra.Command, path string) *core.Zeus {
+	agent, _ := cmd.Flags().GetString("agent")
+	as, _ := cmd.Flags().GetString("as")
+	return core.New(path, core.WithAgent(agent), core.WithActor(core.ResolveActor(as)))
+}
+
+// setReportSchedules はプロジェクトの zeus.yaml の reports.schedules を定期レポートとして登録し、登録したスケジュールを返す
+func setReportSchedules(ctx context.Context, server *dashboard.Server, zeus *core.Zeus) ([]core.ReportSchedule, error) {
+	var schedules []core.ReportSchedule
+	if config, err := zeus.LoadConfig(ctx); err == nil {
+		schedules = config.Reports.Schedules
+	}
+	if err := server.SetReportSchedules(schedules); err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
 // openBrowser はデフォルトブラウザで URL を開く
 func openBrowser(url string) error {
 	var cmd *exec.Cmd
@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var (
+	decisionSupersedeTitle         string
+	decisionSupersedeConsideration string
+	decisionSupersedeSelectedOptID string
+	decisionSupersedeSelectedTitle string
+	decisionSupersedeRationale     string
+	decisionSupersedeImpact        []string
+	decisionSupersedeDecidedBy     string
+)
+
+var decisionCmd = &cobra.Command{
+	Use:   "decision",
+	Short: "Decision の置き換え（覆す）と置き換えの連鎖を扱う",
+	Long: `Decision はイミュータブルで変更・削除できません。
+決定を覆す場合は zeus decision supersede で以前の Decision を参照する新しい Decision を記録します。
+以前の Decision は変更されず、置き換えの連鎖として辿れます。`,
+}
+
+var decisionSupersedeCmd = &cobra.Command{
+	Use:   "supersede <decision-id>",
+	Short: "Decision を覆す新しい Decision を記録",
+	Long: `以前の Decision を覆す新しい Decision を記録します。
+新しい Decision は supersedes で以前の Decision を参照し、以前の Decision 自体は変更しません。
+紐づく Consideration の decision_id は新しい Decision に更新されます。
+
+既に置き換えられた Decision は置き換えられません（連鎖の最新の Decision を指定してください）。
+
+オプション:
+  --title             新しい Decision のタイトル（省略時は以前の Decision のタイトル）
+  --consideration     紐づく Consideration の ID（省略時は以前の Decision と同じ）
+  --selected-opt-id   選択した Option の ID（必須）
+  --selected-title    選択した Option のタイトル（必須）
+  --rationale         覆す理由・選択理由（必須）
+  --impact            影響（複数回指定可）
+  --decided-by        決定者
+
+例:
+  zeus decision supersede dec-1a2b3c4d --selected-opt-id opt-2 --selected-title "OAuth" --rationale "外部 IdP 連携が必須になった"
+  zeus decision supersede dec-1a2b3c4d --selected-opt-id opt-2 --selected-title "OAuth" --rationale "..." --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDecisionSupersede,
+}
+
+var decisionChainCmd = &cobra.Command{
+	Use:   "chain <decision-id>",
+	Short: "Decision の置き換えの連鎖を表示",
+	Long: `Decision を含む置き換えの連鎖を古い順に表示します。
+最後の Decision が現在有効な決定です。
+
+例:
+  zeus decision chain dec-1a2b3c4d
+  zeus decision chain dec-1a2b3c4d --format json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDecisionChain,
+}
+
+func init() {
+	rootCmd.AddCommand(decisionCmd)
+	decisionCmd.AddCommand(decisionSupersedeCmd)
+	decisionCmd.AddCommand(decisionChainCmd)
+
+	decisionSupersedeCmd.Flags().StringVar(&decisionSupersedeTitle, "title", "", "新しい Decision のタイトル")
+	decisionSupersedeCmd.Flags().StringVar(&decisionSupersedeConsideration, "consideration", "", "紐づく Consideration の ID")
+	decisionSupersedeCmd.Flags().StringVar(&decisionSupersedeSelectedOptID, "selected-opt-id", "", "選択した Option の ID")
+	decisionSupersedeCmd.Flags().StringVar(&decisionSupersedeSelectedTitle, "selected-title", "", "選択した Option のタイトル")
+	decisionSupersedeCmd.Flags().StringVar(&decisionSupersedeRationale, "rationale", "", "覆す理由・選択理由")
+	decisionSupersedeCmd.Flags().StringArrayVar(&decisionSupersedeImpact, "impact", nil, "影響（複数回指定可）")
+	decisionSupersedeCmd.Flags().StringVar(&decisionSupersedeDecidedBy, "decided-by", "", "決定者")
+}
+
+func runDecisionSupersede(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+	id := args[0]
+
+	decidedBy, err := zeus.ResolvePerson(ctx, decisionSupersedeDecidedBy)
+	if err != nil {
+		return fmt.Errorf("--decided-by: %w", err)
+	}
+
+	var opts []core.EntityOption
+	if decisionSupersedeConsideration != "" {
+		opts = append(opts, core.WithDecisionConsideration(decisionSupersedeConsideration))
+	}
+	if decisionSupersedeSelectedOptID != "" && decisionSupersedeSelectedTitle != "" {
+		opts = append(opts, core.WithDecisionSelected(core.SelectedOption{
+			OptionID: decisionSupersedeSelectedOptID,
+			Title:    decisionSupersedeSelectedTitle,
+		}))
+	}
+	if decisionSupersedeRationale != "" {
+		opts = append(opts, core.WithDecisionRationale(decisionSupersedeRationale))
+	}
+	if len(decisionSupersedeImpact) > 0 {
+		opts = append(opts, core.WithDecisionImpact(decisionSupersedeImpact))
+	}
+	if decidedBy != "" {
+		opts = append(opts, core.WithDecisionDecidedBy(decidedBy))
+	}
+
+	if isDryRun(cmd) {
+		changes, err := zeus.DryRun(ctx, func(tx *core.Zeus) error {
+			_, err := tx.SupersedeDecision(ctx, id, decisionSupersedeTitle, opts...)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return printFileChanges(cmd, changes)
+	}
+
+	var result *core.AddResult
+	err = mutate(cmd, zeus, func(tx *core.Zeus) error {
+		var err error
+		result, err = tx.SupersedeDecision(ctx, id, decisionSupersedeTitle, opts...)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Decision の置き換えに失敗: %w", err)
+	}
+
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		return printJSONResult(result)
+	}
+
+	if result.NeedsApproval {
+		printQueuedForApproval(fmt.Sprintf("%s の置き換え", id), result.ApprovalID)
+		return nil
+	}
+	green := color.New(color.FgGreen).SprintFunc()
+	fmt.Printf("%s Superseded decision %s by %s\n", green("✓"), id, result.ID)
+
+	chain, err := zeus.DecisionChain(ctx, result.ID)
+	if err != nil {
+		return err
+	}
+	printDecisionChain(chain)
+	return nil
+}
+
+func runDecisionChain(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	chain, err := zeus.DecisionChain(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("Decision の連鎖の取得に失敗: %w", err)
+	}
+
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(chain)
+	}
+
+	cyan := color.New(color.FgCyan).SprintFunc()
+	fmt.Println(cyan("Decision Chain"))
+	fmt.Println("═══════════════════════════════════════════════════════════")
+	printDecisionChain(chain)
+	return nil
+}
+
+// printDecisionChain は置き換えの連鎖を古い順に表示（最後が現在有効な決定）
+func printDecisionChain(chain []*core.DecisionEntity) {
+	for i, d := range chain {
+		state := "superseded"
+		if i == len(chain)-1 {
+			state = "current"
+		}
+		fmt.Printf("  %s  %s  %s [%s]\n", d.DecidedAt, d.ID, d.Title, state)
+		fmt.Printf("      selected: %s\n", d.Selected.Title)
+		if d.Rationale != "" {
+			fmt.Printf("      理由: %s\n", d.Rationale)
+		}
+	}
+}
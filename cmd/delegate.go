@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var delegateCmd = &cobra.Command{
+	Use:   "delegate <id> <person>",
+	Short: "承認待ちを別の承認者に委任",
+	Long: `承認待ちアイテムの承認者を変更します。
+
+委任先はメンバー名簿の ID または表示名で指定します。委任先に zeus.yaml の
+approvals.delegations で有効な委任がある場合は、その委任先に割り当てます。
+
+例:
+  zeus delegate approval-1a2b3c4d bob`,
+	Args: cobra.ExactArgs(2),
+	RunE: runDelegate,
+}
+
+func init() {
+	rootCmd.AddCommand(delegateCmd)
+}
+
+func runDelegate(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	approval, err := zeus.DelegateApproval(ctx, args[0], args[1])
+	if err != nil {
+		return fmt.Errorf("委任失敗: %w", err)
+	}
+
+	green := color.New(color.FgGreen).SprintFunc()
+	from := approval.DelegatedFrom
+	if from == "" {
+		from = "(未割り当て)"
+	}
+	fmt.Printf("%s Delegated %s: %s -> %s\n", green("[SUCCESS]"), approval.ID, from, approval.Approver)
+	return nil
+}
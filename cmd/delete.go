@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete [entity] <id>",
+	Short: "エンティティを削除",
+	Long: `エンティティを削除します。エンティティ種別は ID の接頭辞から推定するため省略できます。
+
+削除するエンティティを参照しているエンティティは、参照の種類ごとの削除ポリシーに従って扱います。
+  restrict  参照元があれば削除を拒否（--cascade 指定時は参照元も連鎖して削除）
+  cascade   参照元も連鎖して削除
+  nullify   参照元から参照を外す
+ポリシーは zeus.yaml の delete_policies で上書きできます。
+Decision は不変のため連鎖削除の対象にはなりません。
+
+--dry-run を指定すると削除せずに、影響範囲（連鎖削除・参照の解除）と変更されるファイルの差分を表示します。
+--preview を指定すると削除したうえで、削除したファイルの内容を差分として表示します。
+settings.operations 等で削除に承認が必要な場合は、承認待ちキューに追加されます。
+
+例:
+  zeus delete risk risk-1a2b3c4d --dry-run
+  zeus delete risk risk-1a2b3c4d
+  zeus delete obj-1a2b3c4d --cascade --dry-run`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runDelete,
+}
+
+var deleteCascade bool
+
+func init() {
+	rootCmd.AddCommand(deleteCmd)
+	deleteCmd.Flags().BoolVar(&deleteCascade, "cascade", false, "restrict の参照元も連鎖して削除")
+}
+
+func runDelete(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+	entityType, id, err := deleteTarget(args)
+	if err != nil {
+		return err
+	}
+	opts := core.DeleteOptions{Cascade: deleteCascade}
+
+	var approvalID string
+	if isDryRun(cmd) {
+		plan, err := zeus.PlanDelete(ctx, entityType, id, opts)
+		if err != nil {
+			return fmt.Errorf("削除失敗: %w", err)
+		}
+		var changes []core.FileChange
+		if len(plan.Restricted) == 0 {
+			changes, err = zeus.DryRun(ctx, func(tx *core.Zeus) error {
+				return queuedForApproval(tx.DeleteWithOptions(ctx, entityType, id, opts), &approvalID)
+			})
+			if err != nil {
+				return fmt.Errorf("削除失敗: %w", err)
+			}
+		}
+		if format, _ := cmd.Flags().GetString("format"); format == "json" {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(map[string]any{"dry_run": true, "plan": plan, "changes": changes})
+		}
+		printDeletePlan(plan)
+		if len(plan.Restricted) > 0 {
+			return fmt.Errorf("削除失敗: %w", &core.DeleteRestrictedError{Plan: plan})
+		}
+		return printFileChanges(cmd, changes)
+	}
+
+	err = mutate(cmd, zeus, func(tx *core.Zeus) error {
+		return queuedForApproval(tx.DeleteWithOptions(ctx, entityType, id, opts), &approvalID)
+	})
+	if err != nil {
+		var restricted *core.DeleteRestrictedError
+		if errors.As(err, &restricted) {
+			printDeletePlan(restricted.Plan)
+		}
+		return fmt.Errorf("削除失敗: %w", err)
+	}
+	if approvalID != "" {
+		printQueuedForApproval(fmt.Sprintf("%s %s の削除", entityType, id), approvalID)
+		return nil
+	}
+	green := color.New(color.FgGreen).SprintFunc()
+	fmt.Printf("%s Deleted %s %s\n", green("[SUCCESS]"), entityType, id)
+	return nil
+}
+
+// deleteTarget は引数から削除対象のエンティティ種別と ID を決める（種別の省略時は ID の接頭辞から推定）
+func deleteTarget(args []string) (string, string, error) {
+	if len(args) == 2 {
+		return args[0], args[1], nil
+	}
+	entityType, ok := core.EntityTypeFromID(args[0])
+	if !ok {
+		return "", "", fmt.Errorf("ID からエンティティ種別を推定できません: %s（'zeus delete <entity> <id>' で指定してください）", args[0])
+	}
+	return entityType, args[0], nil
+}
+
+// printDeletePlan は削除の影響範囲を表示
+func printDeletePlan(plan *core.DeletePlan) {
+	cyan := color.New(color.FgCyan).SprintFunc()
+	yellow := color.New(color.FgYellow).SprintFunc()
+	red := color.New(color.FgRed).SprintFunc()
+
+	fmt.Printf("%s %s %s\n", cyan("Delete Plan:"), plan.Entity, plan.ID)
+	if len(plan.Cascade)+len(plan.Nullify)+len(plan.Restricted) == 0 {
+		fmt.Println("  参照元はありません")
+		return
+	}
+	sections := []struct {
+		label   string
+		effects []core.DeleteEffect
+	}{
+		{red("連鎖して削除"), plan.Cascade},
+		{yellow("参照を解除"), plan.Nullify},
+		{red("削除を妨げる参照元"), plan.Restricted},
+	}
+	for _, s := range sections {
+		if len(s.effects) == 0 {
+			continue
+		}
+		fmt.Printf("  %s (%d)\n", s.label, len(s.effects))
+		for _, e := range s.effects {
+			fmt.Printf("    %-12s %-14s %s  [%s → %s]\n", e.Entity, e.ID, e.Title, e.Reference, e.Target)
+		}
+	}
+	if len(plan.Restricted) > 0 {
+		fmt.Println("  --cascade を指定すると、参照元も連鎖して削除します（Decision を除く）")
+	}
+	fmt.Println()
+}
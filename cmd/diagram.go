@@ -0,0 +1,260 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/biwakonbu/zeus/internal/diagram"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// diagram diff / causes コマンドのフラグ
+var (
+	diagramDiffAgainst   string
+	diagramCausesMermaid bool
+)
+
+var diagramCmd = &cobra.Command{
+	Use:   "diagram",
+	Short: "図の操作",
+	Long:  `Activity・StateMachine・UseCase の図と、Problem の原因ツリーに関する操作を行います。`,
+}
+
+var diagramDiffCmd = &cobra.Command{
+	Use:   "diff <entity-id>",
+	Short: "図の構造（ノード・エッジ）の差分を表示",
+	Long: `図のソースのテキストではなく、ノードとエッジの追加・削除・変更を比較します。
+
+対象:
+  activity      ノード（種別と名前）と遷移（ガード条件）
+  statemachine  状態（種別と名前）と遷移（トリガーとガード条件）
+  usecase       UseCase・アクター・関係先と、アクターの役割・UseCase 間の関係
+
+--against には git の ref（コミット・ブランチ・タグ）を指定します。
+ノード・エッジは ID と "source -> target" で対応付けるため、遷移 ID の付け替えは差分になりません。
+スナップショットはサマリーのみを保存しているため比較できません。
+
+例:
+  zeus diagram diff act-001 --against HEAD
+  zeus diagram diff sm-1a2b3c4d --against main --format json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDiagramDiff,
+}
+
+var diagramCausesCmd = &cobra.Command{
+	Use:   "causes <problem-id>",
+	Short: "Problem の原因ツリーを表示",
+	Long: `Problem の causes に書かれた原因ツリー（なぜなぜ分析）を表示します。
+
+子の無い原因は根本原因の候補として "(root cause)" を付けて表示します。
+原因に関連するエンティティ（entity_id）は [ ] 内に表示します。
+
+例:
+  zeus diagram causes prob-1a2b3c4d
+  zeus diagram causes prob-1a2b3c4d --mermaid > causes.md
+  zeus diagram causes prob-1a2b3c4d --format json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDiagramCauses,
+}
+
+func init() {
+	rootCmd.AddCommand(diagramCmd)
+	diagramCmd.AddCommand(diagramDiffCmd)
+	diagramCmd.AddCommand(diagramCausesCmd)
+
+	diagramDiffCmd.Flags().StringVar(&diagramDiffAgainst, "against", "HEAD", "比較元の git ref")
+	diagramCausesCmd.Flags().BoolVar(&diagramCausesMermaid, "mermaid", false, "Mermaid flowchart で出力")
+}
+
+func runDiagramCauses(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	tree, err := zeus.ProblemCauseTree(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("Problem の取得に失敗: %w", err)
+	}
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(tree)
+	}
+	if diagramCausesMermaid {
+		fmt.Print(tree.ToMermaid())
+		return nil
+	}
+
+	cyan := color.New(color.FgCyan).SprintFunc()
+	fmt.Printf("%s %s %s\n", cyan("Cause Tree:"), tree.ProblemID, tree.Title)
+	fmt.Println("============================================================")
+	lines := tree.Outline()
+	if len(lines) == 0 {
+		fmt.Println("原因は記録されていません（problems/*.yaml の causes に記述してください）。")
+		return nil
+	}
+	for _, line := range lines {
+		fmt.Println(line)
+	}
+	return nil
+}
+
+// DiagramDiffResult は diagram diff の結果
+type DiagramDiffResult struct {
+	EntityID string `json:"entity_id"`
+	Entity   string `json:"entity"`
+	Against  string `json:"against"`
+	Added    bool   `json:"added,omitempty"`   // 比較元の時点には無かった
+	Removed  bool   `json:"removed,omitempty"` // 現在は削除されている
+	diagram.StructureDiff
+}
+
+func runDiagramDiff(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+	id := args[0]
+
+	entityType, ok := core.EntityTypeFromID(id)
+	if !ok || (entityType != "activity" && entityType != "statemachine" && entityType != "usecase") {
+		return fmt.Errorf("図の差分は activity, statemachine, usecase のみ対応しています: %s", id)
+	}
+	if err := rejectSnapshotRef(ctx, zeus, diagramDiffAgainst); err != nil {
+		return err
+	}
+
+	before, err := diagramStructure(entityType, func(out any) error {
+		return zeus.ReadEntityAtRevision(ctx, id, diagramDiffAgainst, out)
+	})
+	added := errors.Is(err, core.ErrEntityNotFound)
+	if err != nil && !added {
+		return fmt.Errorf("%s 時点の %s の取得に失敗: %w", diagramDiffAgainst, id, err)
+	}
+	after, err := diagramStructure(entityType, func(out any) error {
+		entity, err := zeus.Get(ctx, entityType, id)
+		if err != nil {
+			return err
+		}
+		return copyEntity(entity, out)
+	})
+	removed := errors.Is(err, core.ErrEntityNotFound)
+	if err != nil && !removed {
+		return fmt.Errorf("%s の取得に失敗: %w", id, err)
+	}
+	if added && removed {
+		return fmt.Errorf("%s は %s 時点にも現在にもありません", id, diagramDiffAgainst)
+	}
+
+	result := DiagramDiffResult{
+		EntityID:      id,
+		Entity:        entityType,
+		Against:       diagramDiffAgainst,
+		Added:         added,
+		Removed:       removed,
+		StructureDiff: diagram.DiffStructure(before, after),
+	}
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+	printDiagramDiff(result)
+	return nil
+}
+
+// rejectSnapshotRef は --against にスナップショットが指定された場合にエラーを返す
+// スナップショットはサマリーのみでエンティティの内容を持たないため比較できない
+func rejectSnapshotRef(ctx context.Context, zeus *core.Zeus, ref string) error {
+	snapshots, err := zeus.GetHistory(ctx, 0)
+	if err != nil {
+		return nil // スナップショットが読めない場合は git ref として扱う
+	}
+	for _, s := range snapshots {
+		if s.Timestamp == ref || (s.Label != "" && s.Label == ref) {
+			return fmt.Errorf("スナップショット %s はサマリーのみを保存しているため、図を比較できません（git の ref を指定してください）", ref)
+		}
+	}
+	return nil
+}
+
+// diagramStructure は load で読み込んだエンティティから図の構造を組み立てる
+func diagramStructure(entityType string, load func(out any) error) (diagram.Structure, error) {
+	empty := diagram.Structure{Nodes: map[string]string{}, Edges: map[string]string{}}
+	switch entityType {
+	case "activity":
+		var act core.ActivityEntity
+		if err := load(&act); err != nil {
+			return empty, err
+		}
+		return diagram.ActivityStructure(&act), nil
+	case "statemachine":
+		var sm core.StateMachineEntity
+		if err := load(&sm); err != nil {
+			return empty, err
+		}
+		return diagram.StateMachineStructure(&sm), nil
+	default:
+		var uc core.UseCaseEntity
+		if err := load(&uc); err != nil {
+			return empty, err
+		}
+		return diagram.UseCaseStructure(&uc), nil
+	}
+}
+
+// copyEntity は zeus.Get の結果（エンティティのポインタ）を out にコピー
+func copyEntity(entity, out any) error {
+	switch e := entity.(type) {
+	case *core.ActivityEntity:
+		*out.(*core.ActivityEntity) = *e
+	case *core.StateMachineEntity:
+		*out.(*core.StateMachineEntity) = *e
+	case *core.UseCaseEntity:
+		*out.(*core.UseCaseEntity) = *e
+	default:
+		return fmt.Errorf("未対応のエンティティです: %T", entity)
+	}
+	return nil
+}
+
+// printDiagramDiff は図の構造の差分を表示
+func printDiagramDiff(r DiagramDiffResult) {
+	cyan := color.New(color.FgCyan).SprintFunc()
+	green := color.New(color.FgGreen).SprintFunc()
+	red := color.New(color.FgRed).SprintFunc()
+	yellow := color.New(color.FgYellow).SprintFunc()
+
+	fmt.Printf("%s %s (%s) vs %s\n", cyan("Diagram Diff:"), r.EntityID, r.Entity, r.Against)
+	fmt.Println("============================================================")
+	switch {
+	case r.Added:
+		fmt.Printf("%s 時点には存在しません（新規追加）\n", r.Against)
+	case r.Removed:
+		fmt.Println("現在は削除されています")
+	}
+	if r.Empty() {
+		fmt.Println("構造の差分はありません。")
+		return
+	}
+
+	section := func(title string, added, removed, changed []diagram.ElementChange) {
+		if len(added)+len(removed)+len(changed) == 0 {
+			return
+		}
+		fmt.Printf("\n%s (+%d -%d ~%d)\n", title, len(added), len(removed), len(changed))
+		for _, c := range added {
+			fmt.Printf("  %s %s  %s\n", green("+"), c.Key, c.After)
+		}
+		for _, c := range removed {
+			fmt.Printf("  %s %s  %s\n", red("-"), c.Key, c.Before)
+		}
+		for _, c := range changed {
+			fmt.Printf("  %s %s  %s → %s\n", yellow("~"), c.Key, c.Before, c.After)
+		}
+	}
+	section("Nodes", r.AddedNodes, r.RemovedNodes, r.ChangedNodes)
+	section("Edges", r.AddedEdges, r.RemovedEdges, r.ChangedEdges)
+}
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var digestCmd = &cobra.Command{
+	Use:   "digest",
+	Short: "スタンドアップ用ダイジェストを生成",
+	Long: `指定時点以降の変更と期限の近い項目をまとめたダイジェストを出力します。
+
+セクション:
+  Completed          - 決定した Decision、解決した Problem
+  Newly Blocked      - 新規の Problem、顕在化（occurred）した Risk
+  Pending Approvals  - 承認待ちアイテム
+  Upcoming Due Dates - 期限が近い open の Consideration、Risk のレビュー日（超過分を含む）
+
+--since の形式:
+  yesterday（デフォルト）, today, Nd（N 日前）, YYYY-MM-DD, RFC3339
+
+出力形式:
+  markdown - Markdown（デフォルト）
+  slack    - Slack mrkdwn（チャットへの投稿用）
+
+例:
+  zeus digest
+  zeus digest --since 7d --format slack
+  zeus digest --since 2026-01-05 --due-within 14 -o digest.md
+  zeus digest --since 7d --email
+
+--email は zeus.yaml の notifications.email に設定した宛先へ送信します。`,
+	RunE: runDigest,
+}
+
+var (
+	digestSince     string
+	digestFormat    string
+	digestDueWithin int
+	digestOutput    string
+	digestEmail     bool
+)
+
+func init() {
+	rootCmd.AddCommand(digestCmd)
+	digestCmd.Flags().StringVar(&digestSince, "since", "yesterday", "対象期間の開始（yesterday|today|Nd|YYYY-MM-DD）")
+	digestCmd.Flags().StringVar(&digestFormat, "format", "markdown", "出力形式 (markdown|slack)")
+	digestCmd.Flags().IntVar(&digestDueWithin, "due-within", 7, "期限が近いとみなす日数")
+	digestCmd.Flags().StringVarP(&digestOutput, "output", "o", "", "出力ファイル（省略時は標準出力）")
+	digestCmd.Flags().BoolVar(&digestEmail, "email", false, "notifications.email の宛先へメール送信")
+}
+
+func runDigest(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	switch digestFormat {
+	case "markdown", "slack":
+		// OK
+	default:
+		return fmt.Errorf("不明な出力形式: %s (markdown, slack のいずれかを指定してください)", digestFormat)
+	}
+
+	now := time.Now()
+	since, err := core.ParseDigestSince(digestSince, now)
+	if err != nil {
+		return fmt.Errorf("--since が不正です: %w", err)
+	}
+
+	digest, err := zeus.BuildDigest(ctx, core.DigestOptions{
+		Since:     since,
+		Now:       now,
+		DueWithin: digestDueWithin,
+	})
+	if err != nil {
+		return fmt.Errorf("ダイジェスト生成失敗: %w", err)
+	}
+
+	output := digest.ToMarkdown()
+	if digestFormat == "slack" {
+		output = digest.ToSlack()
+	}
+
+	if digestEmail {
+		subject := fmt.Sprintf("ダイジェスト (%s 以降)", since.Format("2006-01-02"))
+		if err := zeus.SendEmail(ctx, subject, output, false); err != nil {
+			return fmt.Errorf("メール送信失敗: %w", err)
+		}
+		green := color.New(color.FgGreen).SprintFunc()
+		fmt.Printf("%s ダイジェストをメールで送信しました。\n", green("[SUCCESS]"))
+		if digestOutput == "" {
+			return nil
+		}
+	}
+
+	if digestOutput != "" {
+		if err := os.WriteFile(digestOutput, []byte(output), 0644); err != nil {
+			return fmt.Errorf("ファイル出力失敗: %w", err)
+		}
+		green := color.New(color.FgGreen).SprintFunc()
+		fmt.Printf("%s ダイジェストを %s に出力しました。\n", green("[SUCCESS]"), digestOutput)
+		return nil
+	}
+
+	fmt.Print(output)
+	return nil
+}
@@ -1,39 +1,95 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 
+	"github.com/biwakonbu/zeus/internal/analysis"
 	"github.com/biwakonbu/zeus/internal/core"
 	"github.com/biwakonbu/zeus/internal/doctor"
 )
 
 var doctorCmd = &cobra.Command{
-	Use:   "doctor",
-	Short: "システムの健全性を診断",
-	RunE:  runDoctor,
+	Use:     "doctor",
+	Aliases: []string{"check"},
+	Short:   "システムの健全性を診断",
+	Long: `システムの健全性（ファイル構成・参照整合性）を診断します。
+
+有効な UseCase に Activity が紐づいていない場合（usecase_coverage）と、
+Activity が UseCase に紐づいていない場合（activity_traceability）は警告として表示します。
+
+--explain-cycles を指定すると、依存関係（UseCase の include / extend / generalize、
+Container / Component の関係）の循環ごとに Mermaid 図を表示し、
+すべての循環を解消するために外す依存を提案します。
+
+参照整合性のチェックはエンティティを種別ごとに一度だけ読み込み、並列に実行します。
+--progress で進捗を標準エラー出力に表示し、--timeout で診断全体の制限時間を指定できます（CI 向け）。
+
+既知・許容済みの指摘は次の方法で抑制できます（抑制した件数は末尾に表示）。
+  - .zeus/suppressions.yaml に rule / entity / reason / expires（YYYY-MM-DD）を記録
+  - エンティティの YAML のコメントや description に "zeus:ignore <rule>" と書く
+  - zeus.yaml の checks.severity でルールごとの重大度を上書き（fail, warn, ignore）
+期限切れの抑制は適用されず、suppression_expired の警告として表示されます。
+
+例:
+  zeus doctor
+  zeus check --explain-cycles
+  zeus check --progress --timeout 2m
+  zeus check --show-suppressed`,
+	RunE: runDoctor,
 }
 
+var (
+	doctorExplainCycles  bool
+	doctorProgress       bool
+	doctorTimeout        time.Duration
+	doctorShowSuppressed bool
+)
+
 func init() {
 	rootCmd.AddCommand(doctorCmd)
+	doctorCmd.Flags().BoolVar(&doctorExplainCycles, "explain-cycles", false, "依存関係の循環を図示し、解消のために外す依存を提案")
+	doctorCmd.Flags().BoolVar(&doctorProgress, "progress", false, "参照整合性チェックの進捗を標準エラー出力に表示")
+	doctorCmd.Flags().DurationVar(&doctorTimeout, "timeout", 0, "診断全体の制限時間（0 は無制限）")
+	doctorCmd.Flags().BoolVar(&doctorShowSuppressed, "show-suppressed", false, "抑制した指摘も表示")
 }
 
 func runDoctor(cmd *cobra.Command, args []string) error {
 	ctx := getContext(cmd)
 	zeus := getZeus(cmd)
 
+	if doctorTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, doctorTimeout)
+		defer cancel()
+	}
+
 	// IntegrityChecker を作成（ハンドラーがある場合）
 	var d *doctor.Doctor
 	if zeus != nil {
 		checker := createIntegrityChecker(zeus)
+		if checker != nil && doctorProgress {
+			checker.SetProgress(func(step string, done, total int) {
+				fmt.Fprintf(os.Stderr, "[%d/%d] %s\n", done, total, step)
+			})
+		}
 		d = doctor.NewWithIntegrity(".", checker)
+		d.SetCoverage(zeus.AnalyzeCoverage)
 	} else {
 		d = doctor.New(".")
 	}
 
 	result, err := d.Diagnose(ctx)
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("診断が制限時間（%s）内に完了しませんでした", doctorTimeout)
+	}
 	if err != nil {
 		return err
 	}
@@ -55,16 +111,76 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 		fmt.Printf("%s %s: %s\n", icon, check.Check, check.Message)
 	}
 
+	if doctorShowSuppressed {
+		for _, check := range result.Suppressed {
+			fmt.Printf("%s %s: %s (suppressed)\n", color.HiBlackString("-"), check.Check, check.Message)
+		}
+	}
+
 	fmt.Println("═══════════════════════════════════════════════════════════")
 	fmt.Printf("Overall: %s\n", result.Overall)
+	if len(result.Suppressed) > 0 && !doctorShowSuppressed {
+		fmt.Printf("%d finding(s) suppressed. Run 'zeus doctor --show-suppressed' to list them.\n", len(result.Suppressed))
+	}
 
 	if result.FixableCount > 0 {
 		fmt.Printf("\n%d issue(s) can be fixed automatically. Run 'zeus fix' to repair.\n", result.FixableCount)
 	}
 
+	if zeus == nil {
+		return nil
+	}
+	report, err := zeus.ExplainDependencyCycles(ctx)
+	if err != nil {
+		return fmt.Errorf("循環の検出に失敗: %w", err)
+	}
+	if len(report.Cycles) == 0 {
+		return nil
+	}
+	if !doctorExplainCycles {
+		fmt.Printf("\n%d dependency cycle(s) detected. Run 'zeus doctor --explain-cycles' for details.\n", len(report.Cycles))
+		return nil
+	}
+	printCycleReport(report)
 	return nil
 }
 
+// printCycleReport は循環ごとの図と、解消のために外す依存の提案を表示
+func printCycleReport(report *analysis.CycleReport) {
+	yellow := color.New(color.FgYellow).SprintFunc()
+	fmt.Println()
+	fmt.Println(yellow(fmt.Sprintf("Dependency Cycles (%d)", len(report.Cycles))))
+	for i, cycle := range report.Cycles {
+		fmt.Printf("\n%d. %s\n", i+1, strings.Join(cycle.Cycle, " -> "))
+		fmt.Println("```mermaid")
+		fmt.Print(cycle.Mermaid)
+		fmt.Println("```")
+		fmt.Printf("   外す候補: %s\n", formatDependencyEdge(cycle.Break))
+	}
+
+	fmt.Println()
+	fmt.Printf("すべての循環を解消するには、次の %d 件の依存を外してください:\n", len(report.Break))
+	for _, e := range report.Break {
+		fmt.Printf("  - %s\n", formatDependencyEdge(e))
+	}
+}
+
+// formatDependencyEdge は依存関係を表示用に整形
+func formatDependencyEdge(e analysis.DependencyEdge) string {
+	notes := []string{}
+	if e.Soft {
+		notes = append(notes, "soft")
+	}
+	if e.AddedAt != "" {
+		notes = append(notes, "更新: "+e.AddedAt)
+	}
+	s := fmt.Sprintf("%s -[%s]-> %s", e.From, e.Relation, e.To)
+	if len(notes) > 0 {
+		s += "（" + strings.Join(notes, ", ") + "）"
+	}
+	return s
+}
+
 // createIntegrityChecker は Zeus インスタンスから IntegrityChecker を作成
 // 全てのハンドラーは任意であり、取得できたものだけが設定される
 func createIntegrityChecker(zeus *core.Zeus) *core.IntegrityChecker {
@@ -151,5 +267,26 @@ func createIntegrityChecker(zeus *core.Zeus) *core.IntegrityChecker {
 		}
 	}
 
+	// Release ハンドラーを設定
+	if relHandler, ok := registry.Get("release"); ok {
+		if relH, ok := relHandler.(*core.ReleaseHandler); ok {
+			checker.SetReleaseHandler(relH)
+		}
+	}
+
+	// Container ハンドラーを設定
+	if ctrHandler, ok := registry.Get("container"); ok {
+		if ctrH, ok := ctrHandler.(*core.ContainerHandler); ok {
+			checker.SetContainerHandler(ctrH)
+		}
+	}
+
+	// Component ハンドラーを設定
+	if cmpHandler, ok := registry.Get("component"); ok {
+		if cmpH, ok := cmpHandler.(*core.ComponentHandler); ok {
+			checker.SetComponentHandler(cmpH)
+		}
+	}
+
 	return checker
 }
@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var eventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "ダッシュボードのイベントログを表示",
+	Long: `ダッシュボードが SSE で配信したイベント（.zeus/logs/events.jsonl）を表示します。
+
+イベントにはシーケンス番号が振られ、/api/events に再接続するクライアントは
+Last-Event-ID ヘッダー（または last_event_id クエリ）以降のイベントを受け取れます。`,
+}
+
+var eventsTailCmd = &cobra.Command{
+	Use:   "tail",
+	Short: "直近のイベントを表示",
+	Long: `イベントログの直近のイベントを表示します。
+
+--follow を指定すると新しいイベントを待ち受けて表示し続けます（Ctrl+C で終了）。
+--format json では 1 行 1 イベントの JSON で出力します（他ツールへのパイプ用）。
+
+例:
+  zeus events tail
+  zeus events tail -n 50 --type report
+  zeus events tail --since 120 --follow --format json`,
+	RunE: runEventsTail,
+}
+
+var (
+	eventsTailLines  int
+	eventsTailSince  uint64
+	eventsTailType   string
+	eventsTailFollow bool
+)
+
+// eventsPollInterval は --follow でログを確認する間隔
+const eventsPollInterval = time.Second
+
+func init() {
+	rootCmd.AddCommand(eventsCmd)
+	eventsCmd.AddCommand(eventsTailCmd)
+	eventsTailCmd.Flags().IntVarP(&eventsTailLines, "lines", "n", 20, "表示するイベント数")
+	eventsTailCmd.Flags().Uint64Var(&eventsTailSince, "since", 0, "指定したシーケンス番号より後のイベントをすべて表示（-n より優先）")
+	eventsTailCmd.Flags().StringVar(&eventsTailType, "type", "", "イベント種別で絞り込み (status|graph|approval|report)")
+	eventsTailCmd.Flags().BoolVar(&eventsTailFollow, "follow", false, "新しいイベントを待ち受けて表示")
+}
+
+func runEventsTail(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+	log := zeus.EventLog()
+	format, _ := cmd.Flags().GetString("format")
+	asJSON := format == "json"
+
+	records, err := log.Since(ctx, eventsTailSince)
+	if err != nil {
+		return fmt.Errorf("イベントログ読み込み失敗: %w", err)
+	}
+	shown := filterEvents(records, eventsTailType)
+	if !cmd.Flags().Changed("since") {
+		shown = lastEvents(shown, eventsTailLines)
+	}
+	for _, r := range shown {
+		if err := printEventRecord(r, asJSON); err != nil {
+			return err
+		}
+	}
+
+	last := eventsTailSince
+	if len(records) > 0 {
+		last = records[len(records)-1].Seq
+	}
+	if !eventsTailFollow {
+		return nil
+	}
+
+	// 新しいイベントをポーリングで待ち受け
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+	ticker := time.NewTicker(eventsPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sigCh:
+			return nil
+		case <-ticker.C:
+			records, err := log.Since(ctx, last)
+			if err != nil {
+				return fmt.Errorf("イベントログ読み込み失敗: %w", err)
+			}
+			for _, r := range filterEvents(records, eventsTailType) {
+				if err := printEventRecord(r, asJSON); err != nil {
+					return err
+				}
+			}
+			if len(records) > 0 {
+				last = records[len(records)-1].Seq
+			}
+		}
+	}
+}
+
+// filterEvents はイベント種別で絞り込む（空の場合はすべて）
+func filterEvents(records []core.EventRecord, eventType string) []core.EventRecord {
+	if eventType == "" {
+		return records
+	}
+	filtered := make([]core.EventRecord, 0, len(records))
+	for _, r := range records {
+		if r.Type == eventType {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered
+}
+
+// lastEvents は末尾 n 件を返す（n が 0 以下の場合はすべて）
+func lastEvents(records []core.EventRecord, n int) []core.EventRecord {
+	if n > 0 && len(records) > n {
+		return records[len(records)-n:]
+	}
+	return records
+}
+
+// printEventRecord はイベントを 1 行で表示（asJSON では JSON Lines）
+func printEventRecord(r core.EventRecord, asJSON bool) error {
+	if asJSON {
+		line, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(line))
+		return nil
+	}
+	cyan := color.New(color.FgCyan).SprintFunc()
+	fmt.Printf("%s %s %s %s\n", cyan(fmt.Sprintf("#%d", r.Seq)), r.Time, r.Type, string(r.Data))
+	return nil
+}
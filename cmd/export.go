@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/biwakonbu/zeus/internal/dashboard"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export <format|file>",
+	Short: "プロジェクト計画をエクスポート",
+	Long: `プロジェクト計画を外部で編集できる形式で出力します。
+引数には形式名、または拡張子から形式を判別できる出力ファイルを指定します。
+
+形式:
+  markdown (.md) - Objective（##）> UseCase（###）> Activity（リスト項目）の Markdown
+                   各行の ID コメントにより zeus import で編集内容を取り込めます
+  xlsx (.xlsx)   - Excel ブック（Activities / Timeline / Risks シート、-o 必須）
+                   Timeline は Consideration の期限・Risk のレビュー日・Decision の決定日を日付順に並べます
+  graphml (.graphml) - 全エンティティと参照関係のグラフ（Neo4j・Gephi・yEd など向け）
+  jsonld (.jsonld)   - 全エンティティと参照関係の JSON-LD（参照はフィールド名のプロパティ）
+  site               - ダッシュボードの静的スナップショット（-o に出力ディレクトリ必須）
+                       状態・計画（Objective > UseCase > Activity）・タイムライン・グラフ・レポートの
+                       HTML と API と同じ JSON（data/*.json）。GitHub Pages などでサーバーなしに公開できます
+
+--anonymize を指定すると、タイトル・説明などの自由記述、人物（people の ID・表示名・メールアドレス）、
+タグを安定した仮名（title-1、person-2 など）に置き換えたコピーから出力します。
+ID・参照・状態・日付・数値は残るため、構造を保ったまま不具合報告やベンチマークに共有できます。
+
+例:
+  zeus export plan.md
+  zeus export markdown
+  zeus export markdown -o docs/plan.md
+  zeus export xlsx -o plan.xlsx
+  zeus export model.graphml
+  zeus export jsonld -o model.jsonld
+  zeus export site -o ./public
+  zeus export model.graphml --anonymize`,
+	Args: cobra.ExactArgs(1),
+	RunE: runExport,
+}
+
+var exportOutput string
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "出力ファイル（省略時は標準出力、site 形式では出力ディレクトリ）")
+	addAnonymizeFlag(exportCmd)
+}
+
+// addAnonymizeFlag は --anonymize を登録
+func addAnonymizeFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("anonymize", false, "自由記述・人物・タグを仮名に置き換えたコピーから出力（構造は保持）")
+}
+
+// anonymizedZeus は --anonymize 指定時に匿名化した一時コピーの Zeus を返す（cleanup で一時コピーを削除）
+// 出力先が標準出力の場合があるため、匿名化の概要は標準エラーに表示する
+func anonymizedZeus(cmd *cobra.Command, zeus *core.Zeus) (*core.Zeus, func(), error) {
+	if anonymize, _ := cmd.Flags().GetBool("anonymize"); !anonymize {
+		return zeus, func() {}, nil
+	}
+	dir, err := os.MkdirTemp("", "zeus-anonymized-")
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+	result, err := zeus.Anonymize(getContext(cmd), dir)
+	if err != nil {
+		cleanup()
+		return nil, nil, fmt.Errorf("匿名化に失敗: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "匿名化: %d ファイル、%d 件の値を置き換え（除外: %s）\n",
+		result.Files, result.Replaced, strings.Join(result.Skipped, ", "))
+	return core.New(dir), cleanup, nil
+}
+
+func runExport(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus, cleanup, err := anonymizedZeus(cmd, getZeus(cmd))
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	format, output := args[0], exportOutput
+	if ext := strings.ToLower(filepath.Ext(args[0])); ext != "" {
+		format, output = exportFormatFromExt(ext), args[0]
+	}
+
+	if format == "site" {
+		return exportSite(ctx, zeus, output)
+	}
+
+	var content []byte
+	switch format {
+	case "markdown":
+		plan, err := zeus.ExportPlanMarkdown(ctx)
+		if err != nil {
+			return fmt.Errorf("エクスポート失敗: %w", err)
+		}
+		content = []byte(plan)
+	case "xlsx":
+		if output == "" {
+			return fmt.Errorf("xlsx 形式では -o で出力ファイルを指定してください")
+		}
+		workbook, err := zeus.ExportWorkbook(ctx)
+		if err != nil {
+			return fmt.Errorf("エクスポート失敗: %w", err)
+		}
+		var buf bytes.Buffer
+		if err := workbook.Write(&buf); err != nil {
+			return fmt.Errorf("エクスポート失敗: %w", err)
+		}
+		content = buf.Bytes()
+	case "graphml", "jsonld":
+		graph, err := zeus.BuildRelationGraph(ctx)
+		if err != nil {
+			return fmt.Errorf("エクスポート失敗: %w", err)
+		}
+		if format == "graphml" {
+			content = []byte(graph.ToGraphML())
+			break
+		}
+		if content, err = graph.ToJSONLD(); err != nil {
+			return fmt.Errorf("エクスポート失敗: %w", err)
+		}
+	default:
+		return fmt.Errorf("不明なエクスポート形式: %s (markdown, xlsx, graphml, jsonld, site のいずれかを指定してください)", args[0])
+	}
+
+	if output == "" {
+		fmt.Print(string(content))
+		return nil
+	}
+	if err := os.WriteFile(output, content, 0644); err != nil {
+		return fmt.Errorf("ファイル出力失敗: %w", err)
+	}
+	green := color.New(color.FgGreen).SprintFunc()
+	fmt.Printf("%s %s を出力しました。\n", green("[SUCCESS]"), output)
+	return nil
+}
+
+// exportSite はダッシュボードの静的スナップショットを dir に出力
+func exportSite(ctx context.Context, zeus *core.Zeus, dir string) error {
+	if dir == "" {
+		return fmt.Errorf("site 形式では -o で出力ディレクトリを指定してください")
+	}
+	files, err := dashboard.ExportSite(ctx, zeus)
+	if err != nil {
+		return fmt.Errorf("エクスポート失敗: %w", err)
+	}
+	for _, f := range files {
+		path := filepath.Join(dir, filepath.FromSlash(f.Path))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("ファイル出力失敗: %w", err)
+		}
+		if err := os.WriteFile(path, f.Content, 0644); err != nil {
+			return fmt.Errorf("ファイル出力失敗: %w", err)
+		}
+	}
+	green := color.New(color.FgGreen).SprintFunc()
+	fmt.Printf("%s %s に %d ファイルを出力しました（%s を開いてください）。\n", green("[SUCCESS]"), dir, len(files), filepath.Join(dir, "index.html"))
+	return nil
+}
+
+// exportFormatFromExt は出力ファイルの拡張子からエクスポート形式を判定
+func exportFormatFromExt(ext string) string {
+	switch ext {
+	case ".md", ".markdown":
+		return "markdown"
+	default:
+		return strings.TrimPrefix(ext, ".")
+	}
+}
@@ -17,12 +17,11 @@ var fixCmd = &cobra.Command{
 
 func init() {
 	rootCmd.AddCommand(fixCmd)
-	fixCmd.Flags().Bool("dry-run", false, "実際に修復せず、何が行われるかを表示")
 }
 
 func runFix(cmd *cobra.Command, args []string) error {
 	ctx := getContext(cmd)
-	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	dryRun := isDryRun(cmd)
 
 	d := doctor.New(".")
 	result, err := d.Fix(ctx, dryRun)
@@ -42,5 +41,8 @@ func runFix(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if dryRun {
+		return printFileChanges(cmd, result.Changes)
+	}
 	return nil
 }
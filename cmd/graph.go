@@ -8,6 +8,7 @@ import (
 
 	"github.com/biwakonbu/zeus/internal/analysis"
 	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/biwakonbu/zeus/internal/diagram"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
@@ -26,6 +27,11 @@ var graphCmd = &cobra.Command{
   text    - ASCIIアートでツリー表示（デフォルト）
   dot     - Graphviz DOT形式
   mermaid - Mermaid形式（Markdown埋め込み可能）
+  png/svg - 画像（zeus.yaml の rendering 設定が必要、PNG は -o 必須）
+
+出力エンジン（--engine、--format より優先）:
+  mermaid  - Mermaid形式
+  plantuml - PlantUML形式
 
 モード:
   (デフォルト)   - タスク依存関係グラフ
@@ -44,6 +50,8 @@ var graphCmd = &cobra.Command{
   zeus graph                           # TEXT形式で標準出力
   zeus graph --format=dot              # DOT形式で標準出力
   zeus graph -f mermaid -o deps.md     # Mermaid形式でファイル出力
+  zeus graph --engine plantuml         # PlantUML形式で標準出力
+  zeus graph --format png -o graph.png # PNG 画像でファイル出力
   zeus graph --unified                 # 統合グラフを表示
   zeus graph --unified --focus act-001 # act-001 を中心に表示
   zeus graph --unified --types activity,usecase       # Activity と UseCase のみ
@@ -54,6 +62,7 @@ var graphCmd = &cobra.Command{
 
 var (
 	graphFormat       string
+	graphEngine       string
 	graphOutput       string
 	graphUnified      bool
 	graphFocus        string
@@ -67,7 +76,8 @@ var (
 
 func init() {
 	rootCmd.AddCommand(graphCmd)
-	graphCmd.Flags().StringVarP(&graphFormat, "format", "f", "text", "出力形式 (text|dot|mermaid)")
+	graphCmd.Flags().StringVarP(&graphFormat, "format", "f", "text", "出力形式 (text|dot|mermaid|png|svg)")
+	graphCmd.Flags().StringVar(&graphEngine, "engine", "", "図の出力エンジン (mermaid|plantuml)")
 	graphCmd.Flags().StringVarP(&graphOutput, "output", "o", "", "出力ファイル（省略時は標準出力）")
 	graphCmd.Flags().BoolVar(&graphUnified, "unified", false, "統合グラフ（Activity, UseCase, Objective）を表示")
 	graphCmd.Flags().StringVar(&graphFocus, "focus", "", "フォーカスするエンティティID")
@@ -83,6 +93,10 @@ func runGraph(cmd *cobra.Command, args []string) error {
 	ctx := getContext(cmd)
 	zeus := getZeus(cmd)
 
+	if err := checkImageOutput(graphFormat, graphOutput); err != nil {
+		return err
+	}
+
 	// --unified フラグ: 統合グラフを表示
 	if graphUnified {
 		return runUnifiedGraph(ctx, zeus)
@@ -105,16 +119,24 @@ func runGraph(cmd *cobra.Command, args []string) error {
 	}
 
 	// 形式に応じて出力を生成
+	renderer, err := resolveDiagramRenderer(graphEngine, graphFormat)
+	if err != nil {
+		return err
+	}
 	var output string
-	switch graphFormat {
-	case "text":
+	switch {
+	case renderer != nil && diagram.IsImageFormat(graphFormat):
+		if output, err = renderDiagramImage(ctx, zeus, renderer, renderer.DependencyGraph(graph), graphFormat); err != nil {
+			return err
+		}
+	case renderer != nil:
+		output = wrapDiagramOutput(renderer, renderer.DependencyGraph(graph))
+	case graphFormat == "text":
 		output = graph.ToText()
-	case "dot":
+	case graphFormat == "dot":
 		output = graph.ToDot()
-	case "mermaid":
-		output = graph.ToMermaid()
 	default:
-		return fmt.Errorf("不明な出力形式: %s (text, dot, mermaid のいずれかを指定してください)", graphFormat)
+		return fmt.Errorf("不明な出力形式: %s (text, dot, mermaid, png, svg のいずれかを指定してください)", graphFormat)
 	}
 
 	// 出力先に応じて出力
@@ -190,16 +212,24 @@ func runUnifiedGraph(ctx context.Context, zeus *core.Zeus) error {
 	}
 
 	// 形式に応じて出力を生成
+	renderer, err := resolveDiagramRenderer(graphEngine, graphFormat)
+	if err != nil {
+		return err
+	}
 	var output string
-	switch graphFormat {
-	case "text":
+	switch {
+	case renderer != nil && diagram.IsImageFormat(graphFormat):
+		if output, err = renderDiagramImage(ctx, zeus, renderer, renderer.UnifiedGraph(graph), graphFormat); err != nil {
+			return err
+		}
+	case renderer != nil:
+		output = wrapDiagramOutput(renderer, renderer.UnifiedGraph(graph))
+	case graphFormat == "text":
 		output = graph.ToText()
-	case "dot":
+	case graphFormat == "dot":
 		output = graph.ToDot()
-	case "mermaid":
-		output = graph.ToMermaid()
 	default:
-		return fmt.Errorf("不明な出力形式: %s (text, dot, mermaid のいずれかを指定してください)", graphFormat)
+		return fmt.Errorf("不明な出力形式: %s (text, dot, mermaid, png, svg のいずれかを指定してください)", graphFormat)
 	}
 
 	// 出力先に応じて出力
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "編集した計画を取り込み",
+	Long: `zeus export で出力した Markdown 計画の編集内容をエンティティに反映します。
+
+  - ID コメント付きの行: タイトル、親（見出しの移動）、完了状態（[x]）の変更を反映
+  - ID コメントのない行: Objective / UseCase / Activity を新規作成
+  - 計画から削除された行: 何もしません（エンティティは削除されません）
+
+新規作成した行には ID が付かないため、取り込み後は zeus export で計画を出力し直してください。
+
+オプション:
+  --dry-run  書き込まずに変更内容と変更されるファイルの差分のみ表示
+
+例:
+  zeus export plan.md
+  zeus import plan.md --dry-run
+  zeus import plan.md`,
+	Args: cobra.ExactArgs(1),
+	RunE: runImport,
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+}
+
+func runImport(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+	dryRun := isDryRun(cmd)
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("ファイル読み込み失敗: %w", err)
+	}
+
+	var result *core.PlanSyncResult
+	if dryRun {
+		result, err = zeus.ImportPlanMarkdown(ctx, data, true)
+	} else {
+		err = mutate(cmd, zeus, func(tx *core.Zeus) error {
+			var err error
+			result, err = tx.ImportPlanMarkdown(ctx, data, false)
+			return err
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("取り込み失敗: %w", err)
+	}
+
+	green := color.New(color.FgGreen).SprintFunc()
+	yellow := color.New(color.FgYellow).SprintFunc()
+	for _, c := range result.Changes {
+		id := c.ID
+		if id == "" {
+			id = "(new)"
+		}
+		line := fmt.Sprintf("%s %s %s: %s", c.Action, c.EntityType, id, c.Title)
+		if c.Detail != "" {
+			line += " (" + c.Detail + ")"
+		}
+		fmt.Printf("  %s\n", line)
+	}
+
+	if dryRun {
+		fmt.Printf("%s %d change(s), %d unchanged (dry-run)\n", yellow("[DRY-RUN]"), len(result.Changes), result.Unchanged)
+		return printFileChanges(cmd, result.Files)
+	}
+	fmt.Printf("%s %d change(s), %d unchanged\n", green("[SUCCESS]"), len(result.Changes), result.Unchanged)
+	for _, c := range result.Changes {
+		if c.Action == "queued" {
+			fmt.Println("承認待ちの変更は 'zeus pending' で確認、'zeus approve <id>' で承認できます。")
+			break
+		}
+	}
+	for _, c := range result.Changes {
+		if c.Action == "create" {
+			// ID のない行を再度取り込むと重複作成されるため、ID 付きの計画を出力し直す
+			fmt.Printf("新規作成した行に ID を付与するには 'zeus export %s' で計画を出力し直してください。\n", args[0])
+			break
+		}
+	}
+	return nil
+}
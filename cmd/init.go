@@ -2,7 +2,9 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 
+	"github.com/biwakonbu/zeus/internal/core"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
@@ -10,18 +12,59 @@ import (
 var initCmd = &cobra.Command{
 	Use:   "init",
 	Short: "Zeus プロジェクトを初期化",
-	Long:  `プロジェクトディレクトリに .zeus/ フォルダを作成し、Zeus プロジェクトを初期化します。`,
-	RunE:  runInit,
+	Long: `プロジェクトディレクトリに .zeus/ フォルダを作成し、Zeus プロジェクトを初期化します。
+
+--from を指定すると、既存の作業項目を取り込んだ状態で初期化します。
+マイルストーンは Objective、作業項目は Activity として登録され、
+両者に共通の milestone:<name> タグが付与されます。
+ラベルはタグになり、優先度ラベル（priority:high, P1, high-priority など）は
+priority:<high|medium|low> に正規化されます。完了済みの項目は deprecated として登録されます。
+
+取り込み元（--input でファイルを指定）:
+  github-issues - gh issue list --state all --json number,title,body,state,labels,milestone の出力
+                  （GitHub REST API の Issue 一覧 JSON も可、Pull Request は除外）
+  csv           - ヘッダー付き CSV（title 必須、description, labels, priority, milestone, status）
+  markdown-plan - 見出しがマイルストーン、リスト項目（- [ ] / - [x]）が作業項目、#label がラベル
+
+例:
+  zeus init
+  gh issue list --state all --json number,title,body,state,labels,milestone > issues.json
+  zeus init --from github-issues --input issues.json
+  zeus init --from csv --input backlog.csv
+  zeus init --from markdown-plan --input PLAN.md`,
+	RunE: runInit,
 }
 
+var (
+	initFrom  string
+	initInput string
+)
+
 func init() {
 	rootCmd.AddCommand(initCmd)
-	// フラグなし（--level 削除済み）
+	initCmd.Flags().StringVar(&initFrom, "from", "", "取り込み元 (github-issues|csv|markdown-plan)")
+	initCmd.Flags().StringVar(&initInput, "input", "", "取り込むファイル（--from と併用）")
 }
 
 func runInit(cmd *cobra.Command, args []string) error {
 	ctx := getContext(cmd)
 
+	// 初期化前に取り込み元を解析（不正な入力で中途半端なプロジェクトを作らない）
+	var plan *core.ImportPlan
+	if initFrom != "" || initInput != "" {
+		if initFrom == "" || initInput == "" {
+			return fmt.Errorf("--from と --input は同時に指定してください")
+		}
+		data, err := os.ReadFile(initInput)
+		if err != nil {
+			return fmt.Errorf("取り込みファイルの読み込み失敗: %w", err)
+		}
+		plan, err = core.ParseImportPlan(initFrom, data)
+		if err != nil {
+			return fmt.Errorf("取り込みファイルの解析失敗: %w", err)
+		}
+	}
+
 	zeus := getZeus(cmd)
 	result, err := zeus.Init(ctx)
 	if err != nil {
@@ -32,5 +75,23 @@ func runInit(cmd *cobra.Command, args []string) error {
 	fmt.Printf("%s Zeus initialized successfully!\n", green("✓"))
 	fmt.Printf("  Path:  %s\n", result.ZeusPath)
 
+	if plan == nil {
+		return nil
+	}
+
+	imported, err := zeus.ImportPlan(ctx, plan)
+	if err != nil {
+		return fmt.Errorf("取り込み失敗: %w", err)
+	}
+	fmt.Printf("%s Imported from %s\n", green("✓"), initFrom)
+	fmt.Printf("  Objectives: %d\n", len(imported.Objectives))
+	fmt.Printf("  Activities: %d\n", len(imported.Activities))
+	if len(imported.Queued) > 0 {
+		fmt.Printf("  Queued:     %d（'zeus pending' で確認できます）\n", len(imported.Queued))
+	}
+	if len(imported.Activities) > 0 {
+		fmt.Println("  Hint: zeus adopt で Activity を UseCase に紐づけられます。")
+	}
+
 	return nil
 }
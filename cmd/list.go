@@ -1,8 +1,11 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -30,6 +33,11 @@ var listCmd = &cobra.Command{
 
 エンティティを省略すると Activity 一覧を表示します。
 
+-q でクエリ式による絞り込みができます（-s は status = <値> の省略形）。
+  比較: = != < <= > >=、in (a,b) / not in (a,b)（= / != / in の値には * ? のワイルドカード）
+  論理: and / or / not と括弧（優先順位は not > and > or）
+  フィールドは YAML のキー（metadata.created_at のように . で入れ子を指定。created_at は metadata を参照）
+
 例:
   zeus list              # Activity 一覧を表示
   zeus list activities   # アクティビティ一覧
@@ -42,7 +50,10 @@ var listCmd = &cobra.Command{
   zeus list assumptions  # 前提条件一覧
   zeus list constraints  # 制約条件一覧
   zeus list quality      # 品質基準一覧
-  zeus list subsystems   # サブシステム一覧`,
+  zeus list subsystems   # サブシステム一覧
+  zeus list activities -q 'status in (draft,active) and usecase_id = uc-*'
+  zeus list risks -q "probability = high and not status = mitigated" --format json
+  zeus list activities --watch   # .zeus の変更を検知して再表示し続ける（Ctrl+C で終了）`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runList,
 }
@@ -50,6 +61,27 @@ var listCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(listCmd)
 	listCmd.Flags().StringP("status", "s", "", "ステータスでフィルタ")
+	listCmd.Flags().StringP("query", "q", "", "クエリ式でフィルタ（例: 'status in (draft,active) and created_at >= 2025-01-01'）")
+	addWatchFlags(listCmd)
+}
+
+// listEntityTypes は list で指定できるエンティティ名（複数形を含む）とエンティティ種別
+var listEntityTypes = map[string]string{
+	"vision": "vision", "objective": "objective", "objectives": "objective",
+	"consideration": "consideration", "considerations": "consideration",
+	"decision": "decision", "decisions": "decision",
+	"problem": "problem", "problems": "problem",
+	"risk": "risk", "risks": "risk",
+	"assumption": "assumption", "assumptions": "assumption",
+	"constraint": "constraint", "constraints": "constraint",
+	"quality": "quality", "qualities": "quality",
+	"subsystem": "subsystem", "subsystems": "subsystem",
+	"actor": "actor", "actors": "actor",
+	"usecase": "usecase", "usecases": "usecase",
+	"statemachine": "statemachine", "statemachines": "statemachine",
+	"release": "release", "releases": "release",
+	"task": "activity", "tasks": "activity",
+	"": "activity", "activity": "activity", "activities": "activity",
 }
 
 func runList(cmd *cobra.Command, args []string) error {
@@ -59,6 +91,18 @@ func runList(cmd *cobra.Command, args []string) error {
 	}
 
 	zeus := getZeus(cmd)
+	return runWatch(cmd, zeus, func() error {
+		return printList(cmd, zeus, entity)
+	})
+}
+
+// printList はエンティティの一覧を表示
+func printList(cmd *cobra.Command, zeus *core.Zeus, entity string) error {
+	query, _ := cmd.Flags().GetString("query")
+	status, _ := cmd.Flags().GetString("status")
+	if query != "" || status != "" {
+		return listQuery(cmd, zeus, entity, query, status)
+	}
 
 	// エンティティタイプに応じて表示を分岐
 	switch entity {
@@ -93,6 +137,56 @@ func runList(cmd *cobra.Command, args []string) error {
 	}
 }
 
+// listQuery はクエリ式（--status は status = <値> として AND で結合）で絞り込んだ一覧を表示
+func listQuery(cmd *cobra.Command, zeus *core.Zeus, entity, query, status string) error {
+	entityType, ok := listEntityTypes[entity]
+	if !ok {
+		return fmt.Errorf("不明なエンティティ: %s", entity)
+	}
+	if status != "" {
+		condition := fmt.Sprintf("status = %q", status)
+		if query != "" {
+			condition = fmt.Sprintf("(%s) and %s", query, condition)
+		}
+		query = condition
+	}
+	q, err := core.ParseQuery(query)
+	if err != nil {
+		return err
+	}
+	result, err := zeus.QueryEntities(getContext(cmd), entityType, q)
+	if err != nil {
+		return err
+	}
+
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+
+	cyan := color.New(color.FgCyan).SprintFunc()
+	fmt.Printf("%s (%d items)\n", cyan(result.Entity), result.Total)
+	fmt.Printf("Query: %s\n", result.Query)
+	fmt.Println("────────────────────────────────────────")
+	if result.Total == 0 {
+		fmt.Println("条件に一致するエンティティがありません。")
+		return nil
+	}
+	for _, item := range result.Items {
+		title := item["title"]
+		if title == nil {
+			title = item["name"]
+		}
+		line := fmt.Sprintf("[%v] %v", item["id"], title)
+		if s, ok := item["status"]; ok {
+			line += fmt.Sprintf(" (%v)", s)
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
+
 // listTasksDeprecated は非推奨メッセージを表示し、Activity への移行を案内
 func listTasksDeprecated(_ *cobra.Command) error {
 	yellow := color.New(color.FgYellow).SprintFunc()
@@ -205,15 +299,21 @@ func listObjectives(cmd *cobra.Command, zeus *core.Zeus) error {
 	return nil
 }
 
-// listConsiderations は Consideration 一覧を表示
+// listConsiderations は Consideration 一覧を表示（期限超過のものを先頭に表示。エスカレーションは行わない）
 func listConsiderations(cmd *cobra.Command, zeus *core.Zeus) error {
 	ctx := getContext(cmd)
+	overdueList, err := zeus.OverdueConsiderations(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
 	result, err := zeus.List(ctx, "consideration")
 	if err != nil {
 		return err
 	}
 
 	cyan := color.New(color.FgCyan).SprintFunc()
+	red := color.New(color.FgRed).SprintFunc()
 	fmt.Printf("%s (%d items)\n", cyan("Considerations"), result.Total)
 	fmt.Println("────────────────────────────────────────")
 
@@ -223,8 +323,27 @@ func listConsiderations(cmd *cobra.Command, zeus *core.Zeus) error {
 		return nil
 	}
 
-	fmt.Printf("Total: %d considerations\n", result.Total)
-	fmt.Println("\n詳細を見るには 'zeus status' を使用してください。")
+	overdue := map[string]core.OverdueConsideration{}
+	pending := 0
+	for _, o := range overdueList {
+		overdue[o.ID] = o
+		if o.EscalatedAt == "" {
+			pending++
+		}
+	}
+	for _, item := range result.Items {
+		line := fmt.Sprintf("[%s] %s (%s)", item.ID, item.Title, item.Status)
+		if o, ok := overdue[item.ID]; ok {
+			line = fmt.Sprintf("%s %s overdue by %d days (due %s)", red("!"), line, o.DaysOverdue, o.DueDate)
+			if o.EscalatedTo != "" {
+				line += " → " + o.EscalatedTo
+			}
+		}
+		fmt.Println(line)
+	}
+	if pending > 0 {
+		fmt.Printf("\n%s 未エスカレーションの期限超過が %d 件あります（'zeus considerations escalate' で記録できます）\n", red("!"), pending)
+	}
 
 	return nil
 }
@@ -254,14 +373,20 @@ func listDecisions(cmd *cobra.Command, zeus *core.Zeus) error {
 }
 
 // listProblems は Problem 一覧を表示
+// 未解決の Problem は経過日数と SLA（escalation.problems.sla_days）を表示し、SLA 違反を先頭に並べる
 func listProblems(cmd *cobra.Command, zeus *core.Zeus) error {
 	ctx := getContext(cmd)
 	result, err := zeus.List(ctx, "problem")
 	if err != nil {
 		return err
 	}
+	aging, err := zeus.ProblemAging(ctx, time.Now())
+	if err != nil {
+		return err
+	}
 
 	cyan := color.New(color.FgCyan).SprintFunc()
+	red := color.New(color.FgRed).SprintFunc()
 	fmt.Printf("%s (%d items)\n", cyan("Problems"), result.Total)
 	fmt.Println("────────────────────────────────────────")
 
@@ -271,8 +396,23 @@ func listProblems(cmd *cobra.Command, zeus *core.Zeus) error {
 		return nil
 	}
 
-	fmt.Printf("Total: %d problems\n", result.Total)
-	fmt.Println("\n詳細を見るには 'zeus status' を使用してください。")
+	unresolved := map[string]bool{}
+	for _, a := range aging {
+		unresolved[a.ID] = true
+		line := fmt.Sprintf("[%s] %s (%s, %s) %d days old", a.ID, a.Title, a.Status, a.Severity, a.AgeDays)
+		switch {
+		case a.Breached:
+			line = fmt.Sprintf("%s %s, SLA breached by %d days (due %s)", red("!"), line, a.DaysOverdue, a.DueDate)
+		case a.SLADays != nil:
+			line += fmt.Sprintf(", SLA due %s", a.DueDate)
+		}
+		fmt.Println(line)
+	}
+	for _, item := range result.Items {
+		if !unresolved[item.ID] {
+			fmt.Printf("[%s] %s (%s)\n", item.ID, item.Title, item.Status)
+		}
+	}
 
 	return nil
 }
@@ -1,7 +1,10 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -12,25 +15,49 @@ import (
 var pendingCmd = &cobra.Command{
 	Use:   "pending",
 	Short: "承認待ちアイテムを表示",
-	Long:  `承認待ちのアイテム一覧を表示します。`,
-	RunE:  runPending,
+	Long: `承認待ちのアイテム一覧を表示します。
+
+表示前に zeus.yaml の approvals ポリシーを適用し、期限切れの承認待ちを自動で
+承認または却下します。remind_after_days を過ぎた承認待ちがある場合は
+リマインダーをメールで送信します（notifications.email.notify_approvals が有効な場合）。
+
+承認後に変更されるファイルが記録されている場合は一覧を表示します。
+--details を指定すると、承認時にそのまま書き込まれるエンティティの内容（YAML）を表示します。
+--diff を指定すると、各ファイルの変更前後の差分も表示します。`,
+	RunE: runPending,
 }
 
+var (
+	pendingDetails bool
+	pendingDiff    bool
+)
+
 func init() {
 	rootCmd.AddCommand(pendingCmd)
+	pendingCmd.Flags().BoolVar(&pendingDetails, "details", false, "承認時に書き込まれるエンティティの内容を表示")
+	pendingCmd.Flags().BoolVar(&pendingDiff, "diff", false, "承認後に変更されるファイルの差分を表示")
 }
 
 func runPending(cmd *cobra.Command, args []string) error {
 	ctx := getContext(cmd)
 	zeus := getZeus(cmd)
+
+	cyan := color.New(color.FgCyan).SprintFunc()
+	yellow := color.New(color.FgYellow).SprintFunc()
+
+	processed, err := zeus.ProcessApprovals(ctx, time.Now())
+	if err != nil && !errors.Is(err, core.ErrConfigNotFound) {
+		return err
+	}
+	if processed != nil {
+		printProcessedApprovals(processed)
+	}
+
 	pending, err := zeus.Pending(ctx)
 	if err != nil {
 		return err
 	}
 
-	cyan := color.New(color.FgCyan).SprintFunc()
-	yellow := color.New(color.FgYellow).SprintFunc()
-
 	fmt.Println(cyan("Pending Approvals"))
 	fmt.Println("═══════════════════════════════════════════════════════════")
 
@@ -43,6 +70,39 @@ func runPending(cmd *cobra.Command, args []string) error {
 		levelColor := getLevelColor(item.Level)
 		fmt.Printf("[%s] %s - %s\n", levelColor(string(item.Level)), yellow(item.ID), item.Description)
 		fmt.Printf("    Type: %s | Created: %s\n", item.Type, item.CreatedAt)
+		if item.RequestedBy != "" || item.Agent != "" {
+			fmt.Printf("    Requested by: %s\n", formatActor(item.RequestedBy, item.Agent))
+		}
+		if item.Approver != "" {
+			approver := item.Approver
+			if item.DelegatedFrom != "" {
+				approver += fmt.Sprintf(" (delegated from %s)", item.DelegatedFrom)
+			}
+			fmt.Printf("    Approver: %s\n", approver)
+		}
+		if item.ExpiresAt != "" {
+			fmt.Printf("    Expires: %s\n", item.ExpiresAt)
+		}
+		for _, c := range item.Changes {
+			fmt.Printf("    %-6s %s\n", c.Op, c.Path)
+		}
+		if pendingDetails && item.Entity != nil {
+			fmt.Printf("    Entity: %s %s %s (%s)\n", item.Entity.Operation(), item.Entity.Type, item.Entity.ID, item.Entity.Path)
+			if item.Entity.Content != "" {
+				for _, line := range strings.Split(strings.TrimSuffix(item.Entity.Content, "\n"), "\n") {
+					fmt.Printf("      %s\n", line)
+				}
+			}
+		}
+		if pendingDiff {
+			for _, c := range item.Changes {
+				fmt.Println()
+				printDiff(c.Diff)
+			}
+			if len(item.Changes) > 0 {
+				fmt.Println()
+			}
+		}
 	}
 
 	fmt.Println("═══════════════════════════════════════════════════════════")
@@ -53,6 +113,24 @@ func runPending(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// printProcessedApprovals は承認ポリシーで自動処理した承認待ちとリマインダーの送信結果を表示
+func printProcessedApprovals(result *core.ApprovalProcessResult) {
+	yellow := color.New(color.FgYellow).SprintFunc()
+	for _, e := range result.Expired {
+		if e.Error != "" {
+			fmt.Printf("%s %s の期限切れ処理に失敗しました: %s\n", yellow("[WARNING]"), e.ID, e.Error)
+			continue
+		}
+		fmt.Printf("%s %s (%s) は期限切れのため %s になりました\n", yellow("[EXPIRED]"), e.ID, e.Description, e.Status)
+	}
+	if result.Reminded {
+		fmt.Printf("%s %d 件の承認待ちのリマインダーを送信しました\n", yellow("[REMINDER]"), len(result.Stale))
+	}
+	if len(result.Expired) > 0 || result.Reminded {
+		fmt.Println()
+	}
+}
+
 func getLevelColor(level core.ApprovalLevel) func(a ...interface{}) string {
 	switch level {
 	case core.ApprovalApprove:
@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var peopleCmd = &cobra.Command{
+	Use:   "people",
+	Short: "メンバー名簿を管理",
+	Long: `オーナー・担当者の表記ゆれを防ぐメンバー名簿（.zeus/config/people.yaml）を管理します。
+
+名簿を作成すると、zeus add の --owner は名簿の ID または表示名（大文字小文字を区別しない）で
+指定する必要があり、正規の ID に変換して保存されます。名簿にない名前はエラーとなり、
+近い候補が提示されます。名簿が無い場合は従来どおり任意の文字列を受け付けます。
+
+チームを登録すると、--owner にチームの ID または表示名も指定できます。`,
+}
+
+var peopleListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "メンバーを一覧表示",
+	RunE:  runPeopleList,
+}
+
+var peopleAddCmd = &cobra.Command{
+	Use:   "add <id>",
+	Short: "メンバーを追加",
+	Long: `メンバーを名簿に追加します（名簿が無い場合は作成します）。
+ID は英小文字・数字・-・_ で指定します。
+
+例:
+  zeus people add alice --name "Alice Smith" --role developer --capacity 32
+  zeus people add bob --name "Bob" --role pm --role reviewer`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPeopleAdd,
+}
+
+var peopleAddTeamCmd = &cobra.Command{
+	Use:   "add-team <id>",
+	Short: "チームを追加",
+	Long: `メンバーをまとめたチームを名簿に追加します。
+メンバーは名簿の ID または表示名で指定します。
+
+例:
+  zeus people add-team platform --name "Platform Team" --member alice --member bob`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPeopleAddTeam,
+}
+
+var (
+	peopleName     string
+	peopleRoles    []string
+	peopleCapacity float64
+	peopleMembers  []string
+)
+
+func init() {
+	rootCmd.AddCommand(peopleCmd)
+	peopleCmd.AddCommand(peopleListCmd)
+	peopleCmd.AddCommand(peopleAddCmd)
+	peopleCmd.AddCommand(peopleAddTeamCmd)
+
+	peopleAddCmd.Flags().StringVar(&peopleName, "name", "", "表示名")
+	peopleAddCmd.Flags().StringSliceVar(&peopleRoles, "role", nil, "ロール（複数指定可）")
+	peopleAddCmd.Flags().Float64Var(&peopleCapacity, "capacity", 0, "週あたりの稼働時間")
+	peopleAddTeamCmd.Flags().StringVar(&peopleName, "name", "", "表示名")
+	peopleAddTeamCmd.Flags().StringSliceVar(&peopleMembers, "member", nil, "メンバー（複数指定可）")
+}
+
+func runPeopleList(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	dir, err := zeus.LoadPeople(ctx)
+	if err != nil {
+		return err
+	}
+
+	cyan := color.New(color.FgCyan).SprintFunc()
+	fmt.Println(cyan("People"))
+	fmt.Println("═══════════════════════════════════════════════════════════")
+	if dir == nil || len(dir.People) == 0 {
+		fmt.Println("No people registered. Use 'zeus people add <id>' to create the directory.")
+		return nil
+	}
+	for _, p := range dir.People {
+		fmt.Printf("%-16s %s\n", p.ID, p.Name)
+		var details []string
+		if len(p.Roles) > 0 {
+			details = append(details, "Roles: "+strings.Join(p.Roles, ", "))
+		}
+		if p.WeeklyCapacity > 0 {
+			details = append(details, fmt.Sprintf("Capacity: %gh/week", p.WeeklyCapacity))
+		}
+		if len(details) > 0 {
+			fmt.Printf("    %s\n", strings.Join(details, " | "))
+		}
+	}
+	if len(dir.Teams) > 0 {
+		fmt.Println()
+		fmt.Println(cyan("Teams"))
+		for _, t := range dir.Teams {
+			fmt.Printf("%-16s %s\n", t.ID, t.Name)
+			fmt.Printf("    Members: %s | Capacity: %gh/week\n", strings.Join(t.Members, ", "), dir.TeamCapacity(t))
+		}
+	}
+	fmt.Println("═══════════════════════════════════════════════════════════")
+	fmt.Printf("Total: %d person(s), %d team(s)\n", len(dir.People), len(dir.Teams))
+	return nil
+}
+
+func runPeopleAdd(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	person := core.Person{
+		ID:             args[0],
+		Name:           peopleName,
+		Roles:          peopleRoles,
+		WeeklyCapacity: peopleCapacity,
+	}
+	if err := zeus.AddPerson(ctx, person); err != nil {
+		return fmt.Errorf("メンバー追加失敗: %w", err)
+	}
+
+	green := color.New(color.FgGreen).SprintFunc()
+	fmt.Printf("%s Added person: %s\n", green("✓"), person.ID)
+	return nil
+}
+
+func runPeopleAddTeam(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	team := core.Team{
+		ID:      args[0],
+		Name:    peopleName,
+		Members: peopleMembers,
+	}
+	if err := zeus.AddTeam(ctx, team); err != nil {
+		return fmt.Errorf("チーム追加失敗: %w", err)
+	}
+
+	green := color.New(color.FgGreen).SprintFunc()
+	fmt.Printf("%s Added team: %s\n", green("✓"), team.ID)
+	return nil
+}
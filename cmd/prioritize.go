@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var prioritizeCmd = &cobra.Command{
+	Use:   "prioritize",
+	Short: "RICE / WSJF スコアでバックログを順位付け",
+	Long: `Objective と Activity のバックログを RICE または WSJF のスコアで順位付けします。
+
+評価値は zeus add の --rice / --wsjf、または zeus update の --set rice.reach=500 などで設定します。
+  RICE = reach × impact × (confidence / 100) / effort
+  WSJF = (business_value + time_criticality + risk_reduction) / job_size
+
+--method を省略した場合は、評価値を持つ項目が多い方式を使用します。
+完了・中止した Objective と廃止した Activity は --all を指定しない限り除外します。
+
+例:
+  zeus prioritize
+  zeus prioritize --method wsjf --entity activity -n 10
+  zeus prioritize --format json`,
+	Args: cobra.NoArgs,
+	RunE: runPrioritize,
+}
+
+var (
+	prioritizeMethod string
+	prioritizeEntity string
+	prioritizeAll    bool
+	prioritizeLimit  int
+)
+
+func init() {
+	rootCmd.AddCommand(prioritizeCmd)
+	prioritizeCmd.Flags().StringVar(&prioritizeMethod, "method", "", "スコアの算出方式（rice, wsjf）")
+	prioritizeCmd.Flags().StringVar(&prioritizeEntity, "entity", "", "対象のエンティティ（objective, activity。省略時は両方）")
+	prioritizeCmd.Flags().BoolVar(&prioritizeAll, "all", false, "完了・中止・廃止した項目も含める")
+	prioritizeCmd.Flags().IntVarP(&prioritizeLimit, "limit", "n", 0, "表示件数（0 は全件）")
+}
+
+func runPrioritize(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	method, err := core.ParseScoringMethod(prioritizeMethod)
+	if err != nil {
+		return err
+	}
+	result, err := zeus.Prioritize(ctx, core.PrioritizeOptions{
+		Method:     method,
+		Entity:     prioritizeEntity,
+		IncludeAll: prioritizeAll,
+	})
+	if err != nil {
+		return fmt.Errorf("優先順位付けに失敗: %w", err)
+	}
+	if prioritizeLimit > 0 && len(result.Ranked) > prioritizeLimit {
+		result.Ranked = result.Ranked[:prioritizeLimit]
+	}
+
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+
+	cyan := color.New(color.FgCyan).SprintFunc()
+	yellow := color.New(color.FgYellow).SprintFunc()
+	fmt.Printf("%s (%s)\n", cyan("Prioritized Backlog"), result.Method)
+	fmt.Println("═══════════════════════════════════════════════════════════")
+	if len(result.Ranked) == 0 {
+		fmt.Printf("%s のスコアを持つ項目がありません。\n", result.Method)
+		fmt.Println("'zeus add objective \"タイトル\" --rice reach=500,impact=2,confidence=80,effort=3' で設定できます。")
+	}
+	for _, item := range result.Ranked {
+		fmt.Printf("%3d. %8.2f  %-10s %-14s %s [%s]\n", item.Rank, item.Score, item.Entity, item.ID, item.Title, item.Status)
+	}
+	if len(result.Unscored) > 0 {
+		fmt.Println()
+		fmt.Printf("%s (%d items)\n", yellow("Unscored"), len(result.Unscored))
+		for _, item := range result.Unscored {
+			fmt.Printf("     %-10s %-14s %s [%s]\n", item.Entity, item.ID, item.Title, item.Status)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var qualityCmd = &cobra.Command{
+	Use:   "quality",
+	Short: "品質基準を操作",
+	Long:  `品質基準（Quality）のメトリクスを操作します。`,
+}
+
+var qualityIngestCmd = &cobra.Command{
+	Use:   "ingest <file>",
+	Short: "CI 成果物からメトリクスを更新",
+	Long: `CI の成果物を解析し、QualityMetric の current と status を更新します。
+
+対応形式（--format 省略時はファイル名と内容から推定）:
+  junit    - JUnit XML        → テスト成功率（%、スキップは母数から除外）
+  gocover  - go test -coverprofile の出力 → ステートメントカバレッジ（%）
+  sarif    - SARIF 2.1        → 静的解析の指摘件数（抑制済みを除く）
+
+更新対象:
+  --metric を省略すると、計測値の種類に対応する名前のメトリクスをすべて更新します。
+    テスト成功率: test_pass_rate, pass_rate, test_pass, tests
+    カバレッジ:   coverage, code_coverage, test_coverage, cov, カバレッジ
+    指摘件数:     lint_issues, lint, lint_warnings, issues, warnings
+  --metric <quality-id>:<metric-id> で明示的に指定することもできます（複数回指定可）。
+
+status は目標値と比較して met / not_met に更新されます
+（指摘件数は目標値以下、それ以外は目標値以上で met）。
+
+例:
+  zeus quality ingest junit.xml
+  zeus quality ingest coverage.out --metric qual-1a2b3c4d:metric-1
+  zeus quality ingest results.sarif --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runQualityIngest,
+}
+
+var (
+	qualityIngestFormat  string
+	qualityIngestMetrics []string
+)
+
+func init() {
+	rootCmd.AddCommand(qualityCmd)
+	qualityCmd.AddCommand(qualityIngestCmd)
+	qualityIngestCmd.Flags().StringVar(&qualityIngestFormat, "format", "", "成果物の形式 (junit|gocover|sarif)")
+	qualityIngestCmd.Flags().StringArrayVar(&qualityIngestMetrics, "metric", nil, "更新するメトリクス（<quality-id>:<metric-id>、複数回指定可）")
+}
+
+func runQualityIngest(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+	dryRun := isDryRun(cmd)
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("ファイル読み込み失敗: %w", err)
+	}
+
+	format := qualityIngestFormat
+	if format == "" {
+		if format, err = core.DetectArtifactFormat(args[0], data); err != nil {
+			return fmt.Errorf("形式を推定できません（--format を指定してください）: %w", err)
+		}
+	}
+
+	var targets []core.QualityMetricTarget
+	for _, s := range qualityIngestMetrics {
+		target, err := core.ParseQualityMetricTarget(s)
+		if err != nil {
+			return err
+		}
+		targets = append(targets, target)
+	}
+
+	measurement, err := core.ParseQualityArtifact(format, data)
+	if err != nil {
+		return fmt.Errorf("成果物の解析失敗: %w", err)
+	}
+	fmt.Printf("%s: %s = %s (%s)\n", format, measurement.Kind, formatMeasurement(measurement.Value, measurement.Unit), measurement.Detail)
+
+	updates, err := zeus.IngestQualityMeasurement(ctx, measurement, targets, dryRun)
+	if err != nil {
+		return fmt.Errorf("メトリクス更新失敗: %w", err)
+	}
+
+	green := color.New(color.FgGreen).SprintFunc()
+	red := color.New(color.FgRed).SprintFunc()
+	for _, u := range updates {
+		status := green(string(u.Status))
+		if u.Status != core.MetricStatusMet {
+			status = red(string(u.Status))
+		}
+		fmt.Printf("  %s:%s %s: %.2f -> %.2f (target %.2f) %s\n", u.QualityID, u.MetricID, u.Name, u.Previous, u.Current, u.Target, status)
+	}
+
+	if dryRun {
+		yellow := color.New(color.FgYellow).SprintFunc()
+		fmt.Printf("%s %d metric(s) would be updated (dry-run)\n", yellow("[DRY-RUN]"), len(updates))
+		return nil
+	}
+	fmt.Printf("%s %d metric(s) updated\n", green("[SUCCESS]"), len(updates))
+	return nil
+}
+
+// formatMeasurement は計測値を単位付きで表示用に整形（% 以外の単位は空白で区切る）
+func formatMeasurement(value float64, unit string) string {
+	s := strconv.FormatFloat(value, 'f', -1, 64)
+	if unit == "%" || unit == "" {
+		return s + unit
+	}
+	return s + " " + unit
+}
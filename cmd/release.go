@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var releaseCmd = &cobra.Command{
+	Use:   "release",
+	Short: "リリースの準備状況を表示",
+	Long: `リリース（releases/rel-*.yaml）の準備状況を表示します。
+
+リリースは zeus add release で作成し、含める UseCase / Activity と判定に使う Quality を --include で指定します。
+含める Activity がすべて完了（deprecated）し、品質ゲートがすべて passed
+（ゲートの無い Quality はメトリクスがすべて met）であれば go と判定します。`,
+}
+
+var releaseStatusCmd = &cobra.Command{
+	Use:   "status <version|release-id>",
+	Short: "リリースの go / no-go を表示",
+	Long: `リリースの準備状況と go / no-go の判定、no-go の理由を表示します。
+
+例:
+  zeus release status v1.2
+  zeus release status rel-1a2b3c4d --format json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runReleaseStatus,
+}
+
+var releaseListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "リリース一覧と準備状況を表示",
+	Long: `全リリースの準備状況を目標日の近い順に表示します（目標日なしは最後）。
+
+例:
+  zeus release list
+  zeus release list --format json`,
+	Args: cobra.NoArgs,
+	RunE: runReleaseList,
+}
+
+func init() {
+	rootCmd.AddCommand(releaseCmd)
+	releaseCmd.AddCommand(releaseStatusCmd)
+	releaseCmd.AddCommand(releaseListCmd)
+}
+
+func runReleaseStatus(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	release, err := zeus.ReleaseStatus(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(release)
+	}
+
+	cyan := color.New(color.FgCyan).SprintFunc()
+	fmt.Printf("%s %s (%s)\n", cyan("Release"), release.Title, release.ID)
+	fmt.Println("═══════════════════════════════════════════════════════════")
+	fmt.Printf("Status:     %s\n", release.Status)
+	fmt.Printf("Target:     %s\n", formatReleaseTarget(release))
+	fmt.Printf("Readiness:  %d%%\n", release.Readiness)
+	fmt.Printf("Decision:   %s\n", formatReleaseDecision(release.Go))
+	fmt.Println()
+
+	done := 0
+	for _, a := range release.Activities {
+		if a.Done {
+			done++
+		}
+	}
+	fmt.Printf("Activities: %d/%d 完了\n", done, len(release.Activities))
+	for _, a := range release.Activities {
+		fmt.Printf("  %s %s %s [%s]\n", formatReleaseCheck(a.Done), a.ID, a.Title, a.Status)
+	}
+	passed := 0
+	for _, g := range release.Gates {
+		if g.Passed {
+			passed++
+		}
+	}
+	fmt.Printf("Gates:      %d/%d 通過\n", passed, len(release.Gates))
+	for _, g := range release.Gates {
+		fmt.Printf("  %s %s / %s [%s]\n", formatReleaseCheck(g.Passed), g.Quality, g.Name, g.Status)
+	}
+
+	if len(release.Blockers) > 0 {
+		fmt.Println()
+		fmt.Println("Blockers:")
+		for _, b := range release.Blockers {
+			fmt.Printf("  - %s\n", b)
+		}
+	}
+	fmt.Println("═══════════════════════════════════════════════════════════")
+	return nil
+}
+
+func runReleaseList(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	releases, err := zeus.Releases(ctx)
+	if err != nil {
+		return err
+	}
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(releases)
+	}
+
+	if len(releases) == 0 {
+		fmt.Println("リリースはありません（zeus add release <version> で作成）")
+		return nil
+	}
+	for _, r := range releases {
+		fmt.Printf("%-12s %-10s %-24s %3d%%  %s\n", r.Title, r.Status, formatReleaseTarget(&r), r.Readiness, formatReleaseDecision(r.Go))
+	}
+	return nil
+}
+
+// formatReleaseTarget は目標日と残り日数を表示用に整形
+func formatReleaseTarget(r *core.ReleaseReadiness) string {
+	if r.DaysRemaining == nil {
+		return "(未設定)"
+	}
+	days := *r.DaysRemaining
+	switch {
+	case days < 0:
+		return fmt.Sprintf("%s (%d 日超過)", r.TargetDate, -days)
+	case days == 0:
+		return fmt.Sprintf("%s (当日)", r.TargetDate)
+	default:
+		return fmt.Sprintf("%s (残り %d 日)", r.TargetDate, days)
+	}
+}
+
+// formatReleaseDecision は go / no-go を色付きで整形
+func formatReleaseDecision(ok bool) string {
+	if ok {
+		return color.GreenString("GO")
+	}
+	return color.RedString("NO-GO")
+}
+
+// formatReleaseCheck は完了・通過の印
+func formatReleaseCheck(ok bool) string {
+	if ok {
+		return color.GreenString("✓")
+	}
+	return color.RedString("✗")
+}
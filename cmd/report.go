@@ -1,11 +1,15 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+
+	"github.com/biwakonbu/zeus/internal/core"
 )
 
 var reportCmd = &cobra.Command{
@@ -18,23 +22,64 @@ var reportCmd = &cobra.Command{
   html     - HTML形式（スタイル付き）
   markdown - Markdown形式（依存関係グラフ付き）
 
+セクション（--sections または zeus.yaml の reports.sections で選択）:
+  considerations - open の Consideration（期限順）
+  decisions      - 直近 30 日の Decision
+  risks          - mitigated / closed 以外の Risk（スコア順）
+  problems       - 未解決の Problem（重大度順）
+  assumptions    - 無効化された Assumption
+  budget         - Objective / Activity の予算対実績（予算超過を先頭）
+  none を指定するとセクションを出力しません。
+
 例:
   zeus report                         # TEXT形式で標準出力
   zeus report --format=html           # HTML形式で標準出力
   zeus report -f markdown -o report.md  # Markdown形式でファイル出力
-  zeus report -f html -o report.html  # HTML形式でファイル出力`,
+  zeus report -f html -o report.html  # HTML形式でファイル出力
+  zeus report --sections risks,problems  # Risk と Problem のセクションのみ
+  zeus report -f html --email         # notifications.email の宛先へ送信`,
 	RunE: runReport,
 }
 
+var reportPortfolioCmd = &cobra.Command{
+	Use:   "portfolio",
+	Short: "複数プロジェクトのポートフォリオレポートを生成",
+	Long: `--project / --workspace で指定したプロジェクト（未指定の場合はカレントプロジェクト）の
+健全性・完了率・完了見込み（予定中のリリースの準備状況）・Top Risks・ボトルネックを
+1 つの Markdown 文書に集約します。ダッシュボードの /api/portfolio と同じ内容です。
+
+ボトルネック:
+  - severity が critical / high の未解決 Problem
+  - 期限を過ぎた open の Consideration
+  - 目標日を過ぎた no-go のリリース
+  - 予算超過の Objective / Activity（zeus budget）
+
+例:
+  zeus report portfolio --workspace zeus-workspace.yaml -o portfolio.md
+  zeus report portfolio --project api=../api --project web=../web
+  zeus report portfolio --workspace zeus-workspace.yaml --format json`,
+	Args: cobra.NoArgs,
+	RunE: runReportPortfolio,
+}
+
 var (
-	reportFormat string
-	reportOutput string
+	reportFormat   string
+	reportOutput   string
+	reportSections []string
+	reportEmail    bool
 )
 
 func init() {
 	rootCmd.AddCommand(reportCmd)
 	reportCmd.Flags().StringVarP(&reportFormat, "format", "f", "text", "出力形式 (text|html|markdown)")
 	reportCmd.Flags().StringVarP(&reportOutput, "output", "o", "", "出力ファイル（省略時は標準出力）")
+	reportCmd.Flags().StringSliceVar(&reportSections, "sections", nil, "出力するセクション (considerations,decisions,risks,problems,assumptions,budget|none)")
+	reportCmd.Flags().BoolVar(&reportEmail, "email", false, "notifications.email の宛先へメール送信")
+
+	reportCmd.AddCommand(reportPortfolioCmd)
+	reportPortfolioCmd.Flags().StringArray("project", nil, "集約するプロジェクト（\"名前=パス\" または \"パス\"、複数指定可）")
+	reportPortfolioCmd.Flags().String("workspace", "", "集約するプロジェクトを列挙したワークスペースファイル")
+	reportPortfolioCmd.Flags().StringP("output", "o", "", "出力ファイル（省略時は標準出力）")
 }
 
 func runReport(cmd *cobra.Command, args []string) error {
@@ -50,11 +95,32 @@ func runReport(cmd *cobra.Command, args []string) error {
 	}
 
 	// レポートを生成
-	output, err := zeus.GenerateReport(ctx, reportFormat)
+	var sections []string
+	if cmd.Flags().Changed("sections") {
+		sections = []string{}
+		for _, s := range reportSections {
+			if s != "none" {
+				sections = append(sections, s)
+			}
+		}
+	}
+	output, err := zeus.GenerateReportWithSections(ctx, reportFormat, sections)
 	if err != nil {
 		return fmt.Errorf("レポート生成失敗: %w", err)
 	}
 
+	if reportEmail {
+		subject := fmt.Sprintf("レポート (%s)", time.Now().Format("2006-01-02"))
+		if err := zeus.SendEmail(ctx, subject, output, reportFormat == "html"); err != nil {
+			return fmt.Errorf("メール送信失敗: %w", err)
+		}
+		green := color.New(color.FgGreen).SprintFunc()
+		fmt.Printf("%s レポートをメールで送信しました。\n", green("[SUCCESS]"))
+		if reportOutput == "" {
+			return nil
+		}
+	}
+
 	// 出力先に応じて出力
 	if reportOutput != "" {
 		if err := os.WriteFile(reportOutput, []byte(output), 0644); err != nil {
@@ -77,3 +143,52 @@ func runReport(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func runReportPortfolio(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+
+	format, _ := cmd.Flags().GetString("format")
+	switch format {
+	case "text", "markdown", "json":
+		// OK
+	default:
+		return fmt.Errorf("不明な出力形式: %s (markdown, json のいずれかを指定してください)", format)
+	}
+
+	projects, err := dashboardProjects(cmd)
+	if err != nil {
+		return err
+	}
+	var members []core.PortfolioMember
+	for _, p := range projects {
+		members = append(members, core.PortfolioMember{Name: p.Name, Zeus: newProjectZeus(cmd, p.Path)})
+	}
+	if len(members) == 0 {
+		members = append(members, core.PortfolioMember{Zeus: getZeus(cmd)})
+	}
+
+	portfolio, err := core.BuildPortfolio(ctx, members)
+	if err != nil {
+		return fmt.Errorf("ポートフォリオ生成失敗: %w", err)
+	}
+	output := portfolio.ToMarkdown()
+	if format == "json" {
+		data, err := json.MarshalIndent(portfolio, "", "  ")
+		if err != nil {
+			return err
+		}
+		output = string(data) + "\n"
+	}
+
+	path, _ := cmd.Flags().GetString("output")
+	if path == "" {
+		fmt.Print(output)
+		return nil
+	}
+	if err := os.WriteFile(path, []byte(output), 0644); err != nil {
+		return fmt.Errorf("ファイル出力失敗: %w", err)
+	}
+	green := color.New(color.FgGreen).SprintFunc()
+	fmt.Printf("%s ポートフォリオレポートを %s に出力しました。\n", green("[SUCCESS]"), path)
+	return nil
+}
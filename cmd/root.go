@@ -3,9 +3,12 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
@@ -22,6 +25,15 @@ var rootCmd = &cobra.Command{
 仕様作成まで）を支援します。`,
 }
 
+// appVersion はバイナリのバージョン（main から SetVersion で設定）
+var appVersion = "dev"
+
+// SetVersion はバイナリのバージョンを設定（zeus --version / /api/version で使用）
+func SetVersion(v string) {
+	appVersion = v
+	rootCmd.Version = v
+}
+
 // Execute はルートコマンドを実行
 func Execute() error {
 	return rootCmd.Execute()
@@ -30,6 +42,16 @@ func Execute() error {
 func init() {
 	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "詳細出力")
 	rootCmd.PersistentFlags().StringP("format", "f", "text", "出力形式 (text|json)")
+	rootCmd.PersistentFlags().Bool("dry-run", false, "書き込まずに変更内容のみ表示")
+	rootCmd.PersistentFlags().Bool("preview", false, "変更したファイルの差分（YAML の変更前後）を表示")
+	rootCmd.PersistentFlags().String("as", "", "操作者（未指定時は環境変数 ZEUS_ACTOR、OS のユーザー名）")
+	rootCmd.PersistentFlags().String("agent", "", "操作している AI エージェント名（監査ログに記録し、zeus.yaml の agents のガードレールを適用）")
+}
+
+// isDryRun はグローバルフラグ --dry-run が指定されているか
+func isDryRun(cmd *cobra.Command) bool {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	return dryRun
 }
 
 // getZeus はコンテキストからZeusインスタンスを取得（DI対応）
@@ -42,7 +64,9 @@ func getZeus(cmd *cobra.Command) *core.Zeus {
 	if z := ctx.Value(zeusContextKey); z != nil {
 		return z.(*core.Zeus)
 	}
-	return core.New(".")
+	agent, _ := cmd.Flags().GetString("agent")
+	as, _ := cmd.Flags().GetString("as")
+	return core.New(".", core.WithAgent(agent), core.WithActor(core.ResolveActor(as)))
 }
 
 // getContext はコマンドからコンテキストを取得
@@ -68,3 +92,99 @@ func printJSONResult(result *core.AddResult) error {
 	fmt.Println(string(data))
 	return nil
 }
+
+// printFileChanges は --dry-run で変更されるはずのファイルと差分を表示
+// --format json では {"dry_run": true, "changes": [...]} を出力する
+func printFileChanges(cmd *cobra.Command, changes []core.FileChange) error {
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		data, err := json.MarshalIndent(map[string]any{"dry_run": true, "changes": changes}, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	yellow := color.New(color.FgYellow).SprintFunc()
+	fmt.Printf("%s %d file(s) would change:\n", yellow("[DRY-RUN]"), len(changes))
+	printChangeList(changes)
+	return nil
+}
+
+// isPreview はグローバルフラグ --preview が指定されているか
+func isPreview(cmd *cobra.Command) bool {
+	preview, _ := cmd.Flags().GetBool("preview")
+	return preview
+}
+
+// mutate は書き込みを伴う処理を実行する
+// --preview 指定時はトランザクションで実行し、反映したファイルの差分を表示する
+func mutate(cmd *cobra.Command, zeus *core.Zeus, fn func(tx *core.Zeus) error) error {
+	if !isPreview(cmd) {
+		return fn(zeus)
+	}
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		return fmt.Errorf("--preview は --format text でのみ使用できます")
+	}
+	changes, err := zeus.Preview(getContext(cmd), fn)
+	if err != nil {
+		return err
+	}
+	cyan := color.New(color.FgCyan).SprintFunc()
+	fmt.Printf("%s %d file(s) changed:\n", cyan("[PREVIEW]"), len(changes))
+	printChangeList(changes)
+	fmt.Println()
+	return nil
+}
+
+// queuedForApproval は err が承認待ちキューへの追加を示す場合は承認 ID を返し、err を nil にする
+// （mutate や DryRun の中で使用し、承認待ちキューへの書き込みを取り消さないようにする）
+func queuedForApproval(err error, approvalID *string) error {
+	var required *core.ApprovalRequiredError
+	if errors.As(err, &required) {
+		*approvalID = required.ApprovalID
+		return nil
+	}
+	return err
+}
+
+// printQueuedForApproval は承認待ちキューに追加された操作を表示
+func printQueuedForApproval(action, approvalID string) {
+	yellow := color.New(color.FgYellow).SprintFunc()
+	fmt.Printf("%s %s は承認待ちキューに追加されました\n", yellow("⏳"), action)
+	fmt.Printf("   承認ID: %s\n", approvalID)
+	fmt.Println("   'zeus pending' で確認、'zeus approve <id>' で承認できます")
+}
+
+// printChangeList は変更されるファイルの一覧と差分を表示
+func printChangeList(changes []core.FileChange) {
+	for _, c := range changes {
+		fmt.Printf("  %-6s %s\n", c.Op, c.Path)
+	}
+	for _, c := range changes {
+		fmt.Println()
+		printDiff(c.Diff)
+	}
+}
+
+// printDiff は unified diff を色付きで表示
+func printDiff(diff string) {
+	green := color.New(color.FgGreen).SprintFunc()
+	red := color.New(color.FgRed).SprintFunc()
+	cyan := color.New(color.FgCyan).SprintFunc()
+
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "---"), strings.HasPrefix(line, "+++"):
+			fmt.Println(line)
+		case strings.HasPrefix(line, "@@"):
+			fmt.Println(cyan(line))
+		case strings.HasPrefix(line, "+"):
+			fmt.Println(green(line))
+		case strings.HasPrefix(line, "-"):
+			fmt.Println(red(line))
+		default:
+			fmt.Println(line)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var rulesCmd = &cobra.Command{
+	Use:   "rules",
+	Short: "自動化ルールを操作",
+	Long: `.zeus/rules/*.yaml に定義した自動化ルール（トリガー / 条件 / アクション）を操作します。
+
+ルールはエンティティの変更イベント（前回の評価時点からの作成・変更）に対して評価されます。
+
+定義例（.zeus/rules/critical-risk.yaml）:
+  id: critical-risk
+  description: リスクスコアが critical になったら Problem を作成
+  trigger:
+    entity: risk          # objective, usecase, activity, consideration, decision,
+                          # problem, risk, assumption, quality
+    on: updated           # created | updated | any（デフォルト: any）
+    field: risk_score     # updated 時にこのフィールドの変化を要求（任意）
+  conditions:
+    - field: risk_score   # ネストしたフィールドはドット区切り（mitigation.preventive）
+      op: eq              # eq | ne | in | not_in | contains | gt | gte | lt | lte
+      value: critical     # gt/lt は数値、または重大度（low < medium < high < critical）で比較
+  actions:
+    - type: create_problem
+      title: "Critical risk: {{title}}"
+      severity: critical
+    - type: notify
+      channel: log        # log（.zeus/logs/rules.yaml）| email
+      message: "{{id}} became critical"`,
+}
+
+var rulesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "ルール一覧を表示",
+	Long:  `定義されているルールを表示します。定義の検証エラーがある場合は失敗します。`,
+	RunE:  runRulesList,
+}
+
+var rulesRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "変更イベントに対してルールを評価",
+	Long: `前回の評価時点（.zeus/state/rules-snapshot.yaml）からのエンティティの作成・変更を検出し、
+ルールを評価してアクションを実行します。初回は現在の状態を記録するのみです。
+
+ルールのアクションで作成されたエンティティは次回の評価でイベントになりません。
+初回に状態を記録した後は、エンティティの書き込みのたびに同じ評価を自動で行います。
+YAML の直接編集など、自動評価の対象外の変更を取り込む場合に実行します。
+
+例:
+  zeus rules run --dry-run   # 評価のトレースのみ表示
+  zeus rules run`,
+	RunE: runRulesRun,
+}
+
+var rulesTestCmd = &cobra.Command{
+	Use:   "test <rule-id> <entity-id>",
+	Short: "既存エンティティでルールを試験",
+	Long: `指定したエンティティの現在の値で変更イベントを模擬し、ルールの評価結果を表示します。
+アクションは実行しません。
+
+--event updated では --changed のフィールド（省略時はトリガーのフィールド）が
+変化したものとして扱います。
+
+例:
+  zeus rules test critical-risk risk-1a2b3c4d
+  zeus rules test critical-risk risk-1a2b3c4d --event created`,
+	Args: cobra.ExactArgs(2),
+	RunE: runRulesTest,
+}
+
+var (
+	rulesTestEvent   string
+	rulesTestChanged []string
+)
+
+func init() {
+	rootCmd.AddCommand(rulesCmd)
+	rulesCmd.AddCommand(rulesListCmd)
+	rulesCmd.AddCommand(rulesRunCmd)
+	rulesCmd.AddCommand(rulesTestCmd)
+
+	rulesTestCmd.Flags().StringVar(&rulesTestEvent, "event", core.RuleOnUpdated, "模擬するイベント (created|updated)")
+	rulesTestCmd.Flags().StringSliceVar(&rulesTestChanged, "changed", nil, "変化したものとして扱うフィールド（カンマ区切り）")
+}
+
+func runRulesList(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	rules, err := zeus.LoadRules(ctx)
+	if err != nil {
+		return fmt.Errorf("ルール読み込み失敗: %w", err)
+	}
+	if len(rules) == 0 {
+		fmt.Println("ルールがありません（.zeus/rules/*.yaml）")
+		return nil
+	}
+
+	for _, r := range rules {
+		on := r.Trigger.On
+		if on == "" {
+			on = core.RuleOnAny
+		}
+		trigger := r.Trigger.Entity + " " + on
+		if r.Trigger.Field != "" {
+			trigger += " (" + r.Trigger.Field + ")"
+		}
+		actions := make([]string, 0, len(r.Actions))
+		for _, a := range r.Actions {
+			actions = append(actions, a.Type)
+		}
+		line := fmt.Sprintf("%s: %s, %d condition(s) -> %s", r.ID, trigger, len(r.Conditions), strings.Join(actions, ", "))
+		if r.Disabled {
+			line += " [disabled]"
+		}
+		fmt.Println(line)
+		if r.Description != "" {
+			fmt.Printf("  %s\n", r.Description)
+		}
+	}
+	return nil
+}
+
+func runRulesRun(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+	dryRun := isDryRun(cmd)
+
+	result, err := zeus.RunRules(ctx, dryRun)
+	if err != nil {
+		return fmt.Errorf("ルール評価失敗: %w", err)
+	}
+
+	green := color.New(color.FgGreen).SprintFunc()
+	yellow := color.New(color.FgYellow).SprintFunc()
+	if result.Baseline {
+		if dryRun {
+			fmt.Printf("%s 初回評価のため現在の状態を記録します (dry-run)\n", yellow("[DRY-RUN]"))
+			return nil
+		}
+		fmt.Printf("%s 現在の状態を記録しました（次回から変更イベントを評価します）\n", green("[SUCCESS]"))
+		return nil
+	}
+
+	fired, failed := 0, 0
+	for _, t := range result.Traces {
+		printRuleTrace(t)
+		if t.Matched {
+			fired++
+		}
+		for _, a := range t.Actions {
+			if a.Error != "" {
+				failed++
+			}
+		}
+	}
+
+	summary := fmt.Sprintf("%d event(s), %d rule(s) fired", result.Events, fired)
+	if dryRun {
+		fmt.Printf("%s %s (dry-run)\n", yellow("[DRY-RUN]"), summary)
+		return nil
+	}
+	if failed > 0 {
+		return fmt.Errorf("%s, %d action(s) failed", summary, failed)
+	}
+	fmt.Printf("%s %s\n", green("[SUCCESS]"), summary)
+	return nil
+}
+
+func runRulesTest(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	rules, err := zeus.LoadRules(ctx)
+	if err != nil {
+		return fmt.Errorf("ルール読み込み失敗: %w", err)
+	}
+	var rule *core.Rule
+	for i := range rules {
+		if rules[i].ID == args[0] {
+			rule = &rules[i]
+			break
+		}
+	}
+	if rule == nil {
+		return fmt.Errorf("ルールが見つかりません: %s", args[0])
+	}
+
+	trace, err := zeus.TestRule(ctx, *rule, args[1], rulesTestEvent, rulesTestChanged)
+	if err != nil {
+		return fmt.Errorf("ルール試験失敗: %w", err)
+	}
+	printRuleTrace(*trace)
+	return nil
+}
+
+// printRuleTrace はルール評価のトレースを表示
+func printRuleTrace(t core.RuleTrace) {
+	green := color.New(color.FgGreen).SprintFunc()
+	red := color.New(color.FgRed).SprintFunc()
+
+	mark := "skip "
+	if t.Matched {
+		mark = green("match")
+	}
+	fmt.Printf("  %s %s on %s %s %s: %s\n", mark, t.RuleID, t.Entity, t.EntityID, t.Event, t.Reason)
+	for _, a := range t.Actions {
+		if a.Error != "" {
+			fmt.Printf("      -> %s %s %s\n", a.Type, a.Detail, red("error: "+a.Error))
+			continue
+		}
+		fmt.Printf("      -> %s %s\n", a.Type, a.Detail)
+	}
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var showCmd = &cobra.Command{
+	Use:   "show <id>",
+	Short: "エンティティの詳細を関連エンティティとともに表示",
+	Long: `エンティティの内容を、参照を解決した関連エンティティとともに表示します。
+エンティティ種別は ID の接頭辞から推定します。
+
+  References     このエンティティが参照している（親・依存先）エンティティとタイトル
+  Referenced By  このエンティティを参照している（子・関連するリスク・課題・品質など）エンティティ
+  Comments       YAML に書かれたコメント
+  Audit          このエンティティに対する直近の変更操作（監査ログ）
+
+参照先が存在しない場合は [missing] と表示します。
+
+例:
+  zeus show obj-1a2b3c4d
+  zeus show uc-1a2b3c4d --audit 20
+  zeus show act-1a2b3c4d --format json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runShow,
+}
+
+var showAuditLimit int
+
+func init() {
+	rootCmd.AddCommand(showCmd)
+	showCmd.Flags().IntVar(&showAuditLimit, "audit", core.DefaultShowAuditLimit, "表示する監査ログの件数（0 で表示しない）")
+}
+
+func runShow(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	opts := core.ShowOptions{AuditLimit: showAuditLimit}
+	if showAuditLimit == 0 {
+		opts.AuditLimit = -1
+	}
+	detail, err := zeus.ShowEntity(ctx, args[0], opts)
+	if err != nil {
+		return fmt.Errorf("エンティティの取得に失敗: %w", err)
+	}
+
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(detail)
+	}
+
+	content, err := detail.YAML()
+	if err != nil {
+		return fmt.Errorf("エンティティの表示に失敗: %w", err)
+	}
+	cyan := color.New(color.FgCyan).SprintFunc()
+	red := color.New(color.FgRed).SprintFunc()
+	fmt.Printf("%s %s %s\n", cyan(detail.Entity), detail.ID, detail.Title)
+	fmt.Println("═══════════════════════════════════════════════════════════")
+	fmt.Printf("(%s)\n", detail.Path)
+	fmt.Print(content)
+
+	fmt.Println()
+	fmt.Printf("%s (%d)\n", cyan("References"), len(detail.References))
+	for _, r := range detail.References {
+		if r.Missing {
+			fmt.Printf("  %-16s %-14s %s\n", r.Relation, r.ID, red("[missing]"))
+			continue
+		}
+		fmt.Printf("  %-16s %-14s %s (%s)\n", r.Relation, r.ID, r.Title, r.Entity)
+	}
+
+	fmt.Println()
+	fmt.Printf("%s (%d)\n", cyan("Referenced By"), len(detail.ReferencedBy))
+	for _, r := range detail.ReferencedBy {
+		fmt.Printf("  %-12s %-14s %s (%s)\n", r.Entity, r.ID, r.Title, r.Relation)
+	}
+
+	if len(detail.Comments) > 0 {
+		fmt.Println()
+		fmt.Printf("%s (%d)\n", cyan("Comments"), len(detail.Comments))
+		for _, c := range detail.Comments {
+			fmt.Printf("  # %s\n", c)
+		}
+	}
+
+	if opts.AuditLimit >= 0 {
+		fmt.Println()
+		fmt.Printf("%s (%d)\n", cyan("Audit"), len(detail.Audit))
+		for _, r := range detail.Audit {
+			fmt.Printf("  %s  %-20s %-6s %s\n", r.Time, formatActor(r.Actor, r.Agent), r.Op, r.Result)
+		}
+	}
+	return nil
+}
@@ -2,15 +2,31 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+
+	"github.com/biwakonbu/zeus/internal/core"
 )
 
 var snapshotCmd = &cobra.Command{
 	Use:   "snapshot",
 	Short: "スナップショット管理",
-	Long:  `プロジェクト状態のスナップショットを管理します。`,
+	Long: `プロジェクト状態のスナップショットを管理します。
+
+--daemon を指定すると zeus.yaml の snapshots.interval_days ごとに自動スナップショット
+（ラベル auto）を作成し、snapshots.retention に従って古い自動スナップショットを削除し続けます。
+ダッシュボード（zeus dashboard）の起動中も同じ設定で自動スナップショットを作成します。
+
+例:
+  zeus snapshot --daemon
+  zeus snapshot --daemon --interval-days 1`,
+	Args: cobra.NoArgs,
+	RunE: runSnapshot,
 }
 
 var snapshotCreateCmd = &cobra.Command{
@@ -26,6 +42,19 @@ var snapshotListCmd = &cobra.Command{
 	RunE:  runSnapshotList,
 }
 
+var snapshotPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "保持ポリシーを超えた自動スナップショットを削除",
+	Long: `zeus.yaml の snapshots.retention に従って古い自動スナップショット（ラベル auto）を削除します。
+手動で作成したスナップショットは削除しません。フラグで設定を上書きできます。
+
+例:
+  zeus snapshot prune
+  zeus snapshot prune --keep-last 12 --max-age-days 180`,
+	Args: cobra.NoArgs,
+	RunE: runSnapshotPrune,
+}
+
 var snapshotRestoreCmd = &cobra.Command{
 	Use:   "restore <timestamp>",
 	Short: "スナップショットから復元",
@@ -38,8 +67,113 @@ func init() {
 	snapshotCmd.AddCommand(snapshotCreateCmd)
 	snapshotCmd.AddCommand(snapshotListCmd)
 	snapshotCmd.AddCommand(snapshotRestoreCmd)
+	snapshotCmd.AddCommand(snapshotPruneCmd)
 
+	snapshotCmd.Flags().Bool("daemon", false, "自動スナップショットを作成し続ける（Ctrl+C で終了）")
+	snapshotCmd.Flags().Int("interval-days", 0, "自動スナップショットの間隔（日数、snapshots.interval_days を上書き）")
+	snapshotCmd.Flags().Duration("check-interval", time.Hour, "--daemon で作成時期を確認する間隔")
 	snapshotListCmd.Flags().IntP("limit", "n", 10, "表示件数")
+	snapshotPruneCmd.Flags().Int("keep-last", 0, "新しい順に残す件数（snapshots.retention.keep_last を上書き）")
+	snapshotPruneCmd.Flags().Int("max-age-days", 0, "残す最大経過日数（snapshots.retention.max_age_days を上書き）")
+}
+
+// runSnapshot は --daemon の場合に自動スナップショットを作成し続ける（それ以外はヘルプを表示）
+func runSnapshot(cmd *cobra.Command, args []string) error {
+	if daemon, _ := cmd.Flags().GetBool("daemon"); !daemon {
+		return cmd.Help()
+	}
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	settings, err := zeus.SnapshotSettings(ctx)
+	if err != nil {
+		return err
+	}
+	if cmd.Flags().Changed("interval-days") {
+		settings.IntervalDays, _ = cmd.Flags().GetInt("interval-days")
+	}
+	if settings.IntervalDays <= 0 {
+		return fmt.Errorf("自動スナップショットの間隔が未設定です（zeus.yaml の snapshots.interval_days または --interval-days）")
+	}
+	interval, _ := cmd.Flags().GetDuration("check-interval")
+	if interval <= 0 {
+		return fmt.Errorf("--check-interval は正の値を指定してください: %s", interval)
+	}
+
+	cyan := color.New(color.FgCyan).SprintFunc()
+	green := color.New(color.FgGreen).SprintFunc()
+	fmt.Println(cyan("Snapshot Daemon"))
+	fmt.Println("═══════════════════════════════════════════════════════════")
+	fmt.Printf("Interval: %d day(s) | Keep last: %d | Max age: %d day(s)\n",
+		settings.IntervalDays, settings.Retention.KeepLast, settings.Retention.MaxAgeDays)
+	fmt.Println("Ctrl+C で終了")
+
+	// 失敗は表示して次回の確認で再試行する
+	tick := func() {
+		now := time.Now()
+		result, err := zeus.AutoSnapshot(ctx, settings, now)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, color.RedString("エラー: %v", err))
+			return
+		}
+		if result.Created != nil {
+			fmt.Printf("%s %s Snapshot created: %s (next: %s)\n", now.Format("2006-01-02 15:04"), green("✓"), result.Created.Timestamp, result.NextDue)
+		}
+		for _, ts := range result.Pruned {
+			fmt.Printf("%s - Snapshot pruned: %s\n", now.Format("2006-01-02 15:04"), ts)
+		}
+	}
+
+	tick()
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sigCh:
+			return nil
+		case <-ticker.C:
+			tick()
+		}
+	}
+}
+
+func runSnapshotPrune(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	settings, err := zeus.SnapshotSettings(ctx)
+	if err != nil {
+		return err
+	}
+	retention := settings.Retention
+	if cmd.Flags().Changed("keep-last") {
+		retention.KeepLast, _ = cmd.Flags().GetInt("keep-last")
+	}
+	if cmd.Flags().Changed("max-age-days") {
+		retention.MaxAgeDays, _ = cmd.Flags().GetInt("max-age-days")
+	}
+	if err := (core.SnapshotSettings{Retention: retention}).Validate(); err != nil {
+		return err
+	}
+	if retention.KeepLast == 0 && retention.MaxAgeDays == 0 {
+		return fmt.Errorf("保持ポリシーが未設定です（zeus.yaml の snapshots.retention または --keep-last / --max-age-days）")
+	}
+
+	pruned, err := zeus.PruneSnapshots(ctx, retention, time.Now())
+	if err != nil {
+		return err
+	}
+	green := color.New(color.FgGreen).SprintFunc()
+	for _, ts := range pruned {
+		fmt.Printf("  - %s\n", ts)
+	}
+	fmt.Printf("%s Pruned %d snapshot(s)\n", green("✓"), len(pruned))
+	return nil
 }
 
 func runSnapshotCreate(cmd *cobra.Command, args []string) error {
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var staleCmd = &cobra.Command{
+	Use:   "stale",
+	Short: "陳腐化したエンティティを表示",
+	Long: `完了・廃止から時間が経った Objective / Activity や、参照の無い Activity を検出し、
+推奨アクション（archive, review）とともに表示します。
+
+陳腐化とみなす日数は zeus.yaml の analysis.stale で設定します（エンティティ種別ごとに上書き可能）。
+  analysis:
+    stale:
+      completed_days: 30
+      entities:
+        activity:
+          completed_days: 60
+
+例:
+  zeus stale
+  zeus stale archive            # アーカイブの対象を表示
+  zeus stale archive --apply    # archive/ に移動`,
+	Args: cobra.NoArgs,
+	RunE: runStale,
+}
+
+var staleArchiveCmd = &cobra.Command{
+	Use:   "archive",
+	Short: "archive を推奨したエンティティを archive/ に移動",
+	Long: `陳腐化分析で archive を推奨したエンティティを .zeus/archive/ に移動します（元のディレクトリ構成を保ちます）。
+
+--apply を指定しない場合は、移動する対象の一覧のみを表示します。
+他のエンティティから参照されているもの、settings.operations 等で削除に承認が必要なものは移動しません。
+移動したエンティティは監査ログに archive として記録します。`,
+	Args: cobra.NoArgs,
+	RunE: runStaleArchive,
+}
+
+var staleArchiveApply bool
+
+func init() {
+	rootCmd.AddCommand(staleCmd)
+	staleCmd.AddCommand(staleArchiveCmd)
+	staleArchiveCmd.Flags().BoolVar(&staleArchiveApply, "apply", false, "対象を archive/ に移動する")
+}
+
+func runStale(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	result, err := zeus.AnalyzeStale(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("陳腐化分析に失敗: %w", err)
+	}
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+
+	cyan := color.New(color.FgCyan).SprintFunc()
+	fmt.Printf("%s (%d items: archive %d, review %d)\n", cyan("Stale Entities"), result.TotalStale, result.ArchiveCount, result.ReviewCount)
+	fmt.Println("═══════════════════════════════════════════════════════════")
+	if result.TotalStale == 0 {
+		fmt.Println("陳腐化したエンティティはありません。")
+		return nil
+	}
+	for _, e := range result.StaleEntities {
+		fmt.Printf("[%-7s] %-10s %-14s %s — %s\n", e.Recommendation, e.EntityType, e.EntityID, e.EntityTitle, e.Message)
+	}
+	if result.ArchiveCount > 0 {
+		fmt.Println()
+		fmt.Println("'zeus stale archive --apply' で archive の推奨を実行できます。")
+	}
+	return nil
+}
+
+func runStaleArchive(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	result, err := zeus.ArchiveStale(ctx, time.Now(), staleArchiveApply)
+	if err != nil {
+		return fmt.Errorf("アーカイブに失敗: %w", err)
+	}
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+
+	green := color.New(color.FgGreen).SprintFunc()
+	yellow := color.New(color.FgYellow).SprintFunc()
+	if len(result.Archived) == 0 {
+		fmt.Println("アーカイブの対象はありません。")
+	}
+	for _, e := range result.Archived {
+		if result.Applied {
+			fmt.Printf("%s %s %s %s → %s\n", green("✓"), e.Entity, e.ID, e.Title, e.Path)
+		} else {
+			fmt.Printf("  %s %s %s（%d 日）→ %s\n", e.Entity, e.ID, e.Title, e.DaysStale, e.Path)
+		}
+	}
+	for _, e := range result.Skipped {
+		fmt.Printf("%s %s %s %s: %s\n", yellow("[SKIP]"), e.Entity, e.ID, e.Title, e.Reason)
+	}
+	if !result.Applied && len(result.Archived) > 0 {
+		fmt.Println()
+		fmt.Printf("%d 件を移動するには --apply を指定してください。\n", len(result.Archived))
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/biwakonbu/zeus/internal/diagram"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// statemachine diagram コマンドのフラグ
+var statemachineDiagramOutput string
+
+var statemachineCmd = &cobra.Command{
+	Use:   "statemachine",
+	Short: "ステートマシン操作",
+	Long:  `ステートマシンに関する操作を行います。`,
+}
+
+var statemachineDiagramCmd = &cobra.Command{
+	Use:   "diagram <statemachine-id>",
+	Short: "ステートマシン図を出力",
+	Long: `ステートマシンの状態と遷移から Mermaid stateDiagram-v2 を出力します。
+
+開始/終了状態は [*]、遷移ラベルは "トリガー [ガード条件]" 形式で出力されます。
+Activity に紐づく状態にはノートで Activity ID が付記されます。
+
+例:
+  zeus statemachine diagram sm-001
+  zeus statemachine diagram sm-001 -o lifecycle.md`,
+	Args: cobra.ExactArgs(1),
+	RunE: runStateMachineDiagram,
+}
+
+func init() {
+	rootCmd.AddCommand(statemachineCmd)
+	statemachineCmd.AddCommand(statemachineDiagramCmd)
+
+	statemachineDiagramCmd.Flags().StringVarP(&statemachineDiagramOutput, "output", "o", "", "出力ファイル（省略時は標準出力）")
+}
+
+func runStateMachineDiagram(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+	machineID := args[0]
+
+	entity, err := zeus.Get(ctx, "statemachine", machineID)
+	if err != nil {
+		return fmt.Errorf("ステートマシン取得失敗: %w", err)
+	}
+	machine, ok := entity.(*core.StateMachineEntity)
+	if !ok {
+		return fmt.Errorf("StateMachineEntity への型アサーションに失敗しました")
+	}
+
+	output := diagram.StateMachineMermaid(machine)
+
+	if statemachineDiagramOutput != "" {
+		if err := os.WriteFile(statemachineDiagramOutput, []byte(output), 0644); err != nil {
+			return fmt.Errorf("ファイル出力失敗: %w", err)
+		}
+		green := color.New(color.FgGreen).SprintFunc()
+		fmt.Printf("%s ステートマシン図を %s に出力しました。\n", green("✓"), statemachineDiagramOutput)
+		return nil
+	}
+
+	fmt.Print(output)
+	return nil
+}
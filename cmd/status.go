@@ -13,16 +13,32 @@ import (
 var statusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "プロジェクトの状態を表示",
-	RunE:  runStatus,
+	Long: `プロジェクトの状態を表示します。
+
+--watch を指定すると .zeus の変更を検知するたびに再表示し続けます（Ctrl+C で終了）。
+変更は --watch-interval の間隔（既定 1s）で確認します。
+
+例:
+  zeus status
+  zeus status --watch --watch-interval 3s`,
+	RunE: runStatus,
 }
 
 func init() {
 	rootCmd.AddCommand(statusCmd)
+	addWatchFlags(statusCmd)
 }
 
 func runStatus(cmd *cobra.Command, args []string) error {
 	ctx := getContext(cmd)
 	zeus := getZeus(cmd)
+	return runWatch(cmd, zeus, func() error {
+		return printStatus(ctx, zeus)
+	})
+}
+
+// printStatus はプロジェクトの状態を表示
+func printStatus(ctx context.Context, zeus *core.Zeus) error {
 	result, err := zeus.Status(ctx)
 	if err != nil {
 		return err
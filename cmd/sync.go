@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "外部サービスと同期",
+	Long:  `外部サービス（課題トラッカー）とエンティティを同期します。`,
+}
+
+var syncIssuesCmd = &cobra.Command{
+	Use:   "issues",
+	Short: "Activity と Issue を双方向に同期",
+	Long: `zeus.yaml の integrations.issue_sync に設定した GitLab / Gitea の Issue と
+Activity を双方向に同期します。対応は .zeus/integrations/<provider>-issues.yaml に記録されます。
+
+  - 未リンクの Activity（deprecated 以外）: Issue を作成
+  - 未リンクの open な Issue: Activity を作成（ラベルはタグとして取り込み）
+  - リンク済み: 前回の同期以降に変更された側の内容（タイトル、説明、完了状態）を反映
+    両側で変更されていた場合は更新日時の新しい側を採用
+  - 片側が削除されたリンク: リンクのみ解除（もう片側は削除しません）
+
+Activity の deprecated は Issue の closed に対応し、再オープンされた Issue は active に戻します。
+--dry-run では課題トラッカーに書き込まず、ローカルで変更されるファイルの差分を表示します。
+トークンは環境変数 ZEUS_ISSUE_SYNC_TOKEN が優先されます。
+
+設定例:
+  integrations:
+    issue_sync:
+      provider: gitlab            # gitlab | gitea
+      base_url: https://gitlab.example.com
+      project: group/app          # Gitea は owner/repo
+
+例:
+  zeus sync issues --dry-run
+  zeus sync issues`,
+	RunE: runSyncIssues,
+}
+
+func init() {
+	rootCmd.AddCommand(syncCmd)
+	syncCmd.AddCommand(syncIssuesCmd)
+}
+
+func runSyncIssues(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+	dryRun := isDryRun(cmd)
+
+	driver, err := zeus.IssueSyncDriver(ctx)
+	if err != nil {
+		return fmt.Errorf("Issue 同期の設定が不正です: %w", err)
+	}
+	var result *core.IssueSyncResult
+	if dryRun {
+		result, err = zeus.SyncIssues(ctx, driver, true)
+	} else {
+		err = mutate(cmd, zeus, func(tx *core.Zeus) error {
+			var err error
+			result, err = tx.SyncIssues(ctx, driver, false)
+			return err
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("Issue 同期失敗: %w", err)
+	}
+
+	for _, c := range result.Changes {
+		activity, issue := c.ActivityID, fmt.Sprintf("#%d", c.Issue)
+		if activity == "" {
+			activity = "(new)"
+		}
+		if c.Issue == 0 {
+			issue = "(new)"
+		}
+		line := fmt.Sprintf("%s %s %s <-> %s", c.Direction, c.Action, activity, issue)
+		if c.Title != "" {
+			line += ": " + c.Title
+		}
+		if c.Detail != "" {
+			line += " (" + c.Detail + ")"
+		}
+		fmt.Printf("  %s\n", line)
+	}
+
+	summary := fmt.Sprintf("%s %s: %d change(s), %d unchanged", result.Provider, result.Project, len(result.Changes), result.Unchanged)
+	if result.Conflicts > 0 {
+		summary += fmt.Sprintf(", %d conflict(s) resolved by newer update", result.Conflicts)
+	}
+	if dryRun {
+		yellow := color.New(color.FgYellow).SprintFunc()
+		fmt.Printf("%s %s (dry-run)\n", yellow("[DRY-RUN]"), summary)
+		return printFileChanges(cmd, result.Files)
+	}
+	green := color.New(color.FgGreen).SprintFunc()
+	fmt.Printf("%s %s\n", green("[SUCCESS]"), summary)
+	return nil
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var traceCmd = &cobra.Command{
+	Use:   "trace",
+	Short: "トレーサビリティマトリクスを生成",
+	Long: `Vision → Objective → UseCase → Activity と Quality のリンクを
+要求トレーサビリティマトリクスとして出力します。
+リンクが欠落している行は Missing 列に欠落先（vision, objective, usecase, activity, quality）を表示します。
+
+出力形式:
+  markdown - Markdown テーブル（デフォルト）
+  csv      - CSV形式（スプレッドシート取り込み用）
+  html     - HTML形式（欠落行を強調表示）
+
+例:
+  zeus trace                       # Markdown形式で標準出力
+  zeus trace --format csv -o trace.csv
+  zeus trace -f html -o trace.html
+  zeus trace --missing-only        # 欠落のある行のみ表示`,
+	RunE: runTrace,
+}
+
+var (
+	traceFormat      string
+	traceOutput      string
+	traceMissingOnly bool
+)
+
+func init() {
+	rootCmd.AddCommand(traceCmd)
+	traceCmd.Flags().StringVarP(&traceFormat, "format", "f", "markdown", "出力形式 (markdown|csv|html)")
+	traceCmd.Flags().StringVarP(&traceOutput, "output", "o", "", "出力ファイル（省略時は標準出力）")
+	traceCmd.Flags().BoolVar(&traceMissingOnly, "missing-only", false, "リンクが欠落している行のみ出力")
+}
+
+func runTrace(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	// 形式を検証
+	switch traceFormat {
+	case "markdown", "csv", "html":
+		// OK
+	default:
+		return fmt.Errorf("不明な出力形式: %s (markdown, csv, html のいずれかを指定してください)", traceFormat)
+	}
+
+	matrix, err := zeus.BuildTraceabilityMatrix(ctx)
+	if err != nil {
+		return fmt.Errorf("トレーサビリティマトリクス生成失敗: %w", err)
+	}
+	if traceMissingOnly {
+		matrix = matrix.Incomplete()
+	}
+
+	var output string
+	switch traceFormat {
+	case "csv":
+		if output, err = matrix.ToCSV(); err != nil {
+			return fmt.Errorf("CSV 出力失敗: %w", err)
+		}
+	case "html":
+		output = matrix.ToHTML()
+	default:
+		output = matrix.ToMarkdown()
+	}
+
+	// 出力先に応じて出力
+	if traceOutput != "" {
+		if err := os.WriteFile(traceOutput, []byte(output), 0644); err != nil {
+			return fmt.Errorf("ファイル出力失敗: %w", err)
+		}
+		green := color.New(color.FgGreen).SprintFunc()
+		fmt.Printf("%s トレーサビリティマトリクスを %s に出力しました。\n", green("[SUCCESS]"), traceOutput)
+	} else {
+		fmt.Print(output)
+	}
+
+	if matrix.Stats.IncompleteRows > 0 {
+		yellow := color.New(color.FgYellow).SprintFunc()
+		fmt.Fprintln(os.Stderr, yellow(fmt.Sprintf("[WARNING] %d 行でリンクが欠落しています。", matrix.Stats.IncompleteRows)))
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/biwakonbu/zeus/internal/diagram"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
@@ -25,23 +26,35 @@ var showUsecaseCmd = &cobra.Command{
 出力形式:
   text    - テキスト形式（デフォルト）
   mermaid - Mermaid形式（Markdown埋め込み可能）
+  png/svg - 画像（zeus.yaml の rendering 設定が必要、PNG は -o 必須）
 
 オプション:
   --boundary <name>  システム境界名を指定
-  --format <type>    出力形式（text|mermaid）
+  --subsystem <id>   指定したサブシステムのユースケースと関連するアクターだけを表示
+  --group-by-subsystem  システム境界の中をサブシステムごとに分けて表示
+  --format <type>    出力形式（text|mermaid|png|svg）
+  --engine <engine>  図の出力エンジン（mermaid|plantuml、--format より優先）
   --output <file>    出力ファイル（省略時は標準出力）
 
 例:
   zeus uml show usecase                            # TEXT形式で標準出力
   zeus uml show usecase --format=mermaid           # Mermaid形式で標準出力
-  zeus uml show usecase --boundary "ECサイト" -o uc.md  # システム境界を指定してファイル出力`,
+  zeus uml show usecase --engine=plantuml          # PlantUML形式で標準出力
+  zeus uml show usecase --format=svg -o uc.svg     # SVG 画像でファイル出力
+  zeus uml show usecase --boundary "ECサイト" -o uc.md  # システム境界を指定してファイル出力
+  zeus uml show usecase --subsystem sub-1a2b3c4d   # サブシステムのユースケースだけ
+  zeus uml show usecase --group-by-subsystem --format=mermaid  # サブシステムごとのサブグラフ`,
 	RunE: runShowUsecase,
 }
 
 var (
 	umlBoundary string
 	umlFormat   string
+	umlEngine   string
 	umlOutput   string
+
+	umlSubsystem        string
+	umlGroupBySubsystem bool
 )
 
 func init() {
@@ -49,14 +62,24 @@ func init() {
 	umlCmd.AddCommand(showUsecaseCmd)
 
 	showUsecaseCmd.Flags().StringVar(&umlBoundary, "boundary", "", "システム境界名")
-	showUsecaseCmd.Flags().StringVarP(&umlFormat, "format", "f", "text", "出力形式 (text|mermaid)")
+	showUsecaseCmd.Flags().StringVarP(&umlFormat, "format", "f", "text", "出力形式 (text|mermaid|png|svg)")
+	showUsecaseCmd.Flags().StringVar(&umlEngine, "engine", "", "図の出力エンジン (mermaid|plantuml)")
 	showUsecaseCmd.Flags().StringVarP(&umlOutput, "output", "o", "", "出力ファイル（省略時は標準出力）")
+	showUsecaseCmd.Flags().StringVar(&umlSubsystem, "subsystem", "", "表示するサブシステム ID")
+	showUsecaseCmd.Flags().BoolVar(&umlGroupBySubsystem, "group-by-subsystem", false, "サブシステムごとに分けて表示")
 }
 
 func runShowUsecase(cmd *cobra.Command, args []string) error {
 	ctx := getContext(cmd)
 	zeus := getZeus(cmd)
 
+	if err := checkImageOutput(umlFormat, umlOutput); err != nil {
+		return err
+	}
+	if umlSubsystem != "" && umlGroupBySubsystem {
+		return fmt.Errorf("--subsystem と --group-by-subsystem は同時に指定できません")
+	}
+
 	// Actor と UseCase を取得
 	actors, err := getActors(ctx, zeus)
 	if err != nil {
@@ -68,8 +91,35 @@ func runShowUsecase(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("ユースケース取得失敗: %w", err)
 	}
 
+	d := diagram.UseCaseDiagram{
+		Actors:   actors,
+		UseCases: usecases,
+		Boundary: umlBoundary,
+	}
+	if umlSubsystem != "" || umlGroupBySubsystem {
+		subsystems, err := getSubsystems(ctx, zeus)
+		if err != nil {
+			return fmt.Errorf("サブシステム取得失敗: %w", err)
+		}
+		if umlGroupBySubsystem {
+			d.Subsystems = subsystems
+		} else {
+			found := false
+			for _, sub := range subsystems {
+				if sub.ID == umlSubsystem {
+					d = d.ForSubsystem(sub)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("サブシステムが見つかりません: %s", umlSubsystem)
+			}
+		}
+	}
+
 	// データがない場合
-	if len(actors) == 0 && len(usecases) == 0 {
+	if len(d.Actors) == 0 && len(d.UseCases) == 0 {
 		cyan := color.New(color.FgCyan).SprintFunc()
 		fmt.Println(cyan("Zeus UseCase Diagram"))
 		fmt.Println("============================================================")
@@ -79,14 +129,23 @@ func runShowUsecase(cmd *cobra.Command, args []string) error {
 	}
 
 	// 形式に応じて出力を生成
+	renderer, err := resolveDiagramRenderer(umlEngine, umlFormat)
+	if err != nil {
+		return err
+	}
 	var output string
-	switch umlFormat {
-	case "text":
-		output = formatUsecaseText(actors, usecases, umlBoundary)
-	case "mermaid":
-		output = formatUsecaseMermaid(actors, usecases, umlBoundary)
+	switch {
+	case renderer != nil && diagram.IsImageFormat(umlFormat):
+		source := renderer.UseCase(d)
+		if output, err = renderDiagramImage(ctx, zeus, renderer, source, umlFormat); err != nil {
+			return err
+		}
+	case renderer != nil:
+		output = wrapDiagramOutput(renderer, renderer.UseCase(d))
+	case umlFormat == "text":
+		output = formatUsecaseText(d)
 	default:
-		return fmt.Errorf("不明な出力形式: %s (text, mermaid のいずれかを指定してください)", umlFormat)
+		return fmt.Errorf("不明な出力形式: %s (text, mermaid, png, svg のいずれかを指定してください)", umlFormat)
 	}
 
 	// 出力先に応じて出力
@@ -165,9 +224,24 @@ func getUseCases(ctx context.Context, zeus *core.Zeus) ([]core.UseCaseEntity, er
 	return usecases, nil
 }
 
+// getSubsystems はサブシステム一覧を取得
+func getSubsystems(ctx context.Context, zeus *core.Zeus) ([]core.SubsystemEntity, error) {
+	fileStore := zeus.FileStore()
+	if !fileStore.Exists(ctx, "subsystems.yaml") {
+		return []core.SubsystemEntity{}, nil
+	}
+	var subsystemsFile core.SubsystemsFile
+	if err := fileStore.ReadYaml(ctx, "subsystems.yaml", &subsystemsFile); err != nil {
+		return nil, err
+	}
+	return subsystemsFile.Subsystems, nil
+}
+
 // formatUsecaseText はテキスト形式でユースケース図を生成
-func formatUsecaseText(actors []core.ActorEntity, usecases []core.UseCaseEntity, boundary string) string {
+// d.Subsystems がある場合はサブシステムごとに分けて表示する
+func formatUsecaseText(d diagram.UseCaseDiagram) string {
 	var sb strings.Builder
+	actors, usecases := d.Actors, d.UseCases
 
 	cyan := color.New(color.FgCyan).SprintFunc()
 	green := color.New(color.FgGreen).SprintFunc()
@@ -179,7 +253,7 @@ func formatUsecaseText(actors []core.ActorEntity, usecases []core.UseCaseEntity,
 	sb.WriteString("============================================================\n")
 
 	// システム境界
-	boundaryName := boundary
+	boundaryName := d.Boundary
 	if boundaryName == "" {
 		boundaryName = "System"
 	}
@@ -195,9 +269,29 @@ func formatUsecaseText(actors []core.ActorEntity, usecases []core.UseCaseEntity,
 
 	// ユースケース一覧
 	fmt.Fprintf(&sb, "\n%s (%d)\n", yellow("UseCases"), len(usecases))
+	groups, rest := d.SubsystemGroups()
+	for _, g := range groups {
+		fmt.Fprintf(&sb, "\n  %s [ %s ] (%d)\n", white("Subsystem:"), g.Subsystem.Name, len(g.UseCases))
+		writeUsecaseText(&sb, g.UseCases, "    ")
+	}
+	if len(groups) > 0 && len(rest) > 0 {
+		fmt.Fprintf(&sb, "\n  %s (%d)\n", white("(no subsystem)"), len(rest))
+		writeUsecaseText(&sb, rest, "    ")
+	} else {
+		writeUsecaseText(&sb, rest, "  ")
+	}
+
+	sb.WriteString("\n============================================================\n")
+	fmt.Fprintf(&sb, "Total: %d actors, %d usecases\n", len(actors), len(usecases))
+
+	return sb.String()
+}
+
+// writeUsecaseText はユースケースとそのアクター・リレーションを indent 付きで書き出す
+func writeUsecaseText(sb *strings.Builder, usecases []core.UseCaseEntity, indent string) {
 	for _, uc := range usecases {
 		statusIcon := getUseCaseStatusIcon(uc.Status)
-		fmt.Fprintf(&sb, "  %s (%s) [%s] %s\n", statusIcon, uc.Title, uc.ID, uc.Status)
+		fmt.Fprintf(sb, indent+"%s (%s) [%s] %s\n", statusIcon, uc.Title, uc.ID, uc.Status)
 
 		// アクター関連
 		for _, actorRef := range uc.Actors {
@@ -205,102 +299,21 @@ func formatUsecaseText(actors []core.ActorEntity, usecases []core.UseCaseEntity,
 			if actorRef.Role == core.ActorRolePrimary {
 				roleIcon = "●→"
 			}
-			fmt.Fprintf(&sb, "      %s %s (%s)\n", roleIcon, actorRef.ActorID, actorRef.Role)
+			fmt.Fprintf(sb, indent+"    %s %s (%s)\n", roleIcon, actorRef.ActorID, actorRef.Role)
 		}
 
 		// リレーション
 		for _, rel := range uc.Relations {
 			relIcon := getRelationIcon(rel.Type)
-			fmt.Fprintf(&sb, "      %s %s %s\n", relIcon, rel.Type, rel.TargetID)
+			fmt.Fprintf(sb, indent+"    %s %s %s\n", relIcon, rel.Type, rel.TargetID)
 			if rel.Condition != "" {
-				fmt.Fprintf(&sb, "          condition: %s\n", rel.Condition)
+				fmt.Fprintf(sb, indent+"        condition: %s\n", rel.Condition)
 			}
 			if rel.ExtensionPoint != "" {
-				fmt.Fprintf(&sb, "          extension-point: %s\n", rel.ExtensionPoint)
-			}
-		}
-	}
-
-	sb.WriteString("\n============================================================\n")
-	fmt.Fprintf(&sb, "Total: %d actors, %d usecases\n", len(actors), len(usecases))
-
-	return sb.String()
-}
-
-// formatUsecaseMermaid は Mermaid 形式でユースケース図を生成
-func formatUsecaseMermaid(actors []core.ActorEntity, usecases []core.UseCaseEntity, boundary string) string {
-	var sb strings.Builder
-
-	// Note: Mermaid は標準でユースケース図をサポートしていないため、
-	// flowchart で近似的に表現する
-	sb.WriteString("```mermaid\n")
-	sb.WriteString("flowchart LR\n")
-
-	// システム境界
-	boundaryName := boundary
-	if boundaryName == "" {
-		boundaryName = "System"
-	}
-
-	// アクター定義（左側）
-	sb.WriteString("    %% Actors\n")
-	for _, actor := range actors {
-		// Mermaid の ID として使用するため、ハイフンをアンダースコアに置換
-		mermaidID := strings.ReplaceAll(actor.ID, "-", "_")
-		typeEmoji := getActorTypeEmoji(actor.Type)
-		fmt.Fprintf(&sb, "    %s[%s %s]\n", mermaidID, typeEmoji, escapeForMermaid(actor.Title))
-	}
-
-	// システム境界サブグラフ
-	fmt.Fprintf(&sb, "\n    subgraph %s[%s]\n", "boundary", escapeForMermaid(boundaryName))
-
-	// ユースケース定義
-	sb.WriteString("        %% UseCases\n")
-	for _, uc := range usecases {
-		mermaidID := strings.ReplaceAll(uc.ID, "-", "_")
-		fmt.Fprintf(&sb, "        %s((%s))\n", mermaidID, escapeForMermaid(uc.Title))
-	}
-
-	sb.WriteString("    end\n")
-
-	// アクターとユースケースの関連
-	sb.WriteString("\n    %% Actor-UseCase Relations\n")
-	for _, uc := range usecases {
-		ucID := strings.ReplaceAll(uc.ID, "-", "_")
-		for _, actorRef := range uc.Actors {
-			actorID := strings.ReplaceAll(actorRef.ActorID, "-", "_")
-			if actorRef.Role == core.ActorRolePrimary {
-				fmt.Fprintf(&sb, "    %s ==> %s\n", actorID, ucID)
-			} else {
-				fmt.Fprintf(&sb, "    %s --> %s\n", actorID, ucID)
+				fmt.Fprintf(sb, indent+"        extension-point: %s\n", rel.ExtensionPoint)
 			}
 		}
 	}
-
-	// ユースケース間のリレーション
-	sb.WriteString("\n    %% UseCase Relations\n")
-	for _, uc := range usecases {
-		ucID := strings.ReplaceAll(uc.ID, "-", "_")
-		for _, rel := range uc.Relations {
-			targetID := strings.ReplaceAll(rel.TargetID, "-", "_")
-			switch rel.Type {
-			case core.RelationTypeInclude:
-				fmt.Fprintf(&sb, "    %s -.->|include| %s\n", ucID, targetID)
-			case core.RelationTypeExtend:
-				label := "extend"
-				if rel.Condition != "" {
-					label = fmt.Sprintf("extend [%s]", rel.Condition)
-				}
-				fmt.Fprintf(&sb, "    %s -.->|%s| %s\n", targetID, escapeForMermaid(label), ucID)
-			case core.RelationTypeGeneralize:
-				fmt.Fprintf(&sb, "    %s -->|generalize| %s\n", ucID, targetID)
-			}
-		}
-	}
-
-	sb.WriteString("```\n")
-
-	return sb.String()
 }
 
 // getActorTypeIcon はアクタータイプのアイコンを返す
@@ -321,24 +334,6 @@ func getActorTypeIcon(t core.ActorType) string {
 	}
 }
 
-// getActorTypeEmoji はアクタータイプの絵文字を返す（Mermaid用）
-func getActorTypeEmoji(t core.ActorType) string {
-	switch t {
-	case core.ActorTypeHuman:
-		return "👤"
-	case core.ActorTypeSystem:
-		return "🖥️"
-	case core.ActorTypeTime:
-		return "⏰"
-	case core.ActorTypeDevice:
-		return "📱"
-	case core.ActorTypeExternal:
-		return "🌐"
-	default:
-		return "❓"
-	}
-}
-
 // getUseCaseStatusIcon はユースケースステータスのアイコンを返す
 func getUseCaseStatusIcon(s core.UseCaseStatus) string {
 	switch s {
@@ -367,11 +362,61 @@ func getRelationIcon(t core.RelationType) string {
 	}
 }
 
-// escapeForMermaid は Mermaid 用にエスケープ
-func escapeForMermaid(s string) string {
-	// ダブルクォートとその他の特殊文字をエスケープ
-	s = strings.ReplaceAll(s, "\"", "'")
-	s = strings.ReplaceAll(s, "<", "&lt;")
-	s = strings.ReplaceAll(s, ">", "&gt;")
-	return s
+// resolveDiagramRenderer は --engine / --format から図の Renderer を決定
+// --engine が指定されていればそれを優先し、--format mermaid は --engine mermaid と同等に扱う。
+// 図以外の形式（text, dot）の場合は nil を返す。
+func resolveDiagramRenderer(engine, format string) (diagram.Renderer, error) {
+	if engine == "" {
+		switch {
+		case format == string(diagram.FormatMermaid):
+			engine = format
+		case diagram.IsImageFormat(format):
+			// 画像出力のソースはデフォルトで Mermaid
+			engine = string(diagram.FormatMermaid)
+		default:
+			return nil, nil
+		}
+	}
+
+	f, ok := diagram.ParseFormat(engine)
+	if !ok {
+		return nil, fmt.Errorf("不明な出力エンジン: %s (mermaid, plantuml のいずれかを指定してください)", engine)
+	}
+	return diagram.NewRenderer(f)
+}
+
+// checkImageOutput は画像形式の出力先を検証（PNG は標準出力に書き出さない）
+func checkImageOutput(format, output string) error {
+	if strings.EqualFold(format, string(diagram.ImageFormatPNG)) && output == "" {
+		return fmt.Errorf("PNG 出力には -o で出力ファイルを指定してください")
+	}
+	return nil
+}
+
+// renderDiagramImage は zeus.yaml の rendering 設定に従い図のソースを画像化
+func renderDiagramImage(ctx context.Context, zeus *core.Zeus, renderer diagram.Renderer, source, format string) (string, error) {
+	var settings core.RenderingSettings
+	if config, err := zeus.LoadConfig(ctx); err == nil {
+		settings = config.Rendering
+	}
+
+	imageRenderer, err := diagram.NewImageRenderer(settings)
+	if err != nil {
+		return "", fmt.Errorf("画像レンダリング設定エラー: %w", err)
+	}
+	imageFormat, _ := diagram.ParseImageFormat(format)
+	data, err := imageRenderer.Render(ctx, source, renderer.Format(), imageFormat)
+	if err != nil {
+		return "", fmt.Errorf("画像レンダリング失敗: %w", err)
+	}
+	return string(data), nil
+}
+
+// wrapDiagramOutput は Mermaid 出力を Markdown のコードブロックで囲む
+// （既に囲まれている出力と PlantUML はそのまま返す）
+func wrapDiagramOutput(renderer diagram.Renderer, output string) string {
+	if renderer.Format() != diagram.FormatMermaid || strings.HasPrefix(output, "```") {
+		return output
+	}
+	return "```mermaid\n" + output + "```\n"
 }
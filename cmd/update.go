@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var updateCmd = &cobra.Command{
+	Use:   "update <entity> <id>",
+	Short: "エンティティのフィールドを更新",
+	Long: `エンティティのフィールドを更新します。
+
+ダッシュボードや他の利用者との同時編集で変更が失われないよう、更新には
+取得時点のリビジョン（--revision）が必要です。リビジョンが現在の内容と一致しない場合は
+更新せず、更新しようとしたフィールドの現在の値を表示します。
+
+settings.operations 等で更新に承認が必要な場合は、承認待ちキューに追加されます。
+
+--set を省略すると現在のリビジョンを表示します。
+値は YAML として解釈されます（数値、true/false、[a, b] など）。
+ネストしたフィールドはドット区切りで指定します（例: mitigation.preventive）。
+
+例:
+  zeus update risk risk-1a2b3c4d
+  zeus update risk risk-1a2b3c4d --revision 3f2a9c1d0b7e4a55 --set impact=high --set owner=alice
+  zeus update risk risk-1a2b3c4d --revision 3f2a9c1d0b7e4a55 --set impact=high --dry-run
+  zeus update risk risk-1a2b3c4d --revision 3f2a9c1d0b7e4a55 --set impact=high --preview`,
+	Args: cobra.ExactArgs(2),
+	RunE: runUpdate,
+}
+
+var (
+	updateSets     []string
+	updateRevision string
+)
+
+func init() {
+	rootCmd.AddCommand(updateCmd)
+	updateCmd.Flags().StringArrayVar(&updateSets, "set", nil, "更新するフィールド（field=value、複数回指定可）")
+	updateCmd.Flags().StringVar(&updateRevision, "revision", "", "更新元のリビジョン（--set 指定時は必須）")
+}
+
+func runUpdate(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+	entityType, id := args[0], args[1]
+
+	if len(updateSets) == 0 {
+		revision, err := zeus.EntityRevision(ctx, entityType, id)
+		if err != nil {
+			return fmt.Errorf("リビジョン取得失敗: %w", err)
+		}
+		fmt.Printf("%s %s revision: %s\n", entityType, id, revision)
+		return nil
+	}
+
+	fields, err := core.ParseFieldAssignments(updateSets)
+	if err != nil {
+		return err
+	}
+	if updateRevision == "" {
+		return fmt.Errorf("--revision を指定してください（現在のリビジョンは zeus update %s %s で確認できます）", entityType, id)
+	}
+
+	var revision, approvalID string
+	if isDryRun(cmd) {
+		var changes []core.FileChange
+		changes, err = zeus.DryRun(ctx, func(tx *core.Zeus) error {
+			_, err := tx.UpdateEntity(ctx, entityType, id, fields, updateRevision)
+			return queuedForApproval(err, &approvalID)
+		})
+		if err == nil {
+			return printFileChanges(cmd, changes)
+		}
+	} else {
+		err = mutate(cmd, zeus, func(tx *core.Zeus) error {
+			var err error
+			revision, err = tx.UpdateEntity(ctx, entityType, id, fields, updateRevision)
+			return queuedForApproval(err, &approvalID)
+		})
+	}
+	var conflict *core.RevisionConflictError
+	if errors.As(err, &conflict) {
+		red := color.New(color.FgRed).SprintFunc()
+		fmt.Printf("%s %s %s は取得後に変更されています（revision %s -> %s）\n", red("[CONFLICT]"), entityType, id, conflict.Expected, conflict.Current)
+		for _, f := range conflict.Fields {
+			fmt.Printf("  %s: current=%v, yours=%v\n", f.Field, f.Current, f.Proposed)
+		}
+		return fmt.Errorf("更新を中止しました。最新の内容を確認し、revision %s で再実行してください", conflict.Current)
+	}
+	if err != nil {
+		return fmt.Errorf("更新失敗: %w", err)
+	}
+	if approvalID != "" {
+		printQueuedForApproval(fmt.Sprintf("%s %s の更新", entityType, id), approvalID)
+		return nil
+	}
+
+	green := color.New(color.FgGreen).SprintFunc()
+	fmt.Printf("%s Updated %s %s (revision: %s)\n", green("[SUCCESS]"), entityType, id, revision)
+	return nil
+}
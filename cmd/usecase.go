@@ -22,6 +22,11 @@ var (
 	usecaseAddActorRole string
 )
 
+// usecase scaffold コマンドのフラグ
+var (
+	usecaseScaffoldTitle string
+)
+
 var usecaseCmd = &cobra.Command{
 	Use:   "usecase",
 	Short: "ユースケース操作",
@@ -65,10 +70,31 @@ extend オプション:
 	RunE: runLink,
 }
 
+var scaffoldCmd = &cobra.Command{
+	Use:   "scaffold <usecase-id>",
+	Short: "ユースケースのシナリオからアクティビティの雛形を生成",
+	Long: `ユースケースのシナリオからアクティビティの雛形を生成します。
+
+メインフローの各ステップを action ノードにして順につなぎ、
+代替フローごとに decision ノードで分岐して rejoins_at のステップの前で合流させます
+（rejoins_at が無い代替フローは終了の直前で分岐します）。
+生成したアクティビティは usecase_id でユースケースに紐付きます。
+
+オプション:
+  --title    アクティビティのタイトル（省略時はユースケースのタイトル）
+
+例:
+  zeus usecase scaffold uc-1a2b3c4d
+  zeus usecase scaffold uc-1a2b3c4d --title "ログインフロー" --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runScaffold,
+}
+
 func init() {
 	rootCmd.AddCommand(usecaseCmd)
 	usecaseCmd.AddCommand(linkCmd)
 	usecaseCmd.AddCommand(addActorCmd)
+	usecaseCmd.AddCommand(scaffoldCmd)
 
 	// link コマンドのフラグ
 	linkCmd.Flags().StringVar(&linkInclude, "include", "", "include 先 UseCase ID")
@@ -79,6 +105,44 @@ func init() {
 
 	// add-actor コマンドのフラグ
 	addActorCmd.Flags().StringVar(&usecaseAddActorRole, "role", "primary", "アクターの役割 (primary|secondary)")
+
+	// scaffold コマンドのフラグ
+	scaffoldCmd.Flags().StringVar(&usecaseScaffoldTitle, "title", "", "アクティビティのタイトル（省略時はユースケースのタイトル）")
+}
+
+func runScaffold(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	usecaseID := args[0]
+
+	zeus := getZeus(cmd)
+
+	var result *core.AddResult
+	scaffold := func(tx *core.Zeus) error {
+		var err error
+		result, err = tx.ScaffoldActivity(ctx, usecaseID, usecaseScaffoldTitle)
+		return err
+	}
+	if isDryRun(cmd) {
+		changes, err := zeus.DryRun(ctx, scaffold)
+		if err != nil {
+			return fmt.Errorf("アクティビティの生成に失敗: %w", err)
+		}
+		return printFileChanges(cmd, changes)
+	}
+	if err := mutate(cmd, zeus, scaffold); err != nil {
+		return fmt.Errorf("アクティビティの生成に失敗: %w", err)
+	}
+	if result.NeedsApproval {
+		printQueuedForApproval(fmt.Sprintf("usecase %s からのアクティビティ生成", usecaseID), result.ApprovalID)
+		return nil
+	}
+
+	// 出力
+	green := color.New(color.FgGreen).SprintFunc()
+	fmt.Printf("%s Scaffolded activity %s from usecase %s\n", green("✓"), result.ID, usecaseID)
+	fmt.Printf("  zeus activity diagram %s で確認できます\n", result.ID)
+
+	return nil
 }
 
 func runAddActor(cmd *cobra.Command, args []string) error {
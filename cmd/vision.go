@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var visionCmd = &cobra.Command{
+	Use:   "vision",
+	Short: "Vision の版の履歴を表示",
+	Long: `Vision の変更履歴（.zeus/vision/history/*.yaml）を表示します。
+
+zeus add vision や zeus update vision で Vision の内容が変わるたびに新しい版が記録されます。
+変更理由は zeus add vision の --rationale で指定します。`,
+}
+
+var visionHistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Vision の版を一覧表示",
+	Long: `Vision の版を古い順に表示します（変更日時・変更者・変更理由）。
+
+例:
+  zeus vision history
+  zeus vision history --format json`,
+	Args: cobra.NoArgs,
+	RunE: runVisionHistory,
+}
+
+var visionDiffCmd = &cobra.Command{
+	Use:   "diff [from] [to]",
+	Short: "Vision の版の差分を表示",
+	Long: `Vision の版 from から版 to への差分を表示します。
+to を省略した場合は最新版、from も省略した場合は最新版とその 1 つ前の版を比較します。
+版は番号（例: 2）または v 付き（例: v2）で指定します。
+
+例:
+  zeus vision diff
+  zeus vision diff 1
+  zeus vision diff v1 v3`,
+	Args: cobra.MaximumNArgs(2),
+	RunE: runVisionDiff,
+}
+
+func init() {
+	rootCmd.AddCommand(visionCmd)
+	visionCmd.AddCommand(visionHistoryCmd)
+	visionCmd.AddCommand(visionDiffCmd)
+}
+
+func runVisionHistory(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	revisions, err := zeus.VisionHistory(ctx)
+	if err != nil {
+		return fmt.Errorf("Vision の履歴の取得に失敗: %w", err)
+	}
+
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(revisions)
+	}
+
+	cyan := color.New(color.FgCyan).SprintFunc()
+	fmt.Println(cyan("Vision History"))
+	fmt.Println("═══════════════════════════════════════════════════════════")
+	if len(revisions) == 0 {
+		fmt.Println("No vision history.")
+		return nil
+	}
+	for _, rev := range revisions {
+		changedBy := rev.ChangedBy
+		if changedBy == "" {
+			changedBy = "-"
+		}
+		fmt.Printf("v%d  %s  %-12s %s [%s]\n", rev.Version, rev.ChangedAt, changedBy, rev.Vision.Title, rev.Vision.Status)
+		if rev.Rationale != "" {
+			fmt.Printf("    理由: %s\n", rev.Rationale)
+		}
+	}
+	return nil
+}
+
+func runVisionDiff(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	versions := make([]int, 2)
+	for i, arg := range args {
+		v, err := strconv.Atoi(strings.TrimPrefix(arg, "v"))
+		if err != nil || v < 1 {
+			return fmt.Errorf("版の指定が不正です: %s", arg)
+		}
+		versions[i] = v
+	}
+	// 版を 1 つだけ指定した場合はその版から最新版への差分
+	diff, err := zeus.VisionDiff(ctx, versions[0], versions[1])
+	if err != nil {
+		return fmt.Errorf("Vision の差分の取得に失敗: %w", err)
+	}
+	if diff == "" {
+		fmt.Println("差分はありません")
+		return nil
+	}
+	printDiff(diff)
+	return nil
+}
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// watchDefaultInterval は --watch で .zeus の変更を確認する既定の間隔
+const watchDefaultInterval = time.Second
+
+// addWatchFlags は --watch と --watch-interval を登録
+func addWatchFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("watch", false, ".zeus の変更を検知して再表示し続ける（Ctrl+C で終了）")
+	cmd.Flags().Duration("watch-interval", watchDefaultInterval, "--watch で変更を確認する間隔")
+}
+
+// runWatch は render を実行し、--watch 指定時は .zeus の変更を検知するたびに画面を消去して再表示する
+// 変更はポーリング（ファイル数・サイズ・mtime）で検知する。render のエラーは表示して待ち受けを続ける
+func runWatch(cmd *cobra.Command, zeus *core.Zeus, render func() error) error {
+	watch, _ := cmd.Flags().GetBool("watch")
+	if !watch {
+		return render()
+	}
+	interval, _ := cmd.Flags().GetDuration("watch-interval")
+	if interval <= 0 {
+		return fmt.Errorf("--watch-interval は正の値を指定してください: %s", interval)
+	}
+	format, _ := cmd.Flags().GetString("format")
+	basePath := zeus.FileStore().BasePath()
+
+	redraw := func() string {
+		if format != "json" {
+			fmt.Print("\033[H\033[2J")
+		}
+		if err := render(); err != nil {
+			fmt.Fprintln(os.Stderr, color.RedString("エラー: %v", err))
+		}
+		if format != "json" {
+			fmt.Println()
+			fmt.Println(color.New(color.Faint).Sprintf("%s 更新 / %s ごとに変更を確認（Ctrl+C で終了）",
+				time.Now().Format("15:04:05"), interval))
+		}
+		// 表示中の書き込み（ログなど）で再表示しないよう、表示後の状態を基準にする
+		return core.StoreVersion(basePath)
+	}
+
+	ctx := getContext(cmd)
+	version := redraw()
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sigCh:
+			return nil
+		case <-ticker.C:
+			if core.StoreVersion(basePath) != version {
+				version = redraw()
+			}
+		}
+	}
+}
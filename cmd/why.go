@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var whyCmd = &cobra.Command{
+	Use:   "why <from-id> <to-id>",
+	Short: "2 つのエンティティの関係（参照の経路）を表示",
+	Long: `2 つのエンティティを結ぶ参照関係の最短経路を表示します。
+
+Vision / Objective / Consideration / Decision / Problem / Risk / Assumption / Constraint /
+Quality / UseCase / Activity / Actor / Subsystem / StateMachine の全参照関係を対象にします。
+<from-id> から参照をたどって <to-id> に到達できる経路（依存関係）を優先し、
+無い場合は逆向きの参照も含む経路（共通の Objective などを介した関係）を表示します。
+
+例:
+  zeus why obj-001 dec-001
+  zeus why act-1a2b3c4d vision-001 --format json`,
+	Args: cobra.ExactArgs(2),
+	RunE: runWhy,
+}
+
+func init() {
+	rootCmd.AddCommand(whyCmd)
+}
+
+func runWhy(cmd *cobra.Command, args []string) error {
+	ctx := getContext(cmd)
+	zeus := getZeus(cmd)
+
+	path, err := zeus.FindRelationPath(ctx, args[0], args[1])
+	if err != nil {
+		return fmt.Errorf("関係の探索に失敗: %w", err)
+	}
+
+	if format, _ := cmd.Flags().GetString("format"); format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(path)
+	}
+
+	cyan := color.New(color.FgCyan).SprintFunc()
+	yellow := color.New(color.FgYellow).SprintFunc()
+	fmt.Printf("%s %s → %s\n", cyan("Why"), path.From.ID, path.To.ID)
+	fmt.Println("═══════════════════════════════════════════════════════════")
+	if !path.Found {
+		fmt.Println(yellow("2 つのエンティティを結ぶ参照関係はありません。"))
+		return nil
+	}
+	if path.Directed {
+		fmt.Printf("%s は参照をたどって %s に依存しています（%d 段）\n\n", path.From.ID, path.To.ID, len(path.Steps))
+	} else {
+		fmt.Printf("%s と %s は逆向きの参照を含む経路でつながっています（%d 段）\n\n", path.From.ID, path.To.ID, len(path.Steps))
+	}
+
+	fmt.Printf("  %s\n", formatRelationNode(path.From))
+	for _, step := range path.Steps {
+		if step.Forward {
+			fmt.Printf("    ─ %s →\n", step.Relation)
+		} else {
+			fmt.Printf("    ← %s ─\n", step.Relation)
+		}
+		fmt.Printf("  %s\n", formatRelationNode(step.To))
+	}
+	return nil
+}
+
+// formatRelationNode は関係グラフのノードを表示用に整形
+func formatRelationNode(node analysis.RelationNode) string {
+	return fmt.Sprintf("%s [%s] %s", node.ID, node.Type, node.Title)
+}
@@ -0,0 +1,207 @@
+package analysis
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// AdoptionCandidate は孤立 Activity の親候補（UseCase）
+type AdoptionCandidate struct {
+	ParentID    string   `json:"parent_id"`
+	ParentTitle string   `json:"parent_title"`
+	Score       float64  `json:"score"` // 0.0-1.0
+	Reasons     []string `json:"reasons"`
+}
+
+// AdoptionProposal は孤立 Activity ごとの親候補一覧（スコア降順）
+type AdoptionProposal struct {
+	ActivityID    string              `json:"activity_id"`
+	ActivityTitle string              `json:"activity_title"`
+	Candidates    []AdoptionCandidate `json:"candidates"`
+}
+
+// Best は最有力候補を返す（候補が同点で並ぶ場合は決定できないため false）
+func (p AdoptionProposal) Best() (AdoptionCandidate, bool) {
+	if len(p.Candidates) == 0 {
+		return AdoptionCandidate{}, false
+	}
+	if len(p.Candidates) > 1 && p.Candidates[0].Score == p.Candidates[1].Score {
+		return AdoptionCandidate{}, false
+	}
+	return p.Candidates[0], true
+}
+
+// AdoptionSuggester は孤立 Activity に親 UseCase を提案する
+// スコアはアフィニティ計算の重みを用いて、親子（UseCase タイトルとの類似度）と
+// 兄弟（UseCase に既に属する Activity との類似度）を合成する
+type AdoptionSuggester struct {
+	activities []ActivityInfo
+	usecases   []UseCaseInfo
+	weights    AffinityWeights
+}
+
+// NewAdoptionSuggester は新しい AdoptionSuggester を作成
+func NewAdoptionSuggester(activities []ActivityInfo, usecases []UseCaseInfo) *AdoptionSuggester {
+	// Activity を UseCase の子タスクとみなしてアフィニティ重みを算出
+	tasks := make([]TaskInfo, len(activities))
+	for i, act := range activities {
+		tasks[i] = TaskInfo{ID: act.ID, Title: act.Title, Status: act.Status, ParentID: act.UseCaseID}
+	}
+	weights := NewAffinityCalculator(VisionInfo{}, nil, tasks, nil, nil).CalculateWeights()
+
+	return &AdoptionSuggester{
+		activities: activities,
+		usecases:   usecases,
+		weights:    weights,
+	}
+}
+
+// Orphans は UseCase に紐づいていない（または参照先が存在しない）Activity を返す
+func (s *AdoptionSuggester) Orphans() []ActivityInfo {
+	usecaseIDs := make(map[string]bool, len(s.usecases))
+	for _, uc := range s.usecases {
+		usecaseIDs[uc.ID] = true
+	}
+	orphans := []ActivityInfo{}
+	for _, act := range s.activities {
+		if !usecaseIDs[act.UseCaseID] {
+			orphans = append(orphans, act)
+		}
+	}
+	sort.Slice(orphans, func(i, j int) bool { return orphans[i].ID < orphans[j].ID })
+	return orphans
+}
+
+// Suggest は孤立 Activity ごとに親候補を最大 limit 件提案（limit <= 0 は無制限）
+// スコアが 0 の候補は含めない
+func (s *AdoptionSuggester) Suggest(ctx context.Context, limit int) ([]AdoptionProposal, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// UseCase ごとの既存 Activity のトークン
+	siblingTokens := make(map[string][]map[string]bool)
+	for _, act := range s.activities {
+		if act.UseCaseID != "" {
+			siblingTokens[act.UseCaseID] = append(siblingTokens[act.UseCaseID], titleTokens(act.Title))
+		}
+	}
+
+	total := s.weights.ParentChild + s.weights.Sibling
+	proposals := []AdoptionProposal{}
+	for _, orphan := range s.Orphans() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		tokens := titleTokens(orphan.Title)
+		candidates := []AdoptionCandidate{}
+		for _, uc := range s.usecases {
+			var reasons []string
+
+			parentSim := diceSimilarity(tokens, titleTokens(uc.Title))
+			if parentSim > 0 {
+				reasons = append(reasons, "UseCase タイトルと類似")
+			}
+
+			siblingSim := 0.0
+			for _, sibling := range siblingTokens[uc.ID] {
+				if sim := diceSimilarity(tokens, sibling); sim > siblingSim {
+					siblingSim = sim
+				}
+			}
+			if siblingSim > 0 {
+				reasons = append(reasons, "同 UseCase の Activity と類似")
+			}
+
+			score := (s.weights.ParentChild*parentSim + s.weights.Sibling*siblingSim) / total
+			if score <= 0 {
+				continue
+			}
+			candidates = append(candidates, AdoptionCandidate{
+				ParentID:    uc.ID,
+				ParentTitle: uc.Title,
+				Score:       score,
+				Reasons:     reasons,
+			})
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if candidates[i].Score != candidates[j].Score {
+				return candidates[i].Score > candidates[j].Score
+			}
+			return candidates[i].ParentID < candidates[j].ParentID
+		})
+		if limit > 0 && len(candidates) > limit {
+			candidates = candidates[:limit]
+		}
+
+		proposals = append(proposals, AdoptionProposal{
+			ActivityID:    orphan.ID,
+			ActivityTitle: orphan.Title,
+			Candidates:    candidates,
+		})
+	}
+
+	return proposals, nil
+}
+
+// titleTokens はタイトルをトークン集合に変換
+// ASCII の英数字は単語単位、それ以外（日本語など）は文字 bigram 単位で分割する
+func titleTokens(title string) map[string]bool {
+	tokens := make(map[string]bool)
+	var word []rune
+	var text []rune
+
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens[strings.ToLower(string(word))] = true
+			word = word[:0]
+		}
+	}
+	flushText := func() {
+		switch {
+		case len(text) == 1:
+			tokens[string(text)] = true
+		case len(text) > 1:
+			for i := 0; i+1 < len(text); i++ {
+				tokens[string(text[i:i+2])] = true
+			}
+		}
+		text = text[:0]
+	}
+
+	for _, r := range title {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			flushText()
+			word = append(word, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushWord()
+			text = append(text, r)
+		default:
+			flushWord()
+			flushText()
+		}
+	}
+	flushWord()
+	flushText()
+
+	return tokens
+}
+
+// diceSimilarity は 2 つのトークン集合の Dice 係数（0.0-1.0）を返す
+func diceSimilarity(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	common := 0
+	for t := range a {
+		if b[t] {
+			common++
+		}
+	}
+	return 2 * float64(common) / float64(len(a)+len(b))
+}
@@ -0,0 +1,78 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAdoptionSuggester_Suggest(t *testing.T) {
+	activities := []ActivityInfo{
+		{ID: "act-001", Title: "ログイン画面を表示", UseCaseID: "uc-login"},
+		{ID: "act-002", Title: "ログイン失敗時の処理", UseCaseID: ""},
+		{ID: "act-003", Title: "Export report as CSV", UseCaseID: "uc-missing"},
+		{ID: "act-004", Title: "xyz", UseCaseID: ""},
+	}
+	usecases := []UseCaseInfo{
+		{ID: "uc-login", Title: "ログイン"},
+		{ID: "uc-report", Title: "Export report"},
+	}
+
+	s := NewAdoptionSuggester(activities, usecases)
+
+	orphans := s.Orphans()
+	if len(orphans) != 3 {
+		t.Fatalf("孤立 Activity は 3 件のはず: %+v", orphans)
+	}
+
+	proposals, err := s.Suggest(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("Suggest でエラー: %v", err)
+	}
+	if len(proposals) != 3 {
+		t.Fatalf("提案は 3 件のはず: %+v", proposals)
+	}
+
+	byID := make(map[string]AdoptionProposal)
+	for _, p := range proposals {
+		byID[p.ActivityID] = p
+	}
+
+	best, ok := byID["act-002"].Best()
+	if !ok || best.ParentID != "uc-login" {
+		t.Errorf("act-002 の最有力候補は uc-login のはず: %+v", byID["act-002"])
+	}
+	if len(best.Reasons) != 2 {
+		t.Errorf("親子と兄弟の両方が理由に含まれるべき: %v", best.Reasons)
+	}
+
+	best, ok = byID["act-003"].Best()
+	if !ok || best.ParentID != "uc-report" {
+		t.Errorf("act-003 の最有力候補は uc-report のはず: %+v", byID["act-003"])
+	}
+	if best.Score <= 0 || best.Score > 1 {
+		t.Errorf("スコアは 0-1 の範囲のはず: %f", best.Score)
+	}
+
+	if _, ok := byID["act-004"].Best(); ok {
+		t.Errorf("類似性のない Activity には候補がないはず: %+v", byID["act-004"])
+	}
+}
+
+func TestAdoptionProposal_BestTie(t *testing.T) {
+	p := AdoptionProposal{Candidates: []AdoptionCandidate{
+		{ParentID: "uc-a", Score: 0.5},
+		{ParentID: "uc-b", Score: 0.5},
+	}}
+	if _, ok := p.Best(); ok {
+		t.Error("同点の場合は決定できないはず")
+	}
+}
+
+func TestTitleTokens(t *testing.T) {
+	tokens := titleTokens("Export レポート")
+	for _, want := range []string{"export", "レポ", "ポー", "ート"} {
+		if !tokens[want] {
+			t.Errorf("トークン %q が含まれていません: %v", want, tokens)
+		}
+	}
+}
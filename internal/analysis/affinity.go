@@ -2,8 +2,13 @@
 package analysis
 
 import (
+	"container/heap"
 	"context"
+	"fmt"
+	"runtime"
 	"sort"
+	"sync"
+	"sync/atomic"
 )
 
 // AffinityType は関連の種類
@@ -55,7 +60,9 @@ type AffinityStats struct {
 	ClusterCount   int     `json:"cluster_count"`
 	AvgConnections float64 `json:"avg_connections"`
 	FilteredEdges  int     `json:"filtered_edges,omitempty"` // フィルタリングで除外されたエッジ数
+	DroppedEdges   int     `json:"dropped_edges,omitempty"`  // うち max_edges を超えたために除外されたエッジ数
 	UsedHubMode    bool    `json:"used_hub_mode,omitempty"`  // ハブモードを使用したか
+	Approximated   bool    `json:"approximated,omitempty"`   // 近似モードでバケット内のペアのみ生成したか
 }
 
 // AffinityOptions は計算オプション
@@ -66,6 +73,74 @@ type AffinityOptions struct {
 	MinScore float64 `json:"min_score"`
 	// MaxEdges は最大エッジ数（デフォルト: 0 = 無制限）
 	MaxEdges int `json:"max_edges"`
+	// MinClusterSize はクラスタとして出力する最小メンバー数（デフォルト: 0 = 制限なし）
+	MinClusterSize int `json:"min_cluster_size,omitempty"`
+	// Weights は自動算出した重みを上書きする値（nil の項目は自動算出値を使用）
+	Weights AffinityWeightOverrides `json:"weights,omitempty"`
+	// Workers は兄弟ペアの生成・スコア計算・クラスタ構築の並列数（デフォルト: 0 = GOMAXPROCS）
+	Workers int `json:"workers,omitempty"`
+	// Approximate は MaxSiblings を超える兄弟グループで、ハブ経由の接続の代わりに
+	// タイトル順で隣り合う兄弟をまとめたバケット内のペアのみを生成する（大規模プロジェクト向けの近似）
+	Approximate bool `json:"approximate,omitempty"`
+}
+
+// AffinityWeightOverrides は関連タイプの重みの上書き値（0.0-1.0）
+type AffinityWeightOverrides struct {
+	ParentChild *float64 `json:"parent_child,omitempty"`
+	Sibling     *float64 `json:"sibling,omitempty"`
+	Reference   *float64 `json:"reference,omitempty"`
+	Category    *float64 `json:"category,omitempty"`
+}
+
+// apply は上書き値を重みに反映
+func (o AffinityWeightOverrides) apply(w AffinityWeights) AffinityWeights {
+	if o.ParentChild != nil {
+		w.ParentChild = *o.ParentChild
+	}
+	if o.Sibling != nil {
+		w.Sibling = *o.Sibling
+	}
+	if o.Reference != nil {
+		w.Reference = *o.Reference
+	}
+	if o.Category != nil {
+		w.Category = *o.Category
+	}
+	return w
+}
+
+// Validate はオプションの値域を検証
+func (o AffinityOptions) Validate() error {
+	if o.MaxSiblings < 0 {
+		return fmt.Errorf("max_siblings must be >= 0: %d", o.MaxSiblings)
+	}
+	if o.MinScore < 0 || o.MinScore > 1 {
+		return fmt.Errorf("min_score must be between 0.0 and 1.0: %g", o.MinScore)
+	}
+	if o.MaxEdges < 0 {
+		return fmt.Errorf("max_edges must be >= 0: %d", o.MaxEdges)
+	}
+	if o.MinClusterSize < 0 {
+		return fmt.Errorf("min_cluster_size must be >= 0: %d", o.MinClusterSize)
+	}
+	if o.Workers < 0 {
+		return fmt.Errorf("workers must be >= 0: %d", o.Workers)
+	}
+	weights := []struct {
+		name  string
+		value *float64
+	}{
+		{"parent_child", o.Weights.ParentChild},
+		{"sibling", o.Weights.Sibling},
+		{"reference", o.Weights.Reference},
+		{"category", o.Weights.Category},
+	}
+	for _, w := range weights {
+		if w.value != nil && (*w.value < 0 || *w.value > 1) {
+			return fmt.Errorf("weights.%s must be between 0.0 and 1.0: %g", w.name, *w.value)
+		}
+	}
+	return nil
 }
 
 // DefaultAffinityOptions はデフォルトのオプションを返す
@@ -88,13 +163,14 @@ type AffinityResult struct {
 
 // AffinityCalculator は類似度を計算
 type AffinityCalculator struct {
-	vision      VisionInfo
-	objectives  []ObjectiveInfo
-	tasks       []TaskInfo
-	quality     []QualityInfo
-	risks       []RiskInfo
-	options     AffinityOptions
-	usedHubMode bool // ハブモードを使用したかどうか
+	vision       VisionInfo
+	objectives   []ObjectiveInfo
+	tasks        []TaskInfo
+	quality      []QualityInfo
+	risks        []RiskInfo
+	options      AffinityOptions
+	usedHubMode  bool // ハブモードを使用したかどうか
+	approximated bool // 近似モードでバケットに分けたかどうか
 }
 
 // NewAffinityCalculator はコンストラクタ
@@ -139,6 +215,8 @@ func NewAffinityCalculatorWithOptions(
 }
 
 // Calculate はアフィニティを計算
+// 兄弟ペアの生成・スコア計算・クラスタ構築はワーカープールで並列に行い、
+// ctx がキャンセルされた場合は計算の途中でも中断して ctx.Err() を返す
 func (ac *AffinityCalculator) Calculate(ctx context.Context) (*AffinityResult, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
@@ -148,26 +226,36 @@ func (ac *AffinityCalculator) Calculate(ctx context.Context) (*AffinityResult, e
 	nodes := ac.buildNodes()
 
 	// エッジを検出
-	edges := ac.detectAllEdges()
+	edges, err := ac.detectAllEdges(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	// 重みを計算
-	weights := ac.CalculateWeights()
+	// 重みを計算（設定による上書きを反映）
+	weights := ac.options.Weights.apply(ac.CalculateWeights())
 
 	// スコアを計算
-	edges = ac.calculateScores(edges, weights)
+	if edges, err = ac.calculateScores(ctx, edges, weights); err != nil {
+		return nil, err
+	}
 
 	// エッジをフィルタリング
 	originalEdgeCount := len(edges)
-	edges = ac.filterEdges(edges)
+	edges, droppedCount := ac.filterEdges(edges)
 	filteredCount := originalEdgeCount - len(edges)
 
 	// クラスタを構築
-	clusters := ac.buildClusters(edges)
+	clusters, err := ac.buildClusters(ctx, edges)
+	if err != nil {
+		return nil, err
+	}
 
 	// 統計を計算
 	stats := ac.calculateStats(nodes, edges, clusters)
 	stats.FilteredEdges = filteredCount
+	stats.DroppedEdges = droppedCount
 	stats.UsedHubMode = ac.usedHubMode
+	stats.Approximated = ac.approximated
 
 	return &AffinityResult{
 		Nodes:    nodes,
@@ -178,29 +266,106 @@ func (ac *AffinityCalculator) Calculate(ctx context.Context) (*AffinityResult, e
 	}, nil
 }
 
-// filterEdges はスコア閾値と最大数でエッジをフィルタリング
-func (ac *AffinityCalculator) filterEdges(edges []AffinityEdge) []AffinityEdge {
-	// スコア閾値でフィルタリング
-	if ac.options.MinScore > 0 {
-		filtered := make([]AffinityEdge, 0, len(edges))
-		for _, e := range edges {
-			if e.Score >= ac.options.MinScore {
-				filtered = append(filtered, e)
+// workers は並列数（Workers が 0 の場合は GOMAXPROCS）
+func (ac *AffinityCalculator) workers() int {
+	if ac.options.Workers > 0 {
+		return ac.options.Workers
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// parallelFor は fn(0..n-1) をワーカープールで実行する
+// 各ワーカーは次の要素を取る前に ctx を確認し、キャンセルされていれば残りを実行せずに ctx.Err() を返す
+func parallelFor(ctx context.Context, workers, n int, fn func(i int)) error {
+	if workers > n {
+		workers = n
+	}
+	var (
+		wg   sync.WaitGroup
+		next atomic.Int64
+	)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				i := int(next.Add(1)) - 1
+				if i >= n {
+					return
+				}
+				fn(i)
 			}
+		}()
+	}
+	wg.Wait()
+	return ctx.Err()
+}
+
+// filterEdges はスコア閾値と最大数でエッジをフィルタリングし、max_edges を超えて除外した数を返す
+// max_edges を指定した場合は全エッジをソートせず、大きさ max_edges のヒープでスコア上位を選ぶ
+// （O(n log k)、追加のメモリは k 件分）。結果はスコアの降順、同点は検出順
+func (ac *AffinityCalculator) filterEdges(edges []AffinityEdge) ([]AffinityEdge, int) {
+	limit := ac.options.MaxEdges
+	if limit <= 0 {
+		// スコア閾値でフィルタリング
+		if ac.options.MinScore > 0 {
+			filtered := make([]AffinityEdge, 0, len(edges))
+			for _, e := range edges {
+				if e.Score >= ac.options.MinScore {
+					filtered = append(filtered, e)
+				}
+			}
+			edges = filtered
 		}
-		edges = filtered
+		return edges, 0
 	}
 
-	// 最大エッジ数でフィルタリング（スコア上位を優先）
-	if ac.options.MaxEdges > 0 && len(edges) > ac.options.MaxEdges {
-		// スコアでソート（降順）
-		sort.Slice(edges, func(i, j int) bool {
-			return edges[i].Score > edges[j].Score
-		})
-		edges = edges[:ac.options.MaxEdges]
+	top := &edgeHeap{edges: edges, indices: make([]int, 0, min(limit, len(edges)))}
+	candidates := 0
+	for i, e := range edges {
+		if e.Score < ac.options.MinScore {
+			continue
+		}
+		candidates++
+		switch {
+		case len(top.indices) < limit:
+			heap.Push(top, i)
+		case top.less(top.indices[0], i):
+			// ヒープの最小（最も順位の低いエッジ）より上位なら入れ替える
+			top.indices[0] = i
+			heap.Fix(top, 0)
+		}
 	}
 
-	return edges
+	selected := make([]AffinityEdge, len(top.indices))
+	for n := len(top.indices) - 1; n >= 0; n-- {
+		selected[n] = edges[heap.Pop(top).(int)]
+	}
+	return selected, candidates - len(selected)
+}
+
+// edgeHeap はエッジのインデックスの最小ヒープ（先頭が最も順位の低いエッジ）
+type edgeHeap struct {
+	edges   []AffinityEdge
+	indices []int
+}
+
+// less は i のエッジが j より順位が低いか（スコアが低い、同点なら後に検出された方が低い）
+func (h *edgeHeap) less(i, j int) bool {
+	if h.edges[i].Score != h.edges[j].Score {
+		return h.edges[i].Score < h.edges[j].Score
+	}
+	return i > j
+}
+
+func (h *edgeHeap) Len() int           { return len(h.indices) }
+func (h *edgeHeap) Less(a, b int) bool { return h.less(h.indices[a], h.indices[b]) }
+func (h *edgeHeap) Swap(a, b int)      { h.indices[a], h.indices[b] = h.indices[b], h.indices[a] }
+func (h *edgeHeap) Push(x any)         { h.indices = append(h.indices, x.(int)) }
+func (h *edgeHeap) Pop() any {
+	last := h.indices[len(h.indices)-1]
+	h.indices = h.indices[:len(h.indices)-1]
+	return last
 }
 
 // buildNodes は全エンティティからノードを構築
@@ -240,50 +405,40 @@ func (ac *AffinityCalculator) buildNodes() []AffinityNode {
 	return nodes
 }
 
-// detectAllEdges は全関連タイプのエッジを検出
-func (ac *AffinityCalculator) detectAllEdges() []AffinityEdge {
-	edgeMap := make(map[string]*AffinityEdge) // source-target -> edge
-
-	// 親子関係
-	for _, e := range ac.detectParentChild() {
-		key := e.Source + "-" + e.Target
-		if existing, ok := edgeMap[key]; ok {
-			existing.Types = append(existing.Types, e.Types...)
-		} else {
-			edgeCopy := e
-			edgeMap[key] = &edgeCopy
-		}
+// detectAllEdges は全関連タイプのエッジを検出（同じ組の関連はタイプをまとめ、検出した順に返す）
+func (ac *AffinityCalculator) detectAllEdges(ctx context.Context) ([]AffinityEdge, error) {
+	siblings, err := ac.detectSibling(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	// 兄弟関係
-	for _, e := range ac.detectSibling() {
-		key := e.Source + "-" + e.Target
-		if existing, ok := edgeMap[key]; ok {
-			existing.Types = append(existing.Types, e.Types...)
-		} else {
+	edgeMap := make(map[string]*AffinityEdge) // source-target -> edge
+	keys := []string{}
+	merge := func(edges []AffinityEdge) {
+		for _, e := range edges {
+			key := e.Source + "-" + e.Target
+			if existing, ok := edgeMap[key]; ok {
+				existing.Types = append(existing.Types, e.Types...)
+				continue
+			}
 			edgeCopy := e
 			edgeMap[key] = &edgeCopy
+			keys = append(keys, key)
 		}
 	}
 
-	// 参照関係
-	for _, e := range ac.detectReference() {
-		key := e.Source + "-" + e.Target
-		if existing, ok := edgeMap[key]; ok {
-			existing.Types = append(existing.Types, e.Types...)
-		} else {
-			edgeCopy := e
-			edgeMap[key] = &edgeCopy
-		}
-	}
+	// 親子関係・兄弟関係・参照関係
+	merge(ac.detectParentChild())
+	merge(siblings)
+	merge(ac.detectReference())
 
 	// マップからスライスに変換
-	edges := make([]AffinityEdge, 0, len(edgeMap))
-	for _, e := range edgeMap {
-		edges = append(edges, *e)
+	edges := make([]AffinityEdge, 0, len(keys))
+	for _, key := range keys {
+		edges = append(edges, *edgeMap[key])
 	}
 
-	return edges
+	return edges, nil
 }
 
 // detectParentChild は親子関係を検出
@@ -317,58 +472,125 @@ func (ac *AffinityCalculator) detectParentChild() []AffinityEdge {
 	return edges
 }
 
-// detectSibling は兄弟関係を検出
-// 兄弟数が閾値（MaxSiblings）を超える場合はハブモードに切り替え
-func (ac *AffinityCalculator) detectSibling() []AffinityEdge {
-	edges := []AffinityEdge{}
+// siblingGroup は同じ親を持つ兄弟と、生成した兄弟エッジ
+type siblingGroup struct {
+	parentID     string
+	ids          []string
+	edges        []AffinityEdge
+	hub          bool
+	approximated bool
+}
 
+// detectSibling は兄弟関係を検出
+// 兄弟数が閾値（MaxSiblings）を超える場合はハブモード（Approximate の場合はバケット）に切り替え
+// 親ごとのペア生成はワーカープールで並列に行い、結果は親 ID 順に連結する
+func (ac *AffinityCalculator) detectSibling(ctx context.Context) ([]AffinityEdge, error) {
 	// 同じ親を持つ Task
 	parentTasks := make(map[string][]string)
+	titles := make(map[string]string, len(ac.tasks))
 	for _, task := range ac.tasks {
+		titles[task.ID] = task.Title
 		if task.ParentID != "" {
 			parentTasks[task.ParentID] = append(parentTasks[task.ParentID], task.ID)
 		}
 	}
-	for parentID, taskIDs := range parentTasks {
-		edges = append(edges, ac.createSiblingEdges(taskIDs, parentID)...)
+	groups := make([]siblingGroup, 0, len(parentTasks))
+	for parentID, ids := range parentTasks {
+		groups = append(groups, siblingGroup{parentID: parentID, ids: ids})
 	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].parentID < groups[j].parentID })
 
-	return edges
+	err := parallelFor(ctx, ac.workers(), len(groups), func(i int) {
+		g := &groups[i]
+		g.edges, g.hub, g.approximated = ac.createSiblingEdges(ctx, g.ids, g.parentID, titles)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	edges := []AffinityEdge{}
+	for _, g := range groups {
+		edges = append(edges, g.edges...)
+		ac.usedHubMode = ac.usedHubMode || g.hub
+		ac.approximated = ac.approximated || g.approximated
+	}
+	return edges, nil
 }
 
-// createSiblingEdges は兄弟エッジを作成（ハブモード対応）
-// ids が閾値を超える場合は全ペアではなくハブノードを介した接続に切り替え
-func (ac *AffinityCalculator) createSiblingEdges(ids []string, parentID string) []AffinityEdge {
+// createSiblingEdges は兄弟エッジを作成（ハブモード・近似モード対応）
+// ids が閾値を超える場合は全ペアではなく、ハブノードを介した接続（Approximate の場合はバケット内のペア）に切り替え
+// 戻り値の hub・approximated はそれぞれのモードを使用したか
+func (ac *AffinityCalculator) createSiblingEdges(ctx context.Context, ids []string, parentID string, titles map[string]string) (edges []AffinityEdge, hub, approximated bool) {
 	if len(ids) < 2 {
-		return nil
+		return nil, false, false
 	}
 
-	edges := []AffinityEdge{}
-
-	// 閾値を超える場合はハブモード
+	// 閾値を超える場合
 	if len(ids) > ac.options.MaxSiblings {
-		ac.usedHubMode = true
-		// 最初の要素をハブとして使用
-		hub := ids[0]
+		if ac.options.Approximate {
+			return ac.createBucketedSiblingEdges(ctx, ids, parentID, titles), false, true
+		}
+		// ハブモード: 最初の要素をハブとして使用
+		hubID := ids[0]
 		for _, id := range ids[1:] {
 			edges = append(edges, AffinityEdge{
-				Source: hub,
+				Source: hubID,
 				Target: id,
 				Types:  []AffinityType{AffinitySibling},
 				Reason: "同じ " + parentID + " に属する（ハブ経由）",
 			})
 		}
-		return edges
+		return edges, true, false
 	}
 
 	// 通常モード: 全ペア生成
+	return siblingPairs(ctx, ids, "同じ "+parentID+" に属する"), false, false
+}
+
+// createBucketedSiblingEdges は近似モードの兄弟エッジを作成
+// 兄弟をタイトル順（同じタイトルは ID 順）で並べて MaxSiblings 件ずつのバケットに分け、
+// 同じバケット内のペアのみを生成する（ペア数は O(n × MaxSiblings)）。
+// バケット同士は先頭の要素をつないで、兄弟グループ全体が連結したままになるようにする
+func (ac *AffinityCalculator) createBucketedSiblingEdges(ctx context.Context, ids []string, parentID string, titles map[string]string) []AffinityEdge {
+	sorted := append([]string(nil), ids...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if titles[sorted[i]] != titles[sorted[j]] {
+			return titles[sorted[i]] < titles[sorted[j]]
+		}
+		return sorted[i] < sorted[j]
+	})
+
+	size := max(ac.options.MaxSiblings, 2)
+	reason := "同じ " + parentID + " に属する（近似）"
+	edges := []AffinityEdge{}
+	for start := 0; start < len(sorted) && ctx.Err() == nil; start += size {
+		bucket := sorted[start:min(start+size, len(sorted))]
+		if start > 0 {
+			edges = append(edges, AffinityEdge{
+				Source: sorted[start-size],
+				Target: bucket[0],
+				Types:  []AffinityType{AffinitySibling},
+				Reason: reason,
+			})
+		}
+		edges = append(edges, siblingPairs(ctx, bucket, reason)...)
+	}
+	return edges
+}
+
+// siblingPairs は ids の全ペアの兄弟エッジを作成（ctx がキャンセルされた場合は途中で打ち切る）
+func siblingPairs(ctx context.Context, ids []string, reason string) []AffinityEdge {
+	edges := []AffinityEdge{}
 	for i := range len(ids) {
+		if ctx.Err() != nil {
+			return edges
+		}
 		for j := i + 1; j < len(ids); j++ {
 			edges = append(edges, AffinityEdge{
 				Source: ids[i],
 				Target: ids[j],
 				Types:  []AffinityType{AffinitySibling},
-				Reason: "同じ " + parentID + " に属する",
+				Reason: reason,
 			})
 		}
 	}
@@ -460,46 +682,111 @@ func (ac *AffinityCalculator) CalculateWeights() AffinityWeights {
 	}
 }
 
-// calculateScores はエッジのスコアを計算
-func (ac *AffinityCalculator) calculateScores(edges []AffinityEdge, weights AffinityWeights) []AffinityEdge {
-	for i := range edges {
-		score := 0.0
-		for _, t := range edges[i].Types {
-			switch t {
-			case AffinityParentChild:
-				score += weights.ParentChild
-			case AffinitySibling:
-				score += weights.Sibling
-			case AffinityReference:
-				score += weights.Reference
-			case AffinityCategory:
-				score += weights.Category
-			}
+// affinityScoreChunk はスコア計算で 1 つのワーカーがまとめて処理するエッジ数
+const affinityScoreChunk = 1024
+
+// calculateScores はエッジのスコアを計算（一定数ずつのチャンクに分けて並列に計算）
+func (ac *AffinityCalculator) calculateScores(ctx context.Context, edges []AffinityEdge, weights AffinityWeights) ([]AffinityEdge, error) {
+	chunks := (len(edges) + affinityScoreChunk - 1) / affinityScoreChunk
+	err := parallelFor(ctx, ac.workers(), chunks, func(c int) {
+		for i := c * affinityScoreChunk; i < min((c+1)*affinityScoreChunk, len(edges)); i++ {
+			edges[i].Score = edgeScore(edges[i].Types, weights)
 		}
-		// 正規化（最大 1.0）
-		if score > 1.0 {
-			score = 1.0
+	})
+	if err != nil {
+		return nil, err
+	}
+	return edges, nil
+}
+
+// edgeScore は関連タイプの重みを合計したスコア（最大 1.0）
+func edgeScore(types []AffinityType, weights AffinityWeights) float64 {
+	score := 0.0
+	for _, t := range types {
+		switch t {
+		case AffinityParentChild:
+			score += weights.ParentChild
+		case AffinitySibling:
+			score += weights.Sibling
+		case AffinityReference:
+			score += weights.Reference
+		case AffinityCategory:
+			score += weights.Category
 		}
-		edges[i].Score = score
 	}
-	return edges
+	// 正規化（最大 1.0）
+	if score > 1.0 {
+		score = 1.0
+	}
+	return score
 }
 
 // buildClusters はノードをクラスタリング
-// Objective ごとにクラスタを構築（親子階層廃止後はフラットな構造）
-func (ac *AffinityCalculator) buildClusters(_ []AffinityEdge) []AffinityCluster {
-	clusters := []AffinityCluster{}
-
-	// 各 Objective を単独クラスタとして扱う
+// Objective ごとにクラスタを構築し、親子・参照エッジで Objective に直結するノードをメンバーに含める
+// （フィルタリング後のエッジを使うため、min_score 未満の関連はメンバーにならない）
+// メンバー数が min_cluster_size 未満のクラスタは出力しない
+// 親子・参照エッジの隣接リストを先に作り、Objective ごとのメンバー収集を並列に行う
+func (ac *AffinityCalculator) buildClusters(ctx context.Context, edges []AffinityEdge) ([]AffinityCluster, error) {
+	objectiveIDs := make(map[string]bool, len(ac.objectives))
 	for _, obj := range ac.objectives {
-		clusters = append(clusters, AffinityCluster{
+		objectiveIDs[obj.ID] = true
+	}
+	neighbors := make(map[string][]string)
+	for _, e := range edges {
+		if !hasStructuralType(e.Types) {
+			continue
+		}
+		if objectiveIDs[e.Source] {
+			neighbors[e.Source] = append(neighbors[e.Source], e.Target)
+		}
+		if objectiveIDs[e.Target] {
+			neighbors[e.Target] = append(neighbors[e.Target], e.Source)
+		}
+	}
+
+	built := make([]*AffinityCluster, len(ac.objectives))
+	err := parallelFor(ctx, ac.workers(), len(ac.objectives), func(i int) {
+		obj := ac.objectives[i]
+		seen := map[string]bool{obj.ID: true, "vision": true}
+		related := []string{}
+		for _, other := range neighbors[obj.ID] {
+			if !seen[other] {
+				seen[other] = true
+				related = append(related, other)
+			}
+		}
+		sort.Strings(related)
+
+		if len(related)+1 < ac.options.MinClusterSize {
+			return
+		}
+		built[i] = &AffinityCluster{
 			ID:      "cluster-" + obj.ID,
 			Name:    obj.Title,
-			Members: []string{obj.ID},
-		})
+			Members: append([]string{obj.ID}, related...),
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return clusters
+	clusters := []AffinityCluster{}
+	for _, c := range built {
+		if c != nil {
+			clusters = append(clusters, *c)
+		}
+	}
+	return clusters, nil
+}
+
+// hasStructuralType は親子または参照の関連を含むか判定
+func hasStructuralType(types []AffinityType) bool {
+	for _, t := range types {
+		if t == AffinityParentChild || t == AffinityReference {
+			return true
+		}
+	}
+	return false
 }
 
 // calculateStats は統計情報を計算
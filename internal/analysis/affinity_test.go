@@ -2,6 +2,10 @@ package analysis
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
 	"testing"
 	"time"
 )
@@ -375,6 +379,99 @@ func TestAffinityCalculator_Clusters(t *testing.T) {
 	}
 }
 
+func TestAffinityCalculator_ClusterMembers(t *testing.T) {
+	objectives := []ObjectiveInfo{
+		{ID: "obj-001", Title: "目標1"},
+		{ID: "obj-002", Title: "目標2"},
+	}
+	quality := []QualityInfo{{ID: "qual-001", Title: "品質", ObjectiveID: "obj-001"}}
+	risks := []RiskInfo{{ID: "risk-001", Title: "リスク", ObjectiveID: "obj-001"}}
+
+	calc := NewAffinityCalculator(VisionInfo{Title: "Vision"}, objectives, nil, quality, risks)
+	result, err := calc.Calculate(context.Background())
+	if err != nil {
+		t.Fatalf("Calculate failed: %v", err)
+	}
+
+	members := make(map[string][]string)
+	for _, c := range result.Clusters {
+		members[c.ID] = c.Members
+	}
+	want := []string{"obj-001", "qual-001", "risk-001"}
+	if got := members["cluster-obj-001"]; len(got) != len(want) || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+		t.Errorf("cluster-obj-001 のメンバーが正しくありません: got %v, want %v", got, want)
+	}
+	if got := members["cluster-obj-002"]; len(got) != 1 {
+		t.Errorf("Vision はメンバーに含めないはず: %v", got)
+	}
+}
+
+func TestAffinityCalculator_WeightOverridesAndMinClusterSize(t *testing.T) {
+	objectives := []ObjectiveInfo{
+		{ID: "obj-001", Title: "目標1"},
+		{ID: "obj-002", Title: "目標2"},
+	}
+	quality := []QualityInfo{{ID: "qual-001", Title: "品質", ObjectiveID: "obj-001"}}
+
+	reference := 0.9
+	options := DefaultAffinityOptions()
+	options.Weights.Reference = &reference
+	options.MinClusterSize = 2
+
+	calc := NewAffinityCalculatorWithOptions(VisionInfo{}, objectives, nil, quality, nil, options)
+	result, err := calc.Calculate(context.Background())
+	if err != nil {
+		t.Fatalf("Calculate failed: %v", err)
+	}
+
+	if result.Weights.Reference != 0.9 {
+		t.Errorf("Reference weight should be overridden: got %f", result.Weights.Reference)
+	}
+	if result.Weights.ParentChild != 1.0 {
+		t.Errorf("ParentChild weight should keep the calculated value: got %f", result.Weights.ParentChild)
+	}
+	for _, e := range result.Edges {
+		if e.Source == "qual-001" || e.Target == "qual-001" {
+			if e.Score != 0.9 {
+				t.Errorf("Reference edge score should use overridden weight: got %f", e.Score)
+			}
+		}
+	}
+
+	if len(result.Clusters) != 1 || result.Clusters[0].ID != "cluster-obj-001" {
+		t.Errorf("min_cluster_size 未満のクラスタは除外されるべき: %+v", result.Clusters)
+	}
+}
+
+func TestAffinityOptions_Validate(t *testing.T) {
+	over := 1.5
+	negative := -0.1
+
+	tests := []struct {
+		name    string
+		modify  func(o *AffinityOptions)
+		wantErr bool
+	}{
+		{"default", func(o *AffinityOptions) {}, false},
+		{"min_score over", func(o *AffinityOptions) { o.MinScore = 1.2 }, true},
+		{"negative max_edges", func(o *AffinityOptions) { o.MaxEdges = -1 }, true},
+		{"negative min_cluster_size", func(o *AffinityOptions) { o.MinClusterSize = -1 }, true},
+		{"weight over", func(o *AffinityOptions) { o.Weights.Sibling = &over }, true},
+		{"weight negative", func(o *AffinityOptions) { o.Weights.Category = &negative }, true},
+		{"negative workers", func(o *AffinityOptions) { o.Workers = -1 }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := DefaultAffinityOptions()
+			tt.modify(&options)
+			if err := options.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 // ===== 統計情報テスト =====
 
 func TestAffinityCalculator_Stats(t *testing.T) {
@@ -490,6 +587,67 @@ func TestAffinityCalculator_MaxEdgesFilter(t *testing.T) {
 	}
 }
 
+func TestAffinityCalculator_FilterEdgesTopK(t *testing.T) {
+	edges := []AffinityEdge{}
+	for i := range 50 {
+		edges = append(edges, AffinityEdge{Source: fmt.Sprintf("s%02d", i), Target: "t", Score: float64((i*37)%10) / 10})
+	}
+
+	tests := []struct {
+		name        string
+		options     AffinityOptions
+		wantCount   int
+		wantDropped int
+	}{
+		{"max edges", AffinityOptions{MaxEdges: 7}, 7, 43},
+		{"max edges with min score", AffinityOptions{MaxEdges: 7, MinScore: 0.5}, 7, 18},
+		{"max edges over candidates", AffinityOptions{MaxEdges: 30, MinScore: 0.5}, 25, 0},
+		{"no max edges", AffinityOptions{MinScore: 0.5}, 25, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewAffinityCalculatorWithOptions(VisionInfo{}, nil, nil, nil, nil, tt.options)
+			got, dropped := calc.filterEdges(append([]AffinityEdge(nil), edges...))
+			if len(got) != tt.wantCount || dropped != tt.wantDropped {
+				t.Fatalf("got %d edges, dropped %d; want %d, %d", len(got), dropped, tt.wantCount, tt.wantDropped)
+			}
+			if tt.options.MaxEdges == 0 {
+				return
+			}
+			// 全ソートした場合と同じ上位 k 件（スコア降順、同点は検出順）
+			want := []AffinityEdge{}
+			for _, e := range edges {
+				if e.Score >= tt.options.MinScore {
+					want = append(want, e)
+				}
+			}
+			sort.SliceStable(want, func(i, j int) bool { return want[i].Score > want[j].Score })
+			if !reflect.DeepEqual(got, want[:tt.wantCount]) {
+				t.Errorf("got %+v, want %+v", got, want[:tt.wantCount])
+			}
+		})
+	}
+}
+
+func TestAffinityCalculator_DroppedEdgesStats(t *testing.T) {
+	_, tasks, _ := largeAffinityTasks(2, 10)
+	options := AffinityOptions{MaxSiblings: 20, MaxEdges: 30}
+	result, err := NewAffinityCalculatorWithOptions(VisionInfo{}, nil, tasks, nil, nil, options).Calculate(context.Background())
+	if err != nil {
+		t.Fatalf("Calculate failed: %v", err)
+	}
+	// 親子 20 + 兄弟 2 × 45 = 110 件から 30 件を選ぶ
+	if len(result.Edges) != 30 || result.Stats.DroppedEdges != 80 || result.Stats.FilteredEdges != 80 {
+		t.Errorf("edges = %d, stats = %+v", len(result.Edges), result.Stats)
+	}
+	// 親子エッジ（スコア 1.0）が兄弟エッジより優先される
+	for _, e := range result.Edges[:20] {
+		if e.Types[0] != AffinityParentChild {
+			t.Errorf("parent-child edges should come first: %+v", e)
+		}
+	}
+}
+
 // ===== MaxSiblings ハブモードテスト =====
 
 func TestAffinityCalculator_MaxSiblingsHubMode(t *testing.T) {
@@ -688,3 +846,111 @@ func TestAffinityCalculator_EdgeScores(t *testing.T) {
 		}
 	}
 }
+
+// ===== 並列計算・近似モードテスト =====
+
+// largeAffinityTasks は parents 個の親のそれぞれに children 個の子を持つ Task を生成
+func largeAffinityTasks(parents, children int) ([]ObjectiveInfo, []TaskInfo, []RiskInfo) {
+	objectives := []ObjectiveInfo{}
+	tasks := []TaskInfo{}
+	risks := []RiskInfo{}
+	for p := range parents {
+		objID := fmt.Sprintf("obj-%03d", p)
+		parentID := fmt.Sprintf("task-p%03d", p)
+		objectives = append(objectives, ObjectiveInfo{ID: objID, Title: "目標" + objID})
+		tasks = append(tasks, TaskInfo{ID: parentID, Title: "親" + parentID})
+		risks = append(risks, RiskInfo{ID: fmt.Sprintf("risk-%03d", p), ObjectiveID: objID})
+		for c := range children {
+			tasks = append(tasks, TaskInfo{
+				ID:       fmt.Sprintf("task-%03d-%03d", p, c),
+				Title:    fmt.Sprintf("子タスク %03d", (c*7)%children),
+				ParentID: parentID,
+			})
+		}
+	}
+	return objectives, tasks, risks
+}
+
+func TestAffinityCalculator_ParallelMatchesSequential(t *testing.T) {
+	objectives, tasks, risks := largeAffinityTasks(20, 30)
+	vision := VisionInfo{ID: "vision-001", Title: "ビジョン"}
+
+	calculate := func(workers int) *AffinityResult {
+		options := AffinityOptions{MaxSiblings: 50, Workers: workers}
+		result, err := NewAffinityCalculatorWithOptions(vision, objectives, tasks, nil, risks, options).Calculate(context.Background())
+		if err != nil {
+			t.Fatalf("Calculate(workers=%d) failed: %v", workers, err)
+		}
+		return result
+	}
+	sequential := calculate(1)
+	parallel := calculate(8)
+
+	// Vision -> Objective 20、親子 600、Risk -> Objective 20、兄弟 20 × 435
+	if len(sequential.Edges) != 20+20*30+20+20*30*29/2 {
+		t.Errorf("edges = %d", len(sequential.Edges))
+	}
+	if !reflect.DeepEqual(sequential, parallel) {
+		t.Error("parallel result should be identical to sequential result")
+	}
+}
+
+func TestAffinityCalculator_CancelMidComputation(t *testing.T) {
+	_, tasks, _ := largeAffinityTasks(200, 200)
+	options := AffinityOptions{MaxSiblings: 1000, Workers: 4}
+	calc := NewAffinityCalculatorWithOptions(VisionInfo{}, nil, tasks, nil, nil, options)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	// 計算の開始直後にキャンセルする
+	time.AfterFunc(time.Millisecond, cancel)
+	if _, err := calc.Calculate(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Calculate should return context.Canceled: %v", err)
+	}
+}
+
+func TestAffinityCalculator_ApproximateMode(t *testing.T) {
+	_, tasks, _ := largeAffinityTasks(1, 100)
+	options := AffinityOptions{MaxSiblings: 10, Approximate: true}
+	result, err := NewAffinityCalculatorWithOptions(VisionInfo{}, nil, tasks, nil, nil, options).Calculate(context.Background())
+	if err != nil {
+		t.Fatalf("Calculate failed: %v", err)
+	}
+	if !result.Stats.Approximated || result.Stats.UsedHubMode {
+		t.Errorf("stats = %+v", result.Stats)
+	}
+	siblings := []AffinityEdge{}
+	for _, e := range result.Edges {
+		if reflect.DeepEqual(e.Types, []AffinityType{AffinitySibling}) {
+			siblings = append(siblings, e)
+		}
+	}
+	// 10 件ずつ 10 バケット: バケット内 45 ペア × 10 + バケット間 9
+	if len(siblings) != 45*10+9 {
+		t.Errorf("sibling edges = %d", len(siblings))
+	}
+
+	// 兄弟エッジだけで兄弟グループ全体が連結している
+	adjacent := map[string][]string{}
+	for _, e := range siblings {
+		if e.Reason != "同じ task-p000 に属する（近似）" {
+			t.Errorf("reason = %q", e.Reason)
+		}
+		adjacent[e.Source] = append(adjacent[e.Source], e.Target)
+		adjacent[e.Target] = append(adjacent[e.Target], e.Source)
+	}
+	seen := map[string]bool{tasks[1].ID: true}
+	queue := []string{tasks[1].ID}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, next := range adjacent[id] {
+			if !seen[next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	if len(seen) != 100 {
+		t.Errorf("siblings should stay connected: %d/100 reachable", len(seen))
+	}
+}
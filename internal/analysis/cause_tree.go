@@ -0,0 +1,97 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CauseNode は原因ツリーの 1 つの原因（子は「なぜそれが起きたか」の原因）
+type CauseNode struct {
+	Description string      `json:"description"`
+	EntityID    string      `json:"entity_id,omitempty"` // 原因に関連するエンティティ
+	Causes      []CauseNode `json:"causes,omitempty"`
+}
+
+// CauseTree は Problem を根とする原因ツリー（なぜなぜ分析）
+type CauseTree struct {
+	ProblemID string      `json:"problem_id"`
+	Title     string      `json:"title"`
+	Causes    []CauseNode `json:"causes"`
+}
+
+// Outline は原因ツリーを字下げした行の一覧で返す（深さ 1 段ごとに 2 文字字下げ）
+// 子の無い原因（根本原因の候補）は行末に "(root cause)" を付ける
+func (t *CauseTree) Outline() []string {
+	lines := []string{}
+	var walk func(causes []CauseNode, depth int)
+	walk = func(causes []CauseNode, depth int) {
+		for _, c := range causes {
+			line := strings.Repeat("  ", depth) + "- " + c.Description
+			if c.EntityID != "" {
+				line += " [" + c.EntityID + "]"
+			}
+			if len(c.Causes) == 0 {
+				line += " (root cause)"
+			}
+			lines = append(lines, line)
+			walk(c.Causes, depth+1)
+		}
+	}
+	walk(t.Causes, 0)
+	return lines
+}
+
+// ToMermaid は Mermaid flowchart で出力（Problem が上、原因へ向かうエッジ）
+// 原因のノード ID は Problem 内の位置（c1、c1_2 など）、関連エンティティは点線でつなぐ
+func (t *CauseTree) ToMermaid() string {
+	var sb strings.Builder
+	sb.WriteString("```mermaid\ngraph TD\n")
+
+	root := strings.ReplaceAll(t.ProblemID, "-", "_")
+	fmt.Fprintf(&sb, "    %s([\"%s\"])\n", root, escapeMermaidText(t.ProblemID+": "+t.Title))
+
+	var edges, links, leaves, entities []string
+	seen := map[string]bool{}
+	var walk func(parent, prefix string, causes []CauseNode)
+	walk = func(parent, prefix string, causes []CauseNode) {
+		for i, c := range causes {
+			id := fmt.Sprintf("%s%d", prefix, i+1)
+			fmt.Fprintf(&sb, "    %s[\"%s\"]\n", id, escapeMermaidText(c.Description))
+			edges = append(edges, fmt.Sprintf("    %s -->|why| %s\n", parent, id))
+			if c.EntityID != "" {
+				entity := strings.ReplaceAll(c.EntityID, "-", "_")
+				if !seen[entity] {
+					seen[entity] = true
+					entities = append(entities, fmt.Sprintf("    %s[[\"%s\"]]\n", entity, escapeMermaidText(c.EntityID)))
+				}
+				links = append(links, fmt.Sprintf("    %s -.- %s\n", id, entity))
+			}
+			if len(c.Causes) == 0 {
+				leaves = append(leaves, id)
+			}
+			walk(id, id+"_", c.Causes)
+		}
+	}
+	walk(root, "c", t.Causes)
+
+	for _, e := range entities {
+		sb.WriteString(e)
+	}
+	sb.WriteString("\n")
+	for _, e := range edges {
+		sb.WriteString(e)
+	}
+	for _, l := range links {
+		sb.WriteString(l)
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString("    classDef problem fill:#FAD7AC,stroke:#D79B00\n")
+	fmt.Fprintf(&sb, "    class %s problem\n", root)
+	if len(leaves) > 0 {
+		sb.WriteString("    classDef rootcause fill:#F8CECC,stroke:#B85450\n")
+		fmt.Fprintf(&sb, "    class %s rootcause\n", strings.Join(leaves, ","))
+	}
+	sb.WriteString("```\n")
+	return sb.String()
+}
@@ -0,0 +1,57 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCauseTree_OutlineAndMermaid(t *testing.T) {
+	tree := &CauseTree{
+		ProblemID: "prob-1",
+		Title:     "リリース遅延",
+		Causes: []CauseNode{
+			{
+				Description: "テストが不安定",
+				EntityID:    "act-1",
+				Causes: []CauseNode{
+					{Description: "外部 API [本番] に依存", EntityID: "act-1"},
+				},
+			},
+			{Description: "レビュー待ち"},
+		},
+	}
+
+	outline := tree.Outline()
+	want := []string{
+		"- テストが不安定 [act-1]",
+		"  - 外部 API [本番] に依存 [act-1] (root cause)",
+		"- レビュー待ち (root cause)",
+	}
+	if strings.Join(outline, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Outline = %q, want %q", outline, want)
+	}
+
+	out := tree.ToMermaid()
+	for _, want := range []string{
+		"graph TD",
+		`prob_1(["prob-1: リリース遅延"])`,
+		`c1_1["外部 API #91;本番#93; に依存"]`,
+		"prob_1 -->|why| c1",
+		"c1 -->|why| c1_1",
+		"prob_1 -->|why| c2",
+		"c1_1 -.- act_1",
+		"class c1_1,c2 rootcause",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Mermaid 出力に %q が含まれていません:\n%s", want, out)
+		}
+	}
+	if n := strings.Count(out, `act_1[["act-1"]]`); n != 1 {
+		t.Errorf("関連エンティティのノードは 1 回だけ出力されるべきです (%d 回):\n%s", n, out)
+	}
+
+	empty := (&CauseTree{ProblemID: "prob-2", Title: "空"}).ToMermaid()
+	if strings.Contains(empty, "rootcause") || !strings.Contains(empty, "prob_2") {
+		t.Errorf("原因の無いツリーの出力が正しくありません:\n%s", empty)
+	}
+}
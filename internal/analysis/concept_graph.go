@@ -0,0 +1,98 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+)
+
+// conceptMermaidShapes はエンティティ種別ごとの Mermaid ノードの形（開き・閉じ）
+var conceptMermaidShapes = map[string][2]string{
+	"vision":        {"{{", "}}"},
+	"objective":     {"[", "]"},
+	"consideration": {"[/", "/]"},
+	"decision":      {"[[", "]]"},
+	"risk":          {">", "]"},
+	"problem":       {"([", "])"},
+	"assumption":    {"[\\", "\\]"},
+}
+
+// conceptMermaidStyles はエンティティ種別ごとの classDef
+var conceptMermaidStyles = map[string]string{
+	"vision":        "fill:#E6E0F8,stroke:#6A5ACD",
+	"objective":     "fill:#DDEBF7,stroke:#4682B4",
+	"consideration": "fill:#FFF2CC,stroke:#D6B656",
+	"decision":      "fill:#D5E8D4,stroke:#82B366",
+	"risk":          "fill:#F8CECC,stroke:#B85450",
+	"problem":       "fill:#FAD7AC,stroke:#D79B00",
+	"assumption":    "fill:#F5F5F5,stroke:#666666",
+}
+
+// Filter は keep を満たすノードと、両端が残るエッジだけのグラフを返す
+func (g *RelationGraph) Filter(keep func(RelationNode) bool) *RelationGraph {
+	filtered := NewRelationGraph()
+	for id, node := range g.Nodes {
+		if keep(node) {
+			filtered.Nodes[id] = node
+		}
+	}
+	for _, e := range g.ResolvedEdges() {
+		if _, ok := filtered.Nodes[e.From]; !ok {
+			continue
+		}
+		if _, ok := filtered.Nodes[e.To]; !ok {
+			continue
+		}
+		filtered.Edges = append(filtered.Edges, e)
+	}
+	return filtered
+}
+
+// ToMermaid は Mermaid flowchart で出力（参照元から参照先へのエッジ、参照先が上）
+// ノードの形と色はエンティティ種別ごと、ラベルは "種別: タイトル (状態)"
+func (g *RelationGraph) ToMermaid() string {
+	var sb strings.Builder
+	sb.WriteString("```mermaid\ngraph BT\n")
+
+	nodes := g.SortedNodes()
+	if len(nodes) == 0 {
+		sb.WriteString("    NoData[\"No data available\"]\n```\n")
+		return sb.String()
+	}
+
+	byType := map[string][]string{}
+	types := []string{}
+	for _, node := range nodes {
+		id := strings.ReplaceAll(node.ID, "-", "_")
+		label := node.Type + ": " + node.Title
+		if node.Status != "" {
+			label += " (" + node.Status + ")"
+		}
+		shape, ok := conceptMermaidShapes[node.Type]
+		if !ok {
+			shape = [2]string{"(", ")"}
+		}
+		fmt.Fprintf(&sb, "    %s%s\"%s\"%s\n", id, shape[0], escapeMermaidText(label), shape[1])
+		if _, ok := byType[node.Type]; !ok {
+			types = append(types, node.Type)
+		}
+		byType[node.Type] = append(byType[node.Type], id)
+	}
+
+	sb.WriteString("\n")
+	for _, e := range g.ResolvedEdges() {
+		fmt.Fprintf(&sb, "    %s -->|%s| %s\n",
+			strings.ReplaceAll(e.From, "-", "_"), escapeMermaidText(e.Relation), strings.ReplaceAll(e.To, "-", "_"))
+	}
+
+	sb.WriteString("\n")
+	for _, t := range types {
+		style, ok := conceptMermaidStyles[t]
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(&sb, "    classDef %s %s\n", t, style)
+		fmt.Fprintf(&sb, "    class %s %s\n", strings.Join(byType[t], ","), t)
+	}
+	sb.WriteString("```\n")
+	return sb.String()
+}
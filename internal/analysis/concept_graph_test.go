@@ -0,0 +1,38 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRelationGraph_FilterAndMermaid(t *testing.T) {
+	g := NewRelationGraph()
+	g.AddNodeWithStatus("obj-1", "objective", "目標", "in_progress")
+	g.AddNodeWithStatus("risk-1", "risk", "遅延 [重大]", "identified")
+	g.AddNode("act-1", "activity", "作業")
+	g.AddEdge("risk-1", "obj-1", "objective_id")
+	g.AddEdge("act-1", "obj-1", "objective_id")
+	g.AddEdge("risk-1", "obj-missing", "objective_id")
+
+	filtered := g.Filter(func(n RelationNode) bool { return n.Type != "activity" })
+	if len(filtered.Nodes) != 2 || len(filtered.Edges) != 1 {
+		t.Fatalf("Filter = %d nodes, %d edges, want 2, 1", len(filtered.Nodes), len(filtered.Edges))
+	}
+
+	out := filtered.ToMermaid()
+	for _, want := range []string{
+		"graph BT",
+		`obj_1["objective: 目標 #40;in_progress#41;"]`,
+		`risk_1>"risk: 遅延 #91;重大#93; #40;identified#41;"]`,
+		"risk_1 -->|objective_id| obj_1",
+		"class risk_1 risk",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Mermaid 出力に %q が含まれていません:\n%s", want, out)
+		}
+	}
+
+	if empty := NewRelationGraph().ToMermaid(); !strings.Contains(empty, "NoData") {
+		t.Errorf("空のグラフの出力が正しくありません:\n%s", empty)
+	}
+}
@@ -8,8 +8,10 @@ import (
 type CoverageIssueType string
 
 const (
-	CoverageIssueNoTasks  CoverageIssueType = "no_tasks"
-	CoverageIssueOrphaned CoverageIssueType = "orphaned"
+	CoverageIssueNoTasks             CoverageIssueType = "no_tasks"
+	CoverageIssueOrphaned            CoverageIssueType = "orphaned"
+	CoverageIssueUseCaseNoActivities CoverageIssueType = "usecase_no_activities" // 有効な UseCase に Activity が無い
+	CoverageIssueActivityUntraced    CoverageIssueType = "activity_untraced"     // Activity が UseCase に紐づいていない
 )
 
 // CoverageIssueSeverity は問題の深刻度
@@ -25,7 +27,7 @@ type CoverageIssue struct {
 	Type        CoverageIssueType     `json:"type"`
 	EntityID    string                `json:"entity_id"`
 	EntityTitle string                `json:"entity_title"`
-	EntityType  string                `json:"entity_type"` // objective, task
+	EntityType  string                `json:"entity_type"` // objective, task, usecase, activity
 	Severity    CoverageIssueSeverity `json:"severity"`
 	Message     string                `json:"message"`
 }
@@ -36,12 +38,16 @@ type CoverageAnalysis struct {
 	CoverageScore   int             `json:"coverage_score"` // 0-100
 	ObjectivesCover int             `json:"objectives_covered"`
 	ObjectivesTotal int             `json:"objectives_total"`
+	UseCasesCovered int             `json:"usecases_covered"` // Activity が紐づいている有効な UseCase の数
+	UseCasesActive  int             `json:"usecases_active"`  // 有効（status: active）な UseCase の数
 }
 
 // CoverageAnalyzer はカバレッジ分析を行う
 type CoverageAnalyzer struct {
 	objectives []ObjectiveInfo
 	tasks      []TaskInfo
+	usecases   []UseCaseInfo
+	activities []ActivityInfo
 }
 
 // NewCoverageAnalyzer は新しい CoverageAnalyzer を作成
@@ -55,6 +61,18 @@ func NewCoverageAnalyzer(
 	}
 }
 
+// WithUseCases は UseCase 情報を設定（UseCase と Activity の紐づけを検査する）
+func (c *CoverageAnalyzer) WithUseCases(usecases []UseCaseInfo) *CoverageAnalyzer {
+	c.usecases = usecases
+	return c
+}
+
+// WithActivities は Activity 情報を設定（UseCase と Activity の紐づけを検査する）
+func (c *CoverageAnalyzer) WithActivities(activities []ActivityInfo) *CoverageAnalyzer {
+	c.activities = activities
+	return c
+}
+
 // Analyze はカバレッジ分析を実行
 func (c *CoverageAnalyzer) Analyze(ctx context.Context) (*CoverageAnalysis, error) {
 	if err := ctx.Err(); err != nil {
@@ -119,7 +137,10 @@ func (c *CoverageAnalyzer) Analyze(ctx context.Context) (*CoverageAnalysis, erro
 		}
 	}
 
-	// カバレッジスコアを計算（Objective + Task ベース）
+	// 3. UseCase と Activity の紐づけをチェック（警告）
+	c.analyzeUseCaseLinkage(result)
+
+	// カバレッジスコアを計算（Objective + Task ベース、どちらも無い場合は UseCase ベース）
 	if result.ObjectivesTotal > 0 {
 		result.CoverageScore = (result.ObjectivesCover * 100) / result.ObjectivesTotal
 	} else if len(c.tasks) > 0 {
@@ -131,9 +152,55 @@ func (c *CoverageAnalyzer) Analyze(ctx context.Context) (*CoverageAnalysis, erro
 			}
 		}
 		result.CoverageScore = 100 - ((orphanCount * 100) / len(c.tasks))
+	} else if result.UseCasesActive > 0 {
+		result.CoverageScore = (result.UseCasesCovered * 100) / result.UseCasesActive
 	} else {
 		result.CoverageScore = 100
 	}
 
 	return result, nil
 }
+
+// analyzeUseCaseLinkage は有効な UseCase に Activity が紐づいているか、
+// Activity が UseCase に紐づいているかをチェックする
+// - 廃止された Activity は対象外（紐づけの有無を問わず、UseCase のカバーにも数えない）
+// - 存在しない UseCase への参照は参照整合性チェックで警告するため、ここでは対象外
+func (c *CoverageAnalyzer) analyzeUseCaseLinkage(result *CoverageAnalysis) {
+	linked := make(map[string]bool)
+	for _, act := range c.activities {
+		if act.Status == "deprecated" {
+			continue
+		}
+		if act.UseCaseID != "" {
+			linked[act.UseCaseID] = true
+			continue
+		}
+		result.Issues = append(result.Issues, CoverageIssue{
+			Type:        CoverageIssueActivityUntraced,
+			EntityID:    act.ID,
+			EntityTitle: act.Title,
+			EntityType:  "activity",
+			Severity:    CoverageSeverityWarning,
+			Message:     "Activity が UseCase に紐づいていません",
+		})
+	}
+
+	for _, uc := range c.usecases {
+		if uc.Status != "active" {
+			continue
+		}
+		result.UseCasesActive++
+		if linked[uc.ID] {
+			result.UseCasesCovered++
+			continue
+		}
+		result.Issues = append(result.Issues, CoverageIssue{
+			Type:        CoverageIssueUseCaseNoActivities,
+			EntityID:    uc.ID,
+			EntityTitle: uc.Title,
+			EntityType:  "usecase",
+			Severity:    CoverageSeverityWarning,
+			Message:     "有効な UseCase に Activity が紐づいていません",
+		})
+	}
+}
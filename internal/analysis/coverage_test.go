@@ -352,3 +352,67 @@ func TestCoverageAnalyzer_Statistics(t *testing.T) {
 		t.Errorf("expected ObjectivesCover 1, got %d", result.ObjectivesCover)
 	}
 }
+
+// ===== UseCase と Activity の紐づけテスト =====
+
+func TestCoverageAnalyzer_UseCaseLinkage(t *testing.T) {
+	usecases := []UseCaseInfo{
+		{ID: "uc-001", Title: "ログイン", Status: "active"},
+		{ID: "uc-002", Title: "ログアウト", Status: "active"},
+		{ID: "uc-003", Title: "下書き", Status: "draft"},
+		{ID: "uc-004", Title: "廃止済みの Activity のみ", Status: "active"},
+	}
+	activities := []ActivityInfo{
+		{ID: "act-001", Title: "認証フロー", UseCaseID: "uc-001"},
+		{ID: "act-002", Title: "未分類", Status: "active"},
+		{ID: "act-003", Title: "旧フロー", Status: "deprecated", UseCaseID: "uc-004"},
+		{ID: "act-004", Title: "旧未分類", Status: "deprecated"},
+	}
+
+	result, err := NewCoverageAnalyzer(nil, nil).
+		WithUseCases(usecases).
+		WithActivities(activities).
+		Analyze(context.Background())
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+
+	got := map[string]CoverageIssueType{}
+	for _, issue := range result.Issues {
+		got[issue.EntityID] = issue.Type
+		if issue.Severity != CoverageSeverityWarning {
+			t.Errorf("%s: expected warning severity, got %s", issue.EntityID, issue.Severity)
+		}
+	}
+	want := map[string]CoverageIssueType{
+		"uc-002":  CoverageIssueUseCaseNoActivities,
+		"uc-004":  CoverageIssueUseCaseNoActivities,
+		"act-002": CoverageIssueActivityUntraced,
+	}
+	if len(got) != len(want) {
+		t.Errorf("expected issues %v, got %v", want, got)
+	}
+	for id, typ := range want {
+		if got[id] != typ {
+			t.Errorf("%s: expected %s, got %q", id, typ, got[id])
+		}
+	}
+
+	if result.UseCasesActive != 3 || result.UseCasesCovered != 1 {
+		t.Errorf("expected 1/3 usecases covered, got %d/%d", result.UseCasesCovered, result.UseCasesActive)
+	}
+	// Objective も Task も無い場合は UseCase ベースのスコア
+	if result.CoverageScore != 33 {
+		t.Errorf("expected CoverageScore 33, got %d", result.CoverageScore)
+	}
+}
+
+func TestCoverageAnalyzer_UseCaseLinkage_NotConfigured(t *testing.T) {
+	result, err := NewCoverageAnalyzer(nil, nil).Analyze(context.Background())
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+	if len(result.Issues) != 0 || result.CoverageScore != 100 {
+		t.Errorf("expected no issues and full coverage, got %v (%d%%)", result.Issues, result.CoverageScore)
+	}
+}
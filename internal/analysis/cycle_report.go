@@ -0,0 +1,173 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DependencyEdge は循環の検出・解消提案に使う依存関係（From が To に依存する）
+type DependencyEdge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Relation string `json:"relation"`
+	Soft     bool   `json:"soft,omitempty"`     // 外しても影響が小さい依存（UseCase の extend など）
+	AddedAt  string `json:"added_at,omitempty"` // 依存を追加・変更した日時（RFC3339、不明な場合は空）
+}
+
+// CycleExplanation は 1 つの循環の説明
+type CycleExplanation struct {
+	Cycle   []string         `json:"cycle"` // 循環パス（先頭と末尾は同じ ID）
+	Edges   []DependencyEdge `json:"edges"` // 循環を構成する依存
+	Break   DependencyEdge   `json:"break"` // この循環を解消するために外す依存の候補
+	Mermaid string           `json:"mermaid"`
+}
+
+// CycleReport は循環の説明と、すべての循環を解消するために外す依存の集合
+type CycleReport struct {
+	Cycles []CycleExplanation `json:"cycles"`
+	Break  []DependencyEdge   `json:"break"`
+}
+
+// ExplainCycles は依存関係の循環を検出し、各循環の Mermaid 図と解消のために外す依存を提案する
+// 外す依存は、より多くの循環に含まれるものを優先し、同数の場合は soft な依存、追加日時の新しい依存の順に選ぶ
+// （最小の集合を求める問題は NP 困難のため、貪欲法による近似）
+func ExplainCycles(edges []DependencyEdge, titles map[string]string) *CycleReport {
+	report := &CycleReport{Cycles: []CycleExplanation{}, Break: []DependencyEdge{}}
+	cycles := findDependencyCycles(edges, nil)
+	if len(cycles) == 0 {
+		return report
+	}
+
+	removed := make(map[DependencyEdge]bool)
+	for remaining := cycles; len(remaining) > 0; remaining = findDependencyCycles(edges, removed) {
+		counts := make(map[DependencyEdge]int)
+		for _, cycle := range remaining {
+			for _, e := range cycle {
+				counts[e]++
+			}
+		}
+		candidates := make([]DependencyEdge, 0, len(counts))
+		for e := range counts {
+			candidates = append(candidates, e)
+		}
+		sort.Slice(candidates, func(i, j int) bool {
+			if counts[candidates[i]] != counts[candidates[j]] {
+				return counts[candidates[i]] > counts[candidates[j]]
+			}
+			return preferBreak(candidates[i], candidates[j])
+		})
+		removed[candidates[0]] = true
+		report.Break = append(report.Break, candidates[0])
+	}
+
+	for _, cycle := range cycles {
+		explanation := CycleExplanation{Edges: cycle, Cycle: make([]string, 0, len(cycle)+1)}
+		for _, e := range cycle {
+			explanation.Cycle = append(explanation.Cycle, e.From)
+		}
+		explanation.Cycle = append(explanation.Cycle, cycle[0].From)
+
+		best := -1
+		for i, e := range cycle {
+			if removed[e] && (best < 0 || preferBreak(e, cycle[best])) {
+				best = i
+			}
+		}
+		explanation.Break = cycle[best]
+		explanation.Mermaid = cycleMermaid(cycle, explanation.Break, titles)
+		report.Cycles = append(report.Cycles, explanation)
+	}
+	return report
+}
+
+// preferBreak は a を b より優先して外すべきか（soft な依存、追加日時の新しい依存、ID 順）
+func preferBreak(a, b DependencyEdge) bool {
+	if a.Soft != b.Soft {
+		return a.Soft
+	}
+	if a.AddedAt != b.AddedAt {
+		return a.AddedAt > b.AddedAt
+	}
+	if a.From != b.From {
+		return a.From < b.From
+	}
+	return a.To < b.To
+}
+
+// findDependencyCycles は removed を除いた依存関係から循環を検出する（深さ優先探索の後退辺ごとに 1 つ）
+func findDependencyCycles(edges []DependencyEdge, removed map[DependencyEdge]bool) [][]DependencyEdge {
+	adj := make(map[string][]DependencyEdge)
+	nodes := []string{}
+	for _, e := range edges {
+		if removed[e] {
+			continue
+		}
+		if _, ok := adj[e.From]; !ok {
+			nodes = append(nodes, e.From)
+		}
+		adj[e.From] = append(adj[e.From], e)
+	}
+	sort.Strings(nodes)
+	for _, id := range nodes {
+		sort.SliceStable(adj[id], func(i, j int) bool { return adj[id][i].To < adj[id][j].To })
+	}
+
+	cycles := [][]DependencyEdge{}
+	visited := make(map[string]int) // 0=未訪問, 1=訪問中, 2=訪問済み
+	path := []DependencyEdge{}
+	var dfs func(id string)
+	dfs = func(id string) {
+		visited[id] = 1
+		for _, e := range adj[id] {
+			switch visited[e.To] {
+			case 0:
+				path = append(path, e)
+				dfs(e.To)
+				path = path[:len(path)-1]
+			case 1:
+				// 経路上で e.To から出る依存から e までが循環（自己参照の場合は e のみ）
+				start := len(path)
+				for e.To != id && start > 0 {
+					start--
+					if path[start].From == e.To {
+						break
+					}
+				}
+				cycle := append(append([]DependencyEdge{}, path[start:]...), e)
+				cycles = append(cycles, cycle)
+			}
+		}
+		visited[id] = 2
+	}
+	for _, id := range nodes {
+		if visited[id] == 0 {
+			dfs(id)
+		}
+	}
+	return cycles
+}
+
+// cycleMermaid は循環を Mermaid のフローチャートにする（外す候補の依存は点線）
+func cycleMermaid(cycle []DependencyEdge, breakEdge DependencyEdge, titles map[string]string) string {
+	var sb strings.Builder
+	sb.WriteString("graph LR\n")
+	for _, e := range cycle {
+		from, to := cycleMermaidNode(e.From, titles), cycleMermaidNode(e.To, titles)
+		if e == breakEdge {
+			fmt.Fprintf(&sb, "    %s -. \"%s (外す候補)\" .-> %s\n", from, e.Relation, to)
+			continue
+		}
+		fmt.Fprintf(&sb, "    %s -->|%s| %s\n", from, e.Relation, to)
+	}
+	return sb.String()
+}
+
+// cycleMermaidNode は Mermaid のノード定義（ID とタイトル）
+func cycleMermaidNode(id string, titles map[string]string) string {
+	label := id
+	if title := titles[id]; title != "" {
+		label = id + ": " + strings.ReplaceAll(title, "\"", "'")
+	}
+	return fmt.Sprintf("%s[\"%s\"]", strings.ReplaceAll(id, "-", "_"), label)
+}
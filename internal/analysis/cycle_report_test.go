@@ -0,0 +1,68 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExplainCycles_NoCycle(t *testing.T) {
+	report := ExplainCycles([]DependencyEdge{
+		{From: "uc-a", To: "uc-b", Relation: "include"},
+		{From: "uc-b", To: "uc-c", Relation: "include"},
+	}, nil)
+	if len(report.Cycles) != 0 || len(report.Break) != 0 {
+		t.Errorf("expected no cycles, got %+v", report)
+	}
+}
+
+func TestExplainCycles_PrefersSoftAndRecentEdges(t *testing.T) {
+	edges := []DependencyEdge{
+		{From: "uc-a", To: "uc-b", Relation: "include", AddedAt: "2026-01-01T00:00:00Z"},
+		{From: "uc-b", To: "uc-c", Relation: "include", AddedAt: "2026-03-01T00:00:00Z"},
+		{From: "uc-c", To: "uc-a", Relation: "extend", Soft: true, AddedAt: "2026-01-01T00:00:00Z"},
+		{From: "uc-x", To: "uc-y", Relation: "include", AddedAt: "2026-01-01T00:00:00Z"},
+		{From: "uc-y", To: "uc-x", Relation: "include", AddedAt: "2026-02-01T00:00:00Z"},
+	}
+	report := ExplainCycles(edges, map[string]string{"uc-a": "注文する"})
+
+	if len(report.Cycles) != 2 || len(report.Break) != 2 {
+		t.Fatalf("expected 2 cycles and 2 edges to break, got %+v", report)
+	}
+	first := report.Cycles[0]
+	if strings.Join(first.Cycle, ",") != "uc-a,uc-b,uc-c,uc-a" {
+		t.Errorf("unexpected cycle path: %v", first.Cycle)
+	}
+	if first.Break.From != "uc-c" || !first.Break.Soft {
+		t.Errorf("soft edge should be suggested first, got %+v", first.Break)
+	}
+	if !strings.Contains(first.Mermaid, `uc_a["uc-a: 注文する"]`) || !strings.Contains(first.Mermaid, `-. "extend (外す候補)" .->`) {
+		t.Errorf("unexpected mermaid:\n%s", first.Mermaid)
+	}
+	if second := report.Cycles[1]; second.Break.From != "uc-y" {
+		t.Errorf("most recently added edge should be suggested, got %+v", second.Break)
+	}
+}
+
+func TestExplainCycles_SharedEdge(t *testing.T) {
+	// uc-a -> uc-b は 2 つの循環に含まれるため、1 本外すだけで両方解消できる
+	edges := []DependencyEdge{
+		{From: "uc-a", To: "uc-b", Relation: "include"},
+		{From: "uc-b", To: "uc-a", Relation: "include", Soft: true},
+		{From: "uc-b", To: "uc-c", Relation: "include"},
+		{From: "uc-c", To: "uc-a", Relation: "include", Soft: true},
+	}
+	report := ExplainCycles(edges, nil)
+	if len(report.Cycles) != 2 {
+		t.Fatalf("expected 2 cycles, got %+v", report.Cycles)
+	}
+	if len(report.Break) != 1 || report.Break[0].From != "uc-a" || report.Break[0].To != "uc-b" {
+		t.Errorf("shared edge should be the only edge to break, got %+v", report.Break)
+	}
+}
+
+func TestExplainCycles_SelfLoop(t *testing.T) {
+	report := ExplainCycles([]DependencyEdge{{From: "cnt-a", To: "cnt-a", Relation: "uses"}}, nil)
+	if len(report.Cycles) != 1 || strings.Join(report.Cycles[0].Cycle, ",") != "cnt-a,cnt-a" {
+		t.Errorf("self loop should be reported, got %+v", report.Cycles)
+	}
+}
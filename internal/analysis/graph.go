@@ -3,6 +3,7 @@ package analysis
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 )
@@ -21,6 +22,94 @@ func NewGraphBuilder(tasks []TaskInfo) *GraphBuilder {
 	return &GraphBuilder{tasks: taskMap}
 }
 
+// BuildFiltered は絞り込み条件に合うタスクのみで依存関係グラフを構築
+// 除いたタスクへの依存は含めない（除いたタスクにしか依存しないタスクは孤立ノードになる）。
+// RootID がタスクに存在しない場合はエラー
+func (g *GraphBuilder) BuildFiltered(ctx context.Context, filter DependencyGraphFilter) (*DependencyGraph, error) {
+	if filter.IsZero() {
+		return g.Build(ctx)
+	}
+	if filter.Depth < 0 {
+		return nil, fmt.Errorf("depth must be 0 or greater: %d", filter.Depth)
+	}
+	if filter.RootID != "" {
+		if _, ok := g.tasks[filter.RootID]; !ok {
+			return nil, fmt.Errorf("root task not found: %s", filter.RootID)
+		}
+	}
+
+	// ステータスで絞り込む
+	keep := make(map[string]bool, len(g.tasks))
+	for id, task := range g.tasks {
+		if id == filter.RootID {
+			keep[id] = true
+			continue
+		}
+		if filter.HideCompleted && (task.Status == TaskStatusCompleted || task.Status == TaskStatusDeprecated) {
+			continue
+		}
+		if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, task.Status) {
+			continue
+		}
+		keep[id] = true
+	}
+	builder := g.subset(keep)
+
+	switch {
+	case filter.RootID != "":
+		// RootID から依存先をたどり、Depth 以内のタスクのみ残す
+		reached := map[string]bool{filter.RootID: true}
+		frontier := []string{filter.RootID}
+		for hop := 1; len(frontier) > 0 && (filter.Depth == 0 || hop <= filter.Depth); hop++ {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			var next []string
+			for _, id := range frontier {
+				for _, depID := range builder.tasks[id].Dependencies {
+					if _, ok := builder.tasks[depID]; ok && !reached[depID] {
+						reached[depID] = true
+						next = append(next, depID)
+					}
+				}
+			}
+			frontier = next
+		}
+		builder = builder.subset(reached)
+	case filter.Depth > 0:
+		// 絞り込み後のグラフでの深さが Depth 以内のタスクのみ残す
+		graph, err := builder.Build(ctx)
+		if err != nil {
+			return nil, err
+		}
+		shallow := make(map[string]bool, len(graph.Nodes))
+		for id, node := range graph.Nodes {
+			if node.Depth <= filter.Depth {
+				shallow[id] = true
+			}
+		}
+		builder = builder.subset(shallow)
+	}
+	return builder.Build(ctx)
+}
+
+// subset は keep に含まれるタスクのみを持つ GraphBuilder を返す（依存先も keep に含まれるものに限る）
+func (g *GraphBuilder) subset(keep map[string]bool) *GraphBuilder {
+	tasks := make(map[string]*TaskInfo, len(keep))
+	for id := range keep {
+		task := *g.tasks[id]
+		deps := make([]string, 0, len(task.Dependencies))
+		for _, depID := range task.Dependencies {
+			if keep[depID] {
+				deps = append(deps, depID)
+			}
+		}
+		task.Dependencies = deps
+		tasks[id] = &task
+	}
+	return &GraphBuilder{tasks: tasks}
+}
+
 // Build は依存関係グラフを構築
 func (g *GraphBuilder) Build(ctx context.Context) (*DependencyGraph, error) {
 	if err := ctx.Err(); err != nil {
@@ -337,6 +426,56 @@ func (graph *DependencyGraph) ToDot() string {
 	return sb.String()
 }
 
+// ToPlantUML は PlantUML 形式で出力
+func (graph *DependencyGraph) ToPlantUML() string {
+	var sb strings.Builder
+
+	sb.WriteString("@startuml\n")
+	if graph == nil || graph.Nodes == nil {
+		sb.WriteString("rectangle \"No data available\"\n")
+		sb.WriteString("@enduml\n")
+		return sb.String()
+	}
+
+	// ノード定義とステータスによる色分け
+	ids := make([]string, 0, len(graph.Nodes))
+	for id := range graph.Nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		node := graph.Nodes[id]
+		color := ""
+		switch node.Task.Status {
+		case TaskStatusCompleted, TaskStatusDeprecated:
+			color = " #90EE90"
+		case TaskStatusInProgress, TaskStatusActive:
+			color = " #FFFFE0"
+		case TaskStatusBlocked, TaskStatusOnHold:
+			color = " #F08080"
+		}
+		label := strings.ReplaceAll(node.Task.Title, "\"", "'")
+		fmt.Fprintf(&sb, "rectangle \"%s\" as %s%s\n", label, plantUMLID(id), color)
+	}
+
+	sb.WriteString("\n")
+
+	// エッジ定義
+	for _, edge := range graph.Edges {
+		fmt.Fprintf(&sb, "%s --> %s\n", plantUMLID(edge.From), plantUMLID(edge.To))
+	}
+
+	sb.WriteString("@enduml\n")
+
+	return sb.String()
+}
+
+// plantUMLID は PlantUML のエイリアスとして使える形式に変換
+func plantUMLID(id string) string {
+	return strings.ReplaceAll(id, "-", "_")
+}
+
 // GetDownstreamTasks は指定タスクの下流タスク（依存しているタスク）を取得
 // taskID から始めて、そのタスクに依存している全てのタスクを再帰的に収集
 func (graph *DependencyGraph) GetDownstreamTasks(taskID string) []string {
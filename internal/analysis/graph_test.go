@@ -2,6 +2,8 @@ package analysis
 
 import (
 	"context"
+	"slices"
+	"sort"
 	"strings"
 	"testing"
 )
@@ -133,6 +135,67 @@ func TestGraphBuilder_Build_ContextCanceled(t *testing.T) {
 	}
 }
 
+func TestGraphBuilder_BuildFiltered(t *testing.T) {
+	ctx := context.Background()
+	// task-1 -> task-2 -> task-3 -> task-4、task-5（孤立、完了済み）、task-6 -> task-2
+	tasks := []TaskInfo{
+		{ID: "task-1", Title: "Task 1", Status: TaskStatusActive, Dependencies: []string{"task-2"}},
+		{ID: "task-2", Title: "Task 2", Status: TaskStatusActive, Dependencies: []string{"task-3"}},
+		{ID: "task-3", Title: "Task 3", Status: TaskStatusDeprecated, Dependencies: []string{"task-4"}},
+		{ID: "task-4", Title: "Task 4", Status: TaskStatusDraft},
+		{ID: "task-5", Title: "Task 5", Status: TaskStatusDeprecated},
+		{ID: "task-6", Title: "Task 6", Status: TaskStatusDraft, Dependencies: []string{"task-2"}},
+	}
+	nodeIDs := func(graph *DependencyGraph) []string {
+		ids := make([]string, 0, len(graph.Nodes))
+		for id := range graph.Nodes {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		return ids
+	}
+
+	tests := []struct {
+		name   string
+		filter DependencyGraphFilter
+		want   []string
+		edges  int
+	}{
+		{"no filter", DependencyGraphFilter{}, []string{"task-1", "task-2", "task-3", "task-4", "task-5", "task-6"}, 4},
+		{"subtree", DependencyGraphFilter{RootID: "task-2"}, []string{"task-2", "task-3", "task-4"}, 2},
+		{"subtree with depth", DependencyGraphFilter{RootID: "task-1", Depth: 1}, []string{"task-1", "task-2"}, 1},
+		{"hide completed", DependencyGraphFilter{HideCompleted: true}, []string{"task-1", "task-2", "task-4", "task-6"}, 2},
+		{"hide completed keeps root", DependencyGraphFilter{RootID: "task-3", HideCompleted: true}, []string{"task-3", "task-4"}, 1},
+		{"subtree stops at filtered task", DependencyGraphFilter{RootID: "task-1", HideCompleted: true}, []string{"task-1", "task-2"}, 1},
+		{"statuses", DependencyGraphFilter{Statuses: []string{TaskStatusDraft}}, []string{"task-4", "task-6"}, 0},
+		{"depth from roots", DependencyGraphFilter{Depth: 1}, []string{"task-1", "task-2", "task-5", "task-6"}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph, err := NewGraphBuilder(tasks).BuildFiltered(ctx, tt.filter)
+			if err != nil {
+				t.Fatalf("BuildFiltered failed: %v", err)
+			}
+			if got := nodeIDs(graph); !slices.Equal(got, tt.want) {
+				t.Errorf("nodes = %v, want %v", got, tt.want)
+			}
+			if len(graph.Edges) != tt.edges {
+				t.Errorf("edges = %v, want %d", graph.Edges, tt.edges)
+			}
+			if graph.Stats.TotalNodes != len(tt.want) {
+				t.Errorf("stats = %+v", graph.Stats)
+			}
+		})
+	}
+
+	if _, err := NewGraphBuilder(tasks).BuildFiltered(ctx, DependencyGraphFilter{RootID: "task-9"}); err == nil {
+		t.Error("unknown root should fail")
+	}
+	if _, err := NewGraphBuilder(tasks).BuildFiltered(ctx, DependencyGraphFilter{Depth: -1}); err == nil {
+		t.Error("negative depth should fail")
+	}
+}
+
 func TestDependencyGraph_ToText(t *testing.T) {
 	ctx := context.Background()
 
@@ -341,6 +404,39 @@ func TestDependencyGraph_ToDot_WithQuotes(t *testing.T) {
 	}
 }
 
+func TestDependencyGraph_ToPlantUML(t *testing.T) {
+	ctx := context.Background()
+
+	tasks := []TaskInfo{
+		{ID: "task-1", Title: "Task \"1\"", Status: TaskStatusCompleted, Dependencies: []string{}},
+		{ID: "task-2", Title: "Task 2", Status: TaskStatusBlocked, Dependencies: []string{"task-1"}},
+	}
+
+	builder := NewGraphBuilder(tasks)
+	graph, _ := builder.Build(ctx)
+
+	out := graph.ToPlantUML()
+
+	wants := []string{
+		"@startuml",
+		"rectangle \"Task '1'\" as task_1 #90EE90",
+		"rectangle \"Task 2\" as task_2 #F08080",
+		"-->",
+		"@enduml",
+	}
+	for _, want := range wants {
+		if !strings.Contains(out, want) {
+			t.Errorf("PlantUML 出力に %q が含まれていません:\n%s", want, out)
+		}
+	}
+
+	// nil グラフでも有効な PlantUML を返す
+	var empty *DependencyGraph
+	if !strings.Contains(empty.ToPlantUML(), "@enduml") {
+		t.Error("nil グラフの出力が不正です")
+	}
+}
+
 func TestDependencyGraph_ToMermaid_WithSpecialChars(t *testing.T) {
 	ctx := context.Background()
 
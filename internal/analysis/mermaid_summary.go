@@ -0,0 +1,314 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DefaultMermaidMaxNodes は Mermaid を要約表示に切り替えるノード数の既定値
+// これを超える図はブラウザでの描画が止まるほど大きくなるため、部分木をまとめて出力する
+const DefaultMermaidMaxNodes = 300
+
+// ToMermaidLimited はノード数が maxNodes 以下なら ToMermaid と同じ図を、超える場合は要約した図を返す
+// （maxNodes <= 0 は制限なし）。2 つ目の戻り値は要約したかどうか。
+// 要約では各 UseCase をそれを実装する Activity の部分木ごと 1 ノードにまとめ（+N 件）、
+// 子を持たない Activity は Objective ごと（グループ外は 1 つ）に件数のノードにまとめる。
+// それでも maxNodes を超える場合は Objective ごとに 1 ノードにまとめる
+func (g *UnifiedGraph) ToMermaidLimited(maxNodes int) (string, bool) {
+	if maxNodes <= 0 || len(g.Nodes) <= maxNodes {
+		return g.ToMermaid(), false
+	}
+
+	groupOf := make(map[string]string)
+	for _, group := range g.Groups {
+		for _, nid := range group.NodeIDs {
+			groupOf[nid] = group.ID
+		}
+	}
+
+	// 構造上のルート（親を持たないノード）ごとに部分木のノード数を数える
+	ids := make([]string, 0, len(g.Nodes))
+	for id := range g.Nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	rootOf := make(map[string]string, len(ids))
+	size := make(map[string]int)
+	for _, id := range ids {
+		root := g.structuralRoot(id)
+		rootOf[id] = root
+		size[root]++
+	}
+
+	// 部分木を持つルートと、グループごとの子を持たないノードの数
+	roots := []string{}
+	loose := make(map[string]int) // グループ ID（グループ外は ""）-> ノード数
+	for _, id := range ids {
+		if rootOf[id] != id {
+			continue
+		}
+		if size[id] > 1 {
+			roots = append(roots, id)
+		} else {
+			loose[groupOf[id]]++
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("```mermaid\ngraph TD\n")
+	fmt.Fprintf(&sb, "  %%%% %d nodes summarized (max_nodes: %d)\n", len(g.Nodes), maxNodes)
+
+	if len(roots)+len(loose) > maxNodes {
+		// Objective ごとに 1 ノード
+		counts := make(map[string]map[EntityType]int)
+		for _, id := range ids {
+			gid := groupOf[rootOf[id]]
+			if counts[gid] == nil {
+				counts[gid] = make(map[EntityType]int)
+			}
+			counts[gid][g.Nodes[id].Type]++
+		}
+		summaries := []string{}
+		for _, group := range g.Groups {
+			if c, ok := counts[group.ID]; ok {
+				id := mermaidSummaryID(group.ID)
+				fmt.Fprintf(&sb, "  %s[\"%s: %s %s\"]\n", id, group.ID, escapeMermaidText(group.Title), escapeMermaidText(summaryCounts(c)))
+				summaries = append(summaries, id)
+			}
+		}
+		if c, ok := counts[""]; ok {
+			id := mermaidSummaryID("-loose")
+			fmt.Fprintf(&sb, "  %s[\"ungrouped %s\"]\n", id, escapeMermaidText(summaryCounts(c)))
+			summaries = append(summaries, id)
+		}
+		sb.WriteString("\n  %% Styles\n")
+		sb.WriteString("  classDef summary fill:#9E9E9E,stroke:#333,color:#fff\n")
+		fmt.Fprintf(&sb, "  class %s summary\n", strings.Join(summaries, ","))
+		sb.WriteString("```\n")
+		return sb.String(), true
+	}
+
+	// Objective ごとのサブグラフに、部分木をまとめたノードと子を持たないノードの件数を出力
+	byGroup := make(map[string][]string)
+	for _, root := range roots {
+		byGroup[groupOf[root]] = append(byGroup[groupOf[root]], root)
+	}
+	writeGroup := func(gid, indent string) {
+		for _, root := range byGroup[gid] {
+			node := g.Nodes[root]
+			label := fmt.Sprintf("%s: %s (+%d)", root, node.Title, size[root]-1)
+			if node.Type == EntityTypeUseCase {
+				fmt.Fprintf(&sb, "%s%s((\"%s\"))\n", indent, root, escapeMermaidText(label))
+			} else {
+				fmt.Fprintf(&sb, "%s%s([\"%s\"])\n", indent, root, escapeMermaidText(label))
+			}
+		}
+		if n := loose[gid]; n > 0 {
+			fmt.Fprintf(&sb, "%s%s[\"%d more\"]\n", indent, mermaidSummaryID(gid+"-loose"), n)
+		}
+	}
+	for _, group := range g.Groups {
+		if len(byGroup[group.ID]) == 0 && loose[group.ID] == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "  subgraph %s[\"%s: %s\"]\n", strings.ReplaceAll(group.ID, "-", "_"), group.ID, escapeMermaidText(group.Title))
+		writeGroup(group.ID, "    ")
+		sb.WriteString("  end\n\n")
+	}
+	writeGroup("", "  ")
+
+	// まとめたノード間のエッジ（同じ部分木の中のエッジは除く）
+	sb.WriteString("\n")
+	seen := make(map[string]bool)
+	for _, edge := range g.Edges {
+		from, to := rootOf[edge.From], rootOf[edge.To]
+		if from == "" || to == "" || from == to || size[from] == 1 || size[to] == 1 {
+			continue
+		}
+		if key := from + ">" + to; !seen[key] {
+			seen[key] = true
+			fmt.Fprintf(&sb, "  %s --> %s\n", from, to)
+		}
+	}
+
+	sb.WriteString("\n  %% Styles\n")
+	sb.WriteString("  classDef activity fill:#4CAF50,stroke:#333,color:#fff\n")
+	sb.WriteString("  classDef usecase fill:#2196F3,stroke:#333,color:#fff\n")
+	sb.WriteString("  classDef summary fill:#9E9E9E,stroke:#333,color:#fff\n")
+	for _, root := range roots {
+		class := "activity"
+		if g.Nodes[root].Type == EntityTypeUseCase {
+			class = "usecase"
+		}
+		fmt.Fprintf(&sb, "  class %s %s\n", root, class)
+	}
+	gids := make([]string, 0, len(loose))
+	for gid := range loose {
+		gids = append(gids, mermaidSummaryID(gid+"-loose"))
+	}
+	if len(gids) > 0 {
+		sort.Strings(gids)
+		fmt.Fprintf(&sb, "  class %s summary\n", strings.Join(gids, ","))
+	}
+	sb.WriteString("```\n")
+	return sb.String(), true
+}
+
+// structuralRoot は構造上の最初の親をたどった先のルートノード ID（循環している場合はたどり始めたノード）
+func (g *UnifiedGraph) structuralRoot(id string) string {
+	visited := map[string]bool{id: true}
+	current := id
+	for {
+		node, ok := g.Nodes[current]
+		if !ok || len(node.StructuralParents) == 0 {
+			return current
+		}
+		parent := node.StructuralParents[0]
+		if _, ok := g.Nodes[parent]; !ok || visited[parent] {
+			if visited[parent] {
+				return id
+			}
+			return current
+		}
+		visited[parent] = true
+		current = parent
+	}
+}
+
+// summaryCounts は種別ごとのノード数を "(2 usecase, 30 activity)" の形式にする
+func summaryCounts(counts map[EntityType]int) string {
+	parts := []string{}
+	for _, t := range []EntityType{EntityTypeObjective, EntityTypeUseCase, EntityTypeActivity} {
+		if counts[t] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[t], t))
+		}
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+// mermaidSummaryID は要約ノードの Mermaid ID（元のノード ID と衝突しないよう接頭辞を付ける）
+func mermaidSummaryID(id string) string {
+	if id == "-loose" {
+		return "summary_ungrouped"
+	}
+	return "summary_" + strings.ReplaceAll(id, "-", "_")
+}
+
+// ToMermaidLimited はノード数が maxNodes 以下なら ToMermaid と同じ図を、超える場合は要約した図を返す
+// （maxNodes <= 0 は制限なし）。2 つ目の戻り値は要約したかどうか。
+// 要約では依存関係でつながったノードの集まり（連結成分）ごとに 1 ノード（+N 件）にまとめ、
+// 依存関係のないノードはステータスごとの件数のノードにまとめる。
+// 連結成分がそれでも maxNodes を超える場合は、大きい順に maxNodes 件まで出力し、残りを 1 ノードにまとめる
+func (graph *DependencyGraph) ToMermaidLimited(maxNodes int) (string, bool) {
+	if maxNodes <= 0 || graph == nil || len(graph.Nodes) <= maxNodes {
+		return graph.ToMermaid(), false
+	}
+
+	ids := make([]string, 0, len(graph.Nodes))
+	for id := range graph.Nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	// 連結成分（依存の向きは無視）
+	component := make(map[string]int, len(ids))
+	components := [][]string{}
+	for _, start := range ids {
+		if _, ok := component[start]; ok {
+			continue
+		}
+		index := len(components)
+		members := []string{}
+		stack := []string{start}
+		component[start] = index
+		for len(stack) > 0 {
+			id := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			members = append(members, id)
+			node := graph.Nodes[id]
+			for _, next := range append(append([]string{}, node.Children...), node.Parents...) {
+				if _, ok := graph.Nodes[next]; !ok {
+					continue
+				}
+				if _, ok := component[next]; !ok {
+					component[next] = index
+					stack = append(stack, next)
+				}
+			}
+		}
+		sort.Strings(members)
+		components = append(components, members)
+	}
+
+	clusters := [][]string{}
+	isolated := make(map[string]int) // ステータス -> 件数
+	for _, members := range components {
+		if len(members) > 1 {
+			clusters = append(clusters, members)
+			continue
+		}
+		isolated[graph.Nodes[members[0]].Task.Status]++
+	}
+	sort.SliceStable(clusters, func(i, j int) bool { return len(clusters[i]) > len(clusters[j]) })
+	rest, restGroups := 0, 0
+	if len(clusters) > maxNodes {
+		for _, members := range clusters[maxNodes:] {
+			rest += len(members)
+		}
+		restGroups = len(clusters) - maxNodes
+		clusters = clusters[:maxNodes]
+	}
+
+	var sb strings.Builder
+	sb.WriteString("```mermaid\n")
+	sb.WriteString("graph TD\n")
+	fmt.Fprintf(&sb, "    %%%% %d nodes summarized (max_nodes: %d)\n", len(graph.Nodes), maxNodes)
+	for _, members := range clusters {
+		// 他のタスクから依存されていないノード（依存の起点）を代表にする
+		head := members[0]
+		for _, id := range members {
+			if len(graph.Nodes[id].Parents) == 0 {
+				head = id
+				break
+			}
+		}
+		label := strings.ReplaceAll(graph.Nodes[head].Task.Title, "\"", "'")
+		fmt.Fprintf(&sb, "    %s[\"%s (+%d)\"]\n", strings.ReplaceAll(head, "-", "_"), label, len(members)-1)
+	}
+	if rest > 0 {
+		fmt.Fprintf(&sb, "    summary_rest[\"%d more in %d groups\"]\n", rest, restGroups)
+	}
+	for _, status := range isolatedKeys(isolated) {
+		name := status
+		if name == "" {
+			name = "no status"
+		}
+		fmt.Fprintf(&sb, "    summary_%s[\"%d isolated (%s)\"]\n", mermaidSafeStatus(status), isolated[status], name)
+	}
+	sb.WriteString("```\n")
+	return sb.String(), true
+}
+
+// isolatedKeys はステータスを名前順に返す
+func isolatedKeys(counts map[string]int) []string {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// mermaidSafeStatus はステータスを Mermaid の ID に使える形にする
+func mermaidSafeStatus(status string) string {
+	if status == "" {
+		return "none"
+	}
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		}
+		return '_'
+	}, status)
+}
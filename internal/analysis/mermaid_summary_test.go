@@ -0,0 +1,119 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// buildSummaryTestGraph は Objective 2 件、UseCase 4 件（各 10 Activity）、UseCase に紐づかない Activity 5 件のグラフを作る
+func buildSummaryTestGraph() *UnifiedGraph {
+	objectives := []ObjectiveInfo{
+		{ID: "obj-001", Title: "Objective 1", Status: "active"},
+		{ID: "obj-002", Title: "Objective 2", Status: "active"},
+	}
+	var usecases []UseCaseInfo
+	var activities []ActivityInfo
+	for u := 1; u <= 4; u++ {
+		ucID := fmt.Sprintf("uc-%03d", u)
+		usecases = append(usecases, UseCaseInfo{
+			ID: ucID, Title: fmt.Sprintf("UseCase %d", u), Status: "active",
+			ObjectiveID: fmt.Sprintf("obj-%03d", (u+1)/2),
+		})
+		for a := 1; a <= 10; a++ {
+			activities = append(activities, ActivityInfo{
+				ID: fmt.Sprintf("act-%d%02d", u, a), Title: fmt.Sprintf("Activity %d-%d", u, a),
+				Status: "active", UseCaseID: ucID,
+			})
+		}
+	}
+	for a := 1; a <= 5; a++ {
+		activities = append(activities, ActivityInfo{
+			ID: fmt.Sprintf("act-9%02d", a), Title: fmt.Sprintf("Loose %d", a), Status: "active",
+		})
+	}
+	return NewUnifiedGraphBuilder().WithObjectives(objectives).WithUseCases(usecases).WithActivities(activities).Build()
+}
+
+func TestUnifiedGraph_ToMermaidLimited(t *testing.T) {
+	graph := buildSummaryTestGraph()
+	if len(graph.Nodes) != 49 {
+		t.Fatalf("expected 49 nodes, got %d", len(graph.Nodes))
+	}
+
+	// 閾値以下・無制限は ToMermaid と同じ
+	for _, maxNodes := range []int{0, 49, 100} {
+		if text, summarized := graph.ToMermaidLimited(maxNodes); summarized || text != graph.ToMermaid() {
+			t.Errorf("maxNodes %d: should not summarize", maxNodes)
+		}
+	}
+
+	// UseCase ごとに部分木をまとめ、紐づかない Activity は件数のノードにする
+	text, summarized := graph.ToMermaidLimited(20)
+	if !summarized {
+		t.Fatal("expected summarized output")
+	}
+	if len(text) >= len(graph.ToMermaid()) {
+		t.Errorf("summarized output should be smaller: %d >= %d", len(text), len(graph.ToMermaid()))
+	}
+	for _, want := range []string{"uc-001((\"uc-001: UseCase 1 #40;+10#41;\"))", "subgraph obj_001", "summary_ungrouped[\"5 more\"]", "49 nodes summarized"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "act-101") {
+		t.Errorf("collapsed activities should not appear:\n%s", text)
+	}
+
+	// それでも閾値を超える場合は Objective ごとに 1 ノード
+	text, summarized = graph.ToMermaidLimited(3)
+	if !summarized {
+		t.Fatal("expected summarized output")
+	}
+	for _, want := range []string{"summary_obj_001[\"obj-001: Objective 1 #40;2 usecase, 20 activity#41;\"]", "summary_ungrouped[\"ungrouped #40;5 activity#41;\"]"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "uc-001") {
+		t.Errorf("usecases should be collapsed into objectives:\n%s", text)
+	}
+}
+
+func TestDependencyGraph_ToMermaidLimited(t *testing.T) {
+	tasks := []TaskInfo{
+		{ID: "task-1", Title: "Chain A", Status: TaskStatusCompleted},
+		{ID: "task-2", Title: "Task 2", Status: TaskStatusPending, Dependencies: []string{"task-1"}},
+		{ID: "task-3", Title: "Task 3", Status: TaskStatusPending, Dependencies: []string{"task-2"}},
+		{ID: "task-4", Title: "Chain B", Status: TaskStatusPending},
+		{ID: "task-5", Title: "Task 5", Status: TaskStatusPending, Dependencies: []string{"task-4"}},
+		{ID: "task-6", Title: "Alone 1", Status: TaskStatusPending},
+		{ID: "task-7", Title: "Alone 2", Status: TaskStatusPending},
+		{ID: "task-8", Title: "Alone 3", Status: TaskStatusCompleted},
+	}
+	graph, err := NewGraphBuilder(tasks).Build(context.Background())
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	if text, summarized := graph.ToMermaidLimited(8); summarized || text != graph.ToMermaid() {
+		t.Error("graph within the limit should not be summarized")
+	}
+
+	text, summarized := graph.ToMermaidLimited(4)
+	if !summarized {
+		t.Fatal("expected summarized output")
+	}
+	for _, want := range []string{"(+2)\"]", "(+1)\"]", "2 isolated (pending)", "1 isolated (completed)"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in:\n%s", want, text)
+		}
+	}
+
+	// 連結成分が閾値を超える場合は残りを 1 ノードにまとめる
+	text, _ = graph.ToMermaidLimited(1)
+	if !strings.Contains(text, "(+2)\"]") || !strings.Contains(text, "summary_rest[\"2 more in 1 groups\"]") {
+		t.Errorf("expected overflow node in:\n%s", text)
+	}
+}
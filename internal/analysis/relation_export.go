@@ -0,0 +1,115 @@
+package analysis
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// JSON-LD の語彙とエンティティ ID の基底 IRI
+const (
+	relationGraphVocab = "https://github.com/biwakonbu/zeus/vocab#"
+	relationGraphBase  = "urn:zeus:"
+)
+
+// SortedNodes はノードを ID 順に返す
+func (g *RelationGraph) SortedNodes() []RelationNode {
+	nodes := make([]RelationNode, 0, len(g.Nodes))
+	for _, node := range g.Nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+	return nodes
+}
+
+// ResolvedEdges は両端のノードが存在するエッジを返す（参照切れは出力しない）
+func (g *RelationGraph) ResolvedEdges() []RelationEdge {
+	edges := make([]RelationEdge, 0, len(g.Edges))
+	for _, e := range g.Edges {
+		if _, ok := g.Nodes[e.From]; !ok {
+			continue
+		}
+		if _, ok := g.Nodes[e.To]; !ok {
+			continue
+		}
+		edges = append(edges, e)
+	}
+	return edges
+}
+
+// ToGraphML は GraphML 形式で出力（Neo4j の apoc.import.graphml、Gephi、yEd などで読み込める）
+// ノードの labels 属性にはエンティティ種別を設定する
+func (g *RelationGraph) ToGraphML() string {
+	var sb strings.Builder
+	sb.WriteString(xml.Header)
+	sb.WriteString(`<graphml xmlns="http://graphml.graphdrawing.org/xmlns">` + "\n")
+	sb.WriteString(`  <key id="type" for="node" attr.name="type" attr.type="string"/>` + "\n")
+	sb.WriteString(`  <key id="title" for="node" attr.name="title" attr.type="string"/>` + "\n")
+	sb.WriteString(`  <key id="relation" for="edge" attr.name="relation" attr.type="string"/>` + "\n")
+	sb.WriteString(`  <graph id="zeus" edgedefault="directed">` + "\n")
+	for _, node := range g.SortedNodes() {
+		fmt.Fprintf(&sb, "    <node id=\"%s\" labels=\":%s\">\n", xmlEscape(node.ID), xmlEscape(node.Type))
+		fmt.Fprintf(&sb, "      <data key=\"type\">%s</data>\n", xmlEscape(node.Type))
+		fmt.Fprintf(&sb, "      <data key=\"title\">%s</data>\n", xmlEscape(node.Title))
+		sb.WriteString("    </node>\n")
+	}
+	for i, e := range g.ResolvedEdges() {
+		fmt.Fprintf(&sb, "    <edge id=\"e%d\" source=\"%s\" target=\"%s\">\n", i, xmlEscape(e.From), xmlEscape(e.To))
+		fmt.Fprintf(&sb, "      <data key=\"relation\">%s</data>\n", xmlEscape(e.Relation))
+		sb.WriteString("    </edge>\n")
+	}
+	sb.WriteString("  </graph>\n")
+	sb.WriteString("</graphml>\n")
+	return sb.String()
+}
+
+// xmlEscape は XML の属性値・テキストとしてエスケープ
+func xmlEscape(s string) string {
+	var sb strings.Builder
+	_ = xml.EscapeText(&sb, []byte(s))
+	return sb.String()
+}
+
+// ToJSONLD は JSON-LD 形式で出力
+// 各エンティティを @graph のノードとし、参照は参照フィールド名（objective_id など）のプロパティで表す
+func (g *RelationGraph) ToJSONLD() ([]byte, error) {
+	references := make(map[string]map[string][]map[string]string)
+	for _, e := range g.ResolvedEdges() {
+		if references[e.From] == nil {
+			references[e.From] = make(map[string][]map[string]string)
+		}
+		references[e.From][e.Relation] = append(references[e.From][e.Relation], map[string]string{"@id": e.To})
+	}
+
+	items := make([]map[string]any, 0, len(g.Nodes))
+	for _, node := range g.SortedNodes() {
+		item := map[string]any{
+			"@id":   node.ID,
+			"@type": node.Type,
+			"title": node.Title,
+		}
+		for relation, targets := range references[node.ID] {
+			item[relation] = targets
+		}
+		items = append(items, item)
+	}
+
+	document := map[string]any{
+		"@context": map[string]any{
+			"@vocab": relationGraphVocab,
+			"@base":  relationGraphBase,
+		},
+		"@graph": items,
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(document); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
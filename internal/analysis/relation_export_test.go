@@ -0,0 +1,76 @@
+package analysis
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestRelationGraph_ToGraphML(t *testing.T) {
+	g := newTestRelationGraph()
+	g.AddNode("risk-001", "risk", `遅延 <"重大"> & 連鎖`)
+	g.AddEdge("risk-001", "obj-001", "objective_id")
+	g.AddEdge("risk-001", "obj-999", "objective_id") // 参照切れは出力しない
+
+	out := g.ToGraphML()
+	var doc struct {
+		Graph struct {
+			Nodes []struct {
+				ID     string `xml:"id,attr"`
+				Labels string `xml:"labels,attr"`
+				Data   []struct {
+					Key   string `xml:"key,attr"`
+					Value string `xml:",chardata"`
+				} `xml:"data"`
+			} `xml:"node"`
+			Edges []struct {
+				Source string `xml:"source,attr"`
+				Target string `xml:"target,attr"`
+			} `xml:"edge"`
+		} `xml:"graph"`
+	}
+	if err := xml.Unmarshal([]byte(out), &doc); err != nil {
+		t.Fatalf("GraphML should be valid XML: %v\n%s", err, out)
+	}
+	if len(doc.Graph.Nodes) != 7 || len(doc.Graph.Edges) != 5 {
+		t.Fatalf("expected 7 nodes and 5 edges, got %d / %d", len(doc.Graph.Nodes), len(doc.Graph.Edges))
+	}
+	for _, node := range doc.Graph.Nodes {
+		if node.ID != "risk-001" {
+			continue
+		}
+		if node.Labels != ":risk" || node.Data[1].Value != `遅延 <"重大"> & 連鎖` {
+			t.Errorf("unexpected risk node: %+v", node)
+		}
+	}
+	if strings.Contains(out, "obj-999") {
+		t.Error("dangling reference should not be exported")
+	}
+}
+
+func TestRelationGraph_ToJSONLD(t *testing.T) {
+	data, err := newTestRelationGraph().ToJSONLD()
+	if err != nil {
+		t.Fatalf("ToJSONLD failed: %v", err)
+	}
+	var doc struct {
+		Context map[string]string `json:"@context"`
+		Graph   []map[string]any  `json:"@graph"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("JSON-LD should be valid JSON: %v", err)
+	}
+	if doc.Context["@vocab"] == "" || len(doc.Graph) != 6 {
+		t.Fatalf("unexpected document: %s", data)
+	}
+	for _, item := range doc.Graph {
+		if item["@id"] != "dec-001" {
+			continue
+		}
+		refs, ok := item["consideration_id"].([]any)
+		if item["@type"] != "decision" || !ok || len(refs) != 1 || refs[0].(map[string]any)["@id"] != "con-001" {
+			t.Errorf("unexpected decision node: %+v", item)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package analysis
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrRelationNodeNotFound は経路探索の始点・終点が関係グラフに存在しない
+var ErrRelationNodeNotFound = errors.New("entity not found in relation graph")
+
+// RelationNode は関係グラフのノード（10 概念モデルのエンティティ）
+type RelationNode struct {
+	ID     string `json:"id"`
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status string `json:"status,omitempty"` // 状態を持つエンティティのみ
+}
+
+// RelationEdge はエンティティ間の参照関係（From が To を参照する）
+type RelationEdge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Relation string `json:"relation"` // 参照に使われているフィールド（objective_id など）
+}
+
+// RelationGraph は全エンティティの参照関係グラフ
+type RelationGraph struct {
+	Nodes map[string]RelationNode
+	Edges []RelationEdge
+}
+
+// NewRelationGraph は空の関係グラフを作成
+func NewRelationGraph() *RelationGraph {
+	return &RelationGraph{Nodes: make(map[string]RelationNode)}
+}
+
+// AddNode はノードを追加
+func (g *RelationGraph) AddNode(id, entityType, title string) {
+	g.AddNodeWithStatus(id, entityType, title, "")
+}
+
+// AddNodeWithStatus は状態付きのノードを追加
+func (g *RelationGraph) AddNodeWithStatus(id, entityType, title, status string) {
+	if id == "" {
+		return
+	}
+	g.Nodes[id] = RelationNode{ID: id, Type: entityType, Title: title, Status: status}
+}
+
+// AddEdge は from が to を参照する関係を追加（参照先が空の場合は無視）
+func (g *RelationGraph) AddEdge(from, to, relation string) {
+	if from == "" || to == "" {
+		return
+	}
+	g.Edges = append(g.Edges, RelationEdge{From: from, To: to, Relation: relation})
+}
+
+// PathStep は経路の 1 段
+// Forward が true の場合は From が To を参照し、false の場合は To が From を参照している
+type PathStep struct {
+	From     RelationNode `json:"from"`
+	To       RelationNode `json:"to"`
+	Relation string       `json:"relation"`
+	Forward  bool         `json:"forward"`
+}
+
+// RelationPath は 2 つのエンティティ間の経路
+type RelationPath struct {
+	From     RelationNode `json:"from"`
+	To       RelationNode `json:"to"`
+	Found    bool         `json:"found"`
+	Directed bool         `json:"directed"` // From から参照をたどるだけで To に到達できる（依存関係）
+	Steps    []PathStep   `json:"steps"`
+}
+
+// FindPath は from から to への最短経路を探索する
+// 参照の向きにたどれる経路（依存関係）を優先し、無い場合は参照の向きを問わない経路（階層上の関係）を返す
+func (g *RelationGraph) FindPath(from, to string) (*RelationPath, error) {
+	fromNode, ok := g.Nodes[from]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrRelationNodeNotFound, from)
+	}
+	toNode, ok := g.Nodes[to]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrRelationNodeNotFound, to)
+	}
+
+	result := &RelationPath{From: fromNode, To: toNode, Steps: []PathStep{}}
+	if steps, ok := g.shortestPath(from, to, true); ok {
+		result.Found, result.Directed, result.Steps = true, true, steps
+	} else if steps, ok := g.shortestPath(from, to, false); ok {
+		result.Found, result.Steps = true, steps
+	}
+	return result, nil
+}
+
+// shortestPath は幅優先探索で最短経路を求める（directed が false の場合は参照を逆向きにもたどる）
+func (g *RelationGraph) shortestPath(from, to string, directed bool) ([]PathStep, bool) {
+	adjacency := make(map[string][]PathStep)
+	for _, e := range g.Edges {
+		fromNode, ok1 := g.Nodes[e.From]
+		toNode, ok2 := g.Nodes[e.To]
+		if !ok1 || !ok2 {
+			continue
+		}
+		adjacency[e.From] = append(adjacency[e.From], PathStep{From: fromNode, To: toNode, Relation: e.Relation, Forward: true})
+		if !directed {
+			adjacency[e.To] = append(adjacency[e.To], PathStep{From: toNode, To: fromNode, Relation: e.Relation, Forward: false})
+		}
+	}
+	// 同じ長さの経路が複数ある場合に結果が安定するよう ID 順にたどる
+	for id := range adjacency {
+		steps := adjacency[id]
+		sort.SliceStable(steps, func(i, j int) bool { return steps[i].To.ID < steps[j].To.ID })
+	}
+
+	prev := map[string]PathStep{}
+	visited := map[string]bool{from: true}
+	queue := []string{from}
+	for len(queue) > 0 && !visited[to] {
+		current := queue[0]
+		queue = queue[1:]
+		for _, step := range adjacency[current] {
+			if visited[step.To.ID] {
+				continue
+			}
+			visited[step.To.ID] = true
+			prev[step.To.ID] = step
+			queue = append(queue, step.To.ID)
+		}
+	}
+	if !visited[to] {
+		return nil, false
+	}
+
+	steps := []PathStep{}
+	for id := to; id != from; id = prev[id].From.ID {
+		steps = append(steps, prev[id])
+	}
+	for i, j := 0, len(steps)-1; i < j; i, j = i+1, j-1 {
+		steps[i], steps[j] = steps[j], steps[i]
+	}
+	return steps, true
+}
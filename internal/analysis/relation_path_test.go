@@ -0,0 +1,79 @@
+package analysis
+
+import (
+	"errors"
+	"testing"
+)
+
+func newTestRelationGraph() *RelationGraph {
+	g := NewRelationGraph()
+	g.AddNode("vision-001", "vision", "V")
+	g.AddNode("obj-001", "objective", "O1")
+	g.AddNode("obj-002", "objective", "O2")
+	g.AddNode("con-001", "consideration", "C")
+	g.AddNode("dec-001", "decision", "D")
+	g.AddNode("const-001", "constraint", "Isolated")
+	g.AddEdge("obj-001", "vision-001", "vision")
+	g.AddEdge("obj-002", "vision-001", "vision")
+	g.AddEdge("con-001", "obj-001", "objective_id")
+	g.AddEdge("dec-001", "con-001", "consideration_id")
+	g.AddEdge("con-001", "", "decision_id") // 空の参照は無視
+	return g
+}
+
+func TestRelationGraph_FindPath_Directed(t *testing.T) {
+	path, err := newTestRelationGraph().FindPath("dec-001", "vision-001")
+	if err != nil {
+		t.Fatalf("FindPath failed: %v", err)
+	}
+	if !path.Found || !path.Directed || len(path.Steps) != 3 {
+		t.Fatalf("expected directed path of 3 steps, got %+v", path)
+	}
+	want := []string{"con-001", "obj-001", "vision-001"}
+	for i, step := range path.Steps {
+		if step.To.ID != want[i] || !step.Forward {
+			t.Errorf("step %d = %+v, want forward to %s", i, step, want[i])
+		}
+	}
+}
+
+func TestRelationGraph_FindPath_Undirected(t *testing.T) {
+	g := newTestRelationGraph()
+
+	// Objective から Decision へは参照を逆向きにたどる
+	path, err := g.FindPath("obj-001", "dec-001")
+	if err != nil {
+		t.Fatalf("FindPath failed: %v", err)
+	}
+	if !path.Found || path.Directed || len(path.Steps) != 2 {
+		t.Fatalf("expected undirected path of 2 steps, got %+v", path)
+	}
+	if path.Steps[0].Forward || path.Steps[0].Relation != "objective_id" || path.Steps[1].To.ID != "dec-001" {
+		t.Errorf("unexpected steps: %+v", path.Steps)
+	}
+
+	// 兄弟の Objective は Vision を介して関係する
+	path, err = g.FindPath("obj-001", "obj-002")
+	if err != nil {
+		t.Fatalf("FindPath failed: %v", err)
+	}
+	if !path.Found || len(path.Steps) != 2 || path.Steps[0].To.ID != "vision-001" || !path.Steps[0].Forward || path.Steps[1].Forward {
+		t.Errorf("unexpected sibling path: %+v", path)
+	}
+}
+
+func TestRelationGraph_FindPath_NotFound(t *testing.T) {
+	g := newTestRelationGraph()
+
+	path, err := g.FindPath("obj-001", "const-001")
+	if err != nil {
+		t.Fatalf("FindPath failed: %v", err)
+	}
+	if path.Found || len(path.Steps) != 0 {
+		t.Errorf("isolated node should not be reachable: %+v", path)
+	}
+
+	if _, err := g.FindPath("obj-001", "obj-999"); !errors.Is(err, ErrRelationNodeNotFound) {
+		t.Errorf("expected ErrRelationNodeNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+package analysis
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// DefaultRiskExposureIterations はリスク露出のシミュレーション回数のデフォルト
+const DefaultRiskExposureIterations = 10000
+
+// MaxRiskExposureIterations はリスク露出のシミュレーション回数の上限
+const MaxRiskExposureIterations = 1000000
+
+// riskExposureSeed はシミュレーションの乱数の種（同じ入力からは同じ結果を返す）
+const riskExposureSeed = 1
+
+// TriangularEstimate は最小・最頻・最大の三点見積もり（三角分布として扱う）
+type TriangularEstimate struct {
+	Min    float64 `json:"min"`
+	Likely float64 `json:"likely"`
+	Max    float64 `json:"max"`
+}
+
+// Mean は三角分布の平均
+func (e TriangularEstimate) Mean() float64 {
+	return (e.Min + e.Likely + e.Max) / 3
+}
+
+// sample は一様乱数 u（0〜1）から三角分布の値を求める（逆関数法）
+func (e TriangularEstimate) sample(u float64) float64 {
+	width := e.Max - e.Min
+	if width <= 0 {
+		return e.Min
+	}
+	mode := (e.Likely - e.Min) / width
+	if u < mode {
+		return e.Min + math.Sqrt(u*width*(e.Likely-e.Min))
+	}
+	return e.Max - math.Sqrt((1-u)*width*(e.Max-e.Likely))
+}
+
+// RiskExposureInput はシミュレーションの対象となるリスク
+type RiskExposureInput struct {
+	ID          string
+	Title       string
+	Probability float64             // 発生確率（0〜1）
+	Cost        *TriangularEstimate // 発生した場合のコスト影響（nil は影響なし）
+	Delay       *TriangularEstimate // 発生した場合の遅延（日数、nil は影響なし）
+}
+
+// ExposureStats はシミュレーションした露出の分布の要約
+type ExposureStats struct {
+	Expected float64 `json:"expected"` // 平均
+	P50      float64 `json:"p50"`
+	P90      float64 `json:"p90"`
+	Max      float64 `json:"max"`
+}
+
+// RiskExposureContribution はリスクごとの期待露出（発生確率 × 三点見積もりの平均）
+type RiskExposureContribution struct {
+	ID            string  `json:"id"`
+	Title         string  `json:"title"`
+	Probability   float64 `json:"probability"`
+	ExpectedCost  float64 `json:"expected_cost"`
+	ExpectedDelay float64 `json:"expected_delay"`
+}
+
+// RiskExposure はリスク露出のモンテカルロシミュレーション結果
+// 遅延はリスク同士が重ならない（直列に積み上がる）ものとして合計する
+type RiskExposure struct {
+	Iterations    int                        `json:"iterations"`
+	Risks         int                        `json:"risks"`
+	Cost          ExposureStats              `json:"cost"`
+	Delay         ExposureStats              `json:"delay"`
+	Contributions []RiskExposureContribution `json:"contributions"` // 期待コスト・期待遅延の大きい順
+}
+
+// SimulateRiskExposure は各リスクの発生と影響を iterations 回試行し、コストと遅延の合計の分布を求める
+// iterations が 0 以下の場合は DefaultRiskExposureIterations 回、上限は MaxRiskExposureIterations 回
+func SimulateRiskExposure(inputs []RiskExposureInput, iterations int) *RiskExposure {
+	if iterations <= 0 {
+		iterations = DefaultRiskExposureIterations
+	}
+	iterations = min(iterations, MaxRiskExposureIterations)
+	result := &RiskExposure{
+		Iterations:    iterations,
+		Risks:         len(inputs),
+		Contributions: []RiskExposureContribution{},
+	}
+	for _, in := range inputs {
+		c := RiskExposureContribution{ID: in.ID, Title: in.Title, Probability: in.Probability}
+		if in.Cost != nil {
+			c.ExpectedCost = in.Probability * in.Cost.Mean()
+		}
+		if in.Delay != nil {
+			c.ExpectedDelay = in.Probability * in.Delay.Mean()
+		}
+		result.Contributions = append(result.Contributions, c)
+	}
+	sort.SliceStable(result.Contributions, func(i, j int) bool {
+		a, b := result.Contributions[i], result.Contributions[j]
+		if a.ExpectedCost != b.ExpectedCost {
+			return a.ExpectedCost > b.ExpectedCost
+		}
+		if a.ExpectedDelay != b.ExpectedDelay {
+			return a.ExpectedDelay > b.ExpectedDelay
+		}
+		return a.ID < b.ID
+	})
+	if len(inputs) == 0 {
+		return result
+	}
+
+	rng := rand.New(rand.NewSource(riskExposureSeed))
+	costs := make([]float64, iterations)
+	delays := make([]float64, iterations)
+	for n := 0; n < iterations; n++ {
+		for _, in := range inputs {
+			// 発生の有無によらず乱数を同じ数だけ消費し、見積もりの変更が他のリスクの試行に影響しないようにする
+			occurred := rng.Float64() < in.Probability
+			uCost, uDelay := rng.Float64(), rng.Float64()
+			if !occurred {
+				continue
+			}
+			if in.Cost != nil {
+				costs[n] += in.Cost.sample(uCost)
+			}
+			if in.Delay != nil {
+				delays[n] += in.Delay.sample(uDelay)
+			}
+		}
+	}
+	result.Cost = summarizeExposure(costs)
+	result.Delay = summarizeExposure(delays)
+	return result
+}
+
+// summarizeExposure は試行結果から平均と分位点を求める（values は並べ替える）
+func summarizeExposure(values []float64) ExposureStats {
+	sort.Float64s(values)
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	percentile := func(p float64) float64 {
+		idx := int(math.Ceil(p*float64(len(values)))) - 1
+		if idx < 0 {
+			idx = 0
+		}
+		return values[idx]
+	}
+	return ExposureStats{
+		Expected: sum / float64(len(values)),
+		P50:      percentile(0.5),
+		P90:      percentile(0.9),
+		Max:      values[len(values)-1],
+	}
+}
@@ -0,0 +1,67 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSimulateRiskExposure(t *testing.T) {
+	inputs := []RiskExposureInput{
+		// 発生済み・固定値のリスクは常に同じ露出になる
+		{ID: "risk-1", Title: "発生済み", Probability: 1, Cost: &TriangularEstimate{Min: 100, Likely: 100, Max: 100}},
+		{ID: "risk-2", Title: "遅延", Probability: 0.5, Delay: &TriangularEstimate{Min: 2, Likely: 5, Max: 20}},
+	}
+
+	result := SimulateRiskExposure(inputs, 20000)
+	if result.Iterations != 20000 || result.Risks != 2 {
+		t.Fatalf("result = %d iterations, %d risks", result.Iterations, result.Risks)
+	}
+	if result.Cost.Expected != 100 || result.Cost.P90 != 100 || result.Cost.Max != 100 {
+		t.Errorf("cost = %+v, want 100 everywhere", result.Cost)
+	}
+
+	// 遅延の期待値は 0.5 × (2 + 5 + 20) / 3 = 4.5
+	if math.Abs(result.Delay.Expected-4.5) > 0.2 {
+		t.Errorf("delay expected = %.2f, want about 4.5", result.Delay.Expected)
+	}
+	if result.Delay.P50 > result.Delay.P90 || result.Delay.P90 > result.Delay.Max || result.Delay.Max > 20 {
+		t.Errorf("delay percentiles are out of order: %+v", result.Delay)
+	}
+
+	if len(result.Contributions) != 2 || result.Contributions[0].ID != "risk-1" ||
+		result.Contributions[0].ExpectedCost != 100 || result.Contributions[1].ExpectedDelay != 4.5 {
+		t.Errorf("contributions = %+v", result.Contributions)
+	}
+
+	// 同じ入力からは同じ結果を返す
+	if again := SimulateRiskExposure(inputs, 20000); again.Delay != result.Delay {
+		t.Errorf("simulation is not deterministic: %+v vs %+v", again.Delay, result.Delay)
+	}
+}
+
+func TestSimulateRiskExposure_Defaults(t *testing.T) {
+	empty := SimulateRiskExposure(nil, 0)
+	if empty.Iterations != DefaultRiskExposureIterations || empty.Risks != 0 || empty.Cost.Expected != 0 {
+		t.Errorf("empty result = %+v", empty)
+	}
+	if capped := SimulateRiskExposure(nil, MaxRiskExposureIterations+1); capped.Iterations != MaxRiskExposureIterations {
+		t.Errorf("iterations = %d, want %d", capped.Iterations, MaxRiskExposureIterations)
+	}
+}
+
+func TestTriangularEstimate_Sample(t *testing.T) {
+	e := TriangularEstimate{Min: 1, Likely: 3, Max: 9}
+	if got := e.sample(0); got != 1 {
+		t.Errorf("sample(0) = %v, want 1", got)
+	}
+	if got := e.sample(1); got != 9 {
+		t.Errorf("sample(1) = %v, want 9", got)
+	}
+	// 最頻値の累積確率は (likely - min) / (max - min) = 0.25
+	if got := e.sample(0.25); math.Abs(got-3) > 1e-9 {
+		t.Errorf("sample(0.25) = %v, want 3", got)
+	}
+	if got := (TriangularEstimate{Min: 4, Likely: 4, Max: 4}).sample(0.7); got != 4 {
+		t.Errorf("fixed sample = %v, want 4", got)
+	}
+}
@@ -2,6 +2,8 @@ package analysis
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"time"
 )
 
@@ -29,7 +31,7 @@ type StaleEntity struct {
 	Type           StaleType           `json:"type"`
 	EntityID       string              `json:"entity_id"`
 	EntityTitle    string              `json:"entity_title"`
-	EntityType     string              `json:"entity_type"` // task, objective
+	EntityType     string              `json:"entity_type"` // task, activity, objective
 	Recommendation StaleRecommendation `json:"recommendation"`
 	Message        string              `json:"message"`
 	DaysStale      int                 `json:"days_stale"`
@@ -49,6 +51,64 @@ type StaleAnalyzerConfig struct {
 	CompletedStaleDays int // 完了後何日で陳腐化とみなすか（デフォルト: 30）
 	BlockedStaleDays   int // ブロック状態が何日で陳腐化とみなすか（デフォルト: 14）
 	NoProgressDays     int // 進捗がない状態が何日で陳腐化とみなすか（デフォルト: 21）
+	// Entities はエンティティ種別（task, activity, objective）ごとの上書き（0 の項目は上の値を使用）
+	Entities map[string]StaleThresholds
+}
+
+// StaleThresholds はエンティティ種別ごとの陳腐化の閾値（日数、0 は共通の値を使用）
+type StaleThresholds struct {
+	CompletedStaleDays int
+	BlockedStaleDays   int
+	NoProgressDays     int
+}
+
+// Validate は閾値が 0 以上であることを検証
+func (c StaleAnalyzerConfig) Validate() error {
+	check := func(prefix string, t StaleThresholds) error {
+		for _, v := range []struct {
+			name  string
+			value int
+		}{
+			{"completed_days", t.CompletedStaleDays},
+			{"blocked_days", t.BlockedStaleDays},
+			{"no_progress_days", t.NoProgressDays},
+		} {
+			if v.value < 0 {
+				return fmt.Errorf("%s%s must be >= 0: %d", prefix, v.name, v.value)
+			}
+		}
+		return nil
+	}
+	if err := check("", StaleThresholds{c.CompletedStaleDays, c.BlockedStaleDays, c.NoProgressDays}); err != nil {
+		return err
+	}
+	entityTypes := make([]string, 0, len(c.Entities))
+	for entityType := range c.Entities {
+		entityTypes = append(entityTypes, entityType)
+	}
+	sort.Strings(entityTypes)
+	for _, entityType := range entityTypes {
+		if err := check("entities."+entityType+".", c.Entities[entityType]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// For はエンティティ種別に適用する閾値を返す（種別ごとの上書きを反映）
+func (c StaleAnalyzerConfig) For(entityType string) StaleThresholds {
+	t := StaleThresholds{c.CompletedStaleDays, c.BlockedStaleDays, c.NoProgressDays}
+	override := c.Entities[entityType]
+	if override.CompletedStaleDays != 0 {
+		t.CompletedStaleDays = override.CompletedStaleDays
+	}
+	if override.BlockedStaleDays != 0 {
+		t.BlockedStaleDays = override.BlockedStaleDays
+	}
+	if override.NoProgressDays != 0 {
+		t.NoProgressDays = override.NoProgressDays
+	}
+	return t
 }
 
 // DefaultStaleConfig はデフォルト設定
@@ -66,6 +126,11 @@ type StaleAnalyzer struct {
 	now        time.Time
 }
 
+// SetNow は分析の基準時刻を設定（テスト・再現用）
+func (s *StaleAnalyzer) SetNow(now time.Time) {
+	s.now = now
+}
+
 // NewStaleAnalyzer は新しい StaleAnalyzer を作成
 func NewStaleAnalyzer(
 	tasks []TaskInfo,
@@ -129,17 +194,23 @@ func (s *StaleAnalyzer) Analyze(ctx context.Context) (*StaleAnalysis, error) {
 
 // checkTaskStale はタスクの陳腐化をチェック
 func (s *StaleAnalyzer) checkTaskStale(task TaskInfo, referenced map[string]bool) *StaleEntity {
+	entityType := task.EntityType
+	if entityType == "" {
+		entityType = "task"
+	}
+	thresholds := s.config.For(entityType)
+
 	// 1. 完了/非推奨後 30 日以上経過
 	if task.Status == TaskStatusCompleted || task.Status == TaskStatusDeprecated {
 		completedAt := s.parseDate(task.CompletedAt)
 		if completedAt != nil {
 			days := int(s.now.Sub(*completedAt).Hours() / 24)
-			if days >= s.config.CompletedStaleDays {
+			if days >= thresholds.CompletedStaleDays {
 				return &StaleEntity{
 					Type:           StaleTypeCompletedOld,
 					EntityID:       task.ID,
 					EntityTitle:    task.Title,
-					EntityType:     "task",
+					EntityType:     entityType,
 					Recommendation: StaleRecommendArchive,
 					Message:        "完了後 " + itoa(days) + " 日が経過しています",
 					DaysStale:      days,
@@ -153,12 +224,12 @@ func (s *StaleAnalyzer) checkTaskStale(task TaskInfo, referenced map[string]bool
 		blockedAt := s.parseDate(task.UpdatedAt)
 		if blockedAt != nil {
 			days := int(s.now.Sub(*blockedAt).Hours() / 24)
-			if days >= s.config.BlockedStaleDays {
+			if days >= thresholds.BlockedStaleDays {
 				return &StaleEntity{
 					Type:           StaleTypeBlockedLong,
 					EntityID:       task.ID,
 					EntityTitle:    task.Title,
-					EntityType:     "task",
+					EntityType:     entityType,
 					Recommendation: StaleRecommendReview,
 					Message:        "保留状態が " + itoa(days) + " 日継続しています",
 					DaysStale:      days,
@@ -173,7 +244,7 @@ func (s *StaleAnalyzer) checkTaskStale(task TaskInfo, referenced map[string]bool
 			Type:           StaleTypeOrphaned,
 			EntityID:       task.ID,
 			EntityTitle:    task.Title,
-			EntityType:     "task",
+			EntityType:     entityType,
 			Recommendation: StaleRecommendReview,
 			Message:        "孤立したタスクです（参照なし）",
 			DaysStale:      0,
@@ -190,7 +261,7 @@ func (s *StaleAnalyzer) checkObjectiveStale(obj ObjectiveInfo, _ map[string]bool
 		updatedAt := s.parseDate(obj.UpdatedAt)
 		if updatedAt != nil {
 			days := int(s.now.Sub(*updatedAt).Hours() / 24)
-			if days >= s.config.CompletedStaleDays {
+			if days >= s.config.For("objective").CompletedStaleDays {
 				return &StaleEntity{
 					Type:           StaleTypeCompletedOld,
 					EntityID:       obj.ID,
@@ -504,3 +504,44 @@ func TestStaleAnalyzer_ComplexScenario(t *testing.T) {
 		t.Error("expected blocked long entity")
 	}
 }
+
+func TestStaleAnalyzerConfig_For(t *testing.T) {
+	config := DefaultStaleConfig
+	config.Entities = map[string]StaleThresholds{
+		"activity": {CompletedStaleDays: 90},
+	}
+
+	got := config.For("activity")
+	if got.CompletedStaleDays != 90 || got.BlockedStaleDays != DefaultStaleConfig.BlockedStaleDays {
+		t.Errorf("unexpected activity thresholds: %+v", got)
+	}
+	if got := config.For("objective"); got.CompletedStaleDays != DefaultStaleConfig.CompletedStaleDays {
+		t.Errorf("objective should use the common threshold: %+v", got)
+	}
+
+	config.Entities["objective"] = StaleThresholds{BlockedStaleDays: -1}
+	if err := config.Validate(); err == nil {
+		t.Error("negative threshold should be rejected")
+	}
+}
+
+func TestStaleAnalyzer_PerEntityThresholds(t *testing.T) {
+	completedAt := time.Now().AddDate(0, 0, -45).Format(time.RFC3339)
+	tasks := []TaskInfo{
+		{ID: "act-001", Title: "廃止済み", Status: TaskStatusDeprecated, CompletedAt: completedAt, ParentID: "uc-001", EntityType: "activity"},
+		{ID: "task-001", Title: "完了タスク", Status: TaskStatusCompleted, CompletedAt: completedAt, ParentID: "obj-001"},
+	}
+	config := DefaultStaleConfig
+	config.Entities = map[string]StaleThresholds{"activity": {CompletedStaleDays: 60}}
+
+	result, err := NewStaleAnalyzer(tasks, nil, &config).Analyze(context.Background())
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+	if len(result.StaleEntities) != 1 {
+		t.Fatalf("expected 1 stale entity, got %+v", result.StaleEntities)
+	}
+	if e := result.StaleEntities[0]; e.EntityID != "task-001" || e.EntityType != "task" {
+		t.Errorf("unexpected stale entity: %+v", e)
+	}
+}
@@ -0,0 +1,351 @@
+package analysis
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+)
+
+// TraceLink はトレーサビリティの欠落リンク種別
+type TraceLink string
+
+const (
+	TraceLinkVision    TraceLink = "vision"
+	TraceLinkObjective TraceLink = "objective"
+	TraceLinkUseCase   TraceLink = "usecase"
+	TraceLinkActivity  TraceLink = "activity"
+	TraceLinkQuality   TraceLink = "quality"
+)
+
+// TraceabilityRow はトレーサビリティマトリクスの 1 行
+// Vision → Objective → UseCase → Activity の 1 経路と Objective に紐づく Quality を表す
+type TraceabilityRow struct {
+	VisionID       string      `json:"vision_id,omitempty"`
+	ObjectiveID    string      `json:"objective_id,omitempty"`
+	ObjectiveTitle string      `json:"objective_title,omitempty"`
+	UseCaseID      string      `json:"usecase_id,omitempty"`
+	UseCaseTitle   string      `json:"usecase_title,omitempty"`
+	ActivityID     string      `json:"activity_id,omitempty"`
+	ActivityTitle  string      `json:"activity_title,omitempty"`
+	QualityIDs     []string    `json:"quality_ids,omitempty"`
+	Missing        []TraceLink `json:"missing,omitempty"` // 欠落しているリンク
+}
+
+// Complete は欠落リンクがないか判定
+func (r TraceabilityRow) Complete() bool {
+	return len(r.Missing) == 0
+}
+
+// TraceabilityStats はマトリクスの統計
+type TraceabilityStats struct {
+	TotalRows      int `json:"total_rows"`
+	CompleteRows   int `json:"complete_rows"`
+	IncompleteRows int `json:"incomplete_rows"`
+}
+
+// TraceabilityMatrix は要求トレーサビリティマトリクス
+type TraceabilityMatrix struct {
+	Rows  []TraceabilityRow `json:"rows"`
+	Stats TraceabilityStats `json:"stats"`
+}
+
+// TraceabilityBuilder はトレーサビリティマトリクスを構築する
+// カバレッジ分析と同じ Info 型（ObjectiveInfo など）を入力に使う
+type TraceabilityBuilder struct {
+	vision     VisionInfo
+	objectives []ObjectiveInfo
+	usecases   []UseCaseInfo
+	activities []ActivityInfo
+	quality    []QualityInfo
+}
+
+// NewTraceabilityBuilder は新しい TraceabilityBuilder を作成
+func NewTraceabilityBuilder(
+	vision VisionInfo,
+	objectives []ObjectiveInfo,
+	usecases []UseCaseInfo,
+	activities []ActivityInfo,
+	quality []QualityInfo,
+) *TraceabilityBuilder {
+	return &TraceabilityBuilder{
+		vision:     vision,
+		objectives: objectives,
+		usecases:   usecases,
+		activities: activities,
+		quality:    quality,
+	}
+}
+
+// Build はマトリクスを構築
+// Objective ごとに UseCase、UseCase ごとに Activity へ展開し、
+// 子を持たない要素や親が見つからない要素は欠落リンク付きの行として出力する
+func (b *TraceabilityBuilder) Build() *TraceabilityMatrix {
+	objectiveIDs := make(map[string]bool, len(b.objectives))
+	for _, obj := range b.objectives {
+		objectiveIDs[obj.ID] = true
+	}
+	usecaseIDs := make(map[string]bool, len(b.usecases))
+	for _, uc := range b.usecases {
+		usecaseIDs[uc.ID] = true
+	}
+
+	usecasesByObjective := make(map[string][]UseCaseInfo)
+	orphanUseCases := []UseCaseInfo{}
+	for _, uc := range b.usecases {
+		if objectiveIDs[uc.ObjectiveID] {
+			usecasesByObjective[uc.ObjectiveID] = append(usecasesByObjective[uc.ObjectiveID], uc)
+		} else {
+			orphanUseCases = append(orphanUseCases, uc)
+		}
+	}
+
+	activitiesByUseCase := make(map[string][]ActivityInfo)
+	orphanActivities := []ActivityInfo{}
+	for _, act := range b.activities {
+		if usecaseIDs[act.UseCaseID] {
+			activitiesByUseCase[act.UseCaseID] = append(activitiesByUseCase[act.UseCaseID], act)
+		} else {
+			orphanActivities = append(orphanActivities, act)
+		}
+	}
+
+	qualityByObjective := make(map[string][]string)
+	for _, q := range b.quality {
+		qualityByObjective[q.ObjectiveID] = append(qualityByObjective[q.ObjectiveID], q.ID)
+	}
+
+	objectives := append([]ObjectiveInfo(nil), b.objectives...)
+	sort.Slice(objectives, func(i, j int) bool { return objectives[i].ID < objectives[j].ID })
+
+	matrix := &TraceabilityMatrix{Rows: []TraceabilityRow{}}
+	addRow := func(row TraceabilityRow) {
+		if b.vision.ID == "" && row.ObjectiveID != "" {
+			row.Missing = append([]TraceLink{TraceLinkVision}, row.Missing...)
+		}
+		matrix.Rows = append(matrix.Rows, row)
+	}
+
+	for _, obj := range objectives {
+		base := TraceabilityRow{
+			VisionID:       b.vision.ID,
+			ObjectiveID:    obj.ID,
+			ObjectiveTitle: obj.Title,
+			QualityIDs:     sortedCopy(qualityByObjective[obj.ID]),
+		}
+		var qualityMissing []TraceLink
+		if len(base.QualityIDs) == 0 {
+			qualityMissing = []TraceLink{TraceLinkQuality}
+		}
+
+		usecases := usecasesByObjective[obj.ID]
+		sortUseCases(usecases)
+		if len(usecases) == 0 {
+			row := base
+			row.Missing = append([]TraceLink{TraceLinkUseCase, TraceLinkActivity}, qualityMissing...)
+			addRow(row)
+			continue
+		}
+
+		for _, uc := range usecases {
+			activities := activitiesByUseCase[uc.ID]
+			sortActivities(activities)
+			if len(activities) == 0 {
+				row := base
+				row.UseCaseID = uc.ID
+				row.UseCaseTitle = uc.Title
+				row.Missing = append([]TraceLink{TraceLinkActivity}, qualityMissing...)
+				addRow(row)
+				continue
+			}
+			for _, act := range activities {
+				row := base
+				row.UseCaseID = uc.ID
+				row.UseCaseTitle = uc.Title
+				row.ActivityID = act.ID
+				row.ActivityTitle = act.Title
+				row.Missing = qualityMissing
+				addRow(row)
+			}
+		}
+	}
+
+	// Objective に紐づかない UseCase
+	sortUseCases(orphanUseCases)
+	for _, uc := range orphanUseCases {
+		activities := activitiesByUseCase[uc.ID]
+		sortActivities(activities)
+		if len(activities) == 0 {
+			addRow(TraceabilityRow{
+				UseCaseID:    uc.ID,
+				UseCaseTitle: uc.Title,
+				Missing:      []TraceLink{TraceLinkObjective, TraceLinkActivity},
+			})
+			continue
+		}
+		for _, act := range activities {
+			addRow(TraceabilityRow{
+				UseCaseID:     uc.ID,
+				UseCaseTitle:  uc.Title,
+				ActivityID:    act.ID,
+				ActivityTitle: act.Title,
+				Missing:       []TraceLink{TraceLinkObjective},
+			})
+		}
+	}
+
+	// UseCase に紐づかない Activity
+	sortActivities(orphanActivities)
+	for _, act := range orphanActivities {
+		addRow(TraceabilityRow{
+			ActivityID:    act.ID,
+			ActivityTitle: act.Title,
+			Missing:       []TraceLink{TraceLinkUseCase},
+		})
+	}
+
+	for _, row := range matrix.Rows {
+		matrix.Stats.TotalRows++
+		if row.Complete() {
+			matrix.Stats.CompleteRows++
+		} else {
+			matrix.Stats.IncompleteRows++
+		}
+	}
+
+	return matrix
+}
+
+// Incomplete は欠落リンクを持つ行のみのマトリクスを返す
+func (m *TraceabilityMatrix) Incomplete() *TraceabilityMatrix {
+	result := &TraceabilityMatrix{Rows: []TraceabilityRow{}}
+	for _, row := range m.Rows {
+		if !row.Complete() {
+			result.Rows = append(result.Rows, row)
+		}
+	}
+	result.Stats = TraceabilityStats{
+		TotalRows:      len(result.Rows),
+		IncompleteRows: len(result.Rows),
+	}
+	return result
+}
+
+// traceHeader はマトリクスの列見出し
+var traceHeader = []string{"Vision", "Objective", "UseCase", "Activity", "Quality", "Missing"}
+
+// cells は行を列の値に変換
+func (r TraceabilityRow) cells() []string {
+	missing := make([]string, len(r.Missing))
+	for i, m := range r.Missing {
+		missing[i] = string(m)
+	}
+	return []string{
+		r.VisionID,
+		traceCell(r.ObjectiveID, r.ObjectiveTitle),
+		traceCell(r.UseCaseID, r.UseCaseTitle),
+		traceCell(r.ActivityID, r.ActivityTitle),
+		strings.Join(r.QualityIDs, " "),
+		strings.Join(missing, " "),
+	}
+}
+
+// ToMarkdown は Markdown テーブル形式で出力
+func (m *TraceabilityMatrix) ToMarkdown() string {
+	var sb strings.Builder
+
+	sb.WriteString("| " + strings.Join(traceHeader, " | ") + " |\n")
+	sb.WriteString("|" + strings.Repeat("---|", len(traceHeader)) + "\n")
+	for _, row := range m.Rows {
+		cells := row.cells()
+		for i, c := range cells {
+			cells[i] = strings.ReplaceAll(c, "|", "\\|")
+		}
+		if !row.Complete() {
+			cells[len(cells)-1] = "⚠ " + cells[len(cells)-1]
+		}
+		sb.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	}
+	fmt.Fprintf(&sb, "\n%d rows, %d complete, %d with missing links\n",
+		m.Stats.TotalRows, m.Stats.CompleteRows, m.Stats.IncompleteRows)
+
+	return sb.String()
+}
+
+// ToCSV は CSV 形式で出力
+func (m *TraceabilityMatrix) ToCSV() (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(traceHeader); err != nil {
+		return "", err
+	}
+	for _, row := range m.Rows {
+		if err := w.Write(row.cells()); err != nil {
+			return "", err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// ToHTML は HTML テーブル形式で出力（欠落のある行は強調表示）
+func (m *TraceabilityMatrix) ToHTML() string {
+	var sb strings.Builder
+
+	sb.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>Traceability Matrix</title>\n")
+	sb.WriteString("<style>table{border-collapse:collapse}th,td{border:1px solid #ccc;padding:4px 8px}tr.missing{background:#FFF3CD}</style>\n")
+	sb.WriteString("</head>\n<body>\n<table>\n<tr>")
+	for _, h := range traceHeader {
+		sb.WriteString("<th>" + html.EscapeString(h) + "</th>")
+	}
+	sb.WriteString("</tr>\n")
+	for _, row := range m.Rows {
+		if row.Complete() {
+			sb.WriteString("<tr>")
+		} else {
+			sb.WriteString("<tr class=\"missing\">")
+		}
+		for _, c := range row.cells() {
+			sb.WriteString("<td>" + html.EscapeString(c) + "</td>")
+		}
+		sb.WriteString("</tr>\n")
+	}
+	fmt.Fprintf(&sb, "</table>\n<p>%d rows, %d complete, %d with missing links</p>\n</body>\n</html>\n",
+		m.Stats.TotalRows, m.Stats.CompleteRows, m.Stats.IncompleteRows)
+
+	return sb.String()
+}
+
+// traceCell は ID とタイトルをセル表記に変換
+func traceCell(id, title string) string {
+	if id == "" {
+		return ""
+	}
+	if title == "" {
+		return id
+	}
+	return id + " " + title
+}
+
+// sortedCopy はソート済みのコピーを返す
+func sortedCopy(ids []string) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := append([]string(nil), ids...)
+	sort.Strings(result)
+	return result
+}
+
+func sortUseCases(usecases []UseCaseInfo) {
+	sort.Slice(usecases, func(i, j int) bool { return usecases[i].ID < usecases[j].ID })
+}
+
+func sortActivities(activities []ActivityInfo) {
+	sort.Slice(activities, func(i, j int) bool { return activities[i].ID < activities[j].ID })
+}
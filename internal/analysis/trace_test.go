@@ -0,0 +1,107 @@
+package analysis
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func sampleTraceabilityBuilder() *TraceabilityBuilder {
+	return NewTraceabilityBuilder(
+		VisionInfo{ID: "vision-001", Title: "Vision"},
+		[]ObjectiveInfo{
+			{ID: "obj-001", Title: "認証"},
+			{ID: "obj-002", Title: "決済"},
+		},
+		[]UseCaseInfo{
+			{ID: "uc-001", Title: "ログイン", ObjectiveID: "obj-001"},
+			{ID: "uc-002", Title: "ログアウト", ObjectiveID: "obj-001"},
+			{ID: "uc-003", Title: "迷子", ObjectiveID: "obj-999"},
+		},
+		[]ActivityInfo{
+			{ID: "act-001", Title: "ログインフロー", UseCaseID: "uc-001"},
+			{ID: "act-002", Title: "単独", UseCaseID: ""},
+		},
+		[]QualityInfo{
+			{ID: "qual-001", Title: "応答時間", ObjectiveID: "obj-001"},
+		},
+	)
+}
+
+func TestTraceabilityBuilder_Build(t *testing.T) {
+	matrix := sampleTraceabilityBuilder().Build()
+
+	want := []struct {
+		objective, usecase, activity string
+		missing                      []TraceLink
+	}{
+		{"obj-001", "uc-001", "act-001", nil},
+		{"obj-001", "uc-002", "", []TraceLink{TraceLinkActivity}},
+		{"obj-002", "", "", []TraceLink{TraceLinkUseCase, TraceLinkActivity, TraceLinkQuality}},
+		{"", "uc-003", "", []TraceLink{TraceLinkObjective, TraceLinkActivity}},
+		{"", "", "act-002", []TraceLink{TraceLinkUseCase}},
+	}
+	if len(matrix.Rows) != len(want) {
+		t.Fatalf("行数が正しくありません: got %d, want %d (%+v)", len(matrix.Rows), len(want), matrix.Rows)
+	}
+	for i, w := range want {
+		row := matrix.Rows[i]
+		if row.ObjectiveID != w.objective || row.UseCaseID != w.usecase || row.ActivityID != w.activity {
+			t.Errorf("row %d: got %s/%s/%s, want %s/%s/%s", i,
+				row.ObjectiveID, row.UseCaseID, row.ActivityID, w.objective, w.usecase, w.activity)
+		}
+		if len(row.Missing) != 0 || len(w.missing) != 0 {
+			if !reflect.DeepEqual(row.Missing, w.missing) {
+				t.Errorf("row %d: missing = %v, want %v", i, row.Missing, w.missing)
+			}
+		}
+	}
+	if matrix.Rows[0].QualityIDs[0] != "qual-001" {
+		t.Errorf("Quality が紐づいていません: %v", matrix.Rows[0].QualityIDs)
+	}
+	if matrix.Stats.TotalRows != 5 || matrix.Stats.CompleteRows != 1 || matrix.Stats.IncompleteRows != 4 {
+		t.Errorf("統計が正しくありません: %+v", matrix.Stats)
+	}
+
+	incomplete := matrix.Incomplete()
+	if len(incomplete.Rows) != 4 || incomplete.Stats.CompleteRows != 0 {
+		t.Errorf("Incomplete の結果が正しくありません: %+v", incomplete.Stats)
+	}
+}
+
+func TestTraceabilityBuilder_NoVision(t *testing.T) {
+	matrix := NewTraceabilityBuilder(
+		VisionInfo{},
+		[]ObjectiveInfo{{ID: "obj-001", Title: "目標"}},
+		nil, nil, nil,
+	).Build()
+
+	if len(matrix.Rows) != 1 || matrix.Rows[0].Missing[0] != TraceLinkVision {
+		t.Errorf("Vision 未作成時は vision の欠落を報告すべき: %+v", matrix.Rows)
+	}
+}
+
+func TestTraceabilityMatrix_Formats(t *testing.T) {
+	matrix := sampleTraceabilityBuilder().Build()
+
+	md := matrix.ToMarkdown()
+	for _, want := range []string{"| Vision | Objective |", "| vision-001 | obj-001 認証 | uc-001 ログイン | act-001 ログインフロー | qual-001 |  |", "⚠ activity", "5 rows, 1 complete"} {
+		if !strings.Contains(md, want) {
+			t.Errorf("Markdown 出力に %q が含まれていません:\n%s", want, md)
+		}
+	}
+
+	csv, err := matrix.ToCSV()
+	if err != nil {
+		t.Fatalf("ToCSV でエラー: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(csv), "\n")
+	if len(lines) != 6 || lines[0] != "Vision,Objective,UseCase,Activity,Quality,Missing" {
+		t.Errorf("CSV 出力が正しくありません:\n%s", csv)
+	}
+
+	html := matrix.ToHTML()
+	if strings.Count(html, `<tr class="missing">`) != 4 {
+		t.Errorf("HTML で欠落行が強調されていません:\n%s", html)
+	}
+}
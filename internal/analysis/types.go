@@ -19,6 +19,7 @@ type TaskInfo struct {
 	CreatedAt   string // 作成日時（ISO8601）
 	UpdatedAt   string // 更新日時（ISO8601）
 	CompletedAt string // 完了日時（ISO8601）
+	EntityType  string // 陳腐化分析で報告するエンティティ種別（空の場合は task）
 }
 
 // ProjectState は分析に必要なプロジェクト状態
@@ -73,6 +74,19 @@ type GraphStats struct {
 	MaxDepth         int // 最大深さ
 }
 
+// DependencyGraphFilter は依存関係グラフを構築する際の絞り込み条件（ゼロ値は絞り込まない）
+type DependencyGraphFilter struct {
+	RootID        string   // このタスクと、これが（推移的に）依存するタスクのみ含める
+	Depth         int      // RootID からの距離、RootID 未指定時はルートからの深さの上限（0 = 無制限）
+	Statuses      []string // 含めるステータス（空 = すべて、RootID のタスクは常に含める）
+	HideCompleted bool     // 完了済み（completed, deprecated）を除く
+}
+
+// IsZero は絞り込み条件が指定されていないか判定
+func (f DependencyGraphFilter) IsZero() bool {
+	return f.RootID == "" && f.Depth == 0 && len(f.Statuses) == 0 && !f.HideCompleted
+}
+
 // AnalysisResult は全分析結果を集約
 type AnalysisResult struct {
 	Graph *DependencyGraph // 依存関係グラフ
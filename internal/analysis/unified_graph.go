@@ -836,6 +836,77 @@ func (g *UnifiedGraph) ToMermaid() string {
 	return sb.String()
 }
 
+// ToPlantUML は PlantUML 形式で出力
+// Objective グループは package、Activity は rectangle、UseCase は usecase として表現する
+func (g *UnifiedGraph) ToPlantUML() string {
+	var sb strings.Builder
+
+	sb.WriteString("@startuml\n")
+
+	// グループに所属するノード ID を収集
+	groupedNodeIDs := make(map[string]bool)
+	for _, group := range g.Groups {
+		for _, nid := range group.NodeIDs {
+			groupedNodeIDs[nid] = true
+		}
+	}
+
+	// Objective をパッケージとして出力
+	for _, group := range g.Groups {
+		title := strings.ReplaceAll(group.Title, "\"", "'")
+		fmt.Fprintf(&sb, "package \"%s: %s\" {\n", group.ID, title)
+		for _, nid := range group.NodeIDs {
+			node, exists := g.Nodes[nid]
+			if !exists {
+				continue
+			}
+			plantUMLNodeDef(&sb, "  ", nid, node)
+		}
+		sb.WriteString("}\n\n")
+	}
+
+	// グループ外のノード
+	ids := make([]string, 0, len(g.Nodes))
+	for id := range g.Nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if groupedNodeIDs[id] {
+			continue
+		}
+		plantUMLNodeDef(&sb, "", id, g.Nodes[id])
+	}
+	sb.WriteString("\n")
+
+	edges := make([]UnifiedEdge, len(g.Edges))
+	copy(edges, g.Edges)
+	sortEdges(edges)
+
+	for _, edge := range edges {
+		switch edge.Relation {
+		case RelationImplements:
+			fmt.Fprintf(&sb, "%s ..> %s : implements\n", plantUMLID(edge.From), plantUMLID(edge.To))
+		default:
+			fmt.Fprintf(&sb, "%s --> %s\n", plantUMLID(edge.From), plantUMLID(edge.To))
+		}
+	}
+
+	sb.WriteString("@enduml\n")
+	return sb.String()
+}
+
+// plantUMLNodeDef は PlantUML ノード定義を出力
+func plantUMLNodeDef(sb *strings.Builder, indent, id string, node *UnifiedGraphNode) {
+	title := strings.ReplaceAll(node.Title, "\"", "'")
+	switch node.Type {
+	case EntityTypeActivity:
+		fmt.Fprintf(sb, "%srectangle \"%s: %s\" as %s #4CAF50\n", indent, id, title, plantUMLID(id))
+	case EntityTypeUseCase:
+		fmt.Fprintf(sb, "%susecase \"%s: %s\" as %s #2196F3\n", indent, id, title, plantUMLID(id))
+	}
+}
+
 // mermaidNodeDef は Mermaid ノード定義を出力
 func mermaidNodeDef(sb *strings.Builder, id string, node *UnifiedGraphNode) {
 	title := escapeMermaidText(node.Title)
@@ -390,6 +390,28 @@ func TestUnifiedGraph_ToMermaid(t *testing.T) {
 	}
 }
 
+func TestUnifiedGraph_ToPlantUML(t *testing.T) {
+	builder := NewUnifiedGraphBuilder()
+	usecases := []UseCaseInfo{
+		{ID: "uc-login", Title: "UseCase 1", Status: "active"},
+	}
+	activities := []ActivityInfo{
+		{ID: "act-001", Title: "Activity 1", Status: "active", UseCaseID: "uc-login"},
+	}
+	graph := builder.WithUseCases(usecases).WithActivities(activities).Build()
+
+	out := graph.ToPlantUML()
+	wants := []string{"@startuml", "usecase", "rectangle", ": implements", "@enduml"}
+	for _, want := range wants {
+		if !strings.Contains(out, want) {
+			t.Errorf("PlantUML 出力に %q が含まれていません:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "graph TD") {
+		t.Error("PlantUML 出力に Mermaid 構文が含まれています")
+	}
+}
+
 func TestUnifiedGraphBuilder_ValidationErrors(t *testing.T) {
 	// Activity が UseCase に implements する有効なエッジの検証
 	builder := NewUnifiedGraphBuilder()
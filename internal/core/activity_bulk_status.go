@@ -0,0 +1,154 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MaxBulkStatusItems は一括ステータス変更で一度に指定できる Activity の上限
+const MaxBulkStatusItems = 200
+
+// BulkStatus の項目ごとの結果
+const (
+	BulkStatusUpdated   = "updated"
+	BulkStatusUnchanged = "unchanged"
+	BulkStatusQueued    = "queued" // 承認待ちキューに追加
+	BulkStatusFailed    = "failed"
+)
+
+// BulkStatusItemResult は一括ステータス変更の Activity ごとの結果
+type BulkStatusItemResult struct {
+	ID         string `json:"id"`
+	Result     string `json:"result"` // updated, unchanged, queued, failed
+	From       string `json:"from,omitempty"`
+	Revision   string `json:"revision,omitempty"`    // 更新後のリビジョン
+	ApprovalID string `json:"approval_id,omitempty"` // queued の場合
+	Error      string `json:"error,omitempty"`
+}
+
+// BulkStatusResult は一括ステータス変更の結果
+type BulkStatusResult struct {
+	Status  ActivityStatus         `json:"status"`
+	Items   []BulkStatusItemResult `json:"items"` // 指定した順
+	Updated int                    `json:"updated"`
+	Failed  int                    `json:"failed"`
+}
+
+// BulkUpdateActivityStatus は複数の Activity のステータスを変更し、Activity ごとの結果を返す
+// 1 件の失敗で全体を中止せず、Activity ごとに検証・更新する
+// revisions に Activity のリビジョンがある場合は楽観的排他制御を行い、無い場合は現在のリビジョンで更新する
+// deprecated（完了）への変更は、Activity の UseCase の Objective に failed の品質ゲートがある場合は拒否する
+func (z *Zeus) BulkUpdateActivityStatus(ctx context.Context, ids []string, status ActivityStatus, revisions map[string]string) (*BulkStatusResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	switch status {
+	case ActivityStatusDraft, ActivityStatusActive, ActivityStatusDeprecated:
+	default:
+		return nil, fmt.Errorf("invalid activity status: %s", status)
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("ids is required")
+	}
+	if len(ids) > MaxBulkStatusItems {
+		return nil, fmt.Errorf("too many ids: %d (max %d)", len(ids), MaxBulkStatusItems)
+	}
+
+	result := &BulkStatusResult{Status: status, Items: make([]BulkStatusItemResult, 0, len(ids))}
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		item := BulkStatusItemResult{ID: id}
+		if seen[id] {
+			item.Result = BulkStatusFailed
+			item.Error = "duplicate id"
+		} else {
+			seen[id] = true
+			z.bulkUpdateActivityStatus(ctx, &item, status, revisions[id])
+		}
+		switch item.Result {
+		case BulkStatusUpdated:
+			result.Updated++
+		case BulkStatusFailed:
+			result.Failed++
+		}
+		result.Items = append(result.Items, item)
+	}
+	return result, nil
+}
+
+// bulkUpdateActivityStatus は 1 件の Activity のステータスを変更し、結果を item に設定
+func (z *Zeus) bulkUpdateActivityStatus(ctx context.Context, item *BulkStatusItemResult, status ActivityStatus, revision string) {
+	fail := func(err error) {
+		item.Result = BulkStatusFailed
+		item.Error = err.Error()
+	}
+	entity, err := z.Get(ctx, "activity", item.ID)
+	if err != nil {
+		fail(err)
+		return
+	}
+	act := entity.(*ActivityEntity)
+	item.From = string(act.Status)
+	if act.Status == status {
+		item.Result = BulkStatusUnchanged
+		return
+	}
+	if status == ActivityStatusDeprecated {
+		if failed := z.failedQualityGates(ctx, act); len(failed) > 0 {
+			fail(fmt.Errorf("quality gates failed: %s", strings.Join(failed, ", ")))
+			return
+		}
+	}
+	if revision == "" {
+		if revision, err = z.EntityRevision(ctx, "activity", item.ID); err != nil {
+			fail(err)
+			return
+		}
+	}
+
+	newRevision, err := z.UpdateEntity(ctx, "activity", item.ID, map[string]any{"status": string(status)}, revision)
+	var required *ApprovalRequiredError
+	switch {
+	case errors.As(err, &required):
+		item.Result = BulkStatusQueued
+		item.ApprovalID = required.ApprovalID
+	case err != nil:
+		fail(err)
+	default:
+		item.Result = BulkStatusUpdated
+		item.Revision = newRevision
+	}
+}
+
+// failedQualityGates は Activity の UseCase が属する Objective の品質ゲートのうち failed のもの（"qual-id/gate" 形式）
+func (z *Zeus) failedQualityGates(ctx context.Context, act *ActivityEntity) []string {
+	if act.UseCaseID == "" {
+		return nil
+	}
+	entity, err := z.Get(ctx, "usecase", act.UseCaseID)
+	if err != nil {
+		return nil
+	}
+	objectiveID := entity.(*UseCaseEntity).ObjectiveID
+	if objectiveID == "" {
+		return nil
+	}
+	var failed []string
+	z.forEachYaml(ctx, "quality", func(path string) {
+		var q QualityEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &q); err != nil || q.ObjectiveID != objectiveID {
+			return
+		}
+		for _, g := range q.Gates {
+			if g.Status == GateStatusFailed {
+				failed = append(failed, q.ID+"/"+g.Name)
+			}
+		}
+	})
+	return failed
+}
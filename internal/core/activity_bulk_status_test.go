@@ -0,0 +1,72 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestBulkUpdateActivityStatus(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	add := func(entity, title string, opts ...EntityOption) string {
+		t.Helper()
+		result, err := z.Add(ctx, entity, title, opts...)
+		if err != nil {
+			t.Fatalf("Add %s failed: %v", title, err)
+		}
+		return result.ID
+	}
+	obj := add("objective", "決済刷新")
+	uc := add("usecase", "決済する", WithUseCaseObjective(obj))
+	add("quality", "決済品質", WithQualityObjective(obj),
+		WithQualityMetrics([]QualityMetric{{ID: "m1", Name: "カバレッジ", Target: 80, Status: MetricStatusInProgress}}),
+		WithQualityGates([]QualityGate{{Name: "e2e", Criteria: []string{"E2E が通る"}, Status: GateStatusFailed}}))
+	gated := add("activity", "決済 API", WithActivityUseCase(uc))
+	free := add("activity", "ドキュメント")
+	done := add("activity", "調査", WithActivityStatus(ActivityStatusDeprecated))
+
+	result, err := z.BulkUpdateActivityStatus(ctx, []string{free, gated, done, "act-00000000", free}, ActivityStatusDeprecated, nil)
+	if err != nil {
+		t.Fatalf("BulkUpdateActivityStatus failed: %v", err)
+	}
+	want := []string{BulkStatusUpdated, BulkStatusFailed, BulkStatusUnchanged, BulkStatusFailed, BulkStatusFailed}
+	for i, item := range result.Items {
+		if item.Result != want[i] {
+			t.Errorf("items[%d] = %+v, want %s", i, item, want[i])
+		}
+	}
+	if result.Updated != 1 || result.Failed != 3 {
+		t.Errorf("updated = %d, failed = %d", result.Updated, result.Failed)
+	}
+	if !strings.Contains(result.Items[1].Error, "e2e") {
+		t.Errorf("quality gate error = %q", result.Items[1].Error)
+	}
+	if result.Items[0].From != "draft" || result.Items[0].Revision == "" {
+		t.Errorf("updated item = %+v", result.Items[0])
+	}
+	entity, _ := z.Get(ctx, "activity", free)
+	if entity.(*ActivityEntity).Status != ActivityStatusDeprecated {
+		t.Error("status should be updated")
+	}
+
+	// 古いリビジョンは項目ごとの失敗
+	result, err = z.BulkUpdateActivityStatus(ctx, []string{gated}, ActivityStatusActive, map[string]string{gated: "stale"})
+	if err != nil {
+		t.Fatalf("BulkUpdateActivityStatus failed: %v", err)
+	}
+	if result.Items[0].Result != BulkStatusFailed {
+		t.Errorf("stale revision item = %+v", result.Items[0])
+	}
+
+	// 不正なステータス・空の指定はリクエスト全体のエラー
+	if _, err := z.BulkUpdateActivityStatus(ctx, []string{free}, "done", nil); err == nil {
+		t.Error("invalid status should fail")
+	}
+	if _, err := z.BulkUpdateActivityStatus(ctx, nil, ActivityStatusActive, nil); err == nil {
+		t.Error("empty ids should fail")
+	}
+}
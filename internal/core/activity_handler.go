@@ -12,6 +12,7 @@ import (
 type ActivityHandler struct {
 	fileStore      FileStore
 	usecaseHandler *UseCaseHandler
+	index          *entityIndex
 }
 
 // NewActivityHandler は ActivityHandler を生成
@@ -19,6 +20,7 @@ func NewActivityHandler(fs FileStore, usecaseHandler *UseCaseHandler) *ActivityH
 	return &ActivityHandler{
 		fileStore:      fs,
 		usecaseHandler: usecaseHandler,
+		index:          newEntityIndex(fs, "activity", "activities"),
 	}
 }
 
@@ -74,8 +76,8 @@ func (h *ActivityHandler) Add(ctx context.Context, name string, opts ...EntityOp
 
 	// 個別ファイルに保存
 	filePath := filepath.Join("activities", id+".yaml")
-	if err := h.fileStore.WriteYaml(ctx, filePath, &activity); err != nil {
-		return nil, fmt.Errorf("failed to write activity file: %w", err)
+	if err := h.writeActivity(ctx, filePath, &activity); err != nil {
+		return nil, err
 	}
 
 	return &AddResult{
@@ -106,23 +108,14 @@ func (h *ActivityHandler) List(ctx context.Context, filter *ListFilter) (*ListRe
 		return nil, fmt.Errorf("failed to list activities directory: %w", err)
 	}
 
-	items := make([]ListItem, 0)
-	for _, file := range files {
-		if !hasYamlSuffix(file) {
-			continue
-		}
+	entries := h.index.Entries(ctx, files, func(file string) (IndexEntry, bool) {
 		var activity ActivityEntity
 		if err := h.fileStore.ReadYaml(ctx, filepath.Join("activities", file), &activity); err != nil {
-			continue // 読み込み失敗はスキップ
+			return IndexEntry{}, false
 		}
-		items = append(items, ListItem{
-			ID:        activity.ID,
-			Title:     activity.Title,
-			Status:    ItemStatus(activity.Status),
-			CreatedAt: activity.Metadata.CreatedAt,
-			UpdatedAt: activity.Metadata.UpdatedAt,
-		})
-	}
+		return activityIndexEntry(&activity), true
+	})
+	items := indexEntriesToListItems(entries)
 
 	return &ListResult{
 		Entity: h.Type(),
@@ -190,6 +183,12 @@ func (h *ActivityHandler) Update(ctx context.Context, id string, update any) err
 		if usecaseID, exists := updateMap["usecase_id"].(string); exists {
 			activity.UseCaseID = usecaseID
 		}
+		if err := applyScoreFields(&activity.RICE, &activity.WSJF, updateMap); err != nil {
+			return err
+		}
+		if err := applyCostFields(&activity.Cost, updateMap); err != nil {
+			return err
+		}
 	}
 
 	// 参照整合性チェック: UseCaseID（任意紐付け）
@@ -206,7 +205,7 @@ func (h *ActivityHandler) Update(ctx context.Context, id string, update any) err
 		return err
 	}
 
-	return h.fileStore.WriteYaml(ctx, filePath, &activity)
+	return h.writeActivity(ctx, filePath, &activity)
 }
 
 // Delete はアクティビティを削除
@@ -225,7 +224,10 @@ func (h *ActivityHandler) Delete(ctx context.Context, id string) error {
 		return ErrEntityNotFound
 	}
 
-	return h.fileStore.Delete(ctx, filePath)
+	if err := h.fileStore.Delete(ctx, filePath); err != nil {
+		return err
+	}
+	return h.index.Remove(ctx, id)
 }
 
 // GetAll は全アクティビティを取得（API用）
@@ -297,7 +299,7 @@ func (h *ActivityHandler) AddNode(ctx context.Context, activityID string, node A
 	activity.Nodes = append(activity.Nodes, node)
 	activity.Metadata.UpdatedAt = Now()
 
-	return h.fileStore.WriteYaml(ctx, filePath, &activity)
+	return h.writeActivity(ctx, filePath, &activity)
 }
 
 // AddTransition はアクティビティに遷移を追加
@@ -349,7 +351,26 @@ func (h *ActivityHandler) AddTransition(ctx context.Context, activityID string,
 	activity.Transitions = append(activity.Transitions, trans)
 	activity.Metadata.UpdatedAt = Now()
 
-	return h.fileStore.WriteYaml(ctx, filePath, &activity)
+	return h.writeActivity(ctx, filePath, &activity)
+}
+
+// writeActivity はアクティビティファイルを書き込み、インデックスを更新
+func (h *ActivityHandler) writeActivity(ctx context.Context, filePath string, activity *ActivityEntity) error {
+	if err := h.fileStore.WriteYaml(ctx, filePath, activity); err != nil {
+		return fmt.Errorf("failed to write activity file: %w", err)
+	}
+	return h.index.Upsert(ctx, activityIndexEntry(activity))
+}
+
+// activityIndexEntry はアクティビティからインデックスエントリを生成
+func activityIndexEntry(activity *ActivityEntity) IndexEntry {
+	return IndexEntry{
+		ID:        activity.ID,
+		Title:     activity.Title,
+		Status:    string(activity.Status),
+		CreatedAt: activity.Metadata.CreatedAt,
+		UpdatedAt: activity.Metadata.UpdatedAt,
+	}
 }
 
 // generateActivityID はアクティビティ ID を生成（UUID 形式）
@@ -0,0 +1,211 @@
+package core
+
+import (
+	"context"
+	"fmt"
+)
+
+// アクティビティの実行可能性チェックで見つかる問題の種類
+const (
+	ActivityIssueInitialCount = "initial_count" // initial ノードが 1 つでない
+	ActivityIssueNoFinal      = "no_final"      // initial から到達できる final ノードが無い
+	ActivityIssueUnreachable  = "unreachable"   // initial から到達できないノード
+	ActivityIssueDeadEnd      = "dead_end"      // final 以外で出ていく遷移が無いノード（行き止まり）
+	ActivityIssueNoExit       = "no_exit"       // 出ていく遷移はあるが final に到達できないノード（抜けられないループ）
+	ActivityIssueMissingGuard = "missing_guard" // 分岐する decision の遷移にガード条件が無い
+)
+
+// ActivityIssue はアクティビティを実行（ウォークスルー）できない原因
+type ActivityIssue struct {
+	Kind    string `json:"kind"`
+	NodeID  string `json:"node_id,omitempty"`
+	Message string `json:"message"`
+}
+
+// InitialNodes は initial ノードの ID を定義順で返す
+func (a *ActivityEntity) InitialNodes() []string {
+	ids := make([]string, 0, 1)
+	for _, node := range a.Nodes {
+		if node.Type == ActivityNodeTypeInitial {
+			ids = append(ids, node.ID)
+		}
+	}
+	return ids
+}
+
+// Outgoing は nodeID から出ていく遷移を定義順で返す
+func (a *ActivityEntity) Outgoing(nodeID string) []ActivityTransition {
+	var out []ActivityTransition
+	for _, trans := range a.Transitions {
+		if trans.Source == nodeID {
+			out = append(out, trans)
+		}
+	}
+	return out
+}
+
+// Node は ID のノードを返す
+func (a *ActivityEntity) Node(id string) (ActivityNode, bool) {
+	for _, node := range a.Nodes {
+		if node.ID == id {
+			return node, true
+		}
+	}
+	return ActivityNode{}, false
+}
+
+// CheckExecutable はアクティビティのノードと遷移を initial から final まで辿れるかを検証する
+// - initial ノードがちょうど 1 つある
+// - initial から final ノードに到達できる
+// - すべてのノードに initial から到達でき、到達したノードから final に抜けられる
+// - 複数の遷移が出ていく decision ノードの遷移にはガード条件がある
+func (a *ActivityEntity) CheckExecutable() []ActivityIssue {
+	issues := make([]ActivityIssue, 0)
+
+	initials := a.InitialNodes()
+	if len(initials) != 1 {
+		issues = append(issues, ActivityIssue{
+			Kind:    ActivityIssueInitialCount,
+			Message: fmt.Sprintf("activity must have exactly one initial node (found %d)", len(initials)),
+		})
+	}
+
+	next := make(map[string][]string)
+	prev := make(map[string][]string)
+	for _, trans := range a.Transitions {
+		next[trans.Source] = append(next[trans.Source], trans.Target)
+		prev[trans.Target] = append(prev[trans.Target], trans.Source)
+	}
+	var finals []string
+	for _, node := range a.Nodes {
+		if node.Type == ActivityNodeTypeFinal {
+			finals = append(finals, node.ID)
+		}
+	}
+	reachable := activityReach(initials, next)
+	canFinish := activityReach(finals, prev)
+
+	if len(initials) > 0 {
+		reachedFinal := false
+		for _, id := range finals {
+			reachedFinal = reachedFinal || reachable[id]
+		}
+		if !reachedFinal {
+			issues = append(issues, ActivityIssue{
+				Kind:    ActivityIssueNoFinal,
+				Message: "no final node is reachable from the initial node",
+			})
+		}
+	}
+
+	for _, node := range a.Nodes {
+		out := a.Outgoing(node.ID)
+		switch {
+		case len(initials) > 0 && !reachable[node.ID]:
+			issues = append(issues, ActivityIssue{
+				Kind: ActivityIssueUnreachable, NodeID: node.ID,
+				Message: fmt.Sprintf("%s is not reachable from the initial node", activityNodeLabel(node)),
+			})
+		case node.Type != ActivityNodeTypeFinal && len(out) == 0:
+			issues = append(issues, ActivityIssue{
+				Kind: ActivityIssueDeadEnd, NodeID: node.ID,
+				Message: fmt.Sprintf("%s has no outgoing transition", activityNodeLabel(node)),
+			})
+		case len(finals) > 0 && !canFinish[node.ID]:
+			issues = append(issues, ActivityIssue{
+				Kind: ActivityIssueNoExit, NodeID: node.ID,
+				Message: fmt.Sprintf("%s cannot reach a final node", activityNodeLabel(node)),
+			})
+		}
+
+		if node.Type == ActivityNodeTypeDecision && len(out) > 1 {
+			for _, trans := range out {
+				if trans.Guard == "" {
+					issues = append(issues, ActivityIssue{
+						Kind: ActivityIssueMissingGuard, NodeID: node.ID,
+						Message: fmt.Sprintf("transition %s from decision %s has no guard", trans.ID, activityNodeLabel(node)),
+					})
+				}
+			}
+		}
+	}
+	return issues
+}
+
+// activityReach は start から edges を辿って到達できるノード
+func activityReach(start []string, edges map[string][]string) map[string]bool {
+	visited := make(map[string]bool)
+	queue := append([]string(nil), start...)
+	for _, id := range start {
+		visited[id] = true
+	}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, id := range edges[current] {
+			if !visited[id] {
+				visited[id] = true
+				queue = append(queue, id)
+			}
+		}
+	}
+	return visited
+}
+
+// activityNodeLabel はメッセージ用のノード表記（"node-003 (入力)"）
+func activityNodeLabel(node ActivityNode) string {
+	if node.Name == "" {
+		return fmt.Sprintf("%s (%s)", node.ID, node.Type)
+	}
+	return fmt.Sprintf("%s (%s)", node.ID, node.Name)
+}
+
+// ActivityDeadEndTitle はアクティビティの行き止まりを記録する Problem のタイトル
+func ActivityDeadEndTitle(activityID, nodeID string) string {
+	return fmt.Sprintf("Activity %s の行き止まり: %s", activityID, nodeID)
+}
+
+// RecordActivityDeadEnds はウォークスルーで見つかった行き止まりを Problem として記録する
+// 同じタイトルの未解決（open / in_progress）の Problem がある行き止まりは記録しない
+// 作成した Problem の ID を返す
+func (z *Zeus) RecordActivityDeadEnds(ctx context.Context, activityID string, nodeIDs []string) ([]string, error) {
+	got, err := z.Get(ctx, "activity", activityID)
+	if err != nil {
+		return nil, err
+	}
+	act := got.(*ActivityEntity)
+
+	open := map[string]bool{}
+	z.forEachYaml(ctx, "problems", func(path string) {
+		var p ProblemEntity
+		if z.fileStore.ReadYaml(ctx, path, &p) != nil {
+			return
+		}
+		if p.Status == ProblemStatusOpen || p.Status == ProblemStatusInProgress {
+			open[p.Title] = true
+		}
+	})
+
+	created := make([]string, 0)
+	for _, nodeID := range nodeIDs {
+		node, ok := act.Node(nodeID)
+		if !ok {
+			return created, fmt.Errorf("%w: node %s in %s", ErrEntityNotFound, nodeID, activityID)
+		}
+		title := ActivityDeadEndTitle(activityID, nodeID)
+		if open[title] {
+			continue
+		}
+		result, err := z.Add(ctx, "problem", title,
+			WithProblemSeverity(ProblemSeverityMedium),
+			WithProblemDescription(fmt.Sprintf("Activity %s（%s）のウォークスルーで、%s から先に進めませんでした。", act.ID, act.Title, activityNodeLabel(node))),
+			WithProblemImpact("アクティビティのフローが終了ノードに到達しない"),
+		)
+		if err != nil {
+			return created, err
+		}
+		open[title] = true
+		created = append(created, result.ID)
+	}
+	return created, nil
+}
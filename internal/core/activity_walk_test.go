@@ -0,0 +1,112 @@
+package core
+
+import (
+	"context"
+	"testing"
+)
+
+func TestActivityCheckExecutable(t *testing.T) {
+	valid := &ActivityEntity{
+		Nodes: []ActivityNode{
+			{ID: "node-001", Type: ActivityNodeTypeInitial},
+			{ID: "node-002", Type: ActivityNodeTypeDecision, Name: "認証"},
+			{ID: "node-003", Type: ActivityNodeTypeAction, Name: "ログイン"},
+			{ID: "node-004", Type: ActivityNodeTypeFinal},
+		},
+		Transitions: []ActivityTransition{
+			{ID: "trans-001", Source: "node-001", Target: "node-002"},
+			{ID: "trans-002", Source: "node-002", Target: "node-003", Guard: "[成功]"},
+			{ID: "trans-003", Source: "node-002", Target: "node-004", Guard: "[else]"},
+			{ID: "trans-004", Source: "node-003", Target: "node-004"},
+		},
+	}
+	if issues := valid.CheckExecutable(); len(issues) != 0 {
+		t.Errorf("expected no issues, got %+v", issues)
+	}
+
+	broken := &ActivityEntity{
+		Nodes: []ActivityNode{
+			{ID: "node-001", Type: ActivityNodeTypeInitial},
+			{ID: "node-002", Type: ActivityNodeTypeDecision, Name: "認証"},
+			{ID: "node-003", Type: ActivityNodeTypeAction, Name: "ログイン"},
+			{ID: "node-004", Type: ActivityNodeTypeAction, Name: "再試行"},
+			{ID: "node-005", Type: ActivityNodeTypeAction, Name: "待機"},
+			{ID: "node-006", Type: ActivityNodeTypeFinal},
+			{ID: "node-007", Type: ActivityNodeTypeAction, Name: "未使用"},
+		},
+		Transitions: []ActivityTransition{
+			{ID: "trans-001", Source: "node-001", Target: "node-002"},
+			{ID: "trans-002", Source: "node-002", Target: "node-003", Guard: "[成功]"},
+			{ID: "trans-003", Source: "node-002", Target: "node-004"},
+			{ID: "trans-004", Source: "node-002", Target: "node-005", Guard: "[待機]"},
+			{ID: "trans-005", Source: "node-005", Target: "node-005"},
+			{ID: "trans-006", Source: "node-003", Target: "node-006"},
+			{ID: "trans-007", Source: "node-007", Target: "node-006"},
+		},
+	}
+	got := map[string]string{}
+	for _, issue := range broken.CheckExecutable() {
+		got[issue.Kind] = issue.NodeID
+	}
+	want := map[string]string{
+		ActivityIssueDeadEnd:      "node-004",
+		ActivityIssueNoExit:       "node-005",
+		ActivityIssueUnreachable:  "node-007",
+		ActivityIssueMissingGuard: "node-002",
+	}
+	for kind, node := range want {
+		if got[kind] != node {
+			t.Errorf("issue %s: got node %q, want %q (all: %+v)", kind, got[kind], node, got)
+		}
+	}
+	if _, ok := got[ActivityIssueNoFinal]; ok {
+		t.Error("final is reachable, no_final should not be reported")
+	}
+
+	empty := &ActivityEntity{Nodes: []ActivityNode{{ID: "node-001", Type: ActivityNodeTypeAction}}}
+	kinds := map[string]bool{}
+	for _, issue := range empty.CheckExecutable() {
+		kinds[issue.Kind] = true
+	}
+	if !kinds[ActivityIssueInitialCount] || kinds[ActivityIssueUnreachable] {
+		t.Errorf("unexpected issues for activity without initial node: %+v", kinds)
+	}
+}
+
+func TestRecordActivityDeadEnds(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	act, err := z.Add(ctx, "activity", "ログイン", WithActivityNodes([]ActivityNode{
+		{ID: "node-001", Type: ActivityNodeTypeInitial},
+		{ID: "node-002", Type: ActivityNodeTypeAction, Name: "入力"},
+	}), WithActivityTransitions([]ActivityTransition{
+		{ID: "trans-001", Source: "node-001", Target: "node-002"},
+	}))
+	if err != nil {
+		t.Fatalf("Add activity failed: %v", err)
+	}
+
+	created, err := z.RecordActivityDeadEnds(ctx, act.ID, []string{"node-002"})
+	if err != nil || len(created) != 1 {
+		t.Fatalf("RecordActivityDeadEnds = %v, %v", created, err)
+	}
+	got, err := z.Get(ctx, "problem", created[0])
+	if err != nil {
+		t.Fatalf("Get problem failed: %v", err)
+	}
+	if title := got.(*ProblemEntity).Title; title != ActivityDeadEndTitle(act.ID, "node-002") {
+		t.Errorf("unexpected title %q", title)
+	}
+
+	// 未解決の Problem がある行き止まりは重複して記録しない
+	again, err := z.RecordActivityDeadEnds(ctx, act.ID, []string{"node-002"})
+	if err != nil || len(again) != 0 {
+		t.Errorf("expected no duplicate problems, got %v, %v", again, err)
+	}
+	if _, err := z.RecordActivityDeadEnds(ctx, act.ID, []string{"node-999"}); err == nil {
+		t.Error("expected error for unknown node")
+	}
+}
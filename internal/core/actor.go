@@ -0,0 +1,71 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/user"
+)
+
+// ActorEnvVar は操作者を指定する環境変数（--as が優先）
+const ActorEnvVar = "ZEUS_ACTOR"
+
+// actorOwnerOptions は操作者をオーナー（作成者）の既定値として設定するエンティティのオプション
+var actorOwnerOptions = map[string]func(string) EntityOption{
+	"vision":        WithVisionOwner,
+	"objective":     WithObjectiveOwner,
+	"consideration": WithConsiderationRaisedBy,
+	"problem":       WithProblemReportedBy,
+	"risk":          WithRiskOwner,
+	"actor":         WithActorOwner,
+	"usecase":       WithUseCaseOwner,
+	"subsystem":     WithSubsystemOwner,
+	"activity":      WithActivityOwner,
+	"statemachine":  WithStateMachineOwner,
+	"container":     WithContainerOwner,
+	"component":     WithComponentOwner,
+	"release":       WithReleaseOwner,
+}
+
+// ResolveActor は操作者を決定する（explicit（--as）、環境変数 ZEUS_ACTOR、OS のユーザー名の順）
+func ResolveActor(explicit string) string {
+	if explicit != "" {
+		return explicit
+	}
+	if actor := os.Getenv(ActorEnvVar); actor != "" {
+		return actor
+	}
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
+
+// WithActor は操作者を設定（オーナーの既定値、監査ログ、承認の記録に使用）
+func WithActor(actor string) Option {
+	return func(z *Zeus) {
+		z.actor = actor
+	}
+}
+
+// Actor は操作者（未設定の場合は空）
+func (z *Zeus) Actor() string {
+	return z.actor
+}
+
+// actorOwnerOption は entity の作成時にオーナーの既定値として操作者を設定するオプションを返す
+// メンバー名簿がある場合は名簿の ID に変換し、名簿に無い操作者は設定しない
+func (z *Zeus) actorOwnerOption(ctx context.Context, entity string) (EntityOption, error) {
+	withOwner, ok := actorOwnerOptions[entity]
+	if !ok || z.actor == "" {
+		return nil, nil
+	}
+	owner, err := z.ResolvePerson(ctx, z.actor)
+	if errors.Is(err, ErrUnknownPerson) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return withOwner(owner), nil
+}
@@ -0,0 +1,122 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrActorInUse はアクターが UseCase から参照されているため削除できない
+var ErrActorInUse = errors.New("actor is referenced by usecases")
+
+// ActorTypes は有効なアクター種別
+var ActorTypes = []ActorType{ActorTypeHuman, ActorTypeSystem, ActorTypeTime, ActorTypeDevice, ActorTypeExternal}
+
+// ActorInUseError はアクターを参照している UseCase があるため削除できないエラー
+type ActorInUseError struct {
+	ID       string
+	UseCases []string
+}
+
+// Error は error インターフェースを実装
+func (e *ActorInUseError) Error() string {
+	return fmt.Sprintf("cannot remove actor %s: referenced by %s", e.ID, strings.Join(e.UseCases, ", "))
+}
+
+// Unwrap は ErrActorInUse を返す
+func (e *ActorInUseError) Unwrap() error {
+	return ErrActorInUse
+}
+
+// ActorUsage はアクターと、そのアクターを参照している UseCase
+type ActorUsage struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Type        ActorType `json:"type"`
+	Description string    `json:"description,omitempty"`
+	UseCases    []string  `json:"usecases"` // 参照している UseCase の ID（昇順）
+}
+
+// ParseActorType はアクター種別を検証して返す
+func ParseActorType(s string) (ActorType, error) {
+	for _, t := range ActorTypes {
+		if string(t) == s {
+			return t, nil
+		}
+	}
+	names := make([]string, len(ActorTypes))
+	for i, t := range ActorTypes {
+		names[i] = string(t)
+	}
+	return "", fmt.Errorf("invalid actor type: %s (%s)", s, strings.Join(names, ", "))
+}
+
+// ActorCatalog は actors.yaml のアクターを、参照している UseCase とともに返す（actors.yaml の記載順）
+func (z *Zeus) ActorCatalog(ctx context.Context) ([]ActorUsage, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var actorsFile ActorsFile
+	if z.fileStore.Exists(ctx, "actors.yaml") {
+		if err := z.fileStore.ReadYaml(ctx, "actors.yaml", &actorsFile); err != nil {
+			return nil, fmt.Errorf("failed to read actors.yaml: %w", err)
+		}
+	}
+	usages := z.actorUsages(ctx)
+
+	catalog := make([]ActorUsage, 0, len(actorsFile.Actors))
+	for _, a := range actorsFile.Actors {
+		usecases := usages[a.ID]
+		if usecases == nil {
+			usecases = []string{}
+		}
+		catalog = append(catalog, ActorUsage{
+			ID:          a.ID,
+			Title:       a.Title,
+			Type:        a.Type,
+			Description: a.Description,
+			UseCases:    usecases,
+		})
+	}
+	return catalog, nil
+}
+
+// RemoveActor はアクターを削除する
+// UseCase から参照されている場合は ActorInUseError を返す（force の場合は UseCase の参照を外して削除）
+func (z *Zeus) RemoveActor(ctx context.Context, id string, force bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if _, err := z.Get(ctx, "actor", id); err != nil {
+		return err
+	}
+	if usecases := z.actorUsages(ctx)[id]; len(usecases) > 0 && !force {
+		return &ActorInUseError{ID: id, UseCases: usecases}
+	}
+	return z.Delete(ctx, "actor", id)
+}
+
+// actorUsages はアクター ID ごとに、参照している UseCase の ID（昇順）を返す
+func (z *Zeus) actorUsages(ctx context.Context) map[string][]string {
+	usages := map[string][]string{}
+	z.forEachYaml(ctx, "usecases", func(path string) {
+		var uc UseCaseEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &uc); err != nil {
+			return
+		}
+		seen := map[string]bool{}
+		for _, ref := range uc.Actors {
+			if ref.ActorID != "" && !seen[ref.ActorID] {
+				seen[ref.ActorID] = true
+				usages[ref.ActorID] = append(usages[ref.ActorID], uc.ID)
+			}
+		}
+	})
+	for _, ids := range usages {
+		sort.Strings(ids)
+	}
+	return usages
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestParseActorType(t *testing.T) {
+	for _, valid := range []string{"human", "system", "time", "device", "external"} {
+		if got, err := ParseActorType(valid); err != nil || string(got) != valid {
+			t.Errorf("ParseActorType(%q) = %q, %v", valid, got, err)
+		}
+	}
+	if _, err := ParseActorType("robot"); err == nil {
+		t.Error("expected error for unknown actor type")
+	}
+}
+
+func TestActorCatalogAndRemove(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	add := func(entity, title string, opts ...EntityOption) string {
+		t.Helper()
+		result, err := z.Add(ctx, entity, title, opts...)
+		if err != nil {
+			t.Fatalf("Add %s failed: %v", title, err)
+		}
+		return result.ID
+	}
+	obj := add("objective", "認証基盤")
+	user := add("actor", "利用者")
+	admin := add("actor", "管理者", WithActorType(ActorTypeSystem))
+	unused := add("actor", "監査担当")
+	uc1 := add("usecase", "ログイン", WithUseCaseObjective(obj), WithUseCaseActor(user, ActorRolePrimary), WithUseCaseActor(admin, ActorRoleSecondary))
+	uc2 := add("usecase", "ログアウト", WithUseCaseObjective(obj), WithUseCaseActor(user, ActorRolePrimary))
+
+	catalog, err := z.ActorCatalog(ctx)
+	if err != nil {
+		t.Fatalf("ActorCatalog failed: %v", err)
+	}
+	if len(catalog) != 3 {
+		t.Fatalf("expected 3 actors, got %+v", catalog)
+	}
+	usage := map[string][]string{}
+	for _, a := range catalog {
+		usage[a.ID] = a.UseCases
+	}
+	if len(usage[user]) != 2 || len(usage[admin]) != 1 || usage[admin][0] != uc1 || len(usage[unused]) != 0 {
+		t.Errorf("unexpected usages: %+v", usage)
+	}
+
+	// 参照されているアクターは削除できない
+	err = z.RemoveActor(ctx, user, false)
+	var inUse *ActorInUseError
+	if !errors.As(err, &inUse) || !errors.Is(err, ErrActorInUse) {
+		t.Fatalf("expected ActorInUseError, got %v", err)
+	}
+	if len(inUse.UseCases) != 2 || inUse.UseCases[0] > inUse.UseCases[1] {
+		t.Errorf("expected both usecases sorted, got %v", inUse.UseCases)
+	}
+
+	if err := z.RemoveActor(ctx, unused, false); err != nil {
+		t.Fatalf("RemoveActor unused failed: %v", err)
+	}
+	if err := z.RemoveActor(ctx, "actor-00000000", false); !errors.Is(err, ErrEntityNotFound) {
+		t.Errorf("expected ErrEntityNotFound, got %v", err)
+	}
+
+	// force の場合は UseCase の参照を外して削除する
+	if err := z.RemoveActor(ctx, user, true); err != nil {
+		t.Fatalf("RemoveActor force failed: %v", err)
+	}
+	got, err := z.Get(ctx, "usecase", uc2)
+	if err != nil {
+		t.Fatalf("Get usecase failed: %v", err)
+	}
+	if actors := got.(*UseCaseEntity).Actors; len(actors) != 0 {
+		t.Errorf("expected actor reference to be removed, got %+v", actors)
+	}
+}
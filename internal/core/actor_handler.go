@@ -170,6 +170,9 @@ func (h *ActorHandler) Update(ctx context.Context, id string, update any) error
 					actorsFile.Actors[i].Description = desc
 				}
 			}
+			if err := actorsFile.Actors[i].Validate(); err != nil {
+				return err
+			}
 			actorsFile.Actors[i].Metadata.UpdatedAt = Now()
 			found = true
 			break
@@ -290,6 +290,30 @@ func TestActorHandlerUpdate(t *testing.T) {
 	}
 }
 
+func TestActorHandlerUpdateInvalidType(t *testing.T) {
+	handler, _, cleanup := setupActorHandlerTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	result, err := handler.Add(ctx, "Invalid Type Actor")
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if err := handler.Update(ctx, result.ID, map[string]any{"type": "robot"}); err == nil {
+		t.Fatal("expected error for invalid actor type")
+	}
+
+	// 不正な種別は保存されない
+	current, err := handler.Get(ctx, result.ID)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if current.(*ActorEntity).Type != ActorTypeHuman {
+		t.Errorf("expected type to stay 'human', got %q", current.(*ActorEntity).Type)
+	}
+}
+
 func TestActorHandlerUpdateNotFound(t *testing.T) {
 	handler, _, cleanup := setupActorHandlerTest(t)
 	defer cleanup()
@@ -0,0 +1,118 @@
+package core
+
+import (
+	"context"
+	"testing"
+)
+
+func TestResolveActor(t *testing.T) {
+	t.Setenv(ActorEnvVar, "bob")
+	if got := ResolveActor("alice"); got != "alice" {
+		t.Errorf("--as should take precedence, got %q", got)
+	}
+	if got := ResolveActor(""); got != "bob" {
+		t.Errorf("ZEUS_ACTOR should be used, got %q", got)
+	}
+	t.Setenv(ActorEnvVar, "")
+	if got := ResolveActor(""); got == "" {
+		t.Error("OS user should be used as the fallback")
+	}
+}
+
+func TestAdd_DefaultsOwnerToActor(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	z := New(dir, WithActor("alice"))
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	owner := func(entity string, opts ...EntityOption) string {
+		t.Helper()
+		added, err := z.Add(ctx, entity, "Item", opts...)
+		if err != nil {
+			t.Fatalf("Add %s failed: %v", entity, err)
+		}
+		got, err := z.Get(ctx, entity, added.ID)
+		if err != nil {
+			t.Fatalf("Get %s failed: %v", entity, err)
+		}
+		switch e := got.(type) {
+		case *ObjectiveEntity:
+			return e.Owner
+		case *ConsiderationEntity:
+			return e.RaisedBy
+		case *ActivityEntity:
+			return e.Metadata.Owner
+		}
+		t.Fatalf("unexpected entity %T", got)
+		return ""
+	}
+
+	if got := owner("objective"); got != "alice" {
+		t.Errorf("objective owner = %q, want alice", got)
+	}
+	if got := owner("objective", WithObjectiveOwner("bob")); got != "bob" {
+		t.Errorf("explicit owner should take precedence, got %q", got)
+	}
+	if got := owner("consideration"); got != "alice" {
+		t.Errorf("consideration raised_by = %q, want alice", got)
+	}
+	if got := owner("activity"); got != "alice" {
+		t.Errorf("activity owner = %q, want alice", got)
+	}
+
+	// メンバー名簿がある場合は ID に変換し、名簿に無い操作者は設定しない
+	if err := z.AddPerson(ctx, Person{ID: "asmith", Name: "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := owner("objective"); got != "asmith" {
+		t.Errorf("owner should be resolved to the person ID, got %q", got)
+	}
+	z = New(dir, WithActor("mallory"))
+	if got := owner("objective"); got != "" {
+		t.Errorf("unknown actor should not be set as owner, got %q", got)
+	}
+}
+
+func TestActor_RecordedOnApprovalsAndAudit(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	requester := New(dir, WithActor("alice"), WithAgent("claude"))
+	if _, err := requester.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	config, _ := requester.LoadConfig(ctx)
+	config.Settings.Operations = map[string]ApprovalLevel{"risk_create": ApprovalApprove}
+	if err := requester.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatal(err)
+	}
+
+	added, err := requester.Add(ctx, "risk", "Outage")
+	if err != nil || !added.NeedsApproval {
+		t.Fatalf("Add should be queued: result=%+v err=%v", added, err)
+	}
+	approval, _ := requester.approvalStore.Get(ctx, added.ApprovalID)
+	if approval.RequestedBy != "alice" || approval.Agent != "claude" {
+		t.Errorf("approval should record the requester: %+v", approval)
+	}
+
+	if _, err := New(dir, WithActor("bob")).Approve(ctx, added.ApprovalID); err != nil {
+		t.Fatalf("Approve failed: %v", err)
+	}
+	var approved PendingApproval
+	if err := requester.fileStore.ReadYaml(ctx, "approvals/approved/"+added.ApprovalID+".yaml", &approved); err != nil || approved.ApprovedBy != "bob" {
+		t.Errorf("approved_by should be the approver: %+v err=%v", approved, err)
+	}
+
+	trail, err := requester.AuditTrail(ctx, "", 0)
+	if err != nil || len(trail) != 2 {
+		t.Fatalf("unexpected audit trail: %+v err=%v", trail, err)
+	}
+	if trail[0].Actor != "alice" || trail[0].Agent != "claude" || trail[1].Actor != "bob" || trail[1].Agent != "" {
+		t.Errorf("audit entries should record the actor: %+v", trail)
+	}
+	if bobs, _ := requester.AuditTrail(ctx, "bob", 0); len(bobs) != 1 {
+		t.Errorf("audit trail should be filterable by actor: %+v", bobs)
+	}
+}
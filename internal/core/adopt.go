@@ -0,0 +1,46 @@
+package core
+
+import (
+	"context"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+)
+
+// SuggestAdoptions は UseCase に紐づいていない Activity に親 UseCase の候補を提案
+// limit は Activity ごとの候補数上限（0 以下で無制限）
+func (z *Zeus) SuggestAdoptions(ctx context.Context, limit int) ([]analysis.AdoptionProposal, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	usecases := []UseCaseEntity{}
+	z.forEachYaml(ctx, "usecases", func(path string) {
+		var uc UseCaseEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &uc); err == nil {
+			usecases = append(usecases, uc)
+		}
+	})
+
+	activities := []ActivityEntity{}
+	z.forEachYaml(ctx, "activities", func(path string) {
+		var act ActivityEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &act); err == nil {
+			activities = append(activities, act)
+		}
+	})
+
+	suggester := analysis.NewAdoptionSuggester(
+		toAnalysisActivityInfo(activities),
+		toAnalysisUseCaseInfo(usecases),
+	)
+	return suggester.Suggest(ctx, limit)
+}
+
+// AdoptActivity は Activity を UseCase に紐づける（UseCase の存在確認はハンドラーが行う）
+// activity_update の承認レベル・エージェントのガードレールを適用し、承認が必要な場合は *ApprovalRequiredError を返す
+func (z *Zeus) AdoptActivity(ctx context.Context, activityID, usecaseID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return z.updateGated(ctx, "activity", activityID, map[string]any{"usecase_id": usecaseID})
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSuggestAndAdoptActivity(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	obj, err := z.Add(ctx, "objective", "Objective")
+	if err != nil {
+		t.Fatalf("Add objective failed: %v", err)
+	}
+	uc, err := z.Add(ctx, "usecase", "Export report", WithUseCaseObjective(obj.ID))
+	if err != nil {
+		t.Fatalf("Add usecase failed: %v", err)
+	}
+	act, err := z.Add(ctx, "activity", "Export report as CSV")
+	if err != nil {
+		t.Fatalf("Add activity failed: %v", err)
+	}
+
+	proposals, err := z.SuggestAdoptions(ctx, 3)
+	if err != nil {
+		t.Fatalf("SuggestAdoptions failed: %v", err)
+	}
+	if len(proposals) != 1 || proposals[0].ActivityID != act.ID {
+		t.Fatalf("unexpected proposals: %+v", proposals)
+	}
+	best, ok := proposals[0].Best()
+	if !ok || best.ParentID != uc.ID {
+		t.Fatalf("expected %s as best candidate, got %+v", uc.ID, proposals[0])
+	}
+
+	if err := z.AdoptActivity(ctx, act.ID, "uc-00000000"); err == nil {
+		t.Error("expected error for missing usecase")
+	}
+	if err := z.AdoptActivity(ctx, act.ID, best.ParentID); err != nil {
+		t.Fatalf("AdoptActivity failed: %v", err)
+	}
+
+	proposals, err = z.SuggestAdoptions(ctx, 3)
+	if err != nil {
+		t.Fatalf("SuggestAdoptions failed: %v", err)
+	}
+	if len(proposals) != 0 {
+		t.Errorf("expected no orphans after adoption, got %+v", proposals)
+	}
+}
+
+func TestAdoptActivity_ProtectedFromAgent(t *testing.T) {
+	agent, human := setupAgentPolicy(t, AgentPolicy{Protected: []string{"activity"}})
+	ctx := context.Background()
+	obj, err := human.Add(ctx, "objective", "Objective")
+	if err != nil {
+		t.Fatalf("Add objective failed: %v", err)
+	}
+	uc, err := human.Add(ctx, "usecase", "Export report", WithUseCaseObjective(obj.ID))
+	if err != nil {
+		t.Fatalf("Add usecase failed: %v", err)
+	}
+	act, err := human.Add(ctx, "activity", "Export report as CSV")
+	if err != nil {
+		t.Fatalf("Add activity failed: %v", err)
+	}
+
+	var required *ApprovalRequiredError
+	if err := agent.AdoptActivity(ctx, act.ID, uc.ID); !errors.As(err, &required) {
+		t.Fatalf("expected ApprovalRequiredError, got %v", err)
+	}
+	if proposals, _ := human.SuggestAdoptions(ctx, 3); len(proposals) != 1 {
+		t.Error("queued adoption should not be applied")
+	}
+	trail, _ := human.AuditTrail(ctx, "claude", 0)
+	if len(trail) != 1 || trail[0].Result != AuditResultQueued || trail[0].ApprovalID != required.ApprovalID {
+		t.Errorf("queued adoption should be audited: %+v", trail)
+	}
+
+	if _, err := human.Approve(ctx, required.ApprovalID); err != nil {
+		t.Fatalf("Approve failed: %v", err)
+	}
+	if proposals, _ := human.SuggestAdoptions(ctx, 3); len(proposals) != 0 {
+		t.Errorf("approved adoption should be applied: %+v", proposals)
+	}
+}
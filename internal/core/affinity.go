@@ -0,0 +1,234 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+)
+
+// ClusterApplyResult はクラスタ適用の結果
+type ClusterApplyResult struct {
+	ClusterID string   `json:"cluster_id"`
+	Tag       string   `json:"tag"`
+	Tagged    []string `json:"tagged"`    // タグを付与したエンティティ
+	Unchanged []string `json:"unchanged"` // 既にタグが付いていたエンティティ
+	Skipped   []string `json:"skipped"`   // タグを持てない種別のエンティティ
+}
+
+// Options は設定をアフィニティ計算オプションに変換（値域を検証）
+func (s AffinitySettings) Options() (analysis.AffinityOptions, error) {
+	options := analysis.DefaultAffinityOptions()
+	if s.MinScore != nil {
+		options.MinScore = *s.MinScore
+	}
+	if s.MaxSiblings != 0 {
+		options.MaxSiblings = s.MaxSiblings
+	}
+	options.MaxEdges = s.MaxEdges
+	options.MinClusterSize = s.MinClusterSize
+	options.Workers = s.Workers
+	options.Approximate = s.Approximate
+	options.Weights = analysis.AffinityWeightOverrides{
+		ParentChild: s.Weights.ParentChild,
+		Sibling:     s.Weights.Sibling,
+		Reference:   s.Weights.Reference,
+		Category:    s.Weights.Category,
+	}
+	if err := options.Validate(); err != nil {
+		return options, fmt.Errorf("analysis.affinity: %w", err)
+	}
+	return options, nil
+}
+
+// AffinityOptions は zeus.yaml の analysis.affinity からアフィニティ計算オプションを構築
+// zeus.yaml が存在しない場合はデフォルト値を返す
+func (z *Zeus) AffinityOptions(ctx context.Context) (analysis.AffinityOptions, error) {
+	config, err := z.LoadConfig(ctx)
+	if err != nil {
+		if errors.Is(err, ErrConfigNotFound) {
+			return analysis.DefaultAffinityOptions(), nil
+		}
+		return analysis.AffinityOptions{}, err
+	}
+	return config.Analysis.Affinity.Options()
+}
+
+// CalculateAffinity はエンティティ間のアフィニティを計算
+func (z *Zeus) CalculateAffinity(ctx context.Context, options analysis.AffinityOptions) (*analysis.AffinityResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var vision Vision
+	_ = z.fileStore.ReadYaml(ctx, "vision.yaml", &vision)
+	visionInfo := analysis.VisionInfo{Title: vision.Title, Status: "active"}
+
+	objectives := []analysis.ObjectiveInfo{}
+	z.forEachYaml(ctx, "objectives", func(path string) {
+		var obj ObjectiveEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &obj); err == nil {
+			objectives = append(objectives, analysis.ObjectiveInfo{
+				ID:        obj.ID,
+				Title:     obj.Title,
+				Status:    string(obj.Status),
+				CreatedAt: obj.Metadata.CreatedAt,
+				UpdatedAt: obj.Metadata.UpdatedAt,
+			})
+		}
+	})
+
+	tasks := []analysis.TaskInfo{}
+	z.forEachYaml(ctx, "activities", func(path string) {
+		var act ActivityEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &act); err == nil {
+			tasks = append(tasks, analysis.TaskInfo{
+				ID:        act.ID,
+				Title:     act.Title,
+				Status:    string(act.Status),
+				CreatedAt: act.Metadata.CreatedAt,
+				UpdatedAt: act.Metadata.UpdatedAt,
+			})
+		}
+	})
+
+	quality := []analysis.QualityInfo{}
+	z.forEachYaml(ctx, "quality", func(path string) {
+		var q QualityEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &q); err == nil {
+			quality = append(quality, analysis.QualityInfo{
+				ID:          q.ID,
+				Title:       q.Title,
+				ObjectiveID: q.ObjectiveID,
+			})
+		}
+	})
+
+	risks := []analysis.RiskInfo{}
+	z.forEachYaml(ctx, "risks", func(path string) {
+		var r RiskEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &r); err == nil {
+			risks = append(risks, analysis.RiskInfo{
+				ID:          r.ID,
+				Title:       r.Title,
+				Probability: string(r.Probability),
+				Impact:      string(r.Impact),
+				Status:      string(r.Status),
+				ObjectiveID: r.ObjectiveID,
+			})
+		}
+	})
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	calculator := analysis.NewAffinityCalculatorWithOptions(visionInfo, objectives, tasks, quality, risks, options)
+	return calculator.Calculate(ctx)
+}
+
+// ApplyAffinityCluster はクラスタのメンバーに共通タグを付与してグルーピングを永続化
+// tag を省略した場合はクラスタ ID をタグとして使う。dryRun では書き込みを行わない
+func (z *Zeus) ApplyAffinityCluster(ctx context.Context, clusterID, tag string, options analysis.AffinityOptions, dryRun bool) (*ClusterApplyResult, error) {
+	result, err := z.CalculateAffinity(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var cluster *analysis.AffinityCluster
+	for i := range result.Clusters {
+		if result.Clusters[i].ID == clusterID {
+			cluster = &result.Clusters[i]
+			break
+		}
+	}
+	if cluster == nil {
+		return nil, fmt.Errorf("cluster not found: %s", clusterID)
+	}
+
+	if tag == "" {
+		tag = cluster.ID
+	}
+	applied := &ClusterApplyResult{
+		ClusterID: cluster.ID,
+		Tag:       tag,
+		Tagged:    []string{},
+		Unchanged: []string{},
+		Skipped:   []string{},
+	}
+
+	for _, id := range cluster.Members {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		added, ok, err := z.addEntityTag(ctx, id, tag, dryRun)
+		if err != nil {
+			return nil, fmt.Errorf("%s へのタグ付与に失敗: %w", id, err)
+		}
+		switch {
+		case !ok:
+			applied.Skipped = append(applied.Skipped, id)
+		case added:
+			applied.Tagged = append(applied.Tagged, id)
+		default:
+			applied.Unchanged = append(applied.Unchanged, id)
+		}
+	}
+
+	return applied, nil
+}
+
+// addEntityTag はエンティティにタグを追加
+// 戻り値: 追加したか、タグを持てる種別か、エラー
+func (z *Zeus) addEntityTag(ctx context.Context, id, tag string, dryRun bool) (bool, bool, error) {
+	entityType, ok := EntityTypeFromID(id)
+	if !ok {
+		return false, false, nil
+	}
+	dir, ok := GetEntityDirectory(entityType)
+	if !ok || dir == "" {
+		return false, false, nil
+	}
+	path := filepath.Join(dir, id+".yaml")
+
+	// タグの格納先は種別ごとに異なる（Objective はトップレベル、それ以外は Metadata）
+	var entity any
+	var tags *[]string
+	var meta *Metadata
+	switch entityType {
+	case "objective":
+		e := &ObjectiveEntity{}
+		entity, tags, meta = e, &e.Tags, &e.Metadata
+	case "quality":
+		e := &QualityEntity{}
+		entity, tags, meta = e, &e.Metadata.Tags, &e.Metadata
+	case "risk":
+		e := &RiskEntity{}
+		entity, tags, meta = e, &e.Metadata.Tags, &e.Metadata
+	case "activity":
+		e := &ActivityEntity{}
+		entity, tags, meta = e, &e.Metadata.Tags, &e.Metadata
+	default:
+		return false, false, nil
+	}
+
+	if err := z.fileStore.ReadYaml(ctx, path, entity); err != nil {
+		return false, true, err
+	}
+	if slices.Contains(*tags, tag) {
+		return false, true, nil
+	}
+	if dryRun {
+		return true, true, nil
+	}
+
+	*tags = append(*tags, tag)
+	meta.UpdatedAt = Now()
+	if err := z.fileStore.WriteYaml(ctx, path, entity); err != nil {
+		return false, true, err
+	}
+	return true, true, nil
+}
@@ -0,0 +1,150 @@
+package core
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+)
+
+func TestApplyAffinityCluster(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	obj, err := z.Add(ctx, "objective", "Objective")
+	if err != nil {
+		t.Fatalf("Add objective failed: %v", err)
+	}
+	qual, err := z.Add(ctx, "quality", "Quality",
+		WithQualityObjective(obj.ID),
+		WithQualityMetrics(defaultMetrics()),
+	)
+	if err != nil {
+		t.Fatalf("Add quality failed: %v", err)
+	}
+	risk, err := z.Add(ctx, "risk", "Risk", WithRiskObjective(obj.ID))
+	if err != nil {
+		t.Fatalf("Add risk failed: %v", err)
+	}
+
+	clusterID := "cluster-" + obj.ID
+	options := analysis.DefaultAffinityOptions()
+
+	// dry-run では書き込まない
+	result, err := z.ApplyAffinityCluster(ctx, clusterID, "", options, true)
+	if err != nil {
+		t.Fatalf("ApplyAffinityCluster (dry-run) failed: %v", err)
+	}
+	if result.Tag != clusterID || len(result.Tagged) != 3 {
+		t.Fatalf("unexpected dry-run result: %+v", result)
+	}
+	var o ObjectiveEntity
+	if err := z.fileStore.ReadYaml(ctx, filepath.Join("objectives", obj.ID+".yaml"), &o); err != nil {
+		t.Fatalf("ReadYaml failed: %v", err)
+	}
+	if len(o.Tags) != 0 {
+		t.Errorf("dry-run should not write tags: %v", o.Tags)
+	}
+
+	result, err = z.ApplyAffinityCluster(ctx, clusterID, "group-a", options, false)
+	if err != nil {
+		t.Fatalf("ApplyAffinityCluster failed: %v", err)
+	}
+	if len(result.Tagged) != 3 {
+		t.Fatalf("expected 3 tagged entities, got %+v", result)
+	}
+
+	if err := z.fileStore.ReadYaml(ctx, filepath.Join("objectives", obj.ID+".yaml"), &o); err != nil {
+		t.Fatalf("ReadYaml failed: %v", err)
+	}
+	if !slices.Contains(o.Tags, "group-a") {
+		t.Errorf("objective should have tag: %v", o.Tags)
+	}
+	var q QualityEntity
+	if err := z.fileStore.ReadYaml(ctx, filepath.Join("quality", qual.ID+".yaml"), &q); err != nil {
+		t.Fatalf("ReadYaml failed: %v", err)
+	}
+	if !slices.Contains(q.Metadata.Tags, "group-a") {
+		t.Errorf("quality should have tag: %v", q.Metadata.Tags)
+	}
+	var r RiskEntity
+	if err := z.fileStore.ReadYaml(ctx, filepath.Join("risks", risk.ID+".yaml"), &r); err != nil {
+		t.Fatalf("ReadYaml failed: %v", err)
+	}
+	if !slices.Contains(r.Metadata.Tags, "group-a") {
+		t.Errorf("risk should have tag: %v", r.Metadata.Tags)
+	}
+
+	// 再適用は冪等
+	result, err = z.ApplyAffinityCluster(ctx, clusterID, "group-a", options, false)
+	if err != nil {
+		t.Fatalf("ApplyAffinityCluster failed: %v", err)
+	}
+	if len(result.Tagged) != 0 || len(result.Unchanged) != 3 {
+		t.Errorf("re-apply should be idempotent: %+v", result)
+	}
+
+	if _, err := z.ApplyAffinityCluster(ctx, "cluster-missing", "", options, false); err == nil {
+		t.Error("expected error for unknown cluster")
+	}
+}
+
+func TestAffinityOptionsFromConfig(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+
+	// zeus.yaml がなければデフォルト
+	options, err := z.AffinityOptions(ctx)
+	if err != nil {
+		t.Fatalf("AffinityOptions failed: %v", err)
+	}
+	if options.MaxSiblings != analysis.DefaultAffinityOptions().MaxSiblings {
+		t.Errorf("expected default options, got %+v", options)
+	}
+
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	config, err := z.LoadConfig(ctx)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+
+	sibling, minScore := 0.6, 0.4
+	config.Analysis.Affinity = AffinitySettings{
+		Weights:        AffinityWeightSettings{Sibling: &sibling},
+		MinScore:       &minScore,
+		MinClusterSize: 2,
+		Workers:        4,
+		Approximate:    true,
+	}
+	if err := z.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+
+	options, err = z.AffinityOptions(ctx)
+	if err != nil {
+		t.Fatalf("AffinityOptions failed: %v", err)
+	}
+	if options.MinScore != 0.4 || options.MinClusterSize != 2 || options.MaxSiblings != 20 || options.Workers != 4 || !options.Approximate {
+		t.Errorf("unexpected options: %+v", options)
+	}
+	if options.Weights.Sibling == nil || *options.Weights.Sibling != 0.6 || options.Weights.ParentChild != nil {
+		t.Errorf("unexpected weight overrides: %+v", options.Weights)
+	}
+
+	// 範囲外の値はエラー
+	invalid := 1.5
+	config.Analysis.Affinity.Weights.Reference = &invalid
+	if err := z.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+	if _, err := z.AffinityOptions(ctx); err == nil {
+		t.Error("expected validation error for out-of-range weight")
+	}
+}
@@ -0,0 +1,168 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// 監査ログの配置と保持件数
+const (
+	auditLogFile          = "logs/audit.jsonl"
+	DefaultAuditLogRetain = 5000
+)
+
+// defaultAgentSessionMinutes はエージェントのセッションの長さのデフォルト（分）
+const defaultAgentSessionMinutes = 60
+
+// 監査ログの操作結果
+const (
+	AuditResultApplied  = "applied"  // 反映した
+	AuditResultQueued   = "queued"   // 承認待ちキューに追加した
+	AuditResultApproved = "approved" // 承認して反映した
+	AuditResultDenied   = "denied"   // ガードレールにより拒否した
+)
+
+// ErrAgentRateLimited はエージェントのセッションあたりの変更回数の上限に達した
+var ErrAgentRateLimited = errors.New("agent mutation rate limit exceeded")
+
+// ErrAgentApprovalForbidden はエージェントによる承認（承認は人が行う）
+var ErrAgentApprovalForbidden = errors.New("agents cannot approve")
+
+// AuditEntry は監査ログに記録する変更操作
+type AuditEntry struct {
+	Actor      string `json:"actor,omitempty"` // 操作者（--as、環境変数 ZEUS_ACTOR、OS のユーザー名）
+	Agent      string `json:"agent,omitempty"` // 操作したエージェント（空なら人）
+	Op         string `json:"op"`              // create, update, delete, archive
+	Entity     string `json:"entity"`
+	ID         string `json:"id,omitempty"`
+	Result     string `json:"result"` // applied, queued, approved, denied
+	ApprovalID string `json:"approval_id,omitempty"`
+	Reason     string `json:"reason,omitempty"` // denied の理由
+}
+
+// AuditRecord は監査ログの 1 件
+type AuditRecord struct {
+	Seq  uint64 `json:"seq"`
+	Time string `json:"time"`
+	AuditEntry
+}
+
+// Agent は操作しているエージェント（人による操作は空）
+func (z *Zeus) Agent() string {
+	return z.agent
+}
+
+// AuditLog はプロジェクトの監査ログ（.zeus/logs/audit.jsonl）を返す
+func (z *Zeus) AuditLog() *EventLog {
+	return z.auditLog
+}
+
+// AuditTrail は監査ログを古い順に返す（who を指定した場合はその操作者またはエージェントの操作のみ、limit > 0 の場合は直近 limit 件）
+func (z *Zeus) AuditTrail(ctx context.Context, who string, limit int) ([]AuditRecord, error) {
+	records, err := z.AuditLog().Since(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	trail := []AuditRecord{}
+	for _, r := range records {
+		var entry AuditEntry
+		if err := json.Unmarshal(r.Data, &entry); err != nil {
+			continue
+		}
+		if who != "" && entry.Actor != who && entry.Agent != who {
+			continue
+		}
+		trail = append(trail, AuditRecord{Seq: r.Seq, Time: r.Time, AuditEntry: entry})
+	}
+	if limit > 0 && len(trail) > limit {
+		trail = trail[len(trail)-limit:]
+	}
+	return trail, nil
+}
+
+// audit は変更操作を監査ログに記録し、反映した変更に対してルールを評価する
+// （ドライランでは記録しない。記録の失敗で操作自体は失敗させない）
+func (z *Zeus) audit(ctx context.Context, entry AuditEntry) {
+	if isDryRunStore(z.fileStore) {
+		return
+	}
+	entry.Actor, entry.Agent = z.actor, z.agent
+	if _, err := z.AuditLog().Append(ctx, entry.Entity+"_"+entry.Op, entry); err != nil {
+		fmt.Printf("Warning: 監査ログの記録に失敗しました: %v\n", err)
+	}
+	// トランザクション外で反映した変更はここでルールを評価する（トランザクション内の変更はコミット後に評価）
+	if _, ok := ruleEntityDirs[entry.Entity]; ok && entry.Result == AuditResultApplied {
+		z.triggerRules(ctx)
+	}
+}
+
+// Validate はエージェントのガードレールの妥当性を検証
+func (p AgentPolicy) Validate() error {
+	if p.MaxMutationsPerSession < 0 {
+		return fmt.Errorf("agents.max_mutations_per_session must be >= 0")
+	}
+	if p.SessionMinutes < 0 {
+		return fmt.Errorf("agents.session_minutes must be >= 0")
+	}
+	return nil
+}
+
+// protects は entity（種別）または id が保護対象か
+func (p AgentPolicy) protects(entity, id string) bool {
+	return slices.Contains(p.Protected, entity) || (id != "" && slices.Contains(p.Protected, id))
+}
+
+// session はセッションの長さ
+func (p AgentPolicy) session() time.Duration {
+	minutes := p.SessionMinutes
+	if minutes == 0 {
+		minutes = defaultAgentSessionMinutes
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// guardAgent はエージェントによる entity の op にガードレールを適用する
+// 変更回数の上限に達している場合はエラー、保護対象の場合は true（人の承認が必要）を返す
+func (z *Zeus) guardAgent(ctx context.Context, policy *AgentPolicy, entity, op, id string) (bool, error) {
+	if z.agent == "" {
+		return false, nil
+	}
+	if err := policy.Validate(); err != nil {
+		return false, err
+	}
+	if policy.MaxMutationsPerSession > 0 {
+		count, err := z.agentMutations(ctx, time.Now().Add(-policy.session()))
+		if err != nil {
+			return false, err
+		}
+		if count >= policy.MaxMutationsPerSession {
+			err := fmt.Errorf("%w: agent %s made %d mutations in the last %s (max %d)",
+				ErrAgentRateLimited, z.agent, count, policy.session(), policy.MaxMutationsPerSession)
+			z.audit(ctx, AuditEntry{Op: op, Entity: entity, ID: id, Result: AuditResultDenied, Reason: err.Error()})
+			return false, err
+		}
+	}
+	return policy.protects(entity, id), nil
+}
+
+// agentMutations は since 以降にこのエージェントが行った変更（反映・承認待ちへの追加）の数
+func (z *Zeus) agentMutations(ctx context.Context, since time.Time) (int, error) {
+	trail, err := z.AuditTrail(ctx, z.agent, 0)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, r := range trail {
+		if r.Agent != z.agent || (r.Result != AuditResultApplied && r.Result != AuditResultQueued) {
+			continue
+		}
+		if t, err := time.Parse(time.RFC3339, r.Time); err == nil && !t.Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
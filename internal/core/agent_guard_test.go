@@ -0,0 +1,148 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// setupAgentPolicy はエージェントのガードレールを設定し、エージェント用と人用の Zeus を返す
+func setupAgentPolicy(t *testing.T, policy AgentPolicy) (agent, human *Zeus) {
+	t.Helper()
+	dir := t.TempDir()
+	human = New(dir)
+	ctx := context.Background()
+	if _, err := human.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	config, _ := human.LoadConfig(ctx)
+	config.Agents = policy
+	if err := human.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+	return New(dir, WithAgent("claude")), human
+}
+
+func TestAgentPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  AgentPolicy
+		wantErr bool
+	}{
+		{"empty", AgentPolicy{}, false},
+		{"valid", AgentPolicy{Protected: []string{"decision"}, MaxMutationsPerSession: 10, SessionMinutes: 30}, false},
+		{"negative limit", AgentPolicy{MaxMutationsPerSession: -1}, true},
+		{"negative session", AgentPolicy{SessionMinutes: -5}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.policy.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAgentGuard_ProtectedEntities(t *testing.T) {
+	ctx := context.Background()
+	agent, human := setupAgentPolicy(t, AgentPolicy{Protected: []string{"decision"}})
+
+	obj, err := human.Add(ctx, "objective", "Launch")
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	config, _ := human.LoadConfig(ctx)
+	config.Agents.Protected = append(config.Agents.Protected, obj.ID)
+	if err := human.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatal(err)
+	}
+
+	// 保護対象でないエンティティはエージェントでも即時実行
+	risk, err := agent.Add(ctx, "risk", "Outage")
+	if err != nil || risk.NeedsApproval {
+		t.Fatalf("risk should be added immediately: result=%+v err=%v", risk, err)
+	}
+
+	// ID で保護したエンティティの削除は承認待ち
+	err = agent.Delete(ctx, "objective", obj.ID)
+	var required *ApprovalRequiredError
+	if !errors.As(err, &required) {
+		t.Fatalf("Delete of a protected entity should require approval, got %v", err)
+	}
+	approval, _ := agent.approvalStore.Get(ctx, required.ApprovalID)
+	if approval.Agent != "claude" {
+		t.Errorf("approval should record the agent: %+v", approval)
+	}
+
+	// 承認は人のみ
+	if _, err := agent.Approve(ctx, required.ApprovalID); !errors.Is(err, ErrAgentApprovalForbidden) {
+		t.Fatalf("agent approval should be forbidden, got %v", err)
+	}
+	if _, err := human.Approve(ctx, required.ApprovalID); err != nil {
+		t.Fatalf("Approve failed: %v", err)
+	}
+	if _, err := human.Get(ctx, "objective", obj.ID); err == nil {
+		t.Error("objective should be deleted after human approval")
+	}
+
+	// 人による操作には保護を適用しない
+	if _, err := human.Add(ctx, "objective", "Expand"); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+
+	trail, err := human.AuditTrail(ctx, "claude", 0)
+	if err != nil {
+		t.Fatalf("AuditTrail failed: %v", err)
+	}
+	var results []string
+	for _, r := range trail {
+		results = append(results, r.Op+":"+r.Result)
+	}
+	want := []string{"create:applied", "delete:queued", "delete:denied"}
+	if len(results) != len(want) {
+		t.Fatalf("agent audit trail = %v, want %v", results, want)
+	}
+	for i := range want {
+		if results[i] != want[i] {
+			t.Errorf("agent audit trail = %v, want %v", results, want)
+			break
+		}
+	}
+	all, _ := human.AuditTrail(ctx, "", 0)
+	if len(all) != 6 || all[len(all)-2].Result != AuditResultApproved || all[len(all)-1].Agent != "" {
+		t.Errorf("unexpected audit trail: %+v", all)
+	}
+}
+
+func TestAgentGuard_RateLimit(t *testing.T) {
+	ctx := context.Background()
+	agent, human := setupAgentPolicy(t, AgentPolicy{MaxMutationsPerSession: 2})
+
+	for _, name := range []string{"A", "B"} {
+		if _, err := agent.Add(ctx, "objective", name); err != nil {
+			t.Fatalf("Add %s failed: %v", name, err)
+		}
+	}
+	if _, err := agent.Add(ctx, "objective", "C"); !errors.Is(err, ErrAgentRateLimited) {
+		t.Fatalf("third mutation should be rate limited, got %v", err)
+	}
+
+	// ドライランは回数に数えず、人の操作は制限しない
+	if _, err := agent.DryRun(ctx, func(tx *Zeus) error {
+		_, err := tx.Add(ctx, "objective", "D")
+		return err
+	}); !errors.Is(err, ErrAgentRateLimited) {
+		t.Errorf("dry run should report the rate limit, got %v", err)
+	}
+	if _, err := human.Add(ctx, "objective", "E"); err != nil {
+		t.Fatalf("human Add failed: %v", err)
+	}
+	if _, err := New(human.ProjectPath, WithAgent("other")).Add(ctx, "objective", "F"); err != nil {
+		t.Errorf("limit should be counted per agent: %v", err)
+	}
+
+	trail, _ := human.AuditTrail(ctx, "claude", 0)
+	if len(trail) != 3 || trail[2].Result != AuditResultDenied || trail[2].Reason == "" {
+		t.Errorf("denied mutation should be audited once: %+v", trail)
+	}
+}
@@ -0,0 +1,283 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// anonymizeSkipDirs は匿名化したコピーに含めないディレクトリ（自由記述を含むログ・バックアップ）
+var anonymizeSkipDirs = map[string]bool{"logs": true, "backups": true, "analytics": true}
+
+// anonymizeTextKeys は自由記述として置き換えるキー（値は <キー>-N の仮名）
+var anonymizeTextKeys = map[string]bool{
+	"title": true, "name": true, "description": true, "rationale": true, "reason": true,
+	"statement": true, "message": true, "context": true, "content": true, "outcome": true,
+	"success_criteria": true, "root_cause": true, "preventive": true, "contingent": true,
+	"potential_solutions": true, "preconditions": true, "postconditions": true,
+	"pros": true, "cons": true, "goals": true, "steps": true, "main_flow": true,
+	"criteria": true, "guard": true, "trigger": true, "technology": true,
+}
+
+// anonymizePersonKeys は人物（people の ID・表示名・メールアドレス）として置き換えるキー
+var anonymizePersonKeys = map[string]bool{
+	"owner": true, "assignee": true, "assigned_to": true, "approver": true, "reviewer": true,
+	"requested_by": true, "reported_by": true, "raised_by": true, "decided_by": true,
+	"approved_by": true, "rejected_by": true, "changed_by": true, "delegated_from": true,
+	"worker": true, "members": true, "from": true, "to": true,
+}
+
+// secretKeys は認証情報として消去するキー（バンドルの書き出しでも既定で消去する）
+var secretKeys = map[string]bool{"password": true, "api_token": true, "token": true}
+
+// anonymizeConnectionKeys は接続先として固定値に置き換えるキー
+var anonymizeConnectionKeys = map[string]string{
+	"username": "", "host": "example.invalid", "url": "https://example.invalid",
+	"base_url": "https://example.invalid", "kroki_url": "https://example.invalid",
+}
+
+// anonymizeEmailPattern はメールアドレスとみなす値
+var anonymizeEmailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+// AnonymizeResult は匿名化の結果
+type AnonymizeResult struct {
+	Files    int      `json:"files"`    // 匿名化して書き込んだ YAML ファイル数
+	Replaced int      `json:"replaced"` // 置き換えた値の数
+	Skipped  []string `json:"skipped"`  // 含めなかったファイル・ディレクトリ（.zeus からの相対パス）
+}
+
+// anonymizer は値ごとに安定した仮名を割り当てる（同じ値は全ファイルで同じ仮名になる）
+type anonymizer struct {
+	names    map[string]map[string]string // 種類 -> 元の値 -> 仮名
+	counts   map[string]int
+	replaced int
+}
+
+func newAnonymizer() *anonymizer {
+	return &anonymizer{names: map[string]map[string]string{"person": {}}, counts: map[string]int{}}
+}
+
+// pseudonym は種類ごとの連番の仮名（<kind>-N）を返す
+func (a *anonymizer) pseudonym(kind, value string) string {
+	if a.names[kind] == nil {
+		a.names[kind] = map[string]string{}
+	}
+	if p, ok := a.names[kind][value]; ok {
+		return p
+	}
+	a.counts[kind]++
+	p := fmt.Sprintf("%s-%d", kind, a.counts[kind])
+	if kind == "email" {
+		p = fmt.Sprintf("user-%d@example.invalid", a.counts[kind])
+	}
+	a.names[kind][value] = p
+	return p
+}
+
+// person は人物の仮名（people の ID と表示名は同じ仮名を共有する）
+func (a *anonymizer) person(value string) string {
+	if anonymizeEmailPattern.MatchString(value) {
+		return a.pseudonym("email", value)
+	}
+	return a.pseudonym("person", value)
+}
+
+// scalar はキーに応じて文字列の値を置き換える
+func (a *anonymizer) scalar(key, value string) string {
+	if value == "" {
+		return value
+	}
+	replaced := value
+	if secretKeys[key] {
+		replaced = ""
+	} else if fixed, ok := anonymizeConnectionKeys[key]; ok {
+		replaced = fixed
+	} else if anonymizePersonKeys[key] {
+		replaced = a.person(value)
+	} else if anonymizeTextKeys[key] {
+		replaced = a.pseudonym(key, value)
+	} else if key == "tags" {
+		replaced = a.pseudonym("tag", value)
+	} else if anonymizeEmailPattern.MatchString(value) {
+		replaced = a.pseudonym("email", value)
+	}
+	if replaced != value {
+		a.replaced++
+	}
+	return replaced
+}
+
+// node は YAML のノードを再帰的に匿名化する
+func (a *anonymizer) node(n *yaml.Node, key string) {
+	replaceYamlStrings(n, key, a.scalar)
+}
+
+// replaceYamlStrings は YAML のノードの文字列の値を再帰的に replace(キー, 値) で置き換える
+// （シーケンスの要素は親のキーを引き継ぐ）
+func replaceYamlStrings(n *yaml.Node, key string, replace func(key, value string) string) {
+	switch n.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, c := range n.Content {
+			replaceYamlStrings(c, key, replace)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			replaceYamlStrings(n.Content[i+1], n.Content[i].Value, replace)
+		}
+	case yaml.ScalarNode:
+		if n.ShortTag() == "!!str" {
+			n.Value = replace(key, n.Value)
+		}
+	}
+}
+
+// redactSecrets は YAML の認証情報（secretKeys の値）を消去した内容を返す（認証情報がなければ data をそのまま返す）
+func redactSecrets(data []byte) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	redacted := 0
+	replaceYamlStrings(&doc, "", func(key, value string) string {
+		if value != "" && secretKeys[key] {
+			redacted++
+			return ""
+		}
+		return value
+	})
+	if redacted == 0 {
+		return data, nil
+	}
+	return yaml.Marshal(&doc)
+}
+
+// people は名簿のメンバー・チームの ID と表示名に同じ仮名を割り当てる（オーナーはどちらでも指定できるため）
+func (a *anonymizer) people(dir *PeopleDirectory) {
+	for i := range dir.People {
+		p := &dir.People[i]
+		alias := a.pseudonym("person", p.ID)
+		if p.Name != "" {
+			a.names["person"][p.Name] = alias
+			p.Name = alias
+		}
+		p.ID = alias
+		a.replaced++
+	}
+	for i := range dir.Teams {
+		t := &dir.Teams[i]
+		alias := a.pseudonym("team", t.ID)
+		a.names["person"][t.ID] = alias
+		if t.Name != "" {
+			a.names["person"][t.Name] = alias
+			t.Name = alias
+		}
+		t.ID = alias
+		for j, m := range t.Members {
+			t.Members[j] = a.person(m)
+		}
+		a.replaced++
+	}
+}
+
+// Anonymize は .zeus の匿名化したコピーを dest/.zeus に書き込む。
+// タイトル・説明などの自由記述、人物（people の ID・表示名・メールアドレス）、タグを値ごとに安定した仮名
+// （title-1、person-2、user-3@example.invalid など）に置き換え、接続先・認証情報を消去する。
+// ID・参照・状態・日付・数値は残すため、エンティティの構造と関係は元のプロジェクトと同じになる。
+// 自由記述を含むログ（logs）・バックアップ・分析結果と YAML 以外のファイルは含めない
+func (z *Zeus) Anonymize(ctx context.Context, dest string) (*AnonymizeResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if _, err := z.LoadConfig(ctx); err != nil {
+		return nil, err
+	}
+	destZeus := filepath.Join(dest, ".zeus")
+	if _, err := os.Stat(destZeus); err == nil {
+		return nil, fmt.Errorf("%w: %s", ErrProjectExists, destZeus)
+	}
+
+	result := &AnonymizeResult{Skipped: []string{}}
+	var files []string
+	err := filepath.WalkDir(z.ZeusPath, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(z.ZeusPath, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if d.IsDir() {
+			if anonymizeSkipDirs[rel] {
+				result.Skipped = append(result.Skipped, rel+"/")
+				return filepath.SkipDir
+			}
+			return os.MkdirAll(filepath.Join(destZeus, filepath.FromSlash(rel)), 0755)
+		}
+		ext := filepath.Ext(rel)
+		switch {
+		case !d.Type().IsRegular() || bundleExcluded(d.Name()):
+		case ext == ".yaml" || ext == ".yml":
+			files = append(files, rel)
+		default:
+			result.Skipped = append(result.Skipped, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 名簿を先に処理し、オーナーなどの参照に名簿と同じ仮名を使う
+	sort.SliceStable(files, func(i, j int) bool { return files[i] == peopleFile && files[j] != peopleFile })
+
+	a := newAnonymizer()
+	for _, rel := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		data, err := os.ReadFile(filepath.Join(z.ZeusPath, filepath.FromSlash(rel)))
+		if err != nil {
+			return nil, err
+		}
+		out, err := a.anonymizeYaml(rel, data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrYamlSyntax, rel, err)
+		}
+		if err := os.WriteFile(filepath.Join(destZeus, filepath.FromSlash(rel)), out, 0644); err != nil {
+			return nil, err
+		}
+		result.Files++
+	}
+	result.Replaced = a.replaced
+	return result, nil
+}
+
+// anonymizeYaml は YAML ファイルの内容を匿名化する
+func (a *anonymizer) anonymizeYaml(rel string, data []byte) ([]byte, error) {
+	if rel == peopleFile {
+		var dir PeopleDirectory
+		if err := yaml.Unmarshal(data, &dir); err != nil {
+			return nil, err
+		}
+		a.people(&dir)
+		return yaml.Marshal(&dir)
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind == 0 {
+		// コメントのみのファイル
+		return []byte{}, nil
+	}
+	// コメントは自由記述を含みうるため取り除く
+	stripComments(&doc)
+	a.node(&doc, "")
+	return yaml.Marshal(&doc)
+}
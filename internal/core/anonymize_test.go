@@ -0,0 +1,106 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAnonymize(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	people := &PeopleDirectory{
+		People: []Person{{ID: "alice", Name: "Alice Smith"}, {ID: "bob", Name: "Bob Jones"}},
+		Teams:  []Team{{ID: "payments", Name: "Payments Team", Members: []string{"alice", "bob"}}},
+	}
+	if err := z.fileStore.WriteYaml(ctx, peopleFile, people); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+	config, _ := z.LoadConfig(ctx)
+	config.Project.Name = "秘密のプロジェクト"
+	config.Notifications.Email = EmailSettings{Host: "smtp.corp.example", Password: "s3cret", From: "pm@corp.example", To: []string{"alice@corp.example"}}
+	if err := z.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+	obj, err := z.Add(ctx, "objective", "買収計画", WithObjectiveOwner("Alice Smith"), WithObjectiveTags([]string{"confidential"}))
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	uc, _ := z.Add(ctx, "usecase", "買収先を評価する", WithUseCaseObjective(obj.ID), WithUseCaseOwner("alice"))
+	act, _ := z.Add(ctx, "activity", "買収計画", WithActivityUseCase(uc.ID), WithActivityOwner("payments"))
+
+	dest := t.TempDir()
+	result, err := z.Anonymize(ctx, dest)
+	if err != nil {
+		t.Fatalf("Anonymize failed: %v", err)
+	}
+	if result.Files == 0 || result.Replaced == 0 || !strings.Contains(strings.Join(result.Skipped, ","), "logs/") {
+		t.Errorf("result = %+v", result)
+	}
+
+	// 元の自由記述・人物・メールアドレス・認証情報が残っていない
+	err = filepathWalkFiles(filepath.Join(dest, ".zeus"), func(path string, data string) {
+		for _, secret := range []string{"買収", "秘密", "Alice", "alice", "bob", "payments", "confidential", "corp.example", "s3cret"} {
+			if strings.Contains(data, secret) {
+				t.Errorf("%s should not contain %q:\n%s", path, secret, data)
+			}
+		}
+	})
+	if err != nil {
+		t.Fatalf("walk failed: %v", err)
+	}
+
+	// ID・参照は残り、同じ値は同じ仮名になる
+	anon := New(dest)
+	gotObj, err := anon.Get(ctx, "objective", obj.ID)
+	if err != nil {
+		t.Fatalf("anonymized objective should exist: %v", err)
+	}
+	o := gotObj.(*ObjectiveEntity)
+	gotUC, _ := anon.Get(ctx, "usecase", uc.ID)
+	u := gotUC.(*UseCaseEntity)
+	gotAct, _ := anon.Get(ctx, "activity", act.ID)
+	a := gotAct.(*ActivityEntity)
+	if u.ObjectiveID != obj.ID || a.UseCaseID != uc.ID {
+		t.Errorf("references should be preserved: %+v %+v", u, a)
+	}
+	if o.Title != a.Title || !strings.HasPrefix(o.Title, "title-") || o.Tags[0] != "tag-1" {
+		t.Errorf("same title should get the same pseudonym: %q %q %v", o.Title, a.Title, o.Tags)
+	}
+	// 表示名と ID のどちらで指定したオーナーも名簿の同じ仮名になる
+	dir, err := anon.LoadPeople(ctx)
+	if err != nil {
+		t.Fatalf("LoadPeople failed: %v", err)
+	}
+	if o.Owner != u.Metadata.Owner || o.Owner != dir.People[0].ID || a.Metadata.Owner != dir.Teams[0].ID {
+		t.Errorf("owners = %q %q %q, people = %+v", o.Owner, u.Metadata.Owner, a.Metadata.Owner, dir)
+	}
+	if anonConfig, _ := anon.LoadConfig(ctx); anonConfig.Notifications.Email.Password != "" || anonConfig.Notifications.Email.To[0] != "user-2@example.invalid" {
+		t.Errorf("email settings = %+v", anonConfig.Notifications.Email)
+	}
+
+	if _, err := z.Anonymize(ctx, dest); !errors.Is(err, ErrProjectExists) {
+		t.Errorf("existing destination should fail: %v", err)
+	}
+}
+
+// filepathWalkFiles は dir 以下のファイルの内容を fn に渡す
+func filepathWalkFiles(dir string, fn func(path, data string)) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		fn(path, string(data))
+		return nil
+	})
+}
@@ -20,18 +20,46 @@ const (
 
 // PendingApproval は承認待ちアイテム
 type PendingApproval struct {
-	ID          string         `yaml:"id"`
-	Type        string         `yaml:"type"` // task_create, task_update, suggestion
-	Description string         `yaml:"description"`
-	Level       ApprovalLevel  `yaml:"level"`
-	Status      ApprovalStatus `yaml:"status"`
-	EntityID    string         `yaml:"entity_id,omitempty"`
-	Payload     any            `yaml:"payload,omitempty"`
-	CreatedAt   string         `yaml:"created_at"`
-	UpdatedAt   string         `yaml:"updated_at"`
-	ApprovedBy  string         `yaml:"approved_by,omitempty"`
-	RejectedBy  string         `yaml:"rejected_by,omitempty"`
-	Reason      string         `yaml:"reason,omitempty"`
+	ID            string          `yaml:"id"`
+	Type          string          `yaml:"type"` // <entity>_create, <entity>_update, <entity>_delete, suggestion
+	Description   string          `yaml:"description"`
+	Level         ApprovalLevel   `yaml:"level"`
+	Status        ApprovalStatus  `yaml:"status"`
+	EntityID      string          `yaml:"entity_id,omitempty"`
+	Payload       any             `yaml:"payload,omitempty"`
+	Entity        *ApprovalEntity `yaml:"entity,omitempty"`         // 承認時にそのまま書き込むエンティティ
+	Approver      string          `yaml:"approver,omitempty"`       // 承認者（people の ID、委任後の承認者）
+	DelegatedFrom string          `yaml:"delegated_from,omitempty"` // 委任元の承認者
+	ExpiresAt     string          `yaml:"expires_at,omitempty"`     // 承認期限（ポリシーの on_expire に従って自動処理）
+	RemindedAt    string          `yaml:"reminded_at,omitempty"`    // 最後にリマインダーを送信した日時
+	Agent         string          `yaml:"agent,omitempty"`          // 承認待ちを作成したエージェント（--agent）
+	RequestedBy   string          `yaml:"requested_by,omitempty"`   // 承認待ちを作成した操作者
+	Changes       []FileChange    `yaml:"changes,omitempty"`        // 承認後に変更されるファイルと差分（作成時点のプレビュー）
+	CreatedAt     string          `yaml:"created_at"`
+	UpdatedAt     string          `yaml:"updated_at"`
+	ApprovedBy    string          `yaml:"approved_by,omitempty"`
+	RejectedBy    string          `yaml:"rejected_by,omitempty"`
+	Reason        string          `yaml:"reason,omitempty"`
+}
+
+// ApprovalEntity は承認対象のエンティティ（承認待ちの作成時点でシリアライズした内容）
+type ApprovalEntity struct {
+	Type         string `yaml:"type"`
+	ID           string `yaml:"id"`
+	Op           string `yaml:"op,omitempty"`            // create（省略時）, update, delete
+	Path         string `yaml:"path"`                    // .zeus からの相対パス
+	Content      string `yaml:"content"`                 // エンティティの YAML（承認時にこの内容を書き込む。delete では空）
+	BaseRevision string `yaml:"base_revision,omitempty"` // update, delete の対象ファイルのキュー追加時点のリビジョン
+	// Related は同じ操作で変更する他のエンティティ（連鎖削除・参照の解除）。承認時に対象とまとめて反映する
+	Related []ApprovalEntity `yaml:"related,omitempty"`
+}
+
+// Operation は承認対象の操作（記録されていない場合は create）
+func (e *ApprovalEntity) Operation() string {
+	if e.Op == "" {
+		return ChangeOpCreate
+	}
+	return e.Op
 }
 
 // ApprovalStore は承認ストア
@@ -41,9 +69,10 @@ type ApprovalStoreData struct {
 
 // ApprovalResult は承認・却下結果
 type ApprovalResult struct {
-	Success bool
-	ID      string
-	Status  ApprovalStatus
+	Success  bool
+	ID       string
+	Status   ApprovalStatus
+	EntityID string // 承認により作成したエンティティの ID
 }
 
 // ApprovalManager は承認を管理
@@ -51,6 +80,7 @@ type ApprovalManager struct {
 	zeusPath  string
 	fileStore FileStore
 	lock      *yaml.FileLock
+	actor     string // 承認・却下の記録に使う操作者
 }
 
 // NewApprovalManager は新しい ApprovalManager を作成
@@ -63,6 +93,14 @@ func NewApprovalManager(zeusPath string, fs FileStore) *ApprovalManager {
 	}
 }
 
+// decidedBy は承認・却下した操作者（未設定の場合は "user"）
+func (am *ApprovalManager) decidedBy() string {
+	if am.actor == "" {
+		return "user"
+	}
+	return am.actor
+}
+
 // generateApprovalID はユニークな承認 ID を生成
 // UUID v4 を使用して衝突を防止
 func (am *ApprovalManager) generateApprovalID() string {
@@ -127,6 +165,23 @@ func (am *ApprovalManager) Get(ctx context.Context, id string) (*PendingApproval
 
 // Create は新しい承認アイテムを作成（原子的操作）
 func (am *ApprovalManager) Create(ctx context.Context, approvalType, description string, level ApprovalLevel, entityID string, payload any) (*PendingApproval, error) {
+	return am.create(ctx, PendingApproval{
+		Type:        approvalType,
+		Description: description,
+		Level:       level,
+		EntityID:    entityID,
+		Payload:     payload,
+	})
+}
+
+// Enqueue は ID・ステータス・作成日時を設定して承認アイテムをキューに追加（原子的操作）
+// 承認対象のエンティティや承認者など、Create で指定できない項目を含める場合に使用する
+func (am *ApprovalManager) Enqueue(ctx context.Context, approval PendingApproval) (*PendingApproval, error) {
+	return am.create(ctx, approval)
+}
+
+// Update は承認待ちアイテムを fn で更新（原子的操作、承認待ち以外はエラー）
+func (am *ApprovalManager) Update(ctx context.Context, id string, fn func(a *PendingApproval) error) (*PendingApproval, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
@@ -141,23 +196,50 @@ func (am *ApprovalManager) Create(ctx context.Context, approvalType, description
 	if err != nil {
 		return nil, err
 	}
+	for i := range all {
+		if all[i].ID != id {
+			continue
+		}
+		if all[i].Status != ApprovalStatusPending {
+			return nil, &ApprovalNotPendingError{ID: id, CurrentStatus: all[i].Status}
+		}
+		if err := fn(&all[i]); err != nil {
+			return nil, err
+		}
+		all[i].UpdatedAt = Now()
+		store := ApprovalStoreData{Approvals: all}
+		if err := am.fileStore.WriteYaml(ctx, "approvals/pending/queue.yaml", &store); err != nil {
+			return nil, err
+		}
+		updated := all[i]
+		return &updated, nil
+	}
+	return nil, ErrEntityNotFound
+}
+
+// create は ID・ステータス・日時を設定して承認アイテムをキューに追加
+func (am *ApprovalManager) create(ctx context.Context, approval PendingApproval) (*PendingApproval, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
-	// UUID ベースの ID 生成（衝突防止）
-	id := am.generateApprovalID()
-	now := Now()
+	// ロックを取得（タイムアウト: 5秒）
+	if err := am.lock.LockWithTimeout(5 * time.Second); err != nil {
+		return nil, ErrLockAcquireFailed
+	}
+	defer am.lock.Unlock()
 
-	approval := PendingApproval{
-		ID:          id,
-		Type:        approvalType,
-		Description: description,
-		Level:       level,
-		Status:      ApprovalStatusPending,
-		EntityID:    entityID,
-		Payload:     payload,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+	all, err := am.GetAll(ctx)
+	if err != nil {
+		return nil, err
 	}
 
+	// UUID ベースの ID 生成（衝突防止）
+	approval.ID = am.generateApprovalID()
+	approval.Status = ApprovalStatusPending
+	approval.CreatedAt = Now()
+	approval.UpdatedAt = approval.CreatedAt
+
 	all = append(all, approval)
 	store := ApprovalStoreData{Approvals: all}
 
@@ -200,7 +282,7 @@ func (am *ApprovalManager) Approve(ctx context.Context, id string) (*ApprovalRes
 			}
 			all[i].Status = ApprovalStatusApproved
 			all[i].UpdatedAt = Now()
-			all[i].ApprovedBy = "user"
+			all[i].ApprovedBy = am.decidedBy()
 			approvedItem = all[i]
 			found = true
 			break
@@ -264,7 +346,7 @@ func (am *ApprovalManager) Reject(ctx context.Context, id, reason string) (*Appr
 			}
 			all[i].Status = ApprovalStatusRejected
 			all[i].UpdatedAt = Now()
-			all[i].RejectedBy = "user"
+			all[i].RejectedBy = am.decidedBy()
 			all[i].Reason = reason
 			rejectedItem = all[i]
 			found = true
@@ -301,7 +383,14 @@ func (am *ApprovalManager) Reject(ctx context.Context, id, reason string) (*Appr
 }
 
 // DetermineApprovalLevel はアクションに応じた承認レベルを決定
+// settings.operations に上書きがある場合はそれを優先する
 func (am *ApprovalManager) DetermineApprovalLevel(actionType string, settings *Settings) ApprovalLevel {
+	if level, ok := settings.operationLevel(actionType); ok {
+		return level
+	}
+	// エンティティ別の操作（objective_delete 等）の既定はタスクの操作と同じ
+	actionType = genericApprovalAction(actionType)
+
 	// 承認モードに応じてデフォルトレベルを決定
 	switch settings.ApprovalMode {
 	case "strict":
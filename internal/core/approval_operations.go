@@ -0,0 +1,234 @@
+package core
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// approvalOperations は settings.operations で承認レベルを上書きできる操作
+var approvalOperations = []string{ChangeOpCreate, ChangeOpUpdate, ChangeOpDelete}
+
+// operationLevel は settings.operations の上書きを返す（エンティティ別の指定、次に task_<op> の順）
+func (s *Settings) operationLevel(actionType string) (ApprovalLevel, bool) {
+	if level, ok := s.Operations[actionType]; ok {
+		return level, true
+	}
+	if generic := genericApprovalAction(actionType); generic != actionType {
+		level, ok := s.Operations[generic]
+		return level, ok
+	}
+	return "", false
+}
+
+// genericApprovalAction はエンティティ別の操作をタスクの操作に読み替える（例: objective_delete → task_delete）
+func genericApprovalAction(actionType string) string {
+	_, op, ok := cutLast(actionType, "_")
+	if !ok || !slices.Contains(approvalOperations, op) {
+		return actionType
+	}
+	return "task_" + op
+}
+
+// validateOperationLevels は settings.operations のキー（<entity>_<op>）と承認レベルを検証
+func (z *Zeus) validateOperationLevels(settings *Settings) error {
+	for action, level := range settings.Operations {
+		switch level {
+		case ApprovalAuto, ApprovalNotify, ApprovalApprove:
+		default:
+			return fmt.Errorf("settings.operations.%s: unknown level %q (auto, notify, approve)", action, level)
+		}
+		entity, op, ok := cutLast(action, "_")
+		if !ok || !slices.Contains(approvalOperations, op) {
+			return fmt.Errorf("settings.operations: unknown operation %q (<entity>_%s)", action, strings.Join(approvalOperations, "|"))
+		}
+		if _, known := z.entityRegistry.Get(entity); !known && entity != "task" {
+			return fmt.Errorf("settings.operations.%s: %w: %s", action, ErrUnknownEntity, entity)
+		}
+	}
+	return nil
+}
+
+// cutLast は s を最後の sep の前後に分割
+func cutLast(s, sep string) (string, string, bool) {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return s, "", false
+	}
+	return s[:i], s[i+len(sep):], true
+}
+
+// approvalConfig は承認レベルの判定に使う設定を読み込む（読み込み失敗時は auto として扱う）
+func (z *Zeus) approvalConfig(ctx context.Context) ZeusConfig {
+	var config ZeusConfig
+	if err := z.fileStore.ReadYaml(ctx, "zeus.yaml", &config); err != nil {
+		config.Settings.ApprovalMode = "loose"
+		config.Settings.AutomationLevel = "auto"
+	}
+	return config
+}
+
+// operationApprovalLevel は entity の op（create, update, delete）の承認レベル
+// settings.operations の上書きは automation_level: auto でも適用する。
+// エージェントによる操作は agents のガードレール（変更回数の上限、保護対象は承認必須）を適用する
+func (z *Zeus) operationApprovalLevel(ctx context.Context, config *ZeusConfig, entity, op, id string) (ApprovalLevel, error) {
+	settings := &config.Settings
+	if err := z.validateOperationLevels(settings); err != nil {
+		return "", err
+	}
+	protected, err := z.guardAgent(ctx, &config.Agents, entity, op, id)
+	if err != nil {
+		return "", err
+	}
+	if protected {
+		return ApprovalApprove, nil
+	}
+	action := entity + "_" + op
+	if _, ok := settings.operationLevel(action); !ok && settings.AutomationLevel == "auto" {
+		return ApprovalAuto, nil
+	}
+	return z.approvalStore.DetermineApprovalLevel(action, settings), nil
+}
+
+// queueApproval は entity の op を行う fn（対象の ID を返す。zeus.yaml のようにファイル名でもよい）をドライランし、
+// 書き込まれる内容と差分とともに承認待ちキューに追加する
+// 承認時はここでシリアライズした内容をそのまま反映する（update, delete は対象がキュー追加後に変更されていないことを確認する）
+func (z *Zeus) queueApproval(ctx context.Context, config *ZeusConfig, entity, op, description string, payload any, fn func(tx *Zeus) (string, error)) (*PendingApproval, error) {
+	return z.queueApprovalWithRelated(ctx, config, entity, op, description, payload, nil, fn)
+}
+
+// queueApprovalWithRelated は queueApproval と同様に承認待ちに追加し、fn が related（Type, ID, Op）のエンティティに
+// 書き込んだ内容も記録する（承認時に対象とまとめて反映する）
+func (z *Zeus) queueApprovalWithRelated(ctx context.Context, config *ZeusConfig, entity, op, description string, payload any, related []ApprovalEntity, fn func(tx *Zeus) (string, error)) (*PendingApproval, error) {
+	if err := config.Approvals.Validate(); err != nil {
+		return nil, err
+	}
+	var target *ApprovalEntity
+	changes, err := z.DryRun(ctx, func(tx *Zeus) error {
+		id, err := fn(tx)
+		if err != nil {
+			return err
+		}
+		name := id
+		if filepath.Ext(name) != ".yaml" {
+			name += ".yaml"
+		}
+		path, content, deleted, ok := stagedFileByName(tx.fileStore, name)
+		if !ok {
+			return fmt.Errorf("staged file for %s %s not found", entity, id)
+		}
+		target = &ApprovalEntity{Type: entity, ID: id, Op: op, Path: path}
+		if !deleted {
+			target.Content = string(content)
+		}
+		if op != ChangeOpCreate {
+			target.BaseRevision = contentRevision(readStoreFile(z.fileStore, path))
+		}
+		for _, r := range related {
+			path, content, deleted, ok := stagedFileByName(tx.fileStore, r.ID+".yaml")
+			if !ok {
+				return fmt.Errorf("staged file for %s %s not found", r.Type, r.ID)
+			}
+			r.Path, r.BaseRevision = path, contentRevision(readStoreFile(z.fileStore, path))
+			if !deleted {
+				r.Content = string(content)
+			}
+			target.Related = append(target.Related, r)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pending := PendingApproval{
+		Type:        entity + "_" + op,
+		Description: description,
+		Level:       ApprovalApprove,
+		EntityID:    target.ID,
+		Entity:      target,
+		Payload:     payload,
+		Changes:     changes,
+		Agent:       z.agent,
+		RequestedBy: z.actor,
+	}
+	// 承認ポリシー（承認者・委任・期限）を適用
+	config.Approvals.assign(&pending, time.Now())
+	approval, err := z.approvalStore.(*ApprovalManager).Enqueue(ctx, pending)
+	if err != nil {
+		return nil, fmt.Errorf("承認待ちキューへの追加に失敗しました: %w", err)
+	}
+	if config.Notifications.Email.NotifyApprovals {
+		// メール送信の失敗で操作自体は失敗させない
+		if err := z.sendApprovalEmail(ctx, config, approval); err != nil {
+			fmt.Printf("Warning: 承認通知メールの送信に失敗しました: %v\n", err)
+		}
+	}
+	z.audit(ctx, AuditEntry{Op: op, Entity: entity, ID: target.ID, Result: AuditResultQueued, ApprovalID: approval.ID})
+	for _, r := range target.Related {
+		z.audit(ctx, AuditEntry{Op: r.Operation(), Entity: r.Type, ID: r.ID, Result: AuditResultQueued, ApprovalID: approval.ID})
+	}
+	return approval, nil
+}
+
+// contentRevision はファイルの内容のリビジョン（承認時に対象が変更されていないことの確認に使用）
+func contentRevision(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+// addGated は Add と同じ承認レベルの判定・エージェントのガードレール・監査ログを通してエンティティを追加する
+// （取り込みや同期など、複数のエンティティをまとめて変更する処理で使用）
+// 承認が必要な場合は承認待ちキューに追加し、*ApprovalRequiredError（EntityID は承認後に作成される ID）を返す
+func (z *Zeus) addGated(ctx context.Context, entity, name string, opts ...EntityOption) (*AddResult, error) {
+	result, err := z.Add(ctx, entity, name, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if !result.NeedsApproval {
+		return result, nil
+	}
+	required := &ApprovalRequiredError{Action: entity + "_" + ChangeOpCreate, ApprovalID: result.ApprovalID}
+	if approval, err := z.approvalStore.Get(ctx, result.ApprovalID); err == nil {
+		required.EntityID = approval.EntityID
+	}
+	return nil, required
+}
+
+// updateGated は settings.operations の <entity>_update・エージェントのガードレール・監査ログを通して
+// ハンドラーの Update を実行する（update はハンドラーが受け付ける構造体または map）
+// 承認が必要な場合は承認待ちキューに追加し、*ApprovalRequiredError を返す
+func (z *Zeus) updateGated(ctx context.Context, entity, id string, update any) error {
+	handler, ok := z.entityRegistry.Get(entity)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownEntity, entity)
+	}
+	config := z.approvalConfig(ctx)
+	level, err := z.operationApprovalLevel(ctx, &config, entity, ChangeOpUpdate, id)
+	if err != nil {
+		return err
+	}
+	if level == ApprovalApprove {
+		approval, err := z.queueApproval(ctx, &config, entity, ChangeOpUpdate,
+			fmt.Sprintf("%s %s の更新", entity, id),
+			map[string]any{"entity": entity, "id": id, "update": update},
+			func(tx *Zeus) (string, error) {
+				txHandler, _ := tx.entityRegistry.Get(entity)
+				return id, txHandler.Update(ctx, id, update)
+			})
+		if err != nil {
+			return err
+		}
+		return &ApprovalRequiredError{Action: approval.Type, ApprovalID: approval.ID, EntityID: id}
+	}
+	if err := handler.Update(ctx, id, update); err != nil {
+		return err
+	}
+	z.audit(ctx, AuditEntry{Op: ChangeOpUpdate, Entity: entity, ID: id, Result: AuditResultApplied})
+	return nil
+}
@@ -0,0 +1,171 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestDetermineApprovalLevel_Operations(t *testing.T) {
+	am := NewApprovalManager(t.TempDir(), nil)
+	settings := &Settings{
+		ApprovalMode: "default",
+		Operations: map[string]ApprovalLevel{
+			"task_create":      ApprovalAuto,
+			"task_delete":      ApprovalNotify,
+			"objective_delete": ApprovalApprove,
+			"decision_create":  ApprovalApprove,
+		},
+	}
+
+	tests := []struct {
+		actionType string
+		expected   ApprovalLevel
+	}{
+		{"objective_delete", ApprovalApprove}, // エンティティ別の上書き
+		{"decision_create", ApprovalApprove},
+		{"risk_create", ApprovalAuto},       // task_create の上書き
+		{"risk_delete", ApprovalNotify},     // task_delete の上書き
+		{"risk_update", ApprovalNotify},     // 上書きなし: デフォルトモードの task_update
+		{"suggestion", ApprovalApprove},     // 上書きなし
+		{"objective_archive", ApprovalAuto}, // 対象外の操作はそのまま判定
+	}
+	for _, tt := range tests {
+		t.Run(tt.actionType, func(t *testing.T) {
+			if got := am.DetermineApprovalLevel(tt.actionType, settings); got != tt.expected {
+				t.Errorf("DetermineApprovalLevel(%s) = %v, want %v", tt.actionType, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateOperationLevels(t *testing.T) {
+	z := New(t.TempDir())
+	tests := []struct {
+		name       string
+		operations map[string]ApprovalLevel
+		wantErr    bool
+	}{
+		{"valid", map[string]ApprovalLevel{"task_create": ApprovalAuto, "objective_delete": ApprovalApprove}, false},
+		{"unknown level", map[string]ApprovalLevel{"objective_delete": "ask"}, true},
+		{"unknown operation", map[string]ApprovalLevel{"objective_archive": ApprovalApprove}, true},
+		{"unknown entity", map[string]ApprovalLevel{"objectve_delete": ApprovalApprove}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := z.validateOperationLevels(&Settings{Operations: tt.operations})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateOperationLevels() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// setupOperationLevels は automation_level: auto のまま操作ごとの承認レベルを設定した Zeus を初期化
+func setupOperationLevels(t *testing.T, operations map[string]ApprovalLevel) *Zeus {
+	t.Helper()
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	config, _ := z.LoadConfig(ctx)
+	config.Settings.Operations = operations
+	if err := z.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+	return z
+}
+
+func TestOperationLevels_GateCreateAndDelete(t *testing.T) {
+	z := setupOperationLevels(t, map[string]ApprovalLevel{
+		"task_create":      ApprovalAuto,
+		"risk_create":      ApprovalApprove,
+		"objective_delete": ApprovalApprove,
+	})
+	ctx := context.Background()
+
+	// 上書きのない作成は即時実行
+	obj, err := z.Add(ctx, "objective", "Launch")
+	if err != nil || obj.NeedsApproval {
+		t.Fatalf("objective should be added immediately: result=%+v err=%v", obj, err)
+	}
+	risk, err := z.Add(ctx, "risk", "Outage")
+	if err != nil || !risk.NeedsApproval {
+		t.Fatalf("risk should be queued: result=%+v err=%v", risk, err)
+	}
+
+	// 削除は承認待ちキューに追加され、承認時に反映される
+	err = z.Delete(ctx, "objective", obj.ID)
+	var required *ApprovalRequiredError
+	if !errors.As(err, &required) || !errors.Is(err, ErrApprovalRequired) || required.Action != "objective_delete" {
+		t.Fatalf("Delete should require approval, got %v", err)
+	}
+	if _, err := z.Get(ctx, "objective", obj.ID); err != nil {
+		t.Fatalf("objective should remain until approval: %v", err)
+	}
+	approval, err := z.approvalStore.Get(ctx, required.ApprovalID)
+	if err != nil {
+		t.Fatalf("Get approval failed: %v", err)
+	}
+	if approval.Entity == nil || approval.Entity.Operation() != ChangeOpDelete || approval.Entity.BaseRevision == "" || len(approval.Changes) != 1 {
+		t.Fatalf("approval should record the deletion: %+v", approval)
+	}
+	if _, err := z.Approve(ctx, required.ApprovalID); err != nil {
+		t.Fatalf("Approve failed: %v", err)
+	}
+	if _, err := z.Get(ctx, "objective", obj.ID); err == nil {
+		t.Error("objective should be deleted on approve")
+	}
+
+	// 存在しないエンティティの削除はキューに追加しない
+	if err := z.Delete(ctx, "objective", "obj-missing"); err == nil || errors.Is(err, ErrApprovalRequired) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestOperationLevels_GateUpdate(t *testing.T) {
+	z := setupOperationLevels(t, map[string]ApprovalLevel{"risk_update": ApprovalApprove})
+	ctx := context.Background()
+
+	risk, err := z.Add(ctx, "risk", "Outage")
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	rev, _ := z.EntityRevision(ctx, "risk", risk.ID)
+
+	_, err = z.UpdateEntity(ctx, "risk", risk.ID, map[string]any{"owner": "alice"}, rev)
+	var required *ApprovalRequiredError
+	if !errors.As(err, &required) {
+		t.Fatalf("UpdateEntity should require approval, got %v", err)
+	}
+	if current, _ := z.EntityRevision(ctx, "risk", risk.ID); current != rev {
+		t.Fatal("risk should not change until approval")
+	}
+	if _, err := z.Approve(ctx, required.ApprovalID); err != nil {
+		t.Fatalf("Approve failed: %v", err)
+	}
+	updated, err := z.Get(ctx, "risk", risk.ID)
+	if err != nil || updated.(*RiskEntity).Owner != "alice" {
+		t.Fatalf("update should be applied on approve: %+v err=%v", updated, err)
+	}
+
+	// キュー追加後に対象が変更された場合は承認時に反映しない
+	rev, _ = z.EntityRevision(ctx, "risk", risk.ID)
+	_, err = z.UpdateEntity(ctx, "risk", risk.ID, map[string]any{"owner": "bob"}, rev)
+	if !errors.As(err, &required) {
+		t.Fatalf("UpdateEntity should require approval, got %v", err)
+	}
+	approval, _ := z.approvalStore.Get(ctx, required.ApprovalID)
+	if err := z.fileStore.WriteFile(ctx, approval.Entity.Path, []byte(approval.Entity.Content+"# edited\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := z.Approve(ctx, required.ApprovalID); err == nil {
+		t.Error("Approve should fail when the entity changed after queueing")
+	}
+
+	// リビジョンの不一致はキューに追加せず競合として返す
+	if _, err := z.UpdateEntity(ctx, "risk", risk.ID, map[string]any{"owner": "carol"}, "stale"); !errors.Is(err, ErrRevisionConflict) {
+		t.Errorf("expected revision conflict, got %v", err)
+	}
+}
@@ -0,0 +1,244 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// 期限切れ時の処理
+const (
+	ApprovalExpireReject  = "reject"
+	ApprovalExpireApprove = "approve"
+)
+
+// ApprovalProcessResult は承認ポリシーの適用結果
+type ApprovalProcessResult struct {
+	Expired  []ExpiredApproval // 期限切れで自動処理した承認待ち
+	Stale    []PendingApproval // リマインダーの対象（remind_after_days 以上経過）
+	Reminded bool              // リマインダーをメールで送信したか
+}
+
+// ExpiredApproval は期限切れで自動処理した承認待ち
+type ExpiredApproval struct {
+	ID          string
+	Description string
+	Status      ApprovalStatus // approved または rejected
+	Error       string         // 自動処理に失敗した場合のエラー（承認待ちのまま残る）
+}
+
+// Validate は承認ポリシーの妥当性を検証
+func (p ApprovalPolicy) Validate() error {
+	if p.ExpireAfterDays < 0 {
+		return fmt.Errorf("approvals.expire_after_days must be >= 0")
+	}
+	if p.RemindAfterDays < 0 {
+		return fmt.Errorf("approvals.remind_after_days must be >= 0")
+	}
+	switch p.OnExpire {
+	case "", ApprovalExpireReject, ApprovalExpireApprove:
+	default:
+		return fmt.Errorf("approvals.on_expire: unknown action %q (reject, approve)", p.OnExpire)
+	}
+	for _, d := range p.Delegations {
+		if d.From == "" || d.To == "" {
+			return fmt.Errorf("approvals.delegations: from and to are required")
+		}
+		if d.From == d.To {
+			return fmt.Errorf("approvals.delegations: %s cannot delegate to self", d.From)
+		}
+		if d.Until != "" {
+			if _, err := time.Parse("2006-01-02", d.Until); err != nil {
+				return fmt.Errorf("approvals.delegations: invalid until %q (YYYY-MM-DD)", d.Until)
+			}
+		}
+	}
+	return nil
+}
+
+// onExpire は期限切れ時の処理（未設定は reject）
+func (p ApprovalPolicy) onExpire() string {
+	if p.OnExpire == "" {
+		return ApprovalExpireReject
+	}
+	return p.OnExpire
+}
+
+// assign は承認待ちに承認者（委任を反映）と期限を設定
+func (p ApprovalPolicy) assign(a *PendingApproval, now time.Time) {
+	if a.Approver == "" && p.Approver != "" {
+		a.Approver, a.DelegatedFrom = p.delegate(p.Approver, now)
+	}
+	if a.ExpiresAt == "" && p.ExpireAfterDays > 0 {
+		a.ExpiresAt = now.AddDate(0, 0, p.ExpireAfterDays).Format(time.RFC3339)
+	}
+}
+
+// delegate は now 時点で有効な委任をたどった承認者と、委任された場合は委任元を返す
+func (p ApprovalPolicy) delegate(approver string, now time.Time) (string, string) {
+	today := now.Format("2006-01-02")
+	current := approver
+	visited := map[string]bool{current: true}
+	for {
+		next := ""
+		for _, d := range p.Delegations {
+			if d.From == current && (d.Until == "" || today <= d.Until) {
+				next = d.To
+				break
+			}
+		}
+		// 委任が循環する場合はそこで止める
+		if next == "" || visited[next] {
+			break
+		}
+		visited[next] = true
+		current = next
+	}
+	if current == approver {
+		return approver, ""
+	}
+	return current, approver
+}
+
+// DelegateApproval は承認待ちの承認者を to に変更（to は people の ID または表示名、to の委任も反映）
+func (z *Zeus) DelegateApproval(ctx context.Context, id, to string) (*PendingApproval, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	am, ok := z.approvalStore.(*ApprovalManager)
+	if !ok {
+		return nil, fmt.Errorf("approval store does not support delegation")
+	}
+	config, err := z.LoadConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := config.Approvals.Validate(); err != nil {
+		return nil, err
+	}
+	approver, err := z.ResolvePerson(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	if approver == "" {
+		return nil, fmt.Errorf("delegate is required")
+	}
+	approver, _ = config.Approvals.delegate(approver, time.Now())
+
+	return am.Update(ctx, id, func(a *PendingApproval) error {
+		if a.Approver == approver {
+			return fmt.Errorf("%s is already assigned to %s", id, approver)
+		}
+		a.DelegatedFrom = a.Approver
+		a.Approver = approver
+		return nil
+	})
+}
+
+// ProcessApprovals は承認ポリシーに従って期限切れの承認待ちを自動処理し、滞留している承認待ちのリマインダーを送信する
+// リマインダーは notifications.email.notify_approvals が有効な場合のみ送信し、remind_after_days ごとに繰り返す
+func (z *Zeus) ProcessApprovals(ctx context.Context, now time.Time) (*ApprovalProcessResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	result := &ApprovalProcessResult{Expired: []ExpiredApproval{}, Stale: []PendingApproval{}}
+	config, err := z.LoadConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policy := config.Approvals
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	pending, err := z.approvalStore.GetPending(ctx)
+	if err != nil {
+		return nil, err
+	}
+	remaining := []PendingApproval{}
+	for _, a := range pending {
+		if !approvalDue(a.ExpiresAt, now) {
+			remaining = append(remaining, a)
+			continue
+		}
+		expired := ExpiredApproval{ID: a.ID, Description: a.Description}
+		var err error
+		if policy.onExpire() == ApprovalExpireApprove {
+			expired.Status = ApprovalStatusApproved
+			_, err = z.Approve(ctx, a.ID)
+		} else {
+			expired.Status = ApprovalStatusRejected
+			_, err = z.approvalStore.Reject(ctx, a.ID, fmt.Sprintf("承認期限（%s）を過ぎたため自動却下", a.ExpiresAt))
+		}
+		if err != nil {
+			expired.Error = err.Error()
+			remaining = append(remaining, a)
+		}
+		result.Expired = append(result.Expired, expired)
+	}
+
+	if policy.RemindAfterDays <= 0 {
+		return result, nil
+	}
+	interval := time.Duration(policy.RemindAfterDays) * 24 * time.Hour
+	for _, a := range remaining {
+		last := a.RemindedAt
+		if last == "" {
+			last = a.CreatedAt
+		}
+		if t, err := time.Parse(time.RFC3339, last); err == nil && now.Sub(t) >= interval {
+			result.Stale = append(result.Stale, a)
+		}
+	}
+	if len(result.Stale) == 0 || !config.Notifications.Email.NotifyApprovals {
+		return result, nil
+	}
+
+	if err := z.sendApprovalReminderEmail(ctx, config, result.Stale, now); err != nil {
+		if errors.Is(err, ErrEmailNotConfigured) {
+			return result, nil
+		}
+		return result, fmt.Errorf("承認リマインダーの送信に失敗しました: %w", err)
+	}
+	result.Reminded = true
+	am, ok := z.approvalStore.(*ApprovalManager)
+	if !ok {
+		return result, nil
+	}
+	for _, a := range result.Stale {
+		if _, err := am.Update(ctx, a.ID, func(p *PendingApproval) error {
+			p.RemindedAt = now.Format(time.RFC3339)
+			return nil
+		}); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// approvalDue は期限（RFC3339）を過ぎているか（期限なし・不正な値は false）
+func approvalDue(expiresAt string, now time.Time) bool {
+	if expiresAt == "" {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, expiresAt)
+	return err == nil && !now.Before(t)
+}
+
+// approvalReminderLine はリマインダーに記載する承認待ちの 1 行
+func approvalReminderLine(a PendingApproval, now time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "- %s %s", a.ID, a.Description)
+	if created, err := time.Parse(time.RFC3339, a.CreatedAt); err == nil {
+		fmt.Fprintf(&b, "（%d 日経過）", int(now.Sub(created).Hours()/24))
+	}
+	if a.Approver != "" {
+		fmt.Fprintf(&b, " 承認者: %s", a.Approver)
+	}
+	if a.ExpiresAt != "" {
+		fmt.Fprintf(&b, " 期限: %s", a.ExpiresAt)
+	}
+	return b.String()
+}
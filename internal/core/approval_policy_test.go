@@ -0,0 +1,211 @@
+package core
+
+import (
+	"context"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestApprovalPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  ApprovalPolicy
+		wantErr bool
+	}{
+		{"empty", ApprovalPolicy{}, false},
+		{"valid", ApprovalPolicy{ExpireAfterDays: 7, OnExpire: "approve", RemindAfterDays: 2, Delegations: []ApprovalDelegation{{From: "alice", To: "bob", Until: "2026-12-31"}}}, false},
+		{"negative expiry", ApprovalPolicy{ExpireAfterDays: -1}, true},
+		{"unknown on_expire", ApprovalPolicy{OnExpire: "ignore"}, true},
+		{"self delegation", ApprovalPolicy{Delegations: []ApprovalDelegation{{From: "alice", To: "alice"}}}, true},
+		{"invalid until", ApprovalPolicy{Delegations: []ApprovalDelegation{{From: "alice", To: "bob", Until: "next week"}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.policy.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestApprovalPolicy_Delegate(t *testing.T) {
+	policy := ApprovalPolicy{Delegations: []ApprovalDelegation{
+		{From: "alice", To: "bob", Until: "2026-10-20"},
+		{From: "bob", To: "carol"},
+		{From: "dave", To: "erin"},
+		{From: "erin", To: "dave"},
+	}}
+	now := time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		approver string
+		now      time.Time
+		want     string
+		from     string
+	}{
+		{"alice", now, "carol", "alice"},                  // 委任をたどる
+		{"alice", now.AddDate(0, 0, 10), "alice", ""},     // 期限切れの委任は無視
+		{"carol", now, "carol", ""},                       // 委任なし
+		{"dave", now, "erin", "dave"},                     // 循環は止める
+		{"alice", now.AddDate(0, 0, 5), "carol", "alice"}, // until 当日は有効
+	}
+	for _, tt := range tests {
+		got, from := policy.delegate(tt.approver, tt.now)
+		if got != tt.want || from != tt.from {
+			t.Errorf("delegate(%s, %s) = (%s, %s), want (%s, %s)", tt.approver, tt.now.Format("2006-01-02"), got, from, tt.want, tt.from)
+		}
+	}
+}
+
+// setupApprovalPolicy は承認必須の設定と承認ポリシーで Zeus を初期化
+func setupApprovalPolicy(t *testing.T, policy ApprovalPolicy, sent *[]string) *Zeus {
+	t.Helper()
+	z := New(t.TempDir(), WithEmailSendFunc(func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		*sent = append(*sent, string(msg))
+		return nil
+	}))
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	config, _ := z.LoadConfig(ctx)
+	config.Settings.AutomationLevel = "approve"
+	config.Settings.ApprovalMode = "strict"
+	config.Approvals = policy
+	config.Notifications.Email = EmailSettings{Host: "localhost", From: "zeus@example.com", To: []string{"lead@example.com"}}
+	if err := z.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+	return z
+}
+
+func TestAdd_AssignsApprovalPolicy(t *testing.T) {
+	var sent []string
+	z := setupApprovalPolicy(t, ApprovalPolicy{
+		Approver:        "alice",
+		ExpireAfterDays: 3,
+		Delegations:     []ApprovalDelegation{{From: "alice", To: "bob"}},
+	}, &sent)
+	ctx := context.Background()
+
+	added, err := z.Add(ctx, "objective", "Launch")
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	approval, err := z.approvalStore.Get(ctx, added.ApprovalID)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if approval.Approver != "bob" || approval.DelegatedFrom != "alice" {
+		t.Errorf("approver should be delegated: approver=%s from=%s", approval.Approver, approval.DelegatedFrom)
+	}
+	created, _ := time.Parse(time.RFC3339, approval.CreatedAt)
+	expires, err := time.Parse(time.RFC3339, approval.ExpiresAt)
+	if err != nil || expires.Sub(created) < 71*time.Hour {
+		t.Errorf("expires_at should be 3 days after creation: created=%s expires=%s", approval.CreatedAt, approval.ExpiresAt)
+	}
+
+	// 手動の委任
+	delegated, err := z.DelegateApproval(ctx, added.ApprovalID, "carol")
+	if err != nil {
+		t.Fatalf("DelegateApproval failed: %v", err)
+	}
+	if delegated.Approver != "carol" || delegated.DelegatedFrom != "bob" {
+		t.Errorf("unexpected delegation: %+v", delegated)
+	}
+	if _, err := z.DelegateApproval(ctx, added.ApprovalID, "carol"); err == nil {
+		t.Error("expected error when delegating to the current approver")
+	}
+	if _, err := z.DelegateApproval(ctx, "approval-missing", "dave"); err == nil {
+		t.Error("expected error for unknown approval")
+	}
+}
+
+func TestProcessApprovals_Expiry(t *testing.T) {
+	ctx := context.Background()
+	later := time.Now().AddDate(0, 0, 3)
+
+	// デフォルトは期限切れで却下
+	var sent []string
+	z := setupApprovalPolicy(t, ApprovalPolicy{ExpireAfterDays: 2}, &sent)
+	added, err := z.Add(ctx, "objective", "Launch")
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	result, err := z.ProcessApprovals(ctx, time.Now())
+	if err != nil || len(result.Expired) != 0 {
+		t.Fatalf("nothing should expire yet: result=%+v err=%v", result, err)
+	}
+	result, err = z.ProcessApprovals(ctx, later)
+	if err != nil {
+		t.Fatalf("ProcessApprovals failed: %v", err)
+	}
+	if len(result.Expired) != 1 || result.Expired[0].ID != added.ApprovalID || result.Expired[0].Status != ApprovalStatusRejected {
+		t.Fatalf("approval should be rejected on expiry: %+v", result.Expired)
+	}
+	if pending, _ := z.Pending(ctx); len(pending) != 0 {
+		t.Errorf("expired approval should leave the queue: %+v", pending)
+	}
+
+	// on_expire: approve ではエンティティを作成
+	z = setupApprovalPolicy(t, ApprovalPolicy{ExpireAfterDays: 2, OnExpire: ApprovalExpireApprove}, &sent)
+	added, err = z.Add(ctx, "objective", "Launch")
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	approval, _ := z.approvalStore.Get(ctx, added.ApprovalID)
+	result, err = z.ProcessApprovals(ctx, later)
+	if err != nil {
+		t.Fatalf("ProcessApprovals failed: %v", err)
+	}
+	if len(result.Expired) != 1 || result.Expired[0].Status != ApprovalStatusApproved || result.Expired[0].Error != "" {
+		t.Fatalf("approval should be approved on expiry: %+v", result.Expired)
+	}
+	if !z.fileStore.Exists(ctx, approval.Entity.Path) {
+		t.Error("auto-approved entity should be written")
+	}
+}
+
+func TestProcessApprovals_Reminder(t *testing.T) {
+	ctx := context.Background()
+	var sent []string
+	z := setupApprovalPolicy(t, ApprovalPolicy{Approver: "alice", RemindAfterDays: 2}, &sent)
+	config, _ := z.LoadConfig(ctx)
+	config.Notifications.Email.NotifyApprovals = true
+	if err := z.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := z.Add(ctx, "objective", "Launch"); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	sent = nil // 承認待ちの追加通知は除く
+
+	result, err := z.ProcessApprovals(ctx, time.Now().Add(time.Hour))
+	if err != nil || len(result.Stale) != 0 || result.Reminded {
+		t.Fatalf("no reminder before remind_after_days: result=%+v err=%v", result, err)
+	}
+
+	now := time.Now().AddDate(0, 0, 3)
+	result, err = z.ProcessApprovals(ctx, now)
+	if err != nil {
+		t.Fatalf("ProcessApprovals failed: %v", err)
+	}
+	if len(result.Stale) != 1 || !result.Reminded || len(sent) != 1 {
+		t.Fatalf("reminder should be sent: result=%+v sent=%d", result, len(sent))
+	}
+	if !strings.Contains(sent[0], "Subject:") {
+		t.Errorf("unexpected message: %s", sent[0])
+	}
+
+	// 送信後は remind_after_days が経過するまで再送しない
+	result, err = z.ProcessApprovals(ctx, now.Add(time.Hour))
+	if err != nil || result.Reminded || len(sent) != 1 {
+		t.Errorf("reminder should not be repeated within the interval: result=%+v sent=%d", result, len(sent))
+	}
+	result, err = z.ProcessApprovals(ctx, now.AddDate(0, 0, 2))
+	if err != nil || !result.Reminded || len(sent) != 2 {
+		t.Errorf("reminder should be repeated after the interval: result=%+v sent=%d", result, len(sent))
+	}
+}
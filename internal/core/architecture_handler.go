@@ -0,0 +1,736 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	containersFileName = "containers.yaml"
+	componentsFileName = "components.yaml"
+)
+
+// ContainerHandler はコンテナエンティティのハンドラー
+type ContainerHandler struct {
+	fileStore        FileStore
+	subsystemHandler *SubsystemHandler
+}
+
+// NewContainerHandler は ContainerHandler を生成
+func NewContainerHandler(fs FileStore, subsystemHandler *SubsystemHandler) *ContainerHandler {
+	return &ContainerHandler{fileStore: fs, subsystemHandler: subsystemHandler}
+}
+
+// Type はエンティティタイプを返す
+func (h *ContainerHandler) Type() string {
+	return "container"
+}
+
+// Add はコンテナを追加
+func (h *ContainerHandler) Add(ctx context.Context, name string, opts ...EntityOption) (*AddResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	containersFile, err := loadContainers(ctx, h.fileStore)
+	if err != nil {
+		return nil, err
+	}
+
+	id := fmt.Sprintf("ctr-%s", uuid.New().String()[:8])
+	now := Now()
+
+	container := ContainerEntity{
+		ID:   id,
+		Name: name,
+		Metadata: Metadata{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+
+	// オプション適用
+	for _, opt := range opts {
+		opt(&container)
+	}
+
+	// バリデーション
+	if err := container.Validate(); err != nil {
+		return nil, err
+	}
+	if err := h.checkReferences(ctx, &container); err != nil {
+		return nil, err
+	}
+
+	containersFile.Containers = append(containersFile.Containers, container)
+	if err := h.fileStore.WriteYaml(ctx, containersFileName, containersFile); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", containersFileName, err)
+	}
+
+	return &AddResult{
+		Success: true,
+		ID:      id,
+		Entity:  h.Type(),
+	}, nil
+}
+
+// List はコンテナ一覧を取得
+func (h *ContainerHandler) List(ctx context.Context, filter *ListFilter) (*ListResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	containersFile, err := loadContainers(ctx, h.fileStore)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]ListItem, 0, len(containersFile.Containers))
+	for _, c := range containersFile.Containers {
+		items = append(items, ListItem{
+			ID:          c.ID,
+			Title:       c.Name,
+			Description: c.Description,
+			CreatedAt:   c.Metadata.CreatedAt,
+			UpdatedAt:   c.Metadata.UpdatedAt,
+		})
+	}
+
+	return &ListResult{
+		Entity: h.Type(),
+		Items:  items,
+		Total:  len(items),
+	}, nil
+}
+
+// Get はコンテナを取得
+func (h *ContainerHandler) Get(ctx context.Context, id string) (any, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// ID のセキュリティ検証
+	if err := ValidateID("container", id); err != nil {
+		return nil, err
+	}
+
+	containersFile, err := loadContainers(ctx, h.fileStore)
+	if err != nil {
+		return nil, err
+	}
+	for _, container := range containersFile.Containers {
+		if container.ID == id {
+			return &container, nil
+		}
+	}
+
+	return nil, ErrEntityNotFound
+}
+
+// Update はコンテナを更新
+func (h *ContainerHandler) Update(ctx context.Context, id string, update any) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// ID のセキュリティ検証
+	if err := ValidateID("container", id); err != nil {
+		return err
+	}
+
+	containersFile, err := loadContainers(ctx, h.fileStore)
+	if err != nil {
+		return err
+	}
+
+	for i := range containersFile.Containers {
+		container := &containersFile.Containers[i]
+		if container.ID != id {
+			continue
+		}
+
+		// 更新データを適用
+		if updateMap, ok := update.(map[string]any); ok {
+			if name, exists := updateMap["name"].(string); exists {
+				container.Name = name
+			}
+			if desc, exists := updateMap["description"].(string); exists {
+				container.Description = desc
+			}
+			if tech, exists := updateMap["technology"].(string); exists {
+				container.Technology = tech
+			}
+			if subsystemID, exists := updateMap["subsystem_id"].(string); exists {
+				container.SubsystemID = subsystemID
+			}
+		}
+		container.Metadata.UpdatedAt = Now()
+
+		if err := container.Validate(); err != nil {
+			return err
+		}
+		if err := h.checkReferences(ctx, container); err != nil {
+			return err
+		}
+		return h.fileStore.WriteYaml(ctx, containersFileName, containersFile)
+	}
+
+	return ErrEntityNotFound
+}
+
+// Delete はコンテナを削除
+// 所属するコンポーネントがある・他の要素から関係を張られている場合は削除しない
+func (h *ContainerHandler) Delete(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// ID のセキュリティ検証
+	if err := ValidateID("container", id); err != nil {
+		return err
+	}
+
+	containersFile, err := loadContainers(ctx, h.fileStore)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	newContainers := make([]ContainerEntity, 0, len(containersFile.Containers))
+	for _, container := range containersFile.Containers {
+		if container.ID == id {
+			found = true
+			continue
+		}
+		newContainers = append(newContainers, container)
+	}
+	if !found {
+		return ErrEntityNotFound
+	}
+
+	referrers, err := architectureReferrers(ctx, h.fileStore, id)
+	if err != nil {
+		return err
+	}
+	if len(referrers) > 0 {
+		return fmt.Errorf("container %s is referenced by: %s", id, strings.Join(referrers, ", "))
+	}
+
+	containersFile.Containers = newContainers
+	return h.fileStore.WriteYaml(ctx, containersFileName, containersFile)
+}
+
+// ListAll は全コンテナを取得（内部用）
+func (h *ContainerHandler) ListAll(ctx context.Context) ([]ContainerEntity, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	containersFile, err := loadContainers(ctx, h.fileStore)
+	if err != nil {
+		return nil, err
+	}
+	return containersFile.Containers, nil
+}
+
+// AddRelation はコンテナに関係を追加
+func (h *ContainerHandler) AddRelation(ctx context.Context, id string, relation ArchitectureRelation) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// ID のセキュリティ検証
+	if err := ValidateID("container", id); err != nil {
+		return err
+	}
+
+	containersFile, err := loadContainers(ctx, h.fileStore)
+	if err != nil {
+		return err
+	}
+
+	for i := range containersFile.Containers {
+		container := &containersFile.Containers[i]
+		if container.ID != id {
+			continue
+		}
+
+		container.Relations = append(container.Relations, relation)
+		container.Metadata.UpdatedAt = Now()
+		if err := container.Validate(); err != nil {
+			return err
+		}
+		if err := h.checkReferences(ctx, container); err != nil {
+			return err
+		}
+		return h.fileStore.WriteYaml(ctx, containersFileName, containersFile)
+	}
+
+	return ErrEntityNotFound
+}
+
+// checkReferences はサブシステムと関係先の存在を確認
+func (h *ContainerHandler) checkReferences(ctx context.Context, container *ContainerEntity) error {
+	if container.SubsystemID != "" && h.subsystemHandler != nil {
+		if _, err := h.subsystemHandler.Get(ctx, container.SubsystemID); err != nil {
+			return fmt.Errorf("referenced subsystem not found: %s", container.SubsystemID)
+		}
+	}
+	return checkArchitectureRelationTargets(ctx, h.fileStore, container.Relations)
+}
+
+// ComponentHandler はコンポーネントエンティティのハンドラー
+type ComponentHandler struct {
+	fileStore        FileStore
+	containerHandler *ContainerHandler
+}
+
+// NewComponentHandler は ComponentHandler を生成
+func NewComponentHandler(fs FileStore, containerHandler *ContainerHandler) *ComponentHandler {
+	return &ComponentHandler{fileStore: fs, containerHandler: containerHandler}
+}
+
+// Type はエンティティタイプを返す
+func (h *ComponentHandler) Type() string {
+	return "component"
+}
+
+// Add はコンポーネントを追加
+func (h *ComponentHandler) Add(ctx context.Context, name string, opts ...EntityOption) (*AddResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	componentsFile, err := loadComponents(ctx, h.fileStore)
+	if err != nil {
+		return nil, err
+	}
+
+	id := fmt.Sprintf("cmp-%s", uuid.New().String()[:8])
+	now := Now()
+
+	component := ComponentEntity{
+		ID:   id,
+		Name: name,
+		Metadata: Metadata{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+
+	// オプション適用
+	for _, opt := range opts {
+		opt(&component)
+	}
+
+	// バリデーション
+	if err := component.Validate(); err != nil {
+		return nil, err
+	}
+	if err := h.checkReferences(ctx, &component); err != nil {
+		return nil, err
+	}
+
+	componentsFile.Components = append(componentsFile.Components, component)
+	if err := h.fileStore.WriteYaml(ctx, componentsFileName, componentsFile); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", componentsFileName, err)
+	}
+
+	return &AddResult{
+		Success: true,
+		ID:      id,
+		Entity:  h.Type(),
+	}, nil
+}
+
+// List はコンポーネント一覧を取得
+func (h *ComponentHandler) List(ctx context.Context, filter *ListFilter) (*ListResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	componentsFile, err := loadComponents(ctx, h.fileStore)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]ListItem, 0, len(componentsFile.Components))
+	for _, c := range componentsFile.Components {
+		items = append(items, ListItem{
+			ID:          c.ID,
+			Title:       c.Name,
+			Description: c.Description,
+			CreatedAt:   c.Metadata.CreatedAt,
+			UpdatedAt:   c.Metadata.UpdatedAt,
+		})
+	}
+
+	return &ListResult{
+		Entity: h.Type(),
+		Items:  items,
+		Total:  len(items),
+	}, nil
+}
+
+// Get はコンポーネントを取得
+func (h *ComponentHandler) Get(ctx context.Context, id string) (any, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// ID のセキュリティ検証
+	if err := ValidateID("component", id); err != nil {
+		return nil, err
+	}
+
+	componentsFile, err := loadComponents(ctx, h.fileStore)
+	if err != nil {
+		return nil, err
+	}
+	for _, component := range componentsFile.Components {
+		if component.ID == id {
+			return &component, nil
+		}
+	}
+
+	return nil, ErrEntityNotFound
+}
+
+// Update はコンポーネントを更新
+func (h *ComponentHandler) Update(ctx context.Context, id string, update any) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// ID のセキュリティ検証
+	if err := ValidateID("component", id); err != nil {
+		return err
+	}
+
+	componentsFile, err := loadComponents(ctx, h.fileStore)
+	if err != nil {
+		return err
+	}
+
+	for i := range componentsFile.Components {
+		component := &componentsFile.Components[i]
+		if component.ID != id {
+			continue
+		}
+
+		// 更新データを適用
+		if updateMap, ok := update.(map[string]any); ok {
+			if name, exists := updateMap["name"].(string); exists {
+				component.Name = name
+			}
+			if desc, exists := updateMap["description"].(string); exists {
+				component.Description = desc
+			}
+			if tech, exists := updateMap["technology"].(string); exists {
+				component.Technology = tech
+			}
+			if containerID, exists := updateMap["container_id"].(string); exists {
+				component.ContainerID = containerID
+			}
+		}
+		component.Metadata.UpdatedAt = Now()
+
+		if err := component.Validate(); err != nil {
+			return err
+		}
+		if err := h.checkReferences(ctx, component); err != nil {
+			return err
+		}
+		return h.fileStore.WriteYaml(ctx, componentsFileName, componentsFile)
+	}
+
+	return ErrEntityNotFound
+}
+
+// Delete はコンポーネントを削除
+// 他の要素から関係を張られている場合は削除しない
+func (h *ComponentHandler) Delete(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// ID のセキュリティ検証
+	if err := ValidateID("component", id); err != nil {
+		return err
+	}
+
+	componentsFile, err := loadComponents(ctx, h.fileStore)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	newComponents := make([]ComponentEntity, 0, len(componentsFile.Components))
+	for _, component := range componentsFile.Components {
+		if component.ID == id {
+			found = true
+			continue
+		}
+		newComponents = append(newComponents, component)
+	}
+	if !found {
+		return ErrEntityNotFound
+	}
+
+	referrers, err := architectureReferrers(ctx, h.fileStore, id)
+	if err != nil {
+		return err
+	}
+	if len(referrers) > 0 {
+		return fmt.Errorf("component %s is referenced by: %s", id, strings.Join(referrers, ", "))
+	}
+
+	componentsFile.Components = newComponents
+	return h.fileStore.WriteYaml(ctx, componentsFileName, componentsFile)
+}
+
+// ListAll は全コンポーネントを取得（内部用）
+func (h *ComponentHandler) ListAll(ctx context.Context) ([]ComponentEntity, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	componentsFile, err := loadComponents(ctx, h.fileStore)
+	if err != nil {
+		return nil, err
+	}
+	return componentsFile.Components, nil
+}
+
+// AddRelation はコンポーネントに関係を追加
+func (h *ComponentHandler) AddRelation(ctx context.Context, id string, relation ArchitectureRelation) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// ID のセキュリティ検証
+	if err := ValidateID("component", id); err != nil {
+		return err
+	}
+
+	componentsFile, err := loadComponents(ctx, h.fileStore)
+	if err != nil {
+		return err
+	}
+
+	for i := range componentsFile.Components {
+		component := &componentsFile.Components[i]
+		if component.ID != id {
+			continue
+		}
+
+		component.Relations = append(component.Relations, relation)
+		component.Metadata.UpdatedAt = Now()
+		if err := component.Validate(); err != nil {
+			return err
+		}
+		if err := h.checkReferences(ctx, component); err != nil {
+			return err
+		}
+		return h.fileStore.WriteYaml(ctx, componentsFileName, componentsFile)
+	}
+
+	return ErrEntityNotFound
+}
+
+// checkReferences は所属コンテナと関係先の存在を確認
+func (h *ComponentHandler) checkReferences(ctx context.Context, component *ComponentEntity) error {
+	if h.containerHandler != nil {
+		if _, err := h.containerHandler.Get(ctx, component.ContainerID); err != nil {
+			return fmt.Errorf("referenced container not found: %s", component.ContainerID)
+		}
+	}
+	return checkArchitectureRelationTargets(ctx, h.fileStore, component.Relations)
+}
+
+// ===== 共通ヘルパー =====
+
+// loadContainers は containers.yaml を読み込む（存在しない場合は空）
+func loadContainers(ctx context.Context, fs FileStore) (*ContainersFile, error) {
+	containersFile := &ContainersFile{Containers: []ContainerEntity{}}
+	if !fs.Exists(ctx, containersFileName) {
+		return containersFile, nil
+	}
+	if err := fs.ReadYaml(ctx, containersFileName, containersFile); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", containersFileName, err)
+	}
+	return containersFile, nil
+}
+
+// loadComponents は components.yaml を読み込む（存在しない場合は空）
+func loadComponents(ctx context.Context, fs FileStore) (*ComponentsFile, error) {
+	componentsFile := &ComponentsFile{Components: []ComponentEntity{}}
+	if !fs.Exists(ctx, componentsFileName) {
+		return componentsFile, nil
+	}
+	if err := fs.ReadYaml(ctx, componentsFileName, componentsFile); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", componentsFileName, err)
+	}
+	return componentsFile, nil
+}
+
+// checkArchitectureRelationTargets は関係先の Container / Component が存在するか確認
+func checkArchitectureRelationTargets(ctx context.Context, fs FileStore, relations []ArchitectureRelation) error {
+	if len(relations) == 0 {
+		return nil
+	}
+
+	containersFile, err := loadContainers(ctx, fs)
+	if err != nil {
+		return err
+	}
+	componentsFile, err := loadComponents(ctx, fs)
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[string]bool, len(containersFile.Containers)+len(componentsFile.Components))
+	for _, c := range containersFile.Containers {
+		ids[c.ID] = true
+	}
+	for _, c := range componentsFile.Components {
+		ids[c.ID] = true
+	}
+
+	for _, rel := range relations {
+		if !ids[rel.TargetID] {
+			return fmt.Errorf("referenced relation target not found: %s", rel.TargetID)
+		}
+	}
+	return nil
+}
+
+// architectureReferrers は指定 ID を参照している要素（所属コンポーネント・関係元）の ID を返す
+func architectureReferrers(ctx context.Context, fs FileStore, id string) ([]string, error) {
+	containersFile, err := loadContainers(ctx, fs)
+	if err != nil {
+		return nil, err
+	}
+	componentsFile, err := loadComponents(ctx, fs)
+	if err != nil {
+		return nil, err
+	}
+
+	referrers := make([]string, 0)
+	for _, c := range containersFile.Containers {
+		for _, rel := range c.Relations {
+			if rel.TargetID == id {
+				referrers = append(referrers, c.ID)
+				break
+			}
+		}
+	}
+	for _, c := range componentsFile.Components {
+		if c.ContainerID == id {
+			referrers = append(referrers, c.ID)
+			continue
+		}
+		for _, rel := range c.Relations {
+			if rel.TargetID == id {
+				referrers = append(referrers, c.ID)
+				break
+			}
+		}
+	}
+	return referrers, nil
+}
+
+// ===== EntityOption 関数群 =====
+
+// WithContainerDescription はコンテナの説明を設定
+func WithContainerDescription(desc string) EntityOption {
+	return func(v any) {
+		if c, ok := v.(*ContainerEntity); ok {
+			c.Description = desc
+		}
+	}
+}
+
+// WithContainerTechnology はコンテナの技術スタックを設定
+func WithContainerTechnology(tech string) EntityOption {
+	return func(v any) {
+		if c, ok := v.(*ContainerEntity); ok {
+			c.Technology = tech
+		}
+	}
+}
+
+// WithContainerSubsystem はコンテナの所属サブシステムを設定
+func WithContainerSubsystem(subsystemID string) EntityOption {
+	return func(v any) {
+		if c, ok := v.(*ContainerEntity); ok {
+			c.SubsystemID = subsystemID
+		}
+	}
+}
+
+// WithContainerOwner はコンテナのオーナーを設定
+func WithContainerOwner(owner string) EntityOption {
+	return func(v any) {
+		if c, ok := v.(*ContainerEntity); ok {
+			c.Metadata.Owner = owner
+		}
+	}
+}
+
+// WithContainerTags はコンテナのタグを設定
+func WithContainerTags(tags []string) EntityOption {
+	return func(v any) {
+		if c, ok := v.(*ContainerEntity); ok {
+			c.Metadata.Tags = tags
+		}
+	}
+}
+
+// WithComponentDescription はコンポーネントの説明を設定
+func WithComponentDescription(desc string) EntityOption {
+	return func(v any) {
+		if c, ok := v.(*ComponentEntity); ok {
+			c.Description = desc
+		}
+	}
+}
+
+// WithComponentTechnology はコンポーネントの技術スタックを設定
+func WithComponentTechnology(tech string) EntityOption {
+	return func(v any) {
+		if c, ok := v.(*ComponentEntity); ok {
+			c.Technology = tech
+		}
+	}
+}
+
+// WithComponentContainer はコンポーネントの所属コンテナを設定
+func WithComponentContainer(containerID string) EntityOption {
+	return func(v any) {
+		if c, ok := v.(*ComponentEntity); ok {
+			c.ContainerID = containerID
+		}
+	}
+}
+
+// WithComponentOwner はコンポーネントのオーナーを設定
+func WithComponentOwner(owner string) EntityOption {
+	return func(v any) {
+		if c, ok := v.(*ComponentEntity); ok {
+			c.Metadata.Owner = owner
+		}
+	}
+}
+
+// WithComponentTags はコンポーネントのタグを設定
+func WithComponentTags(tags []string) EntityOption {
+	return func(v any) {
+		if c, ok := v.(*ComponentEntity); ok {
+			c.Metadata.Tags = tags
+		}
+	}
+}
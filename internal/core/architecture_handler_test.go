@@ -0,0 +1,137 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/biwakonbu/zeus/internal/yaml"
+)
+
+// テスト用のセットアップ
+func setupArchitectureHandlerTest(t *testing.T) (*SubsystemHandler, *ContainerHandler, *ComponentHandler) {
+	t.Helper()
+
+	zeusPath := t.TempDir() + "/.zeus"
+	if err := os.MkdirAll(zeusPath, 0755); err != nil {
+		t.Fatalf("failed to create zeus dir: %v", err)
+	}
+
+	fs := yaml.NewFileManager(zeusPath)
+	subsystemHandler := NewSubsystemHandler(fs)
+	containerHandler := NewContainerHandler(fs, subsystemHandler)
+	return subsystemHandler, containerHandler, NewComponentHandler(fs, containerHandler)
+}
+
+func TestContainerHandlerAdd_SubsystemReference(t *testing.T) {
+	subsystemHandler, containerHandler, _ := setupArchitectureHandlerTest(t)
+	ctx := context.Background()
+
+	sub, err := subsystemHandler.Add(ctx, "認証システム")
+	if err != nil {
+		t.Fatalf("Subsystem Add failed: %v", err)
+	}
+
+	result, err := containerHandler.Add(ctx, "API サーバー",
+		WithContainerSubsystem(sub.ID),
+		WithContainerTechnology("Go"),
+	)
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if !strings.HasPrefix(result.ID, "ctr-") {
+		t.Errorf("expected ID prefix 'ctr-', got %q", result.ID)
+	}
+
+	got, err := containerHandler.Get(ctx, result.ID)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if c := got.(*ContainerEntity); c.SubsystemID != sub.ID || c.Technology != "Go" {
+		t.Errorf("unexpected container: %+v", c)
+	}
+
+	// 存在しないサブシステム
+	_, err = containerHandler.Add(ctx, "Worker", WithContainerSubsystem("sub-00000000"))
+	if err == nil || !strings.Contains(err.Error(), "referenced subsystem not found") {
+		t.Errorf("expected subsystem reference error, got %v", err)
+	}
+}
+
+func TestComponentHandlerAdd_ContainerRequired(t *testing.T) {
+	_, containerHandler, componentHandler := setupArchitectureHandlerTest(t)
+	ctx := context.Background()
+
+	if _, err := componentHandler.Add(ctx, "認証ハンドラー"); err == nil {
+		t.Error("component without container should fail")
+	}
+	if _, err := componentHandler.Add(ctx, "認証ハンドラー", WithComponentContainer("ctr-00000000")); err == nil {
+		t.Error("component with unknown container should fail")
+	}
+
+	ctr, err := containerHandler.Add(ctx, "API サーバー")
+	if err != nil {
+		t.Fatalf("Container Add failed: %v", err)
+	}
+	if _, err := componentHandler.Add(ctx, "認証ハンドラー", WithComponentContainer(ctr.ID)); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+
+	list, err := componentHandler.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if list.Total != 1 {
+		t.Errorf("expected 1 component, got %d", list.Total)
+	}
+}
+
+func TestArchitectureRelations(t *testing.T) {
+	_, containerHandler, componentHandler := setupArchitectureHandlerTest(t)
+	ctx := context.Background()
+
+	web, _ := containerHandler.Add(ctx, "Web")
+	api, _ := containerHandler.Add(ctx, "API")
+	comp, err := componentHandler.Add(ctx, "認証ハンドラー", WithComponentContainer(api.ID))
+	if err != nil {
+		t.Fatalf("Component Add failed: %v", err)
+	}
+
+	// Container -> Component の関係
+	rel := ArchitectureRelation{TargetID: comp.ID, Label: "ログイン", Technology: "HTTPS"}
+	if err := containerHandler.AddRelation(ctx, web.ID, rel); err != nil {
+		t.Fatalf("AddRelation failed: %v", err)
+	}
+
+	// 重複・自己参照・存在しない関係先はエラー
+	if err := containerHandler.AddRelation(ctx, web.ID, rel); err == nil {
+		t.Error("duplicate relation should fail")
+	}
+	if err := containerHandler.AddRelation(ctx, web.ID, ArchitectureRelation{TargetID: web.ID}); err == nil {
+		t.Error("self relation should fail")
+	}
+	if err := componentHandler.AddRelation(ctx, comp.ID, ArchitectureRelation{TargetID: "ctr-00000000"}); err == nil {
+		t.Error("relation to unknown target should fail")
+	}
+	if err := componentHandler.AddRelation(ctx, "cmp-00000000", ArchitectureRelation{TargetID: web.ID}); !errors.Is(err, ErrEntityNotFound) {
+		t.Errorf("expected ErrEntityNotFound, got %v", err)
+	}
+
+	// 参照されている要素は削除できない
+	if err := componentHandler.Delete(ctx, comp.ID); err == nil {
+		t.Error("deleting referenced component should fail")
+	}
+	if err := containerHandler.Delete(ctx, api.ID); err == nil {
+		t.Error("deleting container with components should fail")
+	}
+
+	// 参照されていない要素は削除できる
+	if err := containerHandler.Delete(ctx, web.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := componentHandler.Delete(ctx, comp.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+}
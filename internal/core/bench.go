@@ -0,0 +1,330 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// DefaultBenchActivities はベンチマークで生成する Activity 数の既定値
+const DefaultBenchActivities = 10000
+
+// benchWords は合成プロジェクトのタイトルに使う語彙（アフィニティ計算の類似度に偏りを持たせる）
+var benchWords = []string{
+	"決済", "認証", "検索", "通知", "請求", "在庫", "配送", "顧客", "分析", "監視",
+	"API", "画面", "バッチ", "移行", "設計", "改善", "テスト", "権限", "ログ", "性能",
+}
+
+// benchTags は合成プロジェクトの Activity に付けるタグ
+var benchTags = []string{"backend", "frontend", "infra", "security", "data", "ux"}
+
+// BenchSize は合成プロジェクトのエンティティ数
+type BenchSize struct {
+	Objectives     int `json:"objectives"`
+	UseCases       int `json:"usecases"`
+	Activities     int `json:"activities"`
+	Considerations int `json:"considerations"`
+	Decisions      int `json:"decisions"`
+	Risks          int `json:"risks"`
+}
+
+// NewBenchSize は Activity 数から実際のプロジェクトに近い比率のエンティティ数を決める
+// （Objective 1 : UseCase 10 : Activity 200、Consideration と Risk は Activity の 2%・1%）
+func NewBenchSize(activities int) BenchSize {
+	return BenchSize{
+		Objectives:     max(1, activities/200),
+		UseCases:       max(1, activities/20),
+		Activities:     activities,
+		Considerations: activities / 50,
+		Decisions:      activities / 150,
+		Risks:          activities / 100,
+	}
+}
+
+// BenchCase は計測対象の処理
+type BenchCase struct {
+	Name        string
+	Description string
+	Run         func(ctx context.Context, z *Zeus) error
+}
+
+// BenchCases は zeus bench が計測する分析パイプライン
+var BenchCases = []BenchCase{
+	{"graph.unified", "統合グラフの構築（zeus graph --unified）", func(ctx context.Context, z *Zeus) error {
+		_, err := z.BuildUnifiedGraph(ctx, nil)
+		return err
+	}},
+	{"graph.relation", "関係グラフの構築（zeus export --format graphml）", func(ctx context.Context, z *Zeus) error {
+		_, err := z.BuildRelationGraph(ctx)
+		return err
+	}},
+	{"graph.dependency", "依存グラフの構築（zeus graph）", func(ctx context.Context, z *Zeus) error {
+		_, err := z.BuildDependencyGraph(ctx)
+		return err
+	}},
+	{"wbs", "Objective > UseCase > Activity 階層の出力（zeus export --format markdown）", func(ctx context.Context, z *Zeus) error {
+		_, err := z.ExportPlanMarkdown(ctx)
+		return err
+	}},
+	{"affinity", "アフィニティ計算（zeus affinity）", func(ctx context.Context, z *Zeus) error {
+		options, err := z.AffinityOptions(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = z.CalculateAffinity(ctx, options)
+		return err
+	}},
+	{"timeline", "タイムラインの構築（期限・レビュー日・決定日）", func(ctx context.Context, z *Zeus) error {
+		_, err := z.Timeline(ctx)
+		return err
+	}},
+}
+
+// BenchResult は 1 つの処理の計測結果（1 回あたりの値）
+type BenchResult struct {
+	Name        string `json:"name"`
+	Runs        int    `json:"runs"`
+	NsPerOp     int64  `json:"ns_per_op"`
+	MinNsPerOp  int64  `json:"min_ns_per_op"`
+	AllocsPerOp uint64 `json:"allocs_per_op"`
+	BytesPerOp  uint64 `json:"bytes_per_op"`
+}
+
+// BenchReport はベンチマークの結果（JSON で保存してリリース間で比較する）
+type BenchReport struct {
+	ZeusVersion string        `json:"zeus_version"`
+	GoVersion   string        `json:"go_version"`
+	OS          string        `json:"os"`
+	Arch        string        `json:"arch"`
+	CPUs        int           `json:"cpus"`
+	Seed        int64         `json:"seed"`
+	Size        BenchSize     `json:"size"`
+	CreatedAt   string        `json:"created_at"`
+	Results     []BenchResult `json:"results"`
+}
+
+// BenchComparison は基準の結果との比較（Change は増加率、0.25 は 25% 悪化）
+type BenchComparison struct {
+	Name       string  `json:"name"`
+	Metric     string  `json:"metric"` // ns_per_op, allocs_per_op
+	Baseline   float64 `json:"baseline"`
+	Current    float64 `json:"current"`
+	Change     float64 `json:"change"`
+	Regression bool    `json:"regression"`
+}
+
+// GenerateBenchProject は projectPath に合成プロジェクトを生成する。
+// 同じ size と seed からは常に同じ内容（ID・タイトル・日付）を生成するため、リリース間で結果を比較できる
+func GenerateBenchProject(ctx context.Context, projectPath string, size BenchSize, seed int64) (*Zeus, error) {
+	if size.Activities <= 0 || size.Objectives <= 0 || size.UseCases <= 0 {
+		return nil, fmt.Errorf("bench size must have at least one objective, usecase and activity: %+v", size)
+	}
+	z := New(projectPath)
+	if _, err := z.Init(ctx); err != nil {
+		return nil, err
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	base := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	stamp := func(i int) Metadata {
+		return Metadata{CreatedAt: base.Add(time.Duration(i) * time.Minute).Format(time.RFC3339)}
+	}
+	day := func() string {
+		return base.AddDate(0, 0, rng.Intn(365)).Format("2006-01-02")
+	}
+	title := func(i int) string {
+		words := make([]string, 3)
+		for j := range words {
+			words[j] = benchWords[rng.Intn(len(benchWords))]
+		}
+		return fmt.Sprintf("%s %d", strings.Join(words, " "), i)
+	}
+	write := func(dir, id string, entity any) error {
+		return z.fileStore.WriteYaml(ctx, filepath.Join(dir, id+".yaml"), entity)
+	}
+	objectiveID := func(i int) string { return fmt.Sprintf("obj-%08x", i) }
+	usecaseID := func(i int) string { return fmt.Sprintf("uc-%08x", i) }
+
+	objectiveStatuses := []ObjectiveStatus{ObjectiveStatusNotStarted, ObjectiveStatusInProgress, ObjectiveStatusCompleted, ObjectiveStatusOnHold}
+	for i := 1; i <= size.Objectives; i++ {
+		obj := ObjectiveEntity{ID: objectiveID(i), Title: title(i), Status: objectiveStatuses[rng.Intn(len(objectiveStatuses))], Metadata: stamp(i)}
+		if err := write("objectives", obj.ID, &obj); err != nil {
+			return nil, err
+		}
+	}
+	for i := 1; i <= size.UseCases; i++ {
+		uc := UseCaseEntity{
+			ID: usecaseID(i), Title: title(i), ObjectiveID: objectiveID(1 + rng.Intn(size.Objectives)),
+			Status: UseCaseStatusActive, Metadata: stamp(i),
+		}
+		if err := write("usecases", uc.ID, &uc); err != nil {
+			return nil, err
+		}
+	}
+	activityStatuses := []ActivityStatus{ActivityStatusDraft, ActivityStatusActive, ActivityStatusActive, ActivityStatusDeprecated}
+	for i := 1; i <= size.Activities; i++ {
+		if i%1000 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		act := ActivityEntity{ID: fmt.Sprintf("act-%08x", i), Title: title(i), Status: activityStatuses[rng.Intn(len(activityStatuses))], Metadata: stamp(i)}
+		// 1 割は UseCase に紐付けない（未整理の Activity）
+		if rng.Intn(10) > 0 {
+			act.UseCaseID = usecaseID(1 + rng.Intn(size.UseCases))
+		}
+		act.Metadata.Tags = []string{benchTags[rng.Intn(len(benchTags))]}
+		if err := write("activities", act.ID, &act); err != nil {
+			return nil, err
+		}
+	}
+	for i := 1; i <= size.Considerations; i++ {
+		con := ConsiderationEntity{
+			ID: fmt.Sprintf("con-%08x", i), Title: title(i), Status: ConsiderationStatusOpen,
+			ObjectiveID: objectiveID(1 + rng.Intn(size.Objectives)), DueDate: day(), Metadata: stamp(i),
+			Options: []ConsiderationOption{{ID: "opt-1", Title: "案 A"}, {ID: "opt-2", Title: "案 B"}},
+		}
+		if i <= size.Decisions {
+			dec := DecisionEntity{
+				ID: fmt.Sprintf("dec-%08x", i), Title: con.Title, ConsiderationID: con.ID,
+				Selected: SelectedOption{OptionID: "opt-1", Title: "案 A"}, Rationale: "合成データ", DecidedAt: day(),
+			}
+			if err := write("decisions", dec.ID, &dec); err != nil {
+				return nil, err
+			}
+			con.Status = ConsiderationStatusDecided
+			con.DecisionID = dec.ID
+		}
+		if err := write("considerations", con.ID, &con); err != nil {
+			return nil, err
+		}
+	}
+	probabilities := []RiskProbability{RiskProbabilityHigh, RiskProbabilityMedium, RiskProbabilityLow}
+	impacts := []RiskImpact{RiskImpactCritical, RiskImpactHigh, RiskImpactMedium, RiskImpactLow}
+	for i := 1; i <= size.Risks; i++ {
+		risk := RiskEntity{
+			ID: fmt.Sprintf("risk-%08x", i), Title: title(i), Status: RiskStatusIdentified,
+			Probability: probabilities[rng.Intn(len(probabilities))], Impact: impacts[rng.Intn(len(impacts))],
+			ObjectiveID: objectiveID(1 + rng.Intn(size.Objectives)), ReviewDate: day(), Metadata: stamp(i),
+		}
+		risk.RiskScore = CalculateRiskScore(risk.Probability, risk.Impact)
+		if err := write("risks", risk.ID, &risk); err != nil {
+			return nil, err
+		}
+	}
+	return z, nil
+}
+
+// BenchCaseNames は計測対象の処理名の一覧
+func BenchCaseNames() []string {
+	names := make([]string, len(BenchCases))
+	for i, c := range BenchCases {
+		names[i] = c.Name
+	}
+	return names
+}
+
+// RunBench は names の処理（空なら全て）を runs 回ずつ実行し、1 回あたりの時間とアロケーションを計測する。
+// 計測前に 1 回実行し、ファイルシステムのキャッシュなどの初回コストを除く
+func RunBench(ctx context.Context, z *Zeus, names []string, runs int) ([]BenchResult, error) {
+	if runs <= 0 {
+		return nil, fmt.Errorf("runs must be > 0: %d", runs)
+	}
+	cases := BenchCases
+	if len(names) > 0 {
+		byName := map[string]BenchCase{}
+		for _, c := range BenchCases {
+			byName[c.Name] = c
+		}
+		cases = nil
+		for _, name := range names {
+			c, ok := byName[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown bench case: %s (available: %s)", name, strings.Join(BenchCaseNames(), ", "))
+			}
+			cases = append(cases, c)
+		}
+	}
+
+	results := []BenchResult{}
+	for _, c := range cases {
+		if err := c.Run(ctx, z); err != nil {
+			return nil, fmt.Errorf("%s: %w", c.Name, err)
+		}
+		runtime.GC()
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+		var total, minimum time.Duration
+		for i := 0; i < runs; i++ {
+			start := time.Now()
+			if err := c.Run(ctx, z); err != nil {
+				return nil, fmt.Errorf("%s: %w", c.Name, err)
+			}
+			elapsed := time.Since(start)
+			total += elapsed
+			if i == 0 || elapsed < minimum {
+				minimum = elapsed
+			}
+		}
+		runtime.ReadMemStats(&after)
+		results = append(results, BenchResult{
+			Name:        c.Name,
+			Runs:        runs,
+			NsPerOp:     int64(total) / int64(runs),
+			MinNsPerOp:  int64(minimum),
+			AllocsPerOp: (after.Mallocs - before.Mallocs) / uint64(runs),
+			BytesPerOp:  (after.TotalAlloc - before.TotalAlloc) / uint64(runs),
+		})
+	}
+	return results, nil
+}
+
+// NewBenchReport は実行環境の情報を付けてベンチマークの結果をまとめる
+func NewBenchReport(zeusVersion string, size BenchSize, seed int64, results []BenchResult) *BenchReport {
+	return &BenchReport{
+		ZeusVersion: zeusVersion,
+		GoVersion:   runtime.Version(),
+		OS:          runtime.GOOS,
+		Arch:        runtime.GOARCH,
+		CPUs:        runtime.NumCPU(),
+		Seed:        seed,
+		Size:        size,
+		CreatedAt:   Now(),
+		Results:     results,
+	}
+}
+
+// CompareBench は基準の結果と 1 回あたりの時間・アロケーション数を比較する。
+// threshold（0.2 で 20%）を超えて増えたものを Regression とする。基準にない処理は比較しない
+func CompareBench(baseline, current *BenchReport, threshold float64) []BenchComparison {
+	base := map[string]BenchResult{}
+	for _, r := range baseline.Results {
+		base[r.Name] = r
+	}
+	comparisons := []BenchComparison{}
+	for _, r := range current.Results {
+		b, ok := base[r.Name]
+		if !ok {
+			continue
+		}
+		for _, m := range []struct {
+			metric            string
+			baseline, current float64
+		}{
+			{"ns_per_op", float64(b.NsPerOp), float64(r.NsPerOp)},
+			{"allocs_per_op", float64(b.AllocsPerOp), float64(r.AllocsPerOp)},
+		} {
+			c := BenchComparison{Name: r.Name, Metric: m.metric, Baseline: m.baseline, Current: m.current}
+			if m.baseline > 0 {
+				c.Change = (m.current - m.baseline) / m.baseline
+			}
+			c.Regression = c.Change > threshold
+			comparisons = append(comparisons, c)
+		}
+	}
+	return comparisons
+}
@@ -0,0 +1,102 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenerateBenchProject(t *testing.T) {
+	ctx := context.Background()
+	size := NewBenchSize(300)
+	z, err := GenerateBenchProject(ctx, t.TempDir(), size, 1)
+	if err != nil {
+		t.Fatalf("GenerateBenchProject failed: %v", err)
+	}
+	for entity, want := range map[string]int{
+		"objective": size.Objectives, "usecase": size.UseCases, "activity": size.Activities,
+		"consideration": size.Considerations, "decision": size.Decisions, "risk": size.Risks,
+	} {
+		if result, err := z.List(ctx, entity); err != nil || result.Total != want {
+			t.Errorf("%s: total = %+v, want %d (err: %v)", entity, result, want, err)
+		}
+	}
+	if lint, err := NewLintChecker(z.fileStore).CheckAll(ctx); err != nil || !lint.Valid {
+		t.Errorf("generated project should pass lint: %+v (err: %v)", lint, err)
+	}
+
+	// 同じ seed からは同じ内容を生成する
+	other, err := GenerateBenchProject(ctx, t.TempDir(), size, 1)
+	if err != nil {
+		t.Fatalf("GenerateBenchProject failed: %v", err)
+	}
+	path := filepath.Join("activities", "act-00000064.yaml")
+	a, _ := os.ReadFile(filepath.Join(z.ZeusPath, path))
+	b, _ := os.ReadFile(filepath.Join(other.ZeusPath, path))
+	if len(a) == 0 || string(a) != string(b) {
+		t.Errorf("same seed should generate the same project:\n%s\n%s", a, b)
+	}
+
+	if _, err := GenerateBenchProject(ctx, t.TempDir(), BenchSize{}, 1); err == nil {
+		t.Error("empty size should fail")
+	}
+}
+
+func TestRunBench(t *testing.T) {
+	ctx := context.Background()
+	z, err := GenerateBenchProject(ctx, t.TempDir(), NewBenchSize(50), 1)
+	if err != nil {
+		t.Fatalf("GenerateBenchProject failed: %v", err)
+	}
+	results, err := RunBench(ctx, z, nil, 2)
+	if err != nil {
+		t.Fatalf("RunBench failed: %v", err)
+	}
+	if len(results) != len(BenchCases) {
+		t.Fatalf("results = %+v", results)
+	}
+	for _, r := range results {
+		if r.Runs != 2 || r.NsPerOp <= 0 || r.MinNsPerOp > r.NsPerOp || r.AllocsPerOp == 0 {
+			t.Errorf("result = %+v", r)
+		}
+	}
+
+	if results, err := RunBench(ctx, z, []string{"affinity"}, 1); err != nil || len(results) != 1 || results[0].Name != "affinity" {
+		t.Errorf("only affinity should run: %+v (err: %v)", results, err)
+	}
+	if _, err := RunBench(ctx, z, []string{"unknown"}, 1); err == nil {
+		t.Error("unknown case should fail")
+	}
+	if _, err := RunBench(ctx, z, nil, 0); err == nil {
+		t.Error("runs 0 should fail")
+	}
+}
+
+func TestCompareBench(t *testing.T) {
+	baseline := &BenchReport{Results: []BenchResult{
+		{Name: "affinity", NsPerOp: 1000, AllocsPerOp: 100},
+		{Name: "wbs", NsPerOp: 1000, AllocsPerOp: 100},
+	}}
+	current := &BenchReport{Results: []BenchResult{
+		{Name: "affinity", NsPerOp: 1300, AllocsPerOp: 100},
+		{Name: "wbs", NsPerOp: 900, AllocsPerOp: 110},
+		{Name: "timeline", NsPerOp: 1000, AllocsPerOp: 100},
+	}}
+	comparisons := CompareBench(baseline, current, 0.2)
+	if len(comparisons) != 4 {
+		t.Fatalf("comparisons = %+v", comparisons)
+	}
+	regressions := 0
+	for _, c := range comparisons {
+		if c.Regression {
+			regressions++
+			if c.Name != "affinity" || c.Metric != "ns_per_op" || c.Change < 0.29 || c.Change > 0.31 {
+				t.Errorf("unexpected regression: %+v", c)
+			}
+		}
+	}
+	if regressions != 1 {
+		t.Errorf("regressions = %d, comparisons = %+v", regressions, comparisons)
+	}
+}
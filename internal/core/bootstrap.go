@@ -0,0 +1,415 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// インポート元の形式（zeus init --from）
+const (
+	ImportSourceGitHubIssues = "github-issues"
+	ImportSourceCSV          = "csv"
+	ImportSourceMarkdownPlan = "markdown-plan"
+)
+
+// ImportMilestone はインポート元のマイルストーン（Objective に変換）
+type ImportMilestone struct {
+	Title       string
+	Description string
+	Closed      bool
+}
+
+// ImportItem はインポート元の作業項目（Activity に変換）
+type ImportItem struct {
+	Title       string
+	Description string
+	Labels      []string
+	Milestone   string
+	Closed      bool
+}
+
+// ImportPlan はインポート元から読み取った作業項目とマイルストーン
+type ImportPlan struct {
+	Milestones []ImportMilestone
+	Items      []ImportItem
+}
+
+// ImportResult はインポート結果
+type ImportResult struct {
+	Objectives []string // 作成した Objective ID
+	Activities []string // 作成した Activity ID
+	Queued     []string // 承認待ちキューに追加した作成の承認 ID
+}
+
+// ParseImportPlan はインポート元の形式に応じてデータを解析
+func ParseImportPlan(source string, data []byte) (*ImportPlan, error) {
+	var plan *ImportPlan
+	var err error
+	switch source {
+	case ImportSourceGitHubIssues:
+		plan, err = parseGitHubIssues(data)
+	case ImportSourceCSV:
+		plan, err = parseCSVPlan(data)
+	case ImportSourceMarkdownPlan:
+		plan, err = parseMarkdownPlan(data)
+	default:
+		return nil, fmt.Errorf("unknown import source: %s (github-issues, csv, markdown-plan)", source)
+	}
+	if err != nil {
+		return nil, err
+	}
+	plan.addMissingMilestones()
+	return plan, nil
+}
+
+// addMissingMilestones は作業項目から参照されているが未定義のマイルストーンを追加
+func (p *ImportPlan) addMissingMilestones() {
+	known := make(map[string]bool, len(p.Milestones))
+	for _, m := range p.Milestones {
+		known[m.Title] = true
+	}
+	for _, item := range p.Items {
+		if item.Milestone != "" && !known[item.Milestone] {
+			known[item.Milestone] = true
+			p.Milestones = append(p.Milestones, ImportMilestone{Title: item.Milestone})
+		}
+	}
+}
+
+// gitHubIssue は gh issue list --json / REST API の Issue（必要なフィールドのみ）
+type gitHubIssue struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+	State  string `json:"state"`
+	Labels []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	Milestone *struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+		State       string `json:"state"`
+	} `json:"milestone"`
+	PullRequest json.RawMessage `json:"pull_request"`
+}
+
+// parseGitHubIssues は GitHub Issues の JSON 配列を解析（Pull Request は除外）
+func parseGitHubIssues(data []byte) (*ImportPlan, error) {
+	var issues []gitHubIssue
+	if err := json.Unmarshal(data, &issues); err != nil {
+		return nil, fmt.Errorf("invalid GitHub issues JSON: %w", err)
+	}
+
+	plan := &ImportPlan{}
+	seen := map[string]bool{}
+	for _, issue := range issues {
+		if len(issue.PullRequest) > 0 && string(issue.PullRequest) != "null" {
+			continue
+		}
+		if strings.TrimSpace(issue.Title) == "" {
+			continue
+		}
+		item := ImportItem{
+			Title:       strings.TrimSpace(issue.Title),
+			Description: strings.TrimSpace(issue.Body),
+			Closed:      strings.EqualFold(issue.State, "closed"),
+		}
+		if issue.Number > 0 {
+			item.Description = strings.TrimSpace(fmt.Sprintf("GitHub #%d\n\n%s", issue.Number, item.Description))
+		}
+		for _, l := range issue.Labels {
+			item.Labels = append(item.Labels, l.Name)
+		}
+		if m := issue.Milestone; m != nil && m.Title != "" {
+			item.Milestone = m.Title
+			if !seen[m.Title] {
+				seen[m.Title] = true
+				plan.Milestones = append(plan.Milestones, ImportMilestone{
+					Title:       m.Title,
+					Description: m.Description,
+					Closed:      strings.EqualFold(m.State, "closed"),
+				})
+			}
+		}
+		plan.Items = append(plan.Items, item)
+	}
+	return plan, nil
+}
+
+// parseCSVPlan はヘッダー付き CSV を解析
+// 列: title（必須）, description, labels（, または ; 区切り）, priority, milestone, status（closed/done で完了扱い）
+func parseCSVPlan(data []byte) (*ImportPlan, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV header: %w", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	if _, ok := columns["title"]; !ok {
+		return nil, fmt.Errorf("CSV must have a title column")
+	}
+
+	plan := &ImportPlan{}
+	for line := 2; ; line++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV at line %d: %w", line, err)
+		}
+		get := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		title := get("title")
+		if title == "" {
+			continue
+		}
+		item := ImportItem{
+			Title:       title,
+			Description: get("description"),
+			Milestone:   get("milestone"),
+		}
+		for _, l := range strings.FieldsFunc(get("labels")+";"+get("tags"), func(r rune) bool { return r == ',' || r == ';' }) {
+			if l = strings.TrimSpace(l); l != "" {
+				item.Labels = append(item.Labels, l)
+			}
+		}
+		if p := get("priority"); p != "" {
+			item.Labels = append(item.Labels, "priority:"+p)
+		}
+		switch strings.ToLower(get("status")) {
+		case "closed", "done", "completed":
+			item.Closed = true
+		}
+		plan.Items = append(plan.Items, item)
+	}
+	return plan, nil
+}
+
+var (
+	markdownHeadingPattern = regexp.MustCompile(`^#{1,6}\s+(.+?)\s*#*$`)
+	markdownItemPattern    = regexp.MustCompile(`^\s*[-*+]\s+(?:\[([ xX])\]\s+)?(.+)$`)
+	markdownLabelPattern   = regexp.MustCompile(`(?:^|\s)#([^\s#]+)`)
+)
+
+// parseMarkdownPlan は Markdown の計画書を解析
+// 見出しがマイルストーン、リスト項目（- [ ] / - [x] / -）が作業項目になる。
+// 項目中の #label はラベルとして扱い、見出し直下の段落はマイルストーンの説明になる
+func parseMarkdownPlan(data []byte) (*ImportPlan, error) {
+	plan := &ImportPlan{}
+	milestone := -1
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t")
+		if m := markdownHeadingPattern.FindStringSubmatch(line); m != nil {
+			plan.Milestones = append(plan.Milestones, ImportMilestone{Title: m[1]})
+			milestone = len(plan.Milestones) - 1
+			continue
+		}
+		if m := markdownItemPattern.FindStringSubmatch(line); m != nil {
+			item := ImportItem{Closed: strings.EqualFold(m[1], "x")}
+			for _, label := range markdownLabelPattern.FindAllStringSubmatch(m[2], -1) {
+				item.Labels = append(item.Labels, label[1])
+			}
+			item.Title = strings.Join(strings.Fields(markdownLabelPattern.ReplaceAllString(m[2], "")), " ")
+			if item.Title == "" {
+				continue
+			}
+			if milestone >= 0 {
+				item.Milestone = plan.Milestones[milestone].Title
+			}
+			plan.Items = append(plan.Items, item)
+			continue
+		}
+		if text := strings.TrimSpace(line); text != "" && milestone >= 0 {
+			m := &plan.Milestones[milestone]
+			m.Description = strings.TrimSpace(m.Description + " " + text)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read markdown plan: %w", err)
+	}
+
+	// 作業項目のない見出し（文書タイトルなど）はマイルストーンにしない
+	used := map[string]bool{}
+	for _, item := range plan.Items {
+		used[item.Milestone] = true
+	}
+	milestones := plan.Milestones[:0]
+	for _, m := range plan.Milestones {
+		if used[m.Title] {
+			milestones = append(milestones, m)
+		}
+	}
+	plan.Milestones = milestones
+	return plan, nil
+}
+
+// priorityAliases はラベルから優先度への対応
+var priorityAliases = map[string]string{
+	"p0": "high", "p1": "high", "critical": "high", "urgent": "high", "high": "high",
+	"p2": "medium", "medium": "medium", "normal": "medium",
+	"p3": "low", "p4": "low", "low": "low", "minor": "low",
+}
+
+// normalizeImportLabel はラベルを Zeus のタグに変換
+// 優先度を表すラベル（priority:high, priority/P1, P2, high-priority など）は priority:<high|medium|low> に正規化する
+func normalizeImportLabel(label string) string {
+	l := strings.Join(strings.Fields(strings.ToLower(label)), "-")
+	if l == "" {
+		return ""
+	}
+	key, explicit := l, false
+	for _, prefix := range []string{"priority:", "priority/", "priority-"} {
+		if strings.HasPrefix(l, prefix) {
+			key, explicit = strings.TrimPrefix(l, prefix), true
+			break
+		}
+	}
+	if !explicit {
+		if before, ok := strings.CutSuffix(l, "-priority"); ok {
+			key, explicit = before, true
+		}
+	}
+	if p, ok := priorityAliases[key]; ok && (explicit || (len(key) == 2 && key[0] == 'p')) {
+		return "priority:" + p
+	}
+	return l
+}
+
+// importTags はラベルをタグに変換（重複除去、優先度は最初の 1 つのみ）
+func importTags(labels []string) []string {
+	tags := []string{}
+	seen := map[string]bool{}
+	hasPriority := false
+	for _, label := range labels {
+		tag := normalizeImportLabel(label)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if strings.HasPrefix(tag, "priority:") {
+			if hasPriority {
+				continue
+			}
+			hasPriority = true
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// milestoneTag はマイルストーンに属することを示すタグ
+func milestoneTag(title string) string {
+	return "milestone:" + strings.Join(strings.Fields(strings.ToLower(title)), "-")
+}
+
+// ImportPlan はインポート結果を Objective / Activity として登録
+// マイルストーンは Objective、作業項目は Activity になり、両者には共通の milestone:<name> タグを付与する。
+// 完了済みのマイルストーンは completed、完了済みの作業項目は deprecated として登録する。
+// 登録は 1 トランザクションで行い、途中で失敗した場合は何も登録しない。
+// 各作成には承認レベル・エージェントのガードレールを適用し、承認が必要なものは承認待ちキューに追加する
+func (z *Zeus) ImportPlan(ctx context.Context, plan *ImportPlan) (*ImportResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var result *ImportResult
+	err := z.Transaction(ctx, func(tx *Zeus) error {
+		var err error
+		result, err = tx.importPlan(ctx, plan)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// importPlan は ImportPlan の登録処理（トランザクション内で実行）
+func (z *Zeus) importPlan(ctx context.Context, plan *ImportPlan) (*ImportResult, error) {
+	result := &ImportResult{Objectives: []string{}, Activities: []string{}, Queued: []string{}}
+	// add は承認待ちになった作成を Queued に記録し、作成した ID（承認待ちの場合は空）を返す
+	add := func(entity, title string, opts ...EntityOption) (string, error) {
+		added, err := z.addGated(ctx, entity, title, opts...)
+		var required *ApprovalRequiredError
+		if errors.As(err, &required) {
+			result.Queued = append(result.Queued, required.ApprovalID)
+			return "", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		return added.ID, nil
+	}
+
+	for _, m := range plan.Milestones {
+		status := ObjectiveStatusNotStarted
+		if m.Closed {
+			status = ObjectiveStatusCompleted
+		}
+		opts := []EntityOption{
+			WithObjectiveStatus(status),
+			WithObjectiveTags([]string{milestoneTag(m.Title)}),
+		}
+		if m.Description != "" {
+			opts = append(opts, WithObjectiveDescription(m.Description))
+		}
+		id, err := add("objective", m.Title, opts...)
+		if err != nil {
+			return result, fmt.Errorf("objective %q: %w", m.Title, err)
+		}
+		if id != "" {
+			result.Objectives = append(result.Objectives, id)
+		}
+	}
+
+	for _, item := range plan.Items {
+		status := ActivityStatusDraft
+		if item.Closed {
+			status = ActivityStatusDeprecated
+		}
+		tags := importTags(item.Labels)
+		if item.Milestone != "" {
+			tags = append(tags, milestoneTag(item.Milestone))
+		}
+		opts := []EntityOption{WithActivityStatus(status)}
+		if len(tags) > 0 {
+			opts = append(opts, WithActivityTags(tags))
+		}
+		if item.Description != "" {
+			opts = append(opts, WithActivityDescription(item.Description))
+		}
+		id, err := add("activity", item.Title, opts...)
+		if err != nil {
+			return result, fmt.Errorf("activity %q: %w", item.Title, err)
+		}
+		if id != "" {
+			result.Activities = append(result.Activities, id)
+		}
+	}
+
+	if err := z.updateState(ctx); err != nil {
+		return result, err
+	}
+	return result, nil
+}
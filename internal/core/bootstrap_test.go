@@ -0,0 +1,205 @@
+package core
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestParseImportPlan_GitHubIssues(t *testing.T) {
+	data := []byte(`[
+  {"number": 12, "title": "Login page", "body": "OAuth support", "state": "OPEN",
+   "labels": [{"name": "frontend"}, {"name": "priority/P1"}],
+   "milestone": {"title": "v1.0", "description": "First release"}},
+  {"number": 13, "title": "Fix crash", "state": "closed",
+   "labels": [{"name": "bug"}], "milestone": {"title": "v1.0"}},
+  {"number": 14, "title": "Refactor", "state": "open", "labels": [], "milestone": null},
+  {"number": 15, "title": "A pull request", "state": "open", "pull_request": {"url": "x"}}
+]`)
+
+	plan, err := ParseImportPlan(ImportSourceGitHubIssues, data)
+	if err != nil {
+		t.Fatalf("ParseImportPlan failed: %v", err)
+	}
+	if len(plan.Items) != 3 {
+		t.Fatalf("expected 3 items (pull request excluded), got %d", len(plan.Items))
+	}
+	if len(plan.Milestones) != 1 || plan.Milestones[0].Title != "v1.0" || plan.Milestones[0].Description != "First release" {
+		t.Errorf("unexpected milestones: %+v", plan.Milestones)
+	}
+	if plan.Items[0].Description != "GitHub #12\n\nOAuth support" {
+		t.Errorf("unexpected description: %q", plan.Items[0].Description)
+	}
+	if !plan.Items[1].Closed || plan.Items[0].Closed {
+		t.Errorf("unexpected closed flags: %+v", plan.Items)
+	}
+	if plan.Items[2].Milestone != "" {
+		t.Errorf("expected no milestone, got %q", plan.Items[2].Milestone)
+	}
+
+	if _, err := ParseImportPlan(ImportSourceGitHubIssues, []byte("{")); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+}
+
+func TestParseImportPlan_CSV(t *testing.T) {
+	data := []byte("Title,Description,Labels,Priority,Milestone,Status\n" +
+		"Write docs,User guide,\"docs;writing\",high,Beta,open\n" +
+		"Ship beta,,,,Beta,done\n" +
+		",skipped,,,,\n")
+
+	plan, err := ParseImportPlan(ImportSourceCSV, data)
+	if err != nil {
+		t.Fatalf("ParseImportPlan failed: %v", err)
+	}
+	if len(plan.Items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(plan.Items))
+	}
+	if !reflect.DeepEqual(plan.Items[0].Labels, []string{"docs", "writing", "priority:high"}) {
+		t.Errorf("unexpected labels: %v", plan.Items[0].Labels)
+	}
+	if !plan.Items[1].Closed {
+		t.Error("status done should be closed")
+	}
+	if len(plan.Milestones) != 1 || plan.Milestones[0].Title != "Beta" {
+		t.Errorf("milestone should be derived from items: %+v", plan.Milestones)
+	}
+
+	if _, err := ParseImportPlan(ImportSourceCSV, []byte("name\nfoo\n")); err == nil {
+		t.Error("expected error without title column")
+	}
+}
+
+func TestParseImportPlan_MarkdownPlan(t *testing.T) {
+	data := []byte(`# Project Plan
+
+## Phase 1
+Foundation work.
+
+- [ ] Set up CI #infra #P0
+- [x] Create repository
+* Write README #docs
+
+## Empty phase
+
+## Phase 2
+- [ ] 決済機能 #バックエンド
+`)
+
+	plan, err := ParseImportPlan(ImportSourceMarkdownPlan, data)
+	if err != nil {
+		t.Fatalf("ParseImportPlan failed: %v", err)
+	}
+
+	var titles []string
+	for _, m := range plan.Milestones {
+		titles = append(titles, m.Title)
+	}
+	if !reflect.DeepEqual(titles, []string{"Phase 1", "Phase 2"}) {
+		t.Errorf("headings without items should be dropped: %v", titles)
+	}
+	if plan.Milestones[0].Description != "Foundation work." {
+		t.Errorf("unexpected milestone description: %q", plan.Milestones[0].Description)
+	}
+	if len(plan.Items) != 4 {
+		t.Fatalf("expected 4 items, got %d", len(plan.Items))
+	}
+	first := plan.Items[0]
+	if first.Title != "Set up CI" || !reflect.DeepEqual(first.Labels, []string{"infra", "P0"}) || first.Milestone != "Phase 1" {
+		t.Errorf("unexpected first item: %+v", first)
+	}
+	if !plan.Items[1].Closed {
+		t.Error("checked item should be closed")
+	}
+	if plan.Items[3].Title != "決済機能" || !reflect.DeepEqual(plan.Items[3].Labels, []string{"バックエンド"}) {
+		t.Errorf("unexpected item: %+v", plan.Items[3])
+	}
+}
+
+func TestParseImportPlan_UnknownSource(t *testing.T) {
+	if _, err := ParseImportPlan("jira", []byte("[]")); err == nil {
+		t.Error("expected error for unknown source")
+	}
+}
+
+func TestNormalizeImportLabel(t *testing.T) {
+	tests := map[string]string{
+		"priority:high":    "priority:high",
+		"Priority/P1":      "priority:high",
+		"priority-medium":  "priority:medium",
+		"P2":               "priority:medium",
+		"low-priority":     "priority:low",
+		"High Priority":    "priority:high",
+		"Good First Issue": "good-first-issue",
+		"high":             "high",
+		"bug":              "bug",
+	}
+	for label, want := range tests {
+		if got := normalizeImportLabel(label); got != want {
+			t.Errorf("normalizeImportLabel(%q) = %q, want %q", label, got, want)
+		}
+	}
+}
+
+func TestImportPlan(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	plan := &ImportPlan{
+		Milestones: []ImportMilestone{
+			{Title: "v1.0", Description: "First release"},
+			{Title: "v0.9", Closed: true},
+		},
+		Items: []ImportItem{
+			{Title: "Login page", Labels: []string{"Frontend", "P1", "priority:low"}, Milestone: "v1.0"},
+			{Title: "Old task", Closed: true},
+		},
+	}
+	result, err := z.ImportPlan(ctx, plan)
+	if err != nil {
+		t.Fatalf("ImportPlan failed: %v", err)
+	}
+	if len(result.Objectives) != 2 || len(result.Activities) != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	var obj ObjectiveEntity
+	if err := z.fileStore.ReadYaml(ctx, "objectives/"+result.Objectives[0]+".yaml", &obj); err != nil {
+		t.Fatalf("ReadYaml objective failed: %v", err)
+	}
+	if obj.Title != "v1.0" || obj.Description != "First release" || obj.Status != ObjectiveStatusNotStarted {
+		t.Errorf("unexpected objective: %+v", obj)
+	}
+	if !reflect.DeepEqual(obj.Tags, []string{"milestone:v1.0"}) {
+		t.Errorf("unexpected objective tags: %v", obj.Tags)
+	}
+	var closed ObjectiveEntity
+	if err := z.fileStore.ReadYaml(ctx, "objectives/"+result.Objectives[1]+".yaml", &closed); err != nil {
+		t.Fatalf("ReadYaml objective failed: %v", err)
+	}
+	if closed.Status != ObjectiveStatusCompleted {
+		t.Errorf("closed milestone should be completed, got %s", closed.Status)
+	}
+
+	var act ActivityEntity
+	if err := z.fileStore.ReadYaml(ctx, "activities/"+result.Activities[0]+".yaml", &act); err != nil {
+		t.Fatalf("ReadYaml activity failed: %v", err)
+	}
+	want := []string{"frontend", "priority:high", "milestone:v1.0"}
+	if !reflect.DeepEqual(act.Metadata.Tags, want) {
+		t.Errorf("activity tags = %v, want %v", act.Metadata.Tags, want)
+	}
+	if act.Status != ActivityStatusDraft {
+		t.Errorf("open item should be draft, got %s", act.Status)
+	}
+	var old ActivityEntity
+	if err := z.fileStore.ReadYaml(ctx, "activities/"+result.Activities[1]+".yaml", &old); err != nil {
+		t.Fatalf("ReadYaml activity failed: %v", err)
+	}
+	if old.Status != ActivityStatusDeprecated || len(old.Metadata.Tags) != 0 {
+		t.Errorf("unexpected closed activity: %+v", old)
+	}
+}
@@ -0,0 +1,380 @@
+package core
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// バンドルの形式
+const (
+	BundleFormat        = "zeusbundle"
+	BundleFormatVersion = 1
+	// bundleManifestName はアーカイブ先頭のマニフェスト
+	bundleManifestName = "manifest.json"
+	// bundleRoot はアーカイブ内の .zeus の配置先
+	bundleRoot = "zeus/"
+)
+
+// BundleManifest はバンドルの内容（アーカイブ先頭の manifest.json）
+type BundleManifest struct {
+	Format        string `json:"format"`         // 常に zeusbundle
+	Version       int    `json:"version"`        // バンドル形式のバージョン
+	SchemaVersion string `json:"schema_version"` // zeus.yaml の version
+	ZeusVersion   string `json:"zeus_version,omitempty"`
+	ProjectID     string `json:"project_id"`
+	ProjectName   string `json:"project_name"`
+	CreatedAt     string `json:"created_at"`
+	// SecretsRedacted は zeus.yaml の認証情報（トークン・パスワード）を消去して書き出したか
+	SecretsRedacted bool         `json:"secrets_redacted,omitempty"`
+	Files           []BundleFile `json:"files"` // .zeus からの相対パス（パス順）
+}
+
+// BundleFile はバンドルに含めたファイル
+type BundleFile struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// BundleExportOptions はバンドルの書き出しオプション
+type BundleExportOptions struct {
+	ZeusVersion    string // マニフェストに記録する zeus のバージョン
+	IncludeSecrets bool   // zeus.yaml の認証情報（server.api_token、email.password、連携のトークンなど）を消去せずに含める
+}
+
+// BundleImportOptions はバンドルの取り込みオプション
+type BundleImportOptions struct {
+	Force  bool // 既存の .zeus を退避して置き換える
+	DryRun bool // 検証のみ行い書き込まない
+}
+
+// BundleImportResult はバンドルの取り込み結果
+type BundleImportResult struct {
+	Manifest *BundleManifest `json:"manifest"`
+	Backup   string          `json:"backup,omitempty"` // 退避した既存の .zeus
+	DryRun   bool            `json:"dry_run,omitempty"`
+}
+
+// bundleExcluded はバンドルに含めない一時ファイル（ロック・書き込み途中のファイル）
+func bundleExcluded(name string) bool {
+	return strings.HasSuffix(name, ".lock") || strings.HasSuffix(name, ".tmp")
+}
+
+// ExportBundle は .zeus 全体（エンティティ・設定・スナップショット・ログなど）を
+// 1 つの tar.gz に書き出す。先頭の manifest.json にファイルごとのサイズと SHA-256 を記録する。
+// zeus.yaml の認証情報は opts.IncludeSecrets を指定しない限り消去する
+func (z *Zeus) ExportBundle(ctx context.Context, w io.Writer, opts BundleExportOptions) (*BundleManifest, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	config, err := z.LoadConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dirs, files []string
+	err = filepath.WalkDir(z.ZeusPath, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(z.ZeusPath, p)
+		if err != nil || rel == "." {
+			return err
+		}
+		switch {
+		case d.IsDir():
+			dirs = append(dirs, filepath.ToSlash(rel))
+		case d.Type().IsRegular() && !bundleExcluded(d.Name()):
+			files = append(files, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(dirs)
+	sort.Strings(files)
+
+	manifest := &BundleManifest{
+		Format:        BundleFormat,
+		Version:       BundleFormatVersion,
+		SchemaVersion: config.Version,
+		ZeusVersion:   opts.ZeusVersion,
+		ProjectID:     config.Project.ID,
+		ProjectName:   config.Project.Name,
+		CreatedAt:     Now(),
+		Files:         make([]BundleFile, 0, len(files)),
+	}
+	// 認証情報を消去した内容（.zeus からの相対パス -> 内容）
+	redacted := map[string][]byte{}
+	for _, rel := range files {
+		if rel == "zeus.yaml" && !opts.IncludeSecrets {
+			data, err := os.ReadFile(filepath.Join(z.ZeusPath, rel))
+			if err != nil {
+				return nil, err
+			}
+			if data, err = redactSecrets(data); err != nil {
+				return nil, fmt.Errorf("%w: zeus.yaml: %v", ErrYamlSyntax, err)
+			}
+			redacted[rel] = data
+			manifest.SecretsRedacted = true
+			sum := sha256.Sum256(data)
+			manifest.Files = append(manifest.Files, BundleFile{Path: rel, Size: int64(len(data)), SHA256: hex.EncodeToString(sum[:])})
+			continue
+		}
+		size, sum, err := hashFile(filepath.Join(z.ZeusPath, filepath.FromSlash(rel)))
+		if err != nil {
+			return nil, err
+		}
+		manifest.Files = append(manifest.Files, BundleFile{Path: rel, Size: size, SHA256: sum})
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	modTime := time.Now()
+	if err := tw.WriteHeader(&tar.Header{Name: bundleManifestName, Mode: 0644, Size: int64(len(data)), ModTime: modTime}); err != nil {
+		return nil, err
+	}
+	if _, err := tw.Write(data); err != nil {
+		return nil, err
+	}
+	for _, rel := range dirs {
+		if err := tw.WriteHeader(&tar.Header{Name: bundleRoot + rel + "/", Typeflag: tar.TypeDir, Mode: 0755, ModTime: modTime}); err != nil {
+			return nil, err
+		}
+	}
+	for _, f := range manifest.Files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if data, ok := redacted[f.Path]; ok {
+			if err := tw.WriteHeader(&tar.Header{Name: bundleRoot + f.Path, Mode: 0644, Size: f.Size, ModTime: modTime}); err != nil {
+				return nil, err
+			}
+			if _, err := tw.Write(data); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := writeBundleFile(tw, z.ZeusPath, f, modTime); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// writeBundleFile はファイルを 1 件アーカイブに書き込む（マニフェスト作成後に変更された場合はエラー）
+func writeBundleFile(tw *tar.Writer, base string, f BundleFile, modTime time.Time) error {
+	file, err := os.Open(filepath.Join(base, filepath.FromSlash(f.Path)))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if err := tw.WriteHeader(&tar.Header{Name: bundleRoot + f.Path, Mode: 0644, Size: f.Size, ModTime: modTime}); err != nil {
+		return err
+	}
+	if _, err := io.CopyN(tw, file, f.Size); err != nil {
+		return fmt.Errorf("%s changed during export: %w", f.Path, err)
+	}
+	return nil
+}
+
+// hashFile はファイルのサイズと SHA-256 を返す
+func hashFile(p string) (int64, string, error) {
+	file, err := os.Open(p)
+	if err != nil {
+		return 0, "", err
+	}
+	defer file.Close()
+	h := sha256.New()
+	size, err := io.Copy(h, file)
+	if err != nil {
+		return 0, "", err
+	}
+	return size, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// ImportBundle はバンドルを検証して .zeus として展開する。
+// 全ファイルのサイズと SHA-256 をマニフェストと照合してから置き換えるため、不正なバンドルで既存の .zeus は変更しない。
+// 既存の .zeus がある場合は Force で .zeus.backup-YYYYMMDD-HHMMSS に退避して置き換える
+func (z *Zeus) ImportBundle(ctx context.Context, r io.Reader, opts BundleImportOptions) (*BundleImportResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	exists := false
+	if _, err := os.Stat(z.ZeusPath); err == nil {
+		exists = true
+		if !opts.Force && !opts.DryRun {
+			return nil, fmt.Errorf("%w: %s (use --force to replace)", ErrProjectExists, z.ZeusPath)
+		}
+	}
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBundleInvalid, err)
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	manifest, err := readBundleManifest(tr)
+	if err != nil {
+		return nil, err
+	}
+
+	// DryRun では展開せずに検証のみ行う
+	staging := ""
+	if !opts.DryRun {
+		staging = fmt.Sprintf("%s.import-%d", z.ZeusPath, time.Now().UnixNano())
+		if err := os.MkdirAll(staging, 0755); err != nil {
+			return nil, err
+		}
+		defer os.RemoveAll(staging)
+	}
+	if err := extractBundle(ctx, tr, manifest, staging); err != nil {
+		return nil, err
+	}
+
+	result := &BundleImportResult{Manifest: manifest, DryRun: opts.DryRun}
+	if opts.DryRun {
+		return result, nil
+	}
+	if exists {
+		result.Backup = z.ZeusPath + ".backup-" + time.Now().Format("20060102-150405")
+		if err := os.Rename(z.ZeusPath, result.Backup); err != nil {
+			return nil, err
+		}
+	}
+	if err := os.Rename(staging, z.ZeusPath); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// readBundleManifest はアーカイブ先頭の manifest.json を読み込んで形式とバージョンを検証
+func readBundleManifest(tr *tar.Reader) (*BundleManifest, error) {
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBundleInvalid, err)
+	}
+	if hdr.Name != bundleManifestName {
+		return nil, fmt.Errorf("%w: %s must be the first entry", ErrBundleInvalid, bundleManifestName)
+	}
+	var manifest BundleManifest
+	if err := json.NewDecoder(tr).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrBundleInvalid, bundleManifestName, err)
+	}
+	if manifest.Format != BundleFormat {
+		return nil, fmt.Errorf("%w: unknown format %q", ErrBundleInvalid, manifest.Format)
+	}
+	if manifest.Version < 1 || manifest.Version > BundleFormatVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d (supported: 1-%d)", ErrBundleInvalid, manifest.Version, BundleFormatVersion)
+	}
+	return &manifest, nil
+}
+
+// extractBundle はアーカイブのファイルをマニフェストと照合しながら dest に展開する（dest が空の場合は照合のみ）
+func extractBundle(ctx context.Context, tr *tar.Reader, manifest *BundleManifest, dest string) error {
+	expected := make(map[string]BundleFile, len(manifest.Files))
+	for _, f := range manifest.Files {
+		expected[f.Path] = f
+	}
+	seen := map[string]bool{}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrBundleInvalid, err)
+		}
+		name, ok := strings.CutPrefix(hdr.Name, bundleRoot)
+		name = strings.TrimSuffix(name, "/")
+		if !ok || name == "" || !filepath.IsLocal(filepath.FromSlash(name)) || path.Clean(name) != name {
+			return fmt.Errorf("%w: unexpected entry %q", ErrBundleInvalid, hdr.Name)
+		}
+		target := filepath.Join(dest, filepath.FromSlash(name))
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if dest != "" {
+				if err := os.MkdirAll(target, 0755); err != nil {
+					return err
+				}
+			}
+		case tar.TypeReg:
+			f, ok := expected[name]
+			if !ok || seen[name] {
+				return fmt.Errorf("%w: %s is not listed in %s", ErrBundleInvalid, name, bundleManifestName)
+			}
+			seen[name] = true
+			if err := extractBundleFile(tr, f, target, dest != ""); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%w: unsupported entry type for %s", ErrBundleInvalid, name)
+		}
+	}
+	for _, f := range manifest.Files {
+		if !seen[f.Path] {
+			return fmt.Errorf("%w: %s is missing", ErrBundleInvalid, f.Path)
+		}
+	}
+	if !seen["zeus.yaml"] {
+		return fmt.Errorf("%w: zeus.yaml is missing", ErrBundleInvalid)
+	}
+	return nil
+}
+
+// extractBundleFile はファイルを書き込み（write が false の場合は破棄）、サイズと SHA-256 を照合する
+func extractBundleFile(r io.Reader, f BundleFile, target string, write bool) error {
+	var out io.Writer = io.Discard
+	if write {
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+	h := sha256.New()
+	// マニフェストのサイズを超える内容は読み込まない
+	size, err := io.Copy(io.MultiWriter(out, h), io.LimitReader(r, f.Size+1))
+	if err != nil {
+		return err
+	}
+	if size != f.Size || hex.EncodeToString(h.Sum(nil)) != f.SHA256 {
+		return fmt.Errorf("%w: checksum mismatch for %s", ErrBundleInvalid, f.Path)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package core
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBundleRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	src := New(t.TempDir())
+	if _, err := src.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	added, err := src.Add(ctx, "objective", "決済刷新")
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if _, err := src.CreateSnapshot(ctx, "release"); err != nil {
+		t.Fatalf("CreateSnapshot failed: %v", err)
+	}
+	// 一時ファイルは含めない
+	if err := os.WriteFile(filepath.Join(src.ZeusPath, "claims.yaml.lock"), nil, 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	manifest, err := src.ExportBundle(ctx, &buf, BundleExportOptions{ZeusVersion: "v1.2.3"})
+	if err != nil {
+		t.Fatalf("ExportBundle failed: %v", err)
+	}
+	if manifest.Format != BundleFormat || manifest.ZeusVersion != "v1.2.3" || manifest.SchemaVersion != SchemaVersion {
+		t.Errorf("manifest = %+v", manifest)
+	}
+	paths := map[string]bool{}
+	for _, f := range manifest.Files {
+		paths[f.Path] = true
+	}
+	if !paths["zeus.yaml"] || !paths["objectives/"+added.ID+".yaml"] || paths["claims.yaml.lock"] {
+		t.Errorf("files = %+v", manifest.Files)
+	}
+	bundle := buf.Bytes()
+
+	// 新しいディレクトリに取り込む
+	dst := New(filepath.Join(t.TempDir(), "copy"))
+	result, err := dst.ImportBundle(ctx, bytes.NewReader(bundle), BundleImportOptions{})
+	if err != nil {
+		t.Fatalf("ImportBundle failed: %v", err)
+	}
+	if result.Backup != "" || result.Manifest.ProjectID != manifest.ProjectID {
+		t.Errorf("result = %+v", result)
+	}
+	if _, err := dst.Get(ctx, "objective", added.ID); err != nil {
+		t.Errorf("imported objective should exist: %v", err)
+	}
+	if history, _ := dst.GetHistory(ctx, 0); len(history) != 1 || history[0].Label != "release" {
+		t.Errorf("snapshots should be imported: %+v", history)
+	}
+	if _, err := os.Stat(filepath.Join(dst.ZeusPath, "approvals", "pending")); err != nil {
+		t.Errorf("empty directories should be imported: %v", err)
+	}
+
+	// 既存のプロジェクトは --force なしでは置き換えない
+	if _, err := dst.ImportBundle(ctx, bytes.NewReader(bundle), BundleImportOptions{}); !errors.Is(err, ErrProjectExists) {
+		t.Errorf("existing project should not be replaced: %v", err)
+	}
+	if _, err := dst.ImportBundle(ctx, bytes.NewReader(bundle), BundleImportOptions{Force: true, DryRun: true}); err != nil {
+		t.Errorf("dry run should succeed: %v", err)
+	}
+	if _, err := dst.Add(ctx, "objective", "取り込み後に追加"); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	result, err = dst.ImportBundle(ctx, bytes.NewReader(bundle), BundleImportOptions{Force: true})
+	if err != nil {
+		t.Fatalf("ImportBundle with force failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(result.Backup, "zeus.yaml")); err != nil {
+		t.Errorf("existing .zeus should be backed up: %v", err)
+	}
+	if objs, _ := dst.List(ctx, "objective"); objs.Total != 1 {
+		t.Errorf("project should be replaced: %+v", objs)
+	}
+}
+
+func TestExportBundle_RedactsSecrets(t *testing.T) {
+	ctx := context.Background()
+	src := New(t.TempDir())
+	if _, err := src.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	config, err := src.LoadConfig(ctx)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	config.Server.APIToken = "secret-api-token"
+	config.Notifications.Email = EmailSettings{Host: "smtp.example.com", Port: 587, Username: "zeus", Password: "secret-smtp-password", From: "zeus@example.com", To: []string{"team@example.com"}}
+	config.Integrations.IssueSync.Token = "secret-issue-token"
+	if err := src.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+	secrets := []string{"secret-api-token", "secret-smtp-password", "secret-issue-token"}
+
+	export := func(opts BundleExportOptions) (*BundleManifest, *ZeusConfig) {
+		t.Helper()
+		var buf bytes.Buffer
+		manifest, err := src.ExportBundle(ctx, &buf, opts)
+		if err != nil {
+			t.Fatalf("ExportBundle failed: %v", err)
+		}
+		dst := New(filepath.Join(t.TempDir(), "copy"))
+		if _, err := dst.ImportBundle(ctx, &buf, BundleImportOptions{}); err != nil {
+			t.Fatalf("ImportBundle failed: %v", err)
+		}
+		data, err := os.ReadFile(filepath.Join(dst.ZeusPath, "zeus.yaml"))
+		if err != nil {
+			t.Fatalf("ReadFile failed: %v", err)
+		}
+		for _, secret := range secrets {
+			if bytes.Contains(data, []byte(secret)) != opts.IncludeSecrets {
+				t.Errorf("IncludeSecrets=%v: %q in zeus.yaml = %v", opts.IncludeSecrets, secret, !opts.IncludeSecrets)
+			}
+		}
+		imported, err := dst.LoadConfig(ctx)
+		if err != nil {
+			t.Fatalf("LoadConfig failed: %v", err)
+		}
+		return manifest, imported
+	}
+
+	// 既定では認証情報を消去し、それ以外の設定は残す
+	manifest, imported := export(BundleExportOptions{})
+	if !manifest.SecretsRedacted || imported.Notifications.Email.Host != "smtp.example.com" || imported.Project.ID != config.Project.ID {
+		t.Errorf("manifest = %+v, config = %+v", manifest, imported)
+	}
+	// --include-secrets では元の内容のまま含める
+	if manifest, imported := export(BundleExportOptions{IncludeSecrets: true}); manifest.SecretsRedacted || imported.Server.APIToken != "secret-api-token" {
+		t.Errorf("manifest = %+v, config = %+v", manifest, imported)
+	}
+}
+
+func TestImportBundle_Invalid(t *testing.T) {
+	ctx := context.Background()
+	build := func(manifest BundleManifest, entries map[string]string) []byte {
+		t.Helper()
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gz)
+		data, _ := json.Marshal(manifest)
+		_ = tw.WriteHeader(&tar.Header{Name: bundleManifestName, Mode: 0644, Size: int64(len(data))})
+		_, _ = tw.Write(data)
+		for name, content := range entries {
+			_ = tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))})
+			_, _ = tw.Write([]byte(content))
+		}
+		_ = tw.Close()
+		_ = gz.Close()
+		return buf.Bytes()
+	}
+	valid := BundleManifest{Format: BundleFormat, Version: BundleFormatVersion, Files: []BundleFile{
+		{Path: "zeus.yaml", Size: 5, SHA256: "0000"},
+	}}
+	newer := valid
+	newer.Version = BundleFormatVersion + 1
+
+	tests := map[string][]byte{
+		"not gzip":          []byte("plain text"),
+		"newer version":     build(newer, nil),
+		"checksum mismatch": build(valid, map[string]string{"zeus/zeus.yaml": "hello"}),
+		"path traversal":    build(valid, map[string]string{"zeus/../evil.yaml": "x"}),
+		"missing file":      build(valid, nil),
+	}
+	for name, bundle := range tests {
+		z := New(filepath.Join(t.TempDir(), "p"))
+		if _, err := z.ImportBundle(ctx, bytes.NewReader(bundle), BundleImportOptions{}); !errors.Is(err, ErrBundleInvalid) {
+			t.Errorf("%s: should fail with ErrBundleInvalid: %v", name, err)
+		}
+		if _, err := os.Stat(z.ZeusPath); !os.IsNotExist(err) {
+			t.Errorf("%s: invalid bundle should not create .zeus", name)
+		}
+	}
+}
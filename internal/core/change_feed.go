@@ -0,0 +1,288 @@
+package core
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// changeFeedFile は変更フィードの状態（エンティティごとの最新の変更とハッシュ）
+const changeFeedFile = "state/change-feed.yaml"
+
+// DefaultChangeFeedLimit は 1 ページあたりの変更数のデフォルト
+const DefaultChangeFeedLimit = 100
+
+// 変更の種類
+const (
+	ChangeOpCreate = "create"
+	ChangeOpUpdate = "update"
+	ChangeOpDelete = "delete"
+)
+
+// changeFeedListFiles は単一ファイルにリストで保持するエンティティ
+var changeFeedListFiles = []struct {
+	entityType string
+	file       string
+	key        string
+}{
+	{"actor", "actors.yaml", "actors"},
+	{"subsystem", "subsystems.yaml", "subsystems"},
+	{"constraint", "constraints.yaml", "constraints"},
+	{"container", containersFileName, "containers"},
+	{"component", componentsFileName, "components"},
+}
+
+// changeFeedMu は変更フィードの採番を直列化する（ダッシュボードの同時リクエスト対策）
+var changeFeedMu sync.Mutex
+
+// ChangeRecord はエンティティ単位の変更
+type ChangeRecord struct {
+	Cursor    string // この変更の位置（since に指定するとこれより後を返す）
+	Type      string
+	ID        string
+	Op        string // create, update, delete
+	Timestamp string // 作成・更新はエンティティの更新日時、削除は検出日時
+}
+
+// ChangeFeedPage は変更フィードの 1 ページ
+type ChangeFeedPage struct {
+	Changes    []ChangeRecord
+	NextCursor string // 次回の since に指定する値
+	HasMore    bool
+}
+
+// changeFeedEntry はエンティティごとの最新の変更
+type changeFeedEntry struct {
+	Type      string `yaml:"type"`
+	ID        string `yaml:"id"`
+	Op        string `yaml:"op"`
+	Seq       uint64 `yaml:"seq"`
+	Timestamp string `yaml:"timestamp"`
+	Hash      string `yaml:"hash,omitempty"` // 削除済みは空
+}
+
+// changeFeedState は changeFeedFile の内容
+type changeFeedState struct {
+	LastSeq uint64                      `yaml:"last_seq"`
+	Entries map[string]*changeFeedEntry `yaml:"entries"`
+}
+
+// entitySnapshot は変更検出用のエンティティの内容
+type entitySnapshot struct {
+	entityType string
+	id         string
+	hash       string
+	timestamp  string
+}
+
+// ParseChangeCursor はカーソル文字列を解析（空は先頭）
+func ParseChangeCursor(cursor string) (uint64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	seq, err := strconv.ParseUint(cursor, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cursor: %q", cursor)
+	}
+	return seq, nil
+}
+
+// Changes は since より後のエンティティ変更を古い順に返す
+//
+// 呼び出し時に現在のエンティティと前回の状態を比較して変更を採番するため、
+// CLI や直接の YAML 編集による変更も検出される。エンティティごとに最新の変更のみを保持するので
+// 古いカーソルから再開しても、各エンティティの現在の状態（削除を含む）に追いつける。
+func (z *Zeus) Changes(ctx context.Context, since uint64, limit int) (*ChangeFeedPage, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = DefaultChangeFeedLimit
+	}
+
+	changeFeedMu.Lock()
+	defer changeFeedMu.Unlock()
+
+	state, err := z.refreshChangeFeed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*changeFeedEntry
+	for _, e := range state.Entries {
+		if e.Seq > since {
+			entries = append(entries, e)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Seq < entries[j].Seq })
+
+	page := &ChangeFeedPage{Changes: []ChangeRecord{}, NextCursor: strconv.FormatUint(max(since, state.LastSeq), 10)}
+	if len(entries) > limit {
+		entries = entries[:limit]
+		page.HasMore = true
+	}
+	for _, e := range entries {
+		page.Changes = append(page.Changes, ChangeRecord{
+			Cursor:    strconv.FormatUint(e.Seq, 10),
+			Type:      e.Type,
+			ID:        e.ID,
+			Op:        e.Op,
+			Timestamp: e.Timestamp,
+		})
+	}
+	if page.HasMore {
+		page.NextCursor = page.Changes[len(page.Changes)-1].Cursor
+	}
+	return page, nil
+}
+
+// refreshChangeFeed は現在のエンティティと比較して変更を採番し、状態を保存
+func (z *Zeus) refreshChangeFeed(ctx context.Context) (*changeFeedState, error) {
+	state := &changeFeedState{}
+	if z.fileStore.Exists(ctx, changeFeedFile) {
+		if err := z.fileStore.ReadYaml(ctx, changeFeedFile, state); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", changeFeedFile, err)
+		}
+	}
+	if state.Entries == nil {
+		state.Entries = map[string]*changeFeedEntry{}
+	}
+
+	current := z.entitySnapshots(ctx)
+	keys := make([]string, 0, len(current))
+	for key := range current {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	changed := false
+	for _, key := range keys {
+		snap := current[key]
+		entry, ok := state.Entries[key]
+		op := ChangeOpUpdate
+		switch {
+		case !ok || entry.Op == ChangeOpDelete:
+			op = ChangeOpCreate
+		case entry.Hash == snap.hash:
+			continue
+		}
+		state.LastSeq++
+		state.Entries[key] = &changeFeedEntry{
+			Type:      snap.entityType,
+			ID:        snap.id,
+			Op:        op,
+			Seq:       state.LastSeq,
+			Timestamp: snap.timestamp,
+			Hash:      snap.hash,
+		}
+		changed = true
+	}
+
+	var deleted []string
+	for key, entry := range state.Entries {
+		if _, ok := current[key]; !ok && entry.Op != ChangeOpDelete {
+			deleted = append(deleted, key)
+		}
+	}
+	sort.Strings(deleted)
+	for _, key := range deleted {
+		entry := state.Entries[key]
+		state.LastSeq++
+		entry.Op = ChangeOpDelete
+		entry.Seq = state.LastSeq
+		entry.Timestamp = Now()
+		entry.Hash = ""
+		changed = true
+	}
+
+	if !changed {
+		return state, nil
+	}
+	if err := z.fileStore.EnsureDir(ctx, filepath.Dir(changeFeedFile)); err != nil {
+		return nil, err
+	}
+	if err := z.fileStore.WriteYaml(ctx, changeFeedFile, state); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", changeFeedFile, err)
+	}
+	return state, nil
+}
+
+// entitySnapshots は全エンティティの内容のハッシュを返す（キー: <type>/<id>）
+func (z *Zeus) entitySnapshots(ctx context.Context) map[string]entitySnapshot {
+	snapshots := map[string]entitySnapshot{}
+	add := func(entityType string, raw map[string]any) {
+		id, _ := raw["id"].(string)
+		if id == "" {
+			return
+		}
+		snap := entitySnapshot{entityType: entityType, id: id, hash: hashEntity(raw), timestamp: entityTimestamp(raw)}
+		snapshots[entityType+"/"+id] = snap
+	}
+
+	types := make([]string, 0, len(entityDirectories))
+	for entityType, dir := range entityDirectories {
+		if dir != "" {
+			types = append(types, entityType)
+		}
+	}
+	sort.Strings(types)
+	for _, entityType := range types {
+		z.forEachYaml(ctx, entityDirectories[entityType], func(path string) {
+			var raw map[string]any
+			if err := z.fileStore.ReadYaml(ctx, path, &raw); err == nil {
+				add(entityType, raw)
+			}
+		})
+	}
+
+	if z.fileStore.Exists(ctx, "vision.yaml") {
+		var raw map[string]any
+		if err := z.fileStore.ReadYaml(ctx, "vision.yaml", &raw); err == nil {
+			add("vision", raw)
+		}
+	}
+	for _, lf := range changeFeedListFiles {
+		if !z.fileStore.Exists(ctx, lf.file) {
+			continue
+		}
+		var raw map[string]any
+		if err := z.fileStore.ReadYaml(ctx, lf.file, &raw); err != nil {
+			continue
+		}
+		items, _ := raw[lf.key].([]any)
+		for _, item := range items {
+			if m, ok := item.(map[string]any); ok {
+				add(lf.entityType, m)
+			}
+		}
+	}
+	return snapshots
+}
+
+// hashEntity はエンティティの内容のハッシュ（JSON はマップのキーを整列して出力する）
+func hashEntity(raw map[string]any) string {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		data = []byte(fmt.Sprint(raw))
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// entityTimestamp はエンティティの更新日時（無い場合は作成日時、どちらも無い場合は現在時刻）
+func entityTimestamp(raw map[string]any) string {
+	if metadata, ok := raw["metadata"].(map[string]any); ok {
+		for _, key := range []string{"updated_at", "created_at"} {
+			if v, ok := metadata[key]; ok && fmt.Sprint(v) != "" {
+				return fmt.Sprint(v)
+			}
+		}
+	}
+	return Now()
+}
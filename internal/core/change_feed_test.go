@@ -0,0 +1,152 @@
+package core
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func TestChanges(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	obj, err := z.Add(ctx, "objective", "Ship")
+	if err != nil {
+		t.Fatalf("Add objective failed: %v", err)
+	}
+	risk, err := z.Add(ctx, "risk", "Outage", WithRiskObjective(obj.ID))
+	if err != nil {
+		t.Fatalf("Add risk failed: %v", err)
+	}
+	if _, err := z.Add(ctx, "actor", "User"); err != nil {
+		t.Fatalf("Add actor failed: %v", err)
+	}
+
+	// 初回は既存のエンティティがすべて create
+	page, err := z.Changes(ctx, 0, 0)
+	if err != nil {
+		t.Fatalf("Changes failed: %v", err)
+	}
+	ops := map[string]string{}
+	for _, c := range page.Changes {
+		ops[c.Type+"/"+c.ID] = c.Op
+		if c.Timestamp == "" {
+			t.Errorf("change %s/%s has no timestamp", c.Type, c.ID)
+		}
+	}
+	if ops["objective/"+obj.ID] != ChangeOpCreate || ops["risk/"+risk.ID] != ChangeOpCreate {
+		t.Errorf("unexpected initial changes: %+v", page.Changes)
+	}
+	actorFound := false
+	for key := range ops {
+		if filepath.Dir(key) == "actor" {
+			actorFound = true
+		}
+	}
+	if !actorFound {
+		t.Errorf("actor from actors.yaml not in changes: %+v", page.Changes)
+	}
+	if page.HasMore {
+		t.Error("unexpected has_more")
+	}
+	cursor, _ := ParseChangeCursor(page.NextCursor)
+
+	// 変更なし
+	page, err = z.Changes(ctx, cursor, 0)
+	if err != nil {
+		t.Fatalf("Changes failed: %v", err)
+	}
+	if len(page.Changes) != 0 || page.NextCursor != strconv.FormatUint(cursor, 10) {
+		t.Fatalf("expected no changes, got %+v", page)
+	}
+
+	// 更新と削除
+	handler, _ := z.entityRegistry.Get("risk")
+	got, _ := handler.Get(ctx, risk.ID)
+	updated := got.(*RiskEntity)
+	updated.Description = "changed"
+	if err := handler.Update(ctx, risk.ID, updated); err != nil {
+		t.Fatalf("Update risk failed: %v", err)
+	}
+	if err := z.fileStore.Delete(ctx, filepath.Join("objectives", obj.ID+".yaml")); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	page, err = z.Changes(ctx, cursor, 0)
+	if err != nil {
+		t.Fatalf("Changes failed: %v", err)
+	}
+	if len(page.Changes) != 2 {
+		t.Fatalf("expected 2 changes, got %+v", page.Changes)
+	}
+	if c := page.Changes[0]; c.Type != "risk" || c.ID != risk.ID || c.Op != ChangeOpUpdate {
+		t.Errorf("first change = %+v, want risk update", c)
+	}
+	if c := page.Changes[1]; c.Type != "objective" || c.ID != obj.ID || c.Op != ChangeOpDelete {
+		t.Errorf("second change = %+v, want objective delete", c)
+	}
+
+	// 先頭から読み直すとエンティティごとの最新の変更のみ（削除を含む）
+	page, err = z.Changes(ctx, 0, 0)
+	if err != nil {
+		t.Fatalf("Changes failed: %v", err)
+	}
+	last := map[string]string{}
+	for _, c := range page.Changes {
+		if _, dup := last[c.Type+"/"+c.ID]; dup {
+			t.Errorf("duplicate change for %s/%s", c.Type, c.ID)
+		}
+		last[c.Type+"/"+c.ID] = c.Op
+	}
+	if last["objective/"+obj.ID] != ChangeOpDelete || last["risk/"+risk.ID] != ChangeOpUpdate {
+		t.Errorf("unexpected compacted changes: %+v", page.Changes)
+	}
+}
+
+func TestChanges_Pagination(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	for _, title := range []string{"A", "B", "C"} {
+		if _, err := z.Add(ctx, "objective", title); err != nil {
+			t.Fatalf("Add objective failed: %v", err)
+		}
+	}
+
+	var seen []string
+	cursor := uint64(0)
+	for i := 0; i < 5; i++ {
+		page, err := z.Changes(ctx, cursor, 2)
+		if err != nil {
+			t.Fatalf("Changes failed: %v", err)
+		}
+		for _, c := range page.Changes {
+			seen = append(seen, c.ID)
+		}
+		cursor, _ = ParseChangeCursor(page.NextCursor)
+		if !page.HasMore {
+			break
+		}
+	}
+	if len(seen) != 3 {
+		t.Errorf("expected 3 changes across pages, got %v", seen)
+	}
+}
+
+func TestParseChangeCursor(t *testing.T) {
+	if seq, err := ParseChangeCursor(""); err != nil || seq != 0 {
+		t.Errorf("ParseChangeCursor(\"\") = %d, %v", seq, err)
+	}
+	if seq, err := ParseChangeCursor("42"); err != nil || seq != 42 {
+		t.Errorf("ParseChangeCursor(\"42\") = %d, %v", seq, err)
+	}
+	if _, err := ParseChangeCursor("abc"); err == nil {
+		t.Error("expected error for invalid cursor")
+	}
+}
@@ -0,0 +1,152 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// 指摘の重大度（zeus.yaml の checks.severity で指定する値）
+const (
+	SeverityFail   = "fail"
+	SeverityWarn   = "warn"
+	SeverityIgnore = "ignore" // 指摘を表示せず、抑制として扱う
+)
+
+// SuppressionsFile は既知・許容済みの指摘の抑制を記録するファイル（.zeus からの相対パス）
+const SuppressionsFile = "suppressions.yaml"
+
+// inlineIgnorePattern はエンティティの YAML に書く抑制の注釈（zeus:ignore RULE[,RULE...]）
+var inlineIgnorePattern = regexp.MustCompile(`zeus:ignore\s+([A-Za-z0-9_,-]+)`)
+
+// Validate は重大度の上書きの妥当性を検証
+func (s CheckSettings) Validate() error {
+	for rule, severity := range s.Severity {
+		switch severity {
+		case SeverityFail, SeverityWarn, SeverityIgnore:
+		default:
+			return fmt.Errorf("checks.severity.%s: unknown severity %q (fail, warn, ignore)", rule, severity)
+		}
+	}
+	return nil
+}
+
+// Suppression は既知・許容済みの指摘の抑制（suppressions.yaml の 1 項目）
+type Suppression struct {
+	Rule    string `yaml:"rule" json:"rule"`                           // ルール（チェック名。例: subsystem_reference）
+	Entity  string `yaml:"entity,omitempty" json:"entity,omitempty"`   // 対象のエンティティ ID（空の場合はルールのすべての指摘）
+	Reason  string `yaml:"reason,omitempty" json:"reason,omitempty"`   // 許容した理由
+	Expires string `yaml:"expires,omitempty" json:"expires,omitempty"` // YYYY-MM-DD（この日まで有効、空なら無期限）
+}
+
+// Active は now の時点で抑制が有効か（期限の当日までは有効）
+func (s Suppression) Active(now time.Time) bool {
+	return s.Expires == "" || now.Format("2006-01-02") <= s.Expires
+}
+
+// Matches はルール rule・エンティティ entityID の指摘が抑制の対象か
+func (s Suppression) Matches(rule, entityID string) bool {
+	return s.Rule == rule && (s.Entity == "" || s.Entity == entityID)
+}
+
+// suppressionStore は suppressions.yaml の内容
+type suppressionStore struct {
+	Suppressions []Suppression `yaml:"suppressions"`
+}
+
+// LoadSuppressions は suppressions.yaml の抑制を読み込む（ファイルが無い場合は空）
+func LoadSuppressions(ctx context.Context, fs FileStore) ([]Suppression, error) {
+	if !fs.Exists(ctx, SuppressionsFile) {
+		return []Suppression{}, nil
+	}
+	var store suppressionStore
+	if err := fs.ReadYaml(ctx, SuppressionsFile, &store); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", SuppressionsFile, err)
+	}
+	for i, s := range store.Suppressions {
+		if s.Rule == "" {
+			return nil, fmt.Errorf("%s: suppressions[%d]: rule is required", SuppressionsFile, i)
+		}
+		if s.Expires != "" {
+			if _, err := time.Parse("2006-01-02", s.Expires); err != nil {
+				return nil, fmt.Errorf("%s: suppressions[%d]: invalid expires %q (YYYY-MM-DD)", SuppressionsFile, i, s.Expires)
+			}
+		}
+	}
+	return store.Suppressions, nil
+}
+
+// InlineIgnores はエンティティの YAML に書かれた zeus:ignore 注釈のルールを返す
+// 注釈はエンティティの項目内のコメント、または description などのテキストに書く
+// （コメントは zeus のコマンドで更新すると失われるため、残す場合はテキストに書く）
+// actors.yaml などの単一ファイルのエンティティは、そのエンティティの項目内の注釈のみを対象にする
+func InlineIgnores(ctx context.Context, fs FileStore, entityID string) (map[string]bool, error) {
+	rules := map[string]bool{}
+	entityType, ok := EntityTypeFromID(entityID)
+	if !ok {
+		return rules, nil
+	}
+	path, err := entityRelativePath(entityType, entityID)
+	if err != nil || !fs.Exists(ctx, path) {
+		return rules, nil
+	}
+
+	var doc yaml.Node
+	if err := fs.ReadYaml(ctx, path, &doc); err != nil {
+		return nil, err
+	}
+	entity := findEntityNode(&doc, entityID)
+	if entity == nil {
+		return rules, nil
+	}
+	// ファイル先頭のコメントはルートの項目に付く
+	if len(doc.Content) > 0 && doc.Content[0] == entity {
+		collectInlineIgnores(&doc, false, rules)
+	}
+	collectInlineIgnores(entity, true, rules)
+	return rules, nil
+}
+
+// findEntityNode は id: entityID を持つマッピングを探す
+func findEntityNode(node *yaml.Node, entityID string) *yaml.Node {
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == "id" && node.Content[i+1].Value == entityID {
+				return node
+			}
+		}
+	}
+	for _, child := range node.Content {
+		if found := findEntityNode(child, entityID); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// collectInlineIgnores は node のコメント（recursive の場合は子孫の値も）から zeus:ignore 注釈のルールを集める
+func collectInlineIgnores(node *yaml.Node, recursive bool, rules map[string]bool) {
+	texts := []string{node.HeadComment, node.LineComment, node.FootComment}
+	if node.Kind == yaml.ScalarNode {
+		texts = append(texts, node.Value)
+	}
+	for _, text := range texts {
+		for _, m := range inlineIgnorePattern.FindAllStringSubmatch(text, -1) {
+			for _, rule := range strings.Split(m[1], ",") {
+				if rule != "" {
+					rules[rule] = true
+				}
+			}
+		}
+	}
+	if !recursive {
+		return
+	}
+	for _, child := range node.Content {
+		collectInlineIgnores(child, true, rules)
+	}
+}
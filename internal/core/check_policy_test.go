@@ -0,0 +1,129 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/yaml"
+)
+
+func TestCheckSettings_Validate(t *testing.T) {
+	valid := CheckSettings{Severity: map[string]string{"subsystem_reference": "ignore", "reference_integrity": "warn", "lint_directory": "fail"}}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	invalid := CheckSettings{Severity: map[string]string{"subsystem_reference": "info"}}
+	if err := invalid.Validate(); err == nil {
+		t.Error("expected error for unknown severity")
+	}
+}
+
+func TestSuppression_ActiveAndMatches(t *testing.T) {
+	now := time.Date(2026, 10, 15, 23, 0, 0, 0, time.UTC)
+	tests := []struct {
+		expires string
+		want    bool
+	}{
+		{"", true},
+		{"2026-10-15", true}, // 当日までは有効
+		{"2026-10-14", false},
+	}
+	for _, tt := range tests {
+		if got := (Suppression{Rule: "r", Expires: tt.expires}).Active(now); got != tt.want {
+			t.Errorf("Active() with expires %q = %v, want %v", tt.expires, got, tt.want)
+		}
+	}
+
+	all := Suppression{Rule: "subsystem_reference"}
+	if !all.Matches("subsystem_reference", "uc-11111111") || all.Matches("reference_integrity", "uc-11111111") {
+		t.Error("suppression without entity should match every finding of the rule only")
+	}
+	one := Suppression{Rule: "subsystem_reference", Entity: "uc-11111111"}
+	if !one.Matches("subsystem_reference", "uc-11111111") || one.Matches("subsystem_reference", "uc-22222222") {
+		t.Error("suppression with entity should match that entity only")
+	}
+}
+
+func TestLoadSuppressions(t *testing.T) {
+	dir := t.TempDir()
+	fs := yaml.NewFileManager(dir)
+	ctx := context.Background()
+
+	got, err := LoadSuppressions(ctx, fs)
+	if err != nil || len(got) != 0 {
+		t.Fatalf("expected no suppressions without file, got %v, %v", got, err)
+	}
+
+	write := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, SuppressionsFile), []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write suppressions: %v", err)
+		}
+	}
+	write("suppressions:\n  - rule: subsystem_reference\n    entity: uc-11111111\n    reason: 移行中\n    expires: \"2026-12-31\"\n")
+	got, err = LoadSuppressions(ctx, fs)
+	if err != nil {
+		t.Fatalf("LoadSuppressions() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Entity != "uc-11111111" || got[0].Reason != "移行中" {
+		t.Errorf("unexpected suppressions: %+v", got)
+	}
+
+	write("suppressions:\n  - entity: uc-11111111\n")
+	if _, err := LoadSuppressions(ctx, fs); err == nil {
+		t.Error("expected error for missing rule")
+	}
+	write("suppressions:\n  - rule: subsystem_reference\n    expires: next month\n")
+	if _, err := LoadSuppressions(ctx, fs); err == nil {
+		t.Error("expected error for invalid expires")
+	}
+}
+
+func TestInlineIgnores(t *testing.T) {
+	dir := t.TempDir()
+	fs := yaml.NewFileManager(dir)
+	ctx := context.Background()
+
+	files := map[string]string{
+		"usecases/uc-11111111.yaml": "# zeus:ignore subsystem_reference\nid: uc-11111111\ntitle: コメントで抑制\n",
+		"usecases/uc-22222222.yaml": "id: uc-22222222\ntitle: 説明で抑制\ndescription: 外部 API 待ち（zeus:ignore subsystem_reference,usecase_actor）\n",
+		"usecases/uc-33333333.yaml": "id: uc-33333333\ntitle: 注釈なし\n",
+		"actors.yaml":               "actors:\n  - id: actor-11111111\n    title: A\n    description: zeus:ignore lint_id_format\n  - id: actor-22222222\n    title: B\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	tests := []struct {
+		id   string
+		rule string
+		want bool
+	}{
+		{"uc-11111111", "subsystem_reference", true},
+		{"uc-22222222", "subsystem_reference", true},
+		{"uc-22222222", "usecase_actor", true},
+		{"uc-33333333", "subsystem_reference", false},
+		{"actor-11111111", "lint_id_format", true},
+		{"actor-22222222", "lint_id_format", false}, // 同じファイルの別の項目の注釈は対象外
+		{"uc-99999999", "subsystem_reference", false},
+		{"not-an-id", "subsystem_reference", false},
+	}
+	for _, tt := range tests {
+		rules, err := InlineIgnores(ctx, fs, tt.id)
+		if err != nil {
+			t.Fatalf("InlineIgnores(%s) error = %v", tt.id, err)
+		}
+		if rules[tt.rule] != tt.want {
+			t.Errorf("InlineIgnores(%s)[%s] = %v, want %v", tt.id, tt.rule, rules[tt.rule], tt.want)
+		}
+	}
+}
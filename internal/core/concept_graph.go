@@ -0,0 +1,45 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+)
+
+// ConceptEntityTypes は概念グラフの対象とするエンティティ種別（タスク以外の概念）
+var ConceptEntityTypes = []string{"vision", "objective", "consideration", "decision", "risk", "problem", "assumption"}
+
+// ConceptGraphFilter は概念グラフの絞り込み条件（空の場合は絞り込まない）
+type ConceptGraphFilter struct {
+	Types    []string // エンティティ種別（ConceptEntityTypes のいずれか）
+	Statuses []string // 状態（状態を持たない Decision は常に含める）
+}
+
+// BuildConceptGraph は Vision・Objective・Consideration・Decision・Risk・Problem・Assumption の参照関係グラフを構築
+// 絞り込みで除いたノードにつながるエッジは含めない
+func (z *Zeus) BuildConceptGraph(ctx context.Context, filter ConceptGraphFilter) (*analysis.RelationGraph, error) {
+	for _, t := range filter.Types {
+		if !slices.Contains(ConceptEntityTypes, t) {
+			return nil, fmt.Errorf("%w: %s (concept graph supports %v)", ErrUnknownEntity, t, ConceptEntityTypes)
+		}
+	}
+
+	graph, err := z.BuildRelationGraph(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return graph.Filter(func(node analysis.RelationNode) bool {
+		if !slices.Contains(ConceptEntityTypes, node.Type) {
+			return false
+		}
+		if len(filter.Types) > 0 && !slices.Contains(filter.Types, node.Type) {
+			return false
+		}
+		if len(filter.Statuses) > 0 && node.Type != "decision" && !slices.Contains(filter.Statuses, node.Status) {
+			return false
+		}
+		return true
+	}), nil
+}
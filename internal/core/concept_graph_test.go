@@ -0,0 +1,49 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestBuildConceptGraph(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	obj, err := z.Add(ctx, "objective", "目標")
+	if err != nil {
+		t.Fatalf("Add objective failed: %v", err)
+	}
+	open, err := z.Add(ctx, "problem", "未解決", WithProblemObjective(obj.ID))
+	if err != nil {
+		t.Fatalf("Add problem failed: %v", err)
+	}
+	if _, err := z.Add(ctx, "problem", "解決済み", WithProblemObjective(obj.ID), WithProblemStatus(ProblemStatusResolved)); err != nil {
+		t.Fatalf("Add problem failed: %v", err)
+	}
+	if _, err := z.Add(ctx, "usecase", "ログイン", WithUseCaseObjective(obj.ID)); err != nil {
+		t.Fatalf("Add usecase failed: %v", err)
+	}
+
+	graph, err := z.BuildConceptGraph(ctx, ConceptGraphFilter{})
+	if err != nil {
+		t.Fatalf("BuildConceptGraph failed: %v", err)
+	}
+	if len(graph.Nodes) != 3 || len(graph.Edges) != 2 {
+		t.Errorf("expected 3 nodes and 2 edges (usecase excluded), got %d, %d", len(graph.Nodes), len(graph.Edges))
+	}
+
+	graph, err = z.BuildConceptGraph(ctx, ConceptGraphFilter{Types: []string{"problem"}, Statuses: []string{"open"}})
+	if err != nil {
+		t.Fatalf("BuildConceptGraph failed: %v", err)
+	}
+	if len(graph.Nodes) != 1 || graph.Nodes[open.ID].Status != "open" || len(graph.Edges) != 0 {
+		t.Errorf("unexpected filtered graph: %+v", graph)
+	}
+
+	if _, err := z.BuildConceptGraph(ctx, ConceptGraphFilter{Types: []string{"usecase"}}); !errors.Is(err, ErrUnknownEntity) {
+		t.Errorf("expected ErrUnknownEntity, got %v", err)
+	}
+}
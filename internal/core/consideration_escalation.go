@@ -0,0 +1,222 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/yaml"
+)
+
+// EscalationEventType は期限超過のエスカレーションをイベントログに記録する際の種類
+const EscalationEventType = "escalation"
+
+// escalationLockFile はエスカレーションを直列化するロック（ダッシュボードと CLI が同じ Problem を重複作成しないため）
+const escalationLockFile = "state/escalation"
+
+// OverdueConsideration は期限を過ぎた open の Consideration
+type OverdueConsideration struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	ObjectiveID string `json:"objective_id,omitempty"`
+	DueDate     string `json:"due_date"`
+	DaysOverdue int    `json:"days_overdue"`
+	EscalatedAt string `json:"escalated_at,omitempty"`
+	EscalatedTo string `json:"escalated_to,omitempty"` // 自動作成した Problem の ID（承認待ちの場合は承認 ID）
+}
+
+// ConsiderationEscalationResult はエスカレーションの処理結果
+type ConsiderationEscalationResult struct {
+	Overdue   []OverdueConsideration `json:"overdue"`   // 期限を過ぎた open の Consideration（超過日数の多い順）
+	Escalated []OverdueConsideration `json:"escalated"` // 今回新たにエスカレーションしたもの
+	Problems  []OverdueConsideration `json:"problems"`  // 今回 Problem を作成したもの
+}
+
+// Validate はエスカレーション設定の妥当性を検証
+func (s ConsiderationEscalationSettings) Validate() error {
+	if s.GraceDays < 0 {
+		return fmt.Errorf("escalation.considerations.grace_days must be >= 0")
+	}
+	switch s.ProblemSeverity {
+	case "", ProblemSeverityCritical, ProblemSeverityHigh, ProblemSeverityMedium, ProblemSeverityLow:
+		return nil
+	}
+	return fmt.Errorf("invalid escalation.considerations.problem_severity: %s", s.ProblemSeverity)
+}
+
+// problemSeverity は自動作成する Problem の深刻度（未設定は medium）
+func (s ConsiderationEscalationSettings) problemSeverity() ProblemSeverity {
+	if s.ProblemSeverity == "" {
+		return ProblemSeverityMedium
+	}
+	return s.ProblemSeverity
+}
+
+// overdueDays は open の Consideration が期限を何日過ぎているか（期限当日・期限なし・open 以外は false）
+func (c *ConsiderationEntity) overdueDays(now time.Time) (int, bool) {
+	if c.Status != ConsiderationStatusOpen {
+		return 0, false
+	}
+	due, ok := parseDigestTime(c.DueDate)
+	if !ok {
+		return 0, false
+	}
+	days := int(truncateDay(now).Sub(truncateDay(due)).Hours() / 24)
+	return days, days > 0
+}
+
+// sortConsiderationsByUrgency は期限を過ぎたものを超過日数の多い順に先頭へ、残りは期限の近い順（期限なしは最後）に並べる
+func sortConsiderationsByUrgency(considerations []*ConsiderationEntity, now time.Time) {
+	sort.SliceStable(considerations, func(i, j int) bool {
+		di, oi := considerations[i].overdueDays(now)
+		dj, oj := considerations[j].overdueDays(now)
+		if oi != oj {
+			return oi
+		}
+		if oi && di != dj {
+			return di > dj
+		}
+		ti, okI := parseDigestTime(considerations[i].DueDate)
+		tj, okJ := parseDigestTime(considerations[j].DueDate)
+		if okI != okJ {
+			return okI
+		}
+		if okI && !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return considerations[i].ID < considerations[j].ID
+	})
+}
+
+// OverdueConsiderations は期限を過ぎた open の Consideration を超過日数の多い順に返す
+func (z *Zeus) OverdueConsiderations(ctx context.Context, now time.Time) ([]OverdueConsideration, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	overdue := []OverdueConsideration{}
+	for _, c := range z.loadOverdueConsiderations(ctx, now) {
+		overdue = append(overdue, c.overdue(now))
+	}
+	return overdue, ctx.Err()
+}
+
+// loadOverdueConsiderations は期限を過ぎた open の Consideration を超過日数の多い順に読み込む
+func (z *Zeus) loadOverdueConsiderations(ctx context.Context, now time.Time) []*ConsiderationEntity {
+	considerations := []*ConsiderationEntity{}
+	z.forEachYaml(ctx, "considerations", func(path string) {
+		var c ConsiderationEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &c); err != nil {
+			return
+		}
+		if _, ok := c.overdueDays(now); ok {
+			considerations = append(considerations, &c)
+		}
+	})
+	sortConsiderationsByUrgency(considerations, now)
+	return considerations
+}
+
+// overdue は期限超過の情報に変換
+func (c *ConsiderationEntity) overdue(now time.Time) OverdueConsideration {
+	days, _ := c.overdueDays(now)
+	return OverdueConsideration{
+		ID:          c.ID,
+		Title:       c.Title,
+		ObjectiveID: c.ObjectiveID,
+		DueDate:     c.DueDate,
+		DaysOverdue: days,
+		EscalatedAt: c.EscalatedAt,
+		EscalatedTo: c.EscalatedTo,
+	}
+}
+
+// EscalateConsiderations は期限を過ぎた open の Consideration をエスカレーションする
+// 初めて期限を過ぎたものは escalated_at を記録して Escalated に含める（イベントの記録・配信は呼び出し側）
+// escalation.considerations.auto_problem が有効な場合は、期限から grace_days を過ぎたものに Problem を 1 件だけ作成する
+// 期限が延びて超過でなくなったものは escalated_at を消去し、再び超過したときにエスカレーションする
+// 評価から書き込みまではプロセス間のファイルロックを保持する
+func (z *Zeus) EscalateConsiderations(ctx context.Context, now time.Time) (*ConsiderationEscalationResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	lock := yaml.NewFileLock(filepath.Join(z.ZeusPath, escalationLockFile))
+	if err := lock.LockWithTimeout(5 * time.Second); err != nil {
+		return nil, ErrLockAcquireFailed
+	}
+	defer lock.Unlock()
+	var settings ConsiderationEscalationSettings
+	config, err := z.LoadConfig(ctx)
+	switch {
+	case err == nil:
+		settings = config.Escalation.Considerations
+	case !errors.Is(err, ErrConfigNotFound):
+		return nil, err
+	}
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	result := &ConsiderationEscalationResult{
+		Overdue:   []OverdueConsideration{},
+		Escalated: []OverdueConsideration{},
+		Problems:  []OverdueConsideration{},
+	}
+	var stale []*ConsiderationEntity
+	z.forEachYaml(ctx, "considerations", func(path string) {
+		var c ConsiderationEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &c); err != nil {
+			return
+		}
+		if _, ok := c.overdueDays(now); !ok && c.EscalatedAt != "" && c.Status == ConsiderationStatusOpen {
+			stale = append(stale, &c)
+		}
+	})
+	for _, c := range stale {
+		c.EscalatedAt = ""
+		if err := z.writeConsideration(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, c := range z.loadOverdueConsiderations(ctx, now) {
+		days, _ := c.overdueDays(now)
+		changed := false
+		if c.EscalatedAt == "" {
+			c.EscalatedAt = now.Format(time.RFC3339)
+			changed = true
+			result.Escalated = append(result.Escalated, c.overdue(now))
+		}
+		if settings.AutoProblem && c.EscalatedTo == "" && days > settings.GraceDays {
+			added, err := z.Add(ctx, "problem", "期限超過: "+c.Title,
+				WithProblemSeverity(settings.problemSeverity()),
+				WithProblemObjective(c.ObjectiveID),
+				WithProblemDescription(fmt.Sprintf("Consideration %s の期限（%s）を %d 日過ぎても決定されていません", c.ID, c.DueDate, days)),
+				WithProblemCauses([]ProblemCause{{Description: "検討事項が期限までに決定されなかった", EntityID: c.ID}}),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create problem for overdue consideration %s: %w", c.ID, err)
+			}
+			c.EscalatedTo = added.ID
+			if added.NeedsApproval {
+				c.EscalatedTo = added.ApprovalID
+			}
+			changed = true
+			result.Problems = append(result.Problems, c.overdue(now))
+		}
+		if changed {
+			if err := z.writeConsideration(ctx, c); err != nil {
+				return nil, err
+			}
+		}
+		result.Overdue = append(result.Overdue, c.overdue(now))
+	}
+	return result, ctx.Err()
+}
+
+// writeConsideration はエスカレーションの記録を書き込む（利用者による更新ではないため updated_at は変えない）
+func (z *Zeus) writeConsideration(ctx context.Context, c *ConsiderationEntity) error {
+	return z.fileStore.WriteYaml(ctx, filepath.Join("considerations", c.ID+".yaml"), c)
+}
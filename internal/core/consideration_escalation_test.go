@@ -0,0 +1,163 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEscalateConsiderations(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	config, _ := z.LoadConfig(ctx)
+	config.Escalation.Considerations = ConsiderationEscalationSettings{AutoProblem: true, GraceDays: 3, ProblemSeverity: ProblemSeverityHigh}
+	if err := z.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+
+	add := func(title, due string, opts ...EntityOption) string {
+		t.Helper()
+		result, err := z.Add(ctx, "consideration", title, append(opts, WithConsiderationDueDate(due))...)
+		if err != nil {
+			t.Fatalf("Add %s failed: %v", title, err)
+		}
+		return result.ID
+	}
+	now := time.Date(2026, 3, 20, 10, 0, 0, 0, time.Local)
+	late := add("認証方式", "2026-03-10")
+	recent := add("DB 選定", "2026-03-18")
+	add("期限内", "2026-03-25")
+	add("当日", "2026-03-20")
+
+	result, err := z.EscalateConsiderations(ctx, now)
+	if err != nil {
+		t.Fatalf("EscalateConsiderations failed: %v", err)
+	}
+	// 超過日数の多い順（期限当日は超過ではない）
+	if len(result.Overdue) != 2 || result.Overdue[0].ID != late || result.Overdue[1].ID != recent {
+		t.Fatalf("overdue = %+v", result.Overdue)
+	}
+	if result.Overdue[0].DaysOverdue != 10 || result.Overdue[1].DaysOverdue != 2 {
+		t.Errorf("days overdue = %d, %d", result.Overdue[0].DaysOverdue, result.Overdue[1].DaysOverdue)
+	}
+	if len(result.Escalated) != 2 {
+		t.Errorf("escalated = %+v", result.Escalated)
+	}
+	// 猶予期間（3 日）を過ぎたものだけ Problem を作成
+	if len(result.Problems) != 1 || result.Problems[0].ID != late {
+		t.Fatalf("problems = %+v", result.Problems)
+	}
+	entity, err := z.Get(ctx, "problem", result.Problems[0].EscalatedTo)
+	if err != nil {
+		t.Fatalf("Get problem failed: %v", err)
+	}
+	prob := entity.(*ProblemEntity)
+	if prob.Severity != ProblemSeverityHigh || len(prob.Causes) != 1 || prob.Causes[0].EntityID != late {
+		t.Errorf("problem = %+v", prob)
+	}
+
+	// 2 回目はイベントも Problem も重複しない
+	result, err = z.EscalateConsiderations(ctx, now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("EscalateConsiderations failed: %v", err)
+	}
+	if len(result.Escalated) != 0 || len(result.Problems) != 0 || len(result.Overdue) != 2 {
+		t.Errorf("second run = %+v", result)
+	}
+
+	// 期限を延ばすとエスカレーションの記録が消え、再び超過したときに再度エスカレーションされる
+	entity, err = z.Get(ctx, "consideration", recent)
+	if err != nil {
+		t.Fatalf("Get consideration failed: %v", err)
+	}
+	con := entity.(*ConsiderationEntity)
+	con.DueDate = "2026-03-30"
+	if err := z.writeConsideration(ctx, con); err != nil {
+		t.Fatalf("writeConsideration failed: %v", err)
+	}
+	if _, err := z.EscalateConsiderations(ctx, now); err != nil {
+		t.Fatalf("EscalateConsiderations failed: %v", err)
+	}
+	entity, _ = z.Get(ctx, "consideration", recent)
+	if entity.(*ConsiderationEntity).EscalatedAt != "" {
+		t.Error("escalated_at should be cleared when no longer overdue")
+	}
+	result, err = z.EscalateConsiderations(ctx, time.Date(2026, 4, 1, 10, 0, 0, 0, time.Local))
+	if err != nil {
+		t.Fatalf("EscalateConsiderations failed: %v", err)
+	}
+	reescalated := false
+	for _, e := range result.Escalated {
+		if e.ID == late {
+			t.Errorf("%s should not be escalated twice", late)
+		}
+		reescalated = reescalated || e.ID == recent
+	}
+	if !reescalated {
+		t.Errorf("re-escalated = %+v", result.Escalated)
+	}
+}
+
+func TestEscalateConsiderations_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	z := New(dir)
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	config, _ := z.LoadConfig(ctx)
+	config.Escalation.Considerations = ConsiderationEscalationSettings{AutoProblem: true}
+	if err := z.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+	if _, err := z.Add(ctx, "consideration", "認証方式", WithConsiderationDueDate("2026-03-10")); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+
+	// ダッシュボードと CLI のように別々のインスタンスから同時に評価しても Problem は 1 件
+	now := time.Date(2026, 3, 20, 10, 0, 0, 0, time.Local)
+	var wg sync.WaitGroup
+	for range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := New(dir).EscalateConsiderations(ctx, now); err != nil {
+				t.Errorf("EscalateConsiderations failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	problems, err := z.List(ctx, "problem")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if problems.Total != 1 {
+		t.Errorf("expected exactly 1 problem, got %d", problems.Total)
+	}
+}
+
+func TestSortConsiderationsByUrgency(t *testing.T) {
+	now := time.Date(2026, 3, 20, 10, 0, 0, 0, time.Local)
+	considerations := []*ConsiderationEntity{
+		{ID: "con-a", Status: ConsiderationStatusOpen},
+		{ID: "con-b", Status: ConsiderationStatusOpen, DueDate: "2026-03-25"},
+		{ID: "con-c", Status: ConsiderationStatusOpen, DueDate: "2026-03-18"},
+		{ID: "con-d", Status: ConsiderationStatusDecided, DueDate: "2026-03-01"},
+		{ID: "con-e", Status: ConsiderationStatusOpen, DueDate: "2026-03-10"},
+		{ID: "con-f", Status: ConsiderationStatusOpen, DueDate: "2026-03-22"},
+	}
+	sortConsiderationsByUrgency(considerations, now)
+
+	// 期限超過（超過日数の多い順）→ 期限の近い順 → 期限なし
+	want := []string{"con-e", "con-c", "con-d", "con-f", "con-b", "con-a"}
+	for i, c := range considerations {
+		if c.ID != want[i] {
+			t.Fatalf("order[%d] = %s, want %s", i, c.ID, want[i])
+		}
+	}
+}
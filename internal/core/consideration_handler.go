@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -110,14 +111,33 @@ func (h *ConsiderationHandler) List(ctx context.Context, filter *ListFilter) (*L
 		considerations = filtered
 	}
 
+	// 期限を過ぎた open の Consideration を優先度 high として先頭に並べる
+	now := time.Now()
+	sortConsiderationsByUrgency(considerations, now)
+
 	// Limit 適用
 	if filter != nil && filter.Limit > 0 && len(considerations) > filter.Limit {
 		considerations = considerations[:filter.Limit]
 	}
 
+	items := make([]ListItem, 0, len(considerations))
+	for _, con := range considerations {
+		item := ListItem{
+			ID:        con.ID,
+			Title:     con.Title,
+			Status:    ItemStatus(con.Status),
+			CreatedAt: con.Metadata.CreatedAt,
+			UpdatedAt: con.Metadata.UpdatedAt,
+		}
+		if _, overdue := con.overdueDays(now); overdue {
+			item.Priority = PriorityHigh
+		}
+		items = append(items, item)
+	}
+
 	return &ListResult{
 		Entity: h.Type() + "s",
-		Items:  []ListItem{},
+		Items:  items,
 		Total:  len(considerations),
 	}, nil
 }
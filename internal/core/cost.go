@@ -0,0 +1,251 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Cost は Objective / Activity のコスト（通貨は zeus.yaml の costs.currency）
+// committed は発注・契約済みで未払いの額、actual は支出済みの額
+type Cost struct {
+	Budgeted  float64 `yaml:"budgeted,omitempty" json:"budgeted"`
+	Committed float64 `yaml:"committed,omitempty" json:"committed"`
+	Actual    float64 `yaml:"actual,omitempty" json:"actual"`
+}
+
+// Validate はコストの妥当性を検証
+func (c *Cost) Validate() error {
+	if c.Budgeted < 0 || c.Committed < 0 || c.Actual < 0 {
+		return fmt.Errorf("cost.budgeted, cost.committed and cost.actual must be >= 0")
+	}
+	return nil
+}
+
+// Exposure は支出済みと契約済みの合計（予算と比較する額）
+func (c Cost) Exposure() float64 {
+	return c.Actual + c.Committed
+}
+
+// add は other を加算したコストを返す
+func (c Cost) add(other *Cost) Cost {
+	if other == nil {
+		return c
+	}
+	return Cost{Budgeted: c.Budgeted + other.Budgeted, Committed: c.Committed + other.Committed, Actual: c.Actual + other.Actual}
+}
+
+// costKeys は --cost で指定できるキー
+var costKeys = []string{"budgeted", "committed", "actual"}
+
+// ParseCost は budgeted=1000,committed=200,actual=300 形式の指定を解析（省略したキーは 0）
+func ParseCost(spec string) (*Cost, error) {
+	c := &Cost{}
+	for _, part := range strings.Split(spec, ",") {
+		key, raw, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid cost: %q (expected key=value)", part)
+		}
+		key = strings.TrimSpace(key)
+		if !slices.Contains(costKeys, key) {
+			return nil, fmt.Errorf("invalid cost: unknown key %q (expected %s)", key, strings.Join(costKeys, ", "))
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cost: %s: %w", key, err)
+		}
+		if err := c.set(key, v); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// set はキーに対応する値を設定
+func (c *Cost) set(key string, v float64) error {
+	switch key {
+	case "budgeted":
+		c.Budgeted = v
+	case "committed":
+		c.Committed = v
+	case "actual":
+		c.Actual = v
+	default:
+		return fmt.Errorf("unknown field cost.%s", key)
+	}
+	return nil
+}
+
+// costFields はコストの更新可能フィールド
+var costFields = []string{"cost.budgeted", "cost.committed", "cost.actual"}
+
+// applyCostFields は cost.* のフィールド（値は数値の文字列）をコストに反映
+// コストが未設定の場合は作成する
+func applyCostFields(cost **Cost, fields map[string]any) error {
+	for field, value := range fields {
+		prefix, name, ok := strings.Cut(field, ".")
+		if !ok || prefix != "cost" {
+			continue
+		}
+		v, err := strconv.ParseFloat(fmt.Sprint(value), 64)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", field, err)
+		}
+		if *cost == nil {
+			*cost = &Cost{}
+		}
+		if err := (*cost).set(name, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithCost は Objective / Activity のコストを設定
+func WithCost(cost *Cost) EntityOption {
+	return func(v any) {
+		switch e := v.(type) {
+		case *ObjectiveEntity:
+			e.Cost = cost
+		case *ActivityEntity:
+			e.Cost = cost
+		}
+	}
+}
+
+// BudgetLine は予算対実績の 1 行（Objective は配下の Activity を含めて集計）
+type BudgetLine struct {
+	Entity      string       `json:"entity"` // objective / activity
+	ID          string       `json:"id"`
+	Title       string       `json:"title"`
+	Cost        Cost         `json:"cost"`                 // 自身のコスト（Objective は Activity に割り当てない費用）
+	Total       Cost         `json:"total"`                // 自身と配下の Activity の合計
+	Variance    float64      `json:"variance"`             // total の予算 - (実績 + 契約済み)。負は超過
+	Utilization int          `json:"utilization"`          // total の予算に対する実績 + 契約済みの割合（%、予算なしは 0）
+	Overrun     bool         `json:"overrun"`              // 実績 + 契約済みが予算を超えている
+	Activities  []BudgetLine `json:"activities,omitempty"` // Objective 配下でコストを持つ Activity
+}
+
+// BudgetReport はプロジェクト全体の予算対実績
+type BudgetReport struct {
+	Currency   string       `json:"currency,omitempty"`
+	Total      Cost         `json:"total"`
+	Variance   float64      `json:"variance"`
+	Objectives []BudgetLine `json:"objectives"` // コストを持つ Objective（Activity を含む）
+	Unassigned []BudgetLine `json:"unassigned"` // Objective に辿れない Activity
+	Overruns   []BudgetLine `json:"overruns"`   // 予算超過の Objective / Activity（超過額の大きい順）
+}
+
+// newBudgetLine は自身と配下のコストから予算対実績の行を作る
+func newBudgetLine(entity, id, title string, own *Cost, children []BudgetLine) BudgetLine {
+	line := BudgetLine{Entity: entity, ID: id, Title: title, Activities: children}
+	if own != nil {
+		line.Cost = *own
+	}
+	line.Total = line.Cost
+	for _, child := range children {
+		line.Total = line.Total.add(&child.Cost)
+	}
+	line.Variance = line.Total.Budgeted - line.Total.Exposure()
+	if line.Total.Budgeted > 0 {
+		line.Utilization = int(line.Total.Exposure() * 100 / line.Total.Budgeted)
+	}
+	line.Overrun = line.Total.Exposure() > line.Total.Budgeted && line.Total.Budgeted > 0
+	return line
+}
+
+// Budget はコストを持つ Objective / Activity の予算対実績を集計する
+// Activity は UseCase 経由で Objective に集計し、予算を持つ項目のうち実績 + 契約済みが予算を超えたものを超過とする
+func (z *Zeus) Budget(ctx context.Context) (*BudgetReport, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	result := &BudgetReport{Objectives: []BudgetLine{}, Unassigned: []BudgetLine{}, Overruns: []BudgetLine{}}
+	if config, err := z.LoadConfig(ctx); err == nil {
+		result.Currency = config.Costs.Currency
+	}
+
+	usecaseObjective := map[string]string{}
+	z.forEachYaml(ctx, "usecases", func(path string) {
+		var uc UseCaseEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &uc); err == nil {
+			usecaseObjective[uc.ID] = uc.ObjectiveID
+		}
+	})
+	byObjective := map[string][]BudgetLine{}
+	z.forEachYaml(ctx, "activities", func(path string) {
+		var act ActivityEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &act); err != nil || act.Cost == nil {
+			return
+		}
+		line := newBudgetLine("activity", act.ID, act.Title, act.Cost, nil)
+		if line.Overrun {
+			result.Overruns = append(result.Overruns, line)
+		}
+		objID := usecaseObjective[act.UseCaseID]
+		byObjective[objID] = append(byObjective[objID], line)
+	})
+
+	objectives := map[string]bool{}
+	z.forEachYaml(ctx, "objectives", func(path string) {
+		var obj ObjectiveEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &obj); err != nil {
+			return
+		}
+		objectives[obj.ID] = true
+		children := byObjective[obj.ID]
+		if obj.Cost == nil && len(children) == 0 {
+			return
+		}
+		sortBudgetLines(children)
+		line := newBudgetLine("objective", obj.ID, obj.Title, obj.Cost, children)
+		if line.Overrun {
+			result.Overruns = append(result.Overruns, line)
+		}
+		result.Objectives = append(result.Objectives, line)
+		result.Total = result.Total.add(&line.Total)
+	})
+	for objID, lines := range byObjective {
+		if objID != "" && objectives[objID] {
+			continue
+		}
+		for _, line := range lines {
+			result.Unassigned = append(result.Unassigned, line)
+			result.Total = result.Total.add(&line.Cost)
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sortBudgetLines(result.Objectives)
+	sortBudgetLines(result.Unassigned)
+	sort.SliceStable(result.Overruns, func(i, j int) bool {
+		if result.Overruns[i].Variance != result.Overruns[j].Variance {
+			return result.Overruns[i].Variance < result.Overruns[j].Variance
+		}
+		return result.Overruns[i].ID < result.Overruns[j].ID
+	})
+	result.Variance = result.Total.Budgeted - result.Total.Exposure()
+	return result, nil
+}
+
+// sortBudgetLines は ID 順に並べる
+func sortBudgetLines(lines []BudgetLine) {
+	sort.Slice(lines, func(i, j int) bool { return lines[i].ID < lines[j].ID })
+}
+
+// FormatAmount は金額を通貨コード付きで整形（通貨未設定の場合は数値のみ）
+func FormatAmount(amount float64, currency string) string {
+	s := strconv.FormatFloat(amount, 'f', -1, 64)
+	if currency == "" {
+		return s
+	}
+	return s + " " + currency
+}
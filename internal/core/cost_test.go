@@ -0,0 +1,115 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/report"
+)
+
+func TestParseCost(t *testing.T) {
+	cost, err := ParseCost("budgeted=1000, actual=250.5")
+	if err != nil {
+		t.Fatalf("ParseCost failed: %v", err)
+	}
+	if cost.Budgeted != 1000 || cost.Committed != 0 || cost.Actual != 250.5 {
+		t.Errorf("cost = %+v", cost)
+	}
+	for _, spec := range []string{"budgeted", "budget=1", "actual=x", "committed=-1"} {
+		if _, err := ParseCost(spec); err == nil {
+			t.Errorf("ParseCost(%q) should fail", spec)
+		}
+	}
+}
+
+func TestBudget(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	add := func(entity, title string, opts ...EntityOption) string {
+		t.Helper()
+		result, err := z.Add(ctx, entity, title, opts...)
+		if err != nil {
+			t.Fatalf("Add %s failed: %v", title, err)
+		}
+		return result.ID
+	}
+	obj := add("objective", "決済刷新", WithCost(&Cost{Budgeted: 1000, Actual: 100}))
+	uc := add("usecase", "決済する", WithUseCaseObjective(obj))
+	over := add("activity", "決済 API", WithActivityUseCase(uc), WithCost(&Cost{Budgeted: 300, Committed: 200, Actual: 200}))
+	add("activity", "画面", WithActivityUseCase(uc), WithCost(&Cost{Budgeted: 200, Actual: 50}))
+	single := add("activity", "単独", WithCost(&Cost{Budgeted: 100, Committed: 30}))
+	add("activity", "コストなし")
+	add("objective", "コストなし")
+	if _, err := z.Add(ctx, "objective", "不正", WithCost(&Cost{Budgeted: -1})); err == nil {
+		t.Error("negative cost should fail")
+	}
+
+	budget, err := z.Budget(ctx)
+	if err != nil {
+		t.Fatalf("Budget failed: %v", err)
+	}
+	if len(budget.Objectives) != 1 || len(budget.Objectives[0].Activities) != 2 {
+		t.Fatalf("objectives = %+v", budget.Objectives)
+	}
+	line := budget.Objectives[0]
+	if line.Total != (Cost{Budgeted: 1500, Committed: 200, Actual: 350}) || line.Variance != 950 || line.Utilization != 36 || line.Overrun {
+		t.Errorf("objective line = %+v", line)
+	}
+	if len(budget.Unassigned) != 1 || budget.Unassigned[0].ID != single {
+		t.Errorf("unassigned = %+v", budget.Unassigned)
+	}
+	if budget.Total != (Cost{Budgeted: 1600, Committed: 230, Actual: 350}) || budget.Variance != 1020 {
+		t.Errorf("total = %+v, variance = %v", budget.Total, budget.Variance)
+	}
+	if len(budget.Overruns) != 1 || budget.Overruns[0].ID != over || budget.Overruns[0].Variance != -100 {
+		t.Errorf("overruns = %+v", budget.Overruns)
+	}
+
+	// 予算超過はレポートのセクションとポートフォリオのボトルネックに出力する
+	sections := z.buildReportSections(ctx, []string{report.SectionBudget}, time.Now())
+	if len(sections) != 1 || len(sections[0].Items) != 3 || sections[0].Items[0].Status != "over budget" || len(sections[0].Summary) != 2 {
+		t.Errorf("budget section = %+v", sections)
+	}
+	portfolio, err := BuildPortfolio(ctx, []PortfolioMember{{Name: "p", Zeus: z}})
+	if err != nil {
+		t.Fatalf("BuildPortfolio failed: %v", err)
+	}
+	if len(portfolio.Bottlenecks) != 1 || portfolio.Bottlenecks[0].Kind != "budget" || portfolio.Bottlenecks[0].Severity != string(ProblemSeverityMedium) {
+		t.Errorf("bottlenecks = %+v", portfolio.Bottlenecks)
+	}
+}
+
+func TestUpdateEntity_ActivityCost(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	added, err := z.Add(ctx, "activity", "Build")
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	revision, err := z.EntityRevision(ctx, "activity", added.ID)
+	if err != nil {
+		t.Fatalf("EntityRevision failed: %v", err)
+	}
+	fields, _ := ParseFieldAssignments([]string{"cost.budgeted=500", "cost.actual=120"})
+	if _, err := z.UpdateEntity(ctx, "activity", added.ID, fields, revision); err != nil {
+		t.Fatalf("UpdateEntity failed: %v", err)
+	}
+	got, _ := z.Get(ctx, "activity", added.ID)
+	if act := got.(*ActivityEntity); act.Cost == nil || act.Cost.Budgeted != 500 || act.Cost.Actual != 120 {
+		t.Errorf("cost should be updated: %+v", act.Cost)
+	}
+
+	revision, _ = z.EntityRevision(ctx, "activity", added.ID)
+	fields, _ = ParseFieldAssignments([]string{"cost.actual=-1"})
+	if _, err := z.UpdateEntity(ctx, "activity", added.ID, fields, revision); err == nil || !strings.Contains(err.Error(), "cost") {
+		t.Errorf("negative cost should fail validation: %v", err)
+	}
+}
@@ -0,0 +1,36 @@
+package core
+
+import (
+	"context"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+)
+
+// AnalyzeCoverage は UseCase と Activity の紐づけのカバレッジを分析
+// 有効な UseCase に Activity が無いもの、UseCase に紐づいていない Activity を警告として返す
+func (z *Zeus) AnalyzeCoverage(ctx context.Context) (*analysis.CoverageAnalysis, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	usecases := []UseCaseEntity{}
+	z.forEachYaml(ctx, "usecases", func(path string) {
+		var uc UseCaseEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &uc); err == nil {
+			usecases = append(usecases, uc)
+		}
+	})
+
+	activities := []ActivityEntity{}
+	z.forEachYaml(ctx, "activities", func(path string) {
+		var act ActivityEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &act); err == nil {
+			activities = append(activities, act)
+		}
+	})
+
+	return analysis.NewCoverageAnalyzer(nil, nil).
+		WithUseCases(toAnalysisUseCaseInfo(usecases)).
+		WithActivities(toAnalysisActivityInfo(activities)).
+		Analyze(ctx)
+}
@@ -0,0 +1,47 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+)
+
+func TestAnalyzeCoverage(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	add := func(entity, title string, opts ...EntityOption) string {
+		t.Helper()
+		result, err := z.Add(ctx, entity, title, opts...)
+		if err != nil {
+			t.Fatalf("Add %s failed: %v", title, err)
+		}
+		return result.ID
+	}
+	obj := add("objective", "認証基盤")
+	covered := add("usecase", "ログイン", WithUseCaseObjective(obj), WithUseCaseStatus(UseCaseStatusActive))
+	uncovered := add("usecase", "ログアウト", WithUseCaseObjective(obj), WithUseCaseStatus(UseCaseStatusActive))
+	add("usecase", "検討中", WithUseCaseObjective(obj), WithUseCaseStatus(UseCaseStatusDraft))
+	add("activity", "認証フロー", WithActivityUseCase(covered))
+	untraced := add("activity", "未分類")
+
+	result, err := z.AnalyzeCoverage(ctx)
+	if err != nil {
+		t.Fatalf("AnalyzeCoverage failed: %v", err)
+	}
+
+	got := map[string]analysis.CoverageIssueType{}
+	for _, issue := range result.Issues {
+		got[issue.EntityID] = issue.Type
+	}
+	if len(got) != 2 || got[uncovered] != analysis.CoverageIssueUseCaseNoActivities || got[untraced] != analysis.CoverageIssueActivityUntraced {
+		t.Errorf("unexpected issues: %+v", result.Issues)
+	}
+	if result.UseCasesCovered != 1 || result.UseCasesActive != 2 {
+		t.Errorf("expected 1/2 usecases covered, got %d/%d", result.UseCasesCovered, result.UseCasesActive)
+	}
+}
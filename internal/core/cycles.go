@@ -0,0 +1,75 @@
+package core
+
+import (
+	"context"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+)
+
+// ExplainDependencyCycles は依存関係（UseCase の include / extend / generalize、
+// Container / Component の関係）の循環を検出し、各循環の図と解消のために外す依存を提案する
+// extend は任意の拡張のため soft な依存として扱い、依存の追加日時には参照元の更新日時を使う
+func (z *Zeus) ExplainDependencyCycles(ctx context.Context) (*analysis.CycleReport, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	edges := []analysis.DependencyEdge{}
+	titles := make(map[string]string)
+
+	z.forEachYaml(ctx, "usecases", func(path string) {
+		var uc UseCaseEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &uc); err != nil {
+			return
+		}
+		titles[uc.ID] = uc.Title
+		for _, rel := range uc.Relations {
+			edges = append(edges, analysis.DependencyEdge{
+				From:     uc.ID,
+				To:       rel.TargetID,
+				Relation: string(rel.Type),
+				Soft:     rel.Type == RelationTypeExtend,
+				AddedAt:  uc.Metadata.UpdatedAt,
+			})
+		}
+	})
+
+	var containers ContainersFile
+	if z.fileStore.Exists(ctx, containersFileName) {
+		if err := z.fileStore.ReadYaml(ctx, containersFileName, &containers); err != nil {
+			return nil, err
+		}
+	}
+	for _, c := range containers.Containers {
+		titles[c.ID] = c.Name
+		edges = append(edges, architectureDependencyEdges(c.ID, c.Relations, c.Metadata)...)
+	}
+	var components ComponentsFile
+	if z.fileStore.Exists(ctx, componentsFileName) {
+		if err := z.fileStore.ReadYaml(ctx, componentsFileName, &components); err != nil {
+			return nil, err
+		}
+	}
+	for _, c := range components.Components {
+		titles[c.ID] = c.Name
+		edges = append(edges, architectureDependencyEdges(c.ID, c.Relations, c.Metadata)...)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return analysis.ExplainCycles(edges, titles), nil
+}
+
+// architectureDependencyEdges は Container / Component の関係を依存関係に変換（ラベルが無い場合は "uses"）
+func architectureDependencyEdges(id string, relations []ArchitectureRelation, metadata Metadata) []analysis.DependencyEdge {
+	edges := make([]analysis.DependencyEdge, 0, len(relations))
+	for _, rel := range relations {
+		label := rel.Label
+		if label == "" {
+			label = "uses"
+		}
+		edges = append(edges, analysis.DependencyEdge{From: id, To: rel.TargetID, Relation: label, AddedAt: metadata.UpdatedAt})
+	}
+	return edges
+}
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"context"
+	"testing"
+)
+
+func TestExplainDependencyCycles(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	obj, err := z.Add(ctx, "objective", "目標")
+	if err != nil {
+		t.Fatalf("Add objective failed: %v", err)
+	}
+	ids := []string{}
+	for _, title := range []string{"注文する", "決済する"} {
+		result, err := z.Add(ctx, "usecase", title, WithUseCaseObjective(obj.ID))
+		if err != nil {
+			t.Fatalf("Add usecase failed: %v", err)
+		}
+		ids = append(ids, result.ID)
+	}
+
+	report, err := z.ExplainDependencyCycles(ctx)
+	if err != nil {
+		t.Fatalf("ExplainDependencyCycles failed: %v", err)
+	}
+	if len(report.Cycles) != 0 {
+		t.Fatalf("expected no cycles, got %+v", report.Cycles)
+	}
+
+	handler, _ := z.GetRegistry().Get("usecase")
+	ucHandler := handler.(*UseCaseHandler)
+	if err := ucHandler.AddRelation(ctx, ids[0], UseCaseRelation{Type: RelationTypeInclude, TargetID: ids[1]}); err != nil {
+		t.Fatalf("AddRelation failed: %v", err)
+	}
+	if err := ucHandler.AddRelation(ctx, ids[1], UseCaseRelation{Type: RelationTypeExtend, TargetID: ids[0], ExtensionPoint: "割引"}); err != nil {
+		t.Fatalf("AddRelation failed: %v", err)
+	}
+
+	report, err = z.ExplainDependencyCycles(ctx)
+	if err != nil {
+		t.Fatalf("ExplainDependencyCycles failed: %v", err)
+	}
+	if len(report.Cycles) != 1 || len(report.Break) != 1 {
+		t.Fatalf("expected 1 cycle, got %+v", report)
+	}
+	if b := report.Break[0]; b.From != ids[1] || b.Relation != "extend" || !b.Soft {
+		t.Errorf("extend relation should be suggested to break, got %+v", b)
+	}
+}
@@ -74,6 +74,11 @@ func (h *DecisionHandler) Add(ctx context.Context, name string, opts ...EntityOp
 	if err := decision.Validate(); err != nil {
 		return nil, err
 	}
+	if decision.Supersedes != "" {
+		if err := h.validateSupersedes(ctx, decision.Supersedes); err != nil {
+			return nil, err
+		}
+	}
 
 	// ファイル書き込み
 	filePath := filepath.Join("decisions", id+".yaml")
@@ -150,7 +155,7 @@ func (h *DecisionHandler) Update(ctx context.Context, id string, update any) err
 
 // Delete は Decision を削除（イミュータブルのため常にエラー）
 // Decision は一度作成されると変更・削除ができません。
-// 誤った Decision がある場合は、新しい Consideration を作成して正しい Decision を記録してください。
+// 決定を覆す場合は、supersedes で以前の Decision を参照する新しい Decision を記録してください（zeus decision supersede）。
 func (h *DecisionHandler) Delete(ctx context.Context, id string) error {
 	return fmt.Errorf("decision is immutable: cannot delete decision %s (decisions are permanent records)", id)
 }
@@ -212,6 +217,27 @@ func (h *DecisionHandler) validateConsiderationReference(ctx context.Context, co
 	return err
 }
 
+// validateSupersedes は置き換え対象の Decision が存在し、まだ置き換えられていないことを確認
+// 置き換えの連鎖を一本道に保つため、置き換え済みの Decision は最新の Decision で置き換える
+func (h *DecisionHandler) validateSupersedes(ctx context.Context, id string) error {
+	if _, err := h.Get(ctx, id); err != nil {
+		if err == ErrEntityNotFound {
+			return fmt.Errorf("superseded decision not found: %s", id)
+		}
+		return err
+	}
+	decisions, err := h.getAllDecisions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, d := range decisions {
+		if d.Supersedes == id {
+			return fmt.Errorf("%w: %s is already superseded by %s", ErrDecisionSuperseded, id, d.ID)
+		}
+	}
+	return nil
+}
+
 // Decision オプション関数
 
 // WithDecisionConsideration は Decision の Consideration を設定
@@ -259,6 +285,15 @@ func WithDecisionImpact(impact []string) EntityOption {
 	}
 }
 
+// WithDecisionSupersedes は Decision が置き換える以前の Decision を設定
+func WithDecisionSupersedes(decisionID string) EntityOption {
+	return func(v any) {
+		if dec, ok := v.(*DecisionEntity); ok {
+			dec.Supersedes = decisionID
+		}
+	}
+}
+
 // WithDecisionDecidedBy は Decision の決定者を設定
 func WithDecisionDecidedBy(decidedBy string) EntityOption {
 	return func(v any) {
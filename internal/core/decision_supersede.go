@@ -0,0 +1,84 @@
+package core
+
+import (
+	"context"
+	"fmt"
+)
+
+// SupersedeDecision は以前の Decision を覆す新しい Decision を記録する
+// 以前の Decision は変更せず、新しい Decision の supersedes で参照する
+// Consideration の指定が無い場合は以前の Decision と同じ Consideration に紐づけ、その decision_id を新しい Decision に更新する
+// title が空の場合は以前の Decision のタイトルを引き継ぐ
+func (z *Zeus) SupersedeDecision(ctx context.Context, id, title string, opts ...EntityOption) (*AddResult, error) {
+	entity, err := z.Get(ctx, "decision", id)
+	if err != nil {
+		return nil, err
+	}
+	old := entity.(*DecisionEntity)
+
+	supersededBy, err := z.DecisionSupersededBy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if next, ok := supersededBy[id]; ok {
+		return nil, fmt.Errorf("%w: %s is already superseded by %s", ErrDecisionSuperseded, id, next)
+	}
+
+	if title == "" {
+		title = old.Title
+	}
+	all := append([]EntityOption{WithDecisionConsideration(old.ConsiderationID)}, opts...)
+	all = append(all, WithDecisionSupersedes(id))
+	return z.Add(ctx, "decision", title, all...)
+}
+
+// DecisionSupersededBy は置き換えられた Decision の ID → 置き換えた Decision の ID
+func (z *Zeus) DecisionSupersededBy(ctx context.Context) (map[string]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	supersededBy := map[string]string{}
+	z.forEachYaml(ctx, "decisions", func(path string) {
+		var d DecisionEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &d); err == nil && d.Supersedes != "" {
+			supersededBy[d.Supersedes] = d.ID
+		}
+	})
+	return supersededBy, ctx.Err()
+}
+
+// DecisionChain は Decision を含む置き換えの連鎖を古い順に返す（置き換えが無い場合は自身のみ）
+// 存在しない Decision を参照している場合はその手前で打ち切る
+func (z *Zeus) DecisionChain(ctx context.Context, id string) ([]*DecisionEntity, error) {
+	entity, err := z.Get(ctx, "decision", id)
+	if err != nil {
+		return nil, err
+	}
+	supersededBy, err := z.DecisionSupersededBy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 手作業の編集による循環に備え、訪問済みの Decision で打ち切る
+	seen := map[string]bool{id: true}
+	chain := []*DecisionEntity{entity.(*DecisionEntity)}
+	for prev := chain[0].Supersedes; prev != "" && !seen[prev]; {
+		seen[prev] = true
+		e, err := z.Get(ctx, "decision", prev)
+		if err != nil {
+			break
+		}
+		d := e.(*DecisionEntity)
+		chain = append([]*DecisionEntity{d}, chain...)
+		prev = d.Supersedes
+	}
+	for next := supersededBy[id]; next != "" && !seen[next]; next = supersededBy[next] {
+		seen[next] = true
+		e, err := z.Get(ctx, "decision", next)
+		if err != nil {
+			break
+		}
+		chain = append(chain, e.(*DecisionEntity))
+	}
+	return chain, nil
+}
@@ -0,0 +1,117 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSupersedeDecision(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	obj, err := z.Add(ctx, "objective", "認証刷新")
+	if err != nil {
+		t.Fatalf("Add objective failed: %v", err)
+	}
+	con, err := z.Add(ctx, "consideration", "認証方式の選定", WithConsiderationObjective(obj.ID))
+	if err != nil {
+		t.Fatalf("Add consideration failed: %v", err)
+	}
+	first, err := z.Add(ctx, "decision", "JWT を採用",
+		WithDecisionConsideration(con.ID),
+		WithDecisionSelected(SelectedOption{OptionID: "opt-1", Title: "JWT"}),
+		WithDecisionRationale("実装が容易"))
+	if err != nil {
+		t.Fatalf("Add decision failed: %v", err)
+	}
+
+	second, err := z.SupersedeDecision(ctx, first.ID, "",
+		WithDecisionSelected(SelectedOption{OptionID: "opt-2", Title: "OAuth"}),
+		WithDecisionRationale("外部 IdP 連携が必須になった"))
+	if err != nil {
+		t.Fatalf("SupersedeDecision failed: %v", err)
+	}
+
+	// 新しい Decision は以前の Decision を参照し、タイトルと Consideration を引き継ぐ
+	entity, err := z.Get(ctx, "decision", second.ID)
+	if err != nil {
+		t.Fatalf("Get decision failed: %v", err)
+	}
+	dec := entity.(*DecisionEntity)
+	if dec.Supersedes != first.ID || dec.Title != "JWT を採用" || dec.ConsiderationID != con.ID {
+		t.Errorf("superseding decision = %+v", dec)
+	}
+
+	// 以前の Decision は変更されない
+	entity, err = z.Get(ctx, "decision", first.ID)
+	if err != nil {
+		t.Fatalf("Get decision failed: %v", err)
+	}
+	if old := entity.(*DecisionEntity); old.Supersedes != "" || old.Selected.Title != "JWT" {
+		t.Errorf("superseded decision was modified: %+v", old)
+	}
+
+	// Consideration は新しい Decision を指す
+	entity, err = z.Get(ctx, "consideration", con.ID)
+	if err != nil {
+		t.Fatalf("Get consideration failed: %v", err)
+	}
+	if c := entity.(*ConsiderationEntity); c.DecisionID != second.ID {
+		t.Errorf("consideration decision_id = %s, want %s", c.DecisionID, second.ID)
+	}
+
+	// 置き換え済みの Decision は再度置き換えられない
+	_, err = z.SupersedeDecision(ctx, first.ID, "",
+		WithDecisionSelected(SelectedOption{OptionID: "opt-3", Title: "SAML"}),
+		WithDecisionRationale("再検討"))
+	if !errors.Is(err, ErrDecisionSuperseded) {
+		t.Errorf("SupersedeDecision on superseded decision error = %v, want ErrDecisionSuperseded", err)
+	}
+	if _, err := z.SupersedeDecision(ctx, "dec-00000000", "", WithDecisionRationale("x")); err == nil {
+		t.Error("SupersedeDecision on missing decision should fail")
+	}
+
+	third, err := z.SupersedeDecision(ctx, second.ID, "SAML を採用",
+		WithDecisionSelected(SelectedOption{OptionID: "opt-3", Title: "SAML"}),
+		WithDecisionRationale("顧客要件"))
+	if err != nil {
+		t.Fatalf("SupersedeDecision failed: %v", err)
+	}
+
+	// 連鎖はどの Decision から辿っても古い順
+	for _, id := range []string{first.ID, second.ID, third.ID} {
+		chain, err := z.DecisionChain(ctx, id)
+		if err != nil {
+			t.Fatalf("DecisionChain(%s) failed: %v", id, err)
+		}
+		if len(chain) != 3 || chain[0].ID != first.ID || chain[1].ID != second.ID || chain[2].ID != third.ID {
+			ids := []string{}
+			for _, d := range chain {
+				ids = append(ids, d.ID)
+			}
+			t.Errorf("DecisionChain(%s) = %v", id, ids)
+		}
+	}
+
+	// 関係グラフでは置き換えられた Decision が superseded になる
+	graph, err := z.BuildRelationGraph(ctx)
+	if err != nil {
+		t.Fatalf("BuildRelationGraph failed: %v", err)
+	}
+	if graph.Nodes[first.ID].Status != "superseded" || graph.Nodes[second.ID].Status != "superseded" || graph.Nodes[third.ID].Status != "" {
+		t.Errorf("decision statuses = %q, %q, %q", graph.Nodes[first.ID].Status, graph.Nodes[second.ID].Status, graph.Nodes[third.ID].Status)
+	}
+	found := false
+	for _, e := range graph.Edges {
+		if e.From == third.ID && e.To == second.ID && e.Relation == "supersedes" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("relation graph should have a supersedes edge")
+	}
+}
@@ -0,0 +1,477 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DeletePolicy は削除するエンティティを参照しているエンティティ（参照元）の扱い
+type DeletePolicy string
+
+const (
+	DeleteRestrict DeletePolicy = "restrict" // 参照元がある場合は削除しない
+	DeleteCascade  DeletePolicy = "cascade"  // 参照元も削除する
+	DeleteNullify  DeletePolicy = "nullify"  // 参照元から参照を外す
+)
+
+// ErrDeleteRestricted は restrict の参照元があるため削除できない
+var ErrDeleteRestricted = errors.New("delete restricted by references")
+
+// ReferenceKind は削除ポリシーを適用する参照の種類
+type ReferenceKind struct {
+	Key      string       // zeus.yaml の delete_policies のキー（<参照元>.<フィールド>）
+	Source   string       // 参照元のエンティティ種別
+	Target   string       // 参照先のエンティティ種別
+	List     string       // 参照が配列の要素にある場合の配列のフィールド名
+	Field    string       // 参照先 ID のフィールド名（List 指定時に空の場合は配列の要素そのものが ID）
+	Required bool         // 必須の参照（nullify できない）
+	DropItem bool         // nullify で配列の要素ごと取り除く（要素が参照のためだけにある場合。要素そのものが ID の場合は常に取り除く）
+	Default  DeletePolicy // zeus.yaml で指定しない場合のポリシー
+}
+
+// referenceKinds は削除ポリシーを適用する参照の一覧
+// Component の所属コンテナと Container / Component 間の関係は各ハンドラーが削除時に参照元を確認する（restrict 相当）
+var referenceKinds = []ReferenceKind{
+	{Key: "consideration.objective_id", Source: "consideration", Target: "objective", Field: "objective_id", Default: DeleteNullify},
+	{Key: "decision.consideration_id", Source: "decision", Target: "consideration", Field: "consideration_id", Required: true, Default: DeleteRestrict},
+	{Key: "problem.objective_id", Source: "problem", Target: "objective", Field: "objective_id", Default: DeleteNullify},
+	{Key: "risk.objective_id", Source: "risk", Target: "objective", Field: "objective_id", Default: DeleteNullify},
+	{Key: "assumption.objective_id", Source: "assumption", Target: "objective", Field: "objective_id", Default: DeleteNullify},
+	{Key: "quality.objective_id", Source: "quality", Target: "objective", Field: "objective_id", Required: true, Default: DeleteRestrict},
+	{Key: "usecase.objective_id", Source: "usecase", Target: "objective", Field: "objective_id", Required: true, Default: DeleteRestrict},
+	{Key: "usecase.subsystem_id", Source: "usecase", Target: "subsystem", Field: "subsystem_id", Default: DeleteNullify},
+	{Key: "usecase.actors.actor_id", Source: "usecase", Target: "actor", List: "actors", Field: "actor_id", DropItem: true, Default: DeleteNullify},
+	{Key: "usecase.relations.target_id", Source: "usecase", Target: "usecase", List: "relations", Field: "target_id", DropItem: true, Default: DeleteNullify},
+	{Key: "activity.usecase_id", Source: "activity", Target: "usecase", Field: "usecase_id", Default: DeleteNullify},
+	{Key: "statemachine.usecase_id", Source: "statemachine", Target: "usecase", Field: "usecase_id", Default: DeleteNullify},
+	{Key: "statemachine.states.activity_id", Source: "statemachine", Target: "activity", List: "states", Field: "activity_id", Default: DeleteNullify},
+	{Key: "container.subsystem_id", Source: "container", Target: "subsystem", Field: "subsystem_id", Default: DeleteNullify},
+	{Key: "release.usecase_ids", Source: "release", Target: "usecase", List: "usecase_ids", Default: DeleteNullify},
+	{Key: "release.activity_ids", Source: "release", Target: "activity", List: "activity_ids", Default: DeleteNullify},
+	{Key: "release.quality_ids", Source: "release", Target: "quality", List: "quality_ids", Default: DeleteNullify},
+}
+
+// singleFileSources は単一ファイルで管理する参照元のファイル名と、エンティティを並べる配列のキー
+var singleFileSources = map[string]struct{ file, list string }{
+	"container": {containersFileName, "containers"},
+}
+
+// ReferenceKinds は削除ポリシーを適用する参照の一覧を返す
+func ReferenceKinds() []ReferenceKind {
+	return append([]ReferenceKind(nil), referenceKinds...)
+}
+
+// ValidateDeletePolicies は zeus.yaml の delete_policies の妥当性を検証
+func ValidateDeletePolicies(policies map[string]DeletePolicy) error {
+	for key, policy := range policies {
+		kind := findReferenceKind(key)
+		if kind == nil {
+			return fmt.Errorf("delete_policies: unknown reference %q", key)
+		}
+		switch policy {
+		case DeleteRestrict, DeleteCascade:
+		case DeleteNullify:
+			if kind.Required {
+				return fmt.Errorf("delete_policies.%s: nullify is not allowed for a required reference", key)
+			}
+		default:
+			return fmt.Errorf("delete_policies.%s: unknown policy %q (restrict, cascade, nullify)", key, policy)
+		}
+	}
+	return nil
+}
+
+// findReferenceKind はキーに一致する参照の種類を返す
+func findReferenceKind(key string) *ReferenceKind {
+	for i := range referenceKinds {
+		if referenceKinds[i].Key == key {
+			return &referenceKinds[i]
+		}
+	}
+	return nil
+}
+
+// DeleteOptions は削除のオプション
+type DeleteOptions struct {
+	Cascade bool // restrict の参照元も連鎖して削除する（不変の Decision を除く）
+}
+
+// DeletePlan は削除の影響範囲
+type DeletePlan struct {
+	Entity     string         `json:"entity"`
+	ID         string         `json:"id"`
+	Cascade    []DeleteEffect `json:"cascade"`    // 連鎖して削除するエンティティ（見つけた順）
+	Nullify    []DeleteEffect `json:"nullify"`    // 参照を外すエンティティ
+	Restricted []DeleteEffect `json:"restricted"` // 削除を妨げる参照元
+}
+
+// DeleteEffect は削除による参照元 1 件への影響
+type DeleteEffect struct {
+	Entity    string `json:"entity"` // 参照元のエンティティ種別
+	ID        string `json:"id"`
+	Title     string `json:"title,omitempty"`
+	Reference string `json:"reference"` // 参照の種類（ReferenceKind.Key）
+	Target    string `json:"target"`    // 参照先の ID
+	path      string
+}
+
+// DeleteRestrictedError は restrict の参照元があるため削除できないエラー
+type DeleteRestrictedError struct {
+	Plan *DeletePlan
+}
+
+// Error は error インターフェースを実装
+func (e *DeleteRestrictedError) Error() string {
+	refs := make([]string, 0, len(e.Plan.Restricted))
+	for _, r := range e.Plan.Restricted {
+		refs = append(refs, fmt.Sprintf("%s %s (%s)", r.Entity, r.ID, r.Reference))
+	}
+	return fmt.Sprintf("cannot delete %s %s: referenced by %s", e.Plan.Entity, e.Plan.ID, strings.Join(refs, ", "))
+}
+
+// Unwrap は ErrDeleteRestricted を返す
+func (e *DeleteRestrictedError) Unwrap() error {
+	return ErrDeleteRestricted
+}
+
+// entityReference は参照元のファイルにある参照 1 件
+type entityReference struct {
+	kind   *ReferenceKind
+	source string
+	title  string
+	path   string
+}
+
+// PlanDelete はエンティティを削除した場合の影響範囲（連鎖削除・参照の解除・削除を妨げる参照元）を返す
+// 参照の種類ごとのポリシーは zeus.yaml の delete_policies で上書きでき、opts.Cascade は restrict を cascade として扱う
+func (z *Zeus) PlanDelete(ctx context.Context, entity, id string, opts DeleteOptions) (*DeletePlan, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	handler, ok := z.entityRegistry.Get(entity)
+	if !ok {
+		return nil, ErrUnknownEntity
+	}
+	if _, err := handler.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	policies := z.approvalConfig(ctx).DeletePolicies
+	if err := ValidateDeletePolicies(policies); err != nil {
+		return nil, err
+	}
+	index, err := z.referenceIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &DeletePlan{Entity: entity, ID: id, Cascade: []DeleteEffect{}, Nullify: []DeleteEffect{}, Restricted: []DeleteEffect{}}
+	deleted := map[string]bool{id: true}
+	type target struct{ entity, id string }
+	queue := []target{{entity, id}}
+	for len(queue) > 0 {
+		t := queue[0]
+		queue = queue[1:]
+		for _, ref := range index[t.id] {
+			if ref.kind.Target != t.entity || deleted[ref.source] {
+				continue
+			}
+			policy := ref.kind.Default
+			if p, ok := policies[ref.kind.Key]; ok {
+				policy = p
+			}
+			if policy == DeleteRestrict && opts.Cascade {
+				policy = DeleteCascade
+			}
+			// Decision は不変のため連鎖して削除できない
+			if policy == DeleteCascade && ref.kind.Source == "decision" {
+				policy = DeleteRestrict
+			}
+
+			effect := DeleteEffect{Entity: ref.kind.Source, ID: ref.source, Title: ref.title, Reference: ref.kind.Key, Target: t.id, path: ref.path}
+			switch policy {
+			case DeleteCascade:
+				deleted[ref.source] = true
+				plan.Cascade = append(plan.Cascade, effect)
+				queue = append(queue, target{ref.kind.Source, ref.source})
+			case DeleteNullify:
+				plan.Nullify = append(plan.Nullify, effect)
+			default:
+				plan.Restricted = append(plan.Restricted, effect)
+			}
+		}
+	}
+
+	// 連鎖して削除する参照元は、参照を外す・削除を妨げる対象から除く
+	plan.Nullify = withoutDeleted(plan.Nullify, deleted)
+	plan.Restricted = withoutDeleted(plan.Restricted, deleted)
+	return plan, nil
+}
+
+// withoutDeleted は削除するエンティティへの影響を除く
+func withoutDeleted(effects []DeleteEffect, deleted map[string]bool) []DeleteEffect {
+	kept := effects[:0]
+	for _, e := range effects {
+		if !deleted[e.ID] {
+			kept = append(kept, e)
+		}
+	}
+	return kept
+}
+
+// applyDeletePlan は参照を外し、連鎖して削除するエンティティを後から見つけたものから順に削除する（削除対象そのものは含まない）
+func (z *Zeus) applyDeletePlan(ctx context.Context, plan *DeletePlan) error {
+	if len(plan.Restricted) > 0 {
+		return &DeleteRestrictedError{Plan: plan}
+	}
+	for _, e := range plan.Nullify {
+		if err := z.nullifyReference(ctx, e); err != nil {
+			return fmt.Errorf("failed to remove reference %s from %s: %w", e.Reference, e.ID, err)
+		}
+	}
+	for i := len(plan.Cascade) - 1; i >= 0; i-- {
+		e := plan.Cascade[i]
+		handler, ok := z.entityRegistry.Get(e.Entity)
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownEntity, e.Entity)
+		}
+		if err := handler.Delete(ctx, e.ID); err != nil {
+			return fmt.Errorf("failed to delete %s %s: %w", e.Entity, e.ID, err)
+		}
+	}
+	return nil
+}
+
+// relatedEntities は削除対象以外に変更するエンティティ（参照を外す update、連鎖する delete）を重複なく返す
+func (p *DeletePlan) relatedEntities() []ApprovalEntity {
+	var related []ApprovalEntity
+	seen := map[string]bool{}
+	add := func(effects []DeleteEffect, op string) {
+		for _, e := range effects {
+			if !seen[e.ID] {
+				seen[e.ID] = true
+				related = append(related, ApprovalEntity{Type: e.Entity, ID: e.ID, Op: op})
+			}
+		}
+	}
+	add(p.Nullify, ChangeOpUpdate)
+	add(p.Cascade, ChangeOpDelete)
+	return related
+}
+
+// deletePlanApprovalLevel は削除対象と、参照を外す・連鎖して削除するすべてのエンティティに
+// 操作ごとの承認レベルとエージェントのガードレールを適用し、1 件でも承認が必要なら ApprovalApprove を返す
+func (z *Zeus) deletePlanApprovalLevel(ctx context.Context, config *ZeusConfig, plan *DeletePlan) (ApprovalLevel, error) {
+	level, err := z.operationApprovalLevel(ctx, config, plan.Entity, ChangeOpDelete, plan.ID)
+	if err != nil {
+		return "", err
+	}
+	for _, r := range plan.relatedEntities() {
+		l, err := z.operationApprovalLevel(ctx, config, r.Type, r.Op, r.ID)
+		if err != nil {
+			return "", err
+		}
+		if l == ApprovalApprove {
+			level = ApprovalApprove
+		}
+	}
+	return level, nil
+}
+
+// auditDeletePlan は反映した削除（参照の解除・連鎖削除・削除対象）を監査ログに記録する
+func (z *Zeus) auditDeletePlan(ctx context.Context, plan *DeletePlan) {
+	for _, r := range plan.relatedEntities() {
+		z.audit(ctx, AuditEntry{Op: r.Op, Entity: r.Type, ID: r.ID, Result: AuditResultApplied})
+	}
+	z.audit(ctx, AuditEntry{Op: ChangeOpDelete, Entity: plan.Entity, ID: plan.ID, Result: AuditResultApplied})
+}
+
+// referenceIndex は参照先 ID ごとの参照元の一覧を返す
+func (z *Zeus) referenceIndex(ctx context.Context) (map[string][]entityReference, error) {
+	index := map[string][]entityReference{}
+	sources := map[string][]*ReferenceKind{}
+	for i := range referenceKinds {
+		kind := &referenceKinds[i]
+		sources[kind.Source] = append(sources[kind.Source], kind)
+	}
+
+	types := make([]string, 0, len(sources))
+	for source := range sources {
+		types = append(types, source)
+	}
+	sort.Strings(types)
+	for _, source := range types {
+		collect := func(root *yaml.Node, path string) {
+			sourceID := yamlScalar(root, "id")
+			title := yamlScalar(root, "title")
+			if title == "" {
+				title = yamlScalar(root, "name")
+			}
+			for _, kind := range sources[source] {
+				for _, targetID := range referencedIDs(root, kind) {
+					index[targetID] = append(index[targetID], entityReference{kind: kind, source: sourceID, title: title, path: path})
+				}
+			}
+		}
+
+		dir, ok := GetEntityDirectory(source)
+		if !ok {
+			continue
+		}
+		if dir == "" {
+			single, ok := singleFileSources[source]
+			if !ok {
+				continue
+			}
+			var doc yaml.Node
+			if err := z.fileStore.ReadYaml(ctx, single.file, &doc); err != nil || len(doc.Content) == 0 {
+				continue
+			}
+			if list := yamlValue(doc.Content[0], single.list); list != nil && list.Kind == yaml.SequenceNode {
+				for _, item := range list.Content {
+					collect(item, single.file)
+				}
+			}
+			continue
+		}
+		z.forEachYaml(ctx, dir, func(path string) {
+			var doc yaml.Node
+			if err := z.fileStore.ReadYaml(ctx, path, &doc); err != nil || len(doc.Content) == 0 {
+				return
+			}
+			collect(doc.Content[0], path)
+		})
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// referencedIDs は root から kind の参照先 ID を取り出す
+func referencedIDs(root *yaml.Node, kind *ReferenceKind) []string {
+	if kind.List == "" {
+		if id := yamlScalar(root, kind.Field); id != "" {
+			return []string{id}
+		}
+		return nil
+	}
+	var ids []string
+	if list := yamlValue(root, kind.List); list != nil && list.Kind == yaml.SequenceNode {
+		for _, item := range list.Content {
+			if id := listItemID(item, kind); id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// listItemID は配列の要素 item から kind の参照先 ID を取り出す（Field が空の場合は要素そのもの）
+func listItemID(item *yaml.Node, kind *ReferenceKind) string {
+	if kind.Field == "" {
+		if item.Kind == yaml.ScalarNode {
+			return item.Value
+		}
+		return ""
+	}
+	return yamlScalar(item, kind.Field)
+}
+
+// nullifyReference は参照元のファイルから e の参照を外す（フィールドの削除、または配列の要素の削除）
+func (z *Zeus) nullifyReference(ctx context.Context, e DeleteEffect) error {
+	kind := findReferenceKind(e.Reference)
+	if kind == nil {
+		return fmt.Errorf("unknown reference %q", e.Reference)
+	}
+	var doc yaml.Node
+	if err := z.fileStore.ReadYaml(ctx, e.path, &doc); err != nil {
+		return err
+	}
+	if len(doc.Content) == 0 {
+		return nil
+	}
+	root := doc.Content[0]
+	// 単一ファイルの参照元は、配列から ID が一致する要素を探す
+	if single, ok := singleFileSources[kind.Source]; ok {
+		root = singleFileItem(root, single.list, e.ID)
+		if root == nil {
+			return nil
+		}
+	}
+
+	if kind.List == "" {
+		if yamlScalar(root, kind.Field) == e.Target {
+			removeYamlKey(root, kind.Field)
+		}
+	} else if list := yamlValue(root, kind.List); list != nil && list.Kind == yaml.SequenceNode {
+		items := list.Content[:0]
+		for _, item := range list.Content {
+			if listItemID(item, kind) == e.Target {
+				if kind.DropItem || kind.Field == "" {
+					continue
+				}
+				removeYamlKey(item, kind.Field)
+			}
+			items = append(items, item)
+		}
+		list.Content = items
+	}
+
+	if metadata := yamlValue(root, "metadata"); metadata != nil {
+		if updatedAt := yamlValue(metadata, "updated_at"); updatedAt != nil {
+			updatedAt.Value = Now()
+		}
+	}
+	return z.fileStore.WriteYaml(ctx, e.path, &doc)
+}
+
+// singleFileItem は単一ファイルの配列 list から ID が id の要素を返す（無い場合は nil）
+func singleFileItem(root *yaml.Node, list, id string) *yaml.Node {
+	items := yamlValue(root, list)
+	if items == nil || items.Kind != yaml.SequenceNode {
+		return nil
+	}
+	for _, item := range items.Content {
+		if yamlScalar(item, "id") == id {
+			return item
+		}
+	}
+	return nil
+}
+
+// yamlValue はマッピング m のキー key の値を返す（無い場合は nil）
+func yamlValue(m *yaml.Node, key string) *yaml.Node {
+	if m == nil || m.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return m.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// yamlScalar はマッピング m のキー key のスカラー値を返す（無い場合は空）
+func yamlScalar(m *yaml.Node, key string) string {
+	if v := yamlValue(m, key); v != nil && v.Kind == yaml.ScalarNode {
+		return v.Value
+	}
+	return ""
+}
+
+// removeYamlKey はマッピング m からキー key を取り除く
+func removeYamlKey(m *yaml.Node, key string) {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			m.Content = append(m.Content[:i], m.Content[i+2:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,346 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// setupDeletePolicyTest は参照を持つエンティティ一式を作成する
+func setupDeletePolicyTest(t *testing.T) (*Zeus, map[string]string) {
+	t.Helper()
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	add := func(entity, title string, opts ...EntityOption) string {
+		t.Helper()
+		result, err := z.Add(ctx, entity, title, opts...)
+		if err != nil {
+			t.Fatalf("Add %s failed: %v", title, err)
+		}
+		return result.ID
+	}
+	ids := map[string]string{}
+	ids["objective"] = add("objective", "決済刷新")
+	ids["actor"] = add("actor", "購入者")
+	ids["usecase"] = add("usecase", "決済する", WithUseCaseObjective(ids["objective"]), WithUseCaseActor(ids["actor"], ActorRolePrimary))
+	ids["risk"] = add("risk", "移行遅延", WithRiskObjective(ids["objective"]))
+	ids["activity"] = add("activity", "決済フロー", WithActivityUseCase(ids["usecase"]))
+	return z, ids
+}
+
+func TestDeleteWithOptions_Restrict(t *testing.T) {
+	ctx := context.Background()
+	z, ids := setupDeletePolicyTest(t)
+
+	err := z.Delete(ctx, "objective", ids["objective"])
+	var restricted *DeleteRestrictedError
+	if !errors.As(err, &restricted) || !errors.Is(err, ErrDeleteRestricted) {
+		t.Fatalf("expected DeleteRestrictedError, got %v", err)
+	}
+	if len(restricted.Plan.Restricted) != 1 || restricted.Plan.Restricted[0].ID != ids["usecase"] {
+		t.Errorf("expected usecase to restrict the delete: %+v", restricted.Plan.Restricted)
+	}
+	if !strings.Contains(err.Error(), "usecase.objective_id") {
+		t.Errorf("error should name the reference: %v", err)
+	}
+
+	// 何も変更されていない
+	if _, err := z.Get(ctx, "objective", ids["objective"]); err != nil {
+		t.Errorf("objective should remain: %v", err)
+	}
+	risk, err := z.Get(ctx, "risk", ids["risk"])
+	if err != nil || risk.(*RiskEntity).ObjectiveID != ids["objective"] {
+		t.Errorf("risk reference should remain: %v", err)
+	}
+}
+
+func TestPlanDelete_Cascade(t *testing.T) {
+	ctx := context.Background()
+	z, ids := setupDeletePolicyTest(t)
+
+	plan, err := z.PlanDelete(ctx, "objective", ids["objective"], DeleteOptions{Cascade: true})
+	if err != nil {
+		t.Fatalf("PlanDelete failed: %v", err)
+	}
+	if len(plan.Restricted) != 0 {
+		t.Errorf("expected no restricted references: %+v", plan.Restricted)
+	}
+	if len(plan.Cascade) != 1 || plan.Cascade[0].ID != ids["usecase"] || plan.Cascade[0].Reference != "usecase.objective_id" {
+		t.Errorf("expected usecase to be cascaded: %+v", plan.Cascade)
+	}
+	nullified := map[string]string{}
+	for _, e := range plan.Nullify {
+		nullified[e.ID] = e.Reference
+	}
+	if nullified[ids["risk"]] != "risk.objective_id" || nullified[ids["activity"]] != "activity.usecase_id" {
+		t.Errorf("expected risk and activity references to be removed: %+v", plan.Nullify)
+	}
+}
+
+func TestDeleteWithOptions_Cascade(t *testing.T) {
+	ctx := context.Background()
+	z, ids := setupDeletePolicyTest(t)
+
+	if err := z.DeleteWithOptions(ctx, "objective", ids["objective"], DeleteOptions{Cascade: true}); err != nil {
+		t.Fatalf("DeleteWithOptions failed: %v", err)
+	}
+	for _, entity := range []string{"objective", "usecase"} {
+		if _, err := z.Get(ctx, entity, ids[entity]); !errors.Is(err, ErrEntityNotFound) {
+			t.Errorf("%s should be deleted, got %v", entity, err)
+		}
+	}
+	risk, err := z.Get(ctx, "risk", ids["risk"])
+	if err != nil {
+		t.Fatalf("risk should remain: %v", err)
+	}
+	if risk.(*RiskEntity).ObjectiveID != "" {
+		t.Errorf("risk objective_id should be removed, got %q", risk.(*RiskEntity).ObjectiveID)
+	}
+	act, err := z.Get(ctx, "activity", ids["activity"])
+	if err != nil {
+		t.Fatalf("activity should remain: %v", err)
+	}
+	if act.(*ActivityEntity).UseCaseID != "" {
+		t.Errorf("activity usecase_id should be removed, got %q", act.(*ActivityEntity).UseCaseID)
+	}
+}
+
+func TestDeleteWithOptions_NullifyListItem(t *testing.T) {
+	ctx := context.Background()
+	z, ids := setupDeletePolicyTest(t)
+
+	if err := z.Delete(ctx, "actor", ids["actor"]); err != nil {
+		t.Fatalf("Delete actor failed: %v", err)
+	}
+	uc, err := z.Get(ctx, "usecase", ids["usecase"])
+	if err != nil {
+		t.Fatalf("usecase should remain: %v", err)
+	}
+	if actors := uc.(*UseCaseEntity).Actors; len(actors) != 0 {
+		t.Errorf("actor reference should be removed from usecase, got %+v", actors)
+	}
+}
+
+func TestDeleteWithOptions_NullifyReleaseIDs(t *testing.T) {
+	ctx := context.Background()
+	z, ids := setupDeletePolicyTest(t)
+
+	quality, err := z.Add(ctx, "quality", "決済の応答時間", WithQualityObjective(ids["objective"]),
+		WithQualityMetrics([]QualityMetric{{ID: "m1", Name: "p95", Target: 300, Status: MetricStatusInProgress}}))
+	if err != nil {
+		t.Fatalf("Add quality failed: %v", err)
+	}
+	ids["quality"] = quality.ID
+	other, err := z.Add(ctx, "activity", "返金フロー")
+	if err != nil {
+		t.Fatalf("Add activity failed: %v", err)
+	}
+	release, err := z.Add(ctx, "release", "v1.0",
+		WithReleaseUseCases([]string{ids["usecase"]}),
+		WithReleaseActivities([]string{ids["activity"], other.ID}),
+		WithReleaseQualities([]string{ids["quality"]}))
+	if err != nil {
+		t.Fatalf("Add release failed: %v", err)
+	}
+
+	for _, tt := range []struct {
+		entity    string
+		reference string
+	}{
+		{"activity", "release.activity_ids"},
+		{"usecase", "release.usecase_ids"},
+		{"quality", "release.quality_ids"},
+	} {
+		plan, err := z.PlanDelete(ctx, tt.entity, ids[tt.entity], DeleteOptions{})
+		if err != nil {
+			t.Fatalf("PlanDelete %s failed: %v", tt.entity, err)
+		}
+		found := false
+		for _, e := range plan.Nullify {
+			if e.ID == release.ID && e.Reference == tt.reference {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected %s to be removed from the release: %+v", tt.reference, plan.Nullify)
+		}
+		if err := z.Delete(ctx, tt.entity, ids[tt.entity]); err != nil {
+			t.Fatalf("Delete %s failed: %v", tt.entity, err)
+		}
+	}
+
+	entity, err := z.Get(ctx, "release", release.ID)
+	if err != nil {
+		t.Fatalf("release should remain: %v", err)
+	}
+	rel := entity.(*ReleaseEntity)
+	if len(rel.UseCaseIDs) != 0 || len(rel.QualityIDs) != 0 {
+		t.Errorf("usecase and quality references should be removed, got %v %v", rel.UseCaseIDs, rel.QualityIDs)
+	}
+	if len(rel.ActivityIDs) != 1 || rel.ActivityIDs[0] != other.ID {
+		t.Errorf("only the deleted activity should be removed, got %v", rel.ActivityIDs)
+	}
+}
+
+func TestDeleteWithOptions_NullifyContainerSubsystem(t *testing.T) {
+	ctx := context.Background()
+	z, _ := setupDeletePolicyTest(t)
+
+	subsystem, err := z.Add(ctx, "subsystem", "決済")
+	if err != nil {
+		t.Fatalf("Add subsystem failed: %v", err)
+	}
+	container, err := z.Add(ctx, "container", "決済 API", WithContainerSubsystem(subsystem.ID))
+	if err != nil {
+		t.Fatalf("Add container failed: %v", err)
+	}
+	other, err := z.Add(ctx, "container", "決済 DB", WithContainerSubsystem(subsystem.ID))
+	if err != nil {
+		t.Fatalf("Add container failed: %v", err)
+	}
+
+	plan, err := z.PlanDelete(ctx, "subsystem", subsystem.ID, DeleteOptions{})
+	if err != nil {
+		t.Fatalf("PlanDelete failed: %v", err)
+	}
+	if len(plan.Nullify) != 2 || plan.Nullify[0].Reference != "container.subsystem_id" || plan.Nullify[0].Title != "決済 API" {
+		t.Errorf("expected container references to be removed: %+v", plan.Nullify)
+	}
+
+	if err := z.Delete(ctx, "subsystem", subsystem.ID); err != nil {
+		t.Fatalf("Delete subsystem failed: %v", err)
+	}
+	for _, id := range []string{container.ID, other.ID} {
+		entity, err := z.Get(ctx, "container", id)
+		if err != nil {
+			t.Fatalf("container should remain: %v", err)
+		}
+		if ctr := entity.(*ContainerEntity); ctr.SubsystemID != "" || ctr.Name == "" {
+			t.Errorf("container subsystem_id should be removed, got %+v", ctr)
+		}
+	}
+}
+
+func TestDeleteWithOptions_ConfiguredPolicy(t *testing.T) {
+	ctx := context.Background()
+	z, ids := setupDeletePolicyTest(t)
+
+	writeConfig := func(policies string) {
+		t.Helper()
+		config := z.approvalConfig(ctx)
+		data := "version: \"" + config.Version + "\"\ndelete_policies:\n" + policies
+		if err := os.WriteFile(filepath.Join(z.ZeusPath, "zeus.yaml"), []byte(data), 0644); err != nil {
+			t.Fatalf("failed to write zeus.yaml: %v", err)
+		}
+	}
+
+	writeConfig("  usecase.objective_id: nullify\n")
+	if _, err := z.PlanDelete(ctx, "objective", ids["objective"], DeleteOptions{}); err == nil {
+		t.Error("nullify should not be allowed for a required reference")
+	}
+
+	writeConfig("  usecase.objective_id: cascade\n  risk.objective_id: cascade\n")
+	if err := z.Delete(ctx, "objective", ids["objective"]); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := z.Get(ctx, "risk", ids["risk"]); !errors.Is(err, ErrEntityNotFound) {
+		t.Errorf("risk should be cascaded by configured policy, got %v", err)
+	}
+}
+
+func TestPlanDelete_DecisionIsNeverCascaded(t *testing.T) {
+	ctx := context.Background()
+	z, ids := setupDeletePolicyTest(t)
+
+	con, err := z.Add(ctx, "consideration", "決済基盤の選定", WithConsiderationObjective(ids["objective"]))
+	if err != nil {
+		t.Fatalf("Add consideration failed: %v", err)
+	}
+	dec, err := z.Add(ctx, "decision", "Stripe を採用",
+		WithDecisionConsideration(con.ID),
+		WithDecisionSelected(SelectedOption{OptionID: "opt-1", Title: "Stripe"}),
+		WithDecisionRationale("導入実績"))
+	if err != nil {
+		t.Fatalf("Add decision failed: %v", err)
+	}
+
+	plan, err := z.PlanDelete(ctx, "consideration", con.ID, DeleteOptions{Cascade: true})
+	if err != nil {
+		t.Fatalf("PlanDelete failed: %v", err)
+	}
+	if len(plan.Restricted) != 1 || plan.Restricted[0].ID != dec.ID || len(plan.Cascade) != 0 {
+		t.Errorf("decision should restrict the delete even with cascade: %+v", plan)
+	}
+}
+
+func TestDeleteWithOptions_CascadeRequiresApproval(t *testing.T) {
+	ctx := context.Background()
+	z, ids := setupDeletePolicyTest(t)
+	config, _ := z.LoadConfig(ctx)
+	config.Settings.Operations = map[string]ApprovalLevel{"usecase_delete": ApprovalApprove}
+	if err := z.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+
+	// 削除対象（objective）は自動でも、連鎖して削除する usecase に承認が必要なら削除全体を承認待ちにする
+	err := z.DeleteWithOptions(ctx, "objective", ids["objective"], DeleteOptions{Cascade: true})
+	var required *ApprovalRequiredError
+	if !errors.As(err, &required) {
+		t.Fatalf("expected ApprovalRequiredError, got %v", err)
+	}
+	for _, entity := range []string{"objective", "usecase"} {
+		if _, err := z.Get(ctx, entity, ids[entity]); err != nil {
+			t.Errorf("%s should remain until approved: %v", entity, err)
+		}
+	}
+
+	if _, err := z.Approve(ctx, required.ApprovalID); err != nil {
+		t.Fatalf("Approve failed: %v", err)
+	}
+	for _, entity := range []string{"objective", "usecase"} {
+		if _, err := z.Get(ctx, entity, ids[entity]); !errors.Is(err, ErrEntityNotFound) {
+			t.Errorf("%s should be deleted after approval, got %v", entity, err)
+		}
+	}
+	act, err := z.Get(ctx, "activity", ids["activity"])
+	if err != nil || act.(*ActivityEntity).UseCaseID != "" {
+		t.Errorf("activity usecase_id should be removed after approval: %+v (err: %v)", act, err)
+	}
+
+	// 連鎖したエンティティも監査ログに記録する
+	trail, _ := z.AuditTrail(ctx, "", 0)
+	results := map[string]string{}
+	for _, r := range trail {
+		if r.Op != ChangeOpCreate {
+			results[r.ID] += r.Result + ","
+		}
+	}
+	for _, id := range []string{ids["objective"], ids["usecase"], ids["activity"], ids["risk"]} {
+		if results[id] != AuditResultQueued+","+AuditResultApproved+"," {
+			t.Errorf("audit of %s = %q", id, results[id])
+		}
+	}
+}
+
+func TestDeleteWithOptions_CascadeProtectedFromAgent(t *testing.T) {
+	ctx := context.Background()
+	_, human := setupAgentPolicy(t, AgentPolicy{Protected: []string{"usecase"}})
+	objective, _ := human.Add(ctx, "objective", "決済刷新")
+	usecase, _ := human.Add(ctx, "usecase", "決済する", WithUseCaseObjective(objective.ID))
+
+	agent := New(human.ProjectPath, WithAgent("claude"))
+	err := agent.DeleteWithOptions(ctx, "objective", objective.ID, DeleteOptions{Cascade: true})
+	if !errors.As(err, new(*ApprovalRequiredError)) {
+		t.Fatalf("cascading into a protected usecase should require approval, got %v", err)
+	}
+	if _, err := human.Get(ctx, "usecase", usecase.ID); err != nil {
+		t.Errorf("protected usecase should remain: %v", err)
+	}
+}
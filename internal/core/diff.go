@@ -0,0 +1,185 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContextLines は unified diff の前後に表示する行数
+const diffContextLines = 3
+
+// diffMaxCells は LCS 計算の上限（超える場合は全行の置き換えとして出力する）
+const diffMaxCells = 4_000_000
+
+// diffOp は行単位の編集操作
+type diffOp struct {
+	kind byte // ' ': 共通, '-': 削除, '+': 追加
+	line string
+}
+
+// unifiedDiff は old から new への unified diff を返す（差分が無い場合は空文字列）
+func unifiedDiff(oldName, newName string, oldData, newData []byte) string {
+	oldLines := splitDiffLines(string(oldData))
+	newLines := splitDiffLines(string(newData))
+	ops := diffLines(oldLines, newLines)
+
+	changed := false
+	for _, op := range ops {
+		if op.kind != ' ' {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", oldName, newName)
+
+	// 変更箇所の前後 diffContextLines 行を 1 つのハンクにまとめる
+	for start := 0; start < len(ops); {
+		first := -1
+		for i := start; i < len(ops); i++ {
+			if ops[i].kind != ' ' {
+				first = i
+				break
+			}
+		}
+		if first < 0 {
+			break
+		}
+		begin := max(first-diffContextLines, start)
+		end := first
+		for i := first; i < len(ops); i++ {
+			if ops[i].kind != ' ' {
+				end = i
+				continue
+			}
+			if i-end > diffContextLines*2 {
+				break
+			}
+		}
+		end = min(end+diffContextLines+1, len(ops))
+
+		oldStart, newStart := 1, 1
+		for _, op := range ops[:begin] {
+			if op.kind != '+' {
+				oldStart++
+			}
+			if op.kind != '-' {
+				newStart++
+			}
+		}
+		oldCount, newCount := 0, 0
+		for _, op := range ops[begin:end] {
+			if op.kind != '+' {
+				oldCount++
+			}
+			if op.kind != '-' {
+				newCount++
+			}
+		}
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", hunkRange(oldStart, oldCount), hunkRange(newStart, newCount))
+		for _, op := range ops[begin:end] {
+			sb.WriteByte(op.kind)
+			sb.WriteString(op.line)
+			sb.WriteByte('\n')
+		}
+		start = end
+	}
+	return sb.String()
+}
+
+// hunkRange はハンクヘッダーの範囲表記（行が無い場合は直前の行番号と 0）
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start-1)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
+
+// splitDiffLines は末尾の改行を除いて行に分割
+func splitDiffLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines は LCS に基づく行単位の編集操作を返す（共通の先頭・末尾は計算から除く）
+func diffLines(a, b []string) []diffOp {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]diffOp, 0, len(a)+len(b))
+	for _, line := range a[:prefix] {
+		ops = append(ops, diffOp{' ', line})
+	}
+	ops = append(ops, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		ops = append(ops, diffOp{' ', line})
+	}
+	return ops
+}
+
+// diffMiddle は LCS の表から編集操作を復元
+func diffMiddle(a, b []string) []diffOp {
+	ops := make([]diffOp, 0, len(a)+len(b))
+	if len(a)*len(b) > diffMaxCells {
+		for _, line := range a {
+			ops = append(ops, diffOp{'-', line})
+		}
+		for _, line := range b {
+			ops = append(ops, diffOp{'+', line})
+		}
+		return ops
+	}
+
+	// lcs[i][j] は a[i:] と b[j:] の LCS の長さ
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, diffOp{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, diffOp{'+', b[j]})
+	}
+	return ops
+}
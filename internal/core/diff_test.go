@@ -0,0 +1,67 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      string
+		new      string
+		expected string
+	}{
+		{
+			name:     "no change",
+			old:      "a\nb\n",
+			new:      "a\nb\n",
+			expected: "",
+		},
+		{
+			name:     "create",
+			old:      "",
+			new:      "a\nb\n",
+			expected: "--- a/f\n+++ b/f\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			name:     "delete",
+			old:      "a\n",
+			new:      "",
+			expected: "--- a/f\n+++ b/f\n@@ -1 +0,0 @@\n-a\n",
+		},
+		{
+			name:     "modify with context",
+			old:      "1\n2\n3\n4\n5\n6\n7\n8\n",
+			new:      "1\n2\n3\n4\nfive\n6\n7\n8\n",
+			expected: "--- a/f\n+++ b/f\n@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := unifiedDiff("a/f", "b/f", []byte(tt.old), []byte(tt.new))
+			if got != tt.expected {
+				t.Errorf("unifiedDiff() =\n%s\nwant\n%s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUnifiedDiff_SeparateHunks(t *testing.T) {
+	var oldLines, newLines []string
+	for i := 0; i < 30; i++ {
+		line := string(rune('a' + i%26))
+		oldLines = append(oldLines, line)
+		if i == 2 || i == 25 {
+			line += "!"
+		}
+		newLines = append(newLines, line)
+	}
+	got := unifiedDiff("a/f", "b/f", []byte(strings.Join(oldLines, "\n")), []byte(strings.Join(newLines, "\n")))
+	if n := strings.Count(got, "@@ -"); n != 2 {
+		t.Errorf("expected 2 hunks for distant changes, got %d:\n%s", n, got)
+	}
+	if !strings.Contains(got, "@@ -1,6 +1,6 @@") || !strings.Contains(got, "@@ -23,7 +23,7 @@") {
+		t.Errorf("unexpected hunk headers:\n%s", got)
+	}
+}
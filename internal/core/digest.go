@@ -0,0 +1,313 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DigestItem はダイジェストの 1 項目
+type DigestItem struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Detail string `json:"detail,omitempty"`
+	Date   string `json:"date,omitempty"`
+}
+
+// Digest はスタンドアップ用のダイジェスト
+type Digest struct {
+	ProjectName      string       `json:"project_name"`
+	Since            time.Time    `json:"since"`
+	GeneratedAt      time.Time    `json:"generated_at"`
+	Completed        []DigestItem `json:"completed"`         // 決定した Decision、解決した Problem
+	NewlyBlocked     []DigestItem `json:"newly_blocked"`     // 新規の Problem、顕在化した Risk
+	PendingApprovals []DigestItem `json:"pending_approvals"` // 承認待ち
+	UpcomingDue      []DigestItem `json:"upcoming_due"`      // 期限が近い（または超過した）Consideration / Risk レビュー
+}
+
+// DigestOptions はダイジェスト生成オプション
+type DigestOptions struct {
+	Since     time.Time // この時刻以降の変更を対象にする
+	Now       time.Time // 基準時刻（ゼロ値なら現在時刻）
+	DueWithin int       // 期限が近いとみなす日数（0 以下はデフォルト 7 日）
+}
+
+// BuildDigest は Since 以降の変更と期限の近い項目からダイジェストを生成
+func (z *Zeus) BuildDigest(ctx context.Context, opts DigestOptions) (*Digest, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	dueWithin := opts.DueWithin
+	if dueWithin <= 0 {
+		dueWithin = 7
+	}
+	// 期限は日付単位で判定する
+	dueLimit := truncateDay(now).AddDate(0, 0, dueWithin)
+
+	digest := &Digest{
+		Since:            opts.Since,
+		GeneratedAt:      now,
+		Completed:        []DigestItem{},
+		NewlyBlocked:     []DigestItem{},
+		PendingApprovals: []DigestItem{},
+		UpcomingDue:      []DigestItem{},
+	}
+	if config, err := z.LoadConfig(ctx); err == nil {
+		digest.ProjectName = config.Project.Name
+	}
+
+	changedSince := func(value string) bool {
+		t, ok := parseDigestTime(value)
+		return ok && !t.Before(opts.Since)
+	}
+
+	z.forEachYaml(ctx, "decisions", func(path string) {
+		var d DecisionEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &d); err == nil && changedSince(d.DecidedAt) {
+			detail := "decided: " + d.Selected.Title
+			if d.Supersedes != "" {
+				detail += " (supersedes " + d.Supersedes + ")"
+			}
+			digest.Completed = append(digest.Completed, DigestItem{
+				ID:     d.ID,
+				Title:  d.Title,
+				Detail: detail,
+				Date:   d.DecidedAt,
+			})
+		}
+	})
+
+	z.forEachYaml(ctx, "problems", func(path string) {
+		var p ProblemEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &p); err != nil {
+			return
+		}
+		switch p.Status {
+		case ProblemStatusResolved, ProblemStatusWontFix:
+			if changedSince(p.Metadata.UpdatedAt) {
+				digest.Completed = append(digest.Completed, DigestItem{
+					ID:     p.ID,
+					Title:  p.Title,
+					Detail: string(p.Status),
+					Date:   p.Metadata.UpdatedAt,
+				})
+			}
+		default:
+			if changedSince(p.Metadata.CreatedAt) {
+				digest.NewlyBlocked = append(digest.NewlyBlocked, DigestItem{
+					ID:     p.ID,
+					Title:  p.Title,
+					Detail: "problem, severity: " + string(p.Severity),
+					Date:   p.Metadata.CreatedAt,
+				})
+			}
+		}
+	})
+
+	z.forEachYaml(ctx, "risks", func(path string) {
+		var r RiskEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &r); err != nil {
+			return
+		}
+		if r.Status == RiskStatusOccurred && changedSince(r.Metadata.UpdatedAt) {
+			digest.NewlyBlocked = append(digest.NewlyBlocked, DigestItem{
+				ID:     r.ID,
+				Title:  r.Title,
+				Detail: "risk occurred, score: " + string(r.RiskScore),
+				Date:   r.Metadata.UpdatedAt,
+			})
+		}
+		if r.Status == RiskStatusClosed || r.Status == RiskStatusMitigated {
+			return
+		}
+		if due, ok := parseDigestTime(r.ReviewDate); ok && due.Before(dueLimit) {
+			digest.UpcomingDue = append(digest.UpcomingDue, DigestItem{
+				ID:     r.ID,
+				Title:  r.Title,
+				Detail: dueDetail("risk review", due, now),
+				Date:   r.ReviewDate,
+			})
+		}
+	})
+
+	z.forEachYaml(ctx, "considerations", func(path string) {
+		var c ConsiderationEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &c); err != nil || c.Status != ConsiderationStatusOpen {
+			return
+		}
+		if due, ok := parseDigestTime(c.DueDate); ok && due.Before(dueLimit) {
+			digest.UpcomingDue = append(digest.UpcomingDue, DigestItem{
+				ID:     c.ID,
+				Title:  c.Title,
+				Detail: dueDetail("consideration", due, now),
+				Date:   c.DueDate,
+			})
+		}
+	})
+
+	pending, err := z.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range pending {
+		digest.PendingApprovals = append(digest.PendingApprovals, DigestItem{
+			ID:     p.ID,
+			Title:  p.Description,
+			Detail: p.Type,
+			Date:   p.CreatedAt,
+		})
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, items := range [][]DigestItem{digest.Completed, digest.NewlyBlocked, digest.PendingApprovals, digest.UpcomingDue} {
+		sort.SliceStable(items, func(i, j int) bool {
+			if items[i].Date != items[j].Date {
+				return items[i].Date < items[j].Date
+			}
+			return items[i].ID < items[j].ID
+		})
+	}
+
+	return digest, nil
+}
+
+// ParseDigestSince は --since の値を時刻に変換
+// 対応形式: today, yesterday, Nd（N 日前）, YYYY-MM-DD, RFC3339
+func ParseDigestSince(value string, now time.Time) (time.Time, error) {
+	today := truncateDay(now)
+	switch value {
+	case "", "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	case "today":
+		return today, nil
+	}
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil && n >= 0 {
+			return today.AddDate(0, 0, -n), nil
+		}
+	}
+	if t, ok := parseDigestTime(value); ok {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid since value: %s (today, yesterday, Nd, YYYY-MM-DD, RFC3339)", value)
+}
+
+// ToMarkdown は Markdown 形式で出力
+func (d *Digest) ToMarkdown() string {
+	var sb strings.Builder
+
+	title := "Daily Digest"
+	if d.ProjectName != "" {
+		title += " - " + d.ProjectName
+	}
+	fmt.Fprintf(&sb, "# %s\n\n", title)
+	fmt.Fprintf(&sb, "Since %s (generated %s)\n", d.Since.Format("2006-01-02 15:04"), d.GeneratedAt.Format("2006-01-02 15:04"))
+
+	for _, section := range d.sections() {
+		fmt.Fprintf(&sb, "\n## %s (%d)\n\n", section.title, len(section.items))
+		if len(section.items) == 0 {
+			sb.WriteString("- none\n")
+			continue
+		}
+		for _, item := range section.items {
+			fmt.Fprintf(&sb, "- `%s` %s", item.ID, item.Title)
+			if item.Detail != "" {
+				fmt.Fprintf(&sb, " (%s)", item.Detail)
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	return sb.String()
+}
+
+// ToSlack は Slack の mrkdwn 形式で出力
+func (d *Digest) ToSlack() string {
+	var sb strings.Builder
+
+	title := "Daily Digest"
+	if d.ProjectName != "" {
+		title += " - " + d.ProjectName
+	}
+	fmt.Fprintf(&sb, "*%s*\n_Since %s_\n", title, d.Since.Format("2006-01-02 15:04"))
+
+	for _, section := range d.sections() {
+		fmt.Fprintf(&sb, "\n*%s* (%d)\n", section.title, len(section.items))
+		if len(section.items) == 0 {
+			sb.WriteString("• none\n")
+			continue
+		}
+		for _, item := range section.items {
+			fmt.Fprintf(&sb, "• `%s` %s", item.ID, slackEscape(item.Title))
+			if item.Detail != "" {
+				fmt.Fprintf(&sb, " _(%s)_", slackEscape(item.Detail))
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	return sb.String()
+}
+
+type digestSection struct {
+	title string
+	items []DigestItem
+}
+
+func (d *Digest) sections() []digestSection {
+	return []digestSection{
+		{"Completed", d.Completed},
+		{"Newly Blocked", d.NewlyBlocked},
+		{"Pending Approvals", d.PendingApprovals},
+		{"Upcoming Due Dates", d.UpcomingDue},
+	}
+}
+
+// slackEscape は Slack mrkdwn の制御文字をエスケープ
+func slackEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+// dueDetail は期限までの残り日数を表す説明を返す
+func dueDetail(kind string, due, now time.Time) string {
+	days := int(truncateDay(due).Sub(truncateDay(now)).Hours() / 24)
+	switch {
+	case days < 0:
+		return fmt.Sprintf("%s, overdue by %d days", kind, -days)
+	case days == 0:
+		return kind + ", due today"
+	default:
+		return fmt.Sprintf("%s, due in %d days", kind, days)
+	}
+}
+
+// parseDigestTime は RFC3339 または YYYY-MM-DD 形式の時刻を解析
+func parseDigestTime(value string) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, false
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, true
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+// truncateDay は時刻をその日の 0 時（ローカルタイム）に切り捨てる
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,118 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildDigest(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	now := time.Now()
+	tomorrow := now.AddDate(0, 0, 1).Format("2006-01-02")
+	lastWeek := now.AddDate(0, 0, -7).Format("2006-01-02")
+	nextMonth := now.AddDate(0, 1, 0).Format("2006-01-02")
+
+	con, err := z.Add(ctx, "consideration", "Choose database", WithConsiderationDueDate(tomorrow))
+	if err != nil {
+		t.Fatalf("Add consideration failed: %v", err)
+	}
+	if _, err := z.Add(ctx, "consideration", "Later topic", WithConsiderationDueDate(nextMonth)); err != nil {
+		t.Fatalf("Add consideration failed: %v", err)
+	}
+	decided, err := z.Add(ctx, "consideration", "Choose cache")
+	if err != nil {
+		t.Fatalf("Add consideration failed: %v", err)
+	}
+	dec, err := z.Add(ctx, "decision", "Use Redis",
+		WithDecisionConsideration(decided.ID),
+		WithDecisionSelected(SelectedOption{OptionID: "opt-1", Title: "Redis"}),
+		WithDecisionRationale("reason"),
+	)
+	if err != nil {
+		t.Fatalf("Add decision failed: %v", err)
+	}
+	prob, err := z.Add(ctx, "problem", "Build is flaky", WithProblemSeverity(ProblemSeverityHigh))
+	if err != nil {
+		t.Fatalf("Add problem failed: %v", err)
+	}
+	risk, err := z.Add(ctx, "risk", "Vendor delay", WithRiskReviewDate(lastWeek))
+	if err != nil {
+		t.Fatalf("Add risk failed: %v", err)
+	}
+
+	since, err := ParseDigestSince("yesterday", now)
+	if err != nil {
+		t.Fatalf("ParseDigestSince failed: %v", err)
+	}
+	digest, err := z.BuildDigest(ctx, DigestOptions{Since: since, Now: now})
+	if err != nil {
+		t.Fatalf("BuildDigest failed: %v", err)
+	}
+
+	if len(digest.Completed) != 1 || digest.Completed[0].ID != dec.ID {
+		t.Errorf("expected decision in completed: %+v", digest.Completed)
+	}
+	if len(digest.NewlyBlocked) != 1 || digest.NewlyBlocked[0].ID != prob.ID {
+		t.Errorf("expected problem in newly blocked: %+v", digest.NewlyBlocked)
+	}
+	if len(digest.UpcomingDue) != 2 {
+		t.Fatalf("expected 2 upcoming items, got %+v", digest.UpcomingDue)
+	}
+	// 日付順（超過した Risk レビューが先）
+	if digest.UpcomingDue[0].ID != risk.ID || !strings.Contains(digest.UpcomingDue[0].Detail, "overdue") {
+		t.Errorf("expected overdue risk review first: %+v", digest.UpcomingDue)
+	}
+
+	md := digest.ToMarkdown()
+	for _, want := range []string{"## Completed (1)", "## Pending Approvals (0)", prob.ID} {
+		if !strings.Contains(md, want) {
+			t.Errorf("markdown should contain %q:\n%s", want, md)
+		}
+	}
+	slack := digest.ToSlack()
+	if !strings.Contains(slack, "*Upcoming Due Dates* (2)") || !strings.Contains(slack, "• `"+con.ID+"`") {
+		t.Errorf("unexpected slack output:\n%s", slack)
+	}
+
+	// 未来の since では変更なし
+	digest, err = z.BuildDigest(ctx, DigestOptions{Since: now.Add(time.Hour), Now: now})
+	if err != nil {
+		t.Fatalf("BuildDigest failed: %v", err)
+	}
+	if len(digest.Completed) != 0 || len(digest.NewlyBlocked) != 0 {
+		t.Errorf("expected no changes after since: %+v", digest)
+	}
+}
+
+func TestParseDigestSince(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 30, 0, 0, time.Local)
+
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{"yesterday", time.Date(2026, 3, 9, 0, 0, 0, 0, time.Local), false},
+		{"today", time.Date(2026, 3, 10, 0, 0, 0, 0, time.Local), false},
+		{"7d", time.Date(2026, 3, 3, 0, 0, 0, 0, time.Local), false},
+		{"2026-03-01", time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local), false},
+		{"last week", time.Time{}, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseDigestSince(tt.value, now)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseDigestSince(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !got.Equal(tt.want) {
+			t.Errorf("ParseDigestSince(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
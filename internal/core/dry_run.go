@@ -0,0 +1,161 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// FileChange は書き込みで変更されるファイル（ドライランの結果）
+type FileChange struct {
+	Path string `json:"path" yaml:"path"` // .zeus からの相対パス
+	Op   string `json:"op" yaml:"op"`     // create, update, delete
+	Diff string `json:"diff" yaml:"diff"` // unified diff
+}
+
+// dryRunStore は書き込みをステージするだけでコミットしない FileStore
+// ディレクトリも作成しない（ステージしたファイルのディレクトリは ListDir 等で存在するものとして扱われる）
+type dryRunStore struct {
+	*transactionStore
+}
+
+// EnsureDir は何もしない
+func (s *dryRunStore) EnsureDir(ctx context.Context, path string) error {
+	return ctx.Err()
+}
+
+// DryRun は fn を書き込みなしで実行し、変更されるはずのファイルと差分を返す
+// fn に渡される Zeus の書き込みはすべてステージされ、破棄される
+func (z *Zeus) DryRun(ctx context.Context, fn func(tx *Zeus) error) ([]FileChange, error) {
+	return DryRunFileStore(ctx, z.fileStore, func(fs FileStore) error {
+		return fn(z.withFileStore(fs))
+	})
+}
+
+// DryRunFileStore は base への書き込みをステージする FileStore で fn を実行し、変更されるはずのファイルと差分を返す
+func DryRunFileStore(ctx context.Context, base FileStore, fn func(fs FileStore) error) ([]FileChange, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	store := &dryRunStore{newTransactionStore(base)}
+	if err := fn(store); err != nil {
+		return nil, err
+	}
+	return store.changes(ctx), nil
+}
+
+// isDryRunStore はドライラン用の FileStore か（ファイルストア外への書き込みを抑止するために使用）
+func isDryRunStore(fs FileStore) bool {
+	_, ok := fs.(*dryRunStore)
+	return ok
+}
+
+// changes はステージした内容と base の差分（内容が変わらない書き込みは除く）
+func (s *transactionStore) changes(ctx context.Context) []FileChange {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	paths := make([]string, 0, len(s.staged))
+	for path := range s.staged {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	changes := []FileChange{}
+	for _, path := range paths {
+		f := s.staged[path]
+		exists := s.base.Exists(ctx, path)
+		if f.deleted && !exists {
+			continue
+		}
+		var before []byte
+		if exists {
+			before = s.readBase(path)
+		}
+		after := f.data
+		if f.copyFrom != "" {
+			after = s.readBase(f.copyFrom)
+		}
+
+		change := FileChange{Path: path, Op: ChangeOpUpdate}
+		switch {
+		case f.deleted:
+			change.Op = ChangeOpDelete
+			after = nil
+		case !exists:
+			change.Op = ChangeOpCreate
+		}
+		change.Diff = unifiedDiff("a/"+path, "b/"+path, before, after)
+		if change.Diff == "" && change.Op == ChangeOpUpdate {
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// readBase は base のファイルの内容を読み込む（読めない場合は空）
+func (s *transactionStore) readBase(path string) []byte {
+	return readStoreFile(s.base, path)
+}
+
+// readFile はステージした内容を優先してファイルの内容を読み込む
+func (s *transactionStore) readFile(path string) []byte {
+	s.mu.Lock()
+	f, ok := s.lookup(path)
+	s.mu.Unlock()
+	switch {
+	case !ok:
+		return s.readBase(path)
+	case f.deleted:
+		return nil
+	case f.copyFrom != "":
+		return s.readBase(f.copyFrom)
+	}
+	return f.data
+}
+
+// stagedFileByName はステージ中のファイルのうち、ファイル名が name のものを返す（書き込みをステージする FileStore のみ）
+// 削除をステージしたファイルは deleted が true になる
+func stagedFileByName(fs FileStore, name string) (path string, data []byte, deleted bool, ok bool) {
+	var s *transactionStore
+	switch store := fs.(type) {
+	case *transactionStore:
+		s = store
+	case *dryRunStore:
+		s = store.transactionStore
+	default:
+		return "", nil, false, false
+	}
+	s.mu.Lock()
+	var paths []string
+	for p := range s.staged {
+		if filepath.Base(p) == name {
+			paths = append(paths, p)
+		}
+	}
+	if len(paths) == 1 {
+		deleted = s.staged[paths[0]].deleted
+	}
+	s.mu.Unlock()
+	if len(paths) != 1 {
+		return "", nil, false, false
+	}
+	return paths[0], s.readFile(paths[0]), deleted, true
+}
+
+// readStoreFile は FileStore のファイルの内容を読み込む（トランザクション内ではステージした内容を読む）
+func readStoreFile(fs FileStore, path string) []byte {
+	switch s := fs.(type) {
+	case *transactionStore:
+		return s.readFile(path)
+	case *dryRunStore:
+		return s.readFile(path)
+	}
+	data, err := os.ReadFile(filepath.Join(fs.BasePath(), path))
+	if err != nil {
+		return nil
+	}
+	return data
+}
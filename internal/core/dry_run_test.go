@@ -0,0 +1,79 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDryRun_ReportsChangesWithoutWriting(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	obj, err := z.Add(ctx, "objective", "Existing")
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	objPath := filepath.Join("objectives", obj.ID+".yaml")
+
+	var riskID string
+	changes, err := z.DryRun(ctx, func(tx *Zeus) error {
+		risk, err := tx.Add(ctx, "risk", "Delay", WithRiskObjective(obj.ID))
+		if err != nil {
+			return err
+		}
+		riskID = risk.ID
+		return tx.Delete(ctx, "objective", obj.ID)
+	})
+	if err != nil {
+		t.Fatalf("DryRun failed: %v", err)
+	}
+
+	riskPath := filepath.Join("risks", riskID+".yaml")
+	ops := map[string]FileChange{}
+	for _, c := range changes {
+		ops[c.Path] = c
+	}
+	if c, ok := ops[riskPath]; !ok || c.Op != ChangeOpCreate || !strings.Contains(c.Diff, "+title: Delay") {
+		t.Errorf("expected create of %s with diff, got %+v", riskPath, c)
+	}
+	if c, ok := ops[objPath]; !ok || c.Op != ChangeOpDelete || !strings.Contains(c.Diff, "-title: Existing") {
+		t.Errorf("expected delete of %s with diff, got %+v", objPath, c)
+	}
+
+	// 何も書き込まれない（ディレクトリも作成されない）
+	if !z.fileStore.Exists(ctx, objPath) {
+		t.Error("objective should not be deleted by dry-run")
+	}
+	if _, err := os.Stat(filepath.Join(z.ZeusPath, "risks")); !os.IsNotExist(err) {
+		t.Errorf("risks directory should not be created by dry-run: %v", err)
+	}
+}
+
+func TestDryRun_SkipsUnchangedFiles(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if err := z.fileStore.WriteYaml(ctx, "items/a.yaml", map[string]string{"id": "a"}); err != nil {
+		t.Fatal(err)
+	}
+	changes, err := DryRunFileStore(ctx, z.fileStore, func(fs FileStore) error {
+		if err := fs.WriteYaml(ctx, "items/a.yaml", map[string]string{"id": "a"}); err != nil {
+			return err
+		}
+		// 作成してから削除したファイルは変更に含めない
+		if err := fs.WriteYaml(ctx, "items/b.yaml", map[string]string{"id": "b"}); err != nil {
+			return err
+		}
+		return fs.Delete(ctx, "items/b.yaml")
+	})
+	if err != nil {
+		t.Fatalf("DryRunFileStore failed: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("expected no changes, got %+v", changes)
+	}
+}
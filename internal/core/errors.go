@@ -49,6 +49,48 @@ var (
 var (
 	// ErrApprovalNotPending は承認待ち状態でない
 	ErrApprovalNotPending = errors.New("approval is not in pending state")
+	// ErrApprovalRequired は操作が承認待ちキューに追加された（まだ反映されていない）
+	ErrApprovalRequired = errors.New("approval required")
+)
+
+// メンバー名簿関連エラー
+var (
+	// ErrUnknownPerson は名簿に存在しないメンバー
+	ErrUnknownPerson = errors.New("unknown person")
+)
+
+// 通知関連エラー
+var (
+	// ErrEmailNotConfigured は notifications.email.host が未設定
+	ErrEmailNotConfigured = errors.New("email notifications are not configured")
+)
+
+// 外部連携関連エラー
+var (
+	// ErrIssueSyncNotConfigured は integrations.issue_sync.provider が未設定
+	ErrIssueSyncNotConfigured = errors.New("issue sync is not configured")
+)
+
+// 楽観的排他制御関連エラー
+var (
+	// ErrRevisionRequired は更新時に期待するリビジョンが指定されていない
+	ErrRevisionRequired = errors.New("expected revision is required")
+	// ErrRevisionConflict は期待するリビジョンと現在のリビジョンが一致しない
+	ErrRevisionConflict = errors.New("revision conflict")
+)
+
+// Decision 関連エラー
+var (
+	// ErrDecisionSuperseded は既に別の Decision に置き換えられている
+	ErrDecisionSuperseded = errors.New("decision already superseded")
+)
+
+// バンドル関連エラー
+var (
+	// ErrBundleInvalid はバンドルの形式・バージョン・内容が不正
+	ErrBundleInvalid = errors.New("invalid bundle")
+	// ErrProjectExists は取り込み先に既にプロジェクトがある
+	ErrProjectExists = errors.New("project already exists")
 )
 
 // ApprovalNotPendingError は承認待ち状態でないエラー（詳細情報付き）
@@ -78,3 +120,42 @@ func (e *PathTraversalError) Error() string {
 func (e *PathTraversalError) Is(target error) bool {
 	return target == ErrPathTraversal
 }
+
+// FieldConflict は競合時のフィールドごとの差分（現在の値と更新しようとした値）
+type FieldConflict struct {
+	Field    string
+	Current  any
+	Proposed any
+}
+
+// RevisionConflictError はリビジョン競合エラー（詳細情報付き）
+type RevisionConflictError struct {
+	EntityType string
+	ID         string
+	Expected   string
+	Current    string
+	Fields     []FieldConflict // 現在の値と異なるフィールドのみ
+}
+
+func (e *RevisionConflictError) Error() string {
+	return fmt.Sprintf("%s %s was modified (expected revision %s, current %s)", e.EntityType, e.ID, e.Expected, e.Current)
+}
+
+func (e *RevisionConflictError) Is(target error) bool {
+	return target == ErrRevisionConflict
+}
+
+// ApprovalRequiredError は承認が必要なため操作を承認待ちキューに追加したことを示す
+type ApprovalRequiredError struct {
+	Action     string // 例: objective_delete
+	ApprovalID string
+	EntityID   string // 対象の ID（create では承認後に作成される ID。不明な場合は空）
+}
+
+func (e *ApprovalRequiredError) Error() string {
+	return fmt.Sprintf("%s requires approval (queued as %s)", e.Action, e.ApprovalID)
+}
+
+func (e *ApprovalRequiredError) Is(target error) bool {
+	return target == ErrApprovalRequired
+}
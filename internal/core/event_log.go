@@ -0,0 +1,209 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/yaml"
+)
+
+// イベントログの配置と保持件数
+const (
+	eventLogFile          = "logs/events.jsonl"
+	DefaultEventLogRetain = 1000 // 保持するイベント数（超過分は追記時にまとめて切り詰め）
+)
+
+// EventRecord はイベントログの 1 行（JSON Lines）
+type EventRecord struct {
+	Seq  uint64          `json:"seq"`
+	Time string          `json:"time"`
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// EventLog はイベントの追記専用ログ（ダッシュボードが配信したイベント、監査ログ）
+// シーケンス番号は切り詰め後も単調増加し、SSE の Last-Event-ID として使用する。
+// 追記はファイルロックを取得してから行い、ほかのプロセス（CLI とダッシュボード）が追記した分を含めて採番する
+type EventLog struct {
+	path   string
+	retain int
+	lock   *yaml.FileLock
+
+	mu      sync.Mutex
+	seen    os.FileInfo // lastSeq, lines を読み込んだ時点のファイルの状態（変わっていれば読み直す）
+	lastSeq uint64
+	lines   int
+}
+
+// NewEventLog は path にイベントログを作成（retain が 0 以下の場合はデフォルト）
+func NewEventLog(path string, retain int) *EventLog {
+	if retain <= 0 {
+		retain = DefaultEventLogRetain
+	}
+	return &EventLog{path: path, retain: retain, lock: yaml.NewFileLock(path)}
+}
+
+// EventLog はプロジェクトのイベントログ（.zeus/logs/events.jsonl）を返す
+func (z *Zeus) EventLog() *EventLog {
+	return z.eventLog
+}
+
+// Append はイベントを追記し、採番したレコードを返す
+func (l *EventLog) Append(ctx context.Context, eventType string, data any) (EventRecord, error) {
+	if err := ctx.Err(); err != nil {
+		return EventRecord{}, err
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return EventRecord{}, fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return EventRecord{}, err
+	}
+	if err := l.lock.LockWithTimeout(5 * time.Second); err != nil {
+		return EventRecord{}, ErrLockAcquireFailed
+	}
+	defer l.lock.Unlock()
+	if err := l.sync(); err != nil {
+		return EventRecord{}, err
+	}
+
+	record := EventRecord{Seq: l.lastSeq + 1, Time: Now(), Type: eventType, Data: raw}
+	line, err := json.Marshal(record)
+	if err != nil {
+		return EventRecord{}, fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return EventRecord{}, err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return EventRecord{}, err
+	}
+	if err := f.Close(); err != nil {
+		return EventRecord{}, err
+	}
+	l.lastSeq = record.Seq
+	l.lines++
+
+	// 保持件数の 2 倍を超えたら古いイベントを切り詰める（追記のたびに書き直さない）
+	if l.lines > l.retain*2 {
+		if err := l.compact(); err != nil {
+			return record, err
+		}
+	}
+	l.seen, _ = os.Stat(l.path)
+	return record, nil
+}
+
+// sync は前回の読み込み以降にファイルが変わっていれば（ほかのプロセスの追記・切り詰め）最後の seq と行数を読み直す
+// ファイルロックを取得した状態で呼ぶ
+func (l *EventLog) sync() error {
+	info, err := os.Stat(l.path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read event log: %w", err)
+	}
+	if info != nil && l.seen != nil && os.SameFile(info, l.seen) && info.Size() == l.seen.Size() && info.ModTime().Equal(l.seen.ModTime()) {
+		return nil
+	}
+	records, err := l.readAll()
+	if err != nil {
+		return err
+	}
+	// ファイルが削除された場合も、配信済みの seq より小さい番号は振らない
+	if len(records) > 0 && records[len(records)-1].Seq > l.lastSeq {
+		l.lastSeq = records[len(records)-1].Seq
+	}
+	l.lines = len(records)
+	l.seen = info
+	return nil
+}
+
+// Since は seq より後のイベントを古い順に返す（ログが無い場合は空）
+func (l *EventLog) Since(ctx context.Context, seq uint64) ([]EventRecord, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	records, err := l.readAll()
+	if err != nil {
+		return nil, err
+	}
+	for i, r := range records {
+		if r.Seq > seq {
+			return records[i:], nil
+		}
+	}
+	return []EventRecord{}, nil
+}
+
+// readAll はログ全体を読み込む（解析できない行は読み飛ばす）
+func (l *EventLog) readAll() ([]EventRecord, error) {
+	data, err := os.ReadFile(l.path)
+	if os.IsNotExist(err) {
+		return []EventRecord{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read event log: %w", err)
+	}
+
+	records := []EventRecord{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var r EventRecord
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil || r.Seq == 0 {
+			continue
+		}
+		records = append(records, r)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read event log: %w", err)
+	}
+	return records, nil
+}
+
+// compact は直近 retain 件のみを残してログを書き直す
+func (l *EventLog) compact() error {
+	records, err := l.readAll()
+	if err != nil {
+		return err
+	}
+	if len(records) > l.retain {
+		records = records[len(records)-l.retain:]
+	}
+
+	var buf bytes.Buffer
+	for _, r := range records {
+		line, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	tmp := l.path + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, l.path); err != nil {
+		return err
+	}
+	l.lines = len(records)
+	return nil
+}
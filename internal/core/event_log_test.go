@@ -0,0 +1,148 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestEventLog_AppendSince(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "logs", "events.jsonl")
+	log := NewEventLog(path, 0)
+
+	// ログが無い場合は空
+	records, err := log.Since(ctx, 0)
+	if err != nil || len(records) != 0 {
+		t.Fatalf("Since() on missing log = %v, %v", records, err)
+	}
+
+	for i, typ := range []string{"status", "graph", "report"} {
+		record, err := log.Append(ctx, typ, map[string]int{"n": i})
+		if err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+		if record.Seq != uint64(i+1) {
+			t.Errorf("Append seq = %d, want %d", record.Seq, i+1)
+		}
+	}
+
+	records, err = log.Since(ctx, 1)
+	if err != nil {
+		t.Fatalf("Since failed: %v", err)
+	}
+	if len(records) != 2 || records[0].Seq != 2 || records[1].Type != "report" || string(records[1].Data) != `{"n":2}` {
+		t.Errorf("Since(1) = %+v", records)
+	}
+	if records, _ := log.Since(ctx, 3); len(records) != 0 {
+		t.Errorf("Since(last) = %+v, want empty", records)
+	}
+
+	// 別インスタンス（再起動）でも採番を継続し、壊れた行は読み飛ばす
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+	_, _ = f.WriteString("{broken\n")
+	f.Close()
+
+	reopened := NewEventLog(path, 0)
+	record, err := reopened.Append(ctx, "status", nil)
+	if err != nil {
+		t.Fatalf("Append after reopen failed: %v", err)
+	}
+	if record.Seq != 4 {
+		t.Errorf("seq after reopen = %d, want 4", record.Seq)
+	}
+	if records, _ := reopened.Since(ctx, 0); len(records) != 4 {
+		t.Errorf("expected 4 records, got %d", len(records))
+	}
+}
+
+func TestEventLog_Compaction(t *testing.T) {
+	ctx := context.Background()
+	log := NewEventLog(filepath.Join(t.TempDir(), "events.jsonl"), 3)
+
+	for i := 0; i < 7; i++ {
+		if _, err := log.Append(ctx, "status", i); err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+	}
+	// 6 件（retain の 2 倍）を超えた時点で直近 3 件に切り詰める
+	records, err := log.Since(ctx, 0)
+	if err != nil {
+		t.Fatalf("Since failed: %v", err)
+	}
+	if len(records) != 3 || records[0].Seq != 5 || records[2].Seq != 7 {
+		t.Fatalf("records after compaction = %+v", records)
+	}
+
+	// 切り詰め後も採番は単調増加
+	record, err := log.Append(ctx, "status", 7)
+	if err != nil {
+		t.Fatalf("Append failed: %v", err)
+	}
+	if record.Seq != 8 {
+		t.Errorf("seq after compaction = %d, want 8", record.Seq)
+	}
+}
+
+func TestEventLog_AppendFromMultipleWriters(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "logs", "events.jsonl")
+	// ダッシュボード（長時間動作）と CLI のように別々のインスタンスから追記しても seq は重複しない
+	dashboard, cli := NewEventLog(path, 0), NewEventLog(path, 0)
+	if _, err := dashboard.Append(ctx, "status", nil); err != nil {
+		t.Fatalf("Append failed: %v", err)
+	}
+	if _, err := cli.Append(ctx, "escalation", nil); err != nil {
+		t.Fatalf("Append failed: %v", err)
+	}
+	record, err := dashboard.Append(ctx, "status", nil)
+	if err != nil {
+		t.Fatalf("Append failed: %v", err)
+	}
+	if record.Seq != 3 {
+		t.Errorf("seq = %d, want 3 (should include the other writer's event)", record.Seq)
+	}
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			log := NewEventLog(path, 0)
+			for range 10 {
+				if _, err := log.Append(ctx, "status", i); err != nil {
+					t.Errorf("Append failed: %v", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	records, err := dashboard.Since(ctx, 0)
+	if err != nil {
+		t.Fatalf("Since failed: %v", err)
+	}
+	if len(records) != 83 {
+		t.Fatalf("expected 83 records, got %d", len(records))
+	}
+	for i, r := range records {
+		if r.Seq != uint64(i+1) {
+			t.Fatalf("records[%d].Seq = %d, want %d", i, r.Seq, i+1)
+		}
+	}
+}
+
+func TestZeus_EventLogsAreShared(t *testing.T) {
+	z := New(t.TempDir())
+	if z.AuditLog() != z.AuditLog() || z.EventLog() != z.EventLog() {
+		t.Error("Zeus should keep one event log and one audit log")
+	}
+	if tx := z.withFileStore(z.fileStore); tx.AuditLog() != z.AuditLog() {
+		t.Error("transactions should share the audit log")
+	}
+}
@@ -0,0 +1,170 @@
+package core
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/xlsx"
+)
+
+// ExportWorkbook は Activity 一覧・タイムライン・リスク登録簿のシートを持つブックを構築
+func (z *Zeus) ExportWorkbook(ctx context.Context) (*xlsx.Workbook, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return &xlsx.Workbook{Sheets: []xlsx.Sheet{
+		z.activitySheet(ctx),
+		z.timelineSheet(ctx),
+		z.riskSheet(ctx),
+	}}, nil
+}
+
+// activitySheet は Activity 一覧（UseCase 経由で Objective を解決）
+func (z *Zeus) activitySheet(ctx context.Context) xlsx.Sheet {
+	usecaseObjective := map[string]string{}
+	z.forEachYaml(ctx, "usecases", func(path string) {
+		var u UseCaseEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &u); err == nil {
+			usecaseObjective[u.ID] = u.ObjectiveID
+		}
+	})
+
+	var activities []ActivityEntity
+	z.forEachYaml(ctx, "activities", func(path string) {
+		var a ActivityEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &a); err == nil {
+			activities = append(activities, a)
+		}
+	})
+	sort.SliceStable(activities, func(i, j int) bool {
+		return planLess(activities[i].Metadata.CreatedAt, activities[i].ID, activities[j].Metadata.CreatedAt, activities[j].ID)
+	})
+
+	rows := [][]string{{"ID", "Title", "Status", "Objective", "UseCase", "Owner", "Tags", "Created", "Updated"}}
+	for _, a := range activities {
+		rows = append(rows, []string{
+			a.ID,
+			a.Title,
+			string(a.Status),
+			usecaseObjective[a.UseCaseID],
+			a.UseCaseID,
+			a.Metadata.Owner,
+			strings.Join(a.Metadata.Tags, ", "),
+			sheetDate(a.Metadata.CreatedAt),
+			sheetDate(a.Metadata.UpdatedAt),
+		})
+	}
+	return xlsx.Sheet{Name: "Activities", Rows: rows}
+}
+
+// TimelineEvent は日付を持つ項目（Consideration の期限、Risk のレビュー日、Decision の決定日）
+type TimelineEvent struct {
+	Date   string `json:"date"`  // YYYY-MM-DD
+	Event  string `json:"event"` // consideration due, risk review, decision
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+}
+
+// Timeline は日付を持つ項目を日付順に返す
+func (z *Zeus) Timeline(ctx context.Context) ([]TimelineEvent, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	events := []TimelineEvent{}
+	z.forEachYaml(ctx, "considerations", func(path string) {
+		var c ConsiderationEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &c); err == nil && c.DueDate != "" {
+			events = append(events, TimelineEvent{sheetDate(c.DueDate), "consideration due", c.ID, c.Title, string(c.Status)})
+		}
+	})
+	z.forEachYaml(ctx, "risks", func(path string) {
+		var r RiskEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &r); err == nil && r.ReviewDate != "" {
+			events = append(events, TimelineEvent{sheetDate(r.ReviewDate), "risk review", r.ID, r.Title, string(r.Status)})
+		}
+	})
+	supersededBy, _ := z.DecisionSupersededBy(ctx)
+	z.forEachYaml(ctx, "decisions", func(path string) {
+		var d DecisionEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &d); err == nil && d.DecidedAt != "" {
+			status := "decided"
+			if next, ok := supersededBy[d.ID]; ok {
+				status = "superseded by " + next
+			}
+			if d.Supersedes != "" {
+				status += " (supersedes " + d.Supersedes + ")"
+			}
+			events = append(events, TimelineEvent{sheetDate(d.DecidedAt), "decision", d.ID, d.Title, status})
+		}
+	})
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].Date != events[j].Date {
+			return events[i].Date < events[j].Date
+		}
+		return events[i].ID < events[j].ID
+	})
+	return events, ctx.Err()
+}
+
+// timelineSheet は日付を持つ項目を日付順に並べる
+func (z *Zeus) timelineSheet(ctx context.Context) xlsx.Sheet {
+	events, _ := z.Timeline(ctx)
+	rows := [][]string{{"Date", "Event", "ID", "Title", "Status"}}
+	for _, e := range events {
+		rows = append(rows, []string{e.Date, e.Event, e.ID, e.Title, e.Status})
+	}
+	return xlsx.Sheet{Name: "Timeline", Rows: rows}
+}
+
+// riskSheet はリスク登録簿（スコアの高い順）
+func (z *Zeus) riskSheet(ctx context.Context) xlsx.Sheet {
+	var risks []RiskEntity
+	z.forEachYaml(ctx, "risks", func(path string) {
+		var r RiskEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &r); err == nil {
+			risks = append(risks, r)
+		}
+	})
+	rank := func(r RiskEntity) int {
+		if v, ok := severityRank[string(r.RiskScore)]; ok {
+			return v
+		}
+		return len(severityRank)
+	}
+	sort.SliceStable(risks, func(i, j int) bool {
+		if rank(risks[i]) != rank(risks[j]) {
+			return rank(risks[i]) < rank(risks[j])
+		}
+		return risks[i].ID < risks[j].ID
+	})
+
+	rows := [][]string{{"ID", "Title", "Status", "Probability", "Impact", "Score", "Owner", "Objective", "Review Date", "Trigger", "Preventive", "Contingent"}}
+	for _, r := range risks {
+		rows = append(rows, []string{
+			r.ID,
+			r.Title,
+			string(r.Status),
+			string(r.Probability),
+			string(r.Impact),
+			string(r.RiskScore),
+			r.Owner,
+			r.ObjectiveID,
+			sheetDate(r.ReviewDate),
+			r.Trigger,
+			strings.Join(r.Mitigation.Preventive, "\n"),
+			strings.Join(r.Mitigation.Contingent, "\n"),
+		})
+	}
+	return xlsx.Sheet{Name: "Risks", Rows: rows}
+}
+
+// sheetDate は RFC3339 の日時を YYYY-MM-DD に揃える（解析できない値はそのまま）
+func sheetDate(value string) string {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.Format("2006-01-02")
+	}
+	return value
+}
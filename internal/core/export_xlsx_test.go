@@ -0,0 +1,95 @@
+package core
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestExportWorkbook(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	obj, err := z.Add(ctx, "objective", "Launch")
+	if err != nil {
+		t.Fatalf("Add objective failed: %v", err)
+	}
+	uc, err := z.Add(ctx, "usecase", "Sign up", WithUseCaseObjective(obj.ID))
+	if err != nil {
+		t.Fatalf("Add usecase failed: %v", err)
+	}
+	act, err := z.Add(ctx, "activity", "Build form", WithActivityUseCase(uc.ID), WithActivityTags([]string{"web", "p1"}))
+	if err != nil {
+		t.Fatalf("Add activity failed: %v", err)
+	}
+	low, err := z.Add(ctx, "risk", "Minor delay", WithRiskProbability(RiskProbabilityLow), WithRiskImpact(RiskImpactLow))
+	if err != nil {
+		t.Fatalf("Add risk failed: %v", err)
+	}
+	high, err := z.Add(ctx, "risk", "Vendor outage",
+		WithRiskProbability(RiskProbabilityHigh), WithRiskImpact(RiskImpactCritical), WithRiskReviewDate("2026-03-01"))
+	if err != nil {
+		t.Fatalf("Add risk failed: %v", err)
+	}
+	con, err := z.Add(ctx, "consideration", "Pick vendor", WithConsiderationDueDate("2026-02-01"))
+	if err != nil {
+		t.Fatalf("Add consideration failed: %v", err)
+	}
+
+	wb, err := z.ExportWorkbook(ctx)
+	if err != nil {
+		t.Fatalf("ExportWorkbook failed: %v", err)
+	}
+	if err := wb.Validate(); err != nil {
+		t.Fatalf("workbook should be valid: %v", err)
+	}
+
+	var names []string
+	for _, s := range wb.Sheets {
+		names = append(names, s.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"Activities", "Timeline", "Risks"}) {
+		t.Fatalf("unexpected sheets: %v", names)
+	}
+
+	activities := wb.Sheets[0].Rows
+	if len(activities) != 2 {
+		t.Fatalf("expected header + 1 activity, got %d rows", len(activities))
+	}
+	row := activities[1]
+	if row[0] != act.ID || row[3] != obj.ID || row[4] != uc.ID || row[6] != "web, p1" {
+		t.Errorf("unexpected activity row: %v", row)
+	}
+	if len(row[7]) != len("2006-01-02") {
+		t.Errorf("created date should be YYYY-MM-DD, got %q", row[7])
+	}
+
+	timeline := wb.Sheets[1].Rows
+	if len(timeline) != 3 || timeline[1][2] != con.ID || timeline[2][2] != high.ID {
+		t.Errorf("timeline should be in date order: %v", timeline)
+	}
+
+	risks := wb.Sheets[2].Rows
+	if len(risks) != 3 || risks[1][0] != high.ID || risks[2][0] != low.ID {
+		t.Errorf("risks should be sorted by score: %v", risks)
+	}
+	if risks[1][5] != string(RiskScoreCritical) || risks[1][8] != "2026-03-01" {
+		t.Errorf("unexpected risk row: %v", risks[1])
+	}
+}
+
+func TestSheetDate(t *testing.T) {
+	tests := map[string]string{
+		"2026-01-05T10:00:00Z": "2026-01-05",
+		"2026-01-05":           "2026-01-05",
+		"":                     "",
+	}
+	for input, want := range tests {
+		if got := sheetDate(input); got != want {
+			t.Errorf("sheetDate(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrGitUnavailable は git が使えない（git が無い、または .zeus が git 管理下に無い）
+var ErrGitUnavailable = errors.New("git is not available for this project")
+
+// ReadEntityAtRevision は git の ref（コミット・ブランチ・タグ）時点のエンティティを out に読み込む
+// エンティティ種別は ID の接頭辞から推定する。その時点にファイルが無い場合は ErrEntityNotFound
+func (z *Zeus) ReadEntityAtRevision(ctx context.Context, id, ref string, out any) error {
+	entityType, ok := EntityTypeFromID(id)
+	if !ok {
+		return fmt.Errorf("%w: cannot infer from ID %s", ErrUnknownEntity, id)
+	}
+	path, err := entityRelativePath(entityType, id)
+	if err != nil {
+		return err
+	}
+	if strings.HasPrefix(ref, "-") {
+		return fmt.Errorf("invalid git ref: %s", ref)
+	}
+
+	// "<ref>:./<path>" は git -C のディレクトリからの相対パスとして解決される
+	cmd := exec.CommandContext(ctx, "git", "-C", z.ZeusPath, "show", ref+":./"+filepath.ToSlash(path))
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		switch {
+		case errors.Is(err, exec.ErrNotFound), strings.Contains(msg, "not a git repository"):
+			return fmt.Errorf("%w: %s", ErrGitUnavailable, msg)
+		case strings.Contains(msg, "does not exist in"), strings.Contains(msg, "exists on disk, but not in"):
+			return fmt.Errorf("%w: %s at %s", ErrEntityNotFound, id, ref)
+		case msg != "":
+			return fmt.Errorf("git show %s: %s", ref, msg)
+		default:
+			return fmt.Errorf("git show %s: %w", ref, err)
+		}
+	}
+	if err := yaml.Unmarshal(stdout.Bytes(), out); err != nil {
+		return fmt.Errorf("%w: %s at %s: %v", ErrYamlSyntax, path, ref, err)
+	}
+	return nil
+}
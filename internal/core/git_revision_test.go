@@ -0,0 +1,62 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"testing"
+)
+
+func TestReadEntityAtRevision(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	ctx := context.Background()
+	dir := t.TempDir()
+	z := New(dir)
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+	}
+
+	result, err := z.Add(ctx, "activity", "ログイン")
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	// git 管理下に無い
+	var act ActivityEntity
+	if err := z.ReadEntityAtRevision(ctx, result.ID, "HEAD", &act); !errors.Is(err, ErrGitUnavailable) {
+		t.Errorf("expected ErrGitUnavailable, got %v", err)
+	}
+
+	git("init", "-q")
+	git("add", "-A")
+	git("commit", "-q", "-m", "initial")
+	revision, err := z.EntityRevision(ctx, "activity", result.ID)
+	if err != nil {
+		t.Fatalf("EntityRevision failed: %v", err)
+	}
+	if _, err := z.UpdateEntity(ctx, "activity", result.ID, map[string]any{"title": "ログイン（改）"}, revision); err != nil {
+		t.Fatalf("UpdateEntity failed: %v", err)
+	}
+
+	if err := z.ReadEntityAtRevision(ctx, result.ID, "HEAD", &act); err != nil {
+		t.Fatalf("ReadEntityAtRevision failed: %v", err)
+	}
+	if act.Title != "ログイン" {
+		t.Errorf("Title at HEAD = %q, want ログイン", act.Title)
+	}
+
+	if err := z.ReadEntityAtRevision(ctx, "act-00000000", "HEAD", &act); !errors.Is(err, ErrEntityNotFound) {
+		t.Errorf("expected ErrEntityNotFound, got %v", err)
+	}
+	if err := z.ReadEntityAtRevision(ctx, result.ID, "no-such-ref", &act); err == nil {
+		t.Error("expected error for unknown ref")
+	}
+}
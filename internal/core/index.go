@@ -0,0 +1,226 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// IndexEntry は一覧表示用の軽量エントリ
+// エンティティ本体（シナリオ・フロー等）をパースせずに一覧を返すために使用する
+type IndexEntry struct {
+	ID        string `yaml:"id"`
+	Title     string `yaml:"title"`
+	Status    string `yaml:"status,omitempty"`
+	CreatedAt string `yaml:"created_at,omitempty"`
+	UpdatedAt string `yaml:"updated_at,omitempty"`
+
+	// エントリを作成した時点のファイルの更新日時（UnixNano）とサイズ（手動編集の検知に使用）
+	ModTime int64 `yaml:"mtime,omitempty"`
+	Size    int64 `yaml:"size,omitempty"`
+}
+
+// EntityIndex はエンティティ種別ごとのインデックスファイル（index/<directory>.yaml）
+type EntityIndex struct {
+	Entity    string       `yaml:"entity"`
+	UpdatedAt string       `yaml:"updated_at"`
+	Items     []IndexEntry `yaml:"items"`
+}
+
+// entityIndex は個別ファイル形式のエンティティディレクトリに対するインデックス管理
+// インデックスは生成物であり、欠損・不整合時はディレクトリから再構築する
+type entityIndex struct {
+	fileStore FileStore
+	entity    string
+	directory string
+}
+
+// newEntityIndex は entityIndex を生成
+func newEntityIndex(fs FileStore, entity, directory string) *entityIndex {
+	return &entityIndex{
+		fileStore: fs,
+		entity:    entity,
+		directory: directory,
+	}
+}
+
+// path はインデックスファイルの相対パスを返す
+func (x *entityIndex) path() string {
+	return filepath.Join("index", x.directory+".yaml")
+}
+
+// load はインデックスを読み込む（存在しない場合は空のインデックス）
+func (x *entityIndex) load(ctx context.Context) (*EntityIndex, error) {
+	idx := &EntityIndex{Entity: x.entity, Items: []IndexEntry{}}
+	if !x.fileStore.Exists(ctx, x.path()) {
+		return idx, nil
+	}
+	if err := x.fileStore.ReadYaml(ctx, x.path(), idx); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+// stat はエンティティファイルの更新日時とサイズを返す（ステージ中などでディスク上に無い場合は 0）
+func (x *entityIndex) stat(file string) (int64, int64) {
+	info, err := os.Stat(filepath.Join(x.fileStore.BasePath(), x.directory, file))
+	if err != nil {
+		return 0, 0
+	}
+	return info.ModTime().UnixNano(), info.Size()
+}
+
+// save はインデックスを ID 順に整列して書き込む
+func (x *entityIndex) save(ctx context.Context, idx *EntityIndex) error {
+	sort.Slice(idx.Items, func(i, j int) bool {
+		return idx.Items[i].ID < idx.Items[j].ID
+	})
+	idx.Entity = x.entity
+	idx.UpdatedAt = Now()
+	if err := x.fileStore.WriteYaml(ctx, x.path(), idx); err != nil {
+		return fmt.Errorf("failed to write %s index: %w", x.entity, err)
+	}
+	return nil
+}
+
+// Upsert はエントリを追加または置換（エンティティファイルを書き込んだ後に呼ぶ）
+func (x *entityIndex) Upsert(ctx context.Context, entry IndexEntry) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	entry.ModTime, entry.Size = x.stat(entry.ID + ".yaml")
+
+	idx, err := x.load(ctx)
+	if err != nil {
+		// 壊れたインデックスは作り直す
+		idx = &EntityIndex{Entity: x.entity, Items: []IndexEntry{}}
+	}
+
+	replaced := false
+	for i := range idx.Items {
+		if idx.Items[i].ID == entry.ID {
+			idx.Items[i] = entry
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		idx.Items = append(idx.Items, entry)
+	}
+
+	return x.save(ctx, idx)
+}
+
+// Remove はエントリを削除
+func (x *entityIndex) Remove(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	idx, err := x.load(ctx)
+	if err != nil {
+		idx = &EntityIndex{Entity: x.entity, Items: []IndexEntry{}}
+	}
+
+	items := make([]IndexEntry, 0, len(idx.Items))
+	for _, item := range idx.Items {
+		if item.ID != id {
+			items = append(items, item)
+		}
+	}
+	idx.Items = items
+
+	return x.save(ctx, idx)
+}
+
+// Rebuild はエントリ一覧でインデックスを置き換える
+func (x *entityIndex) Rebuild(ctx context.Context, entries []IndexEntry) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	items := make([]IndexEntry, len(entries))
+	copy(items, entries)
+	return x.save(ctx, &EntityIndex{Items: items})
+}
+
+// Entries はディレクトリ内のエンティティのインデックスエントリを返す
+// ファイルの更新日時・サイズがインデックスと一致するエントリは本体をパースせずに返し、
+// 追加・変更されたファイル（手動編集・git merge 等）のみ parse で読み込んでインデックスを更新する。
+// インデックスは生成物のため、更新の書き込み失敗は一覧取得を妨げない。
+func (x *entityIndex) Entries(ctx context.Context, files []string, parse func(file string) (IndexEntry, bool)) []IndexEntry {
+	indexed := map[string]IndexEntry{}
+	stored := 0
+	if x.fileStore.Exists(ctx, x.path()) {
+		if idx, err := x.load(ctx); err == nil {
+			for _, item := range idx.Items {
+				indexed[item.ID] = item
+			}
+			stored = len(idx.Items)
+		}
+	}
+
+	entries := make([]IndexEntry, 0, len(files))
+	changed, complete := false, true
+	for _, file := range files {
+		if !hasYamlSuffix(file) {
+			continue
+		}
+		modTime, size := x.stat(file)
+		if item, ok := indexed[trimYamlSuffix(file)]; ok && item.ModTime == modTime && item.Size == size {
+			entries = append(entries, item)
+			continue
+		}
+		entry, ok := parse(file)
+		if !ok {
+			complete = false
+			continue // 読み込み失敗はスキップ
+		}
+		entry.ModTime, entry.Size = modTime, size
+		entries = append(entries, entry)
+		changed = true
+	}
+
+	// 全ファイルを読み込めた場合のみ更新（壊れたファイルがあると毎回そのファイルをパースする）
+	if (changed || len(entries) != stored) && complete {
+		_ = x.Rebuild(ctx, entries)
+	}
+
+	return entries
+}
+
+// indexEntriesToListItems はインデックスエントリを ListItem に変換
+func indexEntriesToListItems(entries []IndexEntry) []ListItem {
+	items := make([]ListItem, 0, len(entries))
+	for _, entry := range entries {
+		items = append(items, ListItem{
+			ID:        entry.ID,
+			Title:     entry.Title,
+			Status:    ItemStatus(entry.Status),
+			CreatedAt: entry.CreatedAt,
+			UpdatedAt: entry.UpdatedAt,
+		})
+	}
+	return items
+}
+
+// trimYamlSuffix は .yaml / .yml 拡張子を除去
+func trimYamlSuffix(filename string) string {
+	ext := filepath.Ext(filename)
+	if ext == ".yaml" || ext == ".yml" {
+		return filename[:len(filename)-len(ext)]
+	}
+	return filename
+}
+
+// countYamlFiles は YAML ファイルの数を数える
+func countYamlFiles(files []string) int {
+	count := 0
+	for _, file := range files {
+		if hasYamlSuffix(file) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,201 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestActivityIndex_MaintainedOnWrite(t *testing.T) {
+	handler, zeusPath, cleanup := setupActivityHandlerTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	first, err := handler.Add(ctx, "First")
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	second, err := handler.Add(ctx, "Second")
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(zeusPath, "index", "activities.yaml")); err != nil {
+		t.Fatalf("index file should be created: %v", err)
+	}
+
+	if err := handler.Update(ctx, first.ID, map[string]any{"title": "Renamed", "status": "active"}); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if err := handler.Delete(ctx, second.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	idx, err := handler.index.load(ctx)
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if len(idx.Items) != 1 {
+		t.Fatalf("expected 1 index entry, got %d", len(idx.Items))
+	}
+	entry := idx.Items[0]
+	if entry.ID != first.ID || entry.Title != "Renamed" || entry.Status != "active" {
+		t.Errorf("unexpected index entry: %+v", entry)
+	}
+}
+
+func TestActivityIndex_ListUsesIndex(t *testing.T) {
+	handler, _, cleanup := setupActivityHandlerTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	result, err := handler.Add(ctx, "Indexed")
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+
+	// インデックスのみ書き換えて、List がインデックスを参照していることを確認
+	if err := handler.index.Upsert(ctx, IndexEntry{ID: result.ID, Title: "From Index", Status: "draft"}); err != nil {
+		t.Fatalf("Upsert failed: %v", err)
+	}
+
+	list, err := handler.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if list.Total != 1 || list.Items[0].Title != "From Index" {
+		t.Errorf("List should be served from index, got %+v", list.Items)
+	}
+}
+
+func TestActivityIndex_RebuildOnMismatch(t *testing.T) {
+	handler, zeusPath, cleanup := setupActivityHandlerTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	if _, err := handler.Add(ctx, "Tracked"); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+
+	// インデックスを経由せずにファイルを追加（手動編集・git merge 相当）
+	manual := "id: act-00000001\ntitle: Manual\nstatus: draft\n"
+	if err := os.WriteFile(filepath.Join(zeusPath, "activities", "act-00000001.yaml"), []byte(manual), 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	list, err := handler.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if list.Total != 2 {
+		t.Fatalf("expected 2 items after rebuild, got %d", list.Total)
+	}
+
+	idx, err := handler.index.load(ctx)
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if len(idx.Items) != 2 {
+		t.Errorf("index should be rebuilt with 2 entries, got %d", len(idx.Items))
+	}
+}
+
+func TestActivityIndex_RefreshOnEdit(t *testing.T) {
+	handler, zeusPath, cleanup := setupActivityHandlerTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	result, err := handler.Add(ctx, "Tracked")
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if _, err := handler.Add(ctx, "Untouched"); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+
+	// ファイル名は変えずに内容だけを編集（手動編集・git pull 相当）
+	path := filepath.Join(zeusPath, "activities", result.ID+".yaml")
+	edited := "id: " + result.ID + "\ntitle: Edited by hand\nstatus: active\n"
+	if err := os.WriteFile(path, []byte(edited), 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatalf("Chtimes failed: %v", err)
+	}
+
+	list, err := handler.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	titles := map[string]string{}
+	for _, item := range list.Items {
+		titles[item.ID] = item.Title
+	}
+	if list.Total != 2 || titles[result.ID] != "Edited by hand" {
+		t.Fatalf("edited file should be re-read: %+v", list.Items)
+	}
+
+	idx, err := handler.index.load(ctx)
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	for _, item := range idx.Items {
+		if item.ID == result.ID && (item.Title != "Edited by hand" || item.ModTime != later.UnixNano()) {
+			t.Errorf("index should be refreshed with the edited file: %+v", item)
+		}
+	}
+}
+
+func TestTrimYamlSuffix(t *testing.T) {
+	tests := map[string]string{
+		"act-001.yaml": "act-001",
+		"act-001.yml":  "act-001",
+		"act-001.txt":  "act-001.txt",
+	}
+	for input, want := range tests {
+		if got := trimYamlSuffix(input); got != want {
+			t.Errorf("trimYamlSuffix(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestUseCaseIndex_MaintainedOnWrite(t *testing.T) {
+	handler, _, _, cleanup := setupUseCaseHandlerTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	result, err := handler.Add(ctx, "Indexed UseCase", WithUseCaseObjective("obj-001"))
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if err := handler.Update(ctx, result.ID, map[string]any{"title": "Renamed UseCase"}); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	list, err := handler.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if list.Total != 1 || list.Items[0].Title != "Renamed UseCase" {
+		t.Errorf("unexpected list: %+v", list.Items)
+	}
+
+	if err := handler.Delete(ctx, result.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	idx, err := handler.index.load(ctx)
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if len(idx.Items) != 0 {
+		t.Errorf("index should be empty after delete, got %d", len(idx.Items))
+	}
+}
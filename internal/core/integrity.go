@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 )
 
 // 整合性チェック用エラーメッセージ定数
@@ -15,9 +16,14 @@ const (
 	ErrMsgReferencedSubsystemNotFound     = "referenced subsystem not found"
 	ErrMsgReferencedActorNotFound         = "referenced actor not found"
 	ErrMsgReferencedUseCaseNotFound       = "referenced usecase not found"
+	ErrMsgReferencedActivityNotFound      = "referenced activity not found"
+	ErrMsgReferencedQualityNotFound       = "referenced quality not found"
+	ErrMsgReferencedContainerNotFound     = "referenced container not found"
+	ErrMsgReferencedComponentNotFound     = "referenced component not found"
 	// 必須フィールド欠損メッセージ
 	ErrMsgObjectiveIDRequired     = "objective_id is required but missing"
 	ErrMsgConsiderationIDRequired = "consideration_id is required but missing"
+	ErrMsgContainerIDRequired     = "container_id is required but missing"
 
 	// 無効なID形式メッセージ
 	ErrMsgInvalidSubsystemIDFormat = "invalid subsystem ID format"
@@ -41,6 +47,10 @@ type IntegrityChecker struct {
 	subsystemHandler     *SubsystemHandler
 	activityHandler      *ActivityHandler
 	actorHandler         *ActorHandler
+	releaseHandler       *ReleaseHandler
+	containerHandler     *ContainerHandler
+	componentHandler     *ComponentHandler
+	progress             IntegrityProgressFunc
 }
 
 // NewIntegrityChecker は新しい IntegrityChecker を作成
@@ -100,6 +110,21 @@ func (c *IntegrityChecker) SetActorHandler(h *ActorHandler) {
 	c.actorHandler = h
 }
 
+// SetReleaseHandler は ReleaseHandler を設定
+func (c *IntegrityChecker) SetReleaseHandler(h *ReleaseHandler) {
+	c.releaseHandler = h
+}
+
+// SetContainerHandler は ContainerHandler を設定
+func (c *IntegrityChecker) SetContainerHandler(h *ContainerHandler) {
+	c.containerHandler = h
+}
+
+// SetComponentHandler は ComponentHandler を設定
+func (c *IntegrityChecker) SetComponentHandler(h *ComponentHandler) {
+	c.componentHandler = h
+}
+
 // ReferenceError は参照エラーを表す
 type ReferenceError struct {
 	SourceType string // エンティティ種別（"objective", "quality", "usecase" 等）
@@ -149,34 +174,30 @@ func (w *ReferenceWarning) Warning() string {
 }
 
 // CheckAll は全ての整合性チェックを実行
+// エンティティは種別ごとに一度だけ並列に読み込み、参照チェック・警告チェックも並列に実行する
+// 結果はチェックの定義順に連結するため、並列実行による順序の揺れはない
 func (c *IntegrityChecker) CheckAll(ctx context.Context) (*IntegrityResult, error) {
-	result := &IntegrityResult{
-		Valid:           true,
-		ReferenceErrors: []*ReferenceError{},
-		CycleErrors:     []*CycleError{},
-		Warnings:        []*ReferenceWarning{},
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
 
-	// 参照チェック
-	refErrors, err := c.CheckReferences(ctx)
+	refErrors, warnings, err := c.run(ctx, c.referenceChecks(), c.warningChecks())
 	if err != nil {
-		return nil, fmt.Errorf("reference check failed: %w", err)
+		return nil, err
 	}
-	result.ReferenceErrors = refErrors
 
 	// 循環参照チェック
 	cycleErrors, err := c.CheckCycles(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("cycle check failed: %w", err)
 	}
-	result.CycleErrors = cycleErrors
 
-	// 警告チェック（UseCase → Subsystem 参照など）
-	warnings, err := c.CheckWarnings(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("warning check failed: %w", err)
+	result := &IntegrityResult{
+		Valid:           true,
+		ReferenceErrors: refErrors,
+		CycleErrors:     cycleErrors,
+		Warnings:        warnings,
 	}
-	result.Warnings = warnings
 
 	// エラーがあれば Valid = false（警告は Valid に影響しない）
 	if len(result.ReferenceErrors) > 0 || len(result.CycleErrors) > 0 {
@@ -194,64 +215,20 @@ func (c *IntegrityChecker) CheckAll(ctx context.Context) (*IntegrityResult, erro
 // - Problem → Objective 参照（任意）
 // - Risk → Objective 参照（任意）
 // - Assumption → Objective 参照（任意）
+// - Release → UseCase / Activity / Quality 参照（任意）
+// - Container → Subsystem 参照（任意）、Container / Component の関係先（任意）
+// - Component → Container 参照（必須）
 // - Consideration ← Decision 逆参照（削除時チェック用）
 func (c *IntegrityChecker) CheckReferences(ctx context.Context) ([]*ReferenceError, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
 
-	var errors []*ReferenceError
-
-	// Decision → Consideration 参照チェック（必須）
-	decErrors, err := c.checkDecisionReferences(ctx)
-	if err != nil {
-		return nil, err
-	}
-	errors = append(errors, decErrors...)
-
-	// Quality → Objective 参照チェック（必須）
-	qualErrors, err := c.checkQualityReferences(ctx)
-	if err != nil {
-		return nil, err
-	}
-	errors = append(errors, qualErrors...)
-
-	// UseCase → Objective 参照チェック（必須）
-	ucErrors, err := c.checkUseCaseObjectiveReferences(ctx)
-	if err != nil {
-		return nil, err
-	}
-	errors = append(errors, ucErrors...)
-
-	// Consideration → Objective 参照チェック
-	conErrors, err := c.checkConsiderationReferences(ctx)
-	if err != nil {
-		return nil, err
-	}
-	errors = append(errors, conErrors...)
-
-	// Problem → Objective 参照チェック
-	probErrors, err := c.checkProblemReferences(ctx)
-	if err != nil {
-		return nil, err
-	}
-	errors = append(errors, probErrors...)
-
-	// Risk → Objective 参照チェック
-	riskErrors, err := c.checkRiskReferences(ctx)
+	refErrors, _, err := c.run(ctx, c.referenceChecks(), nil)
 	if err != nil {
 		return nil, err
 	}
-	errors = append(errors, riskErrors...)
-
-	// Assumption → Objective 参照チェック
-	assumErrors, err := c.checkAssumptionReferences(ctx)
-	if err != nil {
-		return nil, err
-	}
-	errors = append(errors, assumErrors...)
-
-	return errors, nil
+	return refErrors, nil
 }
 
 // CheckCycles は循環参照をチェック
@@ -312,19 +289,425 @@ func (c *IntegrityChecker) detectCycleGeneric(startID string, resolver ParentRes
 	return nil // 循環なし
 }
 
-// checkDecisionReferences は Decision から Consideration への参照をチェック（必須）
-func (c *IntegrityChecker) checkDecisionReferences(ctx context.Context) ([]*ReferenceError, error) {
-	if c.decisionHandler == nil {
-		return []*ReferenceError{}, nil
+// CheckWarnings は警告レベルの参照問題をチェック
+// - UseCase → Subsystem 参照（任意、存在しないサブシステムへの参照は警告）
+// - UseCase → Actor 参照（任意、存在しないアクターへの参照は警告）
+// - Activity → UseCase 参照（任意、存在しないユースケースへの参照は警告）
+func (c *IntegrityChecker) CheckWarnings(ctx context.Context) ([]*ReferenceWarning, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
 
-	decisions, err := c.decisionHandler.getAllDecisions(ctx)
+	_, warnings, err := c.run(ctx, nil, c.warningChecks())
 	if err != nil {
 		return nil, err
 	}
+	return warnings, nil
+}
+
+// ===== 並列実行と進捗通知 =====
+
+// IntegrityProgressFunc は整合性チェックの進捗を受け取る関数
+// step は完了したステップ名、done / total は完了したステップ数と総数
+// 呼び出しは直列化されるため、関数側で排他制御する必要はない
+type IntegrityProgressFunc func(step string, done, total int)
+
+// SetProgress は進捗を通知する関数を設定（nil の場合は通知しない）
+func (c *IntegrityChecker) SetProgress(fn IntegrityProgressFunc) {
+	c.progress = fn
+}
+
+// integrityProgress は完了したステップ数を数えて進捗を通知する
+type integrityProgress struct {
+	mu    sync.Mutex
+	fn    IntegrityProgressFunc
+	done  int
+	total int
+}
+
+// complete は step の完了を通知
+func (p *integrityProgress) complete(step string) {
+	if p.fn == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.done++
+	p.fn(step, p.done, p.total)
+}
+
+// integrityTask は並列に実行するステップ（エンティティの読み込み、または 1 種類のチェック）
+type integrityTask struct {
+	step string
+	run  func(ctx context.Context) error
+}
+
+// runIntegrityTasks は tasks を並列に実行し、完了ごとに進捗を通知する
+// いずれかが失敗した場合は残りのステップをキャンセルし、最初のエラーを返す
+func runIntegrityTasks(ctx context.Context, tasks []integrityTask, progress *integrityProgress) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	for _, task := range tasks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := task.run(ctx); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			progress.complete(task.step)
+		}()
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// referenceCheck はスナップショットに対する参照チェック（エラーレベル）
+type referenceCheck struct {
+	step  string
+	check func(s *integritySnapshot) ([]*ReferenceError, error)
+}
+
+// warningCheck はスナップショットに対する参照チェック（警告レベル）
+type warningCheck struct {
+	step  string
+	check func(s *integritySnapshot) ([]*ReferenceWarning, error)
+}
+
+// referenceChecks はエラーレベルの参照チェックを結果の連結順に返す
+func (c *IntegrityChecker) referenceChecks() []referenceCheck {
+	return []referenceCheck{
+		{"check decision references", c.checkDecisionReferences},
+		{"check quality references", c.checkQualityReferences},
+		{"check usecase objective references", c.checkUseCaseObjectiveReferences},
+		{"check consideration references", c.checkConsiderationReferences},
+		{"check problem references", c.checkProblemReferences},
+		{"check risk references", c.checkRiskReferences},
+		{"check assumption references", c.checkAssumptionReferences},
+		{"check release references", c.checkReleaseReferences},
+		{"check container references", c.checkContainerReferences},
+		{"check component references", c.checkComponentReferences},
+	}
+}
+
+// warningChecks は警告レベルの参照チェックを結果の連結順に返す
+func (c *IntegrityChecker) warningChecks() []warningCheck {
+	return []warningCheck{
+		{"check usecase subsystem references", c.checkUseCaseSubsystemReferences},
+		{"check usecase actor references", c.checkUseCaseActorReferences},
+		{"check activity usecase references", c.checkActivityUseCaseReferences},
+	}
+}
+
+// run はエンティティを種別ごとに一度だけ並列に読み込み、指定したチェックを並列に実行する
+// 結果はチェックの指定順に連結する
+func (c *IntegrityChecker) run(ctx context.Context, refChecks []referenceCheck, warnChecks []warningCheck) ([]*ReferenceError, []*ReferenceWarning, error) {
+	snapshot := &integritySnapshot{}
+	loaders := c.snapshotLoaders(snapshot)
+	progress := &integrityProgress{
+		fn:    c.progress,
+		total: len(loaders) + len(refChecks) + len(warnChecks),
+	}
+
+	if err := runIntegrityTasks(ctx, loaders, progress); err != nil {
+		return nil, nil, fmt.Errorf("failed to load entities: %w", err)
+	}
+
+	refResults := make([][]*ReferenceError, len(refChecks))
+	warnResults := make([][]*ReferenceWarning, len(warnChecks))
+	tasks := make([]integrityTask, 0, len(refChecks)+len(warnChecks))
+	for i, rc := range refChecks {
+		tasks = append(tasks, integrityTask{step: rc.step, run: func(context.Context) error {
+			found, err := rc.check(snapshot)
+			if err != nil {
+				return fmt.Errorf("reference check failed: %w", err)
+			}
+			refResults[i] = found
+			return nil
+		}})
+	}
+	for i, wc := range warnChecks {
+		tasks = append(tasks, integrityTask{step: wc.step, run: func(context.Context) error {
+			found, err := wc.check(snapshot)
+			if err != nil {
+				return fmt.Errorf("warning check failed: %w", err)
+			}
+			warnResults[i] = found
+			return nil
+		}})
+	}
+	if err := runIntegrityTasks(ctx, tasks, progress); err != nil {
+		return nil, nil, err
+	}
+
+	refErrors := []*ReferenceError{}
+	for _, found := range refResults {
+		refErrors = append(refErrors, found...)
+	}
+	warnings := []*ReferenceWarning{}
+	for _, found := range warnResults {
+		warnings = append(warnings, found...)
+	}
+	return refErrors, warnings, nil
+}
+
+// ===== エンティティのスナップショット =====
+
+// integritySnapshot はチェック対象のエンティティを一度だけ読み込んだもの
+// ハンドラーが未設定の種別は空のまま（各チェックはハンドラーの有無で実行を判断する）
+type integritySnapshot struct {
+	objectiveIDs     map[string]bool
+	considerations   []*ConsiderationEntity
+	considerationIDs map[string]bool
+	decisions        []*DecisionEntity
+	decisionIDs      map[string]bool
+	problems         []*ProblemEntity
+	risks            []*RiskEntity
+	assumptions      []*AssumptionEntity
+	qualities        []*QualityEntity
+	qualityIDs       map[string]bool
+	usecases         []*UseCaseEntity
+	usecaseIDs       map[string]bool
+	subsystemIDs     map[string]bool
+	actorIDs         map[string]bool
+	activities       []ActivityEntity
+	activityIDs      map[string]bool
+	releases         []*ReleaseEntity
+	containers       []ContainerEntity
+	containerIDs     map[string]bool
+	components       []ComponentEntity
+	componentIDs     map[string]bool
+}
+
+// lookup は読み込み済みの ID 集合で参照先の存在を確認する
+// Get と同じく、ID 形式が不正な場合は ValidationError、存在しない場合は ErrEntityNotFound を返す
+func (s *integritySnapshot) lookup(ids map[string]bool, entityType, id string) error {
+	if err := ValidateID(entityType, id); err != nil {
+		return err
+	}
+	if !ids[id] {
+		return ErrEntityNotFound
+	}
+	return nil
+}
+
+// snapshotLoaders は設定済みのハンドラーごとに、s へエンティティを読み込むステップを返す
+// 各ステップは s の別々のフィールドにのみ書き込むため、並列に実行できる
+func (c *IntegrityChecker) snapshotLoaders(s *integritySnapshot) []integrityTask {
+	var loaders []integrityTask
+
+	if c.objectiveHandler != nil {
+		loaders = append(loaders, integrityTask{step: "load objectives", run: func(ctx context.Context) error {
+			objectives, err := c.objectiveHandler.getAllObjectives(ctx)
+			if err != nil {
+				return err
+			}
+			s.objectiveIDs = make(map[string]bool, len(objectives))
+			for _, obj := range objectives {
+				s.objectiveIDs[obj.ID] = true
+			}
+			return nil
+		}})
+	}
+
+	if c.considerationHandler != nil {
+		loaders = append(loaders, integrityTask{step: "load considerations", run: func(ctx context.Context) error {
+			considerations, err := c.considerationHandler.getAllConsiderations(ctx)
+			if err != nil {
+				return err
+			}
+			s.considerations = considerations
+			s.considerationIDs = make(map[string]bool, len(considerations))
+			for _, con := range considerations {
+				s.considerationIDs[con.ID] = true
+			}
+			return nil
+		}})
+	}
+
+	if c.decisionHandler != nil {
+		loaders = append(loaders, integrityTask{step: "load decisions", run: func(ctx context.Context) error {
+			decisions, err := c.decisionHandler.getAllDecisions(ctx)
+			if err != nil {
+				return err
+			}
+			s.decisions = decisions
+			s.decisionIDs = make(map[string]bool, len(decisions))
+			for _, dec := range decisions {
+				s.decisionIDs[dec.ID] = true
+			}
+			return nil
+		}})
+	}
+
+	if c.problemHandler != nil {
+		loaders = append(loaders, integrityTask{step: "load problems", run: func(ctx context.Context) error {
+			problems, err := c.problemHandler.getAllProblems(ctx)
+			s.problems = problems
+			return err
+		}})
+	}
+
+	if c.riskHandler != nil {
+		loaders = append(loaders, integrityTask{step: "load risks", run: func(ctx context.Context) error {
+			risks, err := c.riskHandler.getAllRisks(ctx)
+			s.risks = risks
+			return err
+		}})
+	}
+
+	if c.assumptionHandler != nil {
+		loaders = append(loaders, integrityTask{step: "load assumptions", run: func(ctx context.Context) error {
+			assumptions, err := c.assumptionHandler.getAllAssumptions(ctx)
+			s.assumptions = assumptions
+			return err
+		}})
+	}
+
+	if c.qualityHandler != nil {
+		loaders = append(loaders, integrityTask{step: "load qualities", run: func(ctx context.Context) error {
+			qualities, err := c.qualityHandler.getAllQualities(ctx)
+			if err != nil {
+				return err
+			}
+			s.qualities = qualities
+			s.qualityIDs = make(map[string]bool, len(qualities))
+			for _, qual := range qualities {
+				s.qualityIDs[qual.ID] = true
+			}
+			return nil
+		}})
+	}
+
+	if c.usecaseHandler != nil {
+		loaders = append(loaders, integrityTask{step: "load usecases", run: func(ctx context.Context) error {
+			usecases, err := c.usecaseHandler.getAllUseCases(ctx)
+			if err != nil {
+				return err
+			}
+			s.usecases = usecases
+			s.usecaseIDs = make(map[string]bool, len(usecases))
+			for _, uc := range usecases {
+				s.usecaseIDs[uc.ID] = true
+			}
+			return nil
+		}})
+	}
+
+	if c.subsystemHandler != nil {
+		loaders = append(loaders, integrityTask{step: "load subsystems", run: func(ctx context.Context) error {
+			subsystems, err := c.subsystemHandler.ListAll(ctx)
+			if err != nil {
+				return err
+			}
+			s.subsystemIDs = make(map[string]bool, len(subsystems))
+			for _, sub := range subsystems {
+				s.subsystemIDs[sub.ID] = true
+			}
+			return nil
+		}})
+	}
+
+	if c.actorHandler != nil {
+		loaders = append(loaders, integrityTask{step: "load actors", run: func(ctx context.Context) error {
+			actors, err := c.actorHandler.List(ctx, nil)
+			if err != nil {
+				return err
+			}
+			s.actorIDs = make(map[string]bool, len(actors.Items))
+			for _, actor := range actors.Items {
+				s.actorIDs[actor.ID] = true
+			}
+			return nil
+		}})
+	}
+
+	if c.activityHandler != nil {
+		loaders = append(loaders, integrityTask{step: "load activities", run: func(ctx context.Context) error {
+			activities, err := c.activityHandler.GetAll(ctx)
+			if err != nil {
+				return err
+			}
+			s.activities = activities
+			s.activityIDs = make(map[string]bool, len(activities))
+			for _, act := range activities {
+				s.activityIDs[act.ID] = true
+			}
+			return nil
+		}})
+	}
+
+	if c.releaseHandler != nil {
+		loaders = append(loaders, integrityTask{step: "load releases", run: func(ctx context.Context) error {
+			releases, err := c.releaseHandler.getAllReleases(ctx)
+			s.releases = releases
+			return err
+		}})
+	}
+
+	if c.containerHandler != nil {
+		loaders = append(loaders, integrityTask{step: "load containers", run: func(ctx context.Context) error {
+			containers, err := c.containerHandler.ListAll(ctx)
+			if err != nil {
+				return err
+			}
+			s.containers = containers
+			s.containerIDs = make(map[string]bool, len(containers))
+			for _, ctr := range containers {
+				s.containerIDs[ctr.ID] = true
+			}
+			return nil
+		}})
+	}
+
+	if c.componentHandler != nil {
+		loaders = append(loaders, integrityTask{step: "load components", run: func(ctx context.Context) error {
+			components, err := c.componentHandler.ListAll(ctx)
+			if err != nil {
+				return err
+			}
+			s.components = components
+			s.componentIDs = make(map[string]bool, len(components))
+			for _, cmp := range components {
+				s.componentIDs[cmp.ID] = true
+			}
+			return nil
+		}})
+	}
+
+	return loaders
+}
+
+// ===== 参照チェック（スナップショットを使用） =====
+
+// checkDecisionReferences は Decision から Consideration への参照をチェック（必須）
+func (c *IntegrityChecker) checkDecisionReferences(s *integritySnapshot) ([]*ReferenceError, error) {
+	if c.decisionHandler == nil {
+		return []*ReferenceError{}, nil
+	}
 
 	var errors []*ReferenceError
-	for _, dec := range decisions {
+	for _, dec := range s.decisions {
+		// 置き換え元の Decision の存在確認（任意）
+		if dec.Supersedes != "" && !s.decisionIDs[dec.Supersedes] {
+			errors = append(errors, &ReferenceError{
+				SourceType: "decision",
+				SourceID:   dec.ID,
+				TargetType: "decision",
+				TargetID:   dec.Supersedes,
+				Message:    ErrMsgReferencedDecisionNotFound,
+			})
+		}
+
 		// ConsiderationID は必須
 		if dec.ConsiderationID == "" {
 			errors = append(errors, &ReferenceError{
@@ -339,7 +722,7 @@ func (c *IntegrityChecker) checkDecisionReferences(ctx context.Context) ([]*Refe
 
 		// Consideration の存在確認
 		if c.considerationHandler != nil {
-			_, err := c.considerationHandler.Get(ctx, dec.ConsiderationID)
+			err := s.lookup(s.considerationIDs, "consideration", dec.ConsiderationID)
 			if err == ErrEntityNotFound {
 				errors = append(errors, &ReferenceError{
 					SourceType: "decision",
@@ -358,18 +741,13 @@ func (c *IntegrityChecker) checkDecisionReferences(ctx context.Context) ([]*Refe
 }
 
 // checkQualityReferences は Quality から Objective への参照をチェック（必須）
-func (c *IntegrityChecker) checkQualityReferences(ctx context.Context) ([]*ReferenceError, error) {
+func (c *IntegrityChecker) checkQualityReferences(s *integritySnapshot) ([]*ReferenceError, error) {
 	if c.qualityHandler == nil {
 		return []*ReferenceError{}, nil
 	}
 
-	qualities, err := c.qualityHandler.getAllQualities(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var errors []*ReferenceError
-	for _, qual := range qualities {
+	for _, qual := range s.qualities {
 		// ObjectiveID は必須
 		if qual.ObjectiveID == "" {
 			errors = append(errors, &ReferenceError{
@@ -384,7 +762,7 @@ func (c *IntegrityChecker) checkQualityReferences(ctx context.Context) ([]*Refer
 
 		// Objective の存在確認
 		if c.objectiveHandler != nil {
-			_, err := c.objectiveHandler.Get(ctx, qual.ObjectiveID)
+			err := s.lookup(s.objectiveIDs, "objective", qual.ObjectiveID)
 			if err == ErrEntityNotFound {
 				errors = append(errors, &ReferenceError{
 					SourceType: "quality",
@@ -403,21 +781,16 @@ func (c *IntegrityChecker) checkQualityReferences(ctx context.Context) ([]*Refer
 }
 
 // checkConsiderationReferences は Consideration から Objective への参照をチェック
-func (c *IntegrityChecker) checkConsiderationReferences(ctx context.Context) ([]*ReferenceError, error) {
+func (c *IntegrityChecker) checkConsiderationReferences(s *integritySnapshot) ([]*ReferenceError, error) {
 	if c.considerationHandler == nil {
 		return []*ReferenceError{}, nil
 	}
 
-	considerations, err := c.considerationHandler.getAllConsiderations(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var errors []*ReferenceError
-	for _, con := range considerations {
+	for _, con := range s.considerations {
 		// ObjectiveID のチェック（任意）
 		if con.ObjectiveID != "" && c.objectiveHandler != nil {
-			_, err := c.objectiveHandler.Get(ctx, con.ObjectiveID)
+			err := s.lookup(s.objectiveIDs, "objective", con.ObjectiveID)
 			if err == ErrEntityNotFound {
 				errors = append(errors, &ReferenceError{
 					SourceType: "consideration",
@@ -433,7 +806,7 @@ func (c *IntegrityChecker) checkConsiderationReferences(ctx context.Context) ([]
 
 		// DecisionID のチェック（任意）
 		if con.DecisionID != "" && c.decisionHandler != nil {
-			_, err := c.decisionHandler.Get(ctx, con.DecisionID)
+			err := s.lookup(s.decisionIDs, "decision", con.DecisionID)
 			if err == ErrEntityNotFound {
 				errors = append(errors, &ReferenceError{
 					SourceType: "consideration",
@@ -452,21 +825,16 @@ func (c *IntegrityChecker) checkConsiderationReferences(ctx context.Context) ([]
 }
 
 // checkProblemReferences は Problem から Objective への参照をチェック
-func (c *IntegrityChecker) checkProblemReferences(ctx context.Context) ([]*ReferenceError, error) {
+func (c *IntegrityChecker) checkProblemReferences(s *integritySnapshot) ([]*ReferenceError, error) {
 	if c.problemHandler == nil {
 		return []*ReferenceError{}, nil
 	}
 
-	problems, err := c.problemHandler.getAllProblems(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var errors []*ReferenceError
-	for _, prob := range problems {
+	for _, prob := range s.problems {
 		// ObjectiveID のチェック（任意）
 		if prob.ObjectiveID != "" && c.objectiveHandler != nil {
-			_, err := c.objectiveHandler.Get(ctx, prob.ObjectiveID)
+			err := s.lookup(s.objectiveIDs, "objective", prob.ObjectiveID)
 			if err == ErrEntityNotFound {
 				errors = append(errors, &ReferenceError{
 					SourceType: "problem",
@@ -485,21 +853,16 @@ func (c *IntegrityChecker) checkProblemReferences(ctx context.Context) ([]*Refer
 }
 
 // checkRiskReferences は Risk から Objective への参照をチェック
-func (c *IntegrityChecker) checkRiskReferences(ctx context.Context) ([]*ReferenceError, error) {
+func (c *IntegrityChecker) checkRiskReferences(s *integritySnapshot) ([]*ReferenceError, error) {
 	if c.riskHandler == nil {
 		return []*ReferenceError{}, nil
 	}
 
-	risks, err := c.riskHandler.getAllRisks(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var errors []*ReferenceError
-	for _, risk := range risks {
+	for _, risk := range s.risks {
 		// ObjectiveID のチェック（任意）
 		if risk.ObjectiveID != "" && c.objectiveHandler != nil {
-			_, err := c.objectiveHandler.Get(ctx, risk.ObjectiveID)
+			err := s.lookup(s.objectiveIDs, "objective", risk.ObjectiveID)
 			if err == ErrEntityNotFound {
 				errors = append(errors, &ReferenceError{
 					SourceType: "risk",
@@ -518,21 +881,16 @@ func (c *IntegrityChecker) checkRiskReferences(ctx context.Context) ([]*Referenc
 }
 
 // checkAssumptionReferences は Assumption から Objective への参照をチェック
-func (c *IntegrityChecker) checkAssumptionReferences(ctx context.Context) ([]*ReferenceError, error) {
+func (c *IntegrityChecker) checkAssumptionReferences(s *integritySnapshot) ([]*ReferenceError, error) {
 	if c.assumptionHandler == nil {
 		return []*ReferenceError{}, nil
 	}
 
-	assumptions, err := c.assumptionHandler.getAllAssumptions(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var errors []*ReferenceError
-	for _, assum := range assumptions {
+	for _, assum := range s.assumptions {
 		// ObjectiveID のチェック（任意）
 		if assum.ObjectiveID != "" && c.objectiveHandler != nil {
-			_, err := c.objectiveHandler.Get(ctx, assum.ObjectiveID)
+			err := s.lookup(s.objectiveIDs, "objective", assum.ObjectiveID)
 			if err == ErrEntityNotFound {
 				errors = append(errors, &ReferenceError{
 					SourceType: "assumption",
@@ -551,18 +909,13 @@ func (c *IntegrityChecker) checkAssumptionReferences(ctx context.Context) ([]*Re
 }
 
 // checkUseCaseObjectiveReferences は UseCase から Objective への参照をチェック（必須）
-func (c *IntegrityChecker) checkUseCaseObjectiveReferences(ctx context.Context) ([]*ReferenceError, error) {
+func (c *IntegrityChecker) checkUseCaseObjectiveReferences(s *integritySnapshot) ([]*ReferenceError, error) {
 	if c.usecaseHandler == nil {
 		return []*ReferenceError{}, nil
 	}
 
-	usecases, err := c.usecaseHandler.getAllUseCases(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var errors []*ReferenceError
-	for _, uc := range usecases {
+	for _, uc := range s.usecases {
 		// ObjectiveID は必須
 		if uc.ObjectiveID == "" {
 			errors = append(errors, &ReferenceError{
@@ -577,7 +930,7 @@ func (c *IntegrityChecker) checkUseCaseObjectiveReferences(ctx context.Context)
 
 		// Objective の存在確認
 		if c.objectiveHandler != nil {
-			_, err := c.objectiveHandler.Get(ctx, uc.ObjectiveID)
+			err := s.lookup(s.objectiveIDs, "objective", uc.ObjectiveID)
 			if err == ErrEntityNotFound {
 				errors = append(errors, &ReferenceError{
 					SourceType: "usecase",
@@ -595,78 +948,167 @@ func (c *IntegrityChecker) checkUseCaseObjectiveReferences(ctx context.Context)
 	return errors, nil
 }
 
-// CheckWarnings は警告レベルの参照問題をチェック
-// - UseCase → Subsystem 参照（任意、存在しないサブシステムへの参照は警告）
-// - UseCase → Actor 参照（任意、存在しないアクターへの参照は警告）
-// - Activity → UseCase 参照（任意、存在しないユースケースへの参照は警告）
-func (c *IntegrityChecker) CheckWarnings(ctx context.Context) ([]*ReferenceWarning, error) {
-	if err := ctx.Err(); err != nil {
-		return nil, err
+// checkReleaseReferences は Release から UseCase / Activity / Quality への参照をチェック
+func (c *IntegrityChecker) checkReleaseReferences(s *integritySnapshot) ([]*ReferenceError, error) {
+	if c.releaseHandler == nil {
+		return []*ReferenceError{}, nil
 	}
 
-	var warnings []*ReferenceWarning
+	type reference struct {
+		entityType string
+		ids        func(rel *ReleaseEntity) []string
+		known      map[string]bool
+		message    string
+	}
+	var refs []reference
+	if c.usecaseHandler != nil {
+		refs = append(refs, reference{"usecase", func(rel *ReleaseEntity) []string { return rel.UseCaseIDs }, s.usecaseIDs, ErrMsgReferencedUseCaseNotFound})
+	}
+	if c.activityHandler != nil {
+		refs = append(refs, reference{"activity", func(rel *ReleaseEntity) []string { return rel.ActivityIDs }, s.activityIDs, ErrMsgReferencedActivityNotFound})
+	}
+	if c.qualityHandler != nil {
+		refs = append(refs, reference{"quality", func(rel *ReleaseEntity) []string { return rel.QualityIDs }, s.qualityIDs, ErrMsgReferencedQualityNotFound})
+	}
 
-	// UseCase → Subsystem 参照チェック
-	usecaseSubsystemWarnings, err := c.checkUseCaseSubsystemReferences(ctx)
-	if err != nil {
-		return nil, err
+	var errors []*ReferenceError
+	for _, rel := range s.releases {
+		for _, ref := range refs {
+			for _, id := range ref.ids(rel) {
+				err := s.lookup(ref.known, ref.entityType, id)
+				if err == ErrEntityNotFound {
+					errors = append(errors, &ReferenceError{
+						SourceType: "release",
+						SourceID:   rel.ID,
+						TargetType: ref.entityType,
+						TargetID:   id,
+						Message:    ref.message,
+					})
+				} else if err != nil {
+					return nil, err
+				}
+			}
+		}
 	}
-	warnings = append(warnings, usecaseSubsystemWarnings...)
 
-	// UseCase → Actor 参照チェック
-	usecaseActorWarnings, err := c.checkUseCaseActorReferences(ctx)
-	if err != nil {
-		return nil, err
+	return errors, nil
+}
+
+// checkContainerReferences は Container から Subsystem への参照と関係先をチェック
+func (c *IntegrityChecker) checkContainerReferences(s *integritySnapshot) ([]*ReferenceError, error) {
+	if c.containerHandler == nil {
+		return []*ReferenceError{}, nil
 	}
-	warnings = append(warnings, usecaseActorWarnings...)
 
-	// Activity 関連チェック（一度だけ取得して再利用）
-	activityWarnings, err := c.checkAllActivityReferences(ctx)
-	if err != nil {
-		return nil, err
+	var errors []*ReferenceError
+	for _, ctr := range s.containers {
+		// SubsystemID のチェック（任意）
+		if ctr.SubsystemID != "" && c.subsystemHandler != nil {
+			err := s.lookup(s.subsystemIDs, "subsystem", ctr.SubsystemID)
+			if err == ErrEntityNotFound {
+				errors = append(errors, &ReferenceError{
+					SourceType: "container",
+					SourceID:   ctr.ID,
+					TargetType: "subsystem",
+					TargetID:   ctr.SubsystemID,
+					Message:    ErrMsgReferencedSubsystemNotFound,
+				})
+			} else if err != nil {
+				return nil, err
+			}
+		}
+
+		found, err := c.checkArchitectureRelations(s, "container", ctr.ID, ctr.Relations)
+		if err != nil {
+			return nil, err
+		}
+		errors = append(errors, found...)
 	}
-	warnings = append(warnings, activityWarnings...)
 
-	return warnings, nil
+	return errors, nil
 }
 
-// checkAllActivityReferences は Activity 関連の全ての参照チェックを一度の GetAll で実行
-// パフォーマンス最適化: Activity を一度だけ取得し、複数のチェックで再利用
-func (c *IntegrityChecker) checkAllActivityReferences(ctx context.Context) ([]*ReferenceWarning, error) {
-	if c.activityHandler == nil {
-		return []*ReferenceWarning{}, nil
+// checkComponentReferences は Component から Container への参照（必須）と関係先をチェック
+func (c *IntegrityChecker) checkComponentReferences(s *integritySnapshot) ([]*ReferenceError, error) {
+	if c.componentHandler == nil {
+		return []*ReferenceError{}, nil
 	}
 
-	// Activity を一度だけ取得
-	activities, err := c.activityHandler.GetAll(ctx)
-	if err != nil {
-		return nil, err
+	var errors []*ReferenceError
+	for _, cmp := range s.components {
+		// ContainerID は必須
+		if cmp.ContainerID == "" {
+			errors = append(errors, &ReferenceError{
+				SourceType: "component",
+				SourceID:   cmp.ID,
+				TargetType: "container",
+				TargetID:   "",
+				Message:    ErrMsgContainerIDRequired,
+			})
+		} else if c.containerHandler != nil {
+			err := s.lookup(s.containerIDs, "container", cmp.ContainerID)
+			if err == ErrEntityNotFound {
+				errors = append(errors, &ReferenceError{
+					SourceType: "component",
+					SourceID:   cmp.ID,
+					TargetType: "container",
+					TargetID:   cmp.ContainerID,
+					Message:    ErrMsgReferencedContainerNotFound,
+				})
+			} else if err != nil {
+				return nil, err
+			}
+		}
+
+		found, err := c.checkArchitectureRelations(s, "component", cmp.ID, cmp.Relations)
+		if err != nil {
+			return nil, err
+		}
+		errors = append(errors, found...)
 	}
 
-	var warnings []*ReferenceWarning
+	return errors, nil
+}
 
-	// Activity → UseCase 参照チェック
-	ucWarnings := c.checkActivityUseCaseReferencesWithData(ctx, activities)
-	warnings = append(warnings, ucWarnings...)
+// checkArchitectureRelations は Container / Component の関係先の存在をチェック
+// 関係先の種別は ID の接頭辞で判断し、該当するハンドラーが未設定の場合はスキップする
+func (c *IntegrityChecker) checkArchitectureRelations(s *integritySnapshot, sourceType, sourceID string, relations []ArchitectureRelation) ([]*ReferenceError, error) {
+	var errors []*ReferenceError
+	for _, rel := range relations {
+		targetType, ids, message := "container", s.containerIDs, ErrMsgReferencedContainerNotFound
+		if ValidateID("container", rel.TargetID) != nil {
+			targetType, ids, message = "component", s.componentIDs, ErrMsgReferencedComponentNotFound
+		}
+		if (targetType == "container" && c.containerHandler == nil) || (targetType == "component" && c.componentHandler == nil) {
+			continue
+		}
 
-	return warnings, nil
+		err := s.lookup(ids, targetType, rel.TargetID)
+		if err == ErrEntityNotFound {
+			errors = append(errors, &ReferenceError{
+				SourceType: sourceType,
+				SourceID:   sourceID,
+				TargetType: targetType,
+				TargetID:   rel.TargetID,
+				Message:    message,
+			})
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	return errors, nil
 }
 
 // checkUseCaseSubsystemReferences は UseCase から Subsystem への参照をチェック（警告レベル）
 // SubsystemID が設定されているが、該当の Subsystem が存在しない場合は警告を出す
 // 無効な ID 形式（ValidationError）も警告として扱う
-func (c *IntegrityChecker) checkUseCaseSubsystemReferences(ctx context.Context) ([]*ReferenceWarning, error) {
+func (c *IntegrityChecker) checkUseCaseSubsystemReferences(s *integritySnapshot) ([]*ReferenceWarning, error) {
 	if c.usecaseHandler == nil {
 		return []*ReferenceWarning{}, nil
 	}
 
-	usecases, err := c.usecaseHandler.getAllUseCases(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var warnings []*ReferenceWarning
-	for _, uc := range usecases {
+	for _, uc := range s.usecases {
 		// SubsystemID が未設定なら OK（任意フィールド）
 		if uc.SubsystemID == "" {
 			continue
@@ -678,7 +1120,7 @@ func (c *IntegrityChecker) checkUseCaseSubsystemReferences(ctx context.Context)
 		}
 
 		// Subsystem の存在確認
-		_, err := c.subsystemHandler.Get(ctx, uc.SubsystemID)
+		err := s.lookup(s.subsystemIDs, "subsystem", uc.SubsystemID)
 		if err == ErrEntityNotFound {
 			// サブシステムが存在しない
 			warnings = append(warnings, &ReferenceWarning{
@@ -710,18 +1152,13 @@ func (c *IntegrityChecker) checkUseCaseSubsystemReferences(ctx context.Context)
 }
 
 // checkUseCaseActorReferences は UseCase から Actor への参照をチェック（警告レベル）
-func (c *IntegrityChecker) checkUseCaseActorReferences(ctx context.Context) ([]*ReferenceWarning, error) {
+func (c *IntegrityChecker) checkUseCaseActorReferences(s *integritySnapshot) ([]*ReferenceWarning, error) {
 	if c.usecaseHandler == nil {
 		return []*ReferenceWarning{}, nil
 	}
 
-	usecases, err := c.usecaseHandler.getAllUseCases(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var warnings []*ReferenceWarning
-	for _, uc := range usecases {
+	for _, uc := range s.usecases {
 		// ActorHandler が未設定なら警告チェックをスキップ
 		if c.actorHandler == nil {
 			continue
@@ -733,7 +1170,7 @@ func (c *IntegrityChecker) checkUseCaseActorReferences(ctx context.Context) ([]*
 			}
 
 			// Actor の存在確認
-			_, err := c.actorHandler.Get(ctx, actorRef.ActorID)
+			err := s.lookup(s.actorIDs, "actor", actorRef.ActorID)
 			if err == ErrEntityNotFound {
 				warnings = append(warnings, &ReferenceWarning{
 					SourceType: "usecase",
@@ -762,12 +1199,10 @@ func (c *IntegrityChecker) checkUseCaseActorReferences(ctx context.Context) ([]*
 	return warnings, nil
 }
 
-// ===== 最適化されたヘルパーメソッド（事前取得データを使用） =====
-
-// checkActivityUseCaseReferencesWithData は Activity から UseCase への参照をチェック（事前取得データ使用）
-func (c *IntegrityChecker) checkActivityUseCaseReferencesWithData(ctx context.Context, activities []ActivityEntity) []*ReferenceWarning {
+// checkActivityUseCaseReferences は Activity から UseCase への参照をチェック（警告レベル）
+func (c *IntegrityChecker) checkActivityUseCaseReferences(s *integritySnapshot) ([]*ReferenceWarning, error) {
 	var warnings []*ReferenceWarning
-	for _, act := range activities {
+	for _, act := range s.activities {
 		// UseCaseID が未設定なら OK（任意フィールド）
 		if act.UseCaseID == "" {
 			continue
@@ -779,7 +1214,7 @@ func (c *IntegrityChecker) checkActivityUseCaseReferencesWithData(ctx context.Co
 		}
 
 		// UseCase の存在確認
-		_, err := c.usecaseHandler.Get(ctx, act.UseCaseID)
+		err := s.lookup(s.usecaseIDs, "usecase", act.UseCaseID)
 		if err == ErrEntityNotFound {
 			warnings = append(warnings, &ReferenceWarning{
 				SourceType: "activity",
@@ -801,5 +1236,5 @@ func (c *IntegrityChecker) checkActivityUseCaseReferencesWithData(ctx context.Co
 			}
 		}
 	}
-	return warnings
+	return warnings, nil
 }
@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/biwakonbu/zeus/internal/yaml"
 )
@@ -1093,3 +1094,213 @@ func TestIntegrityChecker_ActivityValidReferences(t *testing.T) {
 		}
 	}
 }
+
+// TestIntegrityChecker_ReleaseReferences は Release から存在しない UseCase / Activity / Quality への参照をテスト
+func TestIntegrityChecker_ReleaseReferences(t *testing.T) {
+	checker, actHandler, ucHandler, zeusPath, cleanup := setupActivityIntegrityTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	fs := yaml.NewFileManager(zeusPath)
+	qualHandler := NewQualityHandler(fs, nil, nil)
+	checker.SetQualityHandler(qualHandler)
+	checker.SetReleaseHandler(NewReleaseHandler(fs, ucHandler, actHandler, qualHandler))
+
+	act := &ActivityEntity{
+		ID:       "act-12345678",
+		Title:    "含めるアクティビティ",
+		Status:   ActivityStatusDraft,
+		Metadata: Metadata{CreatedAt: Now(), UpdatedAt: Now()},
+	}
+	if err := fs.WriteYaml(ctx, "activities/act-12345678.yaml", act); err != nil {
+		t.Fatalf("Write activity failed: %v", err)
+	}
+	rel := &ReleaseEntity{
+		ID:          "rel-001",
+		Title:       "v1.0",
+		Status:      ReleaseStatusPlanned,
+		UseCaseIDs:  []string{"uc-99999999"},
+		ActivityIDs: []string{"act-12345678", "act-99999999"},
+		QualityIDs:  []string{"qual-99999999"},
+		Metadata:    Metadata{CreatedAt: Now(), UpdatedAt: Now()},
+	}
+	if err := fs.WriteYaml(ctx, "releases/rel-001.yaml", rel); err != nil {
+		t.Fatalf("Write release failed: %v", err)
+	}
+
+	result, err := checker.CheckAll(ctx)
+	if err != nil {
+		t.Fatalf("CheckAll failed: %v", err)
+	}
+	if result.Valid {
+		t.Error("expected Valid to be false")
+	}
+
+	want := map[string]string{
+		"uc-99999999":   ErrMsgReferencedUseCaseNotFound,
+		"act-99999999":  ErrMsgReferencedActivityNotFound,
+		"qual-99999999": ErrMsgReferencedQualityNotFound,
+	}
+	if len(result.ReferenceErrors) != len(want) {
+		t.Fatalf("expected %d reference errors, got %v", len(want), result.ReferenceErrors)
+	}
+	for _, refErr := range result.ReferenceErrors {
+		if refErr.SourceType != "release" || refErr.SourceID != "rel-001" || want[refErr.TargetID] != refErr.Message {
+			t.Errorf("unexpected reference error: %v", refErr)
+		}
+	}
+}
+
+// TestIntegrityChecker_ArchitectureReferences は Container / Component から存在しない参照先への参照をテスト
+func TestIntegrityChecker_ArchitectureReferences(t *testing.T) {
+	checker, _, zeusPath, cleanup := setupIntegrityCheckerTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	fs := yaml.NewFileManager(zeusPath)
+	subHandler := NewSubsystemHandler(fs)
+	ctrHandler := NewContainerHandler(fs, subHandler)
+	checker.SetSubsystemHandler(subHandler)
+	checker.SetContainerHandler(ctrHandler)
+	checker.SetComponentHandler(NewComponentHandler(fs, ctrHandler))
+
+	containers := &ContainersFile{Containers: []ContainerEntity{
+		{ID: "ctr-api", Name: "API", SubsystemID: "sub-99999999", Relations: []ArchitectureRelation{{TargetID: "cmp-missing"}}},
+		{ID: "ctr-db", Name: "DB"},
+	}}
+	if err := fs.WriteYaml(ctx, containersFileName, containers); err != nil {
+		t.Fatalf("Write containers failed: %v", err)
+	}
+	components := &ComponentsFile{Components: []ComponentEntity{
+		{ID: "cmp-handler", Name: "Handler", ContainerID: "ctr-api", Relations: []ArchitectureRelation{{TargetID: "ctr-db"}}},
+		{ID: "cmp-orphan", Name: "Orphan", ContainerID: "ctr-missing"},
+	}}
+	if err := fs.WriteYaml(ctx, componentsFileName, components); err != nil {
+		t.Fatalf("Write components failed: %v", err)
+	}
+
+	result, err := checker.CheckAll(ctx)
+	if err != nil {
+		t.Fatalf("CheckAll failed: %v", err)
+	}
+	if result.Valid {
+		t.Error("expected Valid to be false")
+	}
+
+	want := []string{
+		"container ctr-api → subsystem sub-99999999: " + ErrMsgReferencedSubsystemNotFound,
+		"container ctr-api → component cmp-missing: " + ErrMsgReferencedComponentNotFound,
+		"component cmp-orphan → container ctr-missing: " + ErrMsgReferencedContainerNotFound,
+	}
+	if len(result.ReferenceErrors) != len(want) {
+		t.Fatalf("expected %d reference errors, got %v", len(want), result.ReferenceErrors)
+	}
+	for i, refErr := range result.ReferenceErrors {
+		if refErr.Error() != want[i] {
+			t.Errorf("reference error %d: got %q, want %q", i, refErr.Error(), want[i])
+		}
+	}
+}
+
+// ===== 並列実行・進捗通知テスト =====
+
+// TestIntegrityChecker_Progress は読み込みとチェックの各ステップが進捗として通知されることをテスト
+func TestIntegrityChecker_Progress(t *testing.T) {
+	checker, _, _, _, cleanup := setupActivityIntegrityTest(t)
+	defer cleanup()
+
+	var steps []string
+	lastDone, lastTotal := 0, 0
+	checker.SetProgress(func(step string, done, total int) {
+		steps = append(steps, step)
+		if done != lastDone+1 {
+			t.Errorf("expected done %d, got %d", lastDone+1, done)
+		}
+		lastDone, lastTotal = done, total
+	})
+
+	if _, err := checker.CheckAll(context.Background()); err != nil {
+		t.Fatalf("CheckAll failed: %v", err)
+	}
+
+	// objective, usecase, activity の読み込み + 参照チェック 10 種 + 警告チェック 3 種
+	if lastTotal != 16 || lastDone != lastTotal {
+		t.Errorf("expected 16/16 steps, got %d/%d (%v)", lastDone, lastTotal, steps)
+	}
+	joined := strings.Join(steps, ",")
+	for _, want := range []string{"load activities", "check decision references", "check activity usecase references"} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("expected step %q in %v", want, steps)
+		}
+	}
+}
+
+// TestIntegrityChecker_DeterministicOrder は並列実行しても結果の順序が変わらないことをテスト
+func TestIntegrityChecker_DeterministicOrder(t *testing.T) {
+	checker, _, _, zeusPath, cleanup := setupActivityIntegrityTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	fs := yaml.NewFileManager(zeusPath)
+
+	for i := 1; i <= 5; i++ {
+		id := fmt.Sprintf("act-0000000%d", i)
+		act := &ActivityEntity{
+			ID:        id,
+			Title:     "壊れた UseCase 参照",
+			UseCaseID: fmt.Sprintf("uc-9999999%d", i),
+			Status:    ActivityStatusDraft,
+			Metadata:  Metadata{CreatedAt: Now(), UpdatedAt: Now()},
+		}
+		if err := fs.WriteYaml(ctx, "activities/"+id+".yaml", act); err != nil {
+			t.Fatalf("Write activity failed: %v", err)
+		}
+	}
+	uc := &UseCaseEntity{ID: "uc-00000001", Title: "Objective 未設定", Metadata: Metadata{CreatedAt: Now(), UpdatedAt: Now()}}
+	if err := fs.WriteYaml(ctx, "usecases/uc-00000001.yaml", uc); err != nil {
+		t.Fatalf("Write usecase failed: %v", err)
+	}
+
+	render := func(result *IntegrityResult) string {
+		var lines []string
+		for _, e := range result.ReferenceErrors {
+			lines = append(lines, e.Error())
+		}
+		for _, w := range result.Warnings {
+			lines = append(lines, w.Warning())
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	first, err := checker.CheckAll(ctx)
+	if err != nil {
+		t.Fatalf("CheckAll failed: %v", err)
+	}
+	if len(first.ReferenceErrors) != 1 || len(first.Warnings) != 5 {
+		t.Fatalf("expected 1 error and 5 warnings, got %d and %d", len(first.ReferenceErrors), len(first.Warnings))
+	}
+	want := render(first)
+	for i := 0; i < 10; i++ {
+		result, err := checker.CheckAll(ctx)
+		if err != nil {
+			t.Fatalf("CheckAll failed: %v", err)
+		}
+		if got := render(result); got != want {
+			t.Fatalf("result order changed:\n%s\nwant:\n%s", got, want)
+		}
+	}
+}
+
+// TestIntegrityChecker_DeadlineExceeded はタイムアウトしたコンテキストでチェックが中断されることをテスト
+func TestIntegrityChecker_DeadlineExceeded(t *testing.T) {
+	checker, _, _, _, cleanup := setupActivityIntegrityTest(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+
+	_, err := checker.CheckAll(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
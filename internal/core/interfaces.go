@@ -42,6 +42,9 @@ type FileStore interface {
 	// Copy はファイルをコピー
 	Copy(ctx context.Context, src, dest string) error
 
+	// Rename はファイルを移動（同一ファイルシステム上ではアトミックに置き換える）
+	Rename(ctx context.Context, src, dest string) error
+
 	// ListDir はディレクトリ内のファイルを列挙
 	ListDir(ctx context.Context, path string) ([]string, error)
 
@@ -83,6 +86,9 @@ type StateStore interface {
 	// RestoreSnapshot はスナップショットから復元
 	RestoreSnapshot(ctx context.Context, timestamp string) error
 
+	// DeleteSnapshot はスナップショットを削除
+	DeleteSnapshot(ctx context.Context, timestamp string) error
+
 	// CalculateState はタスクから状態を計算
 	CalculateState(tasks []ListItem) *ProjectState
 }
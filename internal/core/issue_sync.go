@@ -0,0 +1,394 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/integrations/issuesync"
+)
+
+// issueSyncTokenEnv は課題トラッカーのトークンを上書きする環境変数
+const issueSyncTokenEnv = "ZEUS_ISSUE_SYNC_TOKEN"
+
+// IssueLink は Activity と Issue の対応（最後に同期した時点の更新日時を保持）
+type IssueLink struct {
+	ActivityID        string `yaml:"activity_id"`
+	Issue             int    `yaml:"issue"`
+	URL               string `yaml:"url,omitempty"`
+	ActivityUpdatedAt string `yaml:"activity_updated_at"`
+	IssueUpdatedAt    string `yaml:"issue_updated_at"`
+	ApprovalID        string `yaml:"approval_id,omitempty"` // Activity の作成が承認待ちの場合の承認 ID
+}
+
+// IssueLinkFile は integrations/<provider>-issues.yaml の内容
+type IssueLinkFile struct {
+	Provider string      `yaml:"provider"`
+	Project  string      `yaml:"project"`
+	Links    []IssueLink `yaml:"links"`
+}
+
+// IssueSyncChange は同期で行う（dry-run では行う予定の）変更
+type IssueSyncChange struct {
+	Direction  string // push（Activity → Issue）, pull（Issue → Activity）
+	Action     string // create, update, unlink, queued（Activity の変更が承認待ち）
+	ActivityID string // pull の create では dry-run 時に空
+	Issue      int    // push の create では dry-run 時に 0
+	Title      string
+	Detail     string
+}
+
+// IssueSyncResult は同期結果
+type IssueSyncResult struct {
+	Provider  string
+	Project   string
+	DryRun    bool
+	Changes   []IssueSyncChange
+	Unchanged int
+	Conflicts int          // 両側で変更されていたリンク数（更新日時の新しい側を採用）
+	Files     []FileChange // dry-run 時に変更されるはずのファイル（push の create で作成される Issue のリンクは含まない）
+}
+
+// IssueSyncDriver は zeus.yaml の integrations.issue_sync からドライバーを作成
+func (z *Zeus) IssueSyncDriver(ctx context.Context) (issuesync.Driver, error) {
+	config, err := z.LoadConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	settings := config.Integrations.IssueSync
+	if settings.Provider == "" {
+		return nil, ErrIssueSyncNotConfigured
+	}
+	token := settings.Token
+	if env := os.Getenv(issueSyncTokenEnv); env != "" {
+		token = env
+	}
+	return issuesync.NewDriver(issuesync.Config{
+		Provider: settings.Provider,
+		BaseURL:  settings.BaseURL,
+		Project:  settings.Project,
+		Token:    token,
+	}, nil)
+}
+
+// SyncIssues は Activity と Issue を双方向に同期
+//
+//   - リンク済み: 片側のみ変更されていればもう片側へ反映（タイトル、説明/本文、deprecated/closed）
+//     両側で変更されていれば更新日時の新しい側を採用
+//   - 未リンクの Activity（deprecated 以外）: Issue を作成
+//   - 未リンクの open な Issue: Activity を作成（ラベルはタグとして取り込み）
+//   - 片側が削除されたリンク: リンクのみ解除（もう片側は削除しない）
+//
+// Activity の作成・更新は承認レベル・エージェントのガードレールを適用し、承認が必要な場合は承認待ちキューに追加する
+// （作成が承認待ちのリンクは承認されるまで保持する）。
+//
+// dry-run では課題トラッカーへの書き込みを行わず、ローカルの変更はステージして差分のみを返す
+func (z *Zeus) SyncIssues(ctx context.Context, driver issuesync.Driver, dryRun bool) (*IssueSyncResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if !dryRun {
+		return z.syncIssues(ctx, driver, false)
+	}
+	var result *IssueSyncResult
+	files, err := z.DryRun(ctx, func(tx *Zeus) error {
+		var err error
+		result, err = tx.syncIssues(ctx, driver, true)
+		return err
+	})
+	if err != nil {
+		return result, err
+	}
+	result.Files = files
+	return result, nil
+}
+
+// syncIssues は SyncIssues の同期処理（dryRun は課題トラッカーへの書き込みのみを抑止する）
+func (z *Zeus) syncIssues(ctx context.Context, driver issuesync.Driver, dryRun bool) (*IssueSyncResult, error) {
+	config, err := z.LoadConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	project := config.Integrations.IssueSync.Project
+
+	handler, ok := z.entityRegistry.Get("activity")
+	if !ok {
+		return nil, fmt.Errorf("activity handler not found")
+	}
+
+	linkPath := filepath.Join("integrations", driver.Provider()+"-issues.yaml")
+	links := IssueLinkFile{Provider: driver.Provider(), Project: project}
+	if z.fileStore.Exists(ctx, linkPath) {
+		if err := z.fileStore.ReadYaml(ctx, linkPath, &links); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", linkPath, err)
+		}
+		if links.Project != project {
+			return nil, fmt.Errorf("%s is linked to project %q (configured: %q); remove it to re-link", linkPath, links.Project, project)
+		}
+	}
+
+	issues, err := driver.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s issues: %w", driver.Provider(), err)
+	}
+	issueByNumber := make(map[int]issuesync.Issue, len(issues))
+	for _, issue := range issues {
+		issueByNumber[issue.Number] = issue
+	}
+
+	var activities []ActivityEntity
+	activityByID := map[string]ActivityEntity{}
+	z.forEachYaml(ctx, "activities", func(path string) {
+		var a ActivityEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &a); err == nil {
+			activities = append(activities, a)
+			activityByID[a.ID] = a
+		}
+	})
+	sort.SliceStable(activities, func(i, j int) bool {
+		return planLess(activities[i].Metadata.CreatedAt, activities[i].ID, activities[j].Metadata.CreatedAt, activities[j].ID)
+	})
+
+	result := &IssueSyncResult{Provider: driver.Provider(), Project: project, DryRun: dryRun, Changes: []IssueSyncChange{}}
+	linkedActivities := map[string]bool{}
+	linkedIssues := map[int]bool{}
+	var kept []IssueLink
+	pulled := false
+
+	for _, link := range links.Links {
+		activity, activityOK := activityByID[link.ActivityID]
+		issue, issueOK := issueByNumber[link.Issue]
+		if !activityOK && issueOK && z.approvalPending(ctx, link.ApprovalID) {
+			// Activity の作成が承認待ち
+			result.Unchanged++
+			linkedActivities[link.ActivityID] = true
+			linkedIssues[link.Issue] = true
+			kept = append(kept, link)
+			continue
+		}
+		if !activityOK || !issueOK {
+			detail := "activity deleted"
+			switch {
+			case !issueOK:
+				detail = "issue not found"
+			case link.ApprovalID != "":
+				detail = "activity creation rejected"
+			}
+			result.Changes = append(result.Changes, IssueSyncChange{Direction: "-", Action: "unlink", ActivityID: link.ActivityID, Issue: link.Issue, Detail: detail})
+			continue
+		}
+		linkedActivities[link.ActivityID] = true
+		linkedIssues[link.Issue] = true
+		link.ApprovalID = ""
+
+		activityChanged := activity.Metadata.UpdatedAt != link.ActivityUpdatedAt
+		issueChanged := issueStamp(issue) != link.IssueUpdatedAt
+		if !activityChanged && !issueChanged {
+			result.Unchanged++
+			kept = append(kept, link)
+			continue
+		}
+
+		pull := issueChanged
+		detail := ""
+		if activityChanged && issueChanged {
+			result.Conflicts++
+			activityTime, _ := time.Parse(time.RFC3339, activity.Metadata.UpdatedAt)
+			pull = issue.UpdatedAt.After(activityTime)
+			detail = "conflict: activity newer"
+			if pull {
+				detail = "conflict: issue newer"
+			}
+		}
+
+		if issueMatchesActivity(issue, activity) {
+			// 内容が同じ場合は同期時点のみ更新
+			result.Unchanged++
+		} else if pull {
+			change := IssueSyncChange{Direction: "pull", Action: "update", ActivityID: activity.ID, Issue: issue.Number, Title: issue.Title, Detail: detail}
+			err := z.updateGated(ctx, "activity", activity.ID, activityUpdateFromIssue(issue, activity))
+			var required *ApprovalRequiredError
+			switch {
+			case errors.As(err, &required):
+				// 承認後は Activity が更新されるため、次回の同期で Issue と同じ内容として扱われる
+				change.Action, change.Detail = "queued", joinDetail(detail, "approval "+required.ApprovalID)
+			case err != nil:
+				return result, fmt.Errorf("activity %s: %w", activity.ID, err)
+			default:
+				pulled = true
+				updated, err := handler.Get(ctx, activity.ID)
+				if err != nil {
+					return result, fmt.Errorf("activity %s: %w", activity.ID, err)
+				}
+				activity = *updated.(*ActivityEntity)
+			}
+			result.Changes = append(result.Changes, change)
+		} else {
+			result.Changes = append(result.Changes, IssueSyncChange{Direction: "push", Action: "update", ActivityID: activity.ID, Issue: issue.Number, Title: activity.Title, Detail: detail})
+			if !dryRun {
+				updated, err := driver.Update(ctx, issueFromActivity(activity, issue.Number))
+				if err != nil {
+					return result, fmt.Errorf("issue #%d: %w", issue.Number, err)
+				}
+				issue = *updated
+			}
+		}
+		link.ActivityUpdatedAt = activity.Metadata.UpdatedAt
+		link.IssueUpdatedAt = issueStamp(issue)
+		kept = append(kept, link)
+	}
+
+	// 未リンクの Activity → Issue を作成
+	for _, activity := range activities {
+		if linkedActivities[activity.ID] || activity.Status == ActivityStatusDeprecated {
+			continue
+		}
+		change := IssueSyncChange{Direction: "push", Action: "create", ActivityID: activity.ID, Title: activity.Title}
+		if !dryRun {
+			created, err := driver.Create(ctx, issueFromActivity(activity, 0))
+			if err != nil {
+				return result, fmt.Errorf("activity %s: failed to create issue: %w", activity.ID, err)
+			}
+			change.Issue = created.Number
+			kept = append(kept, IssueLink{
+				ActivityID:        activity.ID,
+				Issue:             created.Number,
+				URL:               created.URL,
+				ActivityUpdatedAt: activity.Metadata.UpdatedAt,
+				IssueUpdatedAt:    issueStamp(*created),
+			})
+		}
+		result.Changes = append(result.Changes, change)
+	}
+
+	// 未リンクの open な Issue → Activity を作成
+	sort.Slice(issues, func(i, j int) bool { return issues[i].Number < issues[j].Number })
+	for _, issue := range issues {
+		if linkedIssues[issue.Number] || issue.Closed {
+			continue
+		}
+		change := IssueSyncChange{Direction: "pull", Action: "create", Issue: issue.Number, Title: issue.Title}
+		opts := []EntityOption{WithActivityStatus(ActivityStatusDraft)}
+		if tags := importTags(issue.Labels); len(tags) > 0 {
+			opts = append(opts, WithActivityTags(tags))
+		}
+		if issue.Body != "" {
+			opts = append(opts, WithActivityDescription(issue.Body))
+		}
+		added, err := z.addGated(ctx, "activity", issue.Title, opts...)
+		var required *ApprovalRequiredError
+		if errors.As(err, &required) {
+			// 承認後に作成される Activity とリンクし、承認されるまで Issue を再度取り込まない
+			change.Action, change.Detail = "queued", "approval "+required.ApprovalID
+			kept = append(kept, IssueLink{
+				ActivityID:     required.EntityID,
+				Issue:          issue.Number,
+				URL:            issue.URL,
+				IssueUpdatedAt: issueStamp(issue),
+				ApprovalID:     required.ApprovalID,
+			})
+			result.Changes = append(result.Changes, change)
+			continue
+		}
+		if err != nil {
+			return result, fmt.Errorf("issue #%d: failed to create activity: %w", issue.Number, err)
+		}
+		pulled = true
+		created, err := handler.Get(ctx, added.ID)
+		if err != nil {
+			return result, fmt.Errorf("activity %s: %w", added.ID, err)
+		}
+		// dry-run の ID は実行時に採番し直されるため表示しない
+		if !dryRun {
+			change.ActivityID = added.ID
+		}
+		kept = append(kept, IssueLink{
+			ActivityID:        added.ID,
+			Issue:             issue.Number,
+			URL:               issue.URL,
+			ActivityUpdatedAt: created.(*ActivityEntity).Metadata.UpdatedAt,
+			IssueUpdatedAt:    issueStamp(issue),
+		})
+		result.Changes = append(result.Changes, change)
+	}
+
+	sort.SliceStable(kept, func(i, j int) bool { return kept[i].Issue < kept[j].Issue })
+	links.Project = project
+	links.Links = kept
+	if err := z.fileStore.EnsureDir(ctx, "integrations"); err != nil {
+		return result, err
+	}
+	if err := z.fileStore.WriteYaml(ctx, linkPath, &links); err != nil {
+		return result, fmt.Errorf("failed to write %s: %w", linkPath, err)
+	}
+	if pulled {
+		if err := z.updateState(ctx); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// issueStamp は同期状態の比較に使う Issue の更新日時
+func issueStamp(issue issuesync.Issue) string {
+	return issue.UpdatedAt.UTC().Format(time.RFC3339)
+}
+
+// issueFromActivity は Activity を Issue に変換（deprecated は closed）
+func issueFromActivity(activity ActivityEntity, number int) issuesync.Issue {
+	return issuesync.Issue{
+		Number: number,
+		Title:  activity.Title,
+		Body:   activity.Description,
+		Closed: activity.Status == ActivityStatusDeprecated,
+	}
+}
+
+// issueMatchesActivity は同期対象のフィールドが一致しているか
+func issueMatchesActivity(issue issuesync.Issue, activity ActivityEntity) bool {
+	return issue.Title == activity.Title &&
+		issue.Body == activity.Description &&
+		issue.Closed == (activity.Status == ActivityStatusDeprecated)
+}
+
+// activityUpdateFromIssue は Issue の内容を Activity の更新データに変換
+// closed は deprecated、再オープンされた場合は active に戻す
+func activityUpdateFromIssue(issue issuesync.Issue, activity ActivityEntity) map[string]any {
+	status := activity.Status
+	switch {
+	case issue.Closed:
+		status = ActivityStatusDeprecated
+	case status == ActivityStatusDeprecated:
+		status = ActivityStatusActive
+	}
+	return map[string]any{
+		"title":       issue.Title,
+		"description": issue.Body,
+		"status":      string(status),
+	}
+}
+
+// approvalPending は承認 ID の承認が承認待ちのままか
+func (z *Zeus) approvalPending(ctx context.Context, approvalID string) bool {
+	if approvalID == "" {
+		return false
+	}
+	approval, err := z.approvalStore.Get(ctx, approvalID)
+	return err == nil && approval.Status == ApprovalStatusPending
+}
+
+// joinDetail は同期の変更の詳細を結合（空の要素は除く）
+func joinDetail(details ...string) string {
+	var parts []string
+	for _, d := range details {
+		if d != "" {
+			parts = append(parts, d)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,353 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/integrations/issuesync"
+)
+
+// fakeIssueDriver はメモリ上で Issue を保持するドライバー
+type fakeIssueDriver struct {
+	issues map[int]*issuesync.Issue
+	next   int
+	now    time.Time
+}
+
+func newFakeIssueDriver() *fakeIssueDriver {
+	return &fakeIssueDriver{issues: map[int]*issuesync.Issue{}, next: 1, now: time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)}
+}
+
+func (d *fakeIssueDriver) Provider() string { return "gitea" }
+
+func (d *fakeIssueDriver) List(ctx context.Context) ([]issuesync.Issue, error) {
+	var issues []issuesync.Issue
+	for _, issue := range d.issues {
+		issues = append(issues, *issue)
+	}
+	return issues, nil
+}
+
+func (d *fakeIssueDriver) Create(ctx context.Context, issue issuesync.Issue) (*issuesync.Issue, error) {
+	issue.Number = d.next
+	d.next++
+	issue.UpdatedAt = d.now
+	d.issues[issue.Number] = &issue
+	return &issue, nil
+}
+
+func (d *fakeIssueDriver) Update(ctx context.Context, issue issuesync.Issue) (*issuesync.Issue, error) {
+	if _, ok := d.issues[issue.Number]; !ok {
+		return nil, errors.New("not found")
+	}
+	d.now = d.now.Add(time.Minute)
+	issue.UpdatedAt = d.now
+	d.issues[issue.Number] = &issue
+	return &issue, nil
+}
+
+// edit は Issue をトラッカー側で編集したことにする
+func (d *fakeIssueDriver) edit(number int, at time.Time, fn func(*issuesync.Issue)) {
+	fn(d.issues[number])
+	d.issues[number].UpdatedAt = at
+}
+
+func setupIssueSync(t *testing.T) (*Zeus, context.Context) {
+	t.Helper()
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	config, _ := z.LoadConfig(ctx)
+	config.Integrations.IssueSync = IssueSyncSettings{Provider: "gitea", BaseURL: "https://git.example.com", Project: "team/app"}
+	if err := z.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+	return z, ctx
+}
+
+// editActivity は Activity を指定時刻に編集したことにする
+func editActivity(t *testing.T, z *Zeus, ctx context.Context, id string, at time.Time, fn func(*ActivityEntity)) {
+	t.Helper()
+	path := filepath.Join("activities", id+".yaml")
+	var a ActivityEntity
+	if err := z.fileStore.ReadYaml(ctx, path, &a); err != nil {
+		t.Fatalf("ReadYaml failed: %v", err)
+	}
+	fn(&a)
+	a.Metadata.UpdatedAt = at.Format(time.RFC3339)
+	if err := z.fileStore.WriteYaml(ctx, path, &a); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+}
+
+func getActivity(t *testing.T, z *Zeus, ctx context.Context, id string) *ActivityEntity {
+	t.Helper()
+	handler, _ := z.entityRegistry.Get("activity")
+	a, err := handler.Get(ctx, id)
+	if err != nil {
+		t.Fatalf("Get activity failed: %v", err)
+	}
+	return a.(*ActivityEntity)
+}
+
+func TestIssueSyncDriver(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	if _, err := z.IssueSyncDriver(ctx); !errors.Is(err, ErrIssueSyncNotConfigured) {
+		t.Errorf("expected ErrIssueSyncNotConfigured, got %v", err)
+	}
+
+	z, ctx = setupIssueSync(t)
+	driver, err := z.IssueSyncDriver(ctx)
+	if err != nil {
+		t.Fatalf("IssueSyncDriver failed: %v", err)
+	}
+	if driver.Provider() != "gitea" {
+		t.Errorf("Provider() = %s, want gitea", driver.Provider())
+	}
+}
+
+func TestSyncIssues_InitialLink(t *testing.T) {
+	z, ctx := setupIssueSync(t)
+	driver := newFakeIssueDriver()
+	driver.issues[10] = &issuesync.Issue{Number: 10, Title: "Fix login", Body: "Steps", Labels: []string{"P1"}, UpdatedAt: driver.now}
+	driver.issues[11] = &issuesync.Issue{Number: 11, Title: "Old", Closed: true, UpdatedAt: driver.now}
+	driver.next = 12
+
+	act, err := z.Add(ctx, "activity", "Write docs", WithActivityDescription("Guide"))
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if _, err := z.Add(ctx, "activity", "Retired", WithActivityStatus(ActivityStatusDeprecated)); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+
+	preview, err := z.SyncIssues(ctx, driver, true)
+	if err != nil {
+		t.Fatalf("SyncIssues dry-run failed: %v", err)
+	}
+	if len(preview.Changes) != 2 || len(driver.issues) != 2 {
+		t.Fatalf("dry-run should only report changes: %+v", preview.Changes)
+	}
+	if z.fileStore.Exists(ctx, "integrations/gitea-issues.yaml") {
+		t.Error("dry-run should not write links")
+	}
+	if len(preview.Files) == 0 {
+		t.Error("dry-run should report files that would change")
+	}
+
+	result, err := z.SyncIssues(ctx, driver, false)
+	if err != nil {
+		t.Fatalf("SyncIssues failed: %v", err)
+	}
+	if len(result.Changes) != 2 {
+		t.Fatalf("expected push create and pull create: %+v", result.Changes)
+	}
+	push, pull := result.Changes[0], result.Changes[1]
+	if push.Direction != "push" || push.ActivityID != act.ID || push.Issue != 12 {
+		t.Errorf("unexpected push change: %+v", push)
+	}
+	if driver.issues[12].Title != "Write docs" || driver.issues[12].Body != "Guide" {
+		t.Errorf("issue should mirror activity: %+v", driver.issues[12])
+	}
+	if pull.Direction != "pull" || pull.Issue != 10 || pull.ActivityID == "" {
+		t.Fatalf("unexpected pull change: %+v", pull)
+	}
+	pulled := getActivity(t, z, ctx, pull.ActivityID)
+	if pulled.Title != "Fix login" || pulled.Description != "Steps" || strings.Join(pulled.Metadata.Tags, ",") != "priority:high" {
+		t.Errorf("activity should mirror issue: %+v", pulled)
+	}
+
+	// 2 回目は変更なし
+	again, err := z.SyncIssues(ctx, driver, false)
+	if err != nil {
+		t.Fatalf("SyncIssues failed: %v", err)
+	}
+	if len(again.Changes) != 0 || again.Unchanged != 2 {
+		t.Errorf("second sync should be a no-op: %+v", again)
+	}
+}
+
+// linkedIssues は初回同期の結果から Activity ID → Issue 番号を引く
+func linkedIssues(result *IssueSyncResult) map[string]int {
+	issues := map[string]int{}
+	for _, c := range result.Changes {
+		issues[c.ActivityID] = c.Issue
+	}
+	return issues
+}
+
+func TestSyncIssues_TwoWayUpdates(t *testing.T) {
+	z, ctx := setupIssueSync(t)
+	driver := newFakeIssueDriver()
+	a1, _ := z.Add(ctx, "activity", "Build form")
+	a2, _ := z.Add(ctx, "activity", "Add captcha")
+	a3, _ := z.Add(ctx, "activity", "Write tests")
+	initial, err := z.SyncIssues(ctx, driver, false)
+	if err != nil {
+		t.Fatalf("SyncIssues failed: %v", err)
+	}
+	issues := linkedIssues(initial)
+
+	later := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	// a1: Activity 側のみ変更 → push（deprecated は close）
+	editActivity(t, z, ctx, a1.ID, later, func(a *ActivityEntity) {
+		a.Title = "Build signup form"
+		a.Status = ActivityStatusDeprecated
+	})
+	// a2: Issue 側のみ変更 → pull
+	driver.edit(issues[a2.ID], later, func(i *issuesync.Issue) { i.Body = "Use hCaptcha"; i.Closed = true })
+	// a3: 両側で変更、Issue の方が新しい → pull（競合）
+	editActivity(t, z, ctx, a3.ID, later, func(a *ActivityEntity) { a.Title = "Write unit tests" })
+	driver.edit(issues[a3.ID], later.Add(time.Hour), func(i *issuesync.Issue) { i.Title = "Write e2e tests" })
+
+	result, err := z.SyncIssues(ctx, driver, false)
+	if err != nil {
+		t.Fatalf("SyncIssues failed: %v", err)
+	}
+	if len(result.Changes) != 3 || result.Conflicts != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if issue := driver.issues[issues[a1.ID]]; issue.Title != "Build signup form" || !issue.Closed {
+		t.Errorf("issue 1 should be pushed: %+v", issue)
+	}
+	if a := getActivity(t, z, ctx, a2.ID); a.Description != "Use hCaptcha" || a.Status != ActivityStatusDeprecated {
+		t.Errorf("activity 2 should be pulled: %+v", a)
+	}
+	if a := getActivity(t, z, ctx, a3.ID); a.Title != "Write e2e tests" {
+		t.Errorf("newer issue should win the conflict: %+v", a)
+	}
+	for _, c := range result.Changes {
+		if (c.ActivityID == a3.ID) != strings.HasPrefix(c.Detail, "conflict") {
+			t.Errorf("only the a3 change should be reported as a conflict: %+v", c)
+		}
+	}
+
+	// 再オープンは active に戻す
+	driver.edit(issues[a2.ID], later.Add(2*time.Hour), func(i *issuesync.Issue) { i.Closed = false })
+	if _, err := z.SyncIssues(ctx, driver, false); err != nil {
+		t.Fatalf("SyncIssues failed: %v", err)
+	}
+	if a := getActivity(t, z, ctx, a2.ID); a.Status != ActivityStatusActive {
+		t.Errorf("reopened issue should reactivate activity: %s", a.Status)
+	}
+}
+
+func TestSyncIssues_Unlink(t *testing.T) {
+	z, ctx := setupIssueSync(t)
+	driver := newFakeIssueDriver()
+	a1, _ := z.Add(ctx, "activity", "Build form")
+	a2, _ := z.Add(ctx, "activity", "Add captcha")
+	initial, err := z.SyncIssues(ctx, driver, false)
+	if err != nil {
+		t.Fatalf("SyncIssues failed: %v", err)
+	}
+	issues := linkedIssues(initial)
+
+	handler, _ := z.entityRegistry.Get("activity")
+	if err := handler.Delete(ctx, a1.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	delete(driver.issues, issues[a2.ID])
+
+	result, err := z.SyncIssues(ctx, driver, false)
+	if err != nil {
+		t.Fatalf("SyncIssues failed: %v", err)
+	}
+	// 2 件の unlink と、Issue を失った a2 の Issue 再作成
+	var unlinks int
+	for _, c := range result.Changes {
+		if c.Action == "unlink" {
+			unlinks++
+		}
+	}
+	if unlinks != 2 || len(driver.issues) != 2 {
+		t.Errorf("unexpected result: %+v issues=%d", result.Changes, len(driver.issues))
+	}
+	if getActivity(t, z, ctx, a2.ID).Title != "Add captcha" {
+		t.Error("activity should not be deleted when its issue disappears")
+	}
+	if driver.issues[issues[a1.ID]] == nil {
+		t.Error("issue should not be deleted when its activity disappears")
+	}
+}
+
+func TestSyncIssues_ProjectMismatch(t *testing.T) {
+	z, ctx := setupIssueSync(t)
+	driver := newFakeIssueDriver()
+	if _, err := z.SyncIssues(ctx, driver, false); err != nil {
+		t.Fatalf("SyncIssues failed: %v", err)
+	}
+
+	config, _ := z.LoadConfig(ctx)
+	config.Integrations.IssueSync.Project = "team/other"
+	if err := z.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+	if _, err := z.SyncIssues(ctx, driver, false); err == nil {
+		t.Error("expected error when the configured project changes")
+	}
+}
+
+func TestSyncIssues_ProtectedFromAgent(t *testing.T) {
+	agent, human := setupAgentPolicy(t, AgentPolicy{Protected: []string{"activity"}})
+	ctx := context.Background()
+	config, _ := human.LoadConfig(ctx)
+	config.Integrations.IssueSync = IssueSyncSettings{Provider: "gitea", BaseURL: "https://git.example.com", Project: "team/app"}
+	if err := human.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+	driver := newFakeIssueDriver()
+	driver.issues[10] = &issuesync.Issue{Number: 10, Title: "Fix login", UpdatedAt: driver.now}
+	driver.next = 11
+
+	result, err := agent.SyncIssues(ctx, driver, false)
+	if err != nil {
+		t.Fatalf("SyncIssues failed: %v", err)
+	}
+	if len(result.Changes) != 1 || result.Changes[0].Action != "queued" {
+		t.Fatalf("protected activity creation should be queued: %+v", result.Changes)
+	}
+	pending, _ := human.Pending(ctx)
+	if len(pending) != 1 {
+		t.Fatalf("expected 1 pending approval, got %d", len(pending))
+	}
+	if trail, _ := human.AuditTrail(ctx, "claude", 0); len(trail) != 1 || trail[0].Result != AuditResultQueued {
+		t.Errorf("queued activity creation should be audited: %+v", trail)
+	}
+
+	// 承認待ちの間は同じ Issue を再度取り込まない
+	again, err := agent.SyncIssues(ctx, driver, false)
+	if err != nil {
+		t.Fatalf("SyncIssues failed: %v", err)
+	}
+	if len(again.Changes) != 0 || again.Unchanged != 1 {
+		t.Errorf("pending link should be kept as is: %+v", again)
+	}
+
+	if _, err := human.Approve(ctx, pending[0].ID); err != nil {
+		t.Fatalf("Approve failed: %v", err)
+	}
+	if a := getActivity(t, human, ctx, pending[0].EntityID); a.Title != "Fix login" {
+		t.Errorf("approved activity should mirror issue: %+v", a)
+	}
+	if _, err := human.SyncIssues(ctx, driver, false); err != nil {
+		t.Fatalf("SyncIssues failed: %v", err)
+	}
+	final, err := human.SyncIssues(ctx, driver, false)
+	if err != nil {
+		t.Fatalf("SyncIssues failed: %v", err)
+	}
+	if len(final.Changes) != 0 || final.Unchanged != 1 || len(driver.issues) != 1 {
+		t.Errorf("approved activity should stay linked to the issue: %+v", final)
+	}
+}
@@ -30,6 +30,7 @@ func (e *LintError) Error() string {
 
 // LintWarning は Lint 警告
 type LintWarning struct {
+	Rule       string // ルール ID（zeus check のチェック名。空の場合は lint_directory）
 	EntityType string
 	EntityID   string
 	Field      string
@@ -86,6 +87,9 @@ func (l *LintChecker) CheckAll(ctx context.Context) (*LintResult, error) {
 	_, ufWarnings := l.CheckUnknownFields(ctx)
 	result.Warnings = append(result.Warnings, ufWarnings...)
 
+	// UseCase シナリオの記述漏れ・参照切れチェック
+	result.Warnings = append(result.Warnings, l.CheckScenarios(ctx)...)
+
 	// エラーがあれば valid = false
 	if len(result.Errors) > 0 {
 		result.Valid = false
@@ -115,6 +119,8 @@ func (l *LintChecker) CheckUnknownFields(ctx context.Context) ([]*LintError, []*
 		{"assumption", "assumptions", func() any { return new(AssumptionEntity) }},
 		{"quality", "quality", func() any { return new(QualityEntity) }},
 		{"usecase", "usecases", func() any { return new(UseCaseEntity) }},
+		{"statemachine", "statemachines", func() any { return new(StateMachineEntity) }},
+		{"release", "releases", func() any { return new(ReleaseEntity) }},
 	}
 
 	for _, entity := range directoryEntities {
@@ -145,6 +151,8 @@ func (l *LintChecker) CheckUnknownFields(ctx context.Context) ([]*LintError, []*
 		{"actor", "actors.yaml", func() any { return new(ActorsFile) }},
 		{"subsystem", "subsystems.yaml", func() any { return new(SubsystemsFile) }},
 		{"constraint", "constraints.yaml", func() any { return new(ConstraintsFile) }},
+		{"container", "containers.yaml", func() any { return new(ContainersFile) }},
+		{"component", "components.yaml", func() any { return new(ComponentsFile) }},
 	}
 
 	for _, entity := range singleFileEntities {
@@ -210,6 +218,8 @@ func (l *LintChecker) CheckIDFormat(ctx context.Context) ([]*LintError, []*LintW
 		{"assumption", "assumptions", "assum-NNN"},
 		{"quality", "quality", "qual-NNN"},
 		{"usecase", "usecases", "uc-XXXXXXXX or uc-<name>"},
+		{"statemachine", "statemachines", "sm-NNN"},
+		{"release", "releases", "rel-NNN"},
 	}
 
 	for _, entity := range directoryEntities {
@@ -218,7 +228,7 @@ func (l *LintChecker) CheckIDFormat(ctx context.Context) ([]*LintError, []*LintW
 		warnings = append(warnings, warns...)
 	}
 
-	// 単一ファイルエンティティ（actors.yaml, subsystems.yaml, constraints.yaml）
+	// 単一ファイルエンティティ（actors.yaml, subsystems.yaml, constraints.yaml 等）
 	singleFileEntities := []struct {
 		entityType  string
 		filePath    string
@@ -227,6 +237,8 @@ func (l *LintChecker) CheckIDFormat(ctx context.Context) ([]*LintError, []*LintW
 		{"actor", "actors.yaml", "actor-XXXXXXXX"},
 		{"subsystem", "subsystems.yaml", "sub-XXXXXXXX or sub-<name>"},
 		{"constraint", "constraints.yaml", "const-NNN"},
+		{"container", "containers.yaml", "ctr-XXXXXXXX or ctr-<name>"},
+		{"component", "components.yaml", "cmp-XXXXXXXX or cmp-<name>"},
 	}
 
 	for _, entity := range singleFileEntities {
@@ -385,6 +397,18 @@ func (l *LintChecker) extractEntityID(ctx context.Context, entityType, filePath
 			return "", err
 		}
 		return entity.ID, nil
+	case "statemachine":
+		var entity StateMachineEntity
+		if err := l.fileStore.ReadYaml(ctx, filePath, &entity); err != nil {
+			return "", err
+		}
+		return entity.ID, nil
+	case "release":
+		var entity ReleaseEntity
+		if err := l.fileStore.ReadYaml(ctx, filePath, &entity); err != nil {
+			return "", err
+		}
+		return entity.ID, nil
 	default:
 		return "", fmt.Errorf("unknown entity type: %s", entityType)
 	}
@@ -423,6 +447,26 @@ func (l *LintChecker) extractEntityIDsFromFile(ctx context.Context, entityType,
 			ids[i] = c.ID
 		}
 		return ids, nil
+	case "container":
+		var store ContainersFile
+		if err := l.fileStore.ReadYaml(ctx, filePath, &store); err != nil {
+			return nil, err
+		}
+		ids := make([]string, len(store.Containers))
+		for i, c := range store.Containers {
+			ids[i] = c.ID
+		}
+		return ids, nil
+	case "component":
+		var store ComponentsFile
+		if err := l.fileStore.ReadYaml(ctx, filePath, &store); err != nil {
+			return nil, err
+		}
+		ids := make([]string, len(store.Components))
+		for i, c := range store.Components {
+			ids[i] = c.ID
+		}
+		return ids, nil
 	default:
 		return nil, fmt.Errorf("unknown single-file entity type: %s", entityType)
 	}
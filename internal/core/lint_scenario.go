@@ -0,0 +1,119 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// シナリオの Lint ルール（zeus check のチェック名、抑制の rule に指定する）
+const (
+	LintRuleScenarioMainFlow         = "scenario_main_flow"         // 有効な UseCase にメインフローが無い
+	LintRuleScenarioRejoin           = "scenario_rejoin"            // 代替フローの rejoins_at がメインフローのステップを指していない
+	LintRuleScenarioExceptionOutcome = "scenario_exception_outcome" // 例外フローに outcome が無い
+	LintRuleScenarioExtensionPoint   = "scenario_extension_point"   // extend の extension_point が拡張先のメインフローに無い
+)
+
+// scenarioStepNumberPattern はステップ参照（"3"、"ステップ3"、"step 3" など）の番号
+var scenarioStepNumberPattern = regexp.MustCompile(`\d+`)
+
+// CheckScenarios は UseCase のシナリオの記述漏れ・参照切れをチェック（すべて警告）
+// - scenario_main_flow: status が active の UseCase に main_flow が無い
+// - scenario_rejoin: 代替フローの rejoins_at がメインフローのステップを指していない
+// - scenario_exception_outcome: 例外フローに outcome が無い
+// - scenario_extension_point: extend 関係の extension_point が拡張先 UseCase のメインフローに無い
+func (l *LintChecker) CheckScenarios(ctx context.Context) []*LintWarning {
+	var warnings []*LintWarning
+
+	if !l.fileStore.Exists(ctx, "usecases") {
+		return warnings
+	}
+	files, err := l.fileStore.ListDir(ctx, "usecases")
+	if err != nil {
+		return warnings // ディレクトリの読み取りエラーは CheckIDFormat で警告する
+	}
+
+	usecases := []*UseCaseEntity{}
+	byID := map[string]*UseCaseEntity{}
+	for _, file := range files {
+		if !hasYamlSuffix(file) {
+			continue
+		}
+		var uc UseCaseEntity
+		if err := l.fileStore.ReadYaml(ctx, filepath.Join("usecases", file), &uc); err != nil {
+			continue // 読み込み失敗はスキップ
+		}
+		usecases = append(usecases, &uc)
+		byID[uc.ID] = &uc
+	}
+
+	for _, uc := range usecases {
+		warn := func(rule, field, message string) {
+			warnings = append(warnings, &LintWarning{
+				Rule:       rule,
+				EntityType: "usecase",
+				EntityID:   uc.ID,
+				Field:      field,
+				Message:    message,
+			})
+		}
+		scenario := uc.Scenario
+
+		if uc.Status == UseCaseStatusActive && len(scenario.MainFlow) == 0 {
+			warn(LintRuleScenarioMainFlow, "scenario.main_flow", "active usecase has no main flow")
+		}
+		for i, af := range scenario.AlternativeFlows {
+			if af.RejoinsAt != "" && !scenarioStepExists(scenario.MainFlow, af.RejoinsAt) {
+				warn(LintRuleScenarioRejoin, fmt.Sprintf("scenario.alternative_flows[%d].rejoins_at", i),
+					fmt.Sprintf("rejoin step %q is not in the main flow (%d steps)", af.RejoinsAt, len(scenario.MainFlow)))
+			}
+		}
+		for i, ef := range scenario.ExceptionFlows {
+			if strings.TrimSpace(ef.Outcome) == "" {
+				warn(LintRuleScenarioExceptionOutcome, fmt.Sprintf("scenario.exception_flows[%d].outcome", i),
+					"exception flow has no outcome")
+			}
+		}
+		for i, rel := range uc.Relations {
+			if rel.Type != RelationTypeExtend || rel.ExtensionPoint == "" {
+				continue
+			}
+			// 拡張先が存在しない場合は参照整合性チェックで扱う
+			target, ok := byID[rel.TargetID]
+			if !ok {
+				continue
+			}
+			if !scenarioStepExists(target.Scenario.MainFlow, rel.ExtensionPoint) {
+				warn(LintRuleScenarioExtensionPoint, fmt.Sprintf("relations[%d].extension_point", i),
+					fmt.Sprintf("extension point %q is not in the main flow of %s", rel.ExtensionPoint, rel.TargetID))
+			}
+		}
+	}
+
+	return warnings
+}
+
+// scenarioStepExists は ref がメインフローのステップを指しているか
+// ステップの文言を含む参照、またはステップ番号（1 始まり。"ステップ3"、"step 3" なども可）を受け付ける
+func scenarioStepExists(mainFlow []string, ref string) bool {
+	return scenarioStepIndex(mainFlow, ref) > 0
+}
+
+// scenarioStepIndex は ref が指すメインフローのステップ番号（1 始まり、見つからない場合は 0）
+func scenarioStepIndex(mainFlow []string, ref string) int {
+	ref = strings.TrimSpace(ref)
+	for i, step := range mainFlow {
+		if strings.Contains(step, ref) {
+			return i + 1
+		}
+	}
+	if m := scenarioStepNumberPattern.FindString(ref); m != "" {
+		if n, err := strconv.Atoi(m); err == nil && n >= 1 && n <= len(mainFlow) {
+			return n
+		}
+	}
+	return 0
+}
@@ -0,0 +1,82 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/biwakonbu/zeus/internal/yaml"
+)
+
+func TestLintChecker_CheckScenarios(t *testing.T) {
+	checker, zeusPath, cleanup := setupLintCheckerTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	fs := yaml.NewFileManager(zeusPath)
+
+	usecases := []*UseCaseEntity{
+		{
+			ID: "uc-checkout", Title: "購入", ObjectiveID: "obj-001", Status: UseCaseStatusActive,
+			Scenario: UseCaseScenario{
+				MainFlow: []string{"カートを確認する", "支払い方法を選択する", "注文を確定する"},
+				AlternativeFlows: []AlternativeFlow{
+					{ID: "AF1", Name: "クーポン利用", RejoinsAt: "ステップ2"},
+					{ID: "AF2", Name: "別の支払い方法", RejoinsAt: "支払い方法を選択"},
+					{ID: "AF3", Name: "存在しないステップ", RejoinsAt: "5"},
+				},
+				ExceptionFlows: []ExceptionFlow{
+					{ID: "EF1", Name: "在庫切れ", Outcome: "ステップ1へ戻る"},
+					{ID: "EF2", Name: "決済失敗"},
+				},
+			},
+		},
+		{
+			ID: "uc-coupon", Title: "クーポンを使う", ObjectiveID: "obj-001", Status: UseCaseStatusDraft,
+			Relations: []UseCaseRelation{
+				{Type: RelationTypeExtend, TargetID: "uc-checkout", ExtensionPoint: "支払い方法"},
+				{Type: RelationTypeExtend, TargetID: "uc-checkout", ExtensionPoint: "配送先"},
+				{Type: RelationTypeExtend, TargetID: "uc-missing", ExtensionPoint: "配送先"},
+			},
+		},
+		{ID: "uc-empty", Title: "未記述", ObjectiveID: "obj-001", Status: UseCaseStatusActive},
+	}
+	for _, uc := range usecases {
+		if err := fs.WriteYaml(ctx, "usecases/"+uc.ID+".yaml", uc); err != nil {
+			t.Fatalf("Write usecase failed: %v", err)
+		}
+	}
+
+	got := map[string]int{}
+	for _, w := range checker.CheckScenarios(ctx) {
+		got[w.Rule+" "+w.EntityID+" "+w.Field]++
+	}
+	want := []string{
+		LintRuleScenarioRejoin + " uc-checkout scenario.alternative_flows[2].rejoins_at",
+		LintRuleScenarioExceptionOutcome + " uc-checkout scenario.exception_flows[1].outcome",
+		LintRuleScenarioExtensionPoint + " uc-coupon relations[1].extension_point",
+		LintRuleScenarioMainFlow + " uc-empty scenario.main_flow",
+	}
+	if len(got) != len(want) {
+		t.Errorf("expected %d warnings, got %v", len(want), got)
+	}
+	for _, key := range want {
+		if got[key] != 1 {
+			t.Errorf("expected warning %q, got %v", key, got)
+		}
+	}
+
+	// CheckAll にも含まれる
+	result, err := checker.CheckAll(ctx)
+	if err != nil {
+		t.Fatalf("CheckAll failed: %v", err)
+	}
+	scenarioWarnings := 0
+	for _, w := range result.Warnings {
+		if w.Rule != "" {
+			scenarioWarnings++
+		}
+	}
+	if scenarioWarnings != len(want) {
+		t.Errorf("expected %d scenario warnings in CheckAll, got %d", len(want), scenarioWarnings)
+	}
+}
@@ -0,0 +1,148 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// LegacyTasksPath は旧形式の単一タスクファイルのパス
+const LegacyTasksPath = "tasks/active.yaml"
+
+// legacyTask は旧形式 tasks/active.yaml のタスク（移行に必要なフィールドのみ）
+type legacyTask struct {
+	ID          string   `yaml:"id"`
+	Title       string   `yaml:"title"`
+	Description string   `yaml:"description,omitempty"`
+	Status      string   `yaml:"status"`
+	CreatedAt   string   `yaml:"created_at,omitempty"`
+	UpdatedAt   string   `yaml:"updated_at,omitempty"`
+	Tags        []string `yaml:"tags,omitempty"`
+}
+
+// legacyTaskStore は旧形式 tasks/active.yaml のルート
+type legacyTaskStore struct {
+	Tasks []legacyTask `yaml:"tasks"`
+}
+
+// MigrationResult は移行結果
+type MigrationResult struct {
+	Migrated []string // 作成された Activity ID
+	Skipped  []string // 既に存在したため移行しなかった Activity ID
+	Source   string   // 退避後の旧ファイルパス
+}
+
+// HasLegacyTasks は旧形式 tasks/active.yaml が残っているか確認
+func HasLegacyTasks(ctx context.Context, fs FileStore) bool {
+	return fs.Exists(ctx, LegacyTasksPath)
+}
+
+// MigrateLegacyTasks は tasks/active.yaml を activities/act-*.yaml の個別ファイルに分割する
+//
+// task-xxxxxxxx 形式の ID は act-xxxxxxxx に引き継ぎ、それ以外は新規 ID を採番する。
+// 旧 ID は "legacy:<id>" タグとして保持する。移行後の旧ファイルは
+// tasks/active.yaml.migrated に退避し、再実行しても二重に移行されない。
+// 一覧用インデックスも書き込むため、.zeus/.gitignore が無い場合は作成する。
+func MigrateLegacyTasks(ctx context.Context, fs FileStore) (*MigrationResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	result := &MigrationResult{Migrated: []string{}, Skipped: []string{}}
+	if !HasLegacyTasks(ctx, fs) {
+		return result, nil
+	}
+
+	var store legacyTaskStore
+	if err := fs.ReadYaml(ctx, LegacyTasksPath, &store); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", LegacyTasksPath, err)
+	}
+
+	handler := NewActivityHandler(fs, nil)
+	if err := fs.EnsureDir(ctx, "activities"); err != nil {
+		return nil, fmt.Errorf("failed to ensure activities directory: %w", err)
+	}
+	if err := ensureGitignore(ctx, fs); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", zeusGitignoreFile, err)
+	}
+
+	for _, task := range store.Tasks {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		id, err := legacyTaskActivityID(ctx, handler, task.ID)
+		if err != nil {
+			return nil, err
+		}
+		filePath := filepath.Join("activities", id+".yaml")
+		if fs.Exists(ctx, filePath) {
+			result.Skipped = append(result.Skipped, id)
+			continue
+		}
+
+		now := Now()
+		activity := ActivityEntity{
+			ID:          id,
+			Title:       task.Title,
+			Description: task.Description,
+			Status:      legacyTaskStatusToActivityStatus(task.Status),
+			Metadata: Metadata{
+				CreatedAt: task.CreatedAt,
+				UpdatedAt: task.UpdatedAt,
+				Tags:      task.Tags,
+			},
+		}
+		if activity.Metadata.CreatedAt == "" {
+			activity.Metadata.CreatedAt = now
+		}
+		if activity.Metadata.UpdatedAt == "" {
+			activity.Metadata.UpdatedAt = now
+		}
+		if task.ID != "" {
+			activity.Metadata.Tags = append(activity.Metadata.Tags, "legacy:"+task.ID)
+		}
+
+		if err := activity.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid legacy task %s: %w", task.ID, err)
+		}
+		if err := handler.writeActivity(ctx, filePath, &activity); err != nil {
+			return nil, err
+		}
+		result.Migrated = append(result.Migrated, id)
+	}
+
+	// 旧ファイルを退避（削除はしない）
+	result.Source = LegacyTasksPath + ".migrated"
+	if err := fs.Copy(ctx, LegacyTasksPath, result.Source); err != nil {
+		return nil, fmt.Errorf("failed to back up %s: %w", LegacyTasksPath, err)
+	}
+	if err := fs.Delete(ctx, LegacyTasksPath); err != nil {
+		return nil, fmt.Errorf("failed to remove %s: %w", LegacyTasksPath, err)
+	}
+
+	return result, nil
+}
+
+// legacyTaskActivityID は旧タスク ID から Activity ID を決定
+func legacyTaskActivityID(ctx context.Context, handler *ActivityHandler, taskID string) (string, error) {
+	if ValidateID("task", taskID) == nil {
+		return "act-" + strings.TrimPrefix(taskID, "task-"), nil
+	}
+	return handler.generateActivityID(ctx)
+}
+
+// legacyTaskStatusToActivityStatus は旧タスクステータスを ActivityStatus に変換
+// activityStatusToItemStatus の逆変換に相当する
+func legacyTaskStatusToActivityStatus(status string) ActivityStatus {
+	switch ItemStatus(status) {
+	case ItemStatusInProgress:
+		return ActivityStatusActive
+	case ItemStatusCompleted:
+		return ActivityStatusDeprecated
+	default:
+		// pending, blocked, 不明な値は draft
+		return ActivityStatusDraft
+	}
+}
@@ -0,0 +1,109 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMigrateLegacyTasks(t *testing.T) {
+	handler, zeusPath, cleanup := setupActivityHandlerTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	legacy := `tasks:
+  - id: task-1a2b3c4d
+    title: Legacy In Progress
+    status: in_progress
+    created_at: "2025-01-01T00:00:00Z"
+  - id: custom-id
+    title: Legacy Done
+    status: completed
+`
+	if err := os.MkdirAll(filepath.Join(zeusPath, "tasks"), 0755); err != nil {
+		t.Fatalf("failed to create tasks dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(zeusPath, "tasks", "active.yaml"), []byte(legacy), 0644); err != nil {
+		t.Fatalf("failed to write legacy file: %v", err)
+	}
+
+	if !HasLegacyTasks(ctx, handler.fileStore) {
+		t.Fatal("HasLegacyTasks should be true")
+	}
+
+	result, err := MigrateLegacyTasks(ctx, handler.fileStore)
+	if err != nil {
+		t.Fatalf("MigrateLegacyTasks failed: %v", err)
+	}
+	if len(result.Migrated) != 2 {
+		t.Fatalf("expected 2 migrated, got %d", len(result.Migrated))
+	}
+	if result.Migrated[0] != "act-1a2b3c4d" {
+		t.Errorf("expected task ID suffix to be preserved, got %s", result.Migrated[0])
+	}
+
+	got, err := handler.Get(ctx, "act-1a2b3c4d")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	activity := got.(*ActivityEntity)
+	if activity.Status != ActivityStatusActive {
+		t.Errorf("expected status active, got %s", activity.Status)
+	}
+	if activity.Metadata.CreatedAt != "2025-01-01T00:00:00Z" {
+		t.Errorf("expected created_at to be preserved, got %s", activity.Metadata.CreatedAt)
+	}
+
+	// 旧ファイルは退避され、再実行しても何も起きない
+	if HasLegacyTasks(ctx, handler.fileStore) {
+		t.Error("legacy file should be moved away")
+	}
+	if _, err := os.Stat(filepath.Join(zeusPath, "tasks", "active.yaml.migrated")); err != nil {
+		t.Errorf("backup should exist: %v", err)
+	}
+	again, err := MigrateLegacyTasks(ctx, handler.fileStore)
+	if err != nil {
+		t.Fatalf("second MigrateLegacyTasks failed: %v", err)
+	}
+	if len(again.Migrated) != 0 {
+		t.Errorf("second migration should be a no-op, got %v", again.Migrated)
+	}
+
+	list, err := handler.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if list.Total != 2 {
+		t.Errorf("expected 2 activities, got %d", list.Total)
+	}
+
+	// 移行で書き込んだ一覧用インデックスはコミット対象から除く
+	data, err := os.ReadFile(filepath.Join(zeusPath, ".gitignore"))
+	if err != nil {
+		t.Fatalf(".gitignore should be written: %v", err)
+	}
+	if !strings.Contains(string(data), "\nindex/\n") {
+		t.Errorf(".gitignore should ignore the index:\n%s", data)
+	}
+	if _, err := os.Stat(filepath.Join(zeusPath, "index", "activities.yaml")); err != nil {
+		t.Errorf("index should be written: %v", err)
+	}
+}
+
+func TestLegacyTaskStatusToActivityStatus(t *testing.T) {
+	tests := map[string]ActivityStatus{
+		"pending":     ActivityStatusDraft,
+		"in_progress": ActivityStatusActive,
+		"completed":   ActivityStatusDeprecated,
+		"blocked":     ActivityStatusDraft,
+		"unknown":     ActivityStatusDraft,
+	}
+	for input, want := range tests {
+		if got := legacyTaskStatusToActivityStatus(input); got != want {
+			t.Errorf("legacyTaskStatusToActivityStatus(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
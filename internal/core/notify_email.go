@@ -0,0 +1,102 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/notify"
+)
+
+// smtpPasswordEnv は SMTP パスワードを上書きする環境変数
+const smtpPasswordEnv = "ZEUS_SMTP_PASSWORD"
+
+// emailConfig は EmailSettings を送信設定に変換（パスワードは環境変数を優先）
+func (s EmailSettings) emailConfig() notify.EmailConfig {
+	password := s.Password
+	if env := os.Getenv(smtpPasswordEnv); env != "" {
+		password = env
+	}
+	return notify.EmailConfig{
+		Host:     s.Host,
+		Port:     s.Port,
+		Username: s.Username,
+		Password: password,
+		From:     s.From,
+		To:       s.To,
+	}
+}
+
+// SendEmail は notifications.email の宛先へメールを送信
+func (z *Zeus) SendEmail(ctx context.Context, subject, body string, html bool) error {
+	config, err := z.LoadConfig(ctx)
+	if err != nil {
+		return err
+	}
+	return z.sendEmail(ctx, config.Notifications.Email, emailSubject(config, subject), body, html)
+}
+
+// sendEmail は設定を検証してメールを送信
+func (z *Zeus) sendEmail(ctx context.Context, settings EmailSettings, subject, body string, html bool) error {
+	if settings.Host == "" {
+		return ErrEmailNotConfigured
+	}
+	sender, err := notify.NewEmailSender(settings.emailConfig(), z.emailSend)
+	if err != nil {
+		return fmt.Errorf("invalid notifications.email: %w", err)
+	}
+	return sender.Send(ctx, notify.Email{Subject: subject, Body: body, HTML: html})
+}
+
+// sendApprovalEmail は承認待ちの追加を通知
+func (z *Zeus) sendApprovalEmail(ctx context.Context, config *ZeusConfig, approval *PendingApproval) error {
+	var body strings.Builder
+	fmt.Fprintf(&body, "承認待ちの操作が追加されました。\n\n")
+	fmt.Fprintf(&body, "ID:     %s\n", approval.ID)
+	fmt.Fprintf(&body, "内容:   %s\n", approval.Description)
+	fmt.Fprintf(&body, "レベル: %s\n", approval.Level)
+	if approval.Approver != "" {
+		fmt.Fprintf(&body, "承認者: %s\n", approval.Approver)
+	}
+	if approval.ExpiresAt != "" {
+		fmt.Fprintf(&body, "期限:   %s\n", approval.ExpiresAt)
+	}
+	fmt.Fprintf(&body, "作成:   %s\n\n", approval.CreatedAt)
+	fmt.Fprintf(&body, "承認: zeus approve %s\n", approval.ID)
+	fmt.Fprintf(&body, "却下: zeus reject %s --reason \"...\"\n", approval.ID)
+	if len(approval.Changes) > 0 {
+		fmt.Fprintf(&body, "\n変更されるファイル:\n")
+		for _, c := range approval.Changes {
+			fmt.Fprintf(&body, "  %s %s\n", c.Op, c.Path)
+		}
+		for _, c := range approval.Changes {
+			fmt.Fprintf(&body, "\n%s", c.Diff)
+		}
+	}
+
+	subject := emailSubject(config, "承認待ち: "+approval.Description)
+	return z.sendEmail(ctx, config.Notifications.Email, subject, body.String(), false)
+}
+
+// sendApprovalReminderEmail は滞留している承認待ちのリマインダーを送信
+func (z *Zeus) sendApprovalReminderEmail(ctx context.Context, config *ZeusConfig, stale []PendingApproval, now time.Time) error {
+	var body strings.Builder
+	fmt.Fprintf(&body, "%d 件の承認待ちが処理されていません。\n\n", len(stale))
+	for _, a := range stale {
+		fmt.Fprintf(&body, "%s\n", approvalReminderLine(a, now))
+	}
+	fmt.Fprintf(&body, "\n確認: zeus pending --details\n")
+
+	subject := emailSubject(config, fmt.Sprintf("承認待ちのリマインダー（%d 件）", len(stale)))
+	return z.sendEmail(ctx, config.Notifications.Email, subject, body.String(), false)
+}
+
+// emailSubject は件名にプロジェクト名の接頭辞を付ける
+func emailSubject(config *ZeusConfig, subject string) string {
+	if config != nil && config.Project.Name != "" {
+		return fmt.Sprintf("[Zeus] %s: %s", config.Project.Name, subject)
+	}
+	return "[Zeus] " + subject
+}
@@ -0,0 +1,97 @@
+package core
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/smtp"
+	"strings"
+	"testing"
+)
+
+func TestSendEmail_NotConfigured(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	if err := z.SendEmail(ctx, "subject", "body", false); !errors.Is(err, ErrEmailNotConfigured) {
+		t.Errorf("expected ErrEmailNotConfigured, got %v", err)
+	}
+}
+
+func TestSendEmail_PasswordFromEnv(t *testing.T) {
+	t.Setenv(smtpPasswordEnv, "from-env")
+	config := EmailSettings{Host: "localhost", Username: "zeus", Password: "from-yaml"}.emailConfig()
+	if config.Password != "from-env" {
+		t.Errorf("password = %q, want env override", config.Password)
+	}
+}
+
+func TestAdd_ApprovalEmail(t *testing.T) {
+	var sent []string
+	z := New(t.TempDir(), WithEmailSendFunc(func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		sent = append(sent, string(msg))
+		return nil
+	}))
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	config, _ := z.LoadConfig(ctx)
+	config.Settings.AutomationLevel = "approve"
+	config.Settings.ApprovalMode = "strict"
+	config.Notifications.Email = EmailSettings{
+		Host:            "localhost",
+		From:            "zeus@example.com",
+		To:              []string{"lead@example.com"},
+		NotifyApprovals: true,
+	}
+	if err := z.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+
+	result, err := z.Add(ctx, "objective", "Launch")
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if !result.NeedsApproval {
+		t.Fatal("strict mode should queue the add for approval")
+	}
+	if len(sent) != 1 {
+		t.Fatalf("expected 1 email, got %d", len(sent))
+	}
+
+	parts := strings.SplitN(sent[0], "\r\n\r\n", 2)
+	body, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(parts[1], "\r\n", ""))
+	if err != nil {
+		t.Fatalf("body is not base64: %v", err)
+	}
+	if !strings.Contains(string(body), "zeus approve "+result.ApprovalID) {
+		t.Errorf("email should include approve command:\n%s", body)
+	}
+	if !strings.Contains(string(body), "+title: Launch") {
+		t.Errorf("email should include the diff of the change:\n%s", body)
+	}
+
+	// 承認待ちには追加した場合の差分が記録され、エンティティは追加されない
+	approval, err := z.approvalStore.Get(ctx, result.ApprovalID)
+	if err != nil {
+		t.Fatalf("Get approval failed: %v", err)
+	}
+	if len(approval.Changes) == 0 || approval.Changes[0].Op != ChangeOpCreate || !strings.HasPrefix(approval.Changes[0].Path, "objectives/") {
+		t.Errorf("approval should include changes: %+v", approval.Changes)
+	}
+	if files, _ := z.fileStore.Glob(ctx, "objectives/*.yaml"); len(files) != 0 {
+		t.Errorf("objective should not be added before approval: %v", files)
+	}
+
+	// 送信に失敗しても承認待ちへの追加は成功する
+	z.emailSend = func(string, smtp.Auth, string, []string, []byte) error {
+		return errors.New("connection refused")
+	}
+	if result, err := z.Add(ctx, "objective", "Grow"); err != nil || !result.NeedsApproval {
+		t.Errorf("Add should succeed even if email fails: result=%+v err=%v", result, err)
+	}
+}
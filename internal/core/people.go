@@ -0,0 +1,265 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// peopleFile はメンバー名簿のパス（.zeus からの相対パス）
+const peopleFile = "config/people.yaml"
+
+// personIDPattern はメンバー ID の形式（英小文字・数字・-・_）
+var personIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// Person は名簿のメンバー
+type Person struct {
+	ID             string   `yaml:"id"`
+	Name           string   `yaml:"name"`
+	Roles          []string `yaml:"roles,omitempty"`
+	WeeklyCapacity float64  `yaml:"weekly_capacity,omitempty"` // 週あたりの稼働時間
+}
+
+// Team はメンバーのグループ（オーナーとしてチームを指定できる）
+type Team struct {
+	ID      string   `yaml:"id"`
+	Name    string   `yaml:"name"`
+	Members []string `yaml:"members,omitempty"` // メンバー ID
+}
+
+// PeopleDirectory はメンバー名簿（.zeus/config/people.yaml）
+type PeopleDirectory struct {
+	People []Person `yaml:"people"`
+	Teams  []Team   `yaml:"teams,omitempty"`
+}
+
+// Validate は名簿の妥当性を検証
+// メンバーとチームの ID・表示名は大文字小文字を区別せず全体で一意、チームのメンバーは名簿に存在する必要がある
+func (d *PeopleDirectory) Validate() error {
+	ids := map[string]bool{}
+	seen := map[string]string{}
+	register := func(kind, id, name string) error {
+		if !personIDPattern.MatchString(id) {
+			return fmt.Errorf("invalid %s id %q (lowercase letters, digits, - and _)", kind, id)
+		}
+		if ids[id] {
+			return fmt.Errorf("duplicate %s id %s", kind, id)
+		}
+		ids[id] = true
+		for _, key := range []string{id, name} {
+			if key == "" {
+				continue
+			}
+			k := strings.ToLower(key)
+			if other, ok := seen[k]; ok && other != id {
+				return fmt.Errorf("%s %s: %q is already used by %s", kind, id, key, other)
+			}
+			seen[k] = id
+		}
+		return nil
+	}
+
+	people := map[string]bool{}
+	for _, p := range d.People {
+		if err := register("person", p.ID, p.Name); err != nil {
+			return err
+		}
+		if p.WeeklyCapacity < 0 {
+			return fmt.Errorf("person %s: weekly_capacity must not be negative", p.ID)
+		}
+		people[p.ID] = true
+	}
+	for _, t := range d.Teams {
+		if err := register("team", t.ID, t.Name); err != nil {
+			return err
+		}
+		for _, m := range t.Members {
+			if !people[m] {
+				return fmt.Errorf("team %s: %w: %s", t.ID, ErrUnknownPerson, m)
+			}
+		}
+	}
+	return nil
+}
+
+// TeamCapacity はチームメンバーの週あたり稼働時間の合計を返す
+func (d *PeopleDirectory) TeamCapacity(team Team) float64 {
+	capacity := map[string]float64{}
+	for _, p := range d.People {
+		capacity[p.ID] = p.WeeklyCapacity
+	}
+	total := 0.0
+	for _, m := range team.Members {
+		total += capacity[m]
+	}
+	return total
+}
+
+// Resolve はメンバー・チーム名（ID または表示名、大文字小文字を区別しない）を正規の ID に解決
+// 見つからない場合は ErrUnknownPerson を返し、近い候補があればメッセージに含める
+func (d *PeopleDirectory) Resolve(name string) (string, error) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	for _, e := range d.entries() {
+		if strings.ToLower(e.id) == key || (e.name != "" && strings.ToLower(e.name) == key) {
+			return e.id, nil
+		}
+	}
+	if suggestions := d.Suggest(name); len(suggestions) > 0 {
+		return "", fmt.Errorf("%w: %s (did you mean: %s?)", ErrUnknownPerson, name, strings.Join(suggestions, ", "))
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnknownPerson, name)
+}
+
+// directoryEntry はオーナーとして指定できる名簿の項目（メンバーまたはチーム）
+type directoryEntry struct {
+	id   string
+	name string
+}
+
+// entries はメンバーとチームを名簿順に返す
+func (d *PeopleDirectory) entries() []directoryEntry {
+	entries := make([]directoryEntry, 0, len(d.People)+len(d.Teams))
+	for _, p := range d.People {
+		entries = append(entries, directoryEntry{id: p.ID, name: p.Name})
+	}
+	for _, t := range d.Teams {
+		entries = append(entries, directoryEntry{id: t.ID, name: t.Name})
+	}
+	return entries
+}
+
+// Suggest は名前に近いメンバー・チームの ID を近い順に返す（編集距離 2 以内、または前方一致）
+func (d *PeopleDirectory) Suggest(name string) []string {
+	key := strings.ToLower(strings.TrimSpace(name))
+	if key == "" {
+		return nil
+	}
+	distances := map[string]int{}
+	for _, e := range d.entries() {
+		best := -1
+		for _, candidate := range []string{e.id, e.name} {
+			c := strings.ToLower(candidate)
+			if c == "" {
+				continue
+			}
+			dist := editDistance(key, c)
+			if strings.HasPrefix(c, key) || strings.HasPrefix(key, c) {
+				dist = min(dist, 1)
+			}
+			if best < 0 || dist < best {
+				best = dist
+			}
+		}
+		if best >= 0 && best <= 2 {
+			distances[e.id] = best
+		}
+	}
+
+	ids := make([]string, 0, len(distances))
+	for id := range distances {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if distances[ids[i]] != distances[ids[j]] {
+			return distances[ids[i]] < distances[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	return ids
+}
+
+// editDistance はレーベンシュタイン距離を返す
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// LoadPeople はメンバー名簿を読み込む（未作成の場合は nil を返す）
+func (z *Zeus) LoadPeople(ctx context.Context) (*PeopleDirectory, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if !z.fileStore.Exists(ctx, peopleFile) {
+		return nil, nil
+	}
+	var dir PeopleDirectory
+	if err := z.fileStore.ReadYaml(ctx, peopleFile, &dir); err != nil {
+		return nil, fmt.Errorf("people.yaml の読み込みに失敗: %w", err)
+	}
+	if err := dir.Validate(); err != nil {
+		return nil, fmt.Errorf("people.yaml: %w", err)
+	}
+	return &dir, nil
+}
+
+// ResolvePerson はオーナー・担当者の指定を名簿のメンバーまたはチームの ID に正規化
+// 名簿が未作成の場合は入力をそのまま返す（名簿の利用は任意）
+func (z *Zeus) ResolvePerson(ctx context.Context, name string) (string, error) {
+	dir, err := z.LoadPeople(ctx)
+	if err != nil {
+		return "", err
+	}
+	if dir == nil || name == "" {
+		return name, nil
+	}
+	return dir.Resolve(name)
+}
+
+// AddPerson はメンバーを名簿に追加（名簿が未作成の場合は作成）
+func (z *Zeus) AddPerson(ctx context.Context, person Person) error {
+	dir, err := z.LoadPeople(ctx)
+	if err != nil {
+		return err
+	}
+	if dir == nil {
+		dir = &PeopleDirectory{}
+	}
+	dir.People = append(dir.People, person)
+	if err := dir.Validate(); err != nil {
+		return err
+	}
+	return z.fileStore.WriteYaml(ctx, peopleFile, dir)
+}
+
+// AddTeam はチームを名簿に追加（メンバーは ID または表示名で指定し、正規の ID に変換する）
+func (z *Zeus) AddTeam(ctx context.Context, team Team) error {
+	dir, err := z.LoadPeople(ctx)
+	if err != nil {
+		return err
+	}
+	if dir == nil {
+		dir = &PeopleDirectory{}
+	}
+	members := make([]string, 0, len(team.Members))
+	for _, m := range team.Members {
+		id, err := dir.Resolve(m)
+		if err != nil {
+			return err
+		}
+		members = append(members, id)
+	}
+	team.Members = members
+	dir.Teams = append(dir.Teams, team)
+	if err := dir.Validate(); err != nil {
+		return err
+	}
+	return z.fileStore.WriteYaml(ctx, peopleFile, dir)
+}
@@ -0,0 +1,197 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPeopleDirectory_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		people  []Person
+		wantErr bool
+	}{
+		{"valid", []Person{{ID: "alice", Name: "Alice"}, {ID: "bob", Name: "bob"}}, false},
+		{"invalid id", []Person{{ID: "Alice"}}, true},
+		{"duplicate id", []Person{{ID: "alice"}, {ID: "alice"}}, true},
+		{"name collides with id", []Person{{ID: "alice"}, {ID: "al", Name: "ALICE"}}, true},
+		{"negative capacity", []Person{{ID: "alice", WeeklyCapacity: -1}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := &PeopleDirectory{People: tt.people}
+			if err := dir.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPeopleDirectory_Resolve(t *testing.T) {
+	dir := &PeopleDirectory{People: []Person{
+		{ID: "alice", Name: "Alice Smith"},
+		{ID: "alan", Name: "Alan Turing"},
+		{ID: "bob", Name: "Bob"},
+	}}
+
+	for input, want := range map[string]string{
+		"alice":       "alice",
+		"Alice":       "alice",
+		"alice smith": "alice",
+		" BOB ":       "bob",
+	} {
+		got, err := dir.Resolve(input)
+		if err != nil || got != want {
+			t.Errorf("Resolve(%q) = %q, %v; want %q", input, got, err, want)
+		}
+	}
+
+	_, err := dir.Resolve("alce")
+	if !errors.Is(err, ErrUnknownPerson) {
+		t.Fatalf("expected ErrUnknownPerson, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "did you mean: alice") {
+		t.Errorf("expected suggestion in error, got %v", err)
+	}
+
+	_, err = dir.Resolve("zed")
+	if !errors.Is(err, ErrUnknownPerson) || strings.Contains(err.Error(), "did you mean") {
+		t.Errorf("expected error without suggestions, got %v", err)
+	}
+}
+
+func TestPeopleDirectory_Suggest(t *testing.T) {
+	dir := &PeopleDirectory{People: []Person{
+		{ID: "alice"},
+		{ID: "alan"},
+		{ID: "carol"},
+	}}
+	if got := dir.Suggest("ali"); !reflect.DeepEqual(got, []string{"alice", "alan"}) {
+		t.Errorf("Suggest(ali) = %v", got)
+	}
+	if got := dir.Suggest(""); got != nil {
+		t.Errorf("Suggest(\"\") = %v, want nil", got)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"alice", "alice", 0},
+		{"alice", "alce", 1},
+		{"kitten", "sitting", 3},
+		{"田中", "田仲", 1},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestZeus_People(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	// 名簿がない場合はそのまま受け付ける
+	dir, err := z.LoadPeople(ctx)
+	if err != nil || dir != nil {
+		t.Fatalf("LoadPeople without directory = %v, %v", dir, err)
+	}
+	if got, err := z.ResolvePerson(ctx, "Anyone"); err != nil || got != "Anyone" {
+		t.Errorf("ResolvePerson without directory = %q, %v", got, err)
+	}
+
+	if err := z.AddPerson(ctx, Person{ID: "alice", Name: "Alice", Roles: []string{"developer"}, WeeklyCapacity: 32}); err != nil {
+		t.Fatalf("AddPerson failed: %v", err)
+	}
+	if err := z.AddPerson(ctx, Person{ID: "alice"}); err == nil {
+		t.Error("expected error for duplicate person")
+	}
+
+	dir, err = z.LoadPeople(ctx)
+	if err != nil || dir == nil || len(dir.People) != 1 {
+		t.Fatalf("LoadPeople = %+v, %v", dir, err)
+	}
+	if dir.People[0].WeeklyCapacity != 32 || !reflect.DeepEqual(dir.People[0].Roles, []string{"developer"}) {
+		t.Errorf("unexpected person: %+v", dir.People[0])
+	}
+
+	if got, err := z.ResolvePerson(ctx, "ALICE"); err != nil || got != "alice" {
+		t.Errorf("ResolvePerson(ALICE) = %q, %v", got, err)
+	}
+	if got, err := z.ResolvePerson(ctx, ""); err != nil || got != "" {
+		t.Errorf("ResolvePerson(\"\") = %q, %v", got, err)
+	}
+	if _, err := z.ResolvePerson(ctx, "alise"); !errors.Is(err, ErrUnknownPerson) {
+		t.Errorf("expected ErrUnknownPerson, got %v", err)
+	}
+}
+
+func TestPeopleDirectory_Teams(t *testing.T) {
+	dir := &PeopleDirectory{
+		People: []Person{{ID: "alice", WeeklyCapacity: 32}, {ID: "bob", WeeklyCapacity: 20}},
+		Teams:  []Team{{ID: "platform", Name: "Platform Team", Members: []string{"alice", "bob"}}},
+	}
+	if err := dir.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if got := dir.TeamCapacity(dir.Teams[0]); got != 52 {
+		t.Errorf("TeamCapacity = %v, want 52", got)
+	}
+	if got, err := dir.Resolve("platform team"); err != nil || got != "platform" {
+		t.Errorf("Resolve(platform team) = %q, %v", got, err)
+	}
+	if got := dir.Suggest("platfrom"); !reflect.DeepEqual(got, []string{"platform"}) {
+		t.Errorf("Suggest(platfrom) = %v", got)
+	}
+
+	invalid := []*PeopleDirectory{
+		{People: []Person{{ID: "alice"}}, Teams: []Team{{ID: "alice"}}},
+		{People: []Person{{ID: "alice"}}, Teams: []Team{{ID: "core", Members: []string{"carol"}}}},
+		{Teams: []Team{{ID: "core", Name: "x"}, {ID: "web", Name: "X"}}},
+	}
+	for i, d := range invalid {
+		if err := d.Validate(); err == nil {
+			t.Errorf("case %d: expected validation error", i)
+		}
+	}
+}
+
+func TestZeus_AddTeam(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	if err := z.AddPerson(ctx, Person{ID: "alice", Name: "Alice"}); err != nil {
+		t.Fatalf("AddPerson failed: %v", err)
+	}
+
+	if err := z.AddTeam(ctx, Team{ID: "core", Members: []string{"ALICE"}}); err != nil {
+		t.Fatalf("AddTeam failed: %v", err)
+	}
+	if err := z.AddTeam(ctx, Team{ID: "web", Members: []string{"alise"}}); !errors.Is(err, ErrUnknownPerson) {
+		t.Errorf("expected ErrUnknownPerson, got %v", err)
+	}
+
+	dir, err := z.LoadPeople(ctx)
+	if err != nil {
+		t.Fatalf("LoadPeople failed: %v", err)
+	}
+	if len(dir.Teams) != 1 || !reflect.DeepEqual(dir.Teams[0].Members, []string{"alice"}) {
+		t.Errorf("unexpected teams: %+v", dir.Teams)
+	}
+	if got, err := z.ResolvePerson(ctx, "core"); err != nil || got != "core" {
+		t.Errorf("ResolvePerson(core) = %q, %v", got, err)
+	}
+}
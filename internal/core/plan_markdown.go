@@ -0,0 +1,415 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// planUnassignedMarker は UseCase 未紐付けの Activity を並べる見出しの目印
+const planUnassignedMarker = "zeus:unassigned"
+
+// planNewRef は dry-run で新規作成されるエンティティの表示（ID は実行時に採番される）
+const planNewRef = "(new)"
+
+var (
+	planHeadingPattern = regexp.MustCompile(`^(#{1,3})\s+(.*?)\s*(?:<!--\s*(\S+)\s*-->)?\s*$`)
+	planItemPattern    = regexp.MustCompile(`^\s*[-*+]\s+(?:\[([ xX])\]\s+)?(.*?)\s*(?:<!--\s*(\S+)\s*-->)?\s*$`)
+)
+
+// PlanChange は Markdown 計画書の取り込みで行う変更
+type PlanChange struct {
+	Action     string `json:"action"` // create | update | queued（承認待ち）| skip（親が承認待ち）
+	EntityType string `json:"entity_type"`
+	ID         string `json:"id,omitempty"` // dry-run での新規作成は空
+	Title      string `json:"title"`
+	Detail     string `json:"detail,omitempty"`
+}
+
+// PlanSyncResult は Markdown 計画書の取り込み結果
+type PlanSyncResult struct {
+	Changes   []PlanChange `json:"changes"`
+	Unchanged int          `json:"unchanged"`
+	DryRun    bool         `json:"dry_run"`
+	Files     []FileChange `json:"files,omitempty"` // dry-run 時に変更されるはずのファイル
+}
+
+// ExportPlanMarkdown は Objective > UseCase > Activity の階層を編集可能な Markdown として出力
+// 各行の末尾に ID をコメントで埋め込み、ImportPlanMarkdown で差分を取り込めるようにする
+func (z *Zeus) ExportPlanMarkdown(ctx context.Context) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	projectName := "Zeus Project"
+	if config, err := z.LoadConfig(ctx); err == nil && config.Project.Name != "" {
+		projectName = config.Project.Name
+	}
+
+	var objectives []ObjectiveEntity
+	z.forEachYaml(ctx, "objectives", func(path string) {
+		var o ObjectiveEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &o); err == nil {
+			objectives = append(objectives, o)
+		}
+	})
+	usecases := map[string][]UseCaseEntity{}
+	z.forEachYaml(ctx, "usecases", func(path string) {
+		var u UseCaseEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &u); err == nil {
+			usecases[u.ObjectiveID] = append(usecases[u.ObjectiveID], u)
+		}
+	})
+	activities := map[string][]ActivityEntity{}
+	z.forEachYaml(ctx, "activities", func(path string) {
+		var a ActivityEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &a); err == nil {
+			activities[a.UseCaseID] = append(activities[a.UseCaseID], a)
+		}
+	})
+
+	sort.SliceStable(objectives, func(i, j int) bool {
+		return planLess(objectives[i].Metadata.CreatedAt, objectives[i].ID, objectives[j].Metadata.CreatedAt, objectives[j].ID)
+	})
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# %s\n\n", projectName)
+	sb.WriteString("<!-- ## は Objective、### は UseCase、リスト項目は Activity（[x] は完了）。ID コメントのない行は取り込み時に新規作成されます。 -->\n")
+
+	writeActivities := func(list []ActivityEntity) {
+		sort.SliceStable(list, func(i, j int) bool {
+			return planLess(list[i].Metadata.CreatedAt, list[i].ID, list[j].Metadata.CreatedAt, list[j].ID)
+		})
+		if len(list) > 0 {
+			sb.WriteString("\n")
+		}
+		for _, a := range list {
+			check := " "
+			if a.Status == ActivityStatusDeprecated {
+				check = "x"
+			}
+			fmt.Fprintf(&sb, "- [%s] %s <!-- %s -->\n", check, a.Title, a.ID)
+		}
+	}
+
+	for _, o := range objectives {
+		fmt.Fprintf(&sb, "\n## %s <!-- %s -->\n", o.Title, o.ID)
+		list := usecases[o.ID]
+		sort.SliceStable(list, func(i, j int) bool {
+			return planLess(list[i].Metadata.CreatedAt, list[i].ID, list[j].Metadata.CreatedAt, list[j].ID)
+		})
+		for _, u := range list {
+			fmt.Fprintf(&sb, "\n### %s <!-- %s -->\n", u.Title, u.ID)
+			writeActivities(activities[u.ID])
+		}
+	}
+
+	if unassigned := activities[""]; len(unassigned) > 0 {
+		fmt.Fprintf(&sb, "\n## Unassigned <!-- %s -->\n", planUnassignedMarker)
+		writeActivities(unassigned)
+	}
+
+	return sb.String(), nil
+}
+
+// planLess は作成日時、ID の順で比較
+func planLess(createdA, idA, createdB, idB string) bool {
+	if createdA != createdB {
+		return createdA < createdB
+	}
+	return idA < idB
+}
+
+// ImportPlanMarkdown は ExportPlanMarkdown 形式の Markdown を解析し、差分をエンティティに反映
+// ID 付きの行はタイトル・親・完了状態の変更を反映し、ID のない行は新規作成する。
+// 計画書に含まれないエンティティは変更しない（削除は行わない）。
+// 変更は 1 トランザクションで反映し、途中の行でエラーになった場合は何も変更しない。
+// 各変更は承認レベル・エージェントのガードレールを適用し、承認が必要な変更は承認待ちキューに追加する
+// （承認待ちの見出しの下の行は親が作成されるまで反映しない）
+func (z *Zeus) ImportPlanMarkdown(ctx context.Context, data []byte, dryRun bool) (*PlanSyncResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var result *PlanSyncResult
+	apply := func(tx *Zeus) error {
+		var err error
+		result, err = tx.importPlanMarkdown(ctx, data)
+		return err
+	}
+	if !dryRun {
+		if err := z.Transaction(ctx, apply); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	// dry-run: 書き込みをステージして変更されるファイルを求め、仮の ID は表示しない
+	files, err := z.DryRun(ctx, apply)
+	if err != nil {
+		return nil, err
+	}
+	var created []string
+	for _, c := range result.Changes {
+		if c.Action == "create" {
+			created = append(created, c.ID)
+		}
+	}
+	for i := range result.Changes {
+		if result.Changes[i].Action == "create" {
+			result.Changes[i].ID = ""
+		}
+		for _, id := range created {
+			result.Changes[i].Detail = strings.ReplaceAll(result.Changes[i].Detail, id, planNewRef)
+		}
+	}
+	result.DryRun = true
+	result.Files = files
+	return result, nil
+}
+
+// importPlanMarkdown は ImportPlanMarkdown の解析・反映処理
+func (z *Zeus) importPlanMarkdown(ctx context.Context, data []byte) (*PlanSyncResult, error) {
+	objHandler, ok := z.entityRegistry.Get("objective")
+	if !ok {
+		return nil, fmt.Errorf("objective handler not found")
+	}
+	ucHandler, ok := z.entityRegistry.Get("usecase")
+	if !ok {
+		return nil, fmt.Errorf("usecase handler not found")
+	}
+	actHandler, ok := z.entityRegistry.Get("activity")
+	if !ok {
+		return nil, fmt.Errorf("activity handler not found")
+	}
+
+	result := &PlanSyncResult{Changes: []PlanChange{}}
+	record := func(change PlanChange) {
+		result.Changes = append(result.Changes, change)
+	}
+
+	// 現在の親（awaiting は親の作成が承認待ち）
+	objectiveID, usecaseID := "", ""
+	inObjective, inUseCase, unassigned, awaiting := false, false, false, false
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+
+		if m := planHeadingPattern.FindStringSubmatch(text); m != nil {
+			level, title, id := len(m[1]), m[2], m[3]
+			switch level {
+			case 1:
+				// 文書タイトル
+				continue
+			case 2:
+				inUseCase, usecaseID, awaiting = false, "", false
+				if id == planUnassignedMarker {
+					inObjective, unassigned, objectiveID = false, true, ""
+					continue
+				}
+				inObjective, unassigned = true, false
+				newID, err := z.syncPlanObjective(ctx, objHandler, id, title, record, &result.Unchanged)
+				if err != nil {
+					return result, fmt.Errorf("line %d: %w", line, err)
+				}
+				objectiveID, awaiting = newID, newID == ""
+			case 3:
+				if !inObjective {
+					return result, fmt.Errorf("line %d: use case %q must be under an objective heading", line, title)
+				}
+				inUseCase = true
+				if objectiveID == "" {
+					record(PlanChange{Action: "skip", EntityType: "usecase", ID: id, Title: title, Detail: "objective awaiting approval"})
+					continue
+				}
+				newID, err := z.syncPlanUseCase(ctx, ucHandler, id, title, objectiveID, record, &result.Unchanged)
+				if err != nil {
+					return result, fmt.Errorf("line %d: %w", line, err)
+				}
+				usecaseID, awaiting = newID, newID == ""
+			}
+			continue
+		}
+
+		m := planItemPattern.FindStringSubmatch(text)
+		if m == nil || strings.TrimSpace(m[2]) == "" {
+			continue
+		}
+		if !inUseCase && !unassigned {
+			return result, fmt.Errorf("line %d: activity %q must be under a use case heading or the Unassigned section", line, m[2])
+		}
+		if awaiting {
+			record(PlanChange{Action: "skip", EntityType: "activity", ID: m[3], Title: m[2], Detail: "parent awaiting approval"})
+			continue
+		}
+		done := strings.EqualFold(m[1], "x")
+		if err := z.syncPlanActivity(ctx, actHandler, m[3], m[2], usecaseID, done, record, &result.Unchanged); err != nil {
+			return result, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return result, fmt.Errorf("failed to read plan: %w", err)
+	}
+
+	if len(result.Changes) > 0 {
+		if err := z.updateState(ctx); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// syncPlanObjective は Objective 見出しを反映し、Objective ID を返す
+// 作成が承認待ちになった場合は空の ID を返す
+func (z *Zeus) syncPlanObjective(ctx context.Context, handler EntityHandler, id, title string, record func(PlanChange), unchanged *int) (string, error) {
+	if id == "" {
+		change := PlanChange{Action: "create", EntityType: "objective", Title: title}
+		added, err := z.addGated(ctx, "objective", title)
+		if queued, err := planQueued(err, change, record); queued || err != nil {
+			return "", err
+		}
+		change.ID = added.ID
+		record(change)
+		return added.ID, nil
+	}
+
+	existing, err := handler.Get(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("objective %s: %w", id, err)
+	}
+	obj := existing.(*ObjectiveEntity)
+	if obj.Title == title {
+		*unchanged++
+		return id, nil
+	}
+	change := PlanChange{Action: "update", EntityType: "objective", ID: id, Title: title, Detail: fmt.Sprintf("title: %q -> %q", obj.Title, title)}
+	obj.Title = title
+	if queued, err := planQueued(z.updateGated(ctx, "objective", id, obj), change, record); queued || err != nil {
+		return id, err
+	}
+	record(change)
+	return id, nil
+}
+
+// syncPlanUseCase は UseCase 見出しを反映し、UseCase ID を返す（作成が承認待ちになった場合は空）
+func (z *Zeus) syncPlanUseCase(ctx context.Context, handler EntityHandler, id, title, objectiveID string, record func(PlanChange), unchanged *int) (string, error) {
+	if id == "" {
+		change := PlanChange{Action: "create", EntityType: "usecase", Title: title}
+		added, err := z.addGated(ctx, "usecase", title, WithUseCaseObjective(objectiveID))
+		if queued, err := planQueued(err, change, record); queued || err != nil {
+			return "", err
+		}
+		change.ID = added.ID
+		record(change)
+		return added.ID, nil
+	}
+
+	existing, err := handler.Get(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("usecase %s: %w", id, err)
+	}
+	uc := existing.(*UseCaseEntity)
+	update := map[string]any{}
+	var details []string
+	if uc.Title != title {
+		update["title"] = title
+		details = append(details, fmt.Sprintf("title: %q -> %q", uc.Title, title))
+	}
+	if uc.ObjectiveID != objectiveID {
+		update["objective_id"] = objectiveID
+		details = append(details, fmt.Sprintf("objective: %s -> %s", uc.ObjectiveID, objectiveID))
+	}
+	if len(details) == 0 {
+		*unchanged++
+		return id, nil
+	}
+	change := PlanChange{Action: "update", EntityType: "usecase", ID: id, Title: title, Detail: strings.Join(details, ", ")}
+	if queued, err := planQueued(z.updateGated(ctx, "usecase", id, update), change, record); queued || err != nil {
+		return id, err
+	}
+	record(change)
+	return id, nil
+}
+
+// syncPlanActivity は Activity のリスト項目を反映
+func (z *Zeus) syncPlanActivity(ctx context.Context, handler EntityHandler, id, title, usecaseID string, done bool, record func(PlanChange), unchanged *int) error {
+	status := ActivityStatusDraft
+	if done {
+		status = ActivityStatusDeprecated
+	}
+
+	if id == "" {
+		opts := []EntityOption{WithActivityStatus(status)}
+		if usecaseID != "" {
+			opts = append(opts, WithActivityUseCase(usecaseID))
+		}
+		change := PlanChange{Action: "create", EntityType: "activity", Title: title}
+		added, err := z.addGated(ctx, "activity", title, opts...)
+		if queued, err := planQueued(err, change, record); queued || err != nil {
+			return err
+		}
+		change.ID = added.ID
+		record(change)
+		return nil
+	}
+
+	existing, err := handler.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("activity %s: %w", id, err)
+	}
+	act := existing.(*ActivityEntity)
+	update := map[string]any{}
+	var details []string
+	if act.Title != title {
+		update["title"] = title
+		details = append(details, fmt.Sprintf("title: %q -> %q", act.Title, title))
+	}
+	if act.UseCaseID != usecaseID {
+		update["usecase_id"] = usecaseID
+		details = append(details, fmt.Sprintf("usecase: %s -> %s", planRef(act.UseCaseID), planRef(usecaseID)))
+	}
+	// 完了状態は draft/active と deprecated の切り替えのみ（active は未完了のまま維持）
+	if done != (act.Status == ActivityStatusDeprecated) {
+		update["status"] = string(status)
+		details = append(details, fmt.Sprintf("status: %s -> %s", act.Status, status))
+	}
+	if len(details) == 0 {
+		*unchanged++
+		return nil
+	}
+	change := PlanChange{Action: "update", EntityType: "activity", ID: id, Title: title, Detail: strings.Join(details, ", ")}
+	if queued, err := planQueued(z.updateGated(ctx, "activity", id, update), change, record); queued || err != nil {
+		return err
+	}
+	record(change)
+	return nil
+}
+
+// planQueued は err が承認待ちキューへの追加を示す場合に change を queued として記録し、true を返す
+// （承認待ちはエラーとせず、トランザクションをコミットしてキューへの追加を残す）
+func planQueued(err error, change PlanChange, record func(PlanChange)) (bool, error) {
+	var required *ApprovalRequiredError
+	if !errors.As(err, &required) {
+		return false, err
+	}
+	detail := "approval " + required.ApprovalID
+	if change.Detail != "" {
+		detail = change.Detail + " (" + detail + ")"
+	}
+	change.Action, change.Detail = "queued", detail
+	record(change)
+	return true, nil
+}
+
+// planRef は差分表示用に空の参照を (none) と表示
+func planRef(id string) string {
+	if id == "" {
+		return "(none)"
+	}
+	return id
+}
@@ -0,0 +1,228 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func setupPlanZeus(t *testing.T) (*Zeus, context.Context, string, string, string) {
+	t.Helper()
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	obj, err := z.Add(ctx, "objective", "Launch")
+	if err != nil {
+		t.Fatalf("Add objective failed: %v", err)
+	}
+	uc, err := z.Add(ctx, "usecase", "Sign up", WithUseCaseObjective(obj.ID))
+	if err != nil {
+		t.Fatalf("Add usecase failed: %v", err)
+	}
+	act, err := z.Add(ctx, "activity", "Build form", WithActivityUseCase(uc.ID))
+	if err != nil {
+		t.Fatalf("Add activity failed: %v", err)
+	}
+	return z, ctx, obj.ID, uc.ID, act.ID
+}
+
+func TestExportPlanMarkdown(t *testing.T) {
+	z, ctx, objID, ucID, actID := setupPlanZeus(t)
+	orphan, err := z.Add(ctx, "activity", "Write docs", WithActivityStatus(ActivityStatusDeprecated))
+	if err != nil {
+		t.Fatalf("Add activity failed: %v", err)
+	}
+
+	out, err := z.ExportPlanMarkdown(ctx)
+	if err != nil {
+		t.Fatalf("ExportPlanMarkdown failed: %v", err)
+	}
+	for _, want := range []string{
+		"# New Zeus Project",
+		"## Launch <!-- " + objID + " -->",
+		"### Sign up <!-- " + ucID + " -->",
+		"- [ ] Build form <!-- " + actID + " -->",
+		"## Unassigned <!-- zeus:unassigned -->",
+		"- [x] Write docs <!-- " + orphan.ID + " -->",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("export should contain %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestImportPlanMarkdown_RoundTrip(t *testing.T) {
+	z, ctx, _, _, _ := setupPlanZeus(t)
+
+	out, err := z.ExportPlanMarkdown(ctx)
+	if err != nil {
+		t.Fatalf("ExportPlanMarkdown failed: %v", err)
+	}
+	result, err := z.ImportPlanMarkdown(ctx, []byte(out), false)
+	if err != nil {
+		t.Fatalf("ImportPlanMarkdown failed: %v", err)
+	}
+	if len(result.Changes) != 0 || result.Unchanged != 3 {
+		t.Errorf("unedited plan should not change anything: %+v", result)
+	}
+}
+
+func TestImportPlanMarkdown_Edits(t *testing.T) {
+	z, ctx, objID, ucID, actID := setupPlanZeus(t)
+
+	plan := "# Plan\n\n" +
+		"## Launch v2 <!-- " + objID + " -->\n\n" +
+		"### Sign up <!-- " + ucID + " -->\n\n" +
+		"- [x] Build signup form <!-- " + actID + " -->\n" +
+		"- [ ] Add captcha\n\n" +
+		"## Growth\n\n" +
+		"### Referral\n\n" +
+		"- [ ] Invite links\n\n" +
+		"## Unassigned <!-- zeus:unassigned -->\n\n" +
+		"- [ ] Triage backlog\n"
+
+	// dry-run では書き込まない
+	preview, err := z.ImportPlanMarkdown(ctx, []byte(plan), true)
+	if err != nil {
+		t.Fatalf("ImportPlanMarkdown dry-run failed: %v", err)
+	}
+	if len(preview.Changes) != 7 || !preview.DryRun {
+		t.Fatalf("unexpected dry-run result: %+v", preview)
+	}
+	if len(preview.Files) == 0 {
+		t.Error("dry-run should report files that would change")
+	}
+	obj, _ := z.entityRegistry.Get("objective")
+	if o, _ := obj.Get(ctx, objID); o.(*ObjectiveEntity).Title != "Launch" {
+		t.Error("dry-run should not update objective")
+	}
+
+	result, err := z.ImportPlanMarkdown(ctx, []byte(plan), false)
+	if err != nil {
+		t.Fatalf("ImportPlanMarkdown failed: %v", err)
+	}
+	if len(result.Changes) != 7 || result.Unchanged != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	if o, _ := obj.Get(ctx, objID); o.(*ObjectiveEntity).Title != "Launch v2" {
+		t.Error("objective title should be updated")
+	}
+	actHandler, _ := z.entityRegistry.Get("activity")
+	a, err := actHandler.Get(ctx, actID)
+	if err != nil {
+		t.Fatalf("Get activity failed: %v", err)
+	}
+	act := a.(*ActivityEntity)
+	if act.Title != "Build signup form" || act.Status != ActivityStatusDeprecated {
+		t.Errorf("activity should be renamed and completed: %+v", act)
+	}
+
+	out, err := z.ExportPlanMarkdown(ctx)
+	if err != nil {
+		t.Fatalf("ExportPlanMarkdown failed: %v", err)
+	}
+	for _, want := range []string{"- [ ] Add captcha <!-- act-", "## Growth <!-- obj-", "### Referral <!-- uc-", "- [ ] Invite links <!-- act-", "- [ ] Triage backlog <!-- act-"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("re-export should contain %q:\n%s", want, out)
+		}
+	}
+	if strings.Index(out, "Invite links") < strings.Index(out, "### Referral") {
+		t.Errorf("new activity should be under the new use case:\n%s", out)
+	}
+}
+
+func TestImportPlanMarkdown_MoveActivity(t *testing.T) {
+	z, ctx, objID, ucID, actID := setupPlanZeus(t)
+	other, err := z.Add(ctx, "usecase", "Sign in", WithUseCaseObjective(objID))
+	if err != nil {
+		t.Fatalf("Add usecase failed: %v", err)
+	}
+
+	plan := "## Launch <!-- " + objID + " -->\n" +
+		"### Sign up <!-- " + ucID + " -->\n" +
+		"### Sign in <!-- " + other.ID + " -->\n" +
+		"- [ ] Build form <!-- " + actID + " -->\n"
+	result, err := z.ImportPlanMarkdown(ctx, []byte(plan), false)
+	if err != nil {
+		t.Fatalf("ImportPlanMarkdown failed: %v", err)
+	}
+	if len(result.Changes) != 1 || !strings.Contains(result.Changes[0].Detail, other.ID) {
+		t.Fatalf("unexpected changes: %+v", result.Changes)
+	}
+	actHandler, _ := z.entityRegistry.Get("activity")
+	a, _ := actHandler.Get(ctx, actID)
+	if a.(*ActivityEntity).UseCaseID != other.ID {
+		t.Errorf("activity should be moved to %s", other.ID)
+	}
+}
+
+func TestImportPlanMarkdown_Errors(t *testing.T) {
+	z, ctx, objID, _, _ := setupPlanZeus(t)
+
+	tests := map[string]string{
+		"activity under objective":   "## Launch <!-- " + objID + " -->\n- [ ] Orphan\n",
+		"use case without objective": "### Lonely\n",
+		"unknown id":                 "## Ghost <!-- obj-00000000 -->\n",
+	}
+	for name, plan := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := z.ImportPlanMarkdown(ctx, []byte(plan), true); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestImportPlanMarkdown_ProtectedFromAgent(t *testing.T) {
+	agent, human := setupAgentPolicy(t, AgentPolicy{Protected: []string{"usecase"}})
+	ctx := context.Background()
+	obj, err := human.Add(ctx, "objective", "Launch")
+	if err != nil {
+		t.Fatalf("Add objective failed: %v", err)
+	}
+	uc, err := human.Add(ctx, "usecase", "Sign up", WithUseCaseObjective(obj.ID))
+	if err != nil {
+		t.Fatalf("Add usecase failed: %v", err)
+	}
+
+	plan := "## Launch <!-- " + obj.ID + " -->\n" +
+		"### Sign up v2 <!-- " + uc.ID + " -->\n" +
+		"- [ ] Build form\n" +
+		"### Referral\n" +
+		"- [ ] Invite links\n"
+	result, err := agent.ImportPlanMarkdown(ctx, []byte(plan), false)
+	if err != nil {
+		t.Fatalf("ImportPlanMarkdown failed: %v", err)
+	}
+	var actions []string
+	for _, c := range result.Changes {
+		actions = append(actions, c.Action+" "+c.EntityType)
+	}
+	if got := strings.Join(actions, ","); got != "queued usecase,create activity,queued usecase,skip activity" {
+		t.Fatalf("protected use cases should be queued and their new children skipped: %s", got)
+	}
+
+	ucHandler, _ := human.entityRegistry.Get("usecase")
+	if u, _ := ucHandler.Get(ctx, uc.ID); u.(*UseCaseEntity).Title != "Sign up" {
+		t.Error("queued use case update should not be applied")
+	}
+	pending, _ := human.Pending(ctx)
+	if len(pending) != 2 {
+		t.Fatalf("expected 2 pending approvals, got %d", len(pending))
+	}
+
+	trail, err := human.AuditTrail(ctx, "claude", 0)
+	if err != nil {
+		t.Fatalf("AuditTrail failed: %v", err)
+	}
+	var results []string
+	for _, r := range trail {
+		results = append(results, r.Entity+"_"+r.Op+":"+r.Result)
+	}
+	if got := strings.Join(results, ","); got != "usecase_update:queued,activity_create:applied,usecase_create:queued" {
+		t.Errorf("every imported change should be audited: %s", got)
+	}
+}
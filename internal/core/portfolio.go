@@ -0,0 +1,340 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// portfolioTopRisks はポートフォリオの Top Risks に載せる件数
+const portfolioTopRisks = 10
+
+// PortfolioMember はポートフォリオに集約するプロジェクト
+type PortfolioMember struct {
+	Name string // ワークスペースでの名前（空の場合はプロジェクトのディレクトリ名）
+	Zeus *Zeus
+}
+
+// Portfolio は複数プロジェクトの状態を集約したポートフォリオレポート
+type Portfolio struct {
+	GeneratedAt string             `json:"generated_at"`
+	Projects    []PortfolioProject `json:"projects"` // 指定順
+	Totals      PortfolioTotals    `json:"totals"`
+	TopRisks    []PortfolioItem    `json:"top_risks"`   // 全プロジェクトの未対応 Risk（スコアの高い順、上位 10 件）
+	Bottlenecks []PortfolioItem    `json:"bottlenecks"` // 進捗を止めているもの（重大度の高い順）
+}
+
+// PortfolioProject はプロジェクトごとの状態
+type PortfolioProject struct {
+	Name             string             `json:"name"`
+	Path             string             `json:"path"`
+	ProjectName      string             `json:"project_name,omitempty"`
+	Health           HealthStatus       `json:"health"`
+	Activities       int                `json:"activities"`
+	Completed        int                `json:"completed"`
+	InProgress       int                `json:"in_progress"`
+	Completion       int                `json:"completion"` // 完了した Activity の割合（%）
+	PendingApprovals int                `json:"pending_approvals"`
+	OpenRisks        int                `json:"open_risks"`
+	OpenProblems     int                `json:"open_problems"`
+	Releases         []PortfolioRelease `json:"releases"` // 完了見込み（目標日の近い順）
+	Error            string             `json:"error,omitempty"`
+}
+
+// PortfolioRelease はプロジェクトのリリースの完了見込み
+type PortfolioRelease struct {
+	ID            string        `json:"id"`
+	Title         string        `json:"title"`
+	Status        ReleaseStatus `json:"status"`
+	TargetDate    string        `json:"target_date,omitempty"`
+	DaysRemaining *int          `json:"days_remaining"`
+	Readiness     int           `json:"readiness"`
+	Go            bool          `json:"go"`
+}
+
+// PortfolioTotals は全プロジェクトの合計
+type PortfolioTotals struct {
+	Projects     int            `json:"projects"`
+	Activities   int            `json:"activities"`
+	Completed    int            `json:"completed"`
+	Completion   int            `json:"completion"` // 全 Activity に対する完了の割合（%）
+	OpenRisks    int            `json:"open_risks"`
+	OpenProblems int            `json:"open_problems"`
+	Health       map[string]int `json:"health"` // good / fair / poor / unknown ごとのプロジェクト数
+}
+
+// PortfolioItem は Top Risks / Bottlenecks の項目
+type PortfolioItem struct {
+	Project  string `json:"project"`
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Kind     string `json:"kind"` // risk / problem / consideration / release / budget
+	Severity string `json:"severity,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+}
+
+// BuildPortfolio は members の状態を集約する
+// 読み込めないプロジェクトは Error に理由を記録して続ける。完了見込みはリリースの準備状況、
+// ボトルネックは high 以上または SLA 違反の未解決 Problem・期限を過ぎた Consideration・目標日を過ぎた no-go のリリース・予算超過
+func BuildPortfolio(ctx context.Context, members []PortfolioMember) (*Portfolio, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	p := &Portfolio{
+		GeneratedAt: now.Format(time.RFC3339),
+		Projects:    []PortfolioProject{},
+		TopRisks:    []PortfolioItem{},
+		Bottlenecks: []PortfolioItem{},
+		Totals:      PortfolioTotals{Health: map[string]int{}},
+	}
+	var risks []PortfolioItem
+	for _, m := range members {
+		project, projectRisks, bottlenecks := portfolioProject(ctx, m, now)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		p.Projects = append(p.Projects, project)
+		risks = append(risks, projectRisks...)
+		p.Bottlenecks = append(p.Bottlenecks, bottlenecks...)
+
+		p.Totals.Projects++
+		p.Totals.Health[string(project.Health)]++
+		p.Totals.Activities += project.Activities
+		p.Totals.Completed += project.Completed
+		p.Totals.OpenRisks += project.OpenRisks
+		p.Totals.OpenProblems += project.OpenProblems
+	}
+	if p.Totals.Activities > 0 {
+		p.Totals.Completion = p.Totals.Completed * 100 / p.Totals.Activities
+	}
+	sortPortfolioItems(risks)
+	if len(risks) > portfolioTopRisks {
+		risks = risks[:portfolioTopRisks]
+	}
+	p.TopRisks = append(p.TopRisks, risks...)
+	sortPortfolioItems(p.Bottlenecks)
+	return p, nil
+}
+
+// portfolioProject はプロジェクト 1 件の状態と Risk・ボトルネックを集める
+func portfolioProject(ctx context.Context, m PortfolioMember, now time.Time) (PortfolioProject, []PortfolioItem, []PortfolioItem) {
+	z := m.Zeus
+	project := PortfolioProject{
+		Name:     m.Name,
+		Path:     z.ProjectPath,
+		Health:   HealthUnknown,
+		Releases: []PortfolioRelease{},
+	}
+	if project.Name == "" {
+		wp := WorkspaceProject{Path: z.ProjectPath}
+		wp.fillName()
+		project.Name = wp.Name
+	}
+	status, err := z.Status(ctx)
+	if err != nil {
+		project.Error = err.Error()
+		return project, nil, nil
+	}
+	project.ProjectName = status.Project.Name
+	project.Health = status.State.Health
+	project.Activities = status.State.Summary.TotalActivities
+	project.Completed = status.State.Summary.Completed
+	project.InProgress = status.State.Summary.InProgress
+	project.PendingApprovals = status.PendingApprovals
+	if project.Activities > 0 {
+		project.Completion = project.Completed * 100 / project.Activities
+	}
+
+	item := func(id, title, kind, severity, detail string) PortfolioItem {
+		return PortfolioItem{Project: project.Name, ID: id, Title: title, Kind: kind, Severity: severity, Detail: detail}
+	}
+	var risks, bottlenecks []PortfolioItem
+	z.forEachYaml(ctx, "risks", func(path string) {
+		var r RiskEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &r); err != nil || r.Status == RiskStatusMitigated || r.Status == RiskStatusClosed {
+			return
+		}
+		project.OpenRisks++
+		risks = append(risks, item(r.ID, r.Title, "risk", string(r.RiskScore),
+			"probability: "+string(r.Probability)+", impact: "+string(r.Impact)))
+	})
+	problems, err := z.ProblemAging(ctx, now)
+	if err != nil {
+		project.Error = err.Error()
+	}
+	for _, pr := range problems {
+		project.OpenProblems++
+		if pr.Severity != ProblemSeverityCritical && pr.Severity != ProblemSeverityHigh && !pr.Breached {
+			continue
+		}
+		detail := fmt.Sprintf("未解決 [%s]、%d 日経過", pr.Status, pr.AgeDays)
+		if pr.Breached {
+			detail += fmt.Sprintf("（SLA %d 日を %d 日超過）", *pr.SLADays, pr.DaysOverdue)
+		}
+		bottlenecks = append(bottlenecks, item(pr.ID, pr.Title, "problem", string(pr.Severity), detail))
+	}
+	z.forEachYaml(ctx, "considerations", func(path string) {
+		var c ConsiderationEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &c); err != nil {
+			return
+		}
+		if days, overdue := c.overdueDays(now); overdue {
+			bottlenecks = append(bottlenecks, item(c.ID, c.Title, "consideration", "",
+				fmt.Sprintf("意思決定が期限 %s を %d 日超過", c.DueDate, days)))
+		}
+	})
+	// 予算超過（実績のみで超過は high、契約済みを含めて超過は medium）
+	if budget, err := z.Budget(ctx); err == nil {
+		for _, line := range budget.Overruns {
+			severity := string(ProblemSeverityMedium)
+			if line.Total.Actual > line.Total.Budgeted {
+				severity = string(ProblemSeverityHigh)
+			}
+			bottlenecks = append(bottlenecks, item(line.ID, line.Title, "budget", severity,
+				fmt.Sprintf("予算 %s に対し実績 %s・契約済み %s（%d%%）", FormatAmount(line.Total.Budgeted, budget.Currency),
+					FormatAmount(line.Total.Actual, budget.Currency), FormatAmount(line.Total.Committed, budget.Currency), line.Utilization)))
+		}
+	}
+
+	releases, err := z.Releases(ctx)
+	if err == nil {
+		for _, r := range releases {
+			if r.Status != ReleaseStatusPlanned {
+				continue
+			}
+			project.Releases = append(project.Releases, PortfolioRelease{
+				ID: r.ID, Title: r.Title, Status: r.Status, TargetDate: r.TargetDate,
+				DaysRemaining: r.DaysRemaining, Readiness: r.Readiness, Go: r.Go,
+			})
+			if !r.Go && r.DaysRemaining != nil && *r.DaysRemaining < 0 {
+				bottlenecks = append(bottlenecks, item(r.ID, r.Title, "release", "",
+					fmt.Sprintf("目標日 %s を %d 日超過（準備 %d%%、no-go の理由 %d 件）", r.TargetDate, -*r.DaysRemaining, r.Readiness, len(r.Blockers))))
+			}
+		}
+	}
+	return project, risks, bottlenecks
+}
+
+// sortPortfolioItems は重大度の高い順（重大度なしは最後）、同じ重大度はプロジェクト・ID 順に並べる
+func sortPortfolioItems(items []PortfolioItem) {
+	rank := func(severity string) int {
+		if r, ok := severityRank[severity]; ok {
+			return r
+		}
+		return len(severityRank)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		ri, rj := rank(items[i].Severity), rank(items[j].Severity)
+		if ri != rj {
+			return ri < rj
+		}
+		if items[i].Project != items[j].Project {
+			return items[i].Project < items[j].Project
+		}
+		return items[i].ID < items[j].ID
+	})
+}
+
+// ToMarkdown はポートフォリオを 1 つの Markdown 文書に整形
+func (p *Portfolio) ToMarkdown() string {
+	var sb strings.Builder
+	sb.WriteString("# Portfolio Report\n\n")
+	fmt.Fprintf(&sb, "Generated: %s\n\n", p.GeneratedAt)
+
+	sb.WriteString("## Summary\n\n")
+	fmt.Fprintf(&sb, "- Projects: %d (good: %d, fair: %d, poor: %d, unknown: %d)\n", p.Totals.Projects,
+		p.Totals.Health[string(HealthGood)], p.Totals.Health[string(HealthFair)],
+		p.Totals.Health[string(HealthPoor)], p.Totals.Health[string(HealthUnknown)])
+	fmt.Fprintf(&sb, "- Activities: %d/%d completed (%d%%)\n", p.Totals.Completed, p.Totals.Activities, p.Totals.Completion)
+	fmt.Fprintf(&sb, "- Open risks: %d / Open problems: %d\n\n", p.Totals.OpenRisks, p.Totals.OpenProblems)
+
+	sb.WriteString("## Projects\n\n")
+	sb.WriteString("| Project | Health | Completion | Pending Approvals | Open Risks | Open Problems |\n")
+	sb.WriteString("|---------|--------|------------|-------------------|------------|---------------|\n")
+	for _, project := range p.Projects {
+		if project.Error != "" {
+			fmt.Fprintf(&sb, "| %s | - | - | - | - | - |\n", markdownCell(project.Name))
+			continue
+		}
+		fmt.Fprintf(&sb, "| %s | %s | %d%% (%d/%d) | %d | %d | %d |\n", markdownCell(project.Name), project.Health,
+			project.Completion, project.Completed, project.Activities,
+			project.PendingApprovals, project.OpenRisks, project.OpenProblems)
+	}
+	sb.WriteString("\n")
+	failed := false
+	for _, project := range p.Projects {
+		if project.Error != "" {
+			fmt.Fprintf(&sb, "- **%s**: 読み込みに失敗しました (%s)\n", project.Name, project.Error)
+			failed = true
+		}
+	}
+	if failed {
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("## Completion Forecast\n\n")
+	forecasts := 0
+	for _, project := range p.Projects {
+		for _, r := range project.Releases {
+			if forecasts == 0 {
+				sb.WriteString("| Project | Release | Target | Readiness | Decision |\n")
+				sb.WriteString("|---------|---------|--------|-----------|----------|\n")
+			}
+			forecasts++
+			decision := "NO-GO"
+			if r.Go {
+				decision = "GO"
+			}
+			fmt.Fprintf(&sb, "| %s | %s | %s | %d%% | %s |\n", markdownCell(project.Name), markdownCell(r.Title),
+				portfolioTarget(r), r.Readiness, decision)
+		}
+	}
+	if forecasts == 0 {
+		sb.WriteString("予定されているリリースはありません。\n")
+	}
+	sb.WriteString("\n")
+
+	writeItems := func(title string, items []PortfolioItem, empty string) {
+		fmt.Fprintf(&sb, "## %s\n\n", title)
+		if len(items) == 0 {
+			sb.WriteString(empty + "\n\n")
+			return
+		}
+		for _, it := range items {
+			severity := ""
+			if it.Severity != "" {
+				severity = " [" + it.Severity + "]"
+			}
+			fmt.Fprintf(&sb, "- **%s** %s %s%s", it.Project, it.ID, it.Title, severity)
+			if it.Detail != "" {
+				fmt.Fprintf(&sb, " - %s", it.Detail)
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString("\n")
+	}
+	writeItems("Top Risks", p.TopRisks, "未対応の Risk はありません。")
+	writeItems("Bottlenecks", p.Bottlenecks, "ボトルネックはありません。")
+	return sb.String()
+}
+
+// portfolioTarget は目標日と残り日数を表示用に整形
+func portfolioTarget(r PortfolioRelease) string {
+	if r.DaysRemaining == nil {
+		return "-"
+	}
+	switch days := *r.DaysRemaining; {
+	case days < 0:
+		return fmt.Sprintf("%s (%d days overdue)", r.TargetDate, -days)
+	default:
+		return fmt.Sprintf("%s (%d days left)", r.TargetDate, days)
+	}
+}
+
+// markdownCell は Markdown の表のセルで使えない | をエスケープ
+func markdownCell(s string) string {
+	return strings.ReplaceAll(s, "|", "\\|")
+}
@@ -0,0 +1,107 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestBuildPortfolio(t *testing.T) {
+	ctx := context.Background()
+	newProject := func() *Zeus {
+		t.Helper()
+		z := New(t.TempDir())
+		if _, err := z.Init(ctx); err != nil {
+			t.Fatalf("Init failed: %v", err)
+		}
+		return z
+	}
+	add := func(z *Zeus, entity, title string, opts ...EntityOption) string {
+		t.Helper()
+		result, err := z.Add(ctx, entity, title, opts...)
+		if err != nil {
+			t.Fatalf("Add %s failed: %v", title, err)
+		}
+		return result.ID
+	}
+
+	alpha := newProject()
+	add(alpha, "risk", "低リスク", WithRiskProbability(RiskProbabilityLow), WithRiskImpact(RiskImpactLow))
+	critical := add(alpha, "risk", "重大リスク", WithRiskProbability(RiskProbabilityHigh), WithRiskImpact(RiskImpactCritical))
+	add(alpha, "risk", "対応済み", WithRiskProbability(RiskProbabilityHigh), WithRiskImpact(RiskImpactCritical), WithRiskStatus(RiskStatusMitigated))
+	blocking := add(alpha, "problem", "本番障害", WithProblemSeverity(ProblemSeverityCritical))
+	add(alpha, "problem", "軽微な問題", WithProblemSeverity(ProblemSeverityLow))
+	add(alpha, "activity", "実装")
+
+	beta := newProject()
+	overdue := add(beta, "consideration", "DB 選定", WithConsiderationDueDate("2020-01-01"))
+	late := add(beta, "release", "v1.0", WithReleaseTargetDate("2020-01-01"))
+	add(beta, "release", "v2.0", WithReleaseTargetDate("2999-01-01"))
+
+	broken := New(t.TempDir()) // 未初期化
+
+	p, err := BuildPortfolio(ctx, []PortfolioMember{
+		{Name: "alpha", Zeus: alpha},
+		{Name: "beta", Zeus: beta},
+		{Zeus: broken},
+	})
+	if err != nil {
+		t.Fatalf("BuildPortfolio failed: %v", err)
+	}
+
+	if len(p.Projects) != 3 || p.Projects[0].Name != "alpha" || p.Projects[2].Name == "" {
+		t.Fatalf("projects = %+v", p.Projects)
+	}
+	if p.Projects[0].OpenRisks != 2 || p.Projects[0].OpenProblems != 2 || p.Projects[0].Activities != 1 {
+		t.Errorf("alpha = %+v", p.Projects[0])
+	}
+	if len(p.Projects[1].Releases) != 2 || p.Projects[1].Releases[0].ID != late {
+		t.Errorf("beta releases = %+v", p.Projects[1].Releases)
+	}
+	if p.Projects[2].Error == "" || p.Projects[2].Health != HealthUnknown {
+		t.Errorf("broken project should record error: %+v", p.Projects[2])
+	}
+	if p.Totals.Projects != 3 || p.Totals.OpenRisks != 2 || p.Totals.Activities != 1 || p.Totals.Health[string(HealthUnknown)] == 0 {
+		t.Errorf("totals = %+v", p.Totals)
+	}
+
+	// Top Risks はスコアの高い順（対応済みは含めない）
+	if len(p.TopRisks) != 2 || p.TopRisks[0].ID != critical || p.TopRisks[0].Project != "alpha" {
+		t.Errorf("top risks = %+v", p.TopRisks)
+	}
+
+	// ボトルネックは重大な Problem、期限超過の Consideration、目標日を過ぎた no-go のリリース
+	kinds := map[string]string{}
+	for _, b := range p.Bottlenecks {
+		kinds[b.ID] = b.Kind
+	}
+	if len(p.Bottlenecks) != 3 || p.Bottlenecks[0].ID != blocking ||
+		kinds[overdue] != "consideration" || kinds[late] != "release" {
+		t.Errorf("bottlenecks = %+v", p.Bottlenecks)
+	}
+
+	md := p.ToMarkdown()
+	for _, want := range []string{"# Portfolio Report", "| alpha |", "## Completion Forecast", "v2.0", "## Top Risks", "重大リスク", "## Bottlenecks", "読み込みに失敗"} {
+		if !strings.Contains(md, want) {
+			t.Errorf("markdown should contain %q:\n%s", want, md)
+		}
+	}
+	if _, err := json.Marshal(p); err != nil {
+		t.Errorf("json.Marshal failed: %v", err)
+	}
+}
+
+func TestBuildPortfolioEmpty(t *testing.T) {
+	p, err := BuildPortfolio(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("BuildPortfolio failed: %v", err)
+	}
+	if len(p.Projects) != 0 || len(p.TopRisks) != 0 || len(p.Bottlenecks) != 0 {
+		t.Errorf("portfolio = %+v", p)
+	}
+	md := p.ToMarkdown()
+	if !strings.Contains(md, "予定されているリリースはありません") || !strings.Contains(md, "ボトルネックはありません") {
+		t.Errorf("markdown = %s", md)
+	}
+}
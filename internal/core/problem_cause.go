@@ -0,0 +1,88 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+	"gopkg.in/yaml.v3"
+)
+
+// CauseTree は Problem の原因ツリーを返す
+func (p *ProblemEntity) CauseTree() *analysis.CauseTree {
+	return &analysis.CauseTree{ProblemID: p.ID, Title: p.Title, Causes: toCauseNodes(p.Causes)}
+}
+
+// CauseEntityIDs は原因ツリーが参照しているエンティティ ID（出現順、重複なし）
+func (p *ProblemEntity) CauseEntityIDs() []string {
+	ids := []string{}
+	seen := map[string]bool{}
+	var walk func(causes []ProblemCause)
+	walk = func(causes []ProblemCause) {
+		for _, c := range causes {
+			if c.EntityID != "" && !seen[c.EntityID] {
+				seen[c.EntityID] = true
+				ids = append(ids, c.EntityID)
+			}
+			walk(c.Causes)
+		}
+	}
+	walk(p.Causes)
+	return ids
+}
+
+// ProblemCauseTree は Problem の原因ツリーを返す
+func (z *Zeus) ProblemCauseTree(ctx context.Context, id string) (*analysis.CauseTree, error) {
+	entity, err := z.Get(ctx, "problem", id)
+	if err != nil {
+		return nil, err
+	}
+	return entity.(*ProblemEntity).CauseTree(), nil
+}
+
+// toCauseNodes は原因ツリーを analysis の形式に変換
+func toCauseNodes(causes []ProblemCause) []analysis.CauseNode {
+	nodes := make([]analysis.CauseNode, 0, len(causes))
+	for _, c := range causes {
+		nodes = append(nodes, analysis.CauseNode{
+			Description: c.Description,
+			EntityID:    c.EntityID,
+			Causes:      toCauseNodes(c.Causes),
+		})
+	}
+	return nodes
+}
+
+// validateCauseReferences は原因ツリーが参照しているエンティティの存在を確認
+// known に含まれる ID（更新前から参照していたもの）は確認しない
+func (h *ProblemHandler) validateCauseReferences(ctx context.Context, prob *ProblemEntity, known map[string]bool) error {
+	for _, id := range prob.CauseEntityIDs() {
+		if known[id] {
+			continue
+		}
+		if !h.entityExists(ctx, id) {
+			return fmt.Errorf("referenced entity not found in problem causes: %s", id)
+		}
+	}
+	return nil
+}
+
+// entityExists は ID のエンティティが存在するか（単一ファイルのエンティティはファイル内の項目を探す）
+func (h *ProblemHandler) entityExists(ctx context.Context, id string) bool {
+	entityType, ok := EntityTypeFromID(id)
+	if !ok {
+		return false
+	}
+	path, err := entityRelativePath(entityType, id)
+	if err != nil || !h.fileStore.Exists(ctx, path) {
+		return false
+	}
+	if entityDirectories[entityType] != "" {
+		return true
+	}
+	var doc yaml.Node
+	if err := h.fileStore.ReadYaml(ctx, path, &doc); err != nil {
+		return false
+	}
+	return findEntityNode(&doc, id) != nil
+}
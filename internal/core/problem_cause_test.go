@@ -0,0 +1,135 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestProblemEntity_ValidateCauses(t *testing.T) {
+	deep := []ProblemCause{{Description: "leaf"}}
+	for i := 0; i < MaxProblemCauseDepth; i++ {
+		deep = []ProblemCause{{Description: "why", Causes: deep}}
+	}
+
+	tests := []struct {
+		name    string
+		causes  []ProblemCause
+		wantErr string
+	}{
+		{"valid", []ProblemCause{{Description: "テストが不安定", EntityID: "act-1a2b3c4d", Causes: []ProblemCause{{Description: "外部 API に依存"}}}}, ""},
+		{"empty description", []ProblemCause{{Description: "ok", Causes: []ProblemCause{{Description: " "}}}}, "causes[0].causes[0]"},
+		{"invalid entity id", []ProblemCause{{Description: "ok", EntityID: "../etc"}}, "invalid problem cause entity_id"},
+		{"too deep", deep, "too deep"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &ProblemEntity{ID: "prob-001", Title: "遅延", Causes: tt.causes}
+			err := p.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestProblemCauses_ReferencesAndReport(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	act, err := z.Add(ctx, "activity", "E2E テスト整備")
+	if err != nil {
+		t.Fatalf("Add activity failed: %v", err)
+	}
+
+	// 存在しないエンティティへの参照は追加できない
+	_, err = z.Add(ctx, "problem", "リリース遅延", WithProblemCauses([]ProblemCause{
+		{Description: "テストが不安定", EntityID: "act-deadbeef"},
+	}))
+	if err == nil || !strings.Contains(err.Error(), "act-deadbeef") {
+		t.Fatalf("missing cause reference should be rejected: %v", err)
+	}
+
+	causes := []ProblemCause{
+		{Description: "テストが不安定", EntityID: act.ID, Causes: []ProblemCause{
+			{Description: "外部 API に依存"},
+		}},
+		{Description: "レビュー待ち"},
+	}
+	result, err := z.Add(ctx, "problem", "リリース遅延", WithProblemCauses(causes))
+	if err != nil {
+		t.Fatalf("Add problem failed: %v", err)
+	}
+
+	// 関連エンティティは参照関係グラフのエッジになる
+	graph, err := z.BuildRelationGraph(ctx)
+	if err != nil {
+		t.Fatalf("BuildRelationGraph failed: %v", err)
+	}
+	found := false
+	for _, e := range graph.Edges {
+		if e.From == result.ID && e.To == act.ID && e.Relation == "causes" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("relation graph should have a causes edge to %s: %+v", act.ID, graph.Edges)
+	}
+
+	tree, err := z.ProblemCauseTree(ctx, result.ID)
+	if err != nil {
+		t.Fatalf("ProblemCauseTree failed: %v", err)
+	}
+	if len(tree.Causes) != 2 || len(tree.Causes[0].Causes) != 1 {
+		t.Errorf("cause tree = %+v", tree)
+	}
+
+	// 更新前から参照していたエンティティは削除済みでも更新できる
+	if err := z.fileStore.Delete(ctx, "activities/"+act.ID+".yaml"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	handler, _ := z.entityRegistry.Get("problem")
+	entity, err := z.Get(ctx, "problem", result.ID)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	prob := entity.(*ProblemEntity)
+	prob.Severity = ProblemSeverityHigh
+	if err := handler.Update(ctx, result.ID, prob); err != nil {
+		t.Errorf("Update with an existing dangling reference failed: %v", err)
+	}
+	prob.Causes = append(prob.Causes, ProblemCause{Description: "新しい原因", EntityID: "uc-deadbeef"})
+	if err := handler.Update(ctx, result.ID, prob); err == nil {
+		t.Error("Update with a new missing reference should fail")
+	}
+
+	out, err := z.GenerateReportWithSections(ctx, "text", []string{"problems"})
+	if err != nil {
+		t.Fatalf("GenerateReportWithSections failed: %v", err)
+	}
+	for _, want := range []string{
+		"      - テストが不安定 [" + act.ID + "]",
+		"        - 外部 API に依存 (root cause)",
+		"      - レビュー待ち (root cause)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("text report should contain %q:\n%s", want, out)
+		}
+	}
+
+	out, err = z.GenerateReportWithSections(ctx, "markdown", []string{"problems"})
+	if err != nil {
+		t.Fatalf("GenerateReportWithSections failed: %v", err)
+	}
+	if !strings.Contains(out, "### "+result.ID+" Cause Tree") || !strings.Contains(out, "```mermaid\ngraph TD") {
+		t.Errorf("markdown report should contain the cause tree:\n%s", out)
+	}
+}
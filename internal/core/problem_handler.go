@@ -75,6 +75,9 @@ func (h *ProblemHandler) Add(ctx context.Context, name string, opts ...EntityOpt
 	if err := problem.Validate(); err != nil {
 		return nil, err
 	}
+	if err := h.validateCauseReferences(ctx, problem, nil); err != nil {
+		return nil, err
+	}
 
 	// ファイル書き込み
 	filePath := filepath.Join("problems", id+".yaml")
@@ -180,6 +183,13 @@ func (h *ProblemHandler) Update(ctx context.Context, id string, update any) erro
 		if err := prob.Validate(); err != nil {
 			return err
 		}
+		known := map[string]bool{}
+		for _, id := range existingProb.CauseEntityIDs() {
+			known[id] = true
+		}
+		if err := h.validateCauseReferences(ctx, prob, known); err != nil {
+			return err
+		}
 
 		filePath := filepath.Join("problems", id+".yaml")
 		return h.fileStore.WriteYaml(ctx, filePath, prob)
@@ -339,6 +349,15 @@ func WithProblemRootCause(rootCause string) EntityOption {
 	}
 }
 
+// WithProblemCauses は Problem の原因ツリーを設定
+func WithProblemCauses(causes []ProblemCause) EntityOption {
+	return func(v any) {
+		if prob, ok := v.(*ProblemEntity); ok {
+			prob.Causes = causes
+		}
+	}
+}
+
 // WithProblemPotentialSolutions は Problem の潜在的解決策を設定
 func WithProblemPotentialSolutions(solutions []string) EntityOption {
 	return func(v any) {
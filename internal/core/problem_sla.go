@@ -0,0 +1,122 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ProblemAging は未解決の Problem の経過日数と SLA（解決目標）の状況
+type ProblemAging struct {
+	ID          string          `json:"id"`
+	Title       string          `json:"title"`
+	Severity    ProblemSeverity `json:"severity"`
+	Status      ProblemStatus   `json:"status"`
+	ReportedAt  string          `json:"reported_at,omitempty"` // metadata.created_at
+	AgeDays     int             `json:"age_days"`
+	SLADays     *int            `json:"sla_days"`           // 重大度の解決目標（未設定は null）
+	DueDate     string          `json:"due_date,omitempty"` // 報告日 + SLA
+	Breached    bool            `json:"breached"`
+	DaysOverdue int             `json:"days_overdue,omitempty"`
+}
+
+// Validate は Problem の SLA 設定の妥当性を検証
+func (s ProblemSLASettings) Validate() error {
+	for severity, days := range s.SLADays {
+		switch severity {
+		case ProblemSeverityCritical, ProblemSeverityHigh, ProblemSeverityMedium, ProblemSeverityLow:
+		default:
+			return fmt.Errorf("invalid escalation.problems.sla_days severity: %s", severity)
+		}
+		if days <= 0 {
+			return fmt.Errorf("escalation.problems.sla_days.%s must be > 0", severity)
+		}
+	}
+	return nil
+}
+
+// aging は Problem の経過日数と SLA の状況（報告日が無い・不正な場合は経過 0 日）
+func (s ProblemSLASettings) aging(p *ProblemEntity, now time.Time) ProblemAging {
+	a := ProblemAging{
+		ID:         p.ID,
+		Title:      p.Title,
+		Severity:   p.Severity,
+		Status:     p.Status,
+		ReportedAt: p.Metadata.CreatedAt,
+	}
+	reported, ok := parseDigestTime(p.Metadata.CreatedAt)
+	if ok {
+		a.AgeDays = int(truncateDay(now).Sub(truncateDay(reported)).Hours() / 24)
+	}
+	if days, set := s.SLADays[p.Severity]; set {
+		a.SLADays = &days
+		if ok {
+			a.DueDate = truncateDay(reported).AddDate(0, 0, days).Format("2006-01-02")
+		}
+		if a.AgeDays > days {
+			a.Breached = true
+			a.DaysOverdue = a.AgeDays - days
+		}
+	}
+	return a
+}
+
+// ProblemAging は open / in_progress の Problem の経過日数と SLA 違反を返す
+// SLA は zeus.yaml の escalation.problems.sla_days（重大度ごとの報告から解決までの日数）。
+// SLA 違反を超過日数の多い順に先頭へ、残りは重大度の高い順・経過日数の多い順に並べる
+func (z *Zeus) ProblemAging(ctx context.Context, now time.Time) ([]ProblemAging, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var settings ProblemSLASettings
+	config, err := z.LoadConfig(ctx)
+	switch {
+	case err == nil:
+		settings = config.Escalation.Problems
+	case !errors.Is(err, ErrConfigNotFound):
+		return nil, err
+	}
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	result := []ProblemAging{}
+	z.forEachYaml(ctx, "problems", func(path string) {
+		var p ProblemEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &p); err != nil {
+			return
+		}
+		if p.Status != ProblemStatusOpen && p.Status != ProblemStatusInProgress {
+			return
+		}
+		result = append(result, settings.aging(&p, now))
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	rank := func(severity ProblemSeverity) int {
+		if r, ok := severityRank[string(severity)]; ok {
+			return r
+		}
+		return len(severityRank)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.Breached != b.Breached {
+			return a.Breached
+		}
+		if a.DaysOverdue != b.DaysOverdue {
+			return a.DaysOverdue > b.DaysOverdue
+		}
+		if rank(a.Severity) != rank(b.Severity) {
+			return rank(a.Severity) < rank(b.Severity)
+		}
+		if a.AgeDays != b.AgeDays {
+			return a.AgeDays > b.AgeDays
+		}
+		return a.ID < b.ID
+	})
+	return result, nil
+}
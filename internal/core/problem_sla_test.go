@@ -0,0 +1,86 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestProblemAging(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	config, _ := z.LoadConfig(ctx)
+	config.Escalation.Problems.SLADays = map[ProblemSeverity]int{ProblemSeverityCritical: 1, ProblemSeverityHigh: 5}
+	if err := z.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+
+	add := func(title string, severity ProblemSeverity, reported string, opts ...EntityOption) string {
+		t.Helper()
+		result, err := z.Add(ctx, "problem", title, append(opts, WithProblemSeverity(severity))...)
+		if err != nil {
+			t.Fatalf("Add %s failed: %v", title, err)
+		}
+		path := "problems/" + result.ID + ".yaml"
+		var p ProblemEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &p); err != nil {
+			t.Fatalf("ReadYaml failed: %v", err)
+		}
+		p.Metadata.CreatedAt = reported
+		if err := z.fileStore.WriteYaml(ctx, path, &p); err != nil {
+			t.Fatalf("WriteYaml failed: %v", err)
+		}
+		return result.ID
+	}
+	now := time.Date(2026, 3, 20, 10, 0, 0, 0, time.Local)
+	high := add("高", ProblemSeverityHigh, "2026-03-10")
+	critical := add("致命的", ProblemSeverityCritical, "2026-03-17")
+	inSLA := add("期限内", ProblemSeverityHigh, "2026-03-15") // 5 日経過は SLA 内
+	low := add("低", ProblemSeverityLow, "2026-01-01")
+	add("解決済み", ProblemSeverityCritical, "2026-01-01", WithProblemStatus(ProblemStatusResolved))
+
+	aging, err := z.ProblemAging(ctx, now)
+	if err != nil {
+		t.Fatalf("ProblemAging failed: %v", err)
+	}
+	// SLA 違反を超過日数の多い順、残りは重大度の高い順
+	ids := []string{}
+	for _, a := range aging {
+		ids = append(ids, a.ID)
+	}
+	want := []string{high, critical, inSLA, low}
+	if len(ids) != len(want) {
+		t.Fatalf("aging = %+v", aging)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("order = %v, want %v", ids, want)
+		}
+	}
+	if a := aging[0]; !a.Breached || a.AgeDays != 10 || a.DaysOverdue != 5 || a.DueDate != "2026-03-15" {
+		t.Errorf("high = %+v", a)
+	}
+	if a := aging[1]; !a.Breached || a.DaysOverdue != 2 {
+		t.Errorf("critical = %+v", a)
+	}
+	if a := aging[2]; a.Breached || a.AgeDays != 5 || a.SLADays == nil || *a.SLADays != 5 {
+		t.Errorf("in SLA = %+v", a)
+	}
+	if a := aging[3]; a.Breached || a.SLADays != nil || a.AgeDays != 78 {
+		t.Errorf("low (no SLA) = %+v", a)
+	}
+
+	// 不正な設定はエラー
+	for _, days := range []map[ProblemSeverity]int{{"urgent": 1}, {ProblemSeverityLow: 0}} {
+		config.Escalation.Problems.SLADays = days
+		if err := z.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+			t.Fatalf("WriteYaml failed: %v", err)
+		}
+		if _, err := z.ProblemAging(ctx, now); err == nil {
+			t.Errorf("sla_days %v should be invalid", days)
+		}
+	}
+}
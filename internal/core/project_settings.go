@@ -0,0 +1,216 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// 設定値の選択肢（zeus.yaml の settings セクション）
+var (
+	automationLevels = []string{"auto", "notify", "approve"}
+	approvalModes    = []string{"default", "strict", "loose"}
+	aiProviders      = []string{"claude-code", "gemini", "codex"}
+)
+
+// ProjectSettings はダッシュボードから編集できる zeus.yaml の設定（settings と analysis セクション）
+type ProjectSettings struct {
+	Revision        string                   `json:"revision"` // zeus.yaml の内容のハッシュ
+	AutomationLevel string                   `json:"automation_level"`
+	ApprovalMode    string                   `json:"approval_mode"`
+	AIProvider      string                   `json:"ai_provider"`
+	Operations      map[string]ApprovalLevel `json:"operations"`
+	Analysis        AnalysisSettings         `json:"analysis"`
+}
+
+// validateProjectSettings は設定値の妥当性を検証（不正な値は *ValidationError）
+func (z *Zeus) validateProjectSettings(s *ProjectSettings) error {
+	choices := []struct {
+		field, value string
+		allowed      []string
+	}{
+		{"automation_level", s.AutomationLevel, automationLevels},
+		{"approval_mode", s.ApprovalMode, approvalModes},
+		{"ai_provider", s.AIProvider, aiProviders},
+	}
+	for _, c := range choices {
+		if !slices.Contains(c.allowed, c.value) {
+			return invalidSettings(fmt.Errorf("settings.%s: unknown value %q (%s)", c.field, c.value, strings.Join(c.allowed, ", ")))
+		}
+	}
+	if err := z.validateOperationLevels(&Settings{Operations: s.Operations}); err != nil {
+		return invalidSettings(err)
+	}
+	if _, err := s.Analysis.Stale.Config(); err != nil {
+		return invalidSettings(err)
+	}
+	if _, err := s.Analysis.Affinity.Options(); err != nil {
+		return invalidSettings(err)
+	}
+	return nil
+}
+
+// invalidSettings は設定値の検証エラーを *ValidationError にする
+func invalidSettings(err error) error {
+	return &ValidationError{Field: "zeus.yaml", Message: err.Error()}
+}
+
+// configRevision は zeus.yaml の現在のリビジョン
+func (z *Zeus) configRevision() string {
+	return contentRevision(readStoreFile(z.fileStore, "zeus.yaml"))
+}
+
+// ProjectSettings は zeus.yaml の settings と analysis セクションをリビジョン付きで返す
+func (z *Zeus) ProjectSettings(ctx context.Context) (*ProjectSettings, error) {
+	config, err := z.LoadConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	operations := config.Settings.Operations
+	if operations == nil {
+		operations = map[string]ApprovalLevel{}
+	}
+	return &ProjectSettings{
+		Revision:        z.configRevision(),
+		AutomationLevel: config.Settings.AutomationLevel,
+		ApprovalMode:    config.Settings.ApprovalMode,
+		AIProvider:      config.Settings.AIProvider,
+		Operations:      operations,
+		Analysis:        config.Analysis,
+	}, nil
+}
+
+// UpdateProjectSettings は期待するリビジョンを確認してから settings と analysis セクションを置き換え、更新後の設定を返す
+// 値を検証してから書き込み、zeus.yaml の他のセクションとコメントは保持する。
+// リビジョンが一致しない場合は *RevisionConflictError（Fields は空）を返す。
+// 承認を緩める変更は書き込まずに承認待ちに追加し、*ApprovalRequiredError を返す（変更はすべて監査ログに記録）
+func (z *Zeus) UpdateProjectSettings(ctx context.Context, settings ProjectSettings, expectedRevision string) (*ProjectSettings, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if expectedRevision == "" {
+		return nil, ErrRevisionRequired
+	}
+	if err := z.validateProjectSettings(&settings); err != nil {
+		return nil, err
+	}
+
+	entityUpdateMu.Lock()
+	defer entityUpdateMu.Unlock()
+	unlock, err := z.lockEntityUpdate()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if !z.fileStore.Exists(ctx, "zeus.yaml") {
+		return nil, ErrConfigNotFound
+	}
+	if current := z.configRevision(); current != expectedRevision {
+		return nil, &RevisionConflictError{EntityType: "settings", ID: "zeus.yaml", Expected: expectedRevision, Current: current}
+	}
+
+	config := z.approvalConfig(ctx)
+	protected, err := z.guardAgent(ctx, &config.Agents, "settings", ChangeOpUpdate, "zeus.yaml")
+	if err != nil {
+		return nil, err
+	}
+	// 承認レベルを緩める変更（とエージェントの保護対象への変更）は承認待ちにする
+	if protected || z.loosensSettings(&config.Settings, &settings) {
+		approval, err := z.queueApproval(ctx, &config, "settings", ChangeOpUpdate, "設定の変更: zeus.yaml", settings, func(tx *Zeus) (string, error) {
+			return "zeus.yaml", tx.writeProjectSettings(ctx, &settings)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return nil, &ApprovalRequiredError{Action: "settings_update", ApprovalID: approval.ID, EntityID: "zeus.yaml"}
+	}
+
+	if err := z.writeProjectSettings(ctx, &settings); err != nil {
+		return nil, err
+	}
+	z.audit(ctx, AuditEntry{Op: ChangeOpUpdate, Entity: "settings", ID: "zeus.yaml", Result: AuditResultApplied})
+	return z.ProjectSettings(ctx)
+}
+
+// writeProjectSettings は zeus.yaml の settings と analysis セクションを置き換えて書き込む
+func (z *Zeus) writeProjectSettings(ctx context.Context, settings *ProjectSettings) error {
+	var doc yaml.Node
+	if err := z.fileStore.ReadYaml(ctx, "zeus.yaml", &doc); err != nil {
+		return fmt.Errorf("zeus.yaml の読み込みに失敗: %w", err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return fmt.Errorf("zeus.yaml の形式が不正です")
+	}
+	root := doc.Content[0]
+	// settings は ProjectSettings のフィールドで全項目を置き換える
+	if err := setYamlValue(root, "settings", Settings{
+		AutomationLevel: settings.AutomationLevel,
+		ApprovalMode:    settings.ApprovalMode,
+		AIProvider:      settings.AIProvider,
+		Operations:      settings.Operations,
+	}); err != nil {
+		return err
+	}
+	if err := setYamlValue(root, "analysis", settings.Analysis); err != nil {
+		return err
+	}
+	return z.fileStore.WriteYaml(ctx, "zeus.yaml", &doc)
+}
+
+// approvalLevelRank は承認レベルの厳しさ（auto < notify < approve）
+var approvalLevelRank = map[ApprovalLevel]int{ApprovalAuto: 0, ApprovalNotify: 1, ApprovalApprove: 2}
+
+// approvalModeRank は承認モードの厳しさ（loose < default < strict）
+var approvalModeRank = map[string]int{"loose": 0, "default": 1, "strict": 2}
+
+// loosensSettings は設定の変更が承認を緩めるか（automation_level・approval_mode を緩める、
+// または settings.operations のいずれかの操作の承認レベルが下がる）
+func (z *Zeus) loosensSettings(current *Settings, next *ProjectSettings) bool {
+	proposed := &Settings{AutomationLevel: next.AutomationLevel, ApprovalMode: next.ApprovalMode, Operations: next.Operations}
+	if slices.Index(automationLevels, proposed.AutomationLevel) < slices.Index(automationLevels, current.AutomationLevel) ||
+		approvalModeRank[proposed.ApprovalMode] < approvalModeRank[current.ApprovalMode] {
+		return true
+	}
+	for _, operations := range []map[string]ApprovalLevel{current.Operations, proposed.Operations} {
+		for action := range operations {
+			if approvalLevelRank[z.settingsApprovalLevel(proposed, action)] < approvalLevelRank[z.settingsApprovalLevel(current, action)] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// settingsApprovalLevel は settings での action の承認レベル（operationApprovalLevel からエージェントのガードを除いたもの）
+func (z *Zeus) settingsApprovalLevel(settings *Settings, action string) ApprovalLevel {
+	if _, ok := settings.operationLevel(action); !ok && settings.AutomationLevel == "auto" {
+		return ApprovalAuto
+	}
+	return z.approvalStore.DetermineApprovalLevel(action, settings)
+}
+
+// setYamlValue はマッピング m のキー key の値を value で置き換える（空のマッピングになる場合はキーを取り除く）
+func setYamlValue(m *yaml.Node, key string, value any) error {
+	var node yaml.Node
+	if err := node.Encode(value); err != nil {
+		return err
+	}
+	if node.Kind == yaml.MappingNode && len(node.Content) == 0 {
+		removeYamlKey(m, key)
+		return nil
+	}
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			// キーに付いたコメントは残す
+			node.HeadComment, node.LineComment = m.Content[i+1].HeadComment, m.Content[i+1].LineComment
+			m.Content[i+1] = &node
+			return nil
+		}
+	}
+	m.Content = append(m.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, &node)
+	return nil
+}
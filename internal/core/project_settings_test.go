@@ -0,0 +1,150 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUpdateProjectSettings(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	z := New(dir)
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	// 設定の更新で他のセクションとコメントが失われないことを確認するためのコメント
+	path := filepath.Join(dir, ".zeus", "zeus.yaml")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	if err := os.WriteFile(path, append([]byte("# 手書きのコメント\n"), data...), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	settings, err := z.ProjectSettings(ctx)
+	if err != nil {
+		t.Fatalf("ProjectSettings failed: %v", err)
+	}
+	if settings.AutomationLevel != "auto" || settings.ApprovalMode != "default" || settings.Revision == "" {
+		t.Fatalf("settings = %+v", settings)
+	}
+
+	// リビジョン未指定・不正な値は書き込まない
+	if _, err := z.UpdateProjectSettings(ctx, *settings, ""); !errors.Is(err, ErrRevisionRequired) {
+		t.Errorf("error = %v, want ErrRevisionRequired", err)
+	}
+	invalid := []func(s *ProjectSettings){
+		func(s *ProjectSettings) { s.ApprovalMode = "lenient" },
+		func(s *ProjectSettings) { s.AIProvider = "" },
+		func(s *ProjectSettings) { s.Operations = map[string]ApprovalLevel{"objective_delete": "always"} },
+		func(s *ProjectSettings) { s.Analysis.Stale.Entities = map[string]StaleThresholdSettings{"risk": {}} },
+		func(s *ProjectSettings) { score := 1.5; s.Analysis.Affinity.MinScore = &score },
+	}
+	for i, modify := range invalid {
+		s := *settings
+		modify(&s)
+		var validation *ValidationError
+		if _, err := z.UpdateProjectSettings(ctx, s, settings.Revision); !errors.As(err, &validation) {
+			t.Errorf("invalid[%d]: error = %v, want *ValidationError", i, err)
+		}
+	}
+
+	s := *settings
+	s.ApprovalMode = "strict"
+	s.Operations = map[string]ApprovalLevel{"objective_delete": ApprovalApprove}
+	s.Analysis.Stale.CompletedDays = 14
+	updated, err := z.UpdateProjectSettings(ctx, s, settings.Revision)
+	if err != nil {
+		t.Fatalf("UpdateProjectSettings failed: %v", err)
+	}
+	if updated.Revision == settings.Revision || updated.ApprovalMode != "strict" || updated.Analysis.Stale.CompletedDays != 14 {
+		t.Errorf("updated = %+v", updated)
+	}
+	config, err := z.LoadConfig(ctx)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if config.Settings.Operations["objective_delete"] != ApprovalApprove || config.Project.Name == "" {
+		t.Errorf("config = %+v", config)
+	}
+	data, _ = os.ReadFile(path)
+	if !strings.Contains(string(data), "# 手書きのコメント") {
+		t.Error("comments in zeus.yaml should be preserved")
+	}
+
+	// 古いリビジョンは競合
+	var conflict *RevisionConflictError
+	if _, err := z.UpdateProjectSettings(ctx, s, settings.Revision); !errors.As(err, &conflict) || conflict.Current != updated.Revision {
+		t.Errorf("error = %v, want *RevisionConflictError", err)
+	}
+}
+
+func TestUpdateProjectSettings_LooseningRequiresApproval(t *testing.T) {
+	ctx := context.Background()
+	agent, human := setupAgentPolicy(t, AgentPolicy{})
+	settings, err := human.ProjectSettings(ctx)
+	if err != nil {
+		t.Fatalf("ProjectSettings failed: %v", err)
+	}
+
+	// 承認レベルを厳しくする変更はそのまま反映する
+	s := *settings
+	s.AutomationLevel = "approve"
+	s.Operations = map[string]ApprovalLevel{"objective_delete": ApprovalApprove}
+	tightened, err := agent.UpdateProjectSettings(ctx, s, settings.Revision)
+	if err != nil {
+		t.Fatalf("UpdateProjectSettings failed: %v", err)
+	}
+
+	// 緩める変更（automation_level、approval_mode、operations の削除・引き下げ）は承認待ち
+	loosen := []func(s *ProjectSettings){
+		func(s *ProjectSettings) { s.AutomationLevel = "auto" },
+		func(s *ProjectSettings) { s.ApprovalMode = "loose" },
+		func(s *ProjectSettings) { s.Operations = map[string]ApprovalLevel{} },
+		func(s *ProjectSettings) { s.Operations = map[string]ApprovalLevel{"objective_delete": ApprovalNotify} },
+		func(s *ProjectSettings) { s.Operations["task_update"] = ApprovalAuto },
+	}
+	for i, modify := range loosen {
+		s := *tightened
+		s.Operations = map[string]ApprovalLevel{"objective_delete": ApprovalApprove}
+		modify(&s)
+		var required *ApprovalRequiredError
+		if _, err := agent.UpdateProjectSettings(ctx, s, tightened.Revision); !errors.As(err, &required) || required.ApprovalID == "" {
+			t.Fatalf("loosen[%d]: error = %v, want *ApprovalRequiredError", i, err)
+		}
+	}
+	if current, _ := human.ProjectSettings(ctx); current.Revision != tightened.Revision {
+		t.Fatalf("loosening changes should not be written before approval: %+v", current)
+	}
+
+	pending, err := human.Pending(ctx)
+	if err != nil || len(pending) != len(loosen) {
+		t.Fatalf("Pending = %d, %v; want %d", len(pending), err, len(loosen))
+	}
+	if _, err := human.Approve(ctx, pending[0].ID); err != nil {
+		t.Fatalf("Approve failed: %v", err)
+	}
+	if current, _ := human.ProjectSettings(ctx); current.AutomationLevel != "auto" {
+		t.Errorf("approved settings should be written: %+v", current)
+	}
+
+	// 反映・承認待ちの変更はすべて監査ログに残る
+	trail, err := human.AuditTrail(ctx, "claude", 0)
+	if err != nil {
+		t.Fatalf("AuditTrail failed: %v", err)
+	}
+	results := map[string]int{}
+	for _, r := range trail {
+		if r.Entity == "settings" && r.ID == "zeus.yaml" {
+			results[r.Result]++
+		}
+	}
+	if results[AuditResultApplied] != 1 || results[AuditResultQueued] != len(loosen) {
+		t.Errorf("audit results = %v", results)
+	}
+}
@@ -0,0 +1,364 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CI 成果物の形式
+const (
+	ArtifactJUnit   = "junit"   // JUnit XML（テスト結果）
+	ArtifactGoCover = "gocover" // go test -coverprofile の出力
+	ArtifactSARIF   = "sarif"   // SARIF 2.1（静的解析結果）
+)
+
+// 計測値の種類
+const (
+	MeasurementTestPassRate = "test_pass_rate" // テスト成功率（%）
+	MeasurementCoverage     = "coverage"       // カバレッジ（%）
+	MeasurementLintIssues   = "lint_issues"    // 静的解析の指摘件数
+)
+
+// measurementAliases は自動対応付けに使うメトリクス名（小文字、空白・-・_ を除去して比較）
+var measurementAliases = map[string][]string{
+	MeasurementTestPassRate: {"testpassrate", "passrate", "testpass", "tests"},
+	MeasurementCoverage:     {"coverage", "codecoverage", "testcoverage", "cov", "カバレッジ", "コードカバレッジ"},
+	MeasurementLintIssues:   {"lintissues", "lint", "lintwarnings", "issues", "warnings"},
+}
+
+// QualityMeasurement は CI 成果物から得た計測値
+type QualityMeasurement struct {
+	Kind   string  // test_pass_rate, coverage, lint_issues
+	Value  float64 // % または件数
+	Unit   string
+	Detail string // 集計の内訳（例: "412/420 passed, 3 skipped"）
+}
+
+// lowerIsBetter は値が小さいほど良い計測値か（目標値以下で met）
+func (m *QualityMeasurement) lowerIsBetter() bool {
+	return m.Kind == MeasurementLintIssues
+}
+
+// QualityMetricTarget は計測値を反映する QualityMetric の指定
+type QualityMetricTarget struct {
+	QualityID string
+	MetricID  string
+}
+
+// ParseQualityMetricTarget は "<quality-id>:<metric-id>" をパース
+func ParseQualityMetricTarget(s string) (QualityMetricTarget, error) {
+	qualityID, metricID, ok := strings.Cut(s, ":")
+	if !ok || qualityID == "" || metricID == "" {
+		return QualityMetricTarget{}, fmt.Errorf("metric target must be <quality-id>:<metric-id>: %q", s)
+	}
+	return QualityMetricTarget{QualityID: qualityID, MetricID: metricID}, nil
+}
+
+// QualityMetricUpdate は QualityMetric の更新内容
+type QualityMetricUpdate struct {
+	QualityID string
+	MetricID  string
+	Name      string
+	Previous  float64
+	Current   float64
+	Target    float64
+	Status    MetricStatus
+}
+
+// DetectArtifactFormat はファイル名と内容から CI 成果物の形式を推定
+func DetectArtifactFormat(path string, data []byte) (string, error) {
+	name := strings.ToLower(filepath.Base(path))
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case strings.HasSuffix(name, ".sarif") || strings.HasSuffix(name, ".sarif.json"):
+		return ArtifactSARIF, nil
+	case bytes.HasPrefix(trimmed, []byte("mode:")):
+		return ArtifactGoCover, nil
+	case bytes.HasPrefix(trimmed, []byte("<")):
+		return ArtifactJUnit, nil
+	case bytes.HasPrefix(trimmed, []byte("{")) && bytes.Contains(trimmed, []byte(`"runs"`)):
+		return ArtifactSARIF, nil
+	}
+	return "", fmt.Errorf("cannot detect artifact format of %s (junit, gocover, sarif)", path)
+}
+
+// ParseQualityArtifact は CI 成果物を解析して計測値を返す
+func ParseQualityArtifact(format string, data []byte) (*QualityMeasurement, error) {
+	switch format {
+	case ArtifactJUnit:
+		return parseJUnit(data)
+	case ArtifactGoCover:
+		return parseGoCoverProfile(data)
+	case ArtifactSARIF:
+		return parseSARIF(data)
+	default:
+		return nil, fmt.Errorf("unknown artifact format: %s (junit, gocover, sarif)", format)
+	}
+}
+
+// junitSuite は <testsuites> / <testsuite> の共通構造（入れ子の testsuite を許容）
+type junitSuite struct {
+	Suites []junitSuite `xml:"testsuite"`
+	Cases  []struct {
+		Failure *struct{} `xml:"failure"`
+		Error   *struct{} `xml:"error"`
+		Skipped *struct{} `xml:"skipped"`
+	} `xml:"testcase"`
+}
+
+// count はテストケースを再帰的に集計
+func (s *junitSuite) count() (total, failed, skipped int) {
+	for _, c := range s.Cases {
+		total++
+		switch {
+		case c.Failure != nil || c.Error != nil:
+			failed++
+		case c.Skipped != nil:
+			skipped++
+		}
+	}
+	for i := range s.Suites {
+		t, f, sk := s.Suites[i].count()
+		total, failed, skipped = total+t, failed+f, skipped+sk
+	}
+	return total, failed, skipped
+}
+
+// parseJUnit は JUnit XML からテスト成功率を算出（スキップは母数から除外）
+func parseJUnit(data []byte) (*QualityMeasurement, error) {
+	var root junitSuite
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("invalid junit xml: %w", err)
+	}
+	total, failed, skipped := root.count()
+	executed := total - skipped
+	if executed == 0 {
+		return nil, fmt.Errorf("junit xml has no executed test cases")
+	}
+	passed := executed - failed
+	return &QualityMeasurement{
+		Kind:   MeasurementTestPassRate,
+		Value:  roundMeasurement(float64(passed) / float64(executed) * 100),
+		Unit:   "%",
+		Detail: fmt.Sprintf("%d/%d passed, %d skipped", passed, executed, skipped),
+	}, nil
+}
+
+// parseGoCoverProfile は Go のカバレッジプロファイルからステートメントカバレッジを算出
+// 同じブロックが複数回現れる場合（複数パッケージのテスト）はいずれかで実行されていればカバー済み
+func parseGoCoverProfile(data []byte) (*QualityMeasurement, error) {
+	type block struct {
+		statements int
+		covered    bool
+	}
+	blocks := map[string]*block{}
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "mode:") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("invalid coverage profile line %d: %q", i+1, line)
+		}
+		statements, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid statement count on line %d: %w", i+1, err)
+		}
+		count, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid execution count on line %d: %w", i+1, err)
+		}
+		b, ok := blocks[fields[0]]
+		if !ok {
+			b = &block{statements: statements}
+			blocks[fields[0]] = b
+		}
+		b.covered = b.covered || count > 0
+	}
+
+	var total, covered int
+	for _, b := range blocks {
+		total += b.statements
+		if b.covered {
+			covered += b.statements
+		}
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("coverage profile has no statements")
+	}
+	return &QualityMeasurement{
+		Kind:   MeasurementCoverage,
+		Value:  roundMeasurement(float64(covered) / float64(total) * 100),
+		Unit:   "%",
+		Detail: fmt.Sprintf("%d/%d statements", covered, total),
+	}, nil
+}
+
+// sarifLog は SARIF のうち集計に必要な部分
+type sarifLog struct {
+	Runs []struct {
+		Results []struct {
+			Level        string            `json:"level"`
+			Suppressions []json.RawMessage `json:"suppressions"`
+		} `json:"results"`
+	} `json:"runs"`
+}
+
+// parseSARIF は SARIF の指摘件数を集計（抑制済みと level: none は除外）
+func parseSARIF(data []byte) (*QualityMeasurement, error) {
+	var log sarifLog
+	if err := json.Unmarshal(data, &log); err != nil {
+		return nil, fmt.Errorf("invalid sarif: %w", err)
+	}
+	if log.Runs == nil {
+		return nil, fmt.Errorf("sarif has no runs")
+	}
+
+	levels := map[string]int{}
+	total := 0
+	for _, run := range log.Runs {
+		for _, r := range run.Results {
+			level := r.Level
+			if level == "" {
+				level = "warning" // SARIF の既定値
+			}
+			if len(r.Suppressions) > 0 || level == "none" {
+				continue
+			}
+			levels[level]++
+			total++
+		}
+	}
+
+	var parts []string
+	for _, level := range []string{"error", "warning", "note"} {
+		if n := levels[level]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%s: %d", level, n))
+		}
+	}
+	detail := "no issues"
+	if len(parts) > 0 {
+		detail = strings.Join(parts, ", ")
+	}
+	return &QualityMeasurement{Kind: MeasurementLintIssues, Value: float64(total), Unit: "issues", Detail: detail}, nil
+}
+
+// roundMeasurement は小数第 2 位に丸める
+func roundMeasurement(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// IngestQualityMeasurement は計測値を QualityMetric の current に反映し、目標値と比較して status を更新
+// targets が空の場合は、計測値の種類に対応する名前のメトリクス（例: coverage）をすべて更新する
+func (z *Zeus) IngestQualityMeasurement(ctx context.Context, m *QualityMeasurement, targets []QualityMetricTarget, dryRun bool) ([]QualityMetricUpdate, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	h, ok := z.entityRegistry.Get("quality")
+	if !ok {
+		return nil, fmt.Errorf("quality handler not found")
+	}
+	handler := h.(*QualityHandler)
+
+	qualities, err := handler.getAllQualities(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var updates []QualityMetricUpdate
+	if len(targets) > 0 {
+		byID := map[string]*QualityEntity{}
+		for _, q := range qualities {
+			byID[q.ID] = q
+		}
+		for _, t := range targets {
+			q, ok := byID[t.QualityID]
+			if !ok {
+				return nil, fmt.Errorf("quality not found: %s", t.QualityID)
+			}
+			metric := findQualityMetric(q, t.MetricID)
+			if metric == nil {
+				return nil, fmt.Errorf("metric not found: %s:%s", t.QualityID, t.MetricID)
+			}
+			updates = append(updates, measurementUpdate(q.ID, metric, m))
+		}
+	} else {
+		for _, q := range qualities {
+			for i := range q.Metrics {
+				if matchesMeasurement(q.Metrics[i].Name, m.Kind) {
+					updates = append(updates, measurementUpdate(q.ID, &q.Metrics[i], m))
+				}
+			}
+		}
+		if len(updates) == 0 {
+			return nil, fmt.Errorf("no quality metric named like %q (%s); specify <quality-id>:<metric-id>", m.Kind, strings.Join(measurementAliases[m.Kind], ", "))
+		}
+	}
+
+	sort.SliceStable(updates, func(i, j int) bool {
+		if updates[i].QualityID != updates[j].QualityID {
+			return updates[i].QualityID < updates[j].QualityID
+		}
+		return updates[i].MetricID < updates[j].MetricID
+	})
+	if dryRun {
+		return updates, nil
+	}
+	for _, u := range updates {
+		if err := handler.UpdateMetric(ctx, u.QualityID, u.MetricID, u.Current, u.Status); err != nil {
+			return nil, fmt.Errorf("failed to update %s:%s: %w", u.QualityID, u.MetricID, err)
+		}
+	}
+	return updates, nil
+}
+
+// findQualityMetric は ID でメトリクスを検索
+func findQualityMetric(q *QualityEntity, metricID string) *QualityMetric {
+	for i := range q.Metrics {
+		if q.Metrics[i].ID == metricID {
+			return &q.Metrics[i]
+		}
+	}
+	return nil
+}
+
+// matchesMeasurement はメトリクス名が計測値の種類の別名と一致するか
+func matchesMeasurement(name, kind string) bool {
+	normalized := strings.ToLower(strings.NewReplacer(" ", "", "-", "", "_", "").Replace(name))
+	for _, alias := range measurementAliases[kind] {
+		if normalized == alias {
+			return true
+		}
+	}
+	return false
+}
+
+// measurementUpdate は計測値から更新内容を作成
+func measurementUpdate(qualityID string, metric *QualityMetric, m *QualityMeasurement) QualityMetricUpdate {
+	met := m.Value >= metric.Target
+	if m.lowerIsBetter() {
+		met = m.Value <= metric.Target
+	}
+	status := MetricStatusNotMet
+	if met {
+		status = MetricStatusMet
+	}
+	return QualityMetricUpdate{
+		QualityID: qualityID,
+		MetricID:  metric.ID,
+		Name:      metric.Name,
+		Previous:  metric.Current,
+		Current:   m.Value,
+		Target:    metric.Target,
+		Status:    status,
+	}
+}
@@ -0,0 +1,182 @@
+package core
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDetectArtifactFormat(t *testing.T) {
+	tests := []struct {
+		path string
+		data string
+		want string
+	}{
+		{"junit.xml", `<?xml version="1.0"?><testsuites/>`, ArtifactJUnit},
+		{"coverage.out", "mode: set\n", ArtifactGoCover},
+		{"results.sarif", `{}`, ArtifactSARIF},
+		{"lint.json", `{"version":"2.1.0","runs":[]}`, ArtifactSARIF},
+		{"notes.txt", "hello", ""},
+	}
+	for _, tt := range tests {
+		got, err := DetectArtifactFormat(tt.path, []byte(tt.data))
+		if got != tt.want || (err != nil) != (tt.want == "") {
+			t.Errorf("DetectArtifactFormat(%s) = %q, %v; want %q", tt.path, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseQualityArtifact_JUnit(t *testing.T) {
+	data := `<testsuites>
+  <testsuite name="a">
+    <testcase name="ok1"/>
+    <testcase name="ok2"/>
+    <testcase name="fail"><failure message="boom"/></testcase>
+    <testcase name="skip"><skipped/></testcase>
+  </testsuite>
+  <testsuite name="b">
+    <testsuite name="nested">
+      <testcase name="err"><error/></testcase>
+      <testcase name="ok3"/>
+    </testsuite>
+  </testsuite>
+</testsuites>`
+	m, err := ParseQualityArtifact(ArtifactJUnit, []byte(data))
+	if err != nil {
+		t.Fatalf("ParseQualityArtifact failed: %v", err)
+	}
+	if m.Kind != MeasurementTestPassRate || m.Value != 60 || m.Detail != "3/5 passed, 1 skipped" {
+		t.Errorf("unexpected measurement: %+v", m)
+	}
+
+	// ルートが <testsuite> の場合
+	m, err = ParseQualityArtifact(ArtifactJUnit, []byte(`<testsuite><testcase/><testcase/><testcase><failure/></testcase></testsuite>`))
+	if err != nil {
+		t.Fatalf("ParseQualityArtifact failed: %v", err)
+	}
+	if m.Value != 66.67 {
+		t.Errorf("Value = %v, want 66.67", m.Value)
+	}
+
+	if _, err := ParseQualityArtifact(ArtifactJUnit, []byte(`<testsuite><testcase><skipped/></testcase></testsuite>`)); err == nil {
+		t.Error("expected error when no test cases were executed")
+	}
+}
+
+func TestParseQualityArtifact_GoCover(t *testing.T) {
+	data := `mode: atomic
+example.com/a/a.go:3.10,5.2 2 1
+example.com/a/a.go:7.10,9.2 3 0
+example.com/a/b.go:1.1,2.2 5 0
+example.com/a/b.go:1.1,2.2 5 4
+`
+	m, err := ParseQualityArtifact(ArtifactGoCover, []byte(data))
+	if err != nil {
+		t.Fatalf("ParseQualityArtifact failed: %v", err)
+	}
+	if m.Kind != MeasurementCoverage || m.Value != 70 || m.Detail != "7/10 statements" {
+		t.Errorf("duplicate blocks should be merged: %+v", m)
+	}
+
+	if _, err := ParseQualityArtifact(ArtifactGoCover, []byte("mode: set\nbroken line\n")); err == nil {
+		t.Error("expected error for malformed profile")
+	}
+}
+
+func TestParseQualityArtifact_SARIF(t *testing.T) {
+	data := `{"version":"2.1.0","runs":[
+  {"results":[{"level":"error"},{"level":"warning"},{}]},
+  {"results":[{"level":"note"},{"level":"none"},{"level":"error","suppressions":[{"kind":"inSource"}]}]}
+]}`
+	m, err := ParseQualityArtifact(ArtifactSARIF, []byte(data))
+	if err != nil {
+		t.Fatalf("ParseQualityArtifact failed: %v", err)
+	}
+	if m.Kind != MeasurementLintIssues || m.Value != 4 || m.Detail != "error: 1, warning: 2, note: 1" {
+		t.Errorf("unexpected measurement: %+v", m)
+	}
+
+	if _, err := ParseQualityArtifact("pdf", nil); err == nil {
+		t.Error("expected error for unknown format")
+	}
+}
+
+func TestIngestQualityMeasurement(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	obj, err := z.Add(ctx, "objective", "Ship")
+	if err != nil {
+		t.Fatalf("Add objective failed: %v", err)
+	}
+	qual, err := z.Add(ctx, "quality", "Code quality", WithQualityObjective(obj.ID), WithQualityMetrics([]QualityMetric{
+		{ID: "metric-1", Name: "Code Coverage", Target: 80, Unit: "%", Status: MetricStatusInProgress},
+		{ID: "metric-2", Name: "lint", Target: 0, Status: MetricStatusInProgress},
+		{ID: "metric-3", Name: "latency", Target: 100, Unit: "ms", Status: MetricStatusInProgress},
+	}))
+	if err != nil {
+		t.Fatalf("Add quality failed: %v", err)
+	}
+	handler, _ := z.entityRegistry.Get("quality")
+	metric := func(id string) QualityMetric {
+		q, _ := handler.Get(ctx, qual.ID)
+		return *findQualityMetric(q.(*QualityEntity), id)
+	}
+
+	// 名前による自動対応付け（dry-run は書き込まない）
+	coverage := &QualityMeasurement{Kind: MeasurementCoverage, Value: 85.5, Unit: "%"}
+	updates, err := z.IngestQualityMeasurement(ctx, coverage, nil, true)
+	if err != nil {
+		t.Fatalf("IngestQualityMeasurement dry-run failed: %v", err)
+	}
+	if len(updates) != 1 || updates[0].MetricID != "metric-1" || updates[0].Status != MetricStatusMet {
+		t.Fatalf("unexpected updates: %+v", updates)
+	}
+	if metric("metric-1").Current != 0 {
+		t.Error("dry-run should not update the metric")
+	}
+	if _, err := z.IngestQualityMeasurement(ctx, coverage, nil, false); err != nil {
+		t.Fatalf("IngestQualityMeasurement failed: %v", err)
+	}
+	if m := metric("metric-1"); m.Current != 85.5 || m.Status != MetricStatusMet {
+		t.Errorf("metric should be updated: %+v", m)
+	}
+
+	// 指摘件数は目標値以下で met
+	lint := &QualityMeasurement{Kind: MeasurementLintIssues, Value: 3}
+	if _, err := z.IngestQualityMeasurement(ctx, lint, nil, false); err != nil {
+		t.Fatalf("IngestQualityMeasurement failed: %v", err)
+	}
+	if m := metric("metric-2"); m.Current != 3 || m.Status != MetricStatusNotMet {
+		t.Errorf("lint metric should be not_met: %+v", m)
+	}
+
+	// 明示的な指定
+	targets := []QualityMetricTarget{{QualityID: qual.ID, MetricID: "metric-3"}}
+	if _, err := z.IngestQualityMeasurement(ctx, &QualityMeasurement{Kind: MeasurementTestPassRate, Value: 100}, targets, false); err != nil {
+		t.Fatalf("IngestQualityMeasurement failed: %v", err)
+	}
+	if m := metric("metric-3"); m.Current != 100 || m.Status != MetricStatusMet {
+		t.Errorf("explicit target should be updated: %+v", m)
+	}
+
+	// 対応するメトリクスが無い・存在しない指定はエラー
+	if _, err := z.IngestQualityMeasurement(ctx, &QualityMeasurement{Kind: MeasurementTestPassRate, Value: 90}, nil, false); err == nil {
+		t.Error("expected error when no metric matches")
+	}
+	if _, err := z.IngestQualityMeasurement(ctx, coverage, []QualityMetricTarget{{QualityID: qual.ID, MetricID: "metric-9"}}, false); err == nil {
+		t.Error("expected error for unknown metric")
+	}
+}
+
+func TestParseQualityMetricTarget(t *testing.T) {
+	if got, err := ParseQualityMetricTarget("qual-1:metric-2"); err != nil || got.QualityID != "qual-1" || got.MetricID != "metric-2" {
+		t.Errorf("unexpected result: %+v, %v", got, err)
+	}
+	for _, s := range []string{"qual-1", ":metric-1", "qual-1:"} {
+		if _, err := ParseQualityMetricTarget(s); err == nil {
+			t.Errorf("expected error for %q", s)
+		}
+	}
+}
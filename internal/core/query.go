@@ -0,0 +1,493 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ErrInvalidQuery はクエリ式の構文エラー
+var ErrInvalidQuery = errors.New("invalid query")
+
+// Query はエンティティの一覧を絞り込むクエリ式
+//
+// 構文:
+//
+//	status in (pending,blocked) and due < 2025-07-01 and assignee = alice
+//	title = '認証*' or not (priority = low)
+//	metadata.created_at >= 2025-01-01 and tags != legacy
+//
+// 比較演算子は = != < <= > >= と in (...) / not in (...)、論理演算子は and / or / not（優先順位は not > and > or）。
+// = / != / in の値には * と ? のワイルドカードを使える。< などは両辺が数値なら数値、それ以外は文字列として比較する
+// （日付は YYYY-MM-DD / RFC3339 のため文字列の比較で前後関係を判定できる）。
+// フィールドは . で入れ子をたどり（配列は各要素をたどる）、値が配列の場合はいずれかの要素が条件を満たせば一致とする。
+// トップレベルに無いフィールドは metadata の同名フィールド（created_at など）を参照する。
+type Query struct {
+	source string
+	expr   queryExpr
+}
+
+// ParseQuery はクエリ式を解析する
+func ParseQuery(source string) (*Query, error) {
+	tokens, err := tokenizeQuery(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &queryParser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != queryTokenEOF {
+		return nil, p.errorf(tok, "unexpected %q", tok.text)
+	}
+	return &Query{source: source, expr: expr}, nil
+}
+
+// String はクエリ式の文字列を返す
+func (q *Query) String() string {
+	return q.source
+}
+
+// Match はエンティティのフィールドがクエリ式を満たすか
+func (q *Query) Match(fields map[string]any) bool {
+	return q.expr.match(fields)
+}
+
+// QueryResult はクエリ式で絞り込んだエンティティの一覧
+type QueryResult struct {
+	Entity string           `json:"entity"`
+	Query  string           `json:"query,omitempty"`
+	Items  []map[string]any `json:"items"` // YAML の内容（ID 順）
+	Total  int              `json:"total"`
+}
+
+// queryEntityFiles は単一ファイルで管理するエンティティのファイルと一覧のキー
+var queryEntityFiles = map[string]struct{ file, key string }{
+	"vision":     {"vision.yaml", ""},
+	"constraint": {"constraints.yaml", "constraints"},
+	"actor":      {"actors.yaml", "actors"},
+	"subsystem":  {"subsystems.yaml", "subsystems"},
+	"container":  {containersFileName, "containers"},
+	"component":  {componentsFileName, "components"},
+}
+
+// QueryEntities は entity のエンティティのうちクエリ式を満たすものを返す（q が nil の場合はすべて）
+func (z *Zeus) QueryEntities(ctx context.Context, entity string, q *Query) (*QueryResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	switch entity {
+	case "", "activities", "task", "tasks":
+		// List と同様に Task は Activity として扱う
+		entity = "activity"
+	}
+	dir, ok := GetEntityDirectory(entity)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEntity, entity)
+	}
+
+	var all []map[string]any
+	if dir != "" {
+		var readErr error
+		z.forEachYaml(ctx, dir, func(path string) {
+			var fields map[string]any
+			if err := z.fileStore.ReadYaml(ctx, path, &fields); err != nil {
+				readErr = fmt.Errorf("failed to read %s: %w", path, err)
+				return
+			}
+			all = append(all, fields)
+		})
+		if readErr != nil {
+			return nil, readErr
+		}
+	} else if src, ok := queryEntityFiles[entity]; ok && z.fileStore.Exists(ctx, src.file) {
+		var doc map[string]any
+		if err := z.fileStore.ReadYaml(ctx, src.file, &doc); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", src.file, err)
+		}
+		if src.key == "" {
+			all = append(all, doc)
+		} else if items, ok := doc[src.key].([]any); ok {
+			for _, item := range items {
+				if fields, ok := item.(map[string]any); ok {
+					all = append(all, fields)
+				}
+			}
+		}
+	}
+
+	result := &QueryResult{Entity: entity, Items: []map[string]any{}}
+	if q != nil {
+		result.Query = q.String()
+	}
+	for _, fields := range all {
+		if q == nil || q.Match(fields) {
+			result.Items = append(result.Items, fields)
+		}
+	}
+	sort.SliceStable(result.Items, func(i, j int) bool {
+		return fmt.Sprint(result.Items[i]["id"]) < fmt.Sprint(result.Items[j]["id"])
+	})
+	result.Total = len(result.Items)
+	return result, nil
+}
+
+// queryExpr はクエリ式の構文木のノード
+type queryExpr interface {
+	match(fields map[string]any) bool
+}
+
+type queryAnd struct{ left, right queryExpr }
+
+func (e queryAnd) match(fields map[string]any) bool {
+	return e.left.match(fields) && e.right.match(fields)
+}
+
+type queryOr struct{ left, right queryExpr }
+
+func (e queryOr) match(fields map[string]any) bool {
+	return e.left.match(fields) || e.right.match(fields)
+}
+
+type queryNot struct{ expr queryExpr }
+
+func (e queryNot) match(fields map[string]any) bool {
+	return !e.expr.match(fields)
+}
+
+// queryCompare はフィールドと値の比較（in の場合は values に複数の値）
+type queryCompare struct {
+	field  string
+	op     string // =, !=, <, <=, >, >=, in, not in
+	values []string
+}
+
+func (e queryCompare) match(fields map[string]any) bool {
+	actual := queryFieldValues(fields, e.field)
+	switch e.op {
+	case "!=":
+		return !anyQueryValue(actual, func(v string) bool { return queryEqual(v, e.values[0]) })
+	case "not in":
+		return !anyQueryValue(actual, func(v string) bool { return queryIn(v, e.values) })
+	case "in":
+		return anyQueryValue(actual, func(v string) bool { return queryIn(v, e.values) })
+	case "=":
+		return anyQueryValue(actual, func(v string) bool { return queryEqual(v, e.values[0]) })
+	}
+	return anyQueryValue(actual, func(v string) bool {
+		c := queryCompareValues(v, e.values[0])
+		switch e.op {
+		case "<":
+			return c < 0
+		case "<=":
+			return c <= 0
+		case ">":
+			return c > 0
+		default: // >=
+			return c >= 0
+		}
+	})
+}
+
+func anyQueryValue(values []string, fn func(string) bool) bool {
+	for _, v := range values {
+		if fn(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func queryIn(actual string, values []string) bool {
+	for _, v := range values {
+		if queryEqual(actual, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// queryEqual は値が等しいか（expected に * / ? を含む場合はワイルドカードとして照合）
+func queryEqual(actual, expected string) bool {
+	if !strings.ContainsAny(expected, "*?") {
+		return actual == expected
+	}
+	pattern := regexp.QuoteMeta(expected)
+	pattern = strings.NewReplacer(`\*`, ".*", `\?`, ".").Replace(pattern)
+	return regexp.MustCompile("^" + pattern + "$").MatchString(actual)
+}
+
+// queryCompareValues は両辺が数値なら数値、それ以外は文字列として比較する
+func queryCompareValues(actual, expected string) int {
+	a, errA := strconv.ParseFloat(actual, 64)
+	b, errB := strconv.ParseFloat(expected, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(actual, expected)
+}
+
+// queryFieldValues はフィールドの値を文字列で返す（配列は各要素。フィールドが無い場合は空）
+func queryFieldValues(fields map[string]any, field string) []string {
+	path := strings.Split(field, ".")
+	values := walkQueryField(fields, path)
+	if len(values) == 0 && len(path) == 1 {
+		if metadata, ok := fields["metadata"].(map[string]any); ok {
+			values = walkQueryField(metadata, path)
+		}
+	}
+	return values
+}
+
+func walkQueryField(value any, path []string) []string {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []any:
+		var values []string
+		for _, item := range v {
+			values = append(values, walkQueryField(item, path)...)
+		}
+		return values
+	case map[string]any:
+		if len(path) == 0 {
+			return nil
+		}
+		return walkQueryField(v[path[0]], path[1:])
+	}
+	if len(path) > 0 {
+		return nil
+	}
+	if t, ok := value.(time.Time); ok {
+		if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0 {
+			return []string{t.Format("2006-01-02")}
+		}
+		return []string{t.Format(time.RFC3339)}
+	}
+	return []string{fmt.Sprint(value)}
+}
+
+// クエリ式のトークン
+type queryTokenKind int
+
+const (
+	queryTokenEOF queryTokenKind = iota
+	queryTokenWord
+	queryTokenString // 引用符で囲んだ値
+	queryTokenOp     // = != < <= > >=
+	queryTokenLParen
+	queryTokenRParen
+	queryTokenComma
+)
+
+type queryToken struct {
+	kind queryTokenKind
+	text string
+	pos  int
+}
+
+// keyword はトークンがキーワード（and, or, not, in）か（大文字小文字を区別しない）
+func (t queryToken) keyword(word string) bool {
+	return t.kind == queryTokenWord && strings.EqualFold(t.text, word)
+}
+
+func tokenizeQuery(source string) ([]queryToken, error) {
+	var tokens []queryToken
+	runes := []rune(source)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, queryToken{queryTokenLParen, "(", i})
+			i++
+		case r == ')':
+			tokens = append(tokens, queryToken{queryTokenRParen, ")", i})
+			i++
+		case r == ',':
+			tokens = append(tokens, queryToken{queryTokenComma, ",", i})
+			i++
+		case r == '=' || r == '<' || r == '>' || r == '!':
+			op := string(r)
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, fmt.Errorf("%w: unexpected '!' at position %d (use !=)", ErrInvalidQuery, i+1)
+			}
+			tokens = append(tokens, queryToken{queryTokenOp, op, i})
+			i += len(op)
+		case r == '\'' || r == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != r {
+				end++
+			}
+			if end == len(runes) {
+				return nil, fmt.Errorf("%w: unterminated string at position %d", ErrInvalidQuery, i+1)
+			}
+			tokens = append(tokens, queryToken{queryTokenString, string(runes[i+1 : end]), i})
+			i = end + 1
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune("(),=<>!'\"", runes[i]) {
+				i++
+			}
+			tokens = append(tokens, queryToken{queryTokenWord, string(runes[start:i]), start})
+		}
+	}
+	return append(tokens, queryToken{queryTokenEOF, "", len(runes)}), nil
+}
+
+// queryParser は再帰下降でクエリ式を解析する
+type queryParser struct {
+	tokens []queryToken
+	pos    int
+}
+
+func (p *queryParser) peek() queryToken {
+	return p.tokens[p.pos]
+}
+
+func (p *queryParser) next() queryToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != queryTokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *queryParser) errorf(tok queryToken, format string, args ...any) error {
+	if tok.kind == queryTokenEOF {
+		return fmt.Errorf("%w: %s at end of query", ErrInvalidQuery, fmt.Sprintf(format, args...))
+	}
+	return fmt.Errorf("%w: %s at position %d", ErrInvalidQuery, fmt.Sprintf(format, args...), tok.pos+1)
+}
+
+func (p *queryParser) parseOr() (queryExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().keyword("or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = queryOr{left, right}
+	}
+	return left, nil
+}
+
+func (p *queryParser) parseAnd() (queryExpr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().keyword("and") {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = queryAnd{left, right}
+	}
+	return left, nil
+}
+
+func (p *queryParser) parseNot() (queryExpr, error) {
+	if p.peek().keyword("not") {
+		p.next()
+		expr, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return queryNot{expr}, nil
+	}
+	if p.peek().kind == queryTokenLParen {
+		p.next()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if tok := p.next(); tok.kind != queryTokenRParen {
+			return nil, p.errorf(tok, "expected ')'")
+		}
+		return expr, nil
+	}
+	return p.parseCompare()
+}
+
+func (p *queryParser) parseCompare() (queryExpr, error) {
+	field := p.next()
+	if field.kind != queryTokenWord || field.keyword("and") || field.keyword("or") || field.keyword("in") {
+		return nil, p.errorf(field, "expected field name")
+	}
+
+	tok := p.next()
+	switch {
+	case tok.kind == queryTokenOp:
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		return queryCompare{field: field.text, op: tok.text, values: []string{value}}, nil
+	case tok.keyword("in"):
+		values, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+		return queryCompare{field: field.text, op: "in", values: values}, nil
+	case tok.keyword("not") && p.peek().keyword("in"):
+		p.next()
+		values, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+		return queryCompare{field: field.text, op: "not in", values: values}, nil
+	}
+	return nil, p.errorf(tok, "expected operator after %q (=, !=, <, <=, >, >=, in, not in)", field.text)
+}
+
+func (p *queryParser) parseValue() (string, error) {
+	tok := p.next()
+	if tok.kind != queryTokenWord && tok.kind != queryTokenString {
+		return "", p.errorf(tok, "expected value")
+	}
+	return tok.text, nil
+}
+
+func (p *queryParser) parseList() ([]string, error) {
+	if tok := p.next(); tok.kind != queryTokenLParen {
+		return nil, p.errorf(tok, "expected '(' after in")
+	}
+	var values []string
+	for {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		tok := p.next()
+		if tok.kind == queryTokenRParen {
+			return values, nil
+		}
+		if tok.kind != queryTokenComma {
+			return nil, p.errorf(tok, "expected ',' or ')'")
+		}
+	}
+}
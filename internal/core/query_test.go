@@ -0,0 +1,122 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestQuery_Match(t *testing.T) {
+	fields := map[string]any{
+		"id":       "act-1",
+		"title":    "認証フロー",
+		"status":   "pending",
+		"assignee": "alice",
+		"due":      "2025-06-15",
+		"effort":   3,
+		"tags":     []any{"backend", "auth"},
+		"actors":   []any{map[string]any{"actor_id": "actor-1"}, map[string]any{"actor_id": "actor-2"}},
+		"metadata": map[string]any{"created_at": "2025-01-10T09:00:00Z"},
+	}
+
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"status in (pending,blocked) and due < 2025-07-01 and assignee = alice", true},
+		{"status in (done, blocked)", false},
+		{"status not in (done, blocked)", true},
+		{"assignee != alice", false},
+		{"owner != alice", true},
+		{"owner = alice", false},
+		{"title = '認証*'", true},
+		{"title = '*フロ?'", true},
+		{"effort >= 3 and effort < 10", true},
+		{"effort > 10", false},
+		{"tags = auth", true},
+		{"tags != auth", false},
+		{"actors.actor_id = actor-2", true},
+		{"created_at >= 2025-01-01", true},
+		{"metadata.created_at < 2025-01-01", false},
+		{"status = done or assignee = alice", true},
+		{"not (status = done or assignee = bob)", true},
+		{"status = done or assignee = alice and due > 2025-07-01", false},
+		{"(status = done or assignee = alice) AND NOT due > 2025-07-01", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			q, err := ParseQuery(tt.query)
+			if err != nil {
+				t.Fatalf("ParseQuery failed: %v", err)
+			}
+			if got := q.Match(fields); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseQuery_Errors(t *testing.T) {
+	for _, source := range []string{
+		"",
+		"status",
+		"status = ",
+		"status in pending",
+		"status in (pending",
+		"(status = done",
+		"status = done and",
+		"status ! done",
+		"title = 'unterminated",
+		"status = done extra",
+	} {
+		if _, err := ParseQuery(source); !errors.Is(err, ErrInvalidQuery) {
+			t.Errorf("ParseQuery(%q) expected ErrInvalidQuery, got %v", source, err)
+		}
+	}
+}
+
+func TestQueryEntities(t *testing.T) {
+	ctx := context.Background()
+	z, ids := setupDeletePolicyTest(t)
+	if _, err := z.Add(ctx, "risk", "ベンダー撤退", WithRiskObjective(ids["objective"])); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := z.Add(ctx, "actor", "管理者"); err != nil {
+		t.Fatal(err)
+	}
+
+	q, err := ParseQuery("title = '移行*' and objective_id = " + ids["objective"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := z.QueryEntities(ctx, "risk", q)
+	if err != nil {
+		t.Fatalf("QueryEntities failed: %v", err)
+	}
+	if result.Total != 1 || result.Items[0]["id"] != ids["risk"] || result.Query != q.String() {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	// 単一ファイルで管理するエンティティ
+	q, _ = ParseQuery("title = 購入者")
+	result, err = z.QueryEntities(ctx, "actor", q)
+	if err != nil {
+		t.Fatalf("QueryEntities failed: %v", err)
+	}
+	if result.Total != 1 || result.Items[0]["id"] != ids["actor"] {
+		t.Errorf("unexpected actors: %+v", result)
+	}
+
+	// Task は Activity として扱う
+	result, err = z.QueryEntities(ctx, "task", nil)
+	if err != nil {
+		t.Fatalf("QueryEntities failed: %v", err)
+	}
+	if result.Entity != "activity" || result.Total != 1 {
+		t.Errorf("unexpected activities: %+v", result)
+	}
+
+	if _, err := z.QueryEntities(ctx, "unknown", nil); !errors.Is(err, ErrUnknownEntity) {
+		t.Errorf("expected ErrUnknownEntity, got %v", err)
+	}
+}
@@ -0,0 +1,173 @@
+package core
+
+import (
+	"context"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+)
+
+// BuildRelationGraph は 10 概念モデルと UML 要素の参照関係グラフを構築
+// エッジは参照元から参照先への向き（例: Decision → Consideration → Objective → Vision）
+func (z *Zeus) BuildRelationGraph(ctx context.Context) (*analysis.RelationGraph, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	graph := analysis.NewRelationGraph()
+
+	// Vision は単一で、全 Objective の上位に位置する
+	var vision Vision
+	if z.fileStore.Exists(ctx, "vision.yaml") {
+		if err := z.fileStore.ReadYaml(ctx, "vision.yaml", &vision); err != nil {
+			return nil, err
+		}
+		graph.AddNodeWithStatus(vision.ID, "vision", vision.Title, string(vision.Status))
+	}
+
+	z.forEachYaml(ctx, "objectives", func(path string) {
+		var obj ObjectiveEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &obj); err == nil {
+			graph.AddNodeWithStatus(obj.ID, "objective", obj.Title, string(obj.Status))
+			graph.AddEdge(obj.ID, vision.ID, "vision")
+		}
+	})
+	z.forEachYaml(ctx, "considerations", func(path string) {
+		var c ConsiderationEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &c); err == nil {
+			graph.AddNodeWithStatus(c.ID, "consideration", c.Title, string(c.Status))
+			graph.AddEdge(c.ID, c.ObjectiveID, "objective_id")
+			graph.AddEdge(c.ID, c.DecisionID, "decision_id")
+		}
+	})
+	// 置き換えられた Decision は状態 superseded として扱う（置き換えた Decision から supersedes のエッジ）
+	supersededBy, err := z.DecisionSupersededBy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	z.forEachYaml(ctx, "decisions", func(path string) {
+		var d DecisionEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &d); err == nil {
+			if _, ok := supersededBy[d.ID]; ok {
+				graph.AddNodeWithStatus(d.ID, "decision", d.Title, "superseded")
+			} else {
+				graph.AddNode(d.ID, "decision", d.Title)
+			}
+			graph.AddEdge(d.ID, d.ConsiderationID, "consideration_id")
+			graph.AddEdge(d.ID, d.Supersedes, "supersedes")
+		}
+	})
+	z.forEachYaml(ctx, "problems", func(path string) {
+		var p ProblemEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &p); err == nil {
+			graph.AddNodeWithStatus(p.ID, "problem", p.Title, string(p.Status))
+			graph.AddEdge(p.ID, p.ObjectiveID, "objective_id")
+			for _, id := range p.CauseEntityIDs() {
+				graph.AddEdge(p.ID, id, "causes")
+			}
+		}
+	})
+	z.forEachYaml(ctx, "risks", func(path string) {
+		var r RiskEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &r); err == nil {
+			graph.AddNodeWithStatus(r.ID, "risk", r.Title, string(r.Status))
+			graph.AddEdge(r.ID, r.ObjectiveID, "objective_id")
+		}
+	})
+	z.forEachYaml(ctx, "assumptions", func(path string) {
+		var a AssumptionEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &a); err == nil {
+			graph.AddNodeWithStatus(a.ID, "assumption", a.Title, string(a.Status))
+			graph.AddEdge(a.ID, a.ObjectiveID, "objective_id")
+		}
+	})
+	z.forEachYaml(ctx, "quality", func(path string) {
+		var q QualityEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &q); err == nil {
+			graph.AddNode(q.ID, "quality", q.Title)
+			graph.AddEdge(q.ID, q.ObjectiveID, "objective_id")
+		}
+	})
+
+	// 単一ファイルで管理するエンティティ（参照を持たないが経路の端点として扱う）
+	var constraints ConstraintsFile
+	var actors ActorsFile
+	var subsystems SubsystemsFile
+	for file, out := range map[string]any{"constraints.yaml": &constraints, "actors.yaml": &actors, "subsystems.yaml": &subsystems} {
+		if !z.fileStore.Exists(ctx, file) {
+			continue
+		}
+		if err := z.fileStore.ReadYaml(ctx, file, out); err != nil {
+			return nil, err
+		}
+	}
+	for _, c := range constraints.Constraints {
+		graph.AddNode(c.ID, "constraint", c.Title)
+	}
+	for _, a := range actors.Actors {
+		graph.AddNode(a.ID, "actor", a.Title)
+	}
+	for _, s := range subsystems.Subsystems {
+		graph.AddNode(s.ID, "subsystem", s.Name)
+	}
+
+	z.forEachYaml(ctx, "usecases", func(path string) {
+		var uc UseCaseEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &uc); err == nil {
+			graph.AddNodeWithStatus(uc.ID, "usecase", uc.Title, string(uc.Status))
+			graph.AddEdge(uc.ID, uc.ObjectiveID, "objective_id")
+			graph.AddEdge(uc.ID, uc.SubsystemID, "subsystem_id")
+			for _, ref := range uc.Actors {
+				graph.AddEdge(uc.ID, ref.ActorID, "actor")
+			}
+			for _, rel := range uc.Relations {
+				graph.AddEdge(uc.ID, rel.TargetID, string(rel.Type))
+			}
+		}
+	})
+	z.forEachYaml(ctx, "activities", func(path string) {
+		var act ActivityEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &act); err == nil {
+			graph.AddNodeWithStatus(act.ID, "activity", act.Title, string(act.Status))
+			graph.AddEdge(act.ID, act.UseCaseID, "usecase_id")
+		}
+	})
+	z.forEachYaml(ctx, "statemachines", func(path string) {
+		var sm StateMachineEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &sm); err == nil {
+			graph.AddNodeWithStatus(sm.ID, "statemachine", sm.Title, string(sm.Status))
+			graph.AddEdge(sm.ID, sm.UseCaseID, "usecase_id")
+			for _, state := range sm.States {
+				graph.AddEdge(sm.ID, state.ActivityID, "activity_id")
+			}
+		}
+	})
+	z.forEachYaml(ctx, "releases", func(path string) {
+		var rel ReleaseEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &rel); err == nil {
+			graph.AddNodeWithStatus(rel.ID, "release", rel.Title, string(rel.Status))
+			for _, id := range rel.UseCaseIDs {
+				graph.AddEdge(rel.ID, id, "usecase_ids")
+			}
+			for _, id := range rel.ActivityIDs {
+				graph.AddEdge(rel.ID, id, "activity_ids")
+			}
+			for _, id := range rel.QualityIDs {
+				graph.AddEdge(rel.ID, id, "quality_ids")
+			}
+		}
+	})
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return graph, nil
+}
+
+// FindRelationPath は 2 つのエンティティを結ぶ参照関係の最短経路を返す
+// from から参照をたどって to に到達できる経路を優先し、無い場合は参照の向きを問わない経路を探す
+func (z *Zeus) FindRelationPath(ctx context.Context, from, to string) (*analysis.RelationPath, error) {
+	graph, err := z.BuildRelationGraph(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return graph.FindPath(from, to)
+}
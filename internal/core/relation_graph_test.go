@@ -0,0 +1,50 @@
+package core
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFindRelationPath(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	add := func(entity, title string, opts ...EntityOption) string {
+		t.Helper()
+		result, err := z.Add(ctx, entity, title, opts...)
+		if err != nil {
+			t.Fatalf("Add %s failed: %v", title, err)
+		}
+		return result.ID
+	}
+	vision := add("vision", "Vision", WithVisionStatement("statement"))
+	obj := add("objective", "決済刷新")
+	con := add("consideration", "決済基盤の選定", WithConsiderationObjective(obj))
+	dec := add("decision", "Stripe を採用",
+		WithDecisionConsideration(con),
+		WithDecisionSelected(SelectedOption{OptionID: "opt-1", Title: "Stripe"}),
+		WithDecisionRationale("導入実績"))
+	risk := add("risk", "移行遅延", WithRiskObjective(obj))
+
+	path, err := z.FindRelationPath(ctx, dec, vision)
+	if err != nil {
+		t.Fatalf("FindRelationPath failed: %v", err)
+	}
+	if !path.Found || !path.Directed || len(path.Steps) != 3 {
+		t.Fatalf("decision should depend on vision through consideration and objective: %+v", path)
+	}
+	if path.Steps[0].Relation != "consideration_id" || path.Steps[1].Relation != "objective_id" {
+		t.Errorf("unexpected relations: %+v", path.Steps)
+	}
+
+	path, err = z.FindRelationPath(ctx, risk, dec)
+	if err != nil {
+		t.Fatalf("FindRelationPath failed: %v", err)
+	}
+	if !path.Found || path.Directed || len(path.Steps) != 3 || path.Steps[0].To.ID != obj {
+		t.Errorf("risk and decision should be related through the objective: %+v", path)
+	}
+}
@@ -0,0 +1,358 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ReleaseHandler はリリースエンティティのハンドラー
+type ReleaseHandler struct {
+	fileStore       FileStore
+	usecaseHandler  *UseCaseHandler
+	activityHandler *ActivityHandler
+	qualityHandler  *QualityHandler
+	index           *entityIndex
+}
+
+// NewReleaseHandler は ReleaseHandler を生成
+func NewReleaseHandler(fs FileStore, usecaseHandler *UseCaseHandler, activityHandler *ActivityHandler, qualityHandler *QualityHandler) *ReleaseHandler {
+	return &ReleaseHandler{
+		fileStore:       fs,
+		usecaseHandler:  usecaseHandler,
+		activityHandler: activityHandler,
+		qualityHandler:  qualityHandler,
+		index:           newEntityIndex(fs, "release", "releases"),
+	}
+}
+
+// Type はエンティティタイプを返す
+func (h *ReleaseHandler) Type() string {
+	return "release"
+}
+
+// Add はリリースを追加
+func (h *ReleaseHandler) Add(ctx context.Context, name string, opts ...EntityOption) (*AddResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// releases ディレクトリを確保
+	if err := h.fileStore.EnsureDir(ctx, "releases"); err != nil {
+		return nil, fmt.Errorf("failed to ensure releases directory: %w", err)
+	}
+
+	id := h.generateReleaseID()
+	now := Now()
+
+	release := ReleaseEntity{
+		ID:     id,
+		Title:  name,
+		Status: ReleaseStatusPlanned,
+		Metadata: Metadata{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+
+	// オプション適用
+	for _, opt := range opts {
+		opt(&release)
+	}
+
+	// バリデーション
+	if err := release.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 参照整合性チェック
+	if err := h.checkReferences(ctx, &release); err != nil {
+		return nil, err
+	}
+
+	// 個別ファイルに保存
+	filePath := filepath.Join("releases", id+".yaml")
+	if err := h.writeRelease(ctx, filePath, &release); err != nil {
+		return nil, err
+	}
+
+	return &AddResult{
+		Success: true,
+		ID:      id,
+		Entity:  h.Type(),
+	}, nil
+}
+
+// List はリリース一覧を取得
+func (h *ReleaseHandler) List(ctx context.Context, filter *ListFilter) (*ListResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// releases ディレクトリが存在しない場合は空リストを返す
+	if !h.fileStore.Exists(ctx, "releases") {
+		return &ListResult{
+			Entity: h.Type(),
+			Items:  []ListItem{},
+			Total:  0,
+		}, nil
+	}
+
+	files, err := h.fileStore.ListDir(ctx, "releases")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list releases directory: %w", err)
+	}
+
+	entries := h.index.Entries(ctx, files, func(file string) (IndexEntry, bool) {
+		var release ReleaseEntity
+		if err := h.fileStore.ReadYaml(ctx, filepath.Join("releases", file), &release); err != nil {
+			return IndexEntry{}, false
+		}
+		return releaseIndexEntry(&release), true
+	})
+	items := indexEntriesToListItems(entries)
+
+	return &ListResult{
+		Entity: h.Type(),
+		Items:  items,
+		Total:  len(items),
+	}, nil
+}
+
+// Get はリリースを取得
+func (h *ReleaseHandler) Get(ctx context.Context, id string) (any, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// ID のセキュリティ検証
+	if err := ValidateID("release", id); err != nil {
+		return nil, err
+	}
+
+	filePath := filepath.Join("releases", id+".yaml")
+	if !h.fileStore.Exists(ctx, filePath) {
+		return nil, ErrEntityNotFound
+	}
+
+	var release ReleaseEntity
+	if err := h.fileStore.ReadYaml(ctx, filePath, &release); err != nil {
+		return nil, fmt.Errorf("failed to read release file: %w", err)
+	}
+
+	return &release, nil
+}
+
+// Update はリリースを更新
+func (h *ReleaseHandler) Update(ctx context.Context, id string, update any) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	existing, err := h.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	existingRelease := existing.(*ReleaseEntity)
+
+	release, ok := update.(*ReleaseEntity)
+	if !ok {
+		return fmt.Errorf("invalid update type: expected *ReleaseEntity")
+	}
+	release.ID = id // ID は変更不可
+	release.Metadata.CreatedAt = existingRelease.Metadata.CreatedAt
+	release.Metadata.UpdatedAt = Now()
+
+	// バリデーション
+	if err := release.Validate(); err != nil {
+		return err
+	}
+
+	// 参照整合性チェック
+	if err := h.checkReferences(ctx, release); err != nil {
+		return err
+	}
+
+	return h.writeRelease(ctx, filepath.Join("releases", id+".yaml"), release)
+}
+
+// Delete はリリースを削除
+func (h *ReleaseHandler) Delete(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// ID のセキュリティ検証
+	if err := ValidateID("release", id); err != nil {
+		return err
+	}
+
+	filePath := filepath.Join("releases", id+".yaml")
+	if !h.fileStore.Exists(ctx, filePath) {
+		return ErrEntityNotFound
+	}
+
+	if err := h.fileStore.Delete(ctx, filePath); err != nil {
+		return err
+	}
+	return h.index.Remove(ctx, id)
+}
+
+// getAllReleases は全リリースを取得
+func (h *ReleaseHandler) getAllReleases(ctx context.Context) ([]*ReleaseEntity, error) {
+	files, err := h.fileStore.ListDir(ctx, "releases")
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*ReleaseEntity{}, nil
+		}
+		return nil, err
+	}
+
+	var releases []*ReleaseEntity
+	for _, file := range files {
+		if !strings.HasSuffix(file, ".yaml") {
+			continue
+		}
+
+		id := strings.TrimSuffix(filepath.Base(file), ".yaml")
+		if err := ValidateID("release", id); err != nil {
+			continue
+		}
+
+		filePath := filepath.Join("releases", file)
+		var release ReleaseEntity
+		if err := h.fileStore.ReadYaml(ctx, filePath, &release); err != nil {
+			if !os.IsPermission(err) {
+				return nil, fmt.Errorf("failed to read release file %s: %w", filePath, err)
+			}
+			continue
+		}
+		releases = append(releases, &release)
+	}
+
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].ID < releases[j].ID
+	})
+	return releases, nil
+}
+
+// checkReferences は含める UseCase / Activity / Quality の存在を確認
+func (h *ReleaseHandler) checkReferences(ctx context.Context, release *ReleaseEntity) error {
+	type reference struct {
+		entityType string
+		ids        []string
+		handler    EntityHandler
+	}
+	var refs []reference
+	if h.usecaseHandler != nil {
+		refs = append(refs, reference{"usecase", release.UseCaseIDs, h.usecaseHandler})
+	}
+	if h.activityHandler != nil {
+		refs = append(refs, reference{"activity", release.ActivityIDs, h.activityHandler})
+	}
+	if h.qualityHandler != nil {
+		refs = append(refs, reference{"quality", release.QualityIDs, h.qualityHandler})
+	}
+	for _, ref := range refs {
+		for _, id := range ref.ids {
+			if _, err := ref.handler.Get(ctx, id); err != nil {
+				return fmt.Errorf("referenced %s not found: %s", ref.entityType, id)
+			}
+		}
+	}
+	return nil
+}
+
+// writeRelease はリリースファイルを書き込み、インデックスを更新
+func (h *ReleaseHandler) writeRelease(ctx context.Context, filePath string, release *ReleaseEntity) error {
+	if err := h.fileStore.WriteYaml(ctx, filePath, release); err != nil {
+		return fmt.Errorf("failed to write release file: %w", err)
+	}
+	return h.index.Upsert(ctx, releaseIndexEntry(release))
+}
+
+// releaseIndexEntry はリリースからインデックスエントリを生成
+func releaseIndexEntry(release *ReleaseEntity) IndexEntry {
+	return IndexEntry{
+		ID:        release.ID,
+		Title:     release.Title,
+		Status:    string(release.Status),
+		CreatedAt: release.Metadata.CreatedAt,
+		UpdatedAt: release.Metadata.UpdatedAt,
+	}
+}
+
+// generateReleaseID はリリース ID を生成（UUID 形式）
+func (h *ReleaseHandler) generateReleaseID() string {
+	return fmt.Sprintf("rel-%s", uuid.New().String()[:8])
+}
+
+// ===== EntityOption 関数群 =====
+
+// WithReleaseDescription は説明を設定
+func WithReleaseDescription(desc string) EntityOption {
+	return func(v any) {
+		if r, ok := v.(*ReleaseEntity); ok {
+			r.Description = desc
+		}
+	}
+}
+
+// WithReleaseTargetDate は目標日（YYYY-MM-DD）を設定
+func WithReleaseTargetDate(date string) EntityOption {
+	return func(v any) {
+		if r, ok := v.(*ReleaseEntity); ok {
+			r.TargetDate = date
+		}
+	}
+}
+
+// WithReleaseUseCases は含める UseCase を設定
+func WithReleaseUseCases(ids []string) EntityOption {
+	return func(v any) {
+		if r, ok := v.(*ReleaseEntity); ok {
+			r.UseCaseIDs = ids
+		}
+	}
+}
+
+// WithReleaseActivities は個別に含める Activity を設定
+func WithReleaseActivities(ids []string) EntityOption {
+	return func(v any) {
+		if r, ok := v.(*ReleaseEntity); ok {
+			r.ActivityIDs = ids
+		}
+	}
+}
+
+// WithReleaseQualities はリリース判定に使う品質基準を設定
+func WithReleaseQualities(ids []string) EntityOption {
+	return func(v any) {
+		if r, ok := v.(*ReleaseEntity); ok {
+			r.QualityIDs = ids
+		}
+	}
+}
+
+// WithReleaseOwner はオーナーを設定
+func WithReleaseOwner(owner string) EntityOption {
+	return func(v any) {
+		if r, ok := v.(*ReleaseEntity); ok {
+			r.Metadata.Owner = owner
+		}
+	}
+}
+
+// WithReleaseTags はタグを設定
+func WithReleaseTags(tags []string) EntityOption {
+	return func(v any) {
+		if r, ok := v.(*ReleaseEntity); ok {
+			r.Metadata.Tags = tags
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// ReleaseReadiness はリリースの準備状況（go / no-go の判定）
+type ReleaseReadiness struct {
+	ID            string                `json:"id"`
+	Title         string                `json:"title"`
+	Status        ReleaseStatus         `json:"status"`
+	TargetDate    string                `json:"target_date,omitempty"`
+	DaysRemaining *int                  `json:"days_remaining"` // 目標日までの日数（過ぎた場合は負、目標日なしは null）
+	Go            bool                  `json:"go"`
+	Readiness     int                   `json:"readiness"` // 完了した Activity と通過したゲートの割合（%）
+	Activities    []ReleaseActivityItem `json:"activities"`
+	Gates         []ReleaseGateItem     `json:"gates"`
+	Blockers      []string              `json:"blockers"` // no-go の理由
+}
+
+// ReleaseActivityItem はリリースに含まれる Activity
+type ReleaseActivityItem struct {
+	ID        string         `json:"id"`
+	Title     string         `json:"title"`
+	Status    ActivityStatus `json:"status"`
+	UseCaseID string         `json:"usecase_id,omitempty"`
+	Done      bool           `json:"done"` // deprecated（完了）
+}
+
+// ReleaseGateItem はリリース判定に使う品質ゲート（ゲートの無い品質基準はメトリクスごと）
+type ReleaseGateItem struct {
+	QualityID string `json:"quality_id"`
+	Quality   string `json:"quality"`
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	Passed    bool   `json:"passed"`
+}
+
+// Releases は全リリースの準備状況を目標日の近い順（目標日なしは最後）に返す
+func (z *Zeus) Releases(ctx context.Context) ([]ReleaseReadiness, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	result := []ReleaseReadiness{}
+	z.forEachYaml(ctx, "releases", func(path string) {
+		var rel ReleaseEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &rel); err == nil {
+			result = append(result, z.releaseReadiness(ctx, &rel, now))
+		}
+	})
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i].TargetDate, result[j].TargetDate
+		if (a == "") != (b == "") {
+			return b == ""
+		}
+		if a != b {
+			return a < b
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result, nil
+}
+
+// ReleaseStatus は ID またはバージョン名（title）で指定したリリースの準備状況を返す
+func (z *Zeus) ReleaseStatus(ctx context.Context, ref string) (*ReleaseReadiness, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var found *ReleaseEntity
+	z.forEachYaml(ctx, "releases", func(path string) {
+		var rel ReleaseEntity
+		if found != nil || z.fileStore.ReadYaml(ctx, path, &rel) != nil {
+			return
+		}
+		if rel.ID == ref || rel.Title == ref {
+			found = &rel
+		}
+	})
+	if found == nil {
+		return nil, fmt.Errorf("%w: release %s", ErrEntityNotFound, ref)
+	}
+	readiness := z.releaseReadiness(ctx, found, time.Now())
+	return &readiness, nil
+}
+
+// releaseReadiness は含まれる Activity の完了状況と品質ゲートから go / no-go を判定
+// UseCase を含める場合はその UseCase に紐づく Activity をすべて含める。未完了の Activity、
+// 通過していないゲート、存在しない参照があれば no-go（中止したリリースは常に no-go）
+func (z *Zeus) releaseReadiness(ctx context.Context, rel *ReleaseEntity, now time.Time) ReleaseReadiness {
+	r := ReleaseReadiness{
+		ID:         rel.ID,
+		Title:      rel.Title,
+		Status:     rel.Status,
+		TargetDate: rel.TargetDate,
+		Activities: []ReleaseActivityItem{},
+		Gates:      []ReleaseGateItem{},
+		Blockers:   []string{},
+	}
+	if target, ok := parseDigestTime(rel.TargetDate); ok {
+		days := int(truncateDay(target).Sub(truncateDay(now)).Hours() / 24)
+		r.DaysRemaining = &days
+	}
+	// 手で編集された不正な ID などは参照を辿らずに no-go とする
+	if err := rel.Validate(); err != nil {
+		r.Blockers = append(r.Blockers, "リリースの定義が不正です: "+err.Error())
+		return r
+	}
+
+	// 参照先の存在確認
+	for _, id := range rel.UseCaseIDs {
+		if !z.fileStore.Exists(ctx, filepath.Join("usecases", id+".yaml")) {
+			r.Blockers = append(r.Blockers, "UseCase が見つかりません: "+id)
+		}
+	}
+
+	// Activity（含める UseCase に紐づくものと個別指定のもの）
+	usecases := map[string]bool{}
+	for _, id := range rel.UseCaseIDs {
+		usecases[id] = true
+	}
+	explicit := map[string]bool{}
+	for _, id := range rel.ActivityIDs {
+		explicit[id] = true
+	}
+	z.forEachYaml(ctx, "activities", func(path string) {
+		var act ActivityEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &act); err != nil {
+			return
+		}
+		if !usecases[act.UseCaseID] && !explicit[act.ID] {
+			return
+		}
+		delete(explicit, act.ID)
+		r.Activities = append(r.Activities, ReleaseActivityItem{
+			ID:        act.ID,
+			Title:     act.Title,
+			Status:    act.Status,
+			UseCaseID: act.UseCaseID,
+			Done:      act.Status == ActivityStatusDeprecated,
+		})
+	})
+	sort.Slice(r.Activities, func(i, j int) bool { return r.Activities[i].ID < r.Activities[j].ID })
+	for _, id := range rel.ActivityIDs {
+		if explicit[id] {
+			r.Blockers = append(r.Blockers, "Activity が見つかりません: "+id)
+		}
+	}
+
+	// 品質ゲート（ゲートが無い品質基準はメトリクスの達成で判定）
+	for _, id := range rel.QualityIDs {
+		var q QualityEntity
+		if err := z.fileStore.ReadYaml(ctx, filepath.Join("quality", id+".yaml"), &q); err != nil {
+			r.Blockers = append(r.Blockers, "品質基準が見つかりません: "+id)
+			continue
+		}
+		for _, g := range q.Gates {
+			r.Gates = append(r.Gates, ReleaseGateItem{QualityID: q.ID, Quality: q.Title, Name: g.Name, Status: string(g.Status), Passed: g.Status == GateStatusPassed})
+		}
+		if len(q.Gates) == 0 {
+			for _, m := range q.Metrics {
+				r.Gates = append(r.Gates, ReleaseGateItem{QualityID: q.ID, Quality: q.Title, Name: m.Name, Status: string(m.Status), Passed: m.Status == MetricStatusMet})
+			}
+		}
+	}
+
+	done := 0
+	for _, a := range r.Activities {
+		if a.Done {
+			done++
+			continue
+		}
+		r.Blockers = append(r.Blockers, fmt.Sprintf("未完了の Activity: %s %s [%s]", a.ID, a.Title, a.Status))
+	}
+	for _, g := range r.Gates {
+		if g.Passed {
+			done++
+			continue
+		}
+		r.Blockers = append(r.Blockers, fmt.Sprintf("品質ゲート未通過: %s %s / %s [%s]", g.QualityID, g.Quality, g.Name, g.Status))
+	}
+	if total := len(r.Activities) + len(r.Gates); total > 0 {
+		r.Readiness = done * 100 / total
+	} else {
+		r.Blockers = append(r.Blockers, "リリースに含まれる Activity と品質ゲートがありません")
+	}
+	if rel.Status == ReleaseStatusCancelled {
+		r.Blockers = append(r.Blockers, "リリースは中止されています")
+	}
+	r.Go = len(r.Blockers) == 0
+	return r
+}
@@ -0,0 +1,128 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReleaseStatus(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	add := func(entity, title string, opts ...EntityOption) string {
+		t.Helper()
+		result, err := z.Add(ctx, entity, title, opts...)
+		if err != nil {
+			t.Fatalf("Add %s failed: %v", title, err)
+		}
+		return result.ID
+	}
+	obj := add("objective", "決済刷新")
+	uc := add("usecase", "決済する", WithUseCaseObjective(obj))
+	qual := add("quality", "決済品質", WithQualityObjective(obj),
+		WithQualityMetrics([]QualityMetric{{ID: "m1", Name: "カバレッジ", Target: 80, Status: MetricStatusInProgress}}),
+		WithQualityGates([]QualityGate{{Name: "e2e", Criteria: []string{"E2E が通る"}, Status: GateStatusPending}}))
+	api := add("activity", "決済 API", WithActivityUseCase(uc))
+	docs := add("activity", "リリースノート")
+	add("activity", "対象外")
+
+	if _, err := z.Add(ctx, "release", "v0.9", WithReleaseUseCases([]string{"uc-missing"})); err == nil {
+		t.Error("missing usecase reference should fail")
+	}
+	if _, err := z.Add(ctx, "release", "v0.9", WithReleaseTargetDate("2026/01/01")); err == nil {
+		t.Error("invalid target date should fail")
+	}
+	rel := add("release", "v1.2",
+		WithReleaseTargetDate("2026-12-01"),
+		WithReleaseUseCases([]string{uc}),
+		WithReleaseActivities([]string{docs}),
+		WithReleaseQualities([]string{qual}))
+	add("release", "v2.0")
+
+	status, err := z.ReleaseStatus(ctx, "v1.2")
+	if err != nil {
+		t.Fatalf("ReleaseStatus failed: %v", err)
+	}
+	if status.ID != rel || status.Go || status.Readiness != 0 {
+		t.Errorf("status = %+v", status)
+	}
+	if len(status.Activities) != 2 || status.Activities[0].ID > status.Activities[1].ID {
+		t.Errorf("activities = %+v", status.Activities)
+	}
+	if len(status.Gates) != 1 || status.Gates[0].Name != "e2e" || status.Gates[0].Passed {
+		t.Errorf("gates = %+v", status.Gates)
+	}
+	if len(status.Blockers) != 3 || !strings.Contains(strings.Join(status.Blockers, "\n"), "e2e") {
+		t.Errorf("blockers = %v", status.Blockers)
+	}
+	if status.DaysRemaining == nil {
+		t.Error("days_remaining should be set")
+	}
+
+	// ゲートを通過させ、Activity を完了すると go
+	handler, _ := z.entityRegistry.Get("quality")
+	if err := handler.(*QualityHandler).UpdateGate(ctx, qual, "e2e", GateStatusPassed); err != nil {
+		t.Fatalf("UpdateGate failed: %v", err)
+	}
+	if _, err := z.BulkUpdateActivityStatus(ctx, []string{api, docs}, ActivityStatusDeprecated, nil); err != nil {
+		t.Fatalf("BulkUpdateActivityStatus failed: %v", err)
+	}
+	status, err = z.ReleaseStatus(ctx, rel)
+	if err != nil {
+		t.Fatalf("ReleaseStatus failed: %v", err)
+	}
+	if !status.Go || status.Readiness != 100 || len(status.Blockers) != 0 {
+		t.Errorf("status = %+v", status)
+	}
+
+	// 何も含まないリリースは no-go、目標日の近い順（目標日なしは最後）
+	releases, err := z.Releases(ctx)
+	if err != nil {
+		t.Fatalf("Releases failed: %v", err)
+	}
+	if len(releases) != 2 || releases[0].ID != rel || releases[1].Go || releases[1].DaysRemaining != nil {
+		t.Errorf("releases = %+v", releases)
+	}
+
+	if _, err := z.ReleaseStatus(ctx, "v9.9"); !errors.Is(err, ErrEntityNotFound) {
+		t.Errorf("unknown release: err = %v", err)
+	}
+}
+
+func TestReleaseReadinessDaysRemaining(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	now := time.Date(2026, 11, 28, 15, 0, 0, 0, time.Local)
+	tests := []struct {
+		target string
+		want   int
+	}{
+		{"2026-12-01", 3},
+		{"2026-11-28", 0},
+		{"2026-11-20", -8},
+	}
+	for _, tt := range tests {
+		r := z.releaseReadiness(ctx, &ReleaseEntity{ID: "rel-001", Title: "v1", TargetDate: tt.target}, now)
+		if r.DaysRemaining == nil || *r.DaysRemaining != tt.want {
+			t.Errorf("%s: days_remaining = %v, want %d", tt.target, r.DaysRemaining, tt.want)
+		}
+	}
+
+	// 中止したリリースと不正な定義は no-go
+	r := z.releaseReadiness(ctx, &ReleaseEntity{ID: "rel-001", Title: "v1", Status: ReleaseStatusCancelled}, now)
+	if r.Go || !strings.Contains(strings.Join(r.Blockers, "\n"), "中止") {
+		t.Errorf("cancelled: %+v", r)
+	}
+	r = z.releaseReadiness(ctx, &ReleaseEntity{ID: "rel-001", Title: "v1", UseCaseIDs: []string{"../etc"}}, now)
+	if r.Go || len(r.Blockers) != 1 || !strings.Contains(r.Blockers[0], "不正") {
+		t.Errorf("invalid: %+v", r)
+	}
+}
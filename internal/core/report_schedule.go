@@ -0,0 +1,77 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/schedule"
+)
+
+// reportNamePattern はファイル名に使えるスケジュール名
+var reportNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// reportExtensions はレポート形式ごとのファイル拡張子
+var reportExtensions = map[string]string{
+	"text":     ".txt",
+	"html":     ".html",
+	"markdown": ".md",
+}
+
+// Validate は定期レポート設定の妥当性を検証
+func (s ReportSchedule) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("report schedule name is required")
+	}
+	if !reportNamePattern.MatchString(s.Name) {
+		return fmt.Errorf("report schedule name must match %s: %q", reportNamePattern, s.Name)
+	}
+	if _, ok := reportExtensions[s.format()]; !ok {
+		return fmt.Errorf("report schedule %s: unknown format %q (text, html, markdown)", s.Name, s.Format)
+	}
+	if _, err := schedule.Parse(s.Cron); err != nil {
+		return fmt.Errorf("report schedule %s: invalid cron: %w", s.Name, err)
+	}
+	return nil
+}
+
+func (s ReportSchedule) format() string {
+	if s.Format == "" {
+		return "markdown"
+	}
+	return s.Format
+}
+
+// WriteScheduledReport は定期レポートを生成して出力ディレクトリに書き込む
+// ファイル名は <name>-YYYYMMDD-HHMM.<ext>。.zeus からの相対パスを返す
+// email が有効な場合は notifications.email の宛先へ本文として送信する
+func (z *Zeus) WriteScheduledReport(ctx context.Context, s ReportSchedule, now time.Time) (string, error) {
+	if err := s.Validate(); err != nil {
+		return "", err
+	}
+
+	content, err := z.GenerateReport(ctx, s.format())
+	if err != nil {
+		return "", err
+	}
+
+	dir := s.Output
+	if dir == "" {
+		dir = "reports"
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s-%s%s", s.Name, now.Format("20060102-1504"), reportExtensions[s.format()]))
+	if err := z.fileStore.WriteFile(ctx, path, []byte(content)); err != nil {
+		return "", err
+	}
+
+	if s.Email {
+		// ファイルは書き込み済みのため、送信失敗時もパスを返す
+		subject := fmt.Sprintf("%s レポート (%s)", s.Name, now.Format("2006-01-02 15:04"))
+		if err := z.SendEmail(ctx, subject, content, s.format() == "html"); err != nil {
+			return path, fmt.Errorf("report schedule %s: failed to email report: %w", s.Name, err)
+		}
+	}
+	return path, nil
+}
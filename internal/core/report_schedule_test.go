@@ -0,0 +1,85 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReportSchedule_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule ReportSchedule
+		wantErr  bool
+	}{
+		{"valid", ReportSchedule{Name: "weekly", Cron: "0 9 * * 1"}, false},
+		{"alias", ReportSchedule{Name: "daily", Cron: "@daily", Format: "text"}, false},
+		{"missing name", ReportSchedule{Cron: "0 9 * * 1"}, true},
+		{"path in name", ReportSchedule{Name: "../weekly", Cron: "0 9 * * 1"}, true},
+		{"bad cron", ReportSchedule{Name: "weekly", Cron: "every monday"}, true},
+		{"bad format", ReportSchedule{Name: "weekly", Cron: "0 9 * * 1", Format: "pdf"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.schedule.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWriteScheduledReport(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	now := time.Date(2026, 1, 5, 9, 0, 0, 0, time.Local)
+	path, err := z.WriteScheduledReport(ctx, ReportSchedule{Name: "weekly", Cron: "0 9 * * 1", Format: "text"}, now)
+	if err != nil {
+		t.Fatalf("WriteScheduledReport failed: %v", err)
+	}
+	if path != "reports/weekly-20260105-0900.txt" {
+		t.Errorf("unexpected path: %s", path)
+	}
+	if !z.fileStore.Exists(ctx, path) {
+		t.Errorf("report file not written: %s", path)
+	}
+}
+
+func TestWriteScheduledReport_Email(t *testing.T) {
+	var sent []byte
+	z := New(t.TempDir(), WithEmailSendFunc(func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		sent = msg
+		return nil
+	}))
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	now := time.Date(2026, 1, 5, 9, 0, 0, 0, time.Local)
+	s := ReportSchedule{Name: "weekly", Cron: "0 9 * * 1", Format: "html", Email: true}
+
+	// 未設定の場合はファイルを書き込んだうえでエラー
+	path, err := z.WriteScheduledReport(ctx, s, now)
+	if !errors.Is(err, ErrEmailNotConfigured) || path == "" {
+		t.Fatalf("expected ErrEmailNotConfigured with path, got path=%q err=%v", path, err)
+	}
+
+	config, _ := z.LoadConfig(ctx)
+	config.Notifications.Email = EmailSettings{Host: "localhost", From: "zeus@example.com", To: []string{"team@example.com"}}
+	if err := z.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+	if _, err := z.WriteScheduledReport(ctx, s, now); err != nil {
+		t.Fatalf("WriteScheduledReport failed: %v", err)
+	}
+	if !strings.Contains(string(sent), "Content-Type: text/html") {
+		t.Errorf("html report should be sent as text/html:\n%s", sent)
+	}
+}
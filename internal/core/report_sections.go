@@ -0,0 +1,269 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+	"github.com/biwakonbu/zeus/internal/report"
+)
+
+// recentDecisionDays は「最近の Decision」とみなす日数
+const recentDecisionDays = 30
+
+// severityRank は重大度（Risk スコア / Problem 重大度）の並び順
+var severityRank = map[string]int{
+	"critical": 0,
+	"high":     1,
+	"medium":   2,
+	"low":      3,
+}
+
+// buildReportSections は指定されたキーの順にレポートセクションを構築
+func (z *Zeus) buildReportSections(ctx context.Context, keys []string, now time.Time) []report.Section {
+	sections := make([]report.Section, 0, len(keys))
+	for _, key := range keys {
+		var items []report.SectionItem
+		var summary []string
+		switch key {
+		case report.SectionConsiderations:
+			items = z.openConsiderationItems(ctx)
+		case report.SectionDecisions:
+			items = z.recentDecisionItems(ctx, now.AddDate(0, 0, -recentDecisionDays))
+		case report.SectionRisks:
+			items = z.activeRiskItems(ctx)
+			if exposure, err := z.AnalyzeRiskExposure(ctx, 0); err == nil && exposure.Risks > 0 {
+				summary = riskExposureSummary(exposure)
+				addRiskExposureDetails(items, exposure)
+			}
+		case report.SectionProblems:
+			items = z.unresolvedProblemItems(ctx)
+		case report.SectionAssumptions:
+			items = z.invalidatedAssumptionItems(ctx)
+		case report.SectionBudget:
+			items, summary = z.budgetItems(ctx)
+		default:
+			continue
+		}
+		section := report.NewSection(key, items)
+		section.Summary = summary
+		sections = append(sections, section)
+	}
+	return sections
+}
+
+// riskExposureSummary はリスク露出のシミュレーション結果の要約行
+func riskExposureSummary(exposure *analysis.RiskExposure) []string {
+	return []string{
+		fmt.Sprintf("Exposure (%d risks with estimates, %d runs)", exposure.Risks, exposure.Iterations),
+		fmt.Sprintf("Cost: expected %.1f, P90 %.1f", exposure.Cost.Expected, exposure.Cost.P90),
+		fmt.Sprintf("Delay: expected %.1f days, P90 %.1f days", exposure.Delay.Expected, exposure.Delay.P90),
+	}
+}
+
+// addRiskExposureDetails は見積もりを持つ Risk の項目に期待露出を追記
+func addRiskExposureDetails(items []report.SectionItem, exposure *analysis.RiskExposure) {
+	byID := make(map[string]analysis.RiskExposureContribution, len(exposure.Contributions))
+	for _, c := range exposure.Contributions {
+		byID[c.ID] = c
+	}
+	for i := range items {
+		c, ok := byID[items[i].ID]
+		if !ok {
+			continue
+		}
+		items[i].Detail += fmt.Sprintf(", expected exposure: cost %.1f, delay %.1f days", c.ExpectedCost, c.ExpectedDelay)
+	}
+}
+
+// openConsiderationItems は open の Consideration（期限順、期限なしは末尾）
+func (z *Zeus) openConsiderationItems(ctx context.Context) []report.SectionItem {
+	items := []report.SectionItem{}
+	dueDates := map[string]string{}
+	z.forEachYaml(ctx, "considerations", func(path string) {
+		var c ConsiderationEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &c); err != nil || c.Status != ConsiderationStatusOpen {
+			return
+		}
+		detail := ""
+		if c.DueDate != "" {
+			detail = "due " + c.DueDate
+		}
+		dueDates[c.ID] = c.DueDate
+		items = append(items, report.SectionItem{ID: c.ID, Title: c.Title, Status: string(c.Status), Detail: detail})
+	})
+	sort.SliceStable(items, func(i, j int) bool {
+		di, dj := dueDates[items[i].ID], dueDates[items[j].ID]
+		if (di == "") != (dj == "") {
+			return di != ""
+		}
+		if di != dj {
+			return di < dj
+		}
+		return items[i].ID < items[j].ID
+	})
+	return items
+}
+
+// recentDecisionItems は since 以降の Decision（新しい順）
+func (z *Zeus) recentDecisionItems(ctx context.Context, since time.Time) []report.SectionItem {
+	items := []report.SectionItem{}
+	decidedAt := map[string]string{}
+	supersededBy, _ := z.DecisionSupersededBy(ctx)
+	z.forEachYaml(ctx, "decisions", func(path string) {
+		var d DecisionEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &d); err != nil {
+			return
+		}
+		t, err := time.Parse(time.RFC3339, d.DecidedAt)
+		if err != nil || t.Before(since) {
+			return
+		}
+		decidedAt[d.ID] = d.DecidedAt
+		item := report.SectionItem{
+			ID:     d.ID,
+			Title:  d.Title,
+			Status: "decided",
+			Detail: "selected: " + d.Selected.Title + " (" + t.Format("2006-01-02") + ")",
+		}
+		if next, ok := supersededBy[d.ID]; ok {
+			item.Status = "superseded"
+			item.Detail += ", superseded by " + next
+		}
+		if d.Supersedes != "" {
+			item.Detail += ", supersedes " + d.Supersedes
+		}
+		items = append(items, item)
+	})
+	sort.SliceStable(items, func(i, j int) bool {
+		return decidedAt[items[i].ID] > decidedAt[items[j].ID]
+	})
+	return items
+}
+
+// activeRiskItems は mitigated / closed 以外の Risk（スコアの高い順）
+func (z *Zeus) activeRiskItems(ctx context.Context) []report.SectionItem {
+	items := []report.SectionItem{}
+	scores := map[string]string{}
+	z.forEachYaml(ctx, "risks", func(path string) {
+		var r RiskEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &r); err != nil {
+			return
+		}
+		if r.Status == RiskStatusMitigated || r.Status == RiskStatusClosed {
+			return
+		}
+		scores[r.ID] = string(r.RiskScore)
+		items = append(items, report.SectionItem{
+			ID:     r.ID,
+			Title:  r.Title,
+			Status: string(r.Status),
+			Detail: "score: " + string(r.RiskScore) + " (probability: " + string(r.Probability) + ", impact: " + string(r.Impact) + ")",
+		})
+	})
+	sortBySeverity(items, scores)
+	return items
+}
+
+// unresolvedProblemItems は open / in_progress の Problem（重大度の高い順）
+func (z *Zeus) unresolvedProblemItems(ctx context.Context) []report.SectionItem {
+	items := []report.SectionItem{}
+	severities := map[string]string{}
+	z.forEachYaml(ctx, "problems", func(path string) {
+		var p ProblemEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &p); err != nil {
+			return
+		}
+		if p.Status != ProblemStatusOpen && p.Status != ProblemStatusInProgress {
+			return
+		}
+		severities[p.ID] = string(p.Severity)
+		item := report.SectionItem{
+			ID:     p.ID,
+			Title:  p.Title,
+			Status: string(p.Status),
+			Detail: "severity: " + string(p.Severity),
+		}
+		if len(p.Causes) > 0 {
+			tree := p.CauseTree()
+			item.Causes = tree.Outline()
+			item.Mermaid = tree.ToMermaid()
+		}
+		items = append(items, item)
+	})
+	sortBySeverity(items, severities)
+	return items
+}
+
+// invalidatedAssumptionItems は invalidated の Assumption
+func (z *Zeus) invalidatedAssumptionItems(ctx context.Context) []report.SectionItem {
+	items := []report.SectionItem{}
+	z.forEachYaml(ctx, "assumptions", func(path string) {
+		var a AssumptionEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &a); err != nil || a.Status != AssumptionStatusInvalidated {
+			return
+		}
+		detail := ""
+		if a.IfInvalid != "" {
+			detail = "if invalid: " + a.IfInvalid
+		}
+		items = append(items, report.SectionItem{ID: a.ID, Title: a.Title, Status: string(a.Status), Detail: detail})
+	})
+	sort.SliceStable(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+	return items
+}
+
+// sortBySeverity は重大度の高い順（同順位は ID 順）に並べる
+func sortBySeverity(items []report.SectionItem, severity map[string]string) {
+	rank := func(id string) int {
+		if r, ok := severityRank[severity[id]]; ok {
+			return r
+		}
+		return len(severityRank)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		ri, rj := rank(items[i].ID), rank(items[j].ID)
+		if ri != rj {
+			return ri < rj
+		}
+		return items[i].ID < items[j].ID
+	})
+}
+
+// budgetItems は予算超過の Objective / Activity（超過額の大きい順）と、超過していない Objective・Objective に辿れない Activity の予算対実績
+func (z *Zeus) budgetItems(ctx context.Context) ([]report.SectionItem, []string) {
+	items := []report.SectionItem{}
+	budget, err := z.Budget(ctx)
+	if err != nil {
+		return items, nil
+	}
+	amount := func(v float64) string { return FormatAmount(v, budget.Currency) }
+	lines := append([]BudgetLine{}, budget.Overruns...)
+	for _, line := range append(append([]BudgetLine{}, budget.Objectives...), budget.Unassigned...) {
+		if !line.Overrun {
+			lines = append(lines, line)
+		}
+	}
+	for _, line := range lines {
+		status := "within budget"
+		if line.Overrun {
+			status = "over budget"
+		}
+		items = append(items, report.SectionItem{
+			ID:     line.ID,
+			Title:  line.Title,
+			Status: status,
+			Detail: fmt.Sprintf("budgeted: %s, committed: %s, actual: %s, variance: %s (%d%%)",
+				amount(line.Total.Budgeted), amount(line.Total.Committed), amount(line.Total.Actual), amount(line.Variance), line.Utilization),
+		})
+	}
+	if len(items) == 0 {
+		return items, nil
+	}
+	return items, []string{
+		fmt.Sprintf("Total: budgeted %s, committed %s, actual %s", amount(budget.Total.Budgeted), amount(budget.Total.Committed), amount(budget.Total.Actual)),
+		fmt.Sprintf("Variance: %s (%d over budget)", amount(budget.Variance), len(budget.Overruns)),
+	}
+}
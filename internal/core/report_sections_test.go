@@ -0,0 +1,98 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestGenerateReportWithSections(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	if _, err := z.Add(ctx, "consideration", "Choose database", WithConsiderationDueDate("2026-01-10")); err != nil {
+		t.Fatalf("Add consideration failed: %v", err)
+	}
+	if _, err := z.Add(ctx, "risk", "Low risk", WithRiskProbability(RiskProbabilityLow), WithRiskImpact(RiskImpactLow)); err != nil {
+		t.Fatalf("Add risk failed: %v", err)
+	}
+	if _, err := z.Add(ctx, "risk", "High risk", WithRiskProbability(RiskProbabilityHigh), WithRiskImpact(RiskImpactHigh)); err != nil {
+		t.Fatalf("Add risk failed: %v", err)
+	}
+	if _, err := z.Add(ctx, "risk", "Closed risk", WithRiskStatus(RiskStatusClosed)); err != nil {
+		t.Fatalf("Add risk failed: %v", err)
+	}
+	if _, err := z.Add(ctx, "problem", "Resolved problem", WithProblemStatus(ProblemStatusResolved)); err != nil {
+		t.Fatalf("Add problem failed: %v", err)
+	}
+	if _, err := z.Add(ctx, "assumption", "Users have accounts",
+		WithAssumptionStatus(AssumptionStatusInvalidated),
+		WithAssumptionIfInvalid("add guest checkout"),
+	); err != nil {
+		t.Fatalf("Add assumption failed: %v", err)
+	}
+
+	out, err := z.GenerateReport(ctx, "text")
+	if err != nil {
+		t.Fatalf("GenerateReport failed: %v", err)
+	}
+	for _, want := range []string{
+		"Open Considerations (1)",
+		"due 2026-01-10",
+		"Active Risks (2)",
+		"Unresolved Problems (0)",
+		"Invalidated Assumptions (1)",
+		"if invalid: add guest checkout",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("report should contain %q:\n%s", want, out)
+		}
+	}
+	if strings.Index(out, "High risk") > strings.Index(out, "Low risk") {
+		t.Error("risks should be ordered by score")
+	}
+	if strings.Contains(out, "Closed risk") {
+		t.Error("closed risks should be excluded")
+	}
+
+	// セクション指定
+	out, err = z.GenerateReportWithSections(ctx, "markdown", []string{"risks"})
+	if err != nil {
+		t.Fatalf("GenerateReportWithSections failed: %v", err)
+	}
+	if !strings.Contains(out, "## Active Risks") || strings.Contains(out, "Open Considerations") {
+		t.Errorf("only the risks section should be rendered:\n%s", out)
+	}
+
+	// zeus.yaml で無効化
+	config, err := z.LoadConfig(ctx)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	config.Reports.Sections = map[string]bool{"risks": false}
+	if err := z.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+	out, err = z.GenerateReport(ctx, "text")
+	if err != nil {
+		t.Fatalf("GenerateReport failed: %v", err)
+	}
+	if strings.Contains(out, "Active Risks") || !strings.Contains(out, "Open Considerations") {
+		t.Errorf("only the risks section should be disabled by config:\n%s", out)
+	}
+
+	config.Reports.Sections = map[string]bool{"tasks": true}
+	if err := z.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+	if _, err := z.GenerateReport(ctx, "text"); err == nil {
+		t.Error("expected error for unknown section in config")
+	}
+
+	if _, err := z.GenerateReportWithSections(ctx, "text", []string{"tasks"}); err == nil {
+		t.Error("expected error for unknown section")
+	}
+}
@@ -0,0 +1,290 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/yaml"
+	goyaml "gopkg.in/yaml.v3"
+)
+
+// mapUpdateFields は map で更新を受け付けるハンドラーの更新可能フィールド
+// （それ以外のエンティティは構造体で更新するため、定義済みのフィールドをすべて更新できる）
+var mapUpdateFields = map[string][]string{
+	"activity":     slices.Concat([]string{"title", "description", "status", "usecase_id"}, scoreFields, costFields),
+	"usecase":      {"title", "description", "status", "objective_id", "subsystem_id"},
+	"statemachine": {"title", "description", "status", "usecase_id"},
+	"actor":        {"title", "description", "type"},
+	"subsystem":    {"name", "description"},
+	"container":    {"name", "description", "technology", "subsystem_id"},
+	"component":    {"name", "description", "technology", "container_id"},
+}
+
+// readOnlyFields は UpdateEntity で変更できないフィールド
+var readOnlyFields = map[string]bool{
+	"id":                  true,
+	"metadata.created_at": true,
+	"metadata.updated_at": true,
+}
+
+// entityUpdateMu はリビジョンの確認と書き込みを直列化する（同一プロセス内の同時更新対策）
+var entityUpdateMu sync.Mutex
+
+// entityUpdateLockFile はリビジョンの確認と書き込みをプロセス間で直列化するロック（CLI とダッシュボードの同時更新対策）
+// トランザクション内の書き込みはコミット時に反映されるため、トランザクションはコミットまで同じロックを保持する
+const entityUpdateLockFile = "state/entity-update"
+
+// lockEntityUpdate は entityUpdateLockFile のロックを取得し、解放する関数を返す
+// トランザクション内ではトランザクションがロックを保持しているため何もしない
+func (z *Zeus) lockEntityUpdate() (func(), error) {
+	if inTransaction(z.fileStore) {
+		return func() {}, nil
+	}
+	lock := yaml.NewFileLock(filepath.Join(z.ZeusPath, entityUpdateLockFile))
+	if err := lock.LockWithTimeout(5 * time.Second); err != nil {
+		return nil, ErrLockAcquireFailed
+	}
+	return func() { _ = lock.Unlock() }, nil
+}
+
+// EntityRevision はエンティティの現在のリビジョン（内容のハッシュ）を返す
+// リビジョンは保存のたびに変わるため、ダッシュボードの ETag / CLI の --revision に使用する
+func (z *Zeus) EntityRevision(ctx context.Context, entityType, id string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	handler, ok := z.entityRegistry.Get(entityType)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownEntity, entityType)
+	}
+	entity, err := handler.Get(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	_, revision, err := entityRevisionState(entity)
+	return revision, err
+}
+
+// UpdateEntity は期待するリビジョンを確認してからエンティティのフィールドを更新し、新しいリビジョンを返す
+// fields のキーはドット区切り（例: mitigation.preventive）。リビジョンが一致しない場合は
+// 現在の値と異なるフィールドの差分を含む *RevisionConflictError を返す
+func (z *Zeus) UpdateEntity(ctx context.Context, entityType, id string, fields map[string]any, expectedRevision string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	if expectedRevision == "" {
+		return "", ErrRevisionRequired
+	}
+	if len(fields) == 0 {
+		return "", fmt.Errorf("no fields to update")
+	}
+	handler, ok := z.entityRegistry.Get(entityType)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownEntity, entityType)
+	}
+	for field := range fields {
+		if readOnlyFields[field] {
+			return "", fmt.Errorf("field %s cannot be updated", field)
+		}
+	}
+
+	// settings.operations の <entity>_update 等で承認が必要な場合は、リビジョンを確認したうえで承認待ちキューに追加
+	config := z.approvalConfig(ctx)
+	level, err := z.operationApprovalLevel(ctx, &config, entityType, ChangeOpUpdate, id)
+	if err != nil {
+		return "", err
+	}
+	if level == ApprovalApprove {
+		approval, err := z.queueApproval(ctx, &config, entityType, ChangeOpUpdate,
+			fmt.Sprintf("%s %s の更新", entityType, id),
+			map[string]any{"entity": entityType, "id": id, "fields": fields},
+			func(tx *Zeus) (string, error) {
+				txHandler, _ := tx.entityRegistry.Get(entityType)
+				_, err := tx.updateEntity(ctx, txHandler, entityType, id, fields, expectedRevision)
+				return id, err
+			})
+		if err != nil {
+			return "", err
+		}
+		return "", &ApprovalRequiredError{Action: approval.Type, ApprovalID: approval.ID, EntityID: id}
+	}
+	revision, err := z.updateEntity(ctx, handler, entityType, id, fields, expectedRevision)
+	if err == nil {
+		z.audit(ctx, AuditEntry{Op: ChangeOpUpdate, Entity: entityType, ID: id, Result: AuditResultApplied})
+	}
+	return revision, err
+}
+
+// updateEntity はリビジョンを確認してからエンティティを更新し、新しいリビジョンを返す
+func (z *Zeus) updateEntity(ctx context.Context, handler EntityHandler, entityType, id string, fields map[string]any, expectedRevision string) (string, error) {
+	entityUpdateMu.Lock()
+	defer entityUpdateMu.Unlock()
+	unlock, err := z.lockEntityUpdate()
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+
+	current, err := handler.Get(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	currentMap, revision, err := entityRevisionState(current)
+	if err != nil {
+		return "", err
+	}
+	if revision != expectedRevision {
+		return "", &RevisionConflictError{
+			EntityType: entityType,
+			ID:         id,
+			Expected:   expectedRevision,
+			Current:    revision,
+			Fields:     conflictingFields(currentMap, fields),
+		}
+	}
+
+	update, err := buildEntityUpdate(entityType, current, currentMap, fields)
+	if err != nil {
+		return "", err
+	}
+	if err := handler.Update(ctx, id, update); err != nil {
+		return "", err
+	}
+
+	updated, err := handler.Get(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	_, newRevision, err := entityRevisionState(updated)
+	if err != nil {
+		return "", err
+	}
+	if err := z.updateState(ctx); err != nil {
+		return newRevision, err
+	}
+	return newRevision, nil
+}
+
+// ParseFieldAssignments は field=value 形式の指定を解析（値は YAML として解釈: 数値、真偽値、[a, b] 等）
+func ParseFieldAssignments(assignments []string) (map[string]any, error) {
+	fields := make(map[string]any, len(assignments))
+	for _, a := range assignments {
+		field, raw, ok := strings.Cut(a, "=")
+		field = strings.TrimSpace(field)
+		if !ok || field == "" {
+			return nil, fmt.Errorf("invalid assignment %q (expected field=value)", a)
+		}
+		var value any
+		if err := goyaml.Unmarshal([]byte(raw), &value); err != nil || value == nil {
+			value = raw
+		}
+		fields[field] = value
+	}
+	return fields, nil
+}
+
+// entityRevisionState はエンティティを YAML のマップに変換し、リビジョンを計算
+func entityRevisionState(entity any) (map[string]any, string, error) {
+	data, err := goyaml.Marshal(entity)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encode entity: %w", err)
+	}
+	var m map[string]any
+	if err := goyaml.Unmarshal(data, &m); err != nil {
+		return nil, "", fmt.Errorf("failed to decode entity: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return m, hex.EncodeToString(sum[:8]), nil
+}
+
+// buildEntityUpdate は現在の値にフィールドを反映し、ハンドラーが受け付ける形式の更新データを作成
+func buildEntityUpdate(entityType string, current any, currentMap map[string]any, fields map[string]any) (any, error) {
+	if allowed, ok := mapUpdateFields[entityType]; ok {
+		update := make(map[string]any, len(fields))
+		for field, value := range fields {
+			if !slices.Contains(allowed, field) {
+				return nil, fmt.Errorf("field %s cannot be updated for %s (updatable: %s)", field, entityType, strings.Join(allowed, ", "))
+			}
+			update[field] = fmt.Sprint(value)
+		}
+		return update, nil
+	}
+
+	t := reflect.TypeOf(current)
+	if t == nil || t.Kind() != reflect.Pointer {
+		return nil, fmt.Errorf("%s does not support field updates", entityType)
+	}
+	for field, value := range fields {
+		setField(currentMap, field, value)
+	}
+	data, err := goyaml.Marshal(currentMap)
+	if err != nil {
+		return nil, err
+	}
+
+	// 未定義のフィールド（タイプミス）はエラーにする
+	update := reflect.New(t.Elem()).Interface()
+	decoder := goyaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(update); err != nil {
+		return nil, fmt.Errorf("invalid fields for %s: %w", entityType, err)
+	}
+	return update, nil
+}
+
+// setField はドット区切りのフィールドに値を設定（中間のマップは必要に応じて作成）
+func setField(m map[string]any, field string, value any) {
+	parts := strings.Split(field, ".")
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := m[part].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			m[part] = next
+		}
+		m = next
+	}
+	m[parts[len(parts)-1]] = value
+}
+
+// conflictingFields は更新しようとしたフィールドのうち、現在の値と異なるものを返す
+func conflictingFields(currentMap map[string]any, fields map[string]any) []FieldConflict {
+	names := make([]string, 0, len(fields))
+	for field := range fields {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+
+	conflicts := []FieldConflict{}
+	for _, field := range names {
+		current, _ := lookupField(currentMap, field)
+		if fmt.Sprint(current) != fmt.Sprint(fields[field]) {
+			conflicts = append(conflicts, FieldConflict{Field: field, Current: current, Proposed: fields[field]})
+		}
+	}
+	return conflicts
+}
+
+// lookupField はドット区切りのフィールドの値を返す
+func lookupField(m map[string]any, field string) (any, bool) {
+	var value any = m
+	for _, part := range strings.Split(field, ".") {
+		next, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = next[part]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
@@ -0,0 +1,178 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/yaml"
+)
+
+func TestUpdateEntity(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	risk, err := z.Add(ctx, "risk", "Outage", WithRiskProbability(RiskProbabilityLow), WithRiskImpact(RiskImpactLow))
+	if err != nil {
+		t.Fatalf("Add risk failed: %v", err)
+	}
+
+	rev, err := z.EntityRevision(ctx, "risk", risk.ID)
+	if err != nil || rev == "" {
+		t.Fatalf("EntityRevision() = %q, %v", rev, err)
+	}
+
+	if _, err := z.UpdateEntity(ctx, "risk", risk.ID, map[string]any{"owner": "alice"}, ""); !errors.Is(err, ErrRevisionRequired) {
+		t.Errorf("expected ErrRevisionRequired, got %v", err)
+	}
+
+	newRev, err := z.UpdateEntity(ctx, "risk", risk.ID, map[string]any{
+		"owner":                 "alice",
+		"probability":           "high",
+		"impact":                "high",
+		"mitigation.preventive": []any{"backup"},
+	}, rev)
+	if err != nil {
+		t.Fatalf("UpdateEntity failed: %v", err)
+	}
+	if newRev == rev {
+		t.Error("revision should change after update")
+	}
+	handler, _ := z.entityRegistry.Get("risk")
+	got, _ := handler.Get(ctx, risk.ID)
+	updated := got.(*RiskEntity)
+	if updated.Owner != "alice" || updated.RiskScore != RiskScoreCritical || len(updated.Mitigation.Preventive) != 1 {
+		t.Errorf("update not applied: %+v", updated)
+	}
+	if updated.Title != "Outage" {
+		t.Errorf("untouched field changed: %q", updated.Title)
+	}
+
+	// 古いリビジョンでの更新は競合（フィールドごとの差分付き）
+	_, err = z.UpdateEntity(ctx, "risk", risk.ID, map[string]any{"owner": "bob", "title": "Outage"}, rev)
+	var conflict *RevisionConflictError
+	if !errors.As(err, &conflict) || !errors.Is(err, ErrRevisionConflict) {
+		t.Fatalf("expected RevisionConflictError, got %v", err)
+	}
+	if conflict.Current != newRev || len(conflict.Fields) != 1 {
+		t.Fatalf("unexpected conflict: %+v", conflict)
+	}
+	if f := conflict.Fields[0]; f.Field != "owner" || f.Current != "alice" || f.Proposed != "bob" {
+		t.Errorf("unexpected field conflict: %+v", f)
+	}
+	got, _ = handler.Get(ctx, risk.ID)
+	if got.(*RiskEntity).Owner != "alice" {
+		t.Error("stale update must not be written")
+	}
+
+	// 未定義・読み取り専用のフィールド
+	if _, err := z.UpdateEntity(ctx, "risk", risk.ID, map[string]any{"bogus": 1}, newRev); err == nil || !strings.Contains(err.Error(), "bogus") {
+		t.Errorf("expected unknown field error, got %v", err)
+	}
+	if _, err := z.UpdateEntity(ctx, "risk", risk.ID, map[string]any{"id": "risk-00000000"}, newRev); err == nil {
+		t.Error("expected error for read-only field")
+	}
+}
+
+func TestUpdateEntity_LockedByOtherProcess(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	risk, err := z.Add(ctx, "risk", "Outage")
+	if err != nil {
+		t.Fatalf("Add risk failed: %v", err)
+	}
+	rev, _ := z.EntityRevision(ctx, "risk", risk.ID)
+
+	for name, update := range map[string]func() error{
+		"direct": func() error {
+			_, err := z.UpdateEntity(ctx, "risk", risk.ID, map[string]any{"owner": "alice"}, rev)
+			return err
+		},
+		"transaction": func() error {
+			return z.Transaction(ctx, func(tx *Zeus) error {
+				_, err := tx.UpdateEntity(ctx, "risk", risk.ID, map[string]any{"owner": "alice"}, rev)
+				return err
+			})
+		},
+	} {
+		// 別のプロセスがロックを保持している間はリビジョンを確認しない
+		lock := yaml.NewFileLock(filepath.Join(z.ZeusPath, entityUpdateLockFile))
+		if err := lock.Lock(); err != nil {
+			t.Fatalf("%s: Lock failed: %v", name, err)
+		}
+		done := make(chan error, 1)
+		go func() { done <- update() }()
+		select {
+		case err := <-done:
+			t.Fatalf("%s: update should wait for the lock: %v", name, err)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		// ロック中の別プロセスの書き込みは、ロック解放後のリビジョン確認で競合になる
+		other := New(filepath.Dir(z.ZeusPath))
+		handler, _ := other.entityRegistry.Get("risk")
+		current, _ := handler.Get(ctx, risk.ID)
+		current.(*RiskEntity).Owner = "bob-" + name
+		if err := handler.Update(ctx, risk.ID, current); err != nil {
+			t.Fatalf("%s: Update failed: %v", name, err)
+		}
+		_ = lock.Unlock()
+
+		var conflict *RevisionConflictError
+		if err := <-done; !errors.As(err, &conflict) {
+			t.Errorf("%s: error = %v, want *RevisionConflictError", name, err)
+		}
+		rev, _ = z.EntityRevision(ctx, "risk", risk.ID)
+	}
+}
+
+func TestUpdateEntity_MapHandler(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	act, err := z.Add(ctx, "activity", "Build")
+	if err != nil {
+		t.Fatalf("Add activity failed: %v", err)
+	}
+	rev, _ := z.EntityRevision(ctx, "activity", act.ID)
+
+	if _, err := z.UpdateEntity(ctx, "activity", act.ID, map[string]any{"title": "Build v2"}, rev); err != nil {
+		t.Fatalf("UpdateEntity failed: %v", err)
+	}
+	handler, _ := z.entityRegistry.Get("activity")
+	got, _ := handler.Get(ctx, act.ID)
+	if got.(*ActivityEntity).Title != "Build v2" {
+		t.Errorf("title not updated: %q", got.(*ActivityEntity).Title)
+	}
+
+	rev, _ = z.EntityRevision(ctx, "activity", act.ID)
+	if _, err := z.UpdateEntity(ctx, "activity", act.ID, map[string]any{"nodes": "x"}, rev); err == nil || !strings.Contains(err.Error(), "updatable") {
+		t.Errorf("expected updatable fields error, got %v", err)
+	}
+}
+
+func TestParseFieldAssignments(t *testing.T) {
+	fields, err := ParseFieldAssignments([]string{"owner=alice", "progress=40", "tags=[a, b]", "note=", "title=a=b"})
+	if err != nil {
+		t.Fatalf("ParseFieldAssignments failed: %v", err)
+	}
+	if fields["owner"] != "alice" || fields["progress"] != 40 || fields["note"] != "" || fields["title"] != "a=b" {
+		t.Errorf("unexpected fields: %#v", fields)
+	}
+	if tags, ok := fields["tags"].([]any); !ok || len(tags) != 2 {
+		t.Errorf("tags should be a list: %#v", fields["tags"])
+	}
+	if _, err := ParseFieldAssignments([]string{"novalue"}); err == nil {
+		t.Error("expected error for assignment without =")
+	}
+}
@@ -0,0 +1,90 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+)
+
+// riskOccurrenceProbability は発生確率の区分をシミュレーションで使う確率に換算する
+var riskOccurrenceProbability = map[RiskProbability]float64{
+	RiskProbabilityHigh:   0.7,
+	RiskProbabilityMedium: 0.4,
+	RiskProbabilityLow:    0.1,
+}
+
+// RiskEstimate は Risk が発生した場合の影響の三点見積もり（最小・最頻・最大）
+type RiskEstimate struct {
+	Min    float64 `yaml:"min" json:"min"`
+	Likely float64 `yaml:"likely" json:"likely"`
+	Max    float64 `yaml:"max" json:"max"`
+}
+
+// Validate は三点見積もりの妥当性を検証（0 <= min <= likely <= max）
+func (e *RiskEstimate) Validate(field string) error {
+	if e.Min < 0 {
+		return fmt.Errorf("%s.min must be >= 0", field)
+	}
+	if e.Min > e.Likely || e.Likely > e.Max {
+		return fmt.Errorf("%s must satisfy min <= likely <= max", field)
+	}
+	return nil
+}
+
+// ParseRiskEstimate は min=10,likely=20,max=50 形式の指定を解析
+func ParseRiskEstimate(field, spec string) (*RiskEstimate, error) {
+	values, err := parseScoreSpec(spec, "min", "likely", "max")
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", field, err)
+	}
+	e := &RiskEstimate{Min: values["min"], Likely: values["likely"], Max: values["max"]}
+	if err := e.Validate(field); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// AnalyzeRiskExposure はコスト・遅延の見積もりを持つ Risk の露出をモンテカルロ法で求める
+// mitigated / closed の Risk は対象外、occurred の Risk は発生済み（確率 1）として扱う
+func (z *Zeus) AnalyzeRiskExposure(ctx context.Context, iterations int) (*analysis.RiskExposure, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	inputs := []analysis.RiskExposureInput{}
+	z.forEachYaml(ctx, "risks", func(path string) {
+		var r RiskEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &r); err != nil {
+			return
+		}
+		if r.Status == RiskStatusMitigated || r.Status == RiskStatusClosed {
+			return
+		}
+		if r.CostImpact == nil && r.DelayImpact == nil {
+			return
+		}
+		probability := riskOccurrenceProbability[r.Probability]
+		if r.Status == RiskStatusOccurred {
+			probability = 1
+		}
+		inputs = append(inputs, analysis.RiskExposureInput{
+			ID:          r.ID,
+			Title:       r.Title,
+			Probability: probability,
+			Cost:        r.CostImpact.triangular(),
+			Delay:       r.DelayImpact.triangular(),
+		})
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return analysis.SimulateRiskExposure(inputs, iterations), nil
+}
+
+// triangular は analysis の三点見積もりに変換（nil の場合は nil）
+func (e *RiskEstimate) triangular() *analysis.TriangularEstimate {
+	if e == nil {
+		return nil
+	}
+	return &analysis.TriangularEstimate{Min: e.Min, Likely: e.Likely, Max: e.Max}
+}
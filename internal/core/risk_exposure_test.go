@@ -0,0 +1,88 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestParseRiskEstimate(t *testing.T) {
+	e, err := ParseRiskEstimate("cost_impact", "min=10, likely=20, max=50")
+	if err != nil {
+		t.Fatalf("ParseRiskEstimate failed: %v", err)
+	}
+	if *e != (RiskEstimate{Min: 10, Likely: 20, Max: 50}) {
+		t.Errorf("estimate = %+v", e)
+	}
+	for _, spec := range []string{"min=10,likely=5,max=50", "min=-1,likely=0,max=1", "min=1,likely=2", "low=1,likely=2,max=3"} {
+		if _, err := ParseRiskEstimate("cost_impact", spec); err == nil {
+			t.Errorf("ParseRiskEstimate(%q) should fail", spec)
+		}
+	}
+
+	r := &RiskEntity{ID: "risk-001", Title: "遅延", DelayImpact: &RiskEstimate{Min: 5, Likely: 3, Max: 10}}
+	if err := r.Validate(); err == nil || !strings.Contains(err.Error(), "delay_impact") {
+		t.Errorf("Validate() error = %v, want delay_impact error", err)
+	}
+}
+
+func TestAnalyzeRiskExposure(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	occurred, err := z.Add(ctx, "risk", "Key person left",
+		WithRiskStatus(RiskStatusOccurred),
+		WithRiskCostImpact(&RiskEstimate{Min: 30, Likely: 30, Max: 30}),
+	)
+	if err != nil {
+		t.Fatalf("Add risk failed: %v", err)
+	}
+	if _, err := z.Add(ctx, "risk", "Vendor delay",
+		WithRiskProbability(RiskProbabilityHigh),
+		WithRiskDelayImpact(&RiskEstimate{Min: 2, Likely: 5, Max: 15}),
+	); err != nil {
+		t.Fatalf("Add risk failed: %v", err)
+	}
+	// 見積もりの無いリスク・軽減済みのリスクは対象外
+	if _, err := z.Add(ctx, "risk", "No estimate"); err != nil {
+		t.Fatalf("Add risk failed: %v", err)
+	}
+	if _, err := z.Add(ctx, "risk", "Mitigated",
+		WithRiskStatus(RiskStatusMitigated),
+		WithRiskCostImpact(&RiskEstimate{Min: 1000, Likely: 1000, Max: 1000}),
+	); err != nil {
+		t.Fatalf("Add risk failed: %v", err)
+	}
+
+	exposure, err := z.AnalyzeRiskExposure(ctx, 5000)
+	if err != nil {
+		t.Fatalf("AnalyzeRiskExposure failed: %v", err)
+	}
+	if exposure.Risks != 2 || exposure.Iterations != 5000 {
+		t.Fatalf("exposure = %d risks, %d iterations", exposure.Risks, exposure.Iterations)
+	}
+	// 発生済みのリスクは確率 1 として扱う
+	if exposure.Cost.Expected != 30 || exposure.Contributions[0].ID != occurred.ID || exposure.Contributions[0].Probability != 1 {
+		t.Errorf("cost = %+v, contributions = %+v", exposure.Cost, exposure.Contributions)
+	}
+	if exposure.Delay.Expected <= 0 || exposure.Delay.P90 < exposure.Delay.P50 {
+		t.Errorf("delay = %+v", exposure.Delay)
+	}
+
+	out, err := z.GenerateReportWithSections(ctx, "text", []string{"risks"})
+	if err != nil {
+		t.Fatalf("GenerateReportWithSections failed: %v", err)
+	}
+	for _, want := range []string{
+		"Exposure (2 risks with estimates, 10000 runs)",
+		"Cost: expected 30.0, P90 30.0",
+		"expected exposure: cost 30.0, delay 0.0 days",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("report should contain %q:\n%s", want, out)
+		}
+	}
+}
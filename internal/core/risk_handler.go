@@ -378,6 +378,24 @@ func WithRiskOwner(owner string) EntityOption {
 	}
 }
 
+// WithRiskCostImpact は Risk のコスト影響の見積もりを設定
+func WithRiskCostImpact(estimate *RiskEstimate) EntityOption {
+	return func(v any) {
+		if risk, ok := v.(*RiskEntity); ok {
+			risk.CostImpact = estimate
+		}
+	}
+}
+
+// WithRiskDelayImpact は Risk の遅延（日数）の見積もりを設定
+func WithRiskDelayImpact(estimate *RiskEstimate) EntityOption {
+	return func(v any) {
+		if risk, ok := v.(*RiskEntity); ok {
+			risk.DelayImpact = estimate
+		}
+	}
+}
+
 // WithRiskReviewDate は Risk のレビュー日を設定
 func WithRiskReviewDate(reviewDate string) EntityOption {
 	return func(v any) {
@@ -0,0 +1,165 @@
+package core
+
+import (
+	"context"
+	"sort"
+)
+
+// riskHeatmapProbabilities はヒートマップの行（発生確率の高い順）
+var riskHeatmapProbabilities = []RiskProbability{RiskProbabilityHigh, RiskProbabilityMedium, RiskProbabilityLow}
+
+// riskHeatmapImpacts はヒートマップの列（影響度の低い順）
+var riskHeatmapImpacts = []RiskImpact{RiskImpactLow, RiskImpactMedium, RiskImpactHigh, RiskImpactCritical}
+
+// RiskHeatmapCell は発生確率 × 影響度の 1 マス
+type RiskHeatmapCell struct {
+	Probability RiskProbability `json:"probability"`
+	Impact      RiskImpact      `json:"impact"`
+	Score       RiskScore       `json:"score"`
+	RiskIDs     []string        `json:"risk_ids"`
+	Count       int             `json:"count"`
+	Delta       int             `json:"delta"` // 比較元のスナップショットからの件数の増減
+}
+
+// RiskHeatmapMove はスナップショットから別のマスへ移ったリスク
+type RiskHeatmapMove struct {
+	ID   string `json:"id"`
+	From string `json:"from"` // "probability/impact"
+	To   string `json:"to"`
+}
+
+// RiskHeatmap は mitigated / closed 以外のリスクの発生確率 × 影響度のマトリクス
+// Cells は発生確率の高い順・影響度の低い順に並ぶ（行は Probabilities、列は Impacts）
+type RiskHeatmap struct {
+	Probabilities []RiskProbability  `json:"probabilities"`
+	Impacts       []RiskImpact       `json:"impacts"`
+	Cells         []RiskHeatmapCell  `json:"cells"`
+	Total         int                `json:"total"`
+	Baseline      *RiskHeatmapSource `json:"baseline"` // 比較元のスナップショット（無い場合は null、Delta はすべて 0）
+	Added         []string           `json:"added"`    // 比較元に無かったリスク
+	Removed       []string           `json:"removed"`  // 比較元にあり、現在は対象外のリスク
+	Moved         []RiskHeatmapMove  `json:"moved"`
+}
+
+// RiskHeatmapSource は比較元のスナップショット
+type RiskHeatmapSource struct {
+	Timestamp string `json:"timestamp"`
+	Label     string `json:"label,omitempty"`
+}
+
+// riskCellKey はリスクのマスのキー（"probability/impact"）
+func riskCellKey(probability RiskProbability, impact RiskImpact) string {
+	return string(probability) + "/" + string(impact)
+}
+
+// riskCells は mitigated / closed 以外のリスクが属するマス（リスク ID → "probability/impact"）
+// 発生確率・影響度が不正なリスクは含めない
+func (z *Zeus) riskCells(ctx context.Context) map[string]string {
+	valid := map[string]bool{}
+	for _, p := range riskHeatmapProbabilities {
+		for _, i := range riskHeatmapImpacts {
+			valid[riskCellKey(p, i)] = true
+		}
+	}
+	cells := map[string]string{}
+	z.forEachYaml(ctx, "risks", func(path string) {
+		var r RiskEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &r); err != nil {
+			return
+		}
+		if r.Status == RiskStatusMitigated || r.Status == RiskStatusClosed {
+			return
+		}
+		if key := riskCellKey(r.Probability, r.Impact); valid[key] {
+			cells[r.ID] = key
+		}
+	})
+	return cells
+}
+
+// RiskHeatmap はリスクのヒートマップを返す
+// リスクの配置を記録した直近のスナップショットを比較元として、マスごとの増減と移動を求める
+func (z *Zeus) RiskHeatmap(ctx context.Context) (*RiskHeatmap, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	current := z.riskCells(ctx)
+
+	heatmap := &RiskHeatmap{
+		Probabilities: riskHeatmapProbabilities,
+		Impacts:       riskHeatmapImpacts,
+		Cells:         []RiskHeatmapCell{},
+		Total:         len(current),
+		Added:         []string{},
+		Removed:       []string{},
+		Moved:         []RiskHeatmapMove{},
+	}
+
+	// 新しい順に並んでいるため、最初にリスクの配置を持つものが比較元
+	snapshots, err := z.GetHistory(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	var previous map[string]string
+	for _, s := range snapshots {
+		if s.State.RiskCells != nil {
+			previous = s.State.RiskCells
+			heatmap.Baseline = &RiskHeatmapSource{Timestamp: s.Timestamp, Label: s.Label}
+			break
+		}
+	}
+
+	byCell := map[string][]string{}
+	for id, key := range current {
+		byCell[key] = append(byCell[key], id)
+	}
+	previousCount := map[string]int{}
+	for _, key := range previous {
+		previousCount[key]++
+	}
+	for _, p := range riskHeatmapProbabilities {
+		for _, i := range riskHeatmapImpacts {
+			key := riskCellKey(p, i)
+			ids := byCell[key]
+			if ids == nil {
+				ids = []string{}
+			}
+			sort.Strings(ids)
+			cell := RiskHeatmapCell{
+				Probability: p,
+				Impact:      i,
+				Score:       CalculateRiskScore(p, i),
+				RiskIDs:     ids,
+				Count:       len(ids),
+			}
+			if previous != nil {
+				cell.Delta = len(ids) - previousCount[key]
+			}
+			heatmap.Cells = append(heatmap.Cells, cell)
+		}
+	}
+
+	if previous == nil {
+		return heatmap, nil
+	}
+	for id, key := range current {
+		before, ok := previous[id]
+		switch {
+		case !ok:
+			heatmap.Added = append(heatmap.Added, id)
+		case before != key:
+			heatmap.Moved = append(heatmap.Moved, RiskHeatmapMove{ID: id, From: before, To: key})
+		}
+	}
+	for id := range previous {
+		if _, ok := current[id]; !ok {
+			heatmap.Removed = append(heatmap.Removed, id)
+		}
+	}
+	sort.Strings(heatmap.Added)
+	sort.Strings(heatmap.Removed)
+	sort.Slice(heatmap.Moved, func(i, j int) bool {
+		return heatmap.Moved[i].ID < heatmap.Moved[j].ID
+	})
+	return heatmap, nil
+}
@@ -0,0 +1,101 @@
+package core
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRiskHeatmap(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	add := func(name string, opts ...EntityOption) string {
+		t.Helper()
+		result, err := z.Add(ctx, "risk", name, opts...)
+		if err != nil {
+			t.Fatalf("Add risk failed: %v", err)
+		}
+		return result.ID
+	}
+	vendor := add("Vendor delay", WithRiskProbability(RiskProbabilityHigh), WithRiskImpact(RiskImpactCritical))
+	staff := add("Staff shortage", WithRiskProbability(RiskProbabilityLow), WithRiskImpact(RiskImpactMedium))
+	add("Closed risk", WithRiskProbability(RiskProbabilityHigh), WithRiskImpact(RiskImpactCritical), WithRiskStatus(RiskStatusClosed))
+
+	cell := func(h *RiskHeatmap, p RiskProbability, i RiskImpact) RiskHeatmapCell {
+		t.Helper()
+		for _, c := range h.Cells {
+			if c.Probability == p && c.Impact == i {
+				return c
+			}
+		}
+		t.Fatalf("cell %s/%s not found", p, i)
+		return RiskHeatmapCell{}
+	}
+
+	// スナップショットが無い場合は増減を求めない
+	heatmap, err := z.RiskHeatmap(ctx)
+	if err != nil {
+		t.Fatalf("RiskHeatmap failed: %v", err)
+	}
+	if len(heatmap.Cells) != 12 || heatmap.Total != 2 || heatmap.Baseline != nil {
+		t.Fatalf("heatmap = %d cells, total %d, baseline %v", len(heatmap.Cells), heatmap.Total, heatmap.Baseline)
+	}
+	if heatmap.Cells[0].Probability != RiskProbabilityHigh || heatmap.Cells[0].Impact != RiskImpactLow {
+		t.Errorf("cells should start at high/low: %+v", heatmap.Cells[0])
+	}
+	c := cell(heatmap, RiskProbabilityHigh, RiskImpactCritical)
+	if c.Count != 1 || c.RiskIDs[0] != vendor || c.Score != RiskScoreCritical || c.Delta != 0 {
+		t.Errorf("high/critical cell = %+v", c)
+	}
+
+	if _, err := z.CreateSnapshot(ctx, "baseline"); err != nil {
+		t.Fatalf("CreateSnapshot failed: %v", err)
+	}
+
+	// スナップショット後の変更: 移動・追加・軽減
+	revision, err := z.EntityRevision(ctx, "risk", staff)
+	if err != nil {
+		t.Fatalf("EntityRevision failed: %v", err)
+	}
+	if _, err := z.UpdateEntity(ctx, "risk", staff, map[string]any{"probability": "medium"}, revision); err != nil {
+		t.Fatalf("UpdateEntity failed: %v", err)
+	}
+	budget := add("Budget overrun", WithRiskProbability(RiskProbabilityHigh), WithRiskImpact(RiskImpactCritical))
+	revision, err = z.EntityRevision(ctx, "risk", vendor)
+	if err != nil {
+		t.Fatalf("EntityRevision failed: %v", err)
+	}
+	if _, err := z.UpdateEntity(ctx, "risk", vendor, map[string]any{"status": "mitigated"}, revision); err != nil {
+		t.Fatalf("UpdateEntity failed: %v", err)
+	}
+
+	heatmap, err = z.RiskHeatmap(ctx)
+	if err != nil {
+		t.Fatalf("RiskHeatmap failed: %v", err)
+	}
+	if heatmap.Baseline == nil || heatmap.Baseline.Label != "baseline" {
+		t.Fatalf("baseline = %+v", heatmap.Baseline)
+	}
+	if c := cell(heatmap, RiskProbabilityHigh, RiskImpactCritical); c.Count != 1 || c.RiskIDs[0] != budget || c.Delta != 0 {
+		t.Errorf("high/critical cell = %+v", c)
+	}
+	if c := cell(heatmap, RiskProbabilityLow, RiskImpactMedium); c.Count != 0 || c.Delta != -1 {
+		t.Errorf("low/medium cell = %+v", c)
+	}
+	if c := cell(heatmap, RiskProbabilityMedium, RiskImpactMedium); c.Count != 1 || c.Delta != 1 {
+		t.Errorf("medium/medium cell = %+v", c)
+	}
+	if len(heatmap.Added) != 1 || heatmap.Added[0] != budget {
+		t.Errorf("added = %v", heatmap.Added)
+	}
+	if len(heatmap.Removed) != 1 || heatmap.Removed[0] != vendor {
+		t.Errorf("removed = %v", heatmap.Removed)
+	}
+	want := RiskHeatmapMove{ID: staff, From: "low/medium", To: "medium/medium"}
+	if len(heatmap.Moved) != 1 || heatmap.Moved[0] != want {
+		t.Errorf("moved = %+v", heatmap.Moved)
+	}
+}
@@ -0,0 +1,662 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/yaml"
+)
+
+// 自動化ルールのファイル配置
+const (
+	rulesDir          = "rules"                     // ルール定義（1 ファイル 1 ルール）
+	rulesSnapshotFile = "state/rules-snapshot.yaml" // 前回評価時点のエンティティ状態
+	rulesLogFile      = "logs/rules.yaml"           // notify（channel: log）の記録先
+	rulesLockFile     = "state/rules"               // 評価を直列化するロック（CLI とダッシュボードが同じイベントで重複実行しないため）
+)
+
+// ルールのトリガー種別
+const (
+	RuleOnCreated = "created"
+	RuleOnUpdated = "updated"
+	RuleOnAny     = "any"
+)
+
+// ルールのアクション種別
+const (
+	RuleActionCreateProblem = "create_problem"
+	RuleActionNotify        = "notify"
+)
+
+// ruleEntityDirs はルールの対象にできるエンティティとディレクトリ
+var ruleEntityDirs = map[string]string{
+	"objective":     "objectives",
+	"usecase":       "usecases",
+	"activity":      "activities",
+	"consideration": "considerations",
+	"decision":      "decisions",
+	"problem":       "problems",
+	"risk":          "risks",
+	"assumption":    "assumptions",
+	"quality":       "quality",
+}
+
+// ruleConditionOps は条件の比較演算子
+var ruleConditionOps = map[string]bool{
+	"eq": true, "ne": true, "in": true, "not_in": true, "contains": true,
+	"gt": true, "gte": true, "lt": true, "lte": true,
+}
+
+// ruleIgnoredFields は変更検出で無視するフィールド（保存のたびに変わる）
+var ruleIgnoredFields = map[string]bool{
+	"metadata.updated_at": true,
+}
+
+// rulePlaceholder はアクションの文字列中の {{field}} 置換
+var rulePlaceholder = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.]+)\s*\}\}`)
+
+// Rule は自動化ルール（.zeus/rules/*.yaml）
+type Rule struct {
+	ID          string          `yaml:"id"`
+	Description string          `yaml:"description,omitempty"`
+	Disabled    bool            `yaml:"disabled,omitempty"`
+	Trigger     RuleTrigger     `yaml:"trigger"`
+	Conditions  []RuleCondition `yaml:"conditions,omitempty"`
+	Actions     []RuleAction    `yaml:"actions"`
+}
+
+// RuleTrigger はルールを評価するエンティティ変更イベント
+type RuleTrigger struct {
+	Entity string `yaml:"entity"`          // 対象エンティティ種別（risk, problem, ...）
+	On     string `yaml:"on,omitempty"`    // created, updated, any（デフォルト: any）
+	Field  string `yaml:"field,omitempty"` // updated 時に変化を要求するフィールド（ドット区切り）
+}
+
+// RuleCondition はエンティティの現在値に対する条件（すべて満たす場合に発火）
+type RuleCondition struct {
+	Field string `yaml:"field"`        // ドット区切りのフィールド（例: mitigation.preventive）
+	Op    string `yaml:"op,omitempty"` // eq, ne, in, not_in, contains, gt, gte, lt, lte（デフォルト: eq）
+	Value any    `yaml:"value"`
+}
+
+// RuleAction はルール発火時のアクション（文字列中の {{field}} はエンティティの値に置換）
+type RuleAction struct {
+	Type     string `yaml:"type"`               // create_problem, notify
+	Title    string `yaml:"title,omitempty"`    // create_problem: Problem のタイトル
+	Severity string `yaml:"severity,omitempty"` // create_problem: 重大度（デフォルト: medium）
+	Channel  string `yaml:"channel,omitempty"`  // notify: log, email（デフォルト: log）
+	Message  string `yaml:"message,omitempty"`  // notify: 本文
+}
+
+// Validate はルール定義を検証
+func (r *Rule) Validate() error {
+	if r.ID == "" {
+		return fmt.Errorf("rule id is required")
+	}
+	if _, ok := ruleEntityDirs[r.Trigger.Entity]; !ok {
+		return fmt.Errorf("rule %s: unsupported trigger entity %q", r.ID, r.Trigger.Entity)
+	}
+	switch r.Trigger.On {
+	case "", RuleOnCreated, RuleOnUpdated, RuleOnAny:
+	default:
+		return fmt.Errorf("rule %s: trigger.on must be created, updated or any: %q", r.ID, r.Trigger.On)
+	}
+	for _, c := range r.Conditions {
+		if c.Field == "" {
+			return fmt.Errorf("rule %s: condition field is required", r.ID)
+		}
+		if c.Op != "" && !ruleConditionOps[c.Op] {
+			return fmt.Errorf("rule %s: unknown condition op %q", r.ID, c.Op)
+		}
+		if c.Op == "in" || c.Op == "not_in" {
+			if _, ok := c.Value.([]any); !ok {
+				return fmt.Errorf("rule %s: condition %s %s requires a list value", r.ID, c.Field, c.Op)
+			}
+		}
+	}
+	if len(r.Actions) == 0 {
+		return fmt.Errorf("rule %s: at least one action is required", r.ID)
+	}
+	for _, a := range r.Actions {
+		switch a.Type {
+		case RuleActionCreateProblem:
+			if a.Title == "" {
+				return fmt.Errorf("rule %s: create_problem requires title", r.ID)
+			}
+			if a.Severity != "" {
+				if _, ok := severityRank[a.Severity]; !ok {
+					return fmt.Errorf("rule %s: invalid severity %q", r.ID, a.Severity)
+				}
+			}
+		case RuleActionNotify:
+			if a.Message == "" {
+				return fmt.Errorf("rule %s: notify requires message", r.ID)
+			}
+			if a.Channel != "" && a.Channel != "log" && a.Channel != "email" {
+				return fmt.Errorf("rule %s: notify channel must be log or email: %q", r.ID, a.Channel)
+			}
+		default:
+			return fmt.Errorf("rule %s: unknown action type %q (create_problem, notify)", r.ID, a.Type)
+		}
+	}
+	return nil
+}
+
+// EntityChangeEvent は前回評価時点からのエンティティの変更
+type EntityChangeEvent struct {
+	Entity  string
+	ID      string
+	Kind    string         // created, updated
+	Changed []string       // 変化したフィールド（updated のみ）
+	Fields  map[string]any // 現在の値（ドット区切りのフィールド名）
+}
+
+// RuleTrace はイベントに対するルール評価の記録
+type RuleTrace struct {
+	RuleID   string
+	Entity   string
+	EntityID string
+	Event    string
+	Matched  bool
+	Reason   string // 不一致の理由、または一致した条件の要約
+	Actions  []RuleActionResult
+}
+
+// RuleActionResult はアクションの実行結果（dry-run では実行予定）
+type RuleActionResult struct {
+	Type   string
+	Detail string
+	Error  string
+}
+
+// RuleRunResult はルール評価の結果
+type RuleRunResult struct {
+	DryRun   bool
+	Baseline bool // 初回評価（スナップショットを記録するのみでルールは評価しない）
+	Events   int
+	Traces   []RuleTrace
+}
+
+// LoadRules は .zeus/rules/*.yaml のルールを読み込んで検証（ID 順）
+func (z *Zeus) LoadRules(ctx context.Context) ([]Rule, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var rules []Rule
+	var loadErr error
+	seen := map[string]string{}
+	z.forEachYaml(ctx, rulesDir, func(path string) {
+		if loadErr != nil {
+			return
+		}
+		var r Rule
+		if err := z.fileStore.ReadYaml(ctx, path, &r); err != nil {
+			loadErr = fmt.Errorf("failed to read %s: %w", path, err)
+			return
+		}
+		if err := r.Validate(); err != nil {
+			loadErr = fmt.Errorf("%s: %w", path, err)
+			return
+		}
+		if other, ok := seen[r.ID]; ok {
+			loadErr = fmt.Errorf("duplicate rule id %s (%s, %s)", r.ID, other, path)
+			return
+		}
+		seen[r.ID] = path
+		rules = append(rules, r)
+	})
+	if loadErr != nil {
+		return nil, loadErr
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+	return rules, nil
+}
+
+// RunRules は前回評価時点からのエンティティ変更イベントに対してルールを評価し、アクションを実行
+// エンティティの書き込みのたびにも自動で評価される（triggerRules）。初回はスナップショットを記録するのみ。ルールのアクションで作成されたエンティティは
+// 評価後のスナップショットに含まれるため、次回の評価でイベントにならない（連鎖の防止）
+func (z *Zeus) RunRules(ctx context.Context, dryRun bool) (*RuleRunResult, error) {
+	rules, err := z.LoadRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lock := yaml.NewFileLock(filepath.Join(z.ZeusPath, rulesLockFile))
+	if err := lock.LockWithTimeout(5 * time.Second); err != nil {
+		return nil, ErrLockAcquireFailed
+	}
+	defer lock.Unlock()
+	// アクションによる書き込みから評価を再帰的に起動しない
+	ctx = context.WithValue(ctx, ruleEvaluationKey{}, true)
+
+	current := z.ruleEntityStates(ctx)
+	result := &RuleRunResult{DryRun: dryRun, Traces: []RuleTrace{}}
+	if !z.fileStore.Exists(ctx, rulesSnapshotFile) {
+		result.Baseline = true
+		if !dryRun {
+			return result, z.saveRuleSnapshot(ctx, current)
+		}
+		return result, nil
+	}
+
+	var previous map[string]map[string]string
+	if err := z.fileStore.ReadYaml(ctx, rulesSnapshotFile, &previous); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", rulesSnapshotFile, err)
+	}
+
+	events := diffRuleStates(previous, current)
+	result.Events = len(events)
+	for _, event := range events {
+		for _, rule := range rules {
+			if rule.Disabled || rule.Trigger.Entity != event.Entity {
+				continue
+			}
+			result.Traces = append(result.Traces, z.applyRule(ctx, rule, event, dryRun))
+		}
+	}
+
+	if dryRun {
+		return result, nil
+	}
+	return result, z.saveRuleSnapshot(ctx, z.ruleEntityStates(ctx))
+}
+
+// ruleEvaluationKey はルール評価中であることを示すコンテキストのキー
+type ruleEvaluationKey struct{}
+
+// triggerRules はエンティティの書き込み（トランザクションはコミット後）からルールを評価する
+// zeus rules run で基準のスナップショットを記録するまでは評価しない。有効なルールがなければ何もしない。
+// 評価の失敗で書き込み自体は失敗させない
+func (z *Zeus) triggerRules(ctx context.Context) {
+	if ctx.Value(ruleEvaluationKey{}) != nil || inTransaction(z.fileStore) || !z.fileStore.Exists(ctx, rulesSnapshotFile) {
+		return
+	}
+	rules, err := z.LoadRules(ctx)
+	if err == nil && !slices.ContainsFunc(rules, func(r Rule) bool { return !r.Disabled }) {
+		return
+	}
+	if err == nil {
+		_, err = z.RunRules(ctx, false)
+	}
+	if err != nil {
+		fmt.Printf("Warning: ルールの評価に失敗しました: %v\n", err)
+	}
+}
+
+// changesRuleEntities はコミットした変更にルールの対象エンティティのファイルが含まれるか
+func changesRuleEntities(changes []FileChange) bool {
+	for _, c := range changes {
+		dir, _, _ := strings.Cut(filepath.ToSlash(c.Path), "/")
+		for _, d := range ruleEntityDirs {
+			if dir == d {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// TestRule は指定エンティティの現在値でルールを評価（アクションは実行しない）
+// kind が updated の場合、changed のフィールド（空ならトリガーのフィールド）が変化したものとして扱う
+func (z *Zeus) TestRule(ctx context.Context, rule Rule, entityID, kind string, changed []string) (*RuleTrace, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+	if kind != RuleOnCreated && kind != RuleOnUpdated {
+		return nil, fmt.Errorf("event must be created or updated: %q", kind)
+	}
+	dir := ruleEntityDirs[rule.Trigger.Entity]
+	if err := ValidateID(rule.Trigger.Entity, entityID); err != nil {
+		return nil, err
+	}
+	path := filepath.Join(dir, entityID+".yaml")
+	if !z.fileStore.Exists(ctx, path) {
+		return nil, fmt.Errorf("%s not found: %s", rule.Trigger.Entity, entityID)
+	}
+	var raw map[string]any
+	if err := z.fileStore.ReadYaml(ctx, path, &raw); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	event := EntityChangeEvent{Entity: rule.Trigger.Entity, ID: entityID, Kind: kind, Fields: flattenFields("", raw, map[string]any{})}
+	if kind == RuleOnUpdated {
+		event.Changed = changed
+		if len(event.Changed) == 0 && rule.Trigger.Field != "" {
+			event.Changed = []string{rule.Trigger.Field}
+		}
+	}
+	trace := z.applyRule(ctx, rule, event, true)
+	return &trace, nil
+}
+
+// applyRule はイベントに対してルールを評価し、一致した場合はアクションを実行
+func (z *Zeus) applyRule(ctx context.Context, rule Rule, event EntityChangeEvent, dryRun bool) RuleTrace {
+	trace := RuleTrace{RuleID: rule.ID, Entity: event.Entity, EntityID: event.ID, Event: event.Kind}
+
+	on := rule.Trigger.On
+	if on == "" {
+		on = RuleOnAny
+	}
+	if on != RuleOnAny && on != event.Kind {
+		trace.Reason = fmt.Sprintf("trigger on %s, got %s", on, event.Kind)
+		return trace
+	}
+	if rule.Trigger.Field != "" && event.Kind == RuleOnUpdated && !containsField(event.Changed, rule.Trigger.Field) {
+		trace.Reason = fmt.Sprintf("field %s not changed", rule.Trigger.Field)
+		return trace
+	}
+
+	var matched []string
+	for _, c := range rule.Conditions {
+		ok, desc := evaluateRuleCondition(c, event.Fields)
+		if !ok {
+			trace.Reason = "condition failed: " + desc
+			return trace
+		}
+		matched = append(matched, desc)
+	}
+
+	trace.Matched = true
+	trace.Reason = "all conditions met"
+	if len(matched) > 0 {
+		trace.Reason = strings.Join(matched, ", ")
+	}
+	for _, action := range rule.Actions {
+		trace.Actions = append(trace.Actions, z.runRuleAction(ctx, rule, action, event, dryRun))
+	}
+	return trace
+}
+
+// evaluateRuleCondition は条件を評価し、結果と説明を返す
+func evaluateRuleCondition(c RuleCondition, fields map[string]any) (bool, string) {
+	op := c.Op
+	if op == "" {
+		op = "eq"
+	}
+	actual, exists := fields[c.Field]
+	desc := fmt.Sprintf("%s %s %v (actual: %v)", c.Field, op, c.Value, actual)
+	if !exists {
+		return op == "ne" || op == "not_in", fmt.Sprintf("%s %s %v (field missing)", c.Field, op, c.Value)
+	}
+
+	switch op {
+	case "eq":
+		return ruleValueEqual(actual, c.Value), desc
+	case "ne":
+		return !ruleValueEqual(actual, c.Value), desc
+	case "in", "not_in":
+		found := false
+		for _, v := range c.Value.([]any) {
+			if ruleValueEqual(actual, v) {
+				found = true
+				break
+			}
+		}
+		return found == (op == "in"), desc
+	case "contains":
+		if list, ok := actual.([]any); ok {
+			for _, v := range list {
+				if ruleValueEqual(v, c.Value) {
+					return true, desc
+				}
+			}
+			return false, desc
+		}
+		return strings.Contains(fmt.Sprint(actual), fmt.Sprint(c.Value)), desc
+	default:
+		cmp, ok := compareRuleValues(actual, c.Value)
+		if !ok {
+			return false, fmt.Sprintf("%s %s %v (not comparable: %v)", c.Field, op, c.Value, actual)
+		}
+		switch op {
+		case "gt":
+			return cmp > 0, desc
+		case "gte":
+			return cmp >= 0, desc
+		case "lt":
+			return cmp < 0, desc
+		default:
+			return cmp <= 0, desc
+		}
+	}
+}
+
+// ruleValueEqual は文字列表現で比較（YAML の数値・文字列の違いを吸収）
+func ruleValueEqual(a, b any) bool {
+	return fmt.Sprint(a) == fmt.Sprint(b)
+}
+
+// compareRuleValues は数値、または重大度（low < medium < high < critical）として比較
+func compareRuleValues(a, b any) (int, bool) {
+	as, bs := fmt.Sprint(a), fmt.Sprint(b)
+	if af, err := strconv.ParseFloat(as, 64); err == nil {
+		if bf, err := strconv.ParseFloat(bs, 64); err == nil {
+			switch {
+			case af < bf:
+				return -1, true
+			case af > bf:
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+	ar, aok := severityRank[as]
+	br, bok := severityRank[bs]
+	if aok && bok {
+		// severityRank は critical が 0 のため符号を反転
+		return br - ar, true
+	}
+	return 0, false
+}
+
+// runRuleAction はアクションを実行（dry-run では内容のみ返す）
+func (z *Zeus) runRuleAction(ctx context.Context, rule Rule, action RuleAction, event EntityChangeEvent, dryRun bool) RuleActionResult {
+	result := RuleActionResult{Type: action.Type}
+	switch action.Type {
+	case RuleActionCreateProblem:
+		title := expandRuleTemplate(action.Title, event)
+		severity := ProblemSeverity(action.Severity)
+		if severity == "" {
+			severity = ProblemSeverityMedium
+		}
+		result.Detail = fmt.Sprintf("problem %q (%s)", title, severity)
+		if dryRun {
+			return result
+		}
+		opts := []EntityOption{
+			WithProblemSeverity(severity),
+			WithProblemDescription(fmt.Sprintf("rule %s により %s %s の変更から作成", rule.ID, event.Entity, event.ID)),
+			WithProblemReportedBy("rule:" + rule.ID),
+		}
+		if objectiveID, ok := event.Fields["objective_id"].(string); ok && objectiveID != "" {
+			opts = append(opts, WithProblemObjective(objectiveID))
+		}
+		// 承認レベル・エージェントのガードレール・監査ログは Add と同じ扱い
+		added, err := z.Add(ctx, "problem", title, opts...)
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+		if added.NeedsApproval {
+			result.Detail = fmt.Sprintf("queued problem %q (%s, approval %s)", title, severity, added.ApprovalID)
+			return result
+		}
+		result.Detail = fmt.Sprintf("created %s %q (%s)", added.ID, title, severity)
+
+	case RuleActionNotify:
+		channel := action.Channel
+		if channel == "" {
+			channel = "log"
+		}
+		message := expandRuleTemplate(action.Message, event)
+		result.Detail = fmt.Sprintf("%s: %s", channel, message)
+		if dryRun {
+			return result
+		}
+		var err error
+		if channel == "email" {
+			err = z.SendEmail(ctx, "ルール "+rule.ID, message, false)
+		} else {
+			err = z.appendRuleLog(ctx, rule, event, message)
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+	}
+	return result
+}
+
+// ruleLogEntry は notify（channel: log）の記録
+type ruleLogEntry struct {
+	Time     string `yaml:"time"`
+	Rule     string `yaml:"rule"`
+	Entity   string `yaml:"entity"`
+	EntityID string `yaml:"entity_id"`
+	Message  string `yaml:"message"`
+}
+
+// appendRuleLog は logs/rules.yaml に通知を追記
+func (z *Zeus) appendRuleLog(ctx context.Context, rule Rule, event EntityChangeEvent, message string) error {
+	var entries []ruleLogEntry
+	if z.fileStore.Exists(ctx, rulesLogFile) {
+		if err := z.fileStore.ReadYaml(ctx, rulesLogFile, &entries); err != nil {
+			return fmt.Errorf("failed to read %s: %w", rulesLogFile, err)
+		}
+	}
+	entries = append(entries, ruleLogEntry{Time: Now(), Rule: rule.ID, Entity: event.Entity, EntityID: event.ID, Message: message})
+	if err := z.fileStore.EnsureDir(ctx, filepath.Dir(rulesLogFile)); err != nil {
+		return err
+	}
+	return z.fileStore.WriteYaml(ctx, rulesLogFile, entries)
+}
+
+// expandRuleTemplate は {{field}} をエンティティの値に置換（{{entity}} はエンティティ種別）
+func expandRuleTemplate(s string, event EntityChangeEvent) string {
+	return rulePlaceholder.ReplaceAllStringFunc(s, func(m string) string {
+		key := rulePlaceholder.FindStringSubmatch(m)[1]
+		if key == "entity" {
+			return event.Entity
+		}
+		if v, ok := event.Fields[key]; ok {
+			return fmt.Sprint(v)
+		}
+		return ""
+	})
+}
+
+// ruleEntityStates は対象エンティティの現在値をフラット化して返す（キー: <entity>/<id>）
+func (z *Zeus) ruleEntityStates(ctx context.Context) map[string]map[string]any {
+	states := map[string]map[string]any{}
+	for entity, dir := range ruleEntityDirs {
+		z.forEachYaml(ctx, dir, func(path string) {
+			var raw map[string]any
+			if err := z.fileStore.ReadYaml(ctx, path, &raw); err != nil {
+				return
+			}
+			id, _ := raw["id"].(string)
+			if id == "" {
+				return
+			}
+			states[entity+"/"+id] = flattenFields("", raw, map[string]any{})
+		})
+	}
+	return states
+}
+
+// saveRuleSnapshot は比較用に文字列化した状態を保存
+func (z *Zeus) saveRuleSnapshot(ctx context.Context, states map[string]map[string]any) error {
+	snapshot := make(map[string]map[string]string, len(states))
+	for key, fields := range states {
+		snapshot[key] = stringifyFields(fields)
+	}
+	if err := z.fileStore.EnsureDir(ctx, filepath.Dir(rulesSnapshotFile)); err != nil {
+		return err
+	}
+	return z.fileStore.WriteYaml(ctx, rulesSnapshotFile, snapshot)
+}
+
+// diffRuleStates は前回の状態と比較して作成・変更イベントを返す（削除は対象外）
+func diffRuleStates(previous map[string]map[string]string, current map[string]map[string]any) []EntityChangeEvent {
+	keys := make([]string, 0, len(current))
+	for key := range current {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var events []EntityChangeEvent
+	for _, key := range keys {
+		entity, id, _ := strings.Cut(key, "/")
+		fields := current[key]
+		before, existed := previous[key]
+		if !existed {
+			events = append(events, EntityChangeEvent{Entity: entity, ID: id, Kind: RuleOnCreated, Fields: fields})
+			continue
+		}
+
+		now := stringifyFields(fields)
+		changed := map[string]bool{}
+		for field, value := range now {
+			if before[field] != value {
+				changed[field] = true
+			}
+		}
+		for field := range before {
+			if _, ok := now[field]; !ok {
+				changed[field] = true
+			}
+		}
+		var names []string
+		for field := range changed {
+			if !ruleIgnoredFields[field] {
+				names = append(names, field)
+			}
+		}
+		if len(names) == 0 {
+			continue
+		}
+		sort.Strings(names)
+		events = append(events, EntityChangeEvent{Entity: entity, ID: id, Kind: RuleOnUpdated, Changed: names, Fields: fields})
+	}
+	return events
+}
+
+// flattenFields はネストしたマップをドット区切りのキーに展開（リストはそのまま保持）
+func flattenFields(prefix string, value map[string]any, out map[string]any) map[string]any {
+	for k, v := range value {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]any); ok {
+			flattenFields(key, nested, out)
+			continue
+		}
+		out[key] = v
+	}
+	return out
+}
+
+// stringifyFields は比較用に値を文字列化
+func stringifyFields(fields map[string]any) map[string]string {
+	out := make(map[string]string, len(fields))
+	for k, v := range fields {
+		out[k] = fmt.Sprint(v)
+	}
+	return out
+}
+
+// containsField はフィールド名がリストに含まれるか（親フィールド指定でネストした変更も一致）
+func containsField(fields []string, name string) bool {
+	for _, f := range fields {
+		if f == name || strings.HasPrefix(f, name+".") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,341 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+const criticalRiskRule = `id: critical-risk
+trigger:
+  entity: risk
+  on: updated
+  field: risk_score
+conditions:
+  - field: risk_score
+    op: gte
+    value: high
+actions:
+  - type: create_problem
+    title: "Critical risk: {{title}}"
+    severity: critical
+  - type: notify
+    message: "{{id}} became {{risk_score}}"
+`
+
+func setupRulesTest(t *testing.T) (*Zeus, context.Context, string) {
+	t.Helper()
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	obj, err := z.Add(ctx, "objective", "Ship")
+	if err != nil {
+		t.Fatalf("Add objective failed: %v", err)
+	}
+	risk, err := z.Add(ctx, "risk", "DB outage", WithRiskObjective(obj.ID), WithRiskProbability(RiskProbabilityLow), WithRiskImpact(RiskImpactLow))
+	if err != nil {
+		t.Fatalf("Add risk failed: %v", err)
+	}
+	if err := z.fileStore.EnsureDir(ctx, rulesDir); err != nil {
+		t.Fatalf("EnsureDir failed: %v", err)
+	}
+	if err := z.fileStore.WriteFile(ctx, "rules/critical-risk.yaml", []byte(criticalRiskRule)); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	return z, ctx, risk.ID
+}
+
+func escalateRisk(t *testing.T, z *Zeus, ctx context.Context, id string) {
+	t.Helper()
+	handler, _ := z.entityRegistry.Get("risk")
+	got, err := handler.Get(ctx, id)
+	if err != nil {
+		t.Fatalf("Get risk failed: %v", err)
+	}
+	risk := got.(*RiskEntity)
+	risk.Probability = RiskProbabilityHigh
+	risk.Impact = RiskImpactHigh
+	if err := handler.Update(ctx, id, risk); err != nil {
+		t.Fatalf("Update risk failed: %v", err)
+	}
+}
+
+func listRuleProblems(t *testing.T, z *Zeus, ctx context.Context) []ProblemEntity {
+	t.Helper()
+	var problems []ProblemEntity
+	z.forEachYaml(ctx, "problems", func(path string) {
+		var p ProblemEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &p); err == nil {
+			problems = append(problems, p)
+		}
+	})
+	return problems
+}
+
+func TestRuleValidate(t *testing.T) {
+	valid := Rule{
+		ID:      "r",
+		Trigger: RuleTrigger{Entity: "risk"},
+		Actions: []RuleAction{{Type: RuleActionNotify, Message: "m"}},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("valid rule rejected: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(r *Rule)
+		want   string
+	}{
+		{"missing id", func(r *Rule) { r.ID = "" }, "id is required"},
+		{"unknown entity", func(r *Rule) { r.Trigger.Entity = "vision" }, "unsupported trigger entity"},
+		{"invalid on", func(r *Rule) { r.Trigger.On = "deleted" }, "trigger.on"},
+		{"unknown op", func(r *Rule) { r.Conditions = []RuleCondition{{Field: "status", Op: "like"}} }, "unknown condition op"},
+		{"in without list", func(r *Rule) { r.Conditions = []RuleCondition{{Field: "status", Op: "in", Value: "open"}} }, "requires a list"},
+		{"no actions", func(r *Rule) { r.Actions = nil }, "at least one action"},
+		{"unknown action", func(r *Rule) { r.Actions = []RuleAction{{Type: "webhook"}} }, "unknown action type"},
+		{"problem without title", func(r *Rule) { r.Actions = []RuleAction{{Type: RuleActionCreateProblem}} }, "requires title"},
+		{"invalid severity", func(r *Rule) {
+			r.Actions = []RuleAction{{Type: RuleActionCreateProblem, Title: "t", Severity: "urgent"}}
+		}, "invalid severity"},
+		{"invalid channel", func(r *Rule) { r.Actions = []RuleAction{{Type: RuleActionNotify, Message: "m", Channel: "slack"}} }, "notify channel"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := valid
+			tt.modify(&r)
+			err := r.Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Validate() = %v, want error containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateRuleCondition(t *testing.T) {
+	fields := map[string]any{
+		"status":                "open",
+		"risk_score":            "high",
+		"progress":              40,
+		"tags":                  []any{"infra", "db"},
+		"mitigation.preventive": "backup",
+	}
+	tests := []struct {
+		cond RuleCondition
+		want bool
+	}{
+		{RuleCondition{Field: "status", Value: "open"}, true},
+		{RuleCondition{Field: "status", Op: "ne", Value: "open"}, false},
+		{RuleCondition{Field: "status", Op: "in", Value: []any{"open", "in_progress"}}, true},
+		{RuleCondition{Field: "status", Op: "not_in", Value: []any{"closed"}}, true},
+		{RuleCondition{Field: "risk_score", Op: "gte", Value: "high"}, true},
+		{RuleCondition{Field: "risk_score", Op: "gt", Value: "high"}, false},
+		{RuleCondition{Field: "risk_score", Op: "lt", Value: "critical"}, true},
+		{RuleCondition{Field: "progress", Op: "gt", Value: 30}, true},
+		{RuleCondition{Field: "progress", Op: "lte", Value: "39.5"}, false},
+		{RuleCondition{Field: "tags", Op: "contains", Value: "db"}, true},
+		{RuleCondition{Field: "mitigation.preventive", Op: "contains", Value: "back"}, true},
+		{RuleCondition{Field: "status", Op: "gt", Value: 1}, false},
+		{RuleCondition{Field: "owner", Value: "alice"}, false},
+		{RuleCondition{Field: "owner", Op: "ne", Value: "alice"}, true},
+	}
+	for _, tt := range tests {
+		got, desc := evaluateRuleCondition(tt.cond, fields)
+		if got != tt.want {
+			t.Errorf("evaluateRuleCondition(%+v) = %v (%s), want %v", tt.cond, got, desc, tt.want)
+		}
+	}
+}
+
+func TestLoadRules_Invalid(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	_ = z.fileStore.EnsureDir(ctx, rulesDir)
+	_ = z.fileStore.WriteFile(ctx, "rules/a.yaml", []byte("id: dup\ntrigger: {entity: risk}\nactions: [{type: notify, message: m}]\n"))
+	_ = z.fileStore.WriteFile(ctx, "rules/b.yaml", []byte("id: dup\ntrigger: {entity: risk}\nactions: [{type: notify, message: m}]\n"))
+	if _, err := z.LoadRules(ctx); err == nil || !strings.Contains(err.Error(), "duplicate rule id") {
+		t.Errorf("LoadRules() error = %v, want duplicate rule id", err)
+	}
+}
+
+func TestRunRules(t *testing.T) {
+	z, ctx, riskID := setupRulesTest(t)
+
+	// 初回はスナップショットの記録のみ
+	result, err := z.RunRules(ctx, false)
+	if err != nil {
+		t.Fatalf("RunRules baseline failed: %v", err)
+	}
+	if !result.Baseline || len(result.Traces) != 0 {
+		t.Fatalf("first run should only record baseline: %+v", result)
+	}
+
+	// 変更なし
+	result, err = z.RunRules(ctx, false)
+	if err != nil {
+		t.Fatalf("RunRules failed: %v", err)
+	}
+	if result.Baseline || result.Events != 0 {
+		t.Fatalf("expected no events, got %+v", result)
+	}
+
+	escalateRisk(t, z, ctx, riskID)
+
+	// dry-run はトレースのみ
+	result, err = z.RunRules(ctx, true)
+	if err != nil {
+		t.Fatalf("RunRules dry-run failed: %v", err)
+	}
+	if result.Events != 1 || len(result.Traces) != 1 || !result.Traces[0].Matched {
+		t.Fatalf("dry-run trace = %+v", result)
+	}
+	if got := result.Traces[0].Actions[0].Detail; got != `problem "Critical risk: DB outage" (critical)` {
+		t.Errorf("dry-run action detail = %q", got)
+	}
+	if problems := listRuleProblems(t, z, ctx); len(problems) != 0 {
+		t.Fatalf("dry-run created %d problem(s)", len(problems))
+	}
+
+	result, err = z.RunRules(ctx, false)
+	if err != nil {
+		t.Fatalf("RunRules failed: %v", err)
+	}
+	if len(result.Traces) != 1 || !result.Traces[0].Matched {
+		t.Fatalf("trace = %+v", result)
+	}
+	for _, a := range result.Traces[0].Actions {
+		if a.Error != "" {
+			t.Errorf("action %s failed: %s", a.Type, a.Error)
+		}
+	}
+	problems := listRuleProblems(t, z, ctx)
+	if len(problems) != 1 {
+		t.Fatalf("expected 1 problem, got %d", len(problems))
+	}
+	p := problems[0]
+	if p.Severity != ProblemSeverityCritical || p.Title != "Critical risk: DB outage" || p.ObjectiveID == "" {
+		t.Errorf("unexpected problem: %+v", p)
+	}
+
+	var logs []ruleLogEntry
+	if err := z.fileStore.ReadYaml(ctx, rulesLogFile, &logs); err != nil {
+		t.Fatalf("read rule log failed: %v", err)
+	}
+	if len(logs) != 1 || logs[0].Message != riskID+" became critical" {
+		t.Errorf("rule log = %+v", logs)
+	}
+
+	// ルールで作成された Problem はイベントにならない
+	result, err = z.RunRules(ctx, false)
+	if err != nil {
+		t.Fatalf("RunRules failed: %v", err)
+	}
+	if result.Events != 0 {
+		t.Errorf("expected no events after actions, got %d", result.Events)
+	}
+}
+
+func TestRules_EvaluatedOnWrite(t *testing.T) {
+	z, ctx, riskID := setupRulesTest(t)
+	echo := "id: echo\ntrigger: {entity: problem, on: created}\nactions: [{type: create_problem, title: \"Echo: {{title}}\"}]\n"
+	if err := z.fileStore.WriteFile(ctx, "rules/echo.yaml", []byte(echo)); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	// 基準のスナップショットを記録するまでは書き込みで評価しない
+	if _, err := z.Add(ctx, "problem", "Before baseline"); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if problems := listRuleProblems(t, z, ctx); len(problems) != 1 {
+		t.Fatalf("rules should not run before baseline: %d problem(s)", len(problems))
+	}
+	if _, err := z.RunRules(ctx, false); err != nil {
+		t.Fatalf("RunRules baseline failed: %v", err)
+	}
+
+	// 更新（トランザクションのコミット後）で評価する
+	revision, err := z.EntityRevision(ctx, "risk", riskID)
+	if err != nil {
+		t.Fatalf("EntityRevision failed: %v", err)
+	}
+	err = z.Transaction(ctx, func(tx *Zeus) error {
+		_, err := tx.UpdateEntity(ctx, "risk", riskID, map[string]any{"probability": "high", "impact": "high"}, revision)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("UpdateEntity failed: %v", err)
+	}
+	// ルールで作成した Problem から echo は連鎖しない
+	if problems := listRuleProblems(t, z, ctx); len(problems) != 2 {
+		t.Fatalf("expected critical risk problem only, got %+v", problems)
+	}
+
+	// 作成（トランザクション外）でも評価する
+	if _, err := z.Add(ctx, "problem", "Outage"); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	titles := map[string]bool{}
+	for _, p := range listRuleProblems(t, z, ctx) {
+		titles[p.Title] = true
+	}
+	if len(titles) != 4 || !titles["Critical risk: DB outage"] || !titles["Echo: Outage"] {
+		t.Errorf("problems = %v", titles)
+	}
+	if result, err := z.RunRules(ctx, false); err != nil || result.Events != 0 {
+		t.Errorf("events should already be evaluated: %+v, %v", result, err)
+	}
+}
+
+func TestTestRule(t *testing.T) {
+	z, ctx, riskID := setupRulesTest(t)
+	rules, err := z.LoadRules(ctx)
+	if err != nil || len(rules) != 1 {
+		t.Fatalf("LoadRules() = %v, %v", rules, err)
+	}
+	rule := rules[0]
+
+	trace, err := z.TestRule(ctx, rule, riskID, RuleOnUpdated, nil)
+	if err != nil {
+		t.Fatalf("TestRule failed: %v", err)
+	}
+	if trace.Matched || !strings.Contains(trace.Reason, "condition failed") {
+		t.Errorf("low risk should not match: %+v", trace)
+	}
+
+	trace, err = z.TestRule(ctx, rule, riskID, RuleOnCreated, nil)
+	if err != nil {
+		t.Fatalf("TestRule failed: %v", err)
+	}
+	if trace.Matched || !strings.Contains(trace.Reason, "trigger on updated") {
+		t.Errorf("created event should not match: %+v", trace)
+	}
+
+	escalateRisk(t, z, ctx, riskID)
+	trace, err = z.TestRule(ctx, rule, riskID, RuleOnUpdated, []string{"title"})
+	if err != nil {
+		t.Fatalf("TestRule failed: %v", err)
+	}
+	if trace.Matched || !strings.Contains(trace.Reason, "not changed") {
+		t.Errorf("unrelated change should not match: %+v", trace)
+	}
+
+	trace, err = z.TestRule(ctx, rule, riskID, RuleOnUpdated, nil)
+	if err != nil {
+		t.Fatalf("TestRule failed: %v", err)
+	}
+	if !trace.Matched || len(trace.Actions) != 2 {
+		t.Fatalf("escalated risk should match: %+v", trace)
+	}
+	if problems := listRuleProblems(t, z, ctx); len(problems) != 0 {
+		t.Errorf("TestRule must not execute actions, created %d problem(s)", len(problems))
+	}
+
+	if _, err := z.TestRule(ctx, rule, "risk-00000000", RuleOnUpdated, nil); err == nil {
+		t.Error("expected error for missing entity")
+	}
+}
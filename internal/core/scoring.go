@@ -0,0 +1,394 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ScoringMethod は優先度スコアの算出方式
+type ScoringMethod string
+
+const (
+	ScoringRICE ScoringMethod = "rice" // Reach × Impact × Confidence / Effort
+	ScoringWSJF ScoringMethod = "wsjf" // (Business Value + Time Criticality + Risk Reduction) / Job Size
+)
+
+// RICEScore は RICE 方式の評価値
+type RICEScore struct {
+	Reach      float64 `yaml:"reach" json:"reach"`           // 期間あたりの影響人数・件数
+	Impact     float64 `yaml:"impact" json:"impact"`         // 影響度（0.25, 0.5, 1, 2, 3 など）
+	Confidence float64 `yaml:"confidence" json:"confidence"` // 確信度（%、0〜100）
+	Effort     float64 `yaml:"effort" json:"effort"`         // 工数（人月など、0 より大きい）
+}
+
+// WSJFScore は WSJF 方式の評価値
+type WSJFScore struct {
+	BusinessValue   float64 `yaml:"business_value" json:"business_value"`
+	TimeCriticality float64 `yaml:"time_criticality" json:"time_criticality"`
+	RiskReduction   float64 `yaml:"risk_reduction" json:"risk_reduction"`
+	JobSize         float64 `yaml:"job_size" json:"job_size"` // 0 より大きい
+}
+
+// Validate は RICE の評価値の妥当性を検証
+func (r *RICEScore) Validate() error {
+	if r.Reach < 0 || r.Impact < 0 {
+		return fmt.Errorf("rice.reach and rice.impact must be >= 0")
+	}
+	if r.Confidence < 0 || r.Confidence > 100 {
+		return fmt.Errorf("rice.confidence must be between 0 and 100")
+	}
+	if r.Effort <= 0 {
+		return fmt.Errorf("rice.effort must be > 0")
+	}
+	return nil
+}
+
+// Score は RICE スコア
+func (r *RICEScore) Score() float64 {
+	return r.Reach * r.Impact * (r.Confidence / 100) / r.Effort
+}
+
+// Validate は WSJF の評価値の妥当性を検証
+func (w *WSJFScore) Validate() error {
+	if w.BusinessValue < 0 || w.TimeCriticality < 0 || w.RiskReduction < 0 {
+		return fmt.Errorf("wsjf.business_value, wsjf.time_criticality and wsjf.risk_reduction must be >= 0")
+	}
+	if w.JobSize <= 0 {
+		return fmt.Errorf("wsjf.job_size must be > 0")
+	}
+	return nil
+}
+
+// Score は WSJF スコア
+func (w *WSJFScore) Score() float64 {
+	return (w.BusinessValue + w.TimeCriticality + w.RiskReduction) / w.JobSize
+}
+
+// validateScores は設定されている評価値の妥当性を検証
+func validateScores(rice *RICEScore, wsjf *WSJFScore) error {
+	if rice != nil {
+		if err := rice.Validate(); err != nil {
+			return err
+		}
+	}
+	if wsjf != nil {
+		if err := wsjf.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Scorable は RICE / WSJF の評価値を持つエンティティ（Objective, Activity）
+type Scorable interface {
+	PriorityScores() (*RICEScore, *WSJFScore)
+}
+
+// PriorityScores は Objective の評価値
+func (o *ObjectiveEntity) PriorityScores() (*RICEScore, *WSJFScore) {
+	return o.RICE, o.WSJF
+}
+
+// PriorityScores は Activity の評価値
+func (a *ActivityEntity) PriorityScores() (*RICEScore, *WSJFScore) {
+	return a.RICE, a.WSJF
+}
+
+// ParseScoringMethod は方式名を解析（空文字列は自動判定）
+func ParseScoringMethod(s string) (ScoringMethod, error) {
+	switch m := ScoringMethod(strings.ToLower(strings.TrimSpace(s))); m {
+	case "", ScoringRICE, ScoringWSJF:
+		return m, nil
+	}
+	return "", fmt.Errorf("unknown scoring method: %s (expected rice or wsjf)", s)
+}
+
+// PriorityScore は method の方式でのスコア（評価値が無い場合は false）
+func PriorityScore(s Scorable, method ScoringMethod) (float64, bool) {
+	rice, wsjf := s.PriorityScores()
+	switch {
+	case method == ScoringRICE && rice != nil:
+		return rice.Score(), true
+	case method == ScoringWSJF && wsjf != nil:
+		return wsjf.Score(), true
+	}
+	return 0, false
+}
+
+// DetectScoringMethod は評価値を持つ項目が多い方式を返す（同数の場合は RICE）
+func DetectScoringMethod(items []Scorable) ScoringMethod {
+	var rice, wsjf int
+	for _, item := range items {
+		r, w := item.PriorityScores()
+		if r != nil {
+			rice++
+		}
+		if w != nil {
+			wsjf++
+		}
+	}
+	if wsjf > rice {
+		return ScoringWSJF
+	}
+	return ScoringRICE
+}
+
+// HigherPriority は a が b より優先か（スコアの高い順、スコアの無い項目は後ろ）
+func HigherPriority(a, b Scorable, method ScoringMethod) bool {
+	as, aok := PriorityScore(a, method)
+	bs, bok := PriorityScore(b, method)
+	if aok != bok {
+		return aok
+	}
+	return as > bs
+}
+
+// ParseRICEScore は reach=500,impact=2,confidence=80,effort=3 形式の指定を解析
+func ParseRICEScore(spec string) (*RICEScore, error) {
+	values, err := parseScoreSpec(spec, "reach", "impact", "confidence", "effort")
+	if err != nil {
+		return nil, fmt.Errorf("invalid rice: %w", err)
+	}
+	r := &RICEScore{Reach: values["reach"], Impact: values["impact"], Confidence: values["confidence"], Effort: values["effort"]}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ParseWSJFScore は business_value=8,time_criticality=5,risk_reduction=3,job_size=5 形式の指定を解析
+func ParseWSJFScore(spec string) (*WSJFScore, error) {
+	values, err := parseScoreSpec(spec, "business_value", "time_criticality", "risk_reduction", "job_size")
+	if err != nil {
+		return nil, fmt.Errorf("invalid wsjf: %w", err)
+	}
+	w := &WSJFScore{
+		BusinessValue:   values["business_value"],
+		TimeCriticality: values["time_criticality"],
+		RiskReduction:   values["risk_reduction"],
+		JobSize:         values["job_size"],
+	}
+	if err := w.Validate(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// parseScoreSpec は key=value のカンマ区切りを解析（keys はすべて必須）
+func parseScoreSpec(spec string, keys ...string) (map[string]float64, error) {
+	values := make(map[string]float64, len(keys))
+	for _, part := range strings.Split(spec, ",") {
+		key, raw, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, fmt.Errorf("%q (expected key=value)", part)
+		}
+		key = strings.TrimSpace(key)
+		if !slices.Contains(keys, key) {
+			return nil, fmt.Errorf("unknown key %q (expected %s)", key, strings.Join(keys, ", "))
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		values[key] = v
+	}
+	for _, key := range keys {
+		if _, ok := values[key]; !ok {
+			return nil, fmt.Errorf("%s is required", key)
+		}
+	}
+	return values, nil
+}
+
+// scoreFields は評価値の更新可能フィールド
+var scoreFields = []string{
+	"rice.reach", "rice.impact", "rice.confidence", "rice.effort",
+	"wsjf.business_value", "wsjf.time_criticality", "wsjf.risk_reduction", "wsjf.job_size",
+}
+
+// applyScoreFields は rice.* / wsjf.* のフィールド（値は数値の文字列）を評価値に反映
+// 評価値が未設定の場合は作成する（不足している値は検証でエラーになる）
+func applyScoreFields(rice **RICEScore, wsjf **WSJFScore, fields map[string]any) error {
+	for field, value := range fields {
+		prefix, name, ok := strings.Cut(field, ".")
+		if !ok || (prefix != "rice" && prefix != "wsjf") {
+			continue
+		}
+		v, err := strconv.ParseFloat(fmt.Sprint(value), 64)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", field, err)
+		}
+		if prefix == "rice" {
+			if *rice == nil {
+				*rice = &RICEScore{}
+			}
+			switch name {
+			case "reach":
+				(*rice).Reach = v
+			case "impact":
+				(*rice).Impact = v
+			case "confidence":
+				(*rice).Confidence = v
+			case "effort":
+				(*rice).Effort = v
+			default:
+				return fmt.Errorf("unknown field %s", field)
+			}
+			continue
+		}
+		if *wsjf == nil {
+			*wsjf = &WSJFScore{}
+		}
+		switch name {
+		case "business_value":
+			(*wsjf).BusinessValue = v
+		case "time_criticality":
+			(*wsjf).TimeCriticality = v
+		case "risk_reduction":
+			(*wsjf).RiskReduction = v
+		case "job_size":
+			(*wsjf).JobSize = v
+		default:
+			return fmt.Errorf("unknown field %s", field)
+		}
+	}
+	return nil
+}
+
+// WithRICEScore は Objective / Activity の RICE の評価値を設定
+func WithRICEScore(score *RICEScore) EntityOption {
+	return func(v any) {
+		switch e := v.(type) {
+		case *ObjectiveEntity:
+			e.RICE = score
+		case *ActivityEntity:
+			e.RICE = score
+		}
+	}
+}
+
+// WithWSJFScore は Objective / Activity の WSJF の評価値を設定
+func WithWSJFScore(score *WSJFScore) EntityOption {
+	return func(v any) {
+		switch e := v.(type) {
+		case *ObjectiveEntity:
+			e.WSJF = score
+		case *ActivityEntity:
+			e.WSJF = score
+		}
+	}
+}
+
+// PrioritizeOptions は Prioritize のオプション
+type PrioritizeOptions struct {
+	Method     ScoringMethod // 空の場合は評価値を持つ項目が多い方式
+	Entity     string        // objective / activity（空の場合は両方）
+	IncludeAll bool          // 完了・中止した Objective と廃止した Activity も含める
+}
+
+// PrioritizedItem は優先順位付けしたバックログの項目
+type PrioritizedItem struct {
+	Rank   int        `json:"rank,omitempty"` // スコアの無い項目は 0
+	Entity string     `json:"entity"`
+	ID     string     `json:"id"`
+	Title  string     `json:"title"`
+	Status string     `json:"status"`
+	Score  float64    `json:"score"`
+	RICE   *RICEScore `json:"rice,omitempty"`
+	WSJF   *WSJFScore `json:"wsjf,omitempty"`
+}
+
+// PrioritizeResult は優先順位付けの結果
+type PrioritizeResult struct {
+	Method   ScoringMethod     `json:"method"`
+	Ranked   []PrioritizedItem `json:"ranked"`   // スコアの高い順
+	Unscored []PrioritizedItem `json:"unscored"` // method の評価値が無い項目
+}
+
+// scoredEntity は優先順位付けの対象
+type scoredEntity struct {
+	Scorable
+	item PrioritizedItem
+}
+
+// Prioritize は Objective / Activity のバックログを RICE または WSJF のスコアで順位付けする
+func (z *Zeus) Prioritize(ctx context.Context, opts PrioritizeOptions) (*PrioritizeResult, error) {
+	var entities []scoredEntity
+
+	if opts.Entity != "" && opts.Entity != "objective" && opts.Entity != "activity" {
+		return nil, fmt.Errorf("%w: %s (prioritize supports objective and activity)", ErrUnknownEntity, opts.Entity)
+	}
+	if opts.Entity == "" || opts.Entity == "objective" {
+		handler, ok := z.entityRegistry.Get("objective")
+		if !ok {
+			return nil, fmt.Errorf("objective handler not found")
+		}
+		objectives, err := handler.(*ObjectiveHandler).getAllObjectives(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objectives {
+			if !opts.IncludeAll && (obj.Status == ObjectiveStatusCompleted || obj.Status == ObjectiveStatusCancelled) {
+				continue
+			}
+			entities = append(entities, scoredEntity{Scorable: obj, item: PrioritizedItem{
+				Entity: "objective", ID: obj.ID, Title: obj.Title, Status: string(obj.Status), RICE: obj.RICE, WSJF: obj.WSJF,
+			}})
+		}
+	}
+	if opts.Entity == "" || opts.Entity == "activity" {
+		handler := z.GetActivityHandler()
+		if handler == nil {
+			return nil, fmt.Errorf("activity handler not found")
+		}
+		activities, err := handler.GetAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range activities {
+			act := &activities[i]
+			if !opts.IncludeAll && act.Status == ActivityStatusDeprecated {
+				continue
+			}
+			entities = append(entities, scoredEntity{Scorable: act, item: PrioritizedItem{
+				Entity: "activity", ID: act.ID, Title: act.Title, Status: string(act.Status), RICE: act.RICE, WSJF: act.WSJF,
+			}})
+		}
+	}
+
+	method := opts.Method
+	if method == "" {
+		scorables := make([]Scorable, len(entities))
+		for i, e := range entities {
+			scorables[i] = e.Scorable
+		}
+		method = DetectScoringMethod(scorables)
+	}
+
+	sort.SliceStable(entities, func(i, j int) bool {
+		if HigherPriority(entities[i], entities[j], method) {
+			return true
+		}
+		if HigherPriority(entities[j], entities[i], method) {
+			return false
+		}
+		return entities[i].item.ID < entities[j].item.ID
+	})
+
+	result := &PrioritizeResult{Method: method, Ranked: []PrioritizedItem{}, Unscored: []PrioritizedItem{}}
+	for _, e := range entities {
+		score, ok := PriorityScore(e, method)
+		if !ok {
+			result.Unscored = append(result.Unscored, e.item)
+			continue
+		}
+		e.item.Score = score
+		e.item.Rank = len(result.Ranked) + 1
+		result.Ranked = append(result.Ranked, e.item)
+	}
+	return result, nil
+}
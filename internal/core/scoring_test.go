@@ -0,0 +1,134 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestParseRICEScore(t *testing.T) {
+	r, err := ParseRICEScore("reach=500, impact=2,confidence=80,effort=4")
+	if err != nil {
+		t.Fatalf("ParseRICEScore failed: %v", err)
+	}
+	if got := r.Score(); got != 200 {
+		t.Errorf("RICE score = %v, want 200", got)
+	}
+
+	for _, spec := range []string{
+		"reach=500,impact=2,confidence=80",           // effort が無い
+		"reach=500,impact=2,confidence=80,effort=0",  // effort は 0 より大きい
+		"reach=500,impact=2,confidence=180,effort=1", // confidence は 0〜100
+		"reach=x,impact=2,confidence=80,effort=1",
+		"reach=500,impact=2,confidence=80,effort=1,size=3",
+	} {
+		if _, err := ParseRICEScore(spec); err == nil {
+			t.Errorf("ParseRICEScore(%q) should fail", spec)
+		}
+	}
+}
+
+func TestParseWSJFScore(t *testing.T) {
+	w, err := ParseWSJFScore("business_value=8,time_criticality=5,risk_reduction=3,job_size=4")
+	if err != nil {
+		t.Fatalf("ParseWSJFScore failed: %v", err)
+	}
+	if got := w.Score(); got != 4 {
+		t.Errorf("WSJF score = %v, want 4", got)
+	}
+	if _, err := ParseWSJFScore("business_value=8,time_criticality=5,risk_reduction=3,job_size=0"); err == nil {
+		t.Error("job_size=0 should fail")
+	}
+}
+
+func TestPrioritize(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	add := func(entity, title string, opts ...EntityOption) string {
+		t.Helper()
+		result, err := z.Add(ctx, entity, title, opts...)
+		if err != nil {
+			t.Fatalf("Add %s failed: %v", title, err)
+		}
+		return result.ID
+	}
+	low := add("objective", "Low", WithRICEScore(&RICEScore{Reach: 100, Impact: 1, Confidence: 50, Effort: 1}))
+	high := add("activity", "High", WithRICEScore(&RICEScore{Reach: 1000, Impact: 2, Confidence: 100, Effort: 2}))
+	add("objective", "Done", WithObjectiveStatus(ObjectiveStatusCompleted),
+		WithRICEScore(&RICEScore{Reach: 9999, Impact: 3, Confidence: 100, Effort: 1}))
+	wsjfOnly := add("activity", "WSJF only", WithWSJFScore(&WSJFScore{BusinessValue: 8, TimeCriticality: 5, RiskReduction: 3, JobSize: 2}))
+	add("activity", "Unscored")
+
+	result, err := z.Prioritize(ctx, PrioritizeOptions{})
+	if err != nil {
+		t.Fatalf("Prioritize failed: %v", err)
+	}
+	if result.Method != ScoringRICE {
+		t.Errorf("method should be detected as rice, got %s", result.Method)
+	}
+	if len(result.Ranked) != 2 || result.Ranked[0].ID != high || result.Ranked[1].ID != low {
+		t.Fatalf("unexpected ranking: %+v", result.Ranked)
+	}
+	if result.Ranked[0].Rank != 1 || result.Ranked[0].Score != 1000 || result.Ranked[1].Score != 50 {
+		t.Errorf("unexpected scores: %+v", result.Ranked)
+	}
+	if len(result.Unscored) != 2 {
+		t.Errorf("completed objective should be excluded, unscored = %+v", result.Unscored)
+	}
+
+	// 完了した項目を含める
+	all, _ := z.Prioritize(ctx, PrioritizeOptions{IncludeAll: true})
+	if len(all.Ranked) != 3 || all.Ranked[0].Title != "Done" {
+		t.Errorf("--all should include completed objectives: %+v", all.Ranked)
+	}
+
+	// 方式とエンティティの指定
+	wsjf, err := z.Prioritize(ctx, PrioritizeOptions{Method: ScoringWSJF, Entity: "activity"})
+	if err != nil {
+		t.Fatalf("Prioritize failed: %v", err)
+	}
+	if len(wsjf.Ranked) != 1 || wsjf.Ranked[0].ID != wsjfOnly || wsjf.Ranked[0].Score != 8 || len(wsjf.Unscored) != 2 {
+		t.Errorf("unexpected wsjf ranking: %+v", wsjf)
+	}
+	if _, err := z.Prioritize(ctx, PrioritizeOptions{Entity: "risk"}); !errors.Is(err, ErrUnknownEntity) {
+		t.Errorf("unsupported entity should fail, got %v", err)
+	}
+}
+
+func TestUpdateEntity_ActivityScores(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	added, err := z.Add(ctx, "activity", "Build")
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	revision, err := z.EntityRevision(ctx, "activity", added.ID)
+	if err != nil {
+		t.Fatalf("EntityRevision failed: %v", err)
+	}
+
+	fields, _ := ParseFieldAssignments([]string{
+		"wsjf.business_value=8", "wsjf.time_criticality=5", "wsjf.risk_reduction=3", "wsjf.job_size=4",
+	})
+	if _, err := z.UpdateEntity(ctx, "activity", added.ID, fields, revision); err != nil {
+		t.Fatalf("UpdateEntity failed: %v", err)
+	}
+	got, _ := z.Get(ctx, "activity", added.ID)
+	if act := got.(*ActivityEntity); act.WSJF == nil || act.WSJF.Score() != 4 {
+		t.Errorf("wsjf should be updated: %+v", act.WSJF)
+	}
+
+	// 不完全な評価値は検証でエラー
+	revision, _ = z.EntityRevision(ctx, "activity", added.ID)
+	fields, _ = ParseFieldAssignments([]string{"rice.reach=100"})
+	if _, err := z.UpdateEntity(ctx, "activity", added.ID, fields, revision); err == nil {
+		t.Error("incomplete rice should fail validation")
+	}
+}
@@ -48,6 +48,13 @@ var idPatterns = map[string]*regexp.Regexp{
 	"subsystem": regexp.MustCompile(`^sub-([a-f0-9]{8}|[a-z][a-z0-9]*(-[a-z0-9]+)*)$`),
 	// UML Activity エンティティ（連番と UUID の両方を許可）
 	"activity": regexp.MustCompile(`^act-([0-9]{3}|[a-f0-9]{8})$`),
+	// UML StateMachine エンティティ（連番と UUID の両方を許可）
+	"statemachine": regexp.MustCompile(`^sm-([0-9]{3}|[a-f0-9]{8})$`),
+	// C4 アーキテクチャエンティティ（UUID と名前ベースの両方を許可）
+	"container": regexp.MustCompile(`^ctr-([a-f0-9]{8}|[a-z][a-z0-9]*(-[a-z0-9]+)*)$`),
+	"component": regexp.MustCompile(`^cmp-([a-f0-9]{8}|[a-z][a-z0-9]*(-[a-z0-9]+)*)$`),
+	// Release エンティティ（連番と UUID の両方を許可）
+	"release": regexp.MustCompile(`^rel-([0-9]{3}|[a-f0-9]{8})$`),
 }
 
 // entityDirectories はエンティティタイプとディレクトリのマッピング
@@ -68,6 +75,13 @@ var entityDirectories = map[string]string{
 	"subsystem": "",         // ルートに配置（subsystems.yaml、単一ファイル）
 	// UML Activity エンティティ
 	"activity": "activities", // activities/act-NNN.yaml
+	// UML StateMachine エンティティ
+	"statemachine": "statemachines", // statemachines/sm-NNN.yaml
+	// C4 アーキテクチャエンティティ
+	"container": "", // ルートに配置（containers.yaml、単一ファイル）
+	"component": "", // ルートに配置（components.yaml、単一ファイル）
+	// Release エンティティ
+	"release": "releases", // releases/rel-NNN.yaml
 }
 
 // ValidatePath はパストラバーサル攻撃を防ぐ
@@ -146,6 +160,16 @@ func ValidateID(entityType, id string) error {
 	return nil
 }
 
+// EntityTypeFromID は ID の形式からエンティティタイプを判定する
+func EntityTypeFromID(id string) (string, bool) {
+	for entityType, pattern := range idPatterns {
+		if pattern.MatchString(id) {
+			return entityType, true
+		}
+	}
+	return "", false
+}
+
 // GetEntityFilePath は ID からファイルパスを安全に生成する
 func GetEntityFilePath(baseDir, entityType, id string) (string, error) {
 	// 1. ID バリデーション
@@ -153,34 +177,39 @@ func GetEntityFilePath(baseDir, entityType, id string) (string, error) {
 		return "", err
 	}
 
-	// 2. ディレクトリ名を取得
+	// 2. ファイル名を構築
+	relativePath, err := entityRelativePath(entityType, id)
+	if err != nil {
+		return "", err
+	}
+
+	// 3. パストラバーサルチェック
+	return ValidatePath(baseDir, relativePath)
+}
+
+// entityRelativePath はエンティティのファイルの .zeus からの相対パスを返す（ID は検証しない）
+func entityRelativePath(entityType, id string) (string, error) {
 	dirName, ok := entityDirectories[entityType]
 	if !ok {
 		return "", fmt.Errorf("unknown entity type: %s", entityType)
 	}
-
-	// 3. ファイル名を構築
-	var relativePath string
-	if dirName == "" {
-		// ルートに配置する単一ファイルエンティティ
-		switch entityType {
-		case "vision":
-			relativePath = "vision.yaml"
-		case "constraint":
-			relativePath = "constraints.yaml"
-		case "actor":
-			relativePath = "actors.yaml"
-		case "subsystem":
-			relativePath = "subsystems.yaml"
-		default:
-			relativePath = id + ".yaml"
-		}
-	} else {
-		relativePath = filepath.Join(dirName, id+".yaml")
+	if dirName != "" {
+		return filepath.Join(dirName, id+".yaml"), nil
 	}
 
-	// 4. パストラバーサルチェック
-	return ValidatePath(baseDir, relativePath)
+	// ルートに配置する単一ファイルエンティティ
+	switch entityType {
+	case "vision":
+		return "vision.yaml", nil
+	case "constraint":
+		return "constraints.yaml", nil
+	case "actor":
+		return "actors.yaml", nil
+	case "subsystem":
+		return "subsystems.yaml", nil
+	default:
+		return id + ".yaml", nil
+	}
 }
 
 // IsValidEntityType はエンティティタイプが有効かどうかを確認
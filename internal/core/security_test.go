@@ -290,6 +290,26 @@ func TestValidateID_InvalidFormat(t *testing.T) {
 
 // ===== GetEntityFilePath テスト =====
 
+func TestEntityTypeFromID(t *testing.T) {
+	tests := []struct {
+		id   string
+		want string
+		ok   bool
+	}{
+		{"obj-001", "objective", true},
+		{"act-1a2b3c4d", "activity", true},
+		{"uc-login", "usecase", true},
+		{"qual-002", "quality", true},
+		{"unknown-001", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := EntityTypeFromID(tt.id)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("EntityTypeFromID(%q) = (%q, %v), want (%q, %v)", tt.id, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
 func TestGetEntityFilePath(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "zeus-security-test")
 	if err != nil {
@@ -0,0 +1,181 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultShowAuditLimit は zeus show で表示する監査ログの件数のデフォルト
+const DefaultShowAuditLimit = 10
+
+// EntityDetail はエンティティの内容と、参照を解決した関連エンティティ
+type EntityDetail struct {
+	Entity       string          `json:"entity"`
+	ID           string          `json:"id"`
+	Title        string          `json:"title"`
+	Path         string          `json:"path"`          // .zeus からの相対パス
+	Fields       map[string]any  `json:"fields"`        // YAML の内容
+	References   []RelatedEntity `json:"references"`    // このエンティティが参照している（親・依存先）
+	ReferencedBy []RelatedEntity `json:"referenced_by"` // このエンティティを参照している（子・関連するリスク・課題・品質など）
+	Audit        []AuditRecord   `json:"audit"`         // 直近の監査ログ（古い順）
+	Comments     []string        `json:"comments"`      // YAML に書かれたコメント
+	node         *yaml.Node
+}
+
+// RelatedEntity は参照でつながったエンティティ
+type RelatedEntity struct {
+	ID       string `json:"id"`
+	Entity   string `json:"entity,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Relation string `json:"relation"`          // 参照に使われているフィールド（objective_id など）
+	Missing  bool   `json:"missing,omitempty"` // 参照先が存在しない（参照切れ）
+}
+
+// ShowOptions は ShowEntity のオプション
+type ShowOptions struct {
+	AuditLimit int // 監査ログの件数（0 の場合は DefaultShowAuditLimit、負の場合は表示しない）
+}
+
+// ShowEntity は ID からエンティティの内容と関連エンティティ・監査ログ・コメントを返す
+// エンティティ種別は ID の接頭辞から推定する
+func (z *Zeus) ShowEntity(ctx context.Context, id string, opts ShowOptions) (*EntityDetail, error) {
+	entityType, ok := EntityTypeFromID(id)
+	if !ok {
+		return nil, fmt.Errorf("%w: cannot infer from ID %s", ErrUnknownEntity, id)
+	}
+	path, err := entityRelativePath(entityType, id)
+	if err != nil {
+		return nil, err
+	}
+	if !z.fileStore.Exists(ctx, path) {
+		return nil, fmt.Errorf("%w: %s", ErrEntityNotFound, id)
+	}
+	var doc yaml.Node
+	if err := z.fileStore.ReadYaml(ctx, path, &doc); err != nil {
+		return nil, err
+	}
+	node := findEntityNode(&doc, id)
+	if node == nil {
+		return nil, fmt.Errorf("%w: %s", ErrEntityNotFound, id)
+	}
+
+	detail := &EntityDetail{
+		Entity:       entityType,
+		ID:           id,
+		Path:         path,
+		Fields:       map[string]any{},
+		References:   []RelatedEntity{},
+		ReferencedBy: []RelatedEntity{},
+		Audit:        []AuditRecord{},
+		Comments:     []string{},
+		node:         node,
+	}
+	if err := node.Decode(&detail.Fields); err != nil {
+		return nil, err
+	}
+	detail.Title = yamlScalar(node, "title")
+	if detail.Title == "" {
+		detail.Title = yamlScalar(node, "name")
+	}
+
+	// ファイル先頭のコメントはルートの項目に付く
+	if len(doc.Content) > 0 && doc.Content[0] == node {
+		collectComments(&doc, false, &detail.Comments)
+	}
+	collectComments(node, true, &detail.Comments)
+
+	graph, err := z.BuildRelationGraph(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range graph.Edges {
+		switch id {
+		case e.From:
+			target, ok := graph.Nodes[e.To]
+			detail.References = append(detail.References, RelatedEntity{
+				ID: e.To, Entity: target.Type, Title: target.Title, Relation: e.Relation, Missing: !ok,
+			})
+		case e.To:
+			source := graph.Nodes[e.From]
+			detail.ReferencedBy = append(detail.ReferencedBy, RelatedEntity{
+				ID: e.From, Entity: source.Type, Title: source.Title, Relation: e.Relation,
+			})
+		}
+	}
+	// 参照元はエンティティ種別ごとにまとめて表示できるよう並べる
+	sort.SliceStable(detail.ReferencedBy, func(i, j int) bool {
+		a, b := detail.ReferencedBy[i], detail.ReferencedBy[j]
+		if a.Entity != b.Entity {
+			return a.Entity < b.Entity
+		}
+		return a.ID < b.ID
+	})
+
+	if opts.AuditLimit >= 0 {
+		limit := opts.AuditLimit
+		if limit == 0 {
+			limit = DefaultShowAuditLimit
+		}
+		trail, err := z.AuditTrail(ctx, "", 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range trail {
+			if r.ID == id {
+				detail.Audit = append(detail.Audit, r)
+			}
+		}
+		if len(detail.Audit) > limit {
+			detail.Audit = detail.Audit[len(detail.Audit)-limit:]
+		}
+	}
+	return detail, nil
+}
+
+// YAML はエンティティの内容をファイルに書かれた項目順の YAML で返す（コメントを除く）
+func (d *EntityDetail) YAML() (string, error) {
+	node := *d.node
+	stripComments(&node)
+	data, err := yaml.Marshal(&node)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// collectComments は node のコメント（recursive の場合は子孫のコメントも）を行ごとに集める
+func collectComments(node *yaml.Node, recursive bool, comments *[]string) {
+	for _, text := range []string{node.HeadComment, node.LineComment, node.FootComment} {
+		for _, line := range strings.Split(text, "\n") {
+			line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "#"))
+			if line != "" {
+				*comments = append(*comments, line)
+			}
+		}
+	}
+	if !recursive {
+		return
+	}
+	for _, child := range node.Content {
+		collectComments(child, true, comments)
+	}
+}
+
+// stripComments は node をコメントを除いた複製に置き換える（元のノードは変更しない）
+func stripComments(node *yaml.Node) {
+	node.HeadComment, node.LineComment, node.FootComment = "", "", ""
+	if len(node.Content) == 0 {
+		return
+	}
+	content := make([]*yaml.Node, len(node.Content))
+	for i, child := range node.Content {
+		c := *child
+		stripComments(&c)
+		content[i] = &c
+	}
+	node.Content = content
+}
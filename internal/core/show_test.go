@@ -0,0 +1,120 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestShowEntity(t *testing.T) {
+	ctx := context.Background()
+	z, ids := setupDeletePolicyTest(t)
+
+	detail, err := z.ShowEntity(ctx, ids["usecase"], ShowOptions{})
+	if err != nil {
+		t.Fatalf("ShowEntity failed: %v", err)
+	}
+	if detail.Entity != "usecase" || detail.Title != "決済する" || detail.Path != "usecases/"+ids["usecase"]+".yaml" {
+		t.Errorf("unexpected detail: %+v", detail)
+	}
+	if detail.Fields["objective_id"] != ids["objective"] {
+		t.Errorf("expected fields to include objective_id: %+v", detail.Fields)
+	}
+
+	refs := map[string]RelatedEntity{}
+	for _, r := range detail.References {
+		refs[r.ID] = r
+	}
+	if r := refs[ids["objective"]]; r.Title != "決済刷新" || r.Relation != "objective_id" || r.Missing {
+		t.Errorf("expected objective reference to be resolved: %+v", detail.References)
+	}
+	if r := refs[ids["actor"]]; r.Title != "購入者" || r.Entity != "actor" {
+		t.Errorf("expected actor reference to be resolved: %+v", detail.References)
+	}
+	if len(detail.ReferencedBy) != 1 || detail.ReferencedBy[0].ID != ids["activity"] || detail.ReferencedBy[0].Title != "決済フロー" {
+		t.Errorf("expected activity to reference the usecase: %+v", detail.ReferencedBy)
+	}
+	if len(detail.Audit) != 1 || detail.Audit[0].Op != "create" {
+		t.Errorf("expected create audit record: %+v", detail.Audit)
+	}
+
+	out, err := detail.YAML()
+	if err != nil {
+		t.Fatalf("YAML failed: %v", err)
+	}
+	if !strings.HasPrefix(out, "id: "+ids["usecase"]+"\ntitle: 決済する\n") {
+		t.Errorf("expected fields in file order:\n%s", out)
+	}
+}
+
+func TestShowEntity_ReferencedByAndMissing(t *testing.T) {
+	ctx := context.Background()
+	z, ids := setupDeletePolicyTest(t)
+
+	detail, err := z.ShowEntity(ctx, ids["objective"], ShowOptions{AuditLimit: -1})
+	if err != nil {
+		t.Fatalf("ShowEntity failed: %v", err)
+	}
+	got := []string{}
+	for _, r := range detail.ReferencedBy {
+		got = append(got, r.Entity)
+	}
+	if strings.Join(got, ",") != "risk,usecase" {
+		t.Errorf("expected referrers grouped by entity, got %v", got)
+	}
+	if len(detail.Audit) != 0 {
+		t.Errorf("expected audit to be skipped: %+v", detail.Audit)
+	}
+
+	// 参照先が存在しない場合は参照切れとして示す
+	path := filepath.Join(z.ZeusPath, "risks", ids["risk"]+".yaml")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = []byte(strings.Replace(string(data), "objective_id: "+ids["objective"], "objective_id: obj-00000000 # 移行後に付け替える", 1))
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	detail, err = z.ShowEntity(ctx, ids["risk"], ShowOptions{})
+	if err != nil {
+		t.Fatalf("ShowEntity failed: %v", err)
+	}
+	if len(detail.References) != 1 || !detail.References[0].Missing {
+		t.Errorf("expected missing reference: %+v", detail.References)
+	}
+	if len(detail.Comments) != 1 || detail.Comments[0] != "移行後に付け替える" {
+		t.Errorf("expected comment to be collected: %+v", detail.Comments)
+	}
+}
+
+func TestShowEntity_SingleFileEntity(t *testing.T) {
+	ctx := context.Background()
+	z, ids := setupDeletePolicyTest(t)
+
+	detail, err := z.ShowEntity(ctx, ids["actor"], ShowOptions{})
+	if err != nil {
+		t.Fatalf("ShowEntity failed: %v", err)
+	}
+	if detail.Path != "actors.yaml" || detail.Title != "購入者" {
+		t.Errorf("unexpected detail: %+v", detail)
+	}
+	if len(detail.ReferencedBy) != 1 || detail.ReferencedBy[0].ID != ids["usecase"] {
+		t.Errorf("expected usecase to reference the actor: %+v", detail.ReferencedBy)
+	}
+}
+
+func TestShowEntity_NotFound(t *testing.T) {
+	ctx := context.Background()
+	z, _ := setupDeletePolicyTest(t)
+
+	if _, err := z.ShowEntity(ctx, "obj-00000000", ShowOptions{}); !errors.Is(err, ErrEntityNotFound) {
+		t.Errorf("expected ErrEntityNotFound, got %v", err)
+	}
+	if _, err := z.ShowEntity(ctx, "unknown", ShowOptions{}); !errors.Is(err, ErrUnknownEntity) {
+		t.Errorf("expected ErrUnknownEntity, got %v", err)
+	}
+}
@@ -0,0 +1,142 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// AutoSnapshotLabel は自動スナップショットのラベル（保持ポリシーの対象）
+const AutoSnapshotLabel = "auto"
+
+// AutoSnapshotResult は自動スナップショットの実行結果
+type AutoSnapshotResult struct {
+	Created *Snapshot `json:"created,omitempty"`  // 間隔に達していない場合は nil
+	Pruned  []string  `json:"pruned"`             // 保持ポリシーで削除したスナップショットのタイムスタンプ
+	NextDue string    `json:"next_due,omitempty"` // 次に作成する日（YYYY-MM-DD）
+}
+
+// Validate は自動スナップショットの設定の妥当性を検証
+func (s SnapshotSettings) Validate() error {
+	if s.IntervalDays < 0 {
+		return fmt.Errorf("snapshots.interval_days must be >= 0")
+	}
+	if s.Retention.KeepLast < 0 || s.Retention.MaxAgeDays < 0 {
+		return fmt.Errorf("snapshots.retention.keep_last and snapshots.retention.max_age_days must be >= 0")
+	}
+	return nil
+}
+
+// SnapshotSettings は zeus.yaml の snapshots セクションを読み込んで検証する（zeus.yaml が無い場合は無効）
+func (z *Zeus) SnapshotSettings(ctx context.Context) (SnapshotSettings, error) {
+	var settings SnapshotSettings
+	config, err := z.LoadConfig(ctx)
+	switch {
+	case err == nil:
+		settings = config.Snapshots
+	case !errors.Is(err, ErrConfigNotFound):
+		return settings, err
+	}
+	if err := settings.Validate(); err != nil {
+		return settings, err
+	}
+	return settings, nil
+}
+
+// AutoSnapshot は最後の自動スナップショットから interval_days 日以上経過していれば
+// スナップショットを作成し、保持ポリシーに従って古い自動スナップショットを削除する。
+// 日単位で判定するため、ダッシュボードや daemon が停止していた期間の分は次回の評価で作成される
+func (z *Zeus) AutoSnapshot(ctx context.Context, settings SnapshotSettings, now time.Time) (*AutoSnapshotResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+	result := &AutoSnapshotResult{Pruned: []string{}}
+	if settings.IntervalDays == 0 {
+		return result, nil
+	}
+
+	snapshots, err := z.autoSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	today := truncateDay(now)
+	due := today
+	if len(snapshots) > 0 {
+		if last, ok := parseDigestTime(snapshots[0].Timestamp); ok {
+			due = truncateDay(last.In(now.Location())).AddDate(0, 0, settings.IntervalDays)
+		}
+	}
+	if !today.Before(due) {
+		snapshot, err := z.CreateSnapshot(ctx, AutoSnapshotLabel)
+		if err != nil {
+			return nil, err
+		}
+		result.Created = snapshot
+		due = today.AddDate(0, 0, settings.IntervalDays)
+	}
+	result.NextDue = due.Format("2006-01-02")
+
+	pruned, err := z.PruneSnapshots(ctx, settings.Retention, now)
+	if err != nil {
+		return nil, err
+	}
+	result.Pruned = pruned
+	return result, nil
+}
+
+// PruneSnapshots は保持ポリシーを超えた自動スナップショットを削除し、削除したタイムスタンプを返す
+// 新しい keep_last 件より古いもの、または max_age_days 日より古いものを削除する
+func (z *Zeus) PruneSnapshots(ctx context.Context, retention SnapshotRetention, now time.Time) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	snapshots, err := z.autoSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cutoff := truncateDay(now).AddDate(0, 0, -retention.MaxAgeDays)
+
+	pruned := []string{}
+	for i, s := range snapshots {
+		expired := retention.KeepLast > 0 && i >= retention.KeepLast
+		if retention.MaxAgeDays > 0 {
+			if t, ok := parseDigestTime(s.Timestamp); ok && truncateDay(t.In(now.Location())).Before(cutoff) {
+				expired = true
+			}
+		}
+		if !expired {
+			continue
+		}
+		if err := z.stateStore.DeleteSnapshot(ctx, s.Timestamp); err != nil {
+			return pruned, err
+		}
+		pruned = append(pruned, s.Timestamp)
+	}
+	return pruned, nil
+}
+
+// autoSnapshots は自動スナップショットを新しい順に返す
+func (z *Zeus) autoSnapshots(ctx context.Context) ([]Snapshot, error) {
+	history, err := z.stateStore.GetHistory(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := []Snapshot{}
+	for _, s := range history {
+		if s.Label == AutoSnapshotLabel {
+			snapshots = append(snapshots, s)
+		}
+	}
+	// タイムスタンプの文字列順はタイムゾーンが混在すると崩れるため時刻で並べ直す
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		a, _ := parseDigestTime(snapshots[i].Timestamp)
+		b, _ := parseDigestTime(snapshots[j].Timestamp)
+		return a.After(b)
+	})
+	return snapshots, nil
+}
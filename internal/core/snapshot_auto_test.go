@@ -0,0 +1,95 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestAutoSnapshot(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	now := time.Now()
+	writeSnapshot := func(daysAgo int, label string) string {
+		t.Helper()
+		ts := now.AddDate(0, 0, -daysAgo).Format(time.RFC3339)
+		path := fmt.Sprintf("state/snapshots/snapshot_%s.yaml", sanitizeTimestamp(ts))
+		if err := z.fileStore.WriteYaml(ctx, path, &Snapshot{Timestamp: ts, Label: label}); err != nil {
+			t.Fatalf("WriteYaml failed: %v", err)
+		}
+		return ts
+	}
+	settings := SnapshotSettings{IntervalDays: 7, Retention: SnapshotRetention{KeepLast: 3, MaxAgeDays: 60}}
+
+	// 無効な場合は何もしない
+	result, err := z.AutoSnapshot(ctx, SnapshotSettings{}, now)
+	if err != nil || result.Created != nil || len(result.Pruned) != 0 {
+		t.Fatalf("disabled auto snapshot = %+v, %v", result, err)
+	}
+
+	// 最後の自動スナップショットから 7 日未満なら作成しない
+	recent := writeSnapshot(3, AutoSnapshotLabel)
+	result, err = z.AutoSnapshot(ctx, settings, now)
+	if err != nil {
+		t.Fatalf("AutoSnapshot failed: %v", err)
+	}
+	if result.Created != nil || result.NextDue != now.AddDate(0, 0, 4).Format("2006-01-02") {
+		t.Errorf("snapshot should not be due: %+v", result)
+	}
+
+	// 手動のスナップショットは間隔の判定にも保持ポリシーにも含めない
+	manual := writeSnapshot(100, "release")
+	old := writeSnapshot(90, AutoSnapshotLabel)
+	third := writeSnapshot(20, AutoSnapshotLabel)
+	fourth := writeSnapshot(30, AutoSnapshotLabel)
+	if err := z.fileStore.Delete(ctx, fmt.Sprintf("state/snapshots/snapshot_%s.yaml", sanitizeTimestamp(recent))); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	writeSnapshot(10, AutoSnapshotLabel)
+
+	result, err = z.AutoSnapshot(ctx, settings, now)
+	if err != nil {
+		t.Fatalf("AutoSnapshot failed: %v", err)
+	}
+	if result.Created == nil || result.Created.Label != AutoSnapshotLabel {
+		t.Fatalf("snapshot should be created: %+v", result)
+	}
+	if result.NextDue != now.AddDate(0, 0, 7).Format("2006-01-02") {
+		t.Errorf("next due = %s", result.NextDue)
+	}
+	// 新しい 3 件（作成分、10 日前、20 日前）を残し、30 日前と 60 日を超えた 90 日前を削除
+	if len(result.Pruned) != 2 || result.Pruned[0] != fourth || result.Pruned[1] != old {
+		t.Errorf("pruned = %v", result.Pruned)
+	}
+	history, _ := z.GetHistory(ctx, 0)
+	kept := map[string]bool{}
+	for _, s := range history {
+		kept[s.Timestamp] = true
+	}
+	if len(history) != 4 || !kept[manual] || !kept[third] {
+		t.Errorf("history = %+v", history)
+	}
+
+	if _, err := z.AutoSnapshot(ctx, SnapshotSettings{IntervalDays: -1}, now); err == nil {
+		t.Error("negative interval should fail")
+	}
+}
+
+func TestPruneSnapshots_Unlimited(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	if _, err := z.CreateSnapshot(ctx, AutoSnapshotLabel); err != nil {
+		t.Fatalf("CreateSnapshot failed: %v", err)
+	}
+	pruned, err := z.PruneSnapshots(ctx, SnapshotRetention{}, time.Now().AddDate(1, 0, 0))
+	if err != nil || len(pruned) != 0 {
+		t.Errorf("empty retention should keep all snapshots: %v, %v", pruned, err)
+	}
+}
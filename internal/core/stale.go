@@ -0,0 +1,234 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+	"gopkg.in/yaml.v3"
+)
+
+// ArchiveDir はアーカイブしたエンティティの配置（.zeus からの相対パス、元のディレクトリ構成を保つ）
+const ArchiveDir = "archive"
+
+// AuditOpArchive は監査ログに記録するアーカイブ操作（変更フィードには delete として現れる）
+const AuditOpArchive = "archive"
+
+// staleEntityTypes は陳腐化分析の対象（zeus.yaml の analysis.stale.entities のキー）
+var staleEntityTypes = []string{"activity", "objective"}
+
+// Config は設定を陳腐化分析の設定に変換（値域とエンティティ種別を検証）
+func (s StaleSettings) Config() (analysis.StaleAnalyzerConfig, error) {
+	config := analysis.DefaultStaleConfig
+	apply := func(dst *analysis.StaleThresholds, src StaleThresholdSettings) {
+		if src.CompletedDays != 0 {
+			dst.CompletedStaleDays = src.CompletedDays
+		}
+		if src.BlockedDays != 0 {
+			dst.BlockedStaleDays = src.BlockedDays
+		}
+		if src.NoProgressDays != 0 {
+			dst.NoProgressDays = src.NoProgressDays
+		}
+	}
+	base := analysis.StaleThresholds{
+		CompletedStaleDays: config.CompletedStaleDays,
+		BlockedStaleDays:   config.BlockedStaleDays,
+		NoProgressDays:     config.NoProgressDays,
+	}
+	apply(&base, s.StaleThresholdSettings)
+	config.CompletedStaleDays, config.BlockedStaleDays, config.NoProgressDays = base.CompletedStaleDays, base.BlockedStaleDays, base.NoProgressDays
+
+	if len(s.Entities) > 0 {
+		config.Entities = make(map[string]analysis.StaleThresholds, len(s.Entities))
+		for entityType, thresholds := range s.Entities {
+			if !slices.Contains(staleEntityTypes, entityType) {
+				return config, fmt.Errorf("analysis.stale.entities: unknown entity type %q (activity, objective)", entityType)
+			}
+			var t analysis.StaleThresholds
+			apply(&t, thresholds)
+			config.Entities[entityType] = t
+		}
+	}
+	if err := config.Validate(); err != nil {
+		return config, fmt.Errorf("analysis.stale: %w", err)
+	}
+	return config, nil
+}
+
+// StaleConfig は zeus.yaml の analysis.stale から陳腐化分析の設定を構築
+// zeus.yaml が存在しない場合はデフォルト値を返す
+func (z *Zeus) StaleConfig(ctx context.Context) (analysis.StaleAnalyzerConfig, error) {
+	config, err := z.LoadConfig(ctx)
+	if err != nil {
+		if errors.Is(err, ErrConfigNotFound) {
+			return analysis.DefaultStaleConfig, nil
+		}
+		return analysis.StaleAnalyzerConfig{}, err
+	}
+	return config.Analysis.Stale.Config()
+}
+
+// AnalyzeStale は Activity と Objective の陳腐化を now の時点で分析
+func (z *Zeus) AnalyzeStale(ctx context.Context, now time.Time) (*analysis.StaleAnalysis, error) {
+	config, err := z.StaleConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []analysis.TaskInfo
+	z.forEachYaml(ctx, "activities", func(path string) {
+		var act ActivityEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &act); err != nil {
+			return
+		}
+		info := analysis.TaskInfo{
+			ID:         act.ID,
+			Title:      act.Title,
+			Status:     string(act.Status),
+			ParentID:   act.UseCaseID,
+			CreatedAt:  act.Metadata.CreatedAt,
+			UpdatedAt:  act.Metadata.UpdatedAt,
+			EntityType: "activity",
+		}
+		// 廃止した Activity は最後の更新を廃止日とみなす
+		if act.Status == ActivityStatusDeprecated {
+			info.CompletedAt = act.Metadata.UpdatedAt
+		}
+		tasks = append(tasks, info)
+	})
+	var objectives []analysis.ObjectiveInfo
+	z.forEachYaml(ctx, "objectives", func(path string) {
+		var obj ObjectiveEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &obj); err != nil {
+			return
+		}
+		objectives = append(objectives, analysis.ObjectiveInfo{
+			ID:        obj.ID,
+			Title:     obj.Title,
+			Status:    string(obj.Status),
+			CreatedAt: obj.Metadata.CreatedAt,
+			UpdatedAt: obj.Metadata.UpdatedAt,
+		})
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	analyzer := analysis.NewStaleAnalyzer(tasks, objectives, &config)
+	analyzer.SetNow(now)
+	result, err := analyzer.Analyze(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(result.StaleEntities, func(i, j int) bool {
+		return result.StaleEntities[i].DaysStale > result.StaleEntities[j].DaysStale
+	})
+	return result, nil
+}
+
+// StaleArchiveResult は陳腐化分析のアーカイブ推奨の実行結果
+type StaleArchiveResult struct {
+	Applied  bool             `json:"applied"`  // false の場合は対象の一覧のみ（移動していない）
+	Archived []ArchivedEntity `json:"archived"` // アーカイブした（Applied が false の場合はアーカイブする）エンティティ
+	Skipped  []ArchivedEntity `json:"skipped"`  // 参照元がある・承認が必要などでアーカイブしないエンティティ
+}
+
+// ArchivedEntity はアーカイブの対象となったエンティティ
+type ArchivedEntity struct {
+	Entity    string `json:"entity"`
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	DaysStale int    `json:"days_stale"`
+	Path      string `json:"path,omitempty"`   // アーカイブ先（.zeus からの相対パス）
+	Reason    string `json:"reason,omitempty"` // アーカイブしない理由
+}
+
+// ArchiveStale は陳腐化分析で archive を推奨したエンティティを archive/ に移動する（apply が false の場合は対象の一覧のみ）
+// 参照元が残っているエンティティと、削除に承認が必要なエンティティは移動しない
+// 移動したエンティティは監査ログに archive として記録する
+func (z *Zeus) ArchiveStale(ctx context.Context, now time.Time, apply bool) (*StaleArchiveResult, error) {
+	stale, err := z.AnalyzeStale(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	result := &StaleArchiveResult{Applied: apply, Archived: []ArchivedEntity{}, Skipped: []ArchivedEntity{}}
+
+	var config ZeusConfig
+	if apply {
+		config = z.approvalConfig(ctx)
+	}
+	for _, e := range stale.StaleEntities {
+		if e.Recommendation != analysis.StaleRecommendArchive {
+			continue
+		}
+		item := ArchivedEntity{Entity: e.EntityType, ID: e.EntityID, Title: e.EntityTitle, DaysStale: e.DaysStale}
+
+		plan, err := z.PlanDelete(ctx, e.EntityType, e.EntityID, DeleteOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if n := len(plan.Cascade) + len(plan.Nullify) + len(plan.Restricted); n > 0 {
+			item.Reason = fmt.Sprintf("referenced by %d entities", n)
+			result.Skipped = append(result.Skipped, item)
+			continue
+		}
+		path, err := entityRelativePath(e.EntityType, e.EntityID)
+		if err != nil {
+			return nil, err
+		}
+		item.Path = filepath.Join(ArchiveDir, path)
+
+		if apply {
+			level, err := z.operationApprovalLevel(ctx, &config, e.EntityType, ChangeOpDelete, e.EntityID)
+			if err != nil {
+				return nil, err
+			}
+			if level == ApprovalApprove {
+				item.Reason = "deletion requires approval"
+				result.Skipped = append(result.Skipped, item)
+				continue
+			}
+			if err := z.archiveEntity(ctx, e.EntityType, e.EntityID, path); err != nil {
+				return nil, err
+			}
+		}
+		result.Archived = append(result.Archived, item)
+	}
+
+	if apply && len(result.Archived) > 0 {
+		if err := z.updateState(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// archiveEntity はエンティティのファイルを archive/ にコピーしてから削除する（インデックスはハンドラーが更新）
+func (z *Zeus) archiveEntity(ctx context.Context, entity, id, path string) error {
+	handler, ok := z.entityRegistry.Get(entity)
+	if !ok {
+		return ErrUnknownEntity
+	}
+	var doc yaml.Node
+	if err := z.fileStore.ReadYaml(ctx, path, &doc); err != nil {
+		return err
+	}
+	archivePath := filepath.Join(ArchiveDir, path)
+	if err := z.fileStore.EnsureDir(ctx, filepath.Dir(archivePath)); err != nil {
+		return err
+	}
+	if err := z.fileStore.WriteYaml(ctx, archivePath, &doc); err != nil {
+		return err
+	}
+	if err := handler.Delete(ctx, id); err != nil {
+		return err
+	}
+	z.audit(ctx, AuditEntry{Op: AuditOpArchive, Entity: entity, ID: id, Result: AuditResultApplied})
+	return nil
+}
@@ -0,0 +1,154 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func setupStaleTest(t *testing.T) (*Zeus, map[string]string) {
+	t.Helper()
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	add := func(entity, title string, opts ...EntityOption) string {
+		t.Helper()
+		result, err := z.Add(ctx, entity, title, opts...)
+		if err != nil {
+			t.Fatalf("Add %s failed: %v", title, err)
+		}
+		return result.ID
+	}
+	ids := map[string]string{}
+	ids["done"] = add("objective", "完了済み", WithObjectiveStatus(ObjectiveStatusCompleted))
+	ids["referenced"] = add("objective", "参照あり", WithObjectiveStatus(ObjectiveStatusCompleted))
+	ids["active"] = add("objective", "進行中", WithObjectiveStatus(ObjectiveStatusInProgress))
+	ids["risk"] = add("risk", "移行遅延", WithRiskObjective(ids["referenced"]))
+	ids["deprecated"] = add("activity", "旧フロー", WithActivityStatus(ActivityStatusDeprecated))
+	return z, ids
+}
+
+func writeStaleConfig(t *testing.T, z *Zeus, stale string) {
+	t.Helper()
+	data := "version: \"" + z.approvalConfig(context.Background()).Version + "\"\nanalysis:\n  stale:\n" + stale
+	if err := os.WriteFile(filepath.Join(z.ZeusPath, "zeus.yaml"), []byte(data), 0644); err != nil {
+		t.Fatalf("failed to write zeus.yaml: %v", err)
+	}
+}
+
+func TestAnalyzeStale_Thresholds(t *testing.T) {
+	ctx := context.Background()
+	z, ids := setupStaleTest(t)
+	now := time.Now().AddDate(0, 0, 40)
+
+	result, err := z.AnalyzeStale(ctx, now)
+	if err != nil {
+		t.Fatalf("AnalyzeStale failed: %v", err)
+	}
+	found := map[string]string{}
+	for _, e := range result.StaleEntities {
+		found[e.EntityID] = e.EntityType
+	}
+	if found[ids["done"]] != "objective" || found[ids["deprecated"]] != "activity" {
+		t.Errorf("expected completed objective and deprecated activity to be stale: %+v", result.StaleEntities)
+	}
+	if _, ok := found[ids["active"]]; ok {
+		t.Errorf("in-progress objective should not be stale: %+v", result.StaleEntities)
+	}
+
+	// Activity のみ閾値を延ばす
+	writeStaleConfig(t, z, "    completed_days: 30\n    entities:\n      activity:\n        completed_days: 60\n")
+	result, err = z.AnalyzeStale(ctx, now)
+	if err != nil {
+		t.Fatalf("AnalyzeStale failed: %v", err)
+	}
+	for _, e := range result.StaleEntities {
+		if e.EntityID == ids["deprecated"] && e.Type == "completed_old" {
+			t.Errorf("activity threshold override should apply: %+v", e)
+		}
+	}
+
+	writeStaleConfig(t, z, "    entities:\n      risk:\n        completed_days: 10\n")
+	if _, err := z.AnalyzeStale(ctx, now); err == nil {
+		t.Error("unknown entity type should be rejected")
+	}
+	writeStaleConfig(t, z, "    blocked_days: -1\n")
+	if _, err := z.AnalyzeStale(ctx, now); err == nil {
+		t.Error("negative threshold should be rejected")
+	}
+}
+
+func TestArchiveStale(t *testing.T) {
+	ctx := context.Background()
+	z, ids := setupStaleTest(t)
+	now := time.Now().AddDate(0, 0, 40)
+
+	preview, err := z.ArchiveStale(ctx, now, false)
+	if err != nil {
+		t.Fatalf("ArchiveStale failed: %v", err)
+	}
+	if preview.Applied || len(preview.Archived) != 2 {
+		t.Fatalf("expected 2 archive candidates: %+v", preview)
+	}
+	if len(preview.Skipped) != 1 || preview.Skipped[0].ID != ids["referenced"] {
+		t.Errorf("referenced objective should be skipped: %+v", preview.Skipped)
+	}
+	if !z.fileStore.Exists(ctx, "objectives/"+ids["done"]+".yaml") {
+		t.Fatal("preview should not move files")
+	}
+
+	result, err := z.ArchiveStale(ctx, now, true)
+	if err != nil {
+		t.Fatalf("ArchiveStale failed: %v", err)
+	}
+	if !result.Applied || len(result.Archived) != 2 {
+		t.Fatalf("expected 2 archived entities: %+v", result)
+	}
+	for _, path := range []string{"objectives/" + ids["done"] + ".yaml", "activities/" + ids["deprecated"] + ".yaml"} {
+		if z.fileStore.Exists(ctx, path) {
+			t.Errorf("%s should be moved", path)
+		}
+		if !z.fileStore.Exists(ctx, filepath.Join(ArchiveDir, path)) {
+			t.Errorf("%s should exist under %s", path, ArchiveDir)
+		}
+	}
+	if _, err := z.Get(ctx, "activity", ids["deprecated"]); err == nil {
+		t.Error("archived activity should be removed from the index")
+	}
+
+	archived := 0
+	trail, _ := z.AuditTrail(ctx, "", 0)
+	for _, r := range trail {
+		if r.Op == AuditOpArchive {
+			archived++
+		}
+	}
+	if archived != 2 {
+		t.Errorf("expected 2 archive audit records, got %d", archived)
+	}
+}
+
+func TestArchiveStale_RequiresApproval(t *testing.T) {
+	ctx := context.Background()
+	z, ids := setupStaleTest(t)
+	data := "version: \"" + z.approvalConfig(ctx).Version + "\"\nsettings:\n  operations:\n    objective_delete: approve\n"
+	if err := os.WriteFile(filepath.Join(z.ZeusPath, "zeus.yaml"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := z.ArchiveStale(ctx, time.Now().AddDate(0, 0, 40), true)
+	if err != nil {
+		t.Fatalf("ArchiveStale failed: %v", err)
+	}
+	if len(result.Archived) != 1 || result.Archived[0].ID != ids["deprecated"] {
+		t.Errorf("only the activity should be archived: %+v", result.Archived)
+	}
+	if !z.fileStore.Exists(ctx, "objectives/"+ids["done"]+".yaml") {
+		t.Error("objective requiring approval should not be moved")
+	}
+}
@@ -134,6 +134,19 @@ func (sm *StateManager) RestoreSnapshot(ctx context.Context, timestamp string) e
 	return sm.SaveCurrentState(ctx, &snapshot.State)
 }
 
+// DeleteSnapshot はスナップショットを削除
+func (sm *StateManager) DeleteSnapshot(ctx context.Context, timestamp string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	path := filepath.Join("state/snapshots", fmt.Sprintf("snapshot_%s.yaml", sanitizeTimestamp(timestamp)))
+	if !sm.fileStore.Exists(ctx, path) {
+		return ErrEntityNotFound
+	}
+	return sm.fileStore.Delete(ctx, path)
+}
+
 // CalculateState はタスクから状態を計算
 func (sm *StateManager) CalculateState(tasks []ListItem) *ProjectState {
 	stats := SummaryStats{
@@ -0,0 +1,364 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/google/uuid"
+)
+
+// StateMachineHandler はステートマシンエンティティのハンドラー
+type StateMachineHandler struct {
+	fileStore       FileStore
+	usecaseHandler  *UseCaseHandler
+	activityHandler *ActivityHandler
+	index           *entityIndex
+}
+
+// NewStateMachineHandler は StateMachineHandler を生成
+func NewStateMachineHandler(fs FileStore, usecaseHandler *UseCaseHandler, activityHandler *ActivityHandler) *StateMachineHandler {
+	return &StateMachineHandler{
+		fileStore:       fs,
+		usecaseHandler:  usecaseHandler,
+		activityHandler: activityHandler,
+		index:           newEntityIndex(fs, "statemachine", "statemachines"),
+	}
+}
+
+// Type はエンティティタイプを返す
+func (h *StateMachineHandler) Type() string {
+	return "statemachine"
+}
+
+// Add はステートマシンを追加
+func (h *StateMachineHandler) Add(ctx context.Context, name string, opts ...EntityOption) (*AddResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// statemachines ディレクトリを確保
+	if err := h.fileStore.EnsureDir(ctx, "statemachines"); err != nil {
+		return nil, fmt.Errorf("failed to ensure statemachines directory: %w", err)
+	}
+
+	id := h.generateStateMachineID()
+	now := Now()
+
+	machine := StateMachineEntity{
+		ID:     id,
+		Title:  name,
+		Status: StateMachineStatusDraft,
+		Metadata: Metadata{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+
+	// オプション適用
+	for _, opt := range opts {
+		opt(&machine)
+	}
+
+	// バリデーション
+	if err := machine.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 参照整合性チェック
+	if err := h.checkReferences(ctx, &machine); err != nil {
+		return nil, err
+	}
+
+	// 個別ファイルに保存
+	filePath := filepath.Join("statemachines", id+".yaml")
+	if err := h.writeStateMachine(ctx, filePath, &machine); err != nil {
+		return nil, err
+	}
+
+	return &AddResult{
+		Success: true,
+		ID:      id,
+		Entity:  h.Type(),
+	}, nil
+}
+
+// List はステートマシン一覧を取得
+func (h *StateMachineHandler) List(ctx context.Context, filter *ListFilter) (*ListResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// statemachines ディレクトリが存在しない場合は空リストを返す
+	if !h.fileStore.Exists(ctx, "statemachines") {
+		return &ListResult{
+			Entity: h.Type(),
+			Items:  []ListItem{},
+			Total:  0,
+		}, nil
+	}
+
+	files, err := h.fileStore.ListDir(ctx, "statemachines")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list statemachines directory: %w", err)
+	}
+
+	entries := h.index.Entries(ctx, files, func(file string) (IndexEntry, bool) {
+		var machine StateMachineEntity
+		if err := h.fileStore.ReadYaml(ctx, filepath.Join("statemachines", file), &machine); err != nil {
+			return IndexEntry{}, false
+		}
+		return stateMachineIndexEntry(&machine), true
+	})
+	items := indexEntriesToListItems(entries)
+
+	return &ListResult{
+		Entity: h.Type(),
+		Items:  items,
+		Total:  len(items),
+	}, nil
+}
+
+// Get はステートマシンを取得
+func (h *StateMachineHandler) Get(ctx context.Context, id string) (any, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// ID のセキュリティ検証
+	if err := ValidateID("statemachine", id); err != nil {
+		return nil, err
+	}
+
+	filePath := filepath.Join("statemachines", id+".yaml")
+	if !h.fileStore.Exists(ctx, filePath) {
+		return nil, ErrEntityNotFound
+	}
+
+	var machine StateMachineEntity
+	if err := h.fileStore.ReadYaml(ctx, filePath, &machine); err != nil {
+		return nil, fmt.Errorf("failed to read state machine file: %w", err)
+	}
+
+	return &machine, nil
+}
+
+// Update はステートマシンを更新
+func (h *StateMachineHandler) Update(ctx context.Context, id string, update any) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// ID のセキュリティ検証
+	if err := ValidateID("statemachine", id); err != nil {
+		return err
+	}
+
+	filePath := filepath.Join("statemachines", id+".yaml")
+	if !h.fileStore.Exists(ctx, filePath) {
+		return ErrEntityNotFound
+	}
+
+	var machine StateMachineEntity
+	if err := h.fileStore.ReadYaml(ctx, filePath, &machine); err != nil {
+		return fmt.Errorf("failed to read state machine file: %w", err)
+	}
+
+	// 更新データを適用
+	if updateMap, ok := update.(map[string]any); ok {
+		if title, exists := updateMap["title"].(string); exists {
+			machine.Title = title
+		}
+		if desc, exists := updateMap["description"].(string); exists {
+			machine.Description = desc
+		}
+		if status, exists := updateMap["status"].(string); exists {
+			machine.Status = StateMachineStatus(status)
+		}
+		if usecaseID, exists := updateMap["usecase_id"].(string); exists {
+			machine.UseCaseID = usecaseID
+		}
+		if states, exists := updateMap["states"].([]StateMachineState); exists {
+			machine.States = states
+		}
+		if transitions, exists := updateMap["transitions"].([]StateMachineTransition); exists {
+			machine.Transitions = transitions
+		}
+	}
+
+	machine.Metadata.UpdatedAt = Now()
+
+	// バリデーション
+	if err := machine.Validate(); err != nil {
+		return err
+	}
+
+	// 参照整合性チェック
+	if err := h.checkReferences(ctx, &machine); err != nil {
+		return err
+	}
+
+	return h.writeStateMachine(ctx, filePath, &machine)
+}
+
+// Delete はステートマシンを削除
+func (h *StateMachineHandler) Delete(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// ID のセキュリティ検証
+	if err := ValidateID("statemachine", id); err != nil {
+		return err
+	}
+
+	filePath := filepath.Join("statemachines", id+".yaml")
+	if !h.fileStore.Exists(ctx, filePath) {
+		return ErrEntityNotFound
+	}
+
+	if err := h.fileStore.Delete(ctx, filePath); err != nil {
+		return err
+	}
+	return h.index.Remove(ctx, id)
+}
+
+// GetAll は全ステートマシンを取得（API用）
+func (h *StateMachineHandler) GetAll(ctx context.Context) ([]StateMachineEntity, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if !h.fileStore.Exists(ctx, "statemachines") {
+		return []StateMachineEntity{}, nil
+	}
+
+	files, err := h.fileStore.ListDir(ctx, "statemachines")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list statemachines directory: %w", err)
+	}
+
+	machines := make([]StateMachineEntity, 0)
+	for _, file := range files {
+		if !hasYamlSuffix(file) {
+			continue
+		}
+		var machine StateMachineEntity
+		if err := h.fileStore.ReadYaml(ctx, filepath.Join("statemachines", file), &machine); err != nil {
+			continue // 読み込み失敗はスキップ
+		}
+		machines = append(machines, machine)
+	}
+
+	return machines, nil
+}
+
+// checkReferences は UseCase と状態に紐づく Activity の存在を確認
+func (h *StateMachineHandler) checkReferences(ctx context.Context, machine *StateMachineEntity) error {
+	if machine.UseCaseID != "" && h.usecaseHandler != nil {
+		if _, err := h.usecaseHandler.Get(ctx, machine.UseCaseID); err != nil {
+			return fmt.Errorf("referenced usecase not found: %s", machine.UseCaseID)
+		}
+	}
+	if h.activityHandler == nil {
+		return nil
+	}
+	for _, state := range machine.States {
+		if state.ActivityID == "" {
+			continue
+		}
+		if _, err := h.activityHandler.Get(ctx, state.ActivityID); err != nil {
+			return fmt.Errorf("referenced activity not found: %s (state %s)", state.ActivityID, state.ID)
+		}
+	}
+	return nil
+}
+
+// writeStateMachine はステートマシンファイルを書き込み、インデックスを更新
+func (h *StateMachineHandler) writeStateMachine(ctx context.Context, filePath string, machine *StateMachineEntity) error {
+	if err := h.fileStore.WriteYaml(ctx, filePath, machine); err != nil {
+		return fmt.Errorf("failed to write state machine file: %w", err)
+	}
+	return h.index.Upsert(ctx, stateMachineIndexEntry(machine))
+}
+
+// stateMachineIndexEntry はステートマシンからインデックスエントリを生成
+func stateMachineIndexEntry(machine *StateMachineEntity) IndexEntry {
+	return IndexEntry{
+		ID:        machine.ID,
+		Title:     machine.Title,
+		Status:    string(machine.Status),
+		CreatedAt: machine.Metadata.CreatedAt,
+		UpdatedAt: machine.Metadata.UpdatedAt,
+	}
+}
+
+// generateStateMachineID はステートマシン ID を生成（UUID 形式）
+func (h *StateMachineHandler) generateStateMachineID() string {
+	return fmt.Sprintf("sm-%s", uuid.New().String()[:8])
+}
+
+// ===== EntityOption 関数群 =====
+
+// WithStateMachineUseCase は UseCase ID を設定
+func WithStateMachineUseCase(usecaseID string) EntityOption {
+	return func(v any) {
+		if m, ok := v.(*StateMachineEntity); ok {
+			m.UseCaseID = usecaseID
+		}
+	}
+}
+
+// WithStateMachineDescription は説明を設定
+func WithStateMachineDescription(desc string) EntityOption {
+	return func(v any) {
+		if m, ok := v.(*StateMachineEntity); ok {
+			m.Description = desc
+		}
+	}
+}
+
+// WithStateMachineStatus はステータスを設定
+func WithStateMachineStatus(status StateMachineStatus) EntityOption {
+	return func(v any) {
+		if m, ok := v.(*StateMachineEntity); ok {
+			m.Status = status
+		}
+	}
+}
+
+// WithStateMachineStates は状態を設定
+func WithStateMachineStates(states []StateMachineState) EntityOption {
+	return func(v any) {
+		if m, ok := v.(*StateMachineEntity); ok {
+			m.States = states
+		}
+	}
+}
+
+// WithStateMachineTransitions は遷移を設定
+func WithStateMachineTransitions(transitions []StateMachineTransition) EntityOption {
+	return func(v any) {
+		if m, ok := v.(*StateMachineEntity); ok {
+			m.Transitions = transitions
+		}
+	}
+}
+
+// WithStateMachineOwner はオーナーを設定
+func WithStateMachineOwner(owner string) EntityOption {
+	return func(v any) {
+		if m, ok := v.(*StateMachineEntity); ok {
+			m.Metadata.Owner = owner
+		}
+	}
+}
+
+// WithStateMachineTags はタグを設定
+func WithStateMachineTags(tags []string) EntityOption {
+	return func(v any) {
+		if m, ok := v.(*StateMachineEntity); ok {
+			m.Metadata.Tags = tags
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/biwakonbu/zeus/internal/yaml"
+)
+
+// テスト用のセットアップ
+func setupStateMachineHandlerTest(t *testing.T) (*StateMachineHandler, *ActivityHandler, string) {
+	t.Helper()
+
+	zeusPath := t.TempDir() + "/.zeus"
+	if err := os.MkdirAll(zeusPath, 0755); err != nil {
+		t.Fatalf("failed to create zeus dir: %v", err)
+	}
+
+	fs := yaml.NewFileManager(zeusPath)
+	activityHandler := NewActivityHandler(fs, nil)
+	return NewStateMachineHandler(fs, nil, activityHandler), activityHandler, zeusPath
+}
+
+// orderStates は注文ライフサイクルの状態定義
+func orderStates(activityID string) []StateMachineState {
+	return []StateMachineState{
+		{ID: "s-start", Type: StateTypeInitial},
+		{ID: "s-draft", Type: StateTypeState, Name: "下書き"},
+		{ID: "s-approved", Type: StateTypeState, Name: "承認済み", ActivityID: activityID},
+		{ID: "s-end", Type: StateTypeFinal},
+	}
+}
+
+// orderTransitions は注文ライフサイクルの遷移定義
+func orderTransitions() []StateMachineTransition {
+	return []StateMachineTransition{
+		{ID: "t1", Source: "s-start", Target: "s-draft"},
+		{ID: "t2", Source: "s-draft", Target: "s-approved", Trigger: "approve", Guard: "[権限あり]"},
+		{ID: "t3", Source: "s-approved", Target: "s-end"},
+	}
+}
+
+func TestStateMachineHandlerCRUD(t *testing.T) {
+	handler, activityHandler, zeusPath := setupStateMachineHandlerTest(t)
+	ctx := context.Background()
+
+	act, err := activityHandler.Add(ctx, "承認処理")
+	if err != nil {
+		t.Fatalf("Activity Add failed: %v", err)
+	}
+
+	result, err := handler.Add(ctx, "注文ライフサイクル",
+		WithStateMachineStates(orderStates(act.ID)),
+		WithStateMachineTransitions(orderTransitions()),
+	)
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if !strings.HasPrefix(result.ID, "sm-") {
+		t.Errorf("expected ID prefix 'sm-', got %q", result.ID)
+	}
+	if _, err := os.Stat(zeusPath + "/statemachines/" + result.ID + ".yaml"); err != nil {
+		t.Errorf("state machine file should exist: %v", err)
+	}
+
+	// Get
+	got, err := handler.Get(ctx, result.ID)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	machine := got.(*StateMachineEntity)
+	if len(machine.States) != 4 || len(machine.Transitions) != 3 {
+		t.Errorf("unexpected states/transitions: %d/%d", len(machine.States), len(machine.Transitions))
+	}
+	if machine.Status != StateMachineStatusDraft {
+		t.Errorf("expected default status draft, got %q", machine.Status)
+	}
+
+	// List（インデックス経由）
+	list, err := handler.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if list.Total != 1 || list.Items[0].Title != "注文ライフサイクル" {
+		t.Errorf("unexpected list result: %+v", list)
+	}
+
+	// Update
+	if err := handler.Update(ctx, result.ID, map[string]any{"status": "active"}); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	got, _ = handler.Get(ctx, result.ID)
+	if got.(*StateMachineEntity).Status != StateMachineStatusActive {
+		t.Error("status should be updated to active")
+	}
+
+	// Delete
+	if err := handler.Delete(ctx, result.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := handler.Get(ctx, result.ID); !errors.Is(err, ErrEntityNotFound) {
+		t.Errorf("expected ErrEntityNotFound, got %v", err)
+	}
+	list, _ = handler.List(ctx, nil)
+	if list.Total != 0 {
+		t.Errorf("expected empty list after delete, got %d", list.Total)
+	}
+}
+
+func TestStateMachineHandlerAdd_ActivityNotFound(t *testing.T) {
+	handler, _, _ := setupStateMachineHandlerTest(t)
+	ctx := context.Background()
+
+	_, err := handler.Add(ctx, "注文ライフサイクル",
+		WithStateMachineStates(orderStates("act-00000000")),
+		WithStateMachineTransitions(orderTransitions()),
+	)
+	if err == nil || !strings.Contains(err.Error(), "referenced activity not found") {
+		t.Errorf("expected referenced activity error, got %v", err)
+	}
+}
+
+func TestStateMachineEntityValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		states      []StateMachineState
+		transitions []StateMachineTransition
+		wantErr     string
+	}{
+		{
+			name:        "valid",
+			states:      orderStates(""),
+			transitions: orderTransitions(),
+		},
+		{
+			name: "empty machine",
+		},
+		{
+			name:        "unreachable state",
+			states:      append(orderStates(""), StateMachineState{ID: "s-orphan", Type: StateTypeState, Name: "孤立"}),
+			transitions: orderTransitions(),
+			wantErr:     "unreachable states: s-orphan",
+		},
+		{
+			name:        "missing initial",
+			states:      orderStates("")[1:],
+			transitions: orderTransitions()[1:],
+			wantErr:     "initial state is required",
+		},
+		{
+			name:        "transition from final",
+			states:      orderStates(""),
+			transitions: append(orderTransitions(), StateMachineTransition{ID: "t4", Source: "s-end", Target: "s-draft"}),
+			wantErr:     "transition from final state",
+		},
+		{
+			name:        "unknown target",
+			states:      orderStates(""),
+			transitions: append(orderTransitions(), StateMachineTransition{ID: "t4", Source: "s-draft", Target: "s-missing"}),
+			wantErr:     "transition target not found",
+		},
+		{
+			name:    "state without name",
+			states:  []StateMachineState{{ID: "s-start", Type: StateTypeInitial}, {ID: "s-x", Type: StateTypeState}},
+			wantErr: "state name is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			machine := &StateMachineEntity{
+				ID:          "sm-001",
+				Title:       "注文ライフサイクル",
+				States:      tt.states,
+				Transitions: tt.transitions,
+			}
+			err := machine.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package core
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+)
+
+// StoreVersion は .zeus 配下の更新状態を表すキーを返す
+// ファイル数・合計サイズ・最新の mtime から算出するため、ファイルの追加・更新・削除で値が変わる
+func StoreVersion(basePath string) string {
+	var count int
+	var size int64
+	var latest int64
+
+	_ = filepath.WalkDir(basePath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil // 読めないエントリは無視
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		if !d.IsDir() {
+			count++
+			size += info.Size()
+		}
+		// ディレクトリの mtime も含めることでファイル削除を検知する
+		if mt := info.ModTime().UnixNano(); mt > latest {
+			latest = mt
+		}
+		return nil
+	})
+
+	return fmt.Sprintf("%d-%d-%d", count, size, latest)
+}
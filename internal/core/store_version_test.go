@@ -0,0 +1,55 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStoreVersion(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "activities", "act-001.yaml")
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(file, []byte("id: act-001\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	v1 := StoreVersion(dir)
+	if v2 := StoreVersion(dir); v2 != v1 {
+		t.Errorf("unchanged store: version changed %q -> %q", v1, v2)
+	}
+
+	// 追加
+	added := filepath.Join(dir, "activities", "act-002.yaml")
+	if err := os.WriteFile(added, []byte("id: act-002\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	v2 := StoreVersion(dir)
+	if v2 == v1 {
+		t.Error("add: version should change")
+	}
+
+	// 更新（サイズ同一でも mtime で検知）
+	later := time.Now().Add(time.Minute)
+	if err := os.WriteFile(file, []byte("id: act-009\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(file, later, later); err != nil {
+		t.Fatal(err)
+	}
+	v3 := StoreVersion(dir)
+	if v3 == v2 {
+		t.Error("update: version should change")
+	}
+
+	// 削除
+	if err := os.Remove(added); err != nil {
+		t.Fatal(err)
+	}
+	if v4 := StoreVersion(dir); v4 == v3 {
+		t.Error("delete: version should change")
+	}
+}
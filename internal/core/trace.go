@@ -0,0 +1,94 @@
+package core
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+)
+
+// BuildTraceabilityMatrix は Vision → Objective → UseCase → Activity と Quality の
+// 要求トレーサビリティマトリクスを構築
+func (z *Zeus) BuildTraceabilityMatrix(ctx context.Context) (*analysis.TraceabilityMatrix, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Vision（単一ファイル、未作成なら空）
+	var vision analysis.VisionInfo
+	var v Vision
+	if err := z.fileStore.ReadYaml(ctx, "vision.yaml", &v); err == nil {
+		vision = analysis.VisionInfo{
+			ID:        v.ID,
+			Title:     v.Title,
+			Statement: v.Statement,
+			Status:    string(v.Status),
+		}
+	}
+
+	objectives := []analysis.ObjectiveInfo{}
+	z.forEachYaml(ctx, "objectives", func(path string) {
+		var obj ObjectiveEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &obj); err == nil {
+			objectives = append(objectives, analysis.ObjectiveInfo{
+				ID:     obj.ID,
+				Title:  obj.Title,
+				Status: string(obj.Status),
+			})
+		}
+	})
+
+	usecases := []UseCaseEntity{}
+	z.forEachYaml(ctx, "usecases", func(path string) {
+		var uc UseCaseEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &uc); err == nil {
+			usecases = append(usecases, uc)
+		}
+	})
+
+	activities := []ActivityEntity{}
+	z.forEachYaml(ctx, "activities", func(path string) {
+		var act ActivityEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &act); err == nil {
+			activities = append(activities, act)
+		}
+	})
+
+	quality := []analysis.QualityInfo{}
+	z.forEachYaml(ctx, "quality", func(path string) {
+		var q QualityEntity
+		if err := z.fileStore.ReadYaml(ctx, path, &q); err == nil {
+			quality = append(quality, analysis.QualityInfo{
+				ID:          q.ID,
+				Title:       q.Title,
+				ObjectiveID: q.ObjectiveID,
+			})
+		}
+	})
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	builder := analysis.NewTraceabilityBuilder(
+		vision,
+		objectives,
+		toAnalysisUseCaseInfo(usecases),
+		toAnalysisActivityInfo(activities),
+		quality,
+	)
+	return builder.Build(), nil
+}
+
+// forEachYaml はディレクトリ内の YAML ファイルのパスを順に渡す（ディレクトリがなければ何もしない）
+func (z *Zeus) forEachYaml(ctx context.Context, dir string, fn func(path string)) {
+	files, err := z.fileStore.ListDir(ctx, dir)
+	if err != nil {
+		return
+	}
+	for _, file := range files {
+		if hasYamlSuffix(file) {
+			fn(filepath.Join(dir, file))
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+)
+
+func TestBuildTraceabilityMatrix(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	if _, err := z.Add(ctx, "vision", "Vision", WithVisionStatement("statement")); err != nil {
+		t.Fatalf("Add vision failed: %v", err)
+	}
+	obj, err := z.Add(ctx, "objective", "Objective")
+	if err != nil {
+		t.Fatalf("Add objective failed: %v", err)
+	}
+	uc, err := z.Add(ctx, "usecase", "UseCase", WithUseCaseObjective(obj.ID))
+	if err != nil {
+		t.Fatalf("Add usecase failed: %v", err)
+	}
+	act, err := z.Add(ctx, "activity", "Activity", WithActivityUseCase(uc.ID))
+	if err != nil {
+		t.Fatalf("Add activity failed: %v", err)
+	}
+	if _, err := z.Add(ctx, "quality", "Quality",
+		WithQualityObjective(obj.ID),
+		WithQualityMetrics([]QualityMetric{{ID: "m1", Name: "coverage", Target: 80, Status: MetricStatusInProgress}}),
+	); err != nil {
+		t.Fatalf("Add quality failed: %v", err)
+	}
+	orphan, err := z.Add(ctx, "activity", "Orphan")
+	if err != nil {
+		t.Fatalf("Add orphan activity failed: %v", err)
+	}
+
+	matrix, err := z.BuildTraceabilityMatrix(ctx)
+	if err != nil {
+		t.Fatalf("BuildTraceabilityMatrix failed: %v", err)
+	}
+
+	if len(matrix.Rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d: %+v", len(matrix.Rows), matrix.Rows)
+	}
+	full := matrix.Rows[0]
+	if full.ActivityID != act.ID || full.VisionID == "" || len(full.QualityIDs) != 1 || !full.Complete() {
+		t.Errorf("unexpected complete row: %+v", full)
+	}
+	missing := matrix.Rows[1]
+	if missing.ActivityID != orphan.ID || len(missing.Missing) != 1 || missing.Missing[0] != analysis.TraceLinkUseCase {
+		t.Errorf("unexpected orphan row: %+v", missing)
+	}
+}
@@ -0,0 +1,435 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+)
+
+// トランザクションのジャーナル
+const (
+	transactionJournalFile = "state/transaction-journal.yaml"
+	transactionTempSuffix  = ".txn" // ステージしたファイルの一時ファイル名の接尾辞（*.yaml に一致しない）
+)
+
+// transactionMu はトランザクションを直列化する（同一プロセス内のコミットの競合対策）
+var transactionMu sync.Mutex
+
+// transactionJournal はコミット中の操作の記録
+// ジャーナルが残っている場合はコミットが中断されているため、次回のトランザクション開始時に再実行する
+type transactionJournal struct {
+	ID        string                 `yaml:"id"`
+	CreatedAt string                 `yaml:"created_at"`
+	Ops       []transactionJournalOp `yaml:"ops"`
+}
+
+// transactionJournalOp はジャーナルの 1 操作（Temp を Path に移動、または Path を削除）
+type transactionJournalOp struct {
+	Path   string `yaml:"path"`
+	Temp   string `yaml:"temp,omitempty"`
+	Delete bool   `yaml:"delete,omitempty"`
+}
+
+// Transaction は fn 内の書き込みをステージングし、fn が成功した場合のみまとめて反映する
+//
+// fn には書き込みがステージングされる Zeus が渡される（ステージした内容は tx 内の読み込みに反映される）。
+// fn がエラーを返した場合は何も書き込まない。コミットはステージした内容を一時ファイルに書き出してから
+// ジャーナルを記録し、各ファイルを rename で置き換えるため、途中で中断しても次回のトランザクション開始時
+// （RecoverTransaction）にジャーナルから完了させられる。
+// トランザクション（またはドライラン）内で呼ばれた場合は外側のトランザクションに合流する。
+func (z *Zeus) Transaction(ctx context.Context, fn func(tx *Zeus) error) error {
+	_, err := z.Preview(ctx, fn)
+	return err
+}
+
+// Preview は Transaction と同様に fn の書き込みをまとめて反映し、反映したファイルと差分を返す
+func (z *Zeus) Preview(ctx context.Context, fn func(tx *Zeus) error) ([]FileChange, error) {
+	nested := inTransaction(z.fileStore)
+	changes, err := z.transaction(ctx, fn)
+	if err == nil && !nested && changesRuleEntities(changes) {
+		// コミット後（トランザクションのロックを解放してから）ルールを評価する
+		z.triggerRules(ctx)
+	}
+	return changes, err
+}
+
+// transaction はトランザクションを実行し、コミットしたファイルの差分を返す
+func (z *Zeus) transaction(ctx context.Context, fn func(tx *Zeus) error) ([]FileChange, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if inTransaction(z.fileStore) {
+		return nil, fn(z)
+	}
+
+	transactionMu.Lock()
+	defer transactionMu.Unlock()
+	// ステージした書き込みはコミット時に反映されるため、リビジョンの確認からコミットまで他のプロセスの更新を止める
+	unlock, err := z.lockEntityUpdate()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if err := z.RecoverTransaction(ctx); err != nil {
+		return nil, err
+	}
+
+	store := newTransactionStore(z.fileStore)
+	if err := fn(z.withFileStore(store)); err != nil {
+		return nil, err
+	}
+	// コミット後は base とステージした内容が一致するため、差分はコミット前に取得する
+	changes := store.changes(ctx)
+	if err := store.commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// ID カウンターはトランザクション側で更新されているため読み直す
+	z.idCounterManager.InvalidateCache()
+	return changes, nil
+}
+
+// inTransaction は書き込みをステージする FileStore か（トランザクション・ドライラン内）
+func inTransaction(fs FileStore) bool {
+	switch fs.(type) {
+	case *transactionStore, *dryRunStore:
+		return true
+	}
+	return false
+}
+
+// RecoverTransaction は中断されたコミットをジャーナルから完了させる（ジャーナルが無い場合は何もしない）
+func (z *Zeus) RecoverTransaction(ctx context.Context) error {
+	if !z.fileStore.Exists(ctx, transactionJournalFile) {
+		return nil
+	}
+	var journal transactionJournal
+	if err := z.fileStore.ReadYaml(ctx, transactionJournalFile, &journal); err != nil {
+		return fmt.Errorf("failed to read %s: %w", transactionJournalFile, err)
+	}
+	if err := applyTransactionJournal(ctx, z.fileStore, &journal); err != nil {
+		return fmt.Errorf("failed to recover transaction %s: %w", journal.ID, err)
+	}
+	return z.fileStore.Delete(ctx, transactionJournalFile)
+}
+
+// withFileStore は fileStore を差し替えた Zeus を作成（ハンドラーは差し替えた fileStore で作り直す）
+func (z *Zeus) withFileStore(fs FileStore) *Zeus {
+	opts := []Option{WithFileStore(fs), WithEmailSendFunc(z.emailSend), WithAgent(z.agent), WithActor(z.actor)}
+	// 注入された StateStore / ApprovalStore（モック等）はそのまま引き継ぐ
+	if _, ok := z.stateStore.(*StateManager); !ok {
+		opts = append(opts, WithStateStore(z.stateStore))
+	}
+	if _, ok := z.approvalStore.(*ApprovalManager); !ok {
+		opts = append(opts, WithApprovalStore(z.approvalStore))
+	}
+	tx := New(z.ProjectPath, opts...)
+	tx.eventLog, tx.auditLog = z.eventLog, z.auditLog
+	return tx
+}
+
+// applyTransactionJournal はジャーナルの操作を実行（再実行しても同じ結果になる）
+func applyTransactionJournal(ctx context.Context, fs FileStore, journal *transactionJournal) error {
+	for _, op := range journal.Ops {
+		if op.Delete {
+			if err := fs.Delete(ctx, op.Path); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			continue
+		}
+		// 一時ファイルが無い場合は移動済み
+		if !fs.Exists(ctx, op.Temp) {
+			continue
+		}
+		if err := fs.Rename(ctx, op.Temp, op.Path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stagedFile はステージしたファイルの内容
+type stagedFile struct {
+	data     []byte
+	copyFrom string // コミット時に base からコピーする（Copy / Rename で内容を読まずにステージした場合）
+	deleted  bool
+}
+
+// transactionStore は書き込みをメモリにステージする FileStore
+// 読み込みはステージした内容を優先し、無い場合は base に委譲する
+type transactionStore struct {
+	base FileStore
+
+	mu     sync.Mutex
+	staged map[string]*stagedFile
+}
+
+// newTransactionStore は base に対するトランザクション用の FileStore を作成
+func newTransactionStore(base FileStore) *transactionStore {
+	return &transactionStore{base: base, staged: map[string]*stagedFile{}}
+}
+
+func (s *transactionStore) lookup(path string) (*stagedFile, bool) {
+	f, ok := s.staged[filepath.Clean(path)]
+	return f, ok
+}
+
+func (s *transactionStore) stage(path string, f *stagedFile) {
+	s.staged[filepath.Clean(path)] = f
+}
+
+// Exists はファイルが存在するか確認
+func (s *transactionStore) Exists(ctx context.Context, path string) bool {
+	s.mu.Lock()
+	f, ok := s.lookup(path)
+	s.mu.Unlock()
+	if ok {
+		return !f.deleted && ctx.Err() == nil
+	}
+	return s.base.Exists(ctx, path)
+}
+
+// ReadYaml は YAML ファイルを読み込む
+func (s *transactionStore) ReadYaml(ctx context.Context, path string, v any) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	f, ok := s.lookup(path)
+	s.mu.Unlock()
+	switch {
+	case !ok:
+		return s.base.ReadYaml(ctx, path, v)
+	case f.deleted:
+		return &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+	case f.copyFrom != "":
+		return s.base.ReadYaml(ctx, f.copyFrom, v)
+	}
+	return yaml.Unmarshal(f.data, v)
+}
+
+// WriteYaml は YAML ファイルの書き込みをステージ
+func (s *transactionStore) WriteYaml(ctx context.Context, path string, data any) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	content, err := yaml.Marshal(data)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stage(path, &stagedFile{data: content})
+	return nil
+}
+
+// WriteFile はファイルの書き込みをステージ
+func (s *transactionStore) WriteFile(ctx context.Context, path string, data []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stage(path, &stagedFile{data: append([]byte(nil), data...)})
+	return nil
+}
+
+// EnsureDir はディレクトリを作成（空のディレクトリは部分的な状態にならないため即時に作成する）
+func (s *transactionStore) EnsureDir(ctx context.Context, path string) error {
+	return s.base.EnsureDir(ctx, path)
+}
+
+// Delete はファイルの削除をステージ
+func (s *transactionStore) Delete(ctx context.Context, path string) error {
+	if !s.Exists(ctx, path) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return &os.PathError{Op: "remove", Path: path, Err: os.ErrNotExist}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stage(path, &stagedFile{deleted: true})
+	return nil
+}
+
+// Copy はファイルのコピーをステージ
+func (s *transactionStore) Copy(ctx context.Context, src, dest string) error {
+	if !s.Exists(ctx, src) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return &os.PathError{Op: "open", Path: src, Err: os.ErrNotExist}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if f, ok := s.lookup(src); ok {
+		copied := *f
+		s.stage(dest, &copied)
+		return nil
+	}
+	s.stage(dest, &stagedFile{copyFrom: filepath.Clean(src)})
+	return nil
+}
+
+// Rename はファイルの移動をステージ
+func (s *transactionStore) Rename(ctx context.Context, src, dest string) error {
+	if err := s.Copy(ctx, src, dest); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stage(src, &stagedFile{deleted: true})
+	return nil
+}
+
+// Glob はパターンに一致するファイルを検索（ステージした内容を反映）
+func (s *transactionStore) Glob(ctx context.Context, pattern string) ([]string, error) {
+	matches, err := s.base.Glob(ctx, pattern)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.merge(matches, func(path string) bool {
+		ok, _ := filepath.Match(filepath.Clean(pattern), path)
+		return ok
+	}, func(path string) string { return path }), nil
+}
+
+// ListDir はディレクトリ内のファイルを列挙（ステージした内容を反映）
+func (s *transactionStore) ListDir(ctx context.Context, path string) ([]string, error) {
+	files, err := s.base.ListDir(ctx, path)
+	dir := filepath.Clean(path)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		// ステージした新規ファイルのみのディレクトリ
+		if !os.IsNotExist(err) || !s.hasStagedIn(dir) {
+			return nil, err
+		}
+		files = []string{}
+	}
+	return s.merge(files, func(p string) bool {
+		return filepath.Dir(p) == dir
+	}, func(p string) string { return filepath.Base(p) }), nil
+}
+
+// BasePath はベースパスを返す
+func (s *transactionStore) BasePath() string {
+	return s.base.BasePath()
+}
+
+// hasStagedIn は dir 直下にステージした（削除以外の）ファイルがあるか
+func (s *transactionStore) hasStagedIn(dir string) bool {
+	for path, f := range s.staged {
+		if !f.deleted && filepath.Dir(path) == dir {
+			return true
+		}
+	}
+	return false
+}
+
+// merge は base の結果にステージした内容を反映（name はステージしたパスを結果の形式に変換）
+func (s *transactionStore) merge(base []string, match func(path string) bool, name func(path string) string) []string {
+	seen := map[string]bool{}
+	result := make([]string, 0, len(base))
+	for path, f := range s.staged {
+		if match(path) {
+			seen[name(path)] = true
+			if !f.deleted {
+				result = append(result, name(path))
+			}
+		}
+	}
+	for _, entry := range base {
+		if !seen[entry] {
+			result = append(result, entry)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// commit はステージした内容を base に反映
+// 1. 書き込みを一時ファイルに出力 2. ジャーナルを記録 3. rename / 削除 4. ジャーナルを削除
+func (s *transactionStore) commit(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.staged) == 0 {
+		return nil
+	}
+	paths := make([]string, 0, len(s.staged))
+	for path := range s.staged {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	journal := &transactionJournal{ID: uuid.New().String()[:8], CreatedAt: Now()}
+	var temps []string
+	cleanup := func() {
+		for _, temp := range temps {
+			_ = s.base.Delete(ctx, temp)
+		}
+	}
+
+	for _, path := range paths {
+		f := s.staged[path]
+		if f.deleted {
+			journal.Ops = append(journal.Ops, transactionJournalOp{Path: path, Delete: true})
+			continue
+		}
+		temp := path + transactionTempSuffix
+		if err := s.base.EnsureDir(ctx, filepath.Dir(path)); err != nil {
+			cleanup()
+			return err
+		}
+		var err error
+		if f.copyFrom != "" {
+			err = s.base.Copy(ctx, f.copyFrom, temp)
+		} else {
+			err = s.base.WriteFile(ctx, temp, f.data)
+		}
+		if err != nil {
+			cleanup()
+			return fmt.Errorf("failed to stage %s: %w", path, err)
+		}
+		temps = append(temps, temp)
+		journal.Ops = append(journal.Ops, transactionJournalOp{Path: path, Temp: temp})
+	}
+
+	// ジャーナルも一時ファイルから rename し、記録途中のジャーナルを残さない
+	// （rename の完了がコミットの確定点。それ以前の中断では一時ファイルのみが残る）
+	if err := s.base.EnsureDir(ctx, filepath.Dir(transactionJournalFile)); err != nil {
+		cleanup()
+		return err
+	}
+	journalTemp := transactionJournalFile + transactionTempSuffix
+	if err := s.base.WriteYaml(ctx, journalTemp, journal); err != nil {
+		cleanup()
+		return err
+	}
+	if err := s.base.Rename(ctx, journalTemp, transactionJournalFile); err != nil {
+		cleanup()
+		_ = s.base.Delete(ctx, journalTemp)
+		return err
+	}
+
+	if err := applyTransactionJournal(ctx, s.base, journal); err != nil {
+		// ジャーナルは残し、次回のトランザクション開始時に完了させる
+		return fmt.Errorf("%w (interrupted operations will be completed on the next transaction)", err)
+	}
+	if err := s.base.Delete(ctx, transactionJournalFile); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	s.staged = map[string]*stagedFile{}
+	return nil
+}
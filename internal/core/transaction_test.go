@@ -0,0 +1,223 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTransaction_CommitAndRollback(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	// 失敗したトランザクションは何も書き込まない
+	errBoom := errors.New("boom")
+	var stagedID string
+	err := z.Transaction(ctx, func(tx *Zeus) error {
+		obj, err := tx.Add(ctx, "objective", "Staged")
+		if err != nil {
+			return err
+		}
+		stagedID = obj.ID
+		// トランザクション内ではステージした内容が読める
+		objHandler, _ := tx.entityRegistry.Get("objective")
+		if _, err := objHandler.Get(ctx, obj.ID); err != nil {
+			t.Errorf("staged objective should be readable in transaction: %v", err)
+		}
+		if z.fileStore.Exists(ctx, filepath.Join("objectives", obj.ID+".yaml")) {
+			t.Error("staged objective should not be visible outside transaction")
+		}
+		return errBoom
+	})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("expected fn error, got %v", err)
+	}
+	if z.fileStore.Exists(ctx, filepath.Join("objectives", stagedID+".yaml")) {
+		t.Error("rolled back objective should not be written")
+	}
+
+	// 成功したトランザクションはまとめて反映される
+	var objID, riskID string
+	err = z.Transaction(ctx, func(tx *Zeus) error {
+		obj, err := tx.Add(ctx, "objective", "Launch")
+		if err != nil {
+			return err
+		}
+		objID = obj.ID
+		risk, err := tx.Add(ctx, "risk", "Delay", WithRiskObjective(obj.ID))
+		if err != nil {
+			return err
+		}
+		riskID = risk.ID
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Transaction failed: %v", err)
+	}
+	for _, path := range []string{filepath.Join("objectives", objID+".yaml"), filepath.Join("risks", riskID+".yaml")} {
+		if !z.fileStore.Exists(ctx, path) {
+			t.Errorf("%s should be committed", path)
+		}
+		if z.fileStore.Exists(ctx, path+transactionTempSuffix) {
+			t.Errorf("%s temp file should be removed", path)
+		}
+	}
+	if z.fileStore.Exists(ctx, transactionJournalFile) {
+		t.Error("journal should be removed after commit")
+	}
+}
+
+func TestTransactionStore_DeleteAndList(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if err := z.fileStore.WriteYaml(ctx, "items/a.yaml", map[string]string{"id": "a"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := z.fileStore.WriteYaml(ctx, "items/b.yaml", map[string]string{"id": "b"}); err != nil {
+		t.Fatal(err)
+	}
+
+	store := newTransactionStore(z.fileStore)
+	if err := store.Delete(ctx, "items/a.yaml"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := store.WriteYaml(ctx, "items/c.yaml", map[string]string{"id": "c"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Rename(ctx, "items/b.yaml", "items/d.yaml"); err != nil {
+		t.Fatalf("Rename failed: %v", err)
+	}
+	if err := store.Delete(ctx, "items/missing.yaml"); !os.IsNotExist(err) {
+		t.Errorf("expected not-exist error, got %v", err)
+	}
+
+	files, err := store.ListDir(ctx, "items")
+	if err != nil {
+		t.Fatalf("ListDir failed: %v", err)
+	}
+	if len(files) != 2 || files[0] != "c.yaml" || files[1] != "d.yaml" {
+		t.Errorf("ListDir() = %v, want [c.yaml d.yaml]", files)
+	}
+	var d map[string]string
+	if err := store.ReadYaml(ctx, "items/d.yaml", &d); err != nil || d["id"] != "b" {
+		t.Errorf("renamed file should be readable: %v %v", d, err)
+	}
+	if err := store.ReadYaml(ctx, "items/a.yaml", &d); !os.IsNotExist(err) {
+		t.Errorf("deleted file should not be readable: %v", err)
+	}
+
+	// コミット前は base に反映されない
+	if !z.fileStore.Exists(ctx, "items/a.yaml") || z.fileStore.Exists(ctx, "items/c.yaml") {
+		t.Error("base should be unchanged before commit")
+	}
+	if err := store.commit(ctx); err != nil {
+		t.Fatalf("commit failed: %v", err)
+	}
+	files, _ = z.fileStore.ListDir(ctx, "items")
+	if len(files) != 2 || files[0] != "c.yaml" || files[1] != "d.yaml" {
+		t.Errorf("committed ListDir() = %v, want [c.yaml d.yaml]", files)
+	}
+}
+
+func TestRecoverTransaction(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+
+	// rename の途中で中断したコミット（a は移動済み、b は一時ファイルのまま、c は削除前）
+	_ = z.fileStore.WriteFile(ctx, "items/a.yaml", []byte("id: a\n"))
+	_ = z.fileStore.WriteFile(ctx, "items/b.yaml.txn", []byte("id: b\n"))
+	_ = z.fileStore.WriteFile(ctx, "items/c.yaml", []byte("id: c\n"))
+	journal := transactionJournal{ID: "test", Ops: []transactionJournalOp{
+		{Path: "items/a.yaml", Temp: "items/a.yaml.txn"},
+		{Path: "items/b.yaml", Temp: "items/b.yaml.txn"},
+		{Path: "items/c.yaml", Delete: true},
+	}}
+	if err := z.fileStore.WriteYaml(ctx, transactionJournalFile, journal); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := z.RecoverTransaction(ctx); err != nil {
+		t.Fatalf("RecoverTransaction failed: %v", err)
+	}
+	files, _ := z.fileStore.ListDir(ctx, "items")
+	if len(files) != 2 || files[0] != "a.yaml" || files[1] != "b.yaml" {
+		t.Errorf("ListDir() = %v, want [a.yaml b.yaml]", files)
+	}
+	if z.fileStore.Exists(ctx, transactionJournalFile) {
+		t.Error("journal should be removed after recovery")
+	}
+}
+
+func TestImportPlan_AtomicOnFailure(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	plan := &ImportPlan{
+		Milestones: []ImportMilestone{{Title: "v1"}},
+		Items:      []ImportItem{{Title: "Valid item"}, {Title: ""}},
+	}
+	if _, err := z.ImportPlan(ctx, plan); err == nil {
+		t.Fatal("expected error for item without title")
+	}
+
+	for _, dir := range []string{"objectives", "activities"} {
+		files, _ := z.fileStore.ListDir(ctx, dir)
+		if len(files) != 0 {
+			t.Errorf("%s should be empty after failed import, got %v", dir, files)
+		}
+	}
+}
+
+func TestPreview_CommitsAndReturnsChanges(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	var objID string
+	changes, err := z.Preview(ctx, func(tx *Zeus) error {
+		obj, err := tx.Add(ctx, "objective", "Launch")
+		if err != nil {
+			return err
+		}
+		objID = obj.ID
+		// 入れ子のトランザクションは外側に合流する
+		return tx.Transaction(ctx, func(inner *Zeus) error {
+			_, err := inner.Add(ctx, "risk", "Delay", WithRiskObjective(obj.ID))
+			return err
+		})
+	})
+	if err != nil {
+		t.Fatalf("Preview failed: %v", err)
+	}
+
+	objPath := filepath.Join("objectives", objID+".yaml")
+	if !z.fileStore.Exists(ctx, objPath) {
+		t.Error("preview should commit the changes")
+	}
+	var objChange *FileChange
+	risks := 0
+	for i, c := range changes {
+		switch {
+		case c.Path == objPath:
+			objChange = &changes[i]
+		case filepath.Dir(c.Path) == "risks":
+			risks++
+		}
+	}
+	if objChange == nil || objChange.Op != ChangeOpCreate || objChange.Diff == "" {
+		t.Errorf("expected create of %s with diff, got %+v", objPath, changes)
+	}
+	if risks != 1 {
+		t.Errorf("nested transaction writes should be included: %+v", changes)
+	}
+}
@@ -6,12 +6,30 @@ import (
 	"time"
 )
 
+// SchemaVersion はこのバイナリが扱う .zeus データスキーマのバージョン
+const SchemaVersion = "1.0"
+
 // ZeusConfig はメイン設定
 type ZeusConfig struct {
-	Version    string      `yaml:"version"`
-	Project    ProjectInfo `yaml:"project"`
-	Objectives []Objective `yaml:"objectives"`
-	Settings   Settings    `yaml:"settings"`
+	Version       string               `yaml:"version"`
+	Project       ProjectInfo          `yaml:"project"`
+	Objectives    []Objective          `yaml:"objectives"`
+	Settings      Settings             `yaml:"settings"`
+	Approvals     ApprovalPolicy       `yaml:"approvals,omitempty"`
+	Agents        AgentPolicy          `yaml:"agents,omitempty"`
+	Server        ServerSettings       `yaml:"server,omitempty"`
+	Rendering     RenderingSettings    `yaml:"rendering,omitempty"`
+	Analysis      AnalysisSettings     `yaml:"analysis,omitempty"`
+	Reports       ReportSettings       `yaml:"reports,omitempty"`
+	Notifications NotificationSettings `yaml:"notifications,omitempty"`
+	Integrations  IntegrationSettings  `yaml:"integrations,omitempty"`
+	Checks        CheckSettings        `yaml:"checks,omitempty"`
+	Escalation    EscalationSettings   `yaml:"escalation,omitempty"`
+	Costs         CostSettings         `yaml:"costs,omitempty"`
+	Snapshots     SnapshotSettings     `yaml:"snapshots,omitempty"`
+	// DeletePolicies は削除時の参照元の扱いの上書き（キー: <参照元>.<フィールド>、値: restrict, cascade, nullify）
+	// 例: usecase.objective_id: cascade
+	DeletePolicies map[string]DeletePolicy `yaml:"delete_policies,omitempty"`
 }
 
 // ProjectInfo はプロジェクト情報
@@ -35,6 +53,176 @@ type Settings struct {
 	AutomationLevel string `yaml:"automation_level"` // auto, notify, approve
 	ApprovalMode    string `yaml:"approval_mode"`    // default, strict, loose
 	AIProvider      string `yaml:"ai_provider"`      // claude-code, gemini, codex
+	// Operations は操作ごとの承認レベルの上書き（キー: <entity>_<create|update|delete>、値: auto, notify, approve）
+	// 例: objective_delete: approve。task_<op> はエンティティ別の指定が無い操作すべてに適用される
+	Operations map[string]ApprovalLevel `yaml:"operations,omitempty"`
+}
+
+// ApprovalPolicy は承認ポリシー（zeus.yaml の approvals セクション）
+type ApprovalPolicy struct {
+	Approver        string               `yaml:"approver,omitempty"`          // 既定の承認者（people の ID）
+	ExpireAfterDays int                  `yaml:"expire_after_days,omitempty"` // 承認待ちの期限日数（0 で無期限）
+	OnExpire        string               `yaml:"on_expire,omitempty"`         // 期限切れ時の処理: reject（デフォルト）, approve
+	RemindAfterDays int                  `yaml:"remind_after_days,omitempty"` // 承認待ちのリマインダーを送る間隔日数（0 で送らない）
+	Delegations     []ApprovalDelegation `yaml:"delegations,omitempty"`
+}
+
+// ApprovalDelegation は承認者の委任（不在時などに from の承認を to に割り当てる）
+type ApprovalDelegation struct {
+	From  string `yaml:"from"`
+	To    string `yaml:"to"`
+	Until string `yaml:"until,omitempty"` // YYYY-MM-DD（この日まで有効、空なら無期限）
+}
+
+// AgentPolicy は AI エージェントのガードレール（zeus.yaml の agents セクション、--agent 指定時に適用）
+type AgentPolicy struct {
+	Protected              []string `yaml:"protected,omitempty"`                 // 変更に人の承認が必要なエンティティ（ID またはエンティティ種別）
+	MaxMutationsPerSession int      `yaml:"max_mutations_per_session,omitempty"` // セッションあたりの変更回数の上限（0 で無制限）
+	SessionMinutes         int      `yaml:"session_minutes,omitempty"`           // セッションの長さ（分、デフォルト: 60）
+}
+
+// ServerSettings はダッシュボードサーバーの設定（zeus.yaml の server セクション）
+type ServerSettings struct {
+	AllowedOrigins  []string `yaml:"allowed_origins,omitempty"`   // CORS 許可オリジン（"*" で全許可）
+	APIToken        string   `yaml:"api_token,omitempty"`         // /api/* に要求するトークン（空なら認証なし）
+	RateLimit       int      `yaml:"rate_limit,omitempty"`        // クライアントあたりの毎分リクエスト上限（0 で無制限）
+	MermaidMaxNodes int      `yaml:"mermaid_max_nodes,omitempty"` // Mermaid を要約表示に切り替えるノード数（0 で既定値 300、負の値で要約しない）
+}
+
+// RenderingSettings は図の画像レンダリング設定（zeus.yaml の rendering セクション）
+// engine が未設定の場合、PNG/SVG 出力は無効
+type RenderingSettings struct {
+	Engine     string `yaml:"engine,omitempty"`      // kroki, mermaid-cli
+	KrokiURL   string `yaml:"kroki_url,omitempty"`   // Kroki エンドポイント（デフォルト: https://kroki.io）
+	MermaidCLI string `yaml:"mermaid_cli,omitempty"` // mermaid-cli の実行ファイル（デフォルト: mmdc）
+	Timeout    int    `yaml:"timeout,omitempty"`     // レンダリングのタイムアウト秒数（デフォルト: 30）
+}
+
+// ReportSettings はレポート出力の設定（zeus.yaml の reports セクション）
+type ReportSettings struct {
+	Schedules []ReportSchedule `yaml:"schedules,omitempty"` // ダッシュボード起動中に定期生成するレポート
+	Sections  map[string]bool  `yaml:"sections,omitempty"`  // 10 概念モデルのセクションの有効/無効（未設定は有効）
+}
+
+// ReportSchedule は定期レポートの設定
+type ReportSchedule struct {
+	Name   string `yaml:"name"`             // ファイル名の接頭辞
+	Cron   string `yaml:"cron"`             // 5 フィールドの cron 式（ローカルタイム）
+	Format string `yaml:"format,omitempty"` // text, html, markdown（デフォルト: markdown）
+	Output string `yaml:"output,omitempty"` // .zeus からの出力ディレクトリ（デフォルト: reports）
+	Email  bool   `yaml:"email,omitempty"`  // 生成したレポートを notifications.email の宛先へ送信
+}
+
+// NotificationSettings は通知チャネルの設定（zeus.yaml の notifications セクション）
+type NotificationSettings struct {
+	Email EmailSettings `yaml:"email,omitempty"`
+}
+
+// EmailSettings は SMTP によるメール通知の設定
+// password は環境変数 ZEUS_SMTP_PASSWORD が優先される
+type EmailSettings struct {
+	Host            string   `yaml:"host,omitempty"`             // SMTP サーバー（空ならメール送信は無効）
+	Port            int      `yaml:"port,omitempty"`             // SMTP ポート（デフォルト: 587）
+	Username        string   `yaml:"username,omitempty"`         // SMTP 認証ユーザー（空なら認証なし）
+	Password        string   `yaml:"password,omitempty"`         // SMTP 認証パスワード
+	From            string   `yaml:"from,omitempty"`             // 送信元アドレス
+	To              []string `yaml:"to,omitempty"`               // 宛先アドレス
+	NotifyApprovals bool     `yaml:"notify_approvals,omitempty"` // 承認待ちの追加時にメールを送信
+}
+
+// IntegrationSettings は外部サービス連携の設定（zeus.yaml の integrations セクション）
+type IntegrationSettings struct {
+	IssueSync IssueSyncSettings `yaml:"issue_sync,omitempty"`
+}
+
+// IssueSyncSettings は Activity と課題トラッカーの Issue を同期する設定
+// token は環境変数 ZEUS_ISSUE_SYNC_TOKEN が優先される
+type IssueSyncSettings struct {
+	Provider string `yaml:"provider,omitempty"` // gitlab, gitea（空なら同期は無効）
+	BaseURL  string `yaml:"base_url,omitempty"` // API のベース URL（GitLab はデフォルト: https://gitlab.com）
+	Project  string `yaml:"project,omitempty"`  // GitLab: group/project、Gitea: owner/repo
+	Token    string `yaml:"token,omitempty"`    // アクセストークン
+}
+
+// CheckSettings は zeus check（doctor）の設定（zeus.yaml の checks セクション）
+type CheckSettings struct {
+	// Severity はルール（チェック名）ごとの重大度の上書き（値: fail, warn, ignore）
+	// 例: subsystem_reference: ignore
+	Severity map[string]string `yaml:"severity,omitempty"`
+}
+
+// CostSettings はコスト管理の設定（zeus.yaml の costs セクション）
+type CostSettings struct {
+	Currency string `yaml:"currency,omitempty"` // 金額の通貨コード（例: JPY, USD）。未設定の場合は数値のみ表示
+}
+
+// SnapshotSettings は自動スナップショットの設定（zeus.yaml の snapshots セクション）
+type SnapshotSettings struct {
+	IntervalDays int               `yaml:"interval_days,omitempty"` // 自動スナップショットの間隔（日数、0 は無効）
+	Retention    SnapshotRetention `yaml:"retention,omitempty"`
+}
+
+// SnapshotRetention は自動スナップショットの保持ポリシー（手動で作成したスナップショットは削除しない）
+type SnapshotRetention struct {
+	KeepLast   int `yaml:"keep_last,omitempty"`    // 新しい順に残す件数（0 は無制限）
+	MaxAgeDays int `yaml:"max_age_days,omitempty"` // 残す最大経過日数（0 は無制限）
+}
+
+// EscalationSettings は期限超過のエスカレーションの設定（zeus.yaml の escalation セクション）
+type EscalationSettings struct {
+	Considerations ConsiderationEscalationSettings `yaml:"considerations,omitempty"`
+	Problems       ProblemSLASettings              `yaml:"problems,omitempty"`
+}
+
+// ProblemSLASettings は未解決の Problem の SLA（重大度ごとの解決目標）
+type ProblemSLASettings struct {
+	SLADays map[ProblemSeverity]int `yaml:"sla_days,omitempty"` // 報告から解決までの目標日数（未設定の重大度は SLA なし）
+}
+
+// ConsiderationEscalationSettings は期限を過ぎた open の Consideration のエスカレーション
+type ConsiderationEscalationSettings struct {
+	AutoProblem     bool            `yaml:"auto_problem,omitempty"`     // 猶予期間を過ぎたら Problem を自動作成する
+	GraceDays       int             `yaml:"grace_days,omitempty"`       // 期限から Problem を作成するまでの猶予日数
+	ProblemSeverity ProblemSeverity `yaml:"problem_severity,omitempty"` // 作成する Problem の深刻度（デフォルト medium）
+}
+
+// AnalysisSettings は分析機能の設定（zeus.yaml の analysis セクション）
+type AnalysisSettings struct {
+	Affinity AffinitySettings `yaml:"affinity,omitempty" json:"affinity,omitempty"`
+	Stale    StaleSettings    `yaml:"stale,omitempty" json:"stale,omitempty"`
+}
+
+// StaleSettings は陳腐化分析の閾値（日数、未設定の項目はデフォルト値を使用）
+type StaleSettings struct {
+	StaleThresholdSettings `yaml:",inline"`
+	// Entities はエンティティ種別（activity, objective）ごとの閾値の上書き
+	Entities map[string]StaleThresholdSettings `yaml:"entities,omitempty" json:"entities,omitempty"`
+}
+
+// StaleThresholdSettings は陳腐化とみなすまでの日数
+type StaleThresholdSettings struct {
+	CompletedDays  int `yaml:"completed_days,omitempty" json:"completed_days,omitempty"`     // 完了（廃止）後
+	BlockedDays    int `yaml:"blocked_days,omitempty" json:"blocked_days,omitempty"`         // 保留・ブロック状態の継続
+	NoProgressDays int `yaml:"no_progress_days,omitempty" json:"no_progress_days,omitempty"` // 進捗がない状態の継続
+}
+
+// AffinitySettings はアフィニティ計算の設定（未設定の項目はデフォルト値を使用）
+type AffinitySettings struct {
+	Weights        AffinityWeightSettings `yaml:"weights,omitempty" json:"weights,omitempty"`
+	MinScore       *float64               `yaml:"min_score,omitempty" json:"min_score,omitempty"`               // エッジ・クラスタメンバーの最小スコア（0.0-1.0）
+	MaxSiblings    int                    `yaml:"max_siblings,omitempty" json:"max_siblings,omitempty"`         // ハブモードに切り替える兄弟数の閾値
+	MaxEdges       int                    `yaml:"max_edges,omitempty" json:"max_edges,omitempty"`               // 最大エッジ数（0 で無制限）
+	MinClusterSize int                    `yaml:"min_cluster_size,omitempty" json:"min_cluster_size,omitempty"` // クラスタの最小メンバー数
+	Workers        int                    `yaml:"workers,omitempty" json:"workers,omitempty"`                   // 計算の並列数（0 で CPU 数）
+	Approximate    bool                   `yaml:"approximate,omitempty" json:"approximate,omitempty"`           // 大きな兄弟グループをバケットに分ける近似モード
+}
+
+// AffinityWeightSettings は関連タイプの重みの上書き値（0.0-1.0、未設定は自動算出）
+type AffinityWeightSettings struct {
+	ParentChild *float64 `yaml:"parent_child,omitempty" json:"parent_child,omitempty"`
+	Sibling     *float64 `yaml:"sibling,omitempty" json:"sibling,omitempty"`
+	Reference   *float64 `yaml:"reference,omitempty" json:"reference,omitempty"`
+	Category    *float64 `yaml:"category,omitempty" json:"category,omitempty"`
 }
 
 // ItemStatus はリスト項目のステータス
@@ -100,10 +288,11 @@ type SummaryStats struct {
 
 // ProjectState はプロジェクト状態
 type ProjectState struct {
-	Timestamp string       `yaml:"timestamp"`
-	Summary   SummaryStats `yaml:"summary"`
-	Health    HealthStatus `yaml:"health"`
-	Risks     []string     `yaml:"risks"`
+	Timestamp string            `yaml:"timestamp"`
+	Summary   SummaryStats      `yaml:"summary"`
+	Health    HealthStatus      `yaml:"health"`
+	Risks     []string          `yaml:"risks"`
+	RiskCells map[string]string `yaml:"risk_cells"` // リスク ID → "probability/impact"（ヒートマップの比較に使う。無い場合は記録前の状態）
 }
 
 // Snapshot はスナップショット
@@ -331,6 +520,8 @@ type Vision struct {
 	SuccessCriteria []string     `yaml:"success_criteria,omitempty"`
 	Status          VisionStatus `yaml:"status"`
 	Metadata        Metadata     `yaml:"metadata"`
+
+	rationale string // 変更理由（WithVisionRationale で設定し、版の履歴にのみ記録）
 }
 
 // ObjectiveStatus は Objective の状態
@@ -355,6 +546,9 @@ type ObjectiveEntity struct {
 	Status      ObjectiveStatus `yaml:"status"`
 	Owner       string          `yaml:"owner,omitempty"`
 	Tags        []string        `yaml:"tags,omitempty"`
+	RICE        *RICEScore      `yaml:"rice,omitempty"` // 優先度スコアの評価値（RICE）
+	WSJF        *WSJFScore      `yaml:"wsjf,omitempty"` // 優先度スコアの評価値（WSJF）
+	Cost        *Cost           `yaml:"cost,omitempty"` // 予算・契約済み・実績（Activity に割り当てない費用）
 	Metadata    Metadata        `yaml:"metadata"`
 }
 
@@ -414,7 +608,12 @@ func (o *ObjectiveEntity) Validate() error {
 		}
 	}
 	o.Goals = cleanGoals
-	return nil
+	if o.Cost != nil {
+		if err := o.Cost.Validate(); err != nil {
+			return err
+		}
+	}
+	return validateScores(o.RICE, o.WSJF)
 }
 
 // GetID は Entity インターフェースを実装（Vision）
@@ -465,6 +664,8 @@ type ConsiderationEntity struct {
 	DecisionID  string                `yaml:"decision_id,omitempty"`
 	RaisedBy    string                `yaml:"raised_by,omitempty"`
 	DueDate     string                `yaml:"due_date,omitempty"`
+	EscalatedAt string                `yaml:"escalated_at,omitempty"` // 期限超過をエスカレーションした日時
+	EscalatedTo string                `yaml:"escalated_to,omitempty"` // 期限超過で自動作成した Problem の ID（承認待ちの場合は承認 ID）
 	Metadata    Metadata              `yaml:"metadata"`
 }
 
@@ -536,6 +737,7 @@ type DecisionEntity struct {
 	Impact          []string         `yaml:"impact,omitempty"`
 	DecidedAt       string           `yaml:"decided_at"`
 	DecidedBy       string           `yaml:"decided_by,omitempty"`
+	Supersedes      string           `yaml:"supersedes,omitempty"` // 置き換える（覆す）以前の Decision の ID
 }
 
 // Validate は DecisionEntity の妥当性を検証
@@ -561,6 +763,11 @@ func (d *DecisionEntity) Validate() error {
 	if d.DecidedAt == "" {
 		return fmt.Errorf("decision decided_at is required")
 	}
+	if d.Supersedes != "" {
+		if err := ValidateID("decision", d.Supersedes); err != nil {
+			return fmt.Errorf("invalid decision supersedes: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -592,6 +799,17 @@ const (
 	ProblemSeverityLow      ProblemSeverity = "low"
 )
 
+// MaxProblemCauseDepth は Problem の原因ツリーの最大の深さ
+const MaxProblemCauseDepth = 10
+
+// ProblemCause は Problem の原因ツリーの 1 つの原因
+// Causes には「なぜその原因が起きたか」をさらに掘り下げた原因を入れる
+type ProblemCause struct {
+	Description string         `yaml:"description"`
+	EntityID    string         `yaml:"entity_id,omitempty"` // 原因に関連するエンティティ（act-xxx など）
+	Causes      []ProblemCause `yaml:"causes,omitempty"`
+}
+
 // ProblemEntity は 10 概念モデルの問題
 // problems/prob-NNN.yaml で管理
 type ProblemEntity struct {
@@ -603,6 +821,7 @@ type ProblemEntity struct {
 	Description        string          `yaml:"description,omitempty"`
 	Impact             string          `yaml:"impact,omitempty"`
 	RootCause          string          `yaml:"root_cause,omitempty"`
+	Causes             []ProblemCause  `yaml:"causes,omitempty"` // 原因ツリー（なぜなぜ分析）
 	PotentialSolutions []string        `yaml:"potential_solutions,omitempty"`
 	ReportedBy         string          `yaml:"reported_by,omitempty"`
 	AssignedTo         string          `yaml:"assigned_to,omitempty"`
@@ -638,6 +857,32 @@ func (p *ProblemEntity) Validate() error {
 	default:
 		return fmt.Errorf("invalid problem severity: %s", p.Severity)
 	}
+	return validateProblemCauses(p.Causes, "causes", 1)
+}
+
+// validateProblemCauses は原因ツリーの説明・関連エンティティ ID・深さを検証
+func validateProblemCauses(causes []ProblemCause, path string, depth int) error {
+	if len(causes) > 0 && depth > MaxProblemCauseDepth {
+		return fmt.Errorf("problem cause tree is too deep: %s (max %d levels)", path, MaxProblemCauseDepth)
+	}
+	for i, c := range causes {
+		field := fmt.Sprintf("%s[%d]", path, i)
+		if strings.TrimSpace(c.Description) == "" {
+			return fmt.Errorf("problem cause description is required: %s", field)
+		}
+		if c.EntityID != "" {
+			entityType, ok := EntityTypeFromID(c.EntityID)
+			if !ok {
+				return fmt.Errorf("invalid problem cause entity_id: %s (%s)", c.EntityID, field)
+			}
+			if err := ValidateID(entityType, c.EntityID); err != nil {
+				return fmt.Errorf("invalid problem cause entity_id: %s (%s): %w", c.EntityID, field, err)
+			}
+		}
+		if err := validateProblemCauses(c.Causes, field+".causes", depth+1); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -708,6 +953,8 @@ type RiskEntity struct {
 	Description string          `yaml:"description,omitempty"`
 	Trigger     string          `yaml:"trigger,omitempty"`
 	Mitigation  RiskMitigation  `yaml:"mitigation,omitempty"`
+	CostImpact  *RiskEstimate   `yaml:"cost_impact,omitempty"`  // 発生した場合のコスト影響の三点見積もり
+	DelayImpact *RiskEstimate   `yaml:"delay_impact,omitempty"` // 発生した場合の遅延（日数）の三点見積もり
 	Owner       string          `yaml:"owner,omitempty"`
 	ReviewDate  string          `yaml:"review_date,omitempty"`
 	Metadata    Metadata        `yaml:"metadata"`
@@ -782,6 +1029,16 @@ func (r *RiskEntity) Validate() error {
 	default:
 		return fmt.Errorf("invalid risk impact: %s", r.Impact)
 	}
+	if r.CostImpact != nil {
+		if err := r.CostImpact.Validate("cost_impact"); err != nil {
+			return err
+		}
+	}
+	if r.DelayImpact != nil {
+		if err := r.DelayImpact.Validate("delay_impact"); err != nil {
+			return err
+		}
+	}
 	// RiskScore を自動計算
 	r.RiskScore = CalculateRiskScore(r.Probability, r.Impact)
 	return nil
@@ -1330,6 +1587,9 @@ type ActivityEntity struct {
 	Status      ActivityStatus       `yaml:"status"`
 	Nodes       []ActivityNode       `yaml:"nodes,omitempty"`
 	Transitions []ActivityTransition `yaml:"transitions,omitempty"`
+	RICE        *RICEScore           `yaml:"rice,omitempty"` // 優先度スコアの評価値（RICE）
+	WSJF        *WSJFScore           `yaml:"wsjf,omitempty"` // 優先度スコアの評価値（WSJF）
+	Cost        *Cost                `yaml:"cost,omitempty"` // 予算・契約済み・実績
 	Metadata    Metadata             `yaml:"metadata"`
 }
 
@@ -1419,7 +1679,12 @@ func (a *ActivityEntity) Validate() error {
 		}
 	}
 
-	return nil
+	if a.Cost != nil {
+		if err := a.Cost.Validate(); err != nil {
+			return err
+		}
+	}
+	return validateScores(a.RICE, a.WSJF)
 }
 
 // GetID は Entity インターフェースを実装（ActivityEntity）
@@ -1427,3 +1692,420 @@ func (a *ActivityEntity) GetID() string { return a.ID }
 
 // GetTitle は Entity インターフェースを実装（ActivityEntity）
 func (a *ActivityEntity) GetTitle() string { return a.Title }
+
+// ============================================================
+// UML ステートマシン図型定義 (StateMachine)
+// エンティティ（UseCase の対象物等）のライフサイクルを状態と遷移で表現する
+// ============================================================
+
+// StateMachineStatus はステートマシンの状態
+type StateMachineStatus string
+
+const (
+	StateMachineStatusDraft      StateMachineStatus = "draft"
+	StateMachineStatusActive     StateMachineStatus = "active"
+	StateMachineStatusDeprecated StateMachineStatus = "deprecated"
+)
+
+// StateType は状態の種類
+type StateType string
+
+const (
+	StateTypeInitial StateType = "initial" // 開始状態（黒丸）
+	StateTypeFinal   StateType = "final"   // 終了状態（二重丸）
+	StateTypeState   StateType = "state"   // 通常の状態（角丸四角形）
+)
+
+// StateMachineState はステートマシンの状態
+type StateMachineState struct {
+	ID         string    `yaml:"id"`
+	Type       StateType `yaml:"type"`
+	Name       string    `yaml:"name,omitempty"`        // initial/final では不要
+	ActivityID string    `yaml:"activity_id,omitempty"` // この状態を実現する Activity（任意紐付け）
+}
+
+// StateMachineTransition はステートマシンの遷移
+type StateMachineTransition struct {
+	ID      string `yaml:"id"`
+	Source  string `yaml:"source"`            // ソース状態ID
+	Target  string `yaml:"target"`            // ターゲット状態ID
+	Trigger string `yaml:"trigger,omitempty"` // トリガーイベント（例: "submit"）
+	Guard   string `yaml:"guard,omitempty"`   // ガード条件（例: "[承認済み]"）
+}
+
+// StateMachineEntity はステートマシン図エンティティ
+// statemachines/sm-NNN.yaml で管理（個別ファイル）
+type StateMachineEntity struct {
+	ID          string                   `yaml:"id"`
+	Title       string                   `yaml:"title"`
+	Description string                   `yaml:"description,omitempty"`
+	UseCaseID   string                   `yaml:"usecase_id,omitempty"` // 任意紐付け
+	Status      StateMachineStatus       `yaml:"status"`
+	States      []StateMachineState      `yaml:"states,omitempty"`
+	Transitions []StateMachineTransition `yaml:"transitions,omitempty"`
+	Metadata    Metadata                 `yaml:"metadata"`
+}
+
+// Validate は StateMachineState の妥当性を検証
+func (s *StateMachineState) Validate() error {
+	if s.ID == "" {
+		return fmt.Errorf("state ID is required")
+	}
+	switch s.Type {
+	case StateTypeInitial, StateTypeFinal:
+		// 有効
+	case StateTypeState:
+		if s.Name == "" {
+			return fmt.Errorf("state name is required: %s", s.ID)
+		}
+	case "":
+		return fmt.Errorf("state type is required")
+	default:
+		return fmt.Errorf("invalid state type: %s", s.Type)
+	}
+	if s.ActivityID != "" {
+		if err := ValidateID("activity", s.ActivityID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate は StateMachineTransition の妥当性を検証
+func (t *StateMachineTransition) Validate() error {
+	if t.ID == "" {
+		return fmt.Errorf("state transition ID is required")
+	}
+	if t.Source == "" {
+		return fmt.Errorf("state transition source is required")
+	}
+	if t.Target == "" {
+		return fmt.Errorf("state transition target is required")
+	}
+	return nil
+}
+
+// Validate は StateMachineEntity の妥当性を検証
+func (m *StateMachineEntity) Validate() error {
+	if m.ID == "" {
+		return fmt.Errorf("state machine ID is required")
+	}
+	if err := ValidateID("statemachine", m.ID); err != nil {
+		return err
+	}
+	if m.Title == "" {
+		return fmt.Errorf("state machine title is required")
+	}
+	if m.Status == "" {
+		m.Status = StateMachineStatusDraft
+	}
+	switch m.Status {
+	case StateMachineStatusDraft, StateMachineStatusActive, StateMachineStatusDeprecated:
+		// 有効
+	default:
+		return fmt.Errorf("invalid state machine status: %s", m.Status)
+	}
+
+	// 状態のバリデーションとID重複チェック
+	states := make(map[string]StateType)
+	for _, state := range m.States {
+		if err := state.Validate(); err != nil {
+			return fmt.Errorf("invalid state: %w", err)
+		}
+		if _, exists := states[state.ID]; exists {
+			return fmt.Errorf("duplicate state ID: %s", state.ID)
+		}
+		states[state.ID] = state.Type
+	}
+	// 遷移のバリデーションとID重複チェック
+	transitionIDs := make(map[string]bool)
+	for _, trans := range m.Transitions {
+		if err := trans.Validate(); err != nil {
+			return fmt.Errorf("invalid transition: %w", err)
+		}
+		if transitionIDs[trans.ID] {
+			return fmt.Errorf("duplicate transition ID: %s", trans.ID)
+		}
+		transitionIDs[trans.ID] = true
+		sourceType, ok := states[trans.Source]
+		if !ok {
+			return fmt.Errorf("transition source not found: %s", trans.Source)
+		}
+		targetType, ok := states[trans.Target]
+		if !ok {
+			return fmt.Errorf("transition target not found: %s", trans.Target)
+		}
+		if sourceType == StateTypeFinal {
+			return fmt.Errorf("transition from final state: %s", trans.ID)
+		}
+		if targetType == StateTypeInitial {
+			return fmt.Errorf("transition to initial state: %s", trans.ID)
+		}
+	}
+
+	// 状態が定義されている場合は開始状態が必須で、全状態に到達可能であること
+	if len(m.States) > 0 {
+		hasInitial := false
+		for _, state := range m.States {
+			if state.Type == StateTypeInitial {
+				hasInitial = true
+				break
+			}
+		}
+		if !hasInitial {
+			return fmt.Errorf("initial state is required")
+		}
+		if unreachable := m.UnreachableStates(); len(unreachable) > 0 {
+			return fmt.Errorf("unreachable states: %s", strings.Join(unreachable, ", "))
+		}
+	}
+
+	return nil
+}
+
+// UnreachableStates は開始状態から到達できない状態の ID を定義順で返す
+func (m *StateMachineEntity) UnreachableStates() []string {
+	next := make(map[string][]string)
+	for _, trans := range m.Transitions {
+		next[trans.Source] = append(next[trans.Source], trans.Target)
+	}
+
+	visited := make(map[string]bool)
+	queue := make([]string, 0)
+	for _, state := range m.States {
+		if state.Type == StateTypeInitial {
+			visited[state.ID] = true
+			queue = append(queue, state.ID)
+		}
+	}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, target := range next[current] {
+			if !visited[target] {
+				visited[target] = true
+				queue = append(queue, target)
+			}
+		}
+	}
+
+	unreachable := make([]string, 0)
+	for _, state := range m.States {
+		if !visited[state.ID] {
+			unreachable = append(unreachable, state.ID)
+		}
+	}
+	return unreachable
+}
+
+// GetID は Entity インターフェースを実装（StateMachineEntity）
+func (m *StateMachineEntity) GetID() string { return m.ID }
+
+// GetTitle は Entity インターフェースを実装（StateMachineEntity）
+func (m *StateMachineEntity) GetTitle() string { return m.Title }
+
+// ============================================================
+// C4 アーキテクチャ型定義 (Container / Component)
+// システム構成をユースケース・アクティビティと並べて管理する
+// ============================================================
+
+// ArchitectureRelation はアーキテクチャ要素間の関係（C4 の Rel に相当）
+type ArchitectureRelation struct {
+	TargetID   string `yaml:"target_id"`            // 関係先の Container / Component ID
+	Label      string `yaml:"label,omitempty"`      // 関係の説明（例: "API 呼び出し"）
+	Technology string `yaml:"technology,omitempty"` // 通信手段（例: "HTTPS/JSON"）
+}
+
+// Validate は ArchitectureRelation の妥当性を検証
+func (r *ArchitectureRelation) Validate() error {
+	if r.TargetID == "" {
+		return fmt.Errorf("target_id is required in relation")
+	}
+	if ValidateID("container", r.TargetID) != nil && ValidateID("component", r.TargetID) != nil {
+		return fmt.Errorf("relation target must be a container or component: %s", r.TargetID)
+	}
+	return nil
+}
+
+// validateArchitectureRelations は関係の一覧を検証（自己参照・重複を禁止）
+func validateArchitectureRelations(sourceID string, relations []ArchitectureRelation) error {
+	targets := make(map[string]bool)
+	for _, rel := range relations {
+		if err := rel.Validate(); err != nil {
+			return err
+		}
+		if rel.TargetID == sourceID {
+			return fmt.Errorf("self-referencing relation: %s", sourceID)
+		}
+		if targets[rel.TargetID] {
+			return fmt.Errorf("duplicate relation target: %s", rel.TargetID)
+		}
+		targets[rel.TargetID] = true
+	}
+	return nil
+}
+
+// === Container ===
+
+// ContainerEntity はコンテナエンティティ（アプリケーション・データストア等の実行単位）
+// containers.yaml で管理（単一ファイル）
+type ContainerEntity struct {
+	ID          string                 `yaml:"id"`
+	Name        string                 `yaml:"name"`
+	Description string                 `yaml:"description,omitempty"`
+	Technology  string                 `yaml:"technology,omitempty"`   // 例: "Go", "PostgreSQL"
+	SubsystemID string                 `yaml:"subsystem_id,omitempty"` // 所属サブシステム（任意）
+	Relations   []ArchitectureRelation `yaml:"relations,omitempty"`
+	Metadata    Metadata               `yaml:"metadata"`
+}
+
+// ContainersFile はコンテナファイルの構造（単一ファイル管理）
+type ContainersFile struct {
+	Containers []ContainerEntity `yaml:"containers"`
+}
+
+// Validate は ContainerEntity の妥当性を検証
+func (c *ContainerEntity) Validate() error {
+	if c.ID == "" {
+		return fmt.Errorf("container ID is required")
+	}
+	if err := ValidateID("container", c.ID); err != nil {
+		return err
+	}
+	if c.Name == "" {
+		return fmt.Errorf("container name is required")
+	}
+	if c.SubsystemID != "" {
+		if err := ValidateID("subsystem", c.SubsystemID); err != nil {
+			return err
+		}
+	}
+	return validateArchitectureRelations(c.ID, c.Relations)
+}
+
+// GetID は Entity インターフェースを実装（ContainerEntity）
+func (c *ContainerEntity) GetID() string { return c.ID }
+
+// GetTitle は Entity インターフェースを実装（ContainerEntity）
+func (c *ContainerEntity) GetTitle() string { return c.Name }
+
+// === Component ===
+
+// ComponentEntity はコンポーネントエンティティ（コンテナ内部の構成要素）
+// components.yaml で管理（単一ファイル）
+type ComponentEntity struct {
+	ID          string                 `yaml:"id"`
+	Name        string                 `yaml:"name"`
+	Description string                 `yaml:"description,omitempty"`
+	Technology  string                 `yaml:"technology,omitempty"`
+	ContainerID string                 `yaml:"container_id"` // 所属コンテナ（必須）
+	Relations   []ArchitectureRelation `yaml:"relations,omitempty"`
+	Metadata    Metadata               `yaml:"metadata"`
+}
+
+// ComponentsFile はコンポーネントファイルの構造（単一ファイル管理）
+type ComponentsFile struct {
+	Components []ComponentEntity `yaml:"components"`
+}
+
+// Validate は ComponentEntity の妥当性を検証
+func (c *ComponentEntity) Validate() error {
+	if c.ID == "" {
+		return fmt.Errorf("component ID is required")
+	}
+	if err := ValidateID("component", c.ID); err != nil {
+		return err
+	}
+	if c.Name == "" {
+		return fmt.Errorf("component name is required")
+	}
+	if c.ContainerID == "" {
+		return fmt.Errorf("container_id is required")
+	}
+	if err := ValidateID("container", c.ContainerID); err != nil {
+		return err
+	}
+	return validateArchitectureRelations(c.ID, c.Relations)
+}
+
+// GetID は Entity インターフェースを実装（ComponentEntity）
+func (c *ComponentEntity) GetID() string { return c.ID }
+
+// GetTitle は Entity インターフェースを実装（ComponentEntity）
+func (c *ComponentEntity) GetTitle() string { return c.Name }
+
+// ============================================================
+// Release 型定義
+// 目標日までに出荷する UseCase / Activity と、リリース判定に使う品質ゲートをまとめる
+// ============================================================
+
+// ReleaseStatus は Release の状態
+type ReleaseStatus string
+
+const (
+	ReleaseStatusPlanned   ReleaseStatus = "planned"
+	ReleaseStatusReleased  ReleaseStatus = "released"
+	ReleaseStatusCancelled ReleaseStatus = "cancelled"
+)
+
+// ReleaseEntity はリリース（バージョン）エンティティ
+// releases/rel-NNN.yaml で管理（個別ファイル）
+type ReleaseEntity struct {
+	ID          string        `yaml:"id"`
+	Title       string        `yaml:"title"` // バージョン名（例: v1.2）
+	Description string        `yaml:"description,omitempty"`
+	Status      ReleaseStatus `yaml:"status"`
+	TargetDate  string        `yaml:"target_date,omitempty"`  // 目標日（YYYY-MM-DD）
+	UseCaseIDs  []string      `yaml:"usecase_ids,omitempty"`  // 含める UseCase（紐づく Activity も含める）
+	ActivityIDs []string      `yaml:"activity_ids,omitempty"` // UseCase に属さない Activity を個別に含める
+	QualityIDs  []string      `yaml:"quality_ids,omitempty"`  // リリース判定に使う品質基準（ゲート）
+	Metadata    Metadata      `yaml:"metadata"`
+}
+
+// Validate は ReleaseEntity の妥当性を検証
+func (r *ReleaseEntity) Validate() error {
+	if r.ID == "" {
+		return fmt.Errorf("release ID is required")
+	}
+	if err := ValidateID("release", r.ID); err != nil {
+		return err
+	}
+	if r.Title == "" {
+		return fmt.Errorf("release title is required")
+	}
+	if r.Status == "" {
+		r.Status = ReleaseStatusPlanned
+	}
+	switch r.Status {
+	case ReleaseStatusPlanned, ReleaseStatusReleased, ReleaseStatusCancelled:
+		// 有効
+	default:
+		return fmt.Errorf("invalid release status: %s", r.Status)
+	}
+	if r.TargetDate != "" {
+		if _, err := time.Parse("2006-01-02", r.TargetDate); err != nil {
+			return fmt.Errorf("invalid release target_date (YYYY-MM-DD): %s", r.TargetDate)
+		}
+	}
+	for _, refs := range []struct {
+		entityType string
+		ids        []string
+	}{
+		{"usecase", r.UseCaseIDs},
+		{"activity", r.ActivityIDs},
+		{"quality", r.QualityIDs},
+	} {
+		for _, id := range refs.ids {
+			if err := ValidateID(refs.entityType, id); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// GetID は Entity インターフェースを実装（ReleaseEntity）
+func (r *ReleaseEntity) GetID() string { return r.ID }
+
+// GetTitle は Entity インターフェースを実装（ReleaseEntity）
+func (r *ReleaseEntity) GetTitle() string { return r.Title }
@@ -16,6 +16,7 @@ type UseCaseHandler struct {
 	objectiveHandler *ObjectiveHandler
 	actorHandler     *ActorHandler
 	idCounterManager *IDCounterManager
+	index            *entityIndex
 }
 
 // NewUseCaseHandler は UseCaseHandler を生成
@@ -25,6 +26,7 @@ func NewUseCaseHandler(fs FileStore, objHandler *ObjectiveHandler, actorHandler
 		objectiveHandler: objHandler,
 		actorHandler:     actorHandler,
 		idCounterManager: idCounter,
+		index:            newEntityIndex(fs, "usecase", "usecases"),
 	}
 }
 
@@ -86,8 +88,8 @@ func (h *UseCaseHandler) Add(ctx context.Context, name string, opts ...EntityOpt
 
 	// 個別ファイルに保存
 	filePath := filepath.Join("usecases", id+".yaml")
-	if err := h.fileStore.WriteYaml(ctx, filePath, &usecase); err != nil {
-		return nil, fmt.Errorf("failed to write usecase file: %w", err)
+	if err := h.writeUseCase(ctx, filePath, &usecase); err != nil {
+		return nil, err
 	}
 
 	return &AddResult{
@@ -118,24 +120,15 @@ func (h *UseCaseHandler) List(ctx context.Context, filter *ListFilter) (*ListRes
 		return nil, fmt.Errorf("failed to list usecases directory: %w", err)
 	}
 
-	items := make([]ListItem, 0)
-	for _, file := range files {
-		if !hasYamlSuffix(file) {
-			continue
-		}
+	// シナリオ・フローを含む本体はインデックスが無効な場合のみパースする
+	entries := h.index.Entries(ctx, files, func(file string) (IndexEntry, bool) {
 		var usecase UseCaseEntity
 		if err := h.fileStore.ReadYaml(ctx, filepath.Join("usecases", file), &usecase); err != nil {
-			continue // 読み込み失敗はスキップ
+			return IndexEntry{}, false
 		}
-		// ListItem に変換
-		items = append(items, ListItem{
-			ID:        usecase.ID,
-			Title:     usecase.Title,
-			Status:    ItemStatus(usecase.Status),
-			CreatedAt: usecase.Metadata.CreatedAt,
-			UpdatedAt: usecase.Metadata.UpdatedAt,
-		})
-	}
+		return usecaseIndexEntry(&usecase), true
+	})
+	items := indexEntriesToListItems(entries)
 
 	return &ListResult{
 		Entity: h.Type(),
@@ -214,7 +207,7 @@ func (h *UseCaseHandler) Update(ctx context.Context, id string, update any) erro
 		return err
 	}
 
-	return h.fileStore.WriteYaml(ctx, filePath, &usecase)
+	return h.writeUseCase(ctx, filePath, &usecase)
 }
 
 // Delete はユースケースを削除
@@ -233,7 +226,10 @@ func (h *UseCaseHandler) Delete(ctx context.Context, id string) error {
 		return ErrEntityNotFound
 	}
 
-	return h.fileStore.Delete(ctx, filePath)
+	if err := h.fileStore.Delete(ctx, filePath); err != nil {
+		return err
+	}
+	return h.index.Remove(ctx, id)
 }
 
 // AddRelation はユースケース間の関係を追加
@@ -271,7 +267,7 @@ func (h *UseCaseHandler) AddRelation(ctx context.Context, usecaseID string, rel
 		return err
 	}
 
-	return h.fileStore.WriteYaml(ctx, filePath, &usecase)
+	return h.writeUseCase(ctx, filePath, &usecase)
 }
 
 // AddActor はユースケースにアクター参照を追加
@@ -313,7 +309,7 @@ func (h *UseCaseHandler) AddActor(ctx context.Context, usecaseID string, actorRe
 	usecase.Actors = append(usecase.Actors, actorRef)
 	usecase.Metadata.UpdatedAt = Now()
 
-	return h.fileStore.WriteYaml(ctx, filePath, &usecase)
+	return h.writeUseCase(ctx, filePath, &usecase)
 }
 
 // getAllUseCases は全てのユースケースを取得（内部用）
@@ -350,6 +346,25 @@ func (h *UseCaseHandler) getAllUseCases(ctx context.Context) ([]*UseCaseEntity,
 	return usecases, nil
 }
 
+// writeUseCase はユースケースファイルを書き込み、インデックスを更新
+func (h *UseCaseHandler) writeUseCase(ctx context.Context, filePath string, usecase *UseCaseEntity) error {
+	if err := h.fileStore.WriteYaml(ctx, filePath, usecase); err != nil {
+		return fmt.Errorf("failed to write usecase file: %w", err)
+	}
+	return h.index.Upsert(ctx, usecaseIndexEntry(usecase))
+}
+
+// usecaseIndexEntry はユースケースからインデックスエントリを生成
+func usecaseIndexEntry(usecase *UseCaseEntity) IndexEntry {
+	return IndexEntry{
+		ID:        usecase.ID,
+		Title:     usecase.Title,
+		Status:    string(usecase.Status),
+		CreatedAt: usecase.Metadata.CreatedAt,
+		UpdatedAt: usecase.Metadata.UpdatedAt,
+	}
+}
+
 // generateUseCaseID はユースケース ID を生成
 func (h *UseCaseHandler) generateUseCaseID() string {
 	return fmt.Sprintf("uc-%s", uuid.New().String()[:8])
@@ -0,0 +1,132 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNoMainFlow は UseCase にメインフローが無い（アクティビティの雛形を生成できない）
+var ErrNoMainFlow = errors.New("usecase has no main flow")
+
+// ScaffoldActivity は UseCase のシナリオからアクティビティの雛形を生成して追加する
+// title が空の場合は UseCase のタイトルを使う。生成したアクティビティは usecase_id で UseCase に紐付く
+func (z *Zeus) ScaffoldActivity(ctx context.Context, usecaseID, title string) (*AddResult, error) {
+	got, err := z.Get(ctx, "usecase", usecaseID)
+	if err != nil {
+		return nil, err
+	}
+	uc := got.(*UseCaseEntity)
+	if len(uc.Scenario.MainFlow) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoMainFlow, uc.ID)
+	}
+	if title == "" {
+		title = uc.Title
+	}
+
+	nodes, transitions := BuildActivityScaffold(uc)
+	return z.Add(ctx, "activity", title,
+		WithActivityUseCase(uc.ID),
+		WithActivityDescription(fmt.Sprintf("%s のシナリオから生成", uc.ID)),
+		WithActivityNodes(nodes),
+		WithActivityTransitions(transitions),
+	)
+}
+
+// BuildActivityScaffold は UseCase のシナリオからアクティビティのノードと遷移を組み立てる
+// - メインフローの各ステップを action ノードにし、initial から final まで順につなぐ
+// - 代替フローごとに decision ノードを作り、rejoins_at のステップの直前で分岐して merge ノードで合流する
+// - rejoins_at が無い（メインフローに見つからない）代替フローは final の直前で分岐する
+// 例外フローは雛形に含めない
+func BuildActivityScaffold(uc *UseCaseEntity) ([]ActivityNode, []ActivityTransition) {
+	b := &activityScaffoldBuilder{}
+	mainFlow := uc.Scenario.MainFlow
+
+	// 分岐位置（合流先のステップ番号、final の場合は len(mainFlow)+1）ごとに代替フローをまとめる
+	branches := map[int][]AlternativeFlow{}
+	for _, af := range uc.Scenario.AlternativeFlows {
+		at := scenarioStepIndex(mainFlow, af.RejoinsAt)
+		if af.RejoinsAt == "" || at == 0 {
+			at = len(mainFlow) + 1
+		}
+		branches[at] = append(branches[at], af)
+	}
+
+	prev := b.node(ActivityNodeTypeInitial, "")
+	for at := 1; at <= len(mainFlow)+1; at++ {
+		if flows := branches[at]; len(flows) > 0 {
+			prev = b.branch(prev, flows)
+		}
+		next := ""
+		if at <= len(mainFlow) {
+			next = b.node(ActivityNodeTypeAction, mainFlow[at-1])
+		} else {
+			next = b.node(ActivityNodeTypeFinal, "")
+		}
+		b.link(prev, next, "")
+		prev = next
+	}
+	return b.nodes, b.transitions
+}
+
+// activityScaffoldBuilder はノード・遷移に連番の ID（node-001, trans-001）を振りながら組み立てる
+type activityScaffoldBuilder struct {
+	nodes       []ActivityNode
+	transitions []ActivityTransition
+}
+
+func (b *activityScaffoldBuilder) node(t ActivityNodeType, name string) string {
+	id := fmt.Sprintf("node-%03d", len(b.nodes)+1)
+	b.nodes = append(b.nodes, ActivityNode{ID: id, Type: t, Name: name})
+	return id
+}
+
+func (b *activityScaffoldBuilder) link(source, target, guard string) {
+	b.transitions = append(b.transitions, ActivityTransition{
+		ID:     fmt.Sprintf("trans-%03d", len(b.transitions)+1),
+		Source: source,
+		Target: target,
+		Guard:  guard,
+	})
+}
+
+// branch は prev から代替フローごとの decision ノードを連ね、すべてを merge ノードで合流させる
+// 代替フローに入らない経路は [else] で次の decision（最後は merge）へ進む。merge ノードの ID を返す
+func (b *activityScaffoldBuilder) branch(prev string, flows []AlternativeFlow) string {
+	type pending struct{ source, guard string }
+	var ends []pending
+	for _, af := range flows {
+		name := af.Name
+		if name == "" {
+			name = af.Condition
+		}
+		decision := b.node(ActivityNodeTypeDecision, name)
+		if len(ends) == 0 {
+			b.link(prev, decision, "")
+		} else {
+			b.link(prev, decision, "[else]")
+		}
+
+		guard := ""
+		if af.Condition != "" {
+			guard = "[" + af.Condition + "]"
+		} else if af.Name != "" {
+			guard = "[" + af.Name + "]"
+		}
+		last := decision
+		for _, step := range af.Steps {
+			action := b.node(ActivityNodeTypeAction, step)
+			b.link(last, action, guard)
+			last, guard = action, ""
+		}
+		ends = append(ends, pending{source: last, guard: guard})
+		prev = decision
+	}
+
+	merge := b.node(ActivityNodeTypeMerge, "")
+	b.link(prev, merge, "[else]")
+	for _, end := range ends {
+		b.link(end.source, merge, end.guard)
+	}
+	return merge
+}
@@ -0,0 +1,94 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestBuildActivityScaffold(t *testing.T) {
+	uc := &UseCaseEntity{
+		ID: "uc-12345678",
+		Scenario: UseCaseScenario{
+			MainFlow: []string{"ID を入力", "パスワードを入力", "ログイン完了"},
+			AlternativeFlows: []AlternativeFlow{
+				{ID: "alt-1", Name: "SSO", Condition: "SSO 利用時", Steps: []string{"IdP へリダイレクト"}, RejoinsAt: "3"},
+				{ID: "alt-2", Name: "ゲスト", Condition: "ゲスト利用時"},
+			},
+		},
+	}
+	nodes, transitions := BuildActivityScaffold(uc)
+
+	activity := &ActivityEntity{ID: "act-12345678", Title: "ログイン", Nodes: nodes, Transitions: transitions}
+	if err := activity.Validate(); err != nil {
+		t.Fatalf("scaffold is not a valid activity: %v", err)
+	}
+
+	count := map[ActivityNodeType]int{}
+	byName := map[string]string{}
+	for _, n := range nodes {
+		count[n.Type]++
+		byName[n.Name] = n.ID
+	}
+	if count[ActivityNodeTypeInitial] != 1 || count[ActivityNodeTypeFinal] != 1 ||
+		count[ActivityNodeTypeAction] != 4 || count[ActivityNodeTypeDecision] != 2 || count[ActivityNodeTypeMerge] != 2 {
+		t.Errorf("unexpected node counts: %+v", count)
+	}
+
+	edges := map[[2]string]string{}
+	for _, tr := range transitions {
+		edges[[2]string{tr.Source, tr.Target}] = tr.Guard
+	}
+	// SSO はステップ 3 の直前で分岐し、ステップ 3 の前で合流する
+	if guard, ok := edges[[2]string{byName["SSO"], byName["IdP へリダイレクト"]}]; !ok || guard != "[SSO 利用時]" {
+		t.Errorf("SSO branch edge missing or wrong guard %q", guard)
+	}
+	if _, ok := edges[[2]string{byName["パスワードを入力"], byName["SSO"]}]; !ok {
+		t.Error("SSO decision should follow step 2")
+	}
+	// rejoins_at が無いゲストは final の直前で分岐する
+	if _, ok := edges[[2]string{byName["ログイン完了"], byName["ゲスト"]}]; !ok {
+		t.Error("guest decision should follow the last step")
+	}
+}
+
+func TestScaffoldActivity(t *testing.T) {
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	obj, err := z.Add(ctx, "objective", "認証基盤")
+	if err != nil {
+		t.Fatalf("Add objective failed: %v", err)
+	}
+	uc, err := z.Add(ctx, "usecase", "ログイン", WithUseCaseObjective(obj.ID),
+		WithUseCaseFullScenario(UseCaseScenario{MainFlow: []string{"ID を入力", "ログイン完了"}}))
+	if err != nil {
+		t.Fatalf("Add usecase failed: %v", err)
+	}
+	empty, err := z.Add(ctx, "usecase", "ログアウト", WithUseCaseObjective(obj.ID))
+	if err != nil {
+		t.Fatalf("Add usecase failed: %v", err)
+	}
+
+	result, err := z.ScaffoldActivity(ctx, uc.ID, "")
+	if err != nil {
+		t.Fatalf("ScaffoldActivity failed: %v", err)
+	}
+	got, err := z.Get(ctx, "activity", result.ID)
+	if err != nil {
+		t.Fatalf("Get activity failed: %v", err)
+	}
+	activity := got.(*ActivityEntity)
+	if activity.UseCaseID != uc.ID || activity.Title != "ログイン" || len(activity.Nodes) != 4 || len(activity.Transitions) != 3 {
+		t.Errorf("unexpected activity: %+v", activity)
+	}
+
+	if _, err := z.ScaffoldActivity(ctx, empty.ID, ""); !errors.Is(err, ErrNoMainFlow) {
+		t.Errorf("expected ErrNoMainFlow, got %v", err)
+	}
+	if _, err := z.ScaffoldActivity(ctx, "uc-00000000", ""); err == nil {
+		t.Error("expected error for missing usecase")
+	}
+}
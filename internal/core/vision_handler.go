@@ -11,6 +11,7 @@ import (
 type VisionHandler struct {
 	fileStore FileStore
 	sanitizer *Sanitizer
+	actor     string // 版の履歴に記録する変更者
 }
 
 // NewVisionHandler は新しい VisionHandler を作成
@@ -63,11 +64,13 @@ func (h *VisionHandler) Add(ctx context.Context, name string, opts ...EntityOpti
 	}
 
 	// 既存の Vision を確認
+	var previous *Vision
 	existing, _ := h.Get(ctx, id)
 	if existing != nil {
 		// 既存がある場合は更新（CreatedAt は保持）
 		if ev, ok := existing.(*Vision); ok {
 			vision.Metadata.CreatedAt = ev.Metadata.CreatedAt
+			previous = ev
 		}
 	}
 
@@ -75,6 +78,9 @@ func (h *VisionHandler) Add(ctx context.Context, name string, opts ...EntityOpti
 	if err := h.fileStore.WriteYaml(ctx, "vision.yaml", vision); err != nil {
 		return nil, err
 	}
+	if err := h.recordRevision(ctx, previous, vision); err != nil {
+		return nil, fmt.Errorf("failed to record vision history: %w", err)
+	}
 
 	return &AddResult{
 		Success: true,
@@ -162,7 +168,13 @@ func (h *VisionHandler) Update(ctx context.Context, id string, update any) error
 			return err
 		}
 
-		return h.fileStore.WriteYaml(ctx, "vision.yaml", vision)
+		if err := h.fileStore.WriteYaml(ctx, "vision.yaml", vision); err != nil {
+			return err
+		}
+		if err := h.recordRevision(ctx, existingVision, vision); err != nil {
+			return fmt.Errorf("failed to record vision history: %w", err)
+		}
+		return nil
 	}
 
 	return fmt.Errorf("invalid update type: expected *Vision")
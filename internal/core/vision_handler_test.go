@@ -2,7 +2,9 @@ package core
 
 import (
 	"context"
+	"errors"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/biwakonbu/zeus/internal/yaml"
@@ -451,3 +453,92 @@ func TestVisionHandlerPreservesCreatedAt(t *testing.T) {
 			updated.Metadata.CreatedAt, originalCreatedAt)
 	}
 }
+
+func TestVisionHandler_RecordsHistory(t *testing.T) {
+	handler, _, cleanup := setupVisionHandlerTest(t)
+	defer cleanup()
+	handler.actor = "alice"
+	ctx := context.Background()
+
+	if _, err := handler.Add(ctx, "AI駆動PM", WithVisionStatement("AIと人間が協調するPM")); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	// 内容が変わらない更新は版を増やさない
+	if _, err := handler.Add(ctx, "AI駆動PM", WithVisionStatement("AIと人間が協調するPM")); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if _, err := handler.Add(ctx, "AI駆動PM", WithVisionStatement("AIとチームが協調するPM"), WithVisionRationale("対象をチームに拡大")); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+
+	history, err := handler.History(ctx)
+	if err != nil {
+		t.Fatalf("History failed: %v", err)
+	}
+	if len(history) != 2 {
+		t.Fatalf("expected 2 revisions, got %d", len(history))
+	}
+	latest := history[1]
+	if latest.Version != 2 || latest.Rationale != "対象をチームに拡大" || latest.ChangedBy != "alice" {
+		t.Errorf("unexpected latest revision: %+v", latest)
+	}
+	if latest.Vision.Statement != "AIとチームが協調するPM" {
+		t.Errorf("revision should hold the new statement, got %q", latest.Vision.Statement)
+	}
+
+	// 変更理由は vision.yaml には保存しない
+	data, err := os.ReadFile(handler.fileStore.BasePath() + "/vision.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "対象をチームに拡大") {
+		t.Error("rationale should not be written to vision.yaml")
+	}
+}
+
+func TestVisionHistory_BaselineAndDiff(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	z := New(dir, WithActor("bob"))
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	// 履歴導入前の vision.yaml
+	legacy := &Vision{ID: "vision-001", Title: "Old", Statement: "旧ビジョン", Status: VisionStatusActive,
+		Metadata: Metadata{CreatedAt: "2025-01-01T00:00:00Z", UpdatedAt: "2025-01-01T00:00:00Z"}}
+	if err := z.fileStore.WriteYaml(ctx, "vision.yaml", legacy); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := z.VisionDiff(ctx, 0, 0); !errors.Is(err, ErrVisionRevisionNotFound) {
+		t.Errorf("diff without history should fail, got %v", err)
+	}
+
+	if _, err := z.Add(ctx, "vision", "New", WithVisionStatement("新ビジョン"), WithVisionRationale("方針転換")); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	history, err := z.VisionHistory(ctx)
+	if err != nil || len(history) != 2 {
+		t.Fatalf("expected baseline and new revision: %+v err=%v", history, err)
+	}
+	if history[0].Vision.Statement != "旧ビジョン" || history[0].ChangedAt != "2025-01-01T00:00:00Z" || history[0].Rationale != "" {
+		t.Errorf("unexpected baseline revision: %+v", history[0])
+	}
+	if history[1].ChangedBy != "bob" || history[1].Rationale != "方針転換" {
+		t.Errorf("unexpected revision: %+v", history[1])
+	}
+
+	diff, err := z.VisionDiff(ctx, 0, 0)
+	if err != nil {
+		t.Fatalf("VisionDiff failed: %v", err)
+	}
+	if !strings.Contains(diff, "-statement: 旧ビジョン") || !strings.Contains(diff, "+statement: 新ビジョン") {
+		t.Errorf("diff should show the statement change:\n%s", diff)
+	}
+	if strings.Contains(diff, "updated_at") {
+		t.Errorf("diff should ignore updated_at:\n%s", diff)
+	}
+	if _, err := z.VisionDiff(ctx, 1, 5); !errors.Is(err, ErrVisionRevisionNotFound) {
+		t.Errorf("unknown version should fail, got %v", err)
+	}
+}
@@ -0,0 +1,177 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// visionHistoryDir は Vision の版を保存するディレクトリ
+const visionHistoryDir = "vision/history"
+
+// ErrVisionRevisionNotFound は指定した版の Vision が存在しない
+var ErrVisionRevisionNotFound = errors.New("vision revision not found")
+
+// VisionRevision は変更時に記録した Vision の版（vision/history/NNNN.yaml）
+type VisionRevision struct {
+	Version   int    `yaml:"version" json:"version"`
+	ChangedAt string `yaml:"changed_at" json:"changed_at"`
+	ChangedBy string `yaml:"changed_by,omitempty" json:"changed_by,omitempty"`
+	Rationale string `yaml:"rationale,omitempty" json:"rationale,omitempty"` // 変更理由
+	Vision    Vision `yaml:"vision" json:"vision"`
+}
+
+// WithVisionRationale は Vision の変更理由を設定（版の履歴に記録され、vision.yaml には保存しない）
+func WithVisionRationale(rationale string) EntityOption {
+	return func(v any) {
+		if vision, ok := v.(*Vision); ok {
+			vision.rationale = rationale
+		}
+	}
+}
+
+// visionRevisionPath は版 version の保存先
+func visionRevisionPath(version int) string {
+	return filepath.Join(visionHistoryDir, fmt.Sprintf("%04d.yaml", version))
+}
+
+// History は Vision の版を古い順に返す
+func (h *VisionHandler) History(ctx context.Context) ([]VisionRevision, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	files, err := h.fileStore.Glob(ctx, filepath.Join(visionHistoryDir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]VisionRevision, 0, len(files))
+	for _, file := range files {
+		if _, err := strconv.Atoi(strings.TrimSuffix(filepath.Base(file), ".yaml")); err != nil {
+			continue
+		}
+		var rev VisionRevision
+		if err := h.fileStore.ReadYaml(ctx, file, &rev); err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, rev)
+	}
+	sort.Slice(revisions, func(i, j int) bool { return revisions[i].Version < revisions[j].Version })
+	return revisions, nil
+}
+
+// recordRevision は Vision の変更を新しい版として記録する
+// 履歴が無い既存の Vision を変更する場合は、変更前の内容を最初の版として記録する
+// 直近の版から内容（更新日時を除く）が変わっていない場合は記録しない
+func (h *VisionHandler) recordRevision(ctx context.Context, previous, current *Vision) error {
+	revisions, err := h.History(ctx)
+	if err != nil {
+		return err
+	}
+	version := 0
+	var latest *Vision
+	if len(revisions) > 0 {
+		last := revisions[len(revisions)-1]
+		version, latest = last.Version, &last.Vision
+	} else if previous != nil {
+		version = 1
+		latest = previous
+		baseline := VisionRevision{Version: version, ChangedAt: previous.Metadata.UpdatedAt, Vision: *previous}
+		if err := h.fileStore.WriteYaml(ctx, visionRevisionPath(version), &baseline); err != nil {
+			return err
+		}
+	}
+
+	if latest != nil && sameVisionContent(latest, current) {
+		return nil
+	}
+	version++
+	rev := VisionRevision{
+		Version:   version,
+		ChangedAt: current.Metadata.UpdatedAt,
+		ChangedBy: h.actor,
+		Rationale: current.rationale,
+		Vision:    *current,
+	}
+	return h.fileStore.WriteYaml(ctx, visionRevisionPath(version), &rev)
+}
+
+// sameVisionContent は a と b が更新日時を除いて同じ内容か
+func sameVisionContent(a, b *Vision) bool {
+	return bytes.Equal(visionContentYaml(a), visionContentYaml(b))
+}
+
+// visionContentYaml は差分表示・比較用に Vision を更新日時を除いて YAML にする
+func visionContentYaml(v *Vision) []byte {
+	c := *v
+	c.Metadata.UpdatedAt = ""
+	data, err := yaml.Marshal(&c)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// visionHandler は登録済みの VisionHandler を返す
+func (z *Zeus) visionHandler() (*VisionHandler, error) {
+	handler, ok := z.entityRegistry.Get("vision")
+	if !ok {
+		return nil, fmt.Errorf("%w: vision", ErrUnknownEntity)
+	}
+	return handler.(*VisionHandler), nil
+}
+
+// VisionHistory は Vision の版を古い順に返す
+func (z *Zeus) VisionHistory(ctx context.Context) ([]VisionRevision, error) {
+	handler, err := z.visionHandler()
+	if err != nil {
+		return nil, err
+	}
+	return handler.History(ctx)
+}
+
+// VisionDiff は Vision の版 from から版 to への unified diff を返す
+// to が 0 の場合は最新版、from が 0 の場合は to の 1 つ前の版を使用する
+func (z *Zeus) VisionDiff(ctx context.Context, from, to int) (string, error) {
+	revisions, err := z.VisionHistory(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(revisions) == 0 {
+		return "", fmt.Errorf("%w: no vision history", ErrVisionRevisionNotFound)
+	}
+	if to == 0 {
+		to = revisions[len(revisions)-1].Version
+	}
+	if from == 0 {
+		from = to - 1
+	}
+	find := func(version int) (*VisionRevision, error) {
+		for i := range revisions {
+			if revisions[i].Version == version {
+				return &revisions[i], nil
+			}
+		}
+		return nil, fmt.Errorf("%w: v%d", ErrVisionRevisionNotFound, version)
+	}
+	newRev, err := find(to)
+	if err != nil {
+		return "", err
+	}
+	var oldData []byte
+	if from > 0 {
+		oldRev, err := find(from)
+		if err != nil {
+			return "", err
+		}
+		oldData = visionContentYaml(&oldRev.Vision)
+	}
+	return unifiedDiff(fmt.Sprintf("vision v%d", from), fmt.Sprintf("vision v%d", to),
+		oldData, visionContentYaml(&newRev.Vision)), nil
+}
@@ -0,0 +1,250 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/yaml"
+	"github.com/google/uuid"
+)
+
+// 作業キューのリース（Activity の割り当て）の配置とデフォルトの有効期間
+const (
+	claimsFile      = "state/claims.yaml"
+	DefaultClaimTTL = 15 * time.Minute
+)
+
+// 作業キューのエラー
+var (
+	// ErrNoAvailableWork は割り当て可能な Activity が無い
+	ErrNoAvailableWork = errors.New("no available activity")
+	// ErrActivityClaimed は Activity が別のワーカーに割り当て済み
+	ErrActivityClaimed = errors.New("activity already claimed")
+	// ErrLeaseNotFound はリースが存在しないか期限切れ
+	ErrLeaseNotFound = errors.New("lease not found or expired")
+)
+
+// workQueueMu はプロセス内でのリースの読み書きを直列化する（プロセス間はファイルロック）
+var workQueueMu sync.Mutex
+
+// WorkClaim は Activity をワーカーに割り当てたリース
+type WorkClaim struct {
+	LeaseID    string `yaml:"lease_id" json:"lease_id"`
+	ActivityID string `yaml:"activity_id" json:"activity_id"`
+	Title      string `yaml:"title" json:"title"`
+	Worker     string `yaml:"worker" json:"worker"`
+	ClaimedAt  string `yaml:"claimed_at" json:"claimed_at"`
+	RenewedAt  string `yaml:"renewed_at,omitempty" json:"renewed_at,omitempty"` // 最後のハートビート
+	ExpiresAt  string `yaml:"expires_at" json:"expires_at"`
+	TTLSeconds int    `yaml:"ttl_seconds" json:"ttl_seconds"`
+}
+
+// expired はリースが now の時点で期限切れか
+func (c *WorkClaim) expired(now time.Time) bool {
+	t, err := time.Parse(time.RFC3339, c.ExpiresAt)
+	return err != nil || !now.Before(t)
+}
+
+// workClaimStore は state/claims.yaml の内容
+type workClaimStore struct {
+	Claims []WorkClaim `yaml:"claims"`
+}
+
+// ClaimRequest は作業の割り当て要求
+type ClaimRequest struct {
+	Worker     string        // ワーカー名（必須）
+	ActivityID string        // 割り当てる Activity（空の場合は優先度の高い順に空いているものを選ぶ）
+	TTL        time.Duration // リースの有効期間（0 の場合は DefaultClaimTTL）
+}
+
+// ClaimWork は空いている Activity をワーカーに割り当て、リースを返す
+// 複数のエージェントが同時に要求しても同じ Activity を二重に割り当てない
+// ActivityID を省略した場合は廃止されていない Activity を優先度スコア（RICE / WSJF）の高い順に選ぶ
+func (z *Zeus) ClaimWork(ctx context.Context, req ClaimRequest, now time.Time) (*WorkClaim, error) {
+	if req.Worker == "" {
+		return nil, fmt.Errorf("worker is required")
+	}
+	ttl, err := claimTTL(req.TTL)
+	if err != nil {
+		return nil, err
+	}
+
+	var claim *WorkClaim
+	err = z.updateClaims(ctx, now, func(store *workClaimStore) error {
+		claimed := make(map[string]string, len(store.Claims))
+		for _, c := range store.Claims {
+			claimed[c.ActivityID] = c.Worker
+		}
+
+		var activityID, title string
+		if req.ActivityID != "" {
+			got, err := z.Get(ctx, "activity", req.ActivityID)
+			if err != nil {
+				return err
+			}
+			act := got.(*ActivityEntity)
+			if act.Status == ActivityStatusDeprecated {
+				return fmt.Errorf("activity %s is deprecated", act.ID)
+			}
+			if worker, ok := claimed[act.ID]; ok {
+				return fmt.Errorf("%w: %s is claimed by %s", ErrActivityClaimed, act.ID, worker)
+			}
+			activityID, title = act.ID, act.Title
+		} else {
+			backlog, err := z.Prioritize(ctx, PrioritizeOptions{Entity: "activity"})
+			if err != nil {
+				return err
+			}
+			for _, item := range append(backlog.Ranked, backlog.Unscored...) {
+				if _, ok := claimed[item.ID]; !ok {
+					activityID, title = item.ID, item.Title
+					break
+				}
+			}
+			if activityID == "" {
+				return ErrNoAvailableWork
+			}
+		}
+
+		claim = &WorkClaim{
+			LeaseID:    "lease-" + uuid.New().String()[:8],
+			ActivityID: activityID,
+			Title:      title,
+			Worker:     req.Worker,
+			ClaimedAt:  now.UTC().Format(time.RFC3339),
+			ExpiresAt:  now.Add(ttl).UTC().Format(time.RFC3339),
+			TTLSeconds: int(ttl / time.Second),
+		}
+		store.Claims = append(store.Claims, *claim)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claim, nil
+}
+
+// RenewClaim はハートビートとしてリースの期限を延長する（ttl が 0 の場合は割り当て時の有効期間）
+// 期限切れのリースは延長できない（別のワーカーに割り当てられている可能性があるため）
+func (z *Zeus) RenewClaim(ctx context.Context, leaseID string, ttl time.Duration, now time.Time) (*WorkClaim, error) {
+	if ttl != 0 {
+		var err error
+		if ttl, err = claimTTL(ttl); err != nil {
+			return nil, err
+		}
+	}
+
+	var claim *WorkClaim
+	err := z.updateClaims(ctx, now, func(store *workClaimStore) error {
+		for i := range store.Claims {
+			c := &store.Claims[i]
+			if c.LeaseID != leaseID {
+				continue
+			}
+			if ttl == 0 {
+				ttl = time.Duration(c.TTLSeconds) * time.Second
+			}
+			c.RenewedAt = now.UTC().Format(time.RFC3339)
+			c.ExpiresAt = now.Add(ttl).UTC().Format(time.RFC3339)
+			c.TTLSeconds = int(ttl / time.Second)
+			claim = c
+			return nil
+		}
+		return fmt.Errorf("%w: %s", ErrLeaseNotFound, leaseID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := *claim
+	return &result, nil
+}
+
+// ReleaseClaim はリースを解放し、Activity を再び割り当て可能にする
+func (z *Zeus) ReleaseClaim(ctx context.Context, leaseID string, now time.Time) error {
+	return z.updateClaims(ctx, now, func(store *workClaimStore) error {
+		for i, c := range store.Claims {
+			if c.LeaseID == leaseID {
+				store.Claims = append(store.Claims[:i], store.Claims[i+1:]...)
+				return nil
+			}
+		}
+		return fmt.Errorf("%w: %s", ErrLeaseNotFound, leaseID)
+	})
+}
+
+// Claims は now の時点で有効なリースを返す
+func (z *Zeus) Claims(ctx context.Context, now time.Time) ([]WorkClaim, error) {
+	store, err := z.readClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	claims := []WorkClaim{}
+	for _, c := range store.Claims {
+		if !c.expired(now) {
+			claims = append(claims, c)
+		}
+	}
+	return claims, nil
+}
+
+// claimTTL はリースの有効期間を検証（0 の場合は DefaultClaimTTL）
+func claimTTL(ttl time.Duration) (time.Duration, error) {
+	switch {
+	case ttl == 0:
+		return DefaultClaimTTL, nil
+	case ttl < time.Second:
+		return 0, fmt.Errorf("lease ttl must be >= 1s")
+	}
+	return ttl, nil
+}
+
+// readClaims はリースを読み込む（ファイルが無い場合は空）
+func (z *Zeus) readClaims(ctx context.Context) (*workClaimStore, error) {
+	var store workClaimStore
+	if !z.fileStore.Exists(ctx, claimsFile) {
+		return &store, nil
+	}
+	if err := z.fileStore.ReadYaml(ctx, claimsFile, &store); err != nil {
+		return nil, err
+	}
+	return &store, nil
+}
+
+// updateClaims はロックを取得して期限切れのリースを除いたうえで fn を適用し、書き戻す（fn がエラーの場合は書き込まない）
+func (z *Zeus) updateClaims(ctx context.Context, now time.Time, fn func(store *workClaimStore) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	workQueueMu.Lock()
+	defer workQueueMu.Unlock()
+	lock := yaml.NewFileLock(filepath.Join(z.ZeusPath, claimsFile))
+	if err := lock.LockWithTimeout(5 * time.Second); err != nil {
+		return ErrLockAcquireFailed
+	}
+	defer lock.Unlock()
+
+	store, err := z.readClaims(ctx)
+	if err != nil {
+		return err
+	}
+	active := store.Claims[:0]
+	for _, c := range store.Claims {
+		if !c.expired(now) {
+			active = append(active, c)
+		}
+	}
+	store.Claims = active
+
+	if err := fn(store); err != nil {
+		return err
+	}
+	if err := z.fileStore.EnsureDir(ctx, filepath.Dir(claimsFile)); err != nil {
+		return err
+	}
+	return z.fileStore.WriteYaml(ctx, claimsFile, store)
+}
@@ -0,0 +1,148 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func setupWorkQueue(t *testing.T, titles ...string) (*Zeus, []string) {
+	t.Helper()
+	ctx := context.Background()
+	z := New(t.TempDir())
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	ids := make([]string, 0, len(titles))
+	for _, title := range titles {
+		result, err := z.Add(ctx, "activity", title)
+		if err != nil {
+			t.Fatalf("Add %s failed: %v", title, err)
+		}
+		ids = append(ids, result.ID)
+	}
+	return z, ids
+}
+
+func TestClaimWork_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	z, ids := setupWorkQueue(t, "A", "B", "C")
+	now := time.Now()
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		claimed = map[string]string{}
+		noWork  int
+	)
+	for i := range 6 {
+		wg.Add(1)
+		go func(worker string) {
+			defer wg.Done()
+			claim, err := z.ClaimWork(ctx, ClaimRequest{Worker: worker}, now)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case errors.Is(err, ErrNoAvailableWork):
+				noWork++
+			case err != nil:
+				t.Errorf("ClaimWork failed: %v", err)
+			default:
+				if prev, ok := claimed[claim.ActivityID]; ok {
+					t.Errorf("%s claimed by both %s and %s", claim.ActivityID, prev, worker)
+				}
+				claimed[claim.ActivityID] = worker
+			}
+		}(string(rune('a' + i)))
+	}
+	wg.Wait()
+
+	if len(claimed) != len(ids) || noWork != 3 {
+		t.Errorf("expected %d claims and 3 without work, got %v / %d", len(ids), claimed, noWork)
+	}
+}
+
+func TestClaimWork_SpecificAndPriority(t *testing.T) {
+	ctx := context.Background()
+	z, ids := setupWorkQueue(t, "Unscored")
+	high, err := z.Add(ctx, "activity", "High", WithRICEScore(&RICEScore{Reach: 100, Impact: 2, Confidence: 100, Effort: 1}))
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	now := time.Now()
+
+	claim, err := z.ClaimWork(ctx, ClaimRequest{Worker: "agent-1"}, now)
+	if err != nil {
+		t.Fatalf("ClaimWork failed: %v", err)
+	}
+	if claim.ActivityID != high.ID {
+		t.Errorf("highest priority activity should be claimed first, got %s", claim.ActivityID)
+	}
+
+	if _, err := z.ClaimWork(ctx, ClaimRequest{Worker: "agent-2", ActivityID: high.ID}, now); !errors.Is(err, ErrActivityClaimed) {
+		t.Errorf("expected ErrActivityClaimed, got %v", err)
+	}
+	if _, err := z.ClaimWork(ctx, ClaimRequest{Worker: "agent-2", ActivityID: "act-999"}, now); !errors.Is(err, ErrEntityNotFound) {
+		t.Errorf("expected ErrEntityNotFound, got %v", err)
+	}
+	if _, err := z.ClaimWork(ctx, ClaimRequest{ActivityID: ids[0]}, now); err == nil {
+		t.Error("worker should be required")
+	}
+	claim, err = z.ClaimWork(ctx, ClaimRequest{Worker: "agent-2", ActivityID: ids[0]}, now)
+	if err != nil || claim.ActivityID != ids[0] {
+		t.Fatalf("claiming a specific activity failed: %v", err)
+	}
+}
+
+func TestClaimWork_LeaseLifecycle(t *testing.T) {
+	ctx := context.Background()
+	z, ids := setupWorkQueue(t, "Only")
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	claim, err := z.ClaimWork(ctx, ClaimRequest{Worker: "agent-1", TTL: time.Minute}, now)
+	if err != nil {
+		t.Fatalf("ClaimWork failed: %v", err)
+	}
+	if claim.ActivityID != ids[0] || claim.ExpiresAt != "2026-01-01T10:01:00Z" {
+		t.Fatalf("unexpected claim: %+v", claim)
+	}
+
+	// ハートビートで期限を延長すると、当初の期限を過ぎても割り当て済みのまま
+	renewed, err := z.RenewClaim(ctx, claim.LeaseID, 0, now.Add(50*time.Second))
+	if err != nil {
+		t.Fatalf("RenewClaim failed: %v", err)
+	}
+	if renewed.ExpiresAt != "2026-01-01T10:01:50Z" {
+		t.Errorf("unexpected expiry after heartbeat: %s", renewed.ExpiresAt)
+	}
+	if _, err := z.ClaimWork(ctx, ClaimRequest{Worker: "agent-2"}, now.Add(90*time.Second)); !errors.Is(err, ErrNoAvailableWork) {
+		t.Errorf("renewed lease should still hold the activity, got %v", err)
+	}
+
+	// 期限が切れると別のワーカーに割り当てられ、元のリースは延長できない
+	later := now.Add(5 * time.Minute)
+	claims, err := z.Claims(ctx, later)
+	if err != nil || len(claims) != 0 {
+		t.Errorf("expired lease should not be listed: %+v, %v", claims, err)
+	}
+	reclaimed, err := z.ClaimWork(ctx, ClaimRequest{Worker: "agent-2"}, later)
+	if err != nil || reclaimed.ActivityID != ids[0] {
+		t.Fatalf("expired activity should be reclaimable: %v", err)
+	}
+	if _, err := z.RenewClaim(ctx, claim.LeaseID, 0, later); !errors.Is(err, ErrLeaseNotFound) {
+		t.Errorf("expected ErrLeaseNotFound for expired lease, got %v", err)
+	}
+
+	// 解放すると再び割り当て可能になる
+	if err := z.ReleaseClaim(ctx, reclaimed.LeaseID, later); err != nil {
+		t.Fatalf("ReleaseClaim failed: %v", err)
+	}
+	if err := z.ReleaseClaim(ctx, reclaimed.LeaseID, later); !errors.Is(err, ErrLeaseNotFound) {
+		t.Errorf("expected ErrLeaseNotFound for released lease, got %v", err)
+	}
+	if _, err := z.ClaimWork(ctx, ClaimRequest{Worker: "agent-3"}, later); err != nil {
+		t.Errorf("released activity should be claimable: %v", err)
+	}
+}
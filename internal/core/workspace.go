@@ -0,0 +1,103 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// workspaceNamePattern はワークスペースのプロジェクト名（ダッシュボードの ?project= に使う）
+var workspaceNamePattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// Workspace は 1 つのダッシュボードで切り替えて表示する複数のプロジェクト（ワークスペースファイル）
+//
+//	projects:
+//	  - name: api
+//	    path: ../api
+//	  - path: ../web   # name 省略時はディレクトリ名
+type Workspace struct {
+	Projects []WorkspaceProject `yaml:"projects"`
+}
+
+// WorkspaceProject はワークスペースのプロジェクト
+type WorkspaceProject struct {
+	Name string `yaml:"name,omitempty"`
+	Path string `yaml:"path"` // プロジェクトのルート（.zeus の親ディレクトリ）
+}
+
+// LoadWorkspace はワークスペースファイルを読み込む（相対パスはワークスペースファイルのディレクトリ基準）
+func LoadWorkspace(path string) (*Workspace, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("ワークスペースファイルの読み込みに失敗: %w", err)
+	}
+	var ws Workspace
+	if err := yaml.Unmarshal(data, &ws); err != nil {
+		return nil, fmt.Errorf("ワークスペースファイルのパースに失敗: %w", err)
+	}
+	base := filepath.Dir(path)
+	for i, p := range ws.Projects {
+		if p.Path != "" && !filepath.IsAbs(p.Path) {
+			ws.Projects[i].Path = filepath.Join(base, p.Path)
+		}
+	}
+	ws.fillNames()
+	if err := ws.Validate(); err != nil {
+		return nil, err
+	}
+	return &ws, nil
+}
+
+// ParseWorkspaceProject は "name=path" または "path" 形式のプロジェクト指定を解析（name 省略時はディレクトリ名）
+func ParseWorkspaceProject(spec string) WorkspaceProject {
+	p := WorkspaceProject{Path: spec}
+	if name, path, ok := strings.Cut(spec, "="); ok {
+		p = WorkspaceProject{Name: name, Path: path}
+	}
+	p.fillName()
+	return p
+}
+
+// Validate はワークスペースの妥当性を検証（プロジェクト名は英数字と . _ - のみ、重複不可）
+func (w *Workspace) Validate() error {
+	if len(w.Projects) == 0 {
+		return fmt.Errorf("workspace: projects is required")
+	}
+	seen := map[string]bool{}
+	for i, p := range w.Projects {
+		if p.Path == "" {
+			return fmt.Errorf("workspace: projects[%d].path is required", i)
+		}
+		if !workspaceNamePattern.MatchString(p.Name) {
+			return fmt.Errorf("workspace: invalid project name %q (letters, digits, '.', '_', '-')", p.Name)
+		}
+		if seen[p.Name] {
+			return fmt.Errorf("workspace: duplicate project name %q", p.Name)
+		}
+		seen[p.Name] = true
+	}
+	return nil
+}
+
+// fillNames は name を省略したプロジェクトにディレクトリ名を設定
+func (w *Workspace) fillNames() {
+	for i := range w.Projects {
+		w.Projects[i].fillName()
+	}
+}
+
+// fillName は name が空の場合にディレクトリ名を設定
+func (p *WorkspaceProject) fillName() {
+	if p.Name != "" || p.Path == "" {
+		return
+	}
+	path := p.Path
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	p.Name = filepath.Base(path)
+}
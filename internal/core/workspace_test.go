@@ -0,0 +1,61 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadWorkspace(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "zeus-workspace.yaml")
+	content := "projects:\n  - name: api\n    path: services/api\n  - path: /srv/web\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	ws, err := LoadWorkspace(path)
+	if err != nil {
+		t.Fatalf("LoadWorkspace failed: %v", err)
+	}
+	want := []WorkspaceProject{
+		{Name: "api", Path: filepath.Join(dir, "services", "api")},
+		{Name: "web", Path: "/srv/web"},
+	}
+	if len(ws.Projects) != len(want) {
+		t.Fatalf("projects = %+v", ws.Projects)
+	}
+	for i, p := range ws.Projects {
+		if p != want[i] {
+			t.Errorf("projects[%d] = %+v, want %+v", i, p, want[i])
+		}
+	}
+
+	for _, content := range []string{
+		"projects: []\n",
+		"projects:\n  - name: api\n",
+		"projects:\n  - path: a/api\n  - path: b/api\n",
+		"projects:\n  - name: 'my api'\n    path: api\n",
+	} {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("WriteFile failed: %v", err)
+		}
+		if _, err := LoadWorkspace(path); err == nil {
+			t.Errorf("LoadWorkspace(%q) should fail", content)
+		}
+	}
+}
+
+func TestParseWorkspaceProject(t *testing.T) {
+	tests := []struct {
+		spec string
+		want WorkspaceProject
+	}{
+		{"web=../frontend", WorkspaceProject{Name: "web", Path: "../frontend"}},
+		{"/srv/api", WorkspaceProject{Name: "api", Path: "/srv/api"}},
+	}
+	for _, tt := range tests {
+		if got := ParseWorkspaceProject(tt.spec); got != tt.want {
+			t.Errorf("ParseWorkspaceProject(%q) = %+v, want %+v", tt.spec, got, tt.want)
+		}
+	}
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/biwakonbu/zeus/internal/analysis"
 	"github.com/biwakonbu/zeus/internal/generator"
+	"github.com/biwakonbu/zeus/internal/notify"
 	"github.com/biwakonbu/zeus/internal/report"
 	"github.com/biwakonbu/zeus/internal/yaml"
 	"github.com/google/uuid"
@@ -29,6 +31,19 @@ type Zeus struct {
 
 	// UML ハンドラーへの直接アクセス（TASK-006）
 	subsystemHandler *SubsystemHandler
+
+	// メール送信関数（nil の場合は smtp.SendMail）
+	emailSend notify.SendFunc
+
+	// 操作しているエージェント（--agent、空なら人による操作）
+	agent string
+
+	// 操作者（--as、環境変数 ZEUS_ACTOR、OS のユーザー名）
+	actor string
+
+	// イベントログと監査ログ（採番の状態を保持するため Zeus ごとに 1 つ）
+	eventLog *EventLog
+	auditLog *EventLog
 }
 
 // Option は Zeus の設定オプション
@@ -62,6 +77,20 @@ func WithEntityRegistry(er *EntityRegistry) Option {
 	}
 }
 
+// WithAgent は操作しているエージェントを設定（ガードレールの適用と監査ログの記録に使用）
+func WithAgent(agent string) Option {
+	return func(z *Zeus) {
+		z.agent = agent
+	}
+}
+
+// WithEmailSendFunc はメール送信関数を設定（テスト用）
+func WithEmailSendFunc(send notify.SendFunc) Option {
+	return func(z *Zeus) {
+		z.emailSend = send
+	}
+}
+
 // New は新しい Zeus インスタンスを作成
 func New(projectPath string, opts ...Option) *Zeus {
 	zeusPath := filepath.Join(projectPath, ".zeus")
@@ -85,16 +114,22 @@ func New(projectPath string, opts ...Option) *Zeus {
 		z.stateStore = NewStateManager(zeusPath, z.fileStore)
 	}
 	if z.approvalStore == nil {
-		z.approvalStore = NewApprovalManager(zeusPath, z.fileStore)
+		am := NewApprovalManager(zeusPath, z.fileStore)
+		am.actor = z.actor
+		z.approvalStore = am
 	}
 	if z.idCounterManager == nil {
 		z.idCounterManager = NewIDCounterManager(z.fileStore)
 	}
+	z.eventLog = NewEventLog(filepath.Join(zeusPath, eventLogFile), DefaultEventLogRetain)
+	z.auditLog = NewEventLog(filepath.Join(zeusPath, auditLogFile), DefaultAuditLogRetain)
 	if z.entityRegistry == nil {
 		z.entityRegistry = NewEntityRegistry()
 
 		// 10 概念モデルのハンドラー登録（Phase 1）
-		z.entityRegistry.Register(NewVisionHandler(z.fileStore))
+		visionHandler := NewVisionHandler(z.fileStore)
+		visionHandler.actor = z.actor
+		z.entityRegistry.Register(visionHandler)
 		objHandler := NewObjectiveHandler(z.fileStore, z.idCounterManager)
 		z.entityRegistry.Register(objHandler)
 
@@ -109,7 +144,8 @@ func New(projectPath string, opts ...Option) *Zeus {
 
 		// 10 概念モデルのハンドラー登録（Phase 3）
 		z.entityRegistry.Register(NewConstraintHandler(z.fileStore))
-		z.entityRegistry.Register(NewQualityHandler(z.fileStore, objHandler, z.idCounterManager))
+		qualityHandler := NewQualityHandler(z.fileStore, objHandler, z.idCounterManager)
+		z.entityRegistry.Register(qualityHandler)
 
 		// UML ユースケース図のハンドラー登録
 		actorHandler := NewActorHandler(z.fileStore)
@@ -123,7 +159,19 @@ func New(projectPath string, opts ...Option) *Zeus {
 		z.entityRegistry.Register(usecaseHandler)
 
 		// UML アクティビティ図のハンドラー登録
-		z.entityRegistry.Register(NewActivityHandler(z.fileStore, usecaseHandler))
+		activityHandler := NewActivityHandler(z.fileStore, usecaseHandler)
+		z.entityRegistry.Register(activityHandler)
+
+		// UML ステートマシン図のハンドラー登録
+		z.entityRegistry.Register(NewStateMachineHandler(z.fileStore, usecaseHandler, activityHandler))
+
+		// C4 アーキテクチャ（Container / Component）のハンドラー登録
+		containerHandler := NewContainerHandler(z.fileStore, z.subsystemHandler)
+		z.entityRegistry.Register(containerHandler)
+		z.entityRegistry.Register(NewComponentHandler(z.fileStore, containerHandler))
+
+		// Release のハンドラー登録
+		z.entityRegistry.Register(NewReleaseHandler(z.fileStore, usecaseHandler, activityHandler, qualityHandler))
 	}
 
 	return z
@@ -159,16 +207,23 @@ func (z *Zeus) Init(ctx context.Context) (*InitResult, error) {
 		return nil, err
 	}
 
+	// マシンごとに異なる実行時ファイルをコミットしない
+	if err := ensureGitignore(ctx, z.fileStore); err != nil {
+		return nil, err
+	}
+
 	// 初期状態を記録（空の状態）
 	state := z.stateStore.CalculateState([]ListItem{})
 	if err := z.stateStore.SaveCurrentState(ctx, state); err != nil {
 		return nil, err
 	}
 
-	// Claude Code 連携ファイルを常に生成
-	gen := generator.NewGenerator(z.ProjectPath)
-	if err := gen.GenerateAll(ctx, config.Project.Name); err != nil {
-		fmt.Printf("Warning: Claude Code ファイル生成に失敗: %v\n", err)
+	// Claude Code 連携ファイルを常に生成（.zeus 外への書き込みのためドライランでは行わない）
+	if !isDryRunStore(z.fileStore) {
+		gen := generator.NewGenerator(z.ProjectPath)
+		if err := gen.GenerateAll(ctx, config.Project.Name); err != nil {
+			fmt.Printf("Warning: Claude Code ファイル生成に失敗: %v\n", err)
+		}
 	}
 
 	return &InitResult{
@@ -178,6 +233,23 @@ func (z *Zeus) Init(ctx context.Context) (*InitResult, error) {
 	}, nil
 }
 
+// LoadConfig は zeus.yaml を読み込む
+func (z *Zeus) LoadConfig(ctx context.Context) (*ZeusConfig, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if !z.fileStore.Exists(ctx, "zeus.yaml") {
+		return nil, ErrConfigNotFound
+	}
+
+	var config ZeusConfig
+	if err := z.fileStore.ReadYaml(ctx, "zeus.yaml", &config); err != nil {
+		return nil, fmt.Errorf("zeus.yaml の読み込みに失敗: %w", err)
+	}
+	return &config, nil
+}
+
 // Status はプロジェクトステータスを取得
 func (z *Zeus) Status(ctx context.Context) (*StatusResult, error) {
 	if err := ctx.Err(); err != nil {
@@ -224,48 +296,37 @@ func (z *Zeus) Add(ctx context.Context, entity, name string, opts ...EntityOptio
 		return nil, ErrUnknownEntity
 	}
 
-	// 設定を読み込んで承認レベルを判定
-	var config ZeusConfig
-	if err := z.fileStore.ReadYaml(ctx, "zeus.yaml", &config); err != nil {
-		// 設定読み込み失敗時は auto として扱う
-		config.Settings.ApprovalMode = "loose"
-		config.Settings.AutomationLevel = "auto"
+	// 操作者をオーナーの既定値にする（明示したオプションが優先）
+	ownerOpt, err := z.actorOwnerOption(ctx, entity)
+	if err != nil {
+		return nil, err
 	}
-
-	// auto レベルは常に即時実行
-	if config.Settings.AutomationLevel == "auto" {
-		return z.executeAdd(ctx, handler, entity, name, opts...)
+	if ownerOpt != nil {
+		opts = append([]EntityOption{ownerOpt}, opts...)
 	}
 
-	// notify/approve: 承認レベルを判定
-	approvalLevel := z.approvalStore.(*ApprovalManager).DetermineApprovalLevel("task_create", &config.Settings)
-
-	switch approvalLevel {
-	case ApprovalAuto:
-		// 自動承認: 即時実行
-		return z.executeAdd(ctx, handler, entity, name, opts...)
-
-	case ApprovalNotify:
-		// 通知付き実行: 実行してログに記録
-		result, err := z.executeAdd(ctx, handler, entity, name, opts...)
-		if err != nil {
-			return nil, err
-		}
-		// TODO: 通知ログの記録（将来の機能拡張）
-		return result, nil
+	// 設定を読み込んで承認レベルを判定（settings.operations の <entity>_create を優先）
+	config := z.approvalConfig(ctx)
+	approvalLevel, err := z.operationApprovalLevel(ctx, &config, entity, ChangeOpCreate, "")
+	if err != nil {
+		return nil, err
+	}
 
-	case ApprovalApprove:
-		// 明示的承認が必要: 承認待ちキューに追加
-		approval, err := z.approvalStore.(*ApprovalManager).Create(
-			ctx,
-			"task_create",
+	if approvalLevel == ApprovalApprove {
+		// 明示的承認が必要: 承認者が確認できるよう、追加するエンティティの内容と差分とともに承認待ちキューに追加
+		approval, err := z.queueApproval(ctx, &config, entity, ChangeOpCreate,
 			fmt.Sprintf("%s '%s' の追加", entity, name),
-			approvalLevel,
-			"", // entityID は承認後に決定
 			map[string]string{"entity": entity, "name": name},
-		)
+			func(tx *Zeus) (string, error) {
+				txHandler, _ := tx.entityRegistry.Get(entity)
+				added, err := tx.executeAdd(ctx, txHandler, entity, name, opts...)
+				if err != nil {
+					return "", err
+				}
+				return added.ID, nil
+			})
 		if err != nil {
-			return nil, fmt.Errorf("承認待ちキューへの追加に失敗しました: %w", err)
+			return nil, err
 		}
 
 		return &AddResult{
@@ -275,11 +336,15 @@ func (z *Zeus) Add(ctx context.Context, entity, name string, opts ...EntityOptio
 			NeedsApproval: true,
 			ApprovalID:    approval.ID,
 		}, nil
+	}
 
-	default:
-		// デフォルトは即時実行
-		return z.executeAdd(ctx, handler, entity, name, opts...)
+	// auto / notify: 即時実行（notify の通知ログの記録は将来の機能拡張）
+	result, err := z.executeAdd(ctx, handler, entity, name, opts...)
+	if err != nil {
+		return nil, err
 	}
+	z.audit(ctx, AuditEntry{Op: ChangeOpCreate, Entity: entity, ID: result.ID, Result: AuditResultApplied})
+	return result, nil
 }
 
 // executeAdd は実際のエンティティ追加を実行
@@ -337,6 +402,68 @@ func (z *Zeus) Get(ctx context.Context, entity, id string) (any, error) {
 	return handler.Get(ctx, id)
 }
 
+// Delete は指定されたエンティティを削除
+func (z *Zeus) Delete(ctx context.Context, entity, id string) error {
+	return z.DeleteWithOptions(ctx, entity, id, DeleteOptions{})
+}
+
+// DeleteWithOptions はエンティティを削除し、参照元には参照の種類ごとの削除ポリシー（restrict, cascade, nullify）を適用する
+// restrict の参照元がある場合は何も変更せずに DeleteRestrictedError を返す
+func (z *Zeus) DeleteWithOptions(ctx context.Context, entity, id string, opts DeleteOptions) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if _, ok := z.entityRegistry.Get(entity); !ok {
+		return ErrUnknownEntity
+	}
+
+	// 承認待ちに追加する前に、削除を妨げる参照元が無いことを確認
+	plan, err := z.PlanDelete(ctx, entity, id, opts)
+	if err != nil {
+		return err
+	}
+	if len(plan.Restricted) > 0 {
+		return &DeleteRestrictedError{Plan: plan}
+	}
+
+	// 連鎖して削除・参照を外すエンティティを含め、1 件でも承認が必要な場合は削除全体を承認待ちキューに追加
+	config := z.approvalConfig(ctx)
+	level, err := z.deletePlanApprovalLevel(ctx, &config, plan)
+	if err != nil {
+		return err
+	}
+	apply := func(tx *Zeus, plan *DeletePlan) error {
+		if err := tx.applyDeletePlan(ctx, plan); err != nil {
+			return err
+		}
+		txHandler, _ := tx.entityRegistry.Get(entity)
+		return txHandler.Delete(ctx, id)
+	}
+	if level == ApprovalApprove {
+		approval, err := z.queueApprovalWithRelated(ctx, &config, entity, ChangeOpDelete,
+			fmt.Sprintf("%s %s の削除", entity, id),
+			map[string]string{"entity": entity, "id": id},
+			plan.relatedEntities(),
+			func(tx *Zeus) (string, error) {
+				return id, apply(tx, plan)
+			})
+		if err != nil {
+			return err
+		}
+		return &ApprovalRequiredError{Action: approval.Type, ApprovalID: approval.ID, EntityID: id}
+	}
+
+	// 連鎖削除の途中で失敗しても一部だけ反映されないよう、まとめて反映する
+	if err := z.Transaction(ctx, func(tx *Zeus) error { return apply(tx, plan) }); err != nil {
+		return err
+	}
+	z.auditDeletePlan(ctx, plan)
+
+	// 状態を更新
+	return z.updateState(ctx)
+}
+
 // GetRegistry は EntityRegistry を返す
 func (z *Zeus) GetRegistry() *EntityRegistry {
 	return z.entityRegistry
@@ -351,11 +478,81 @@ func (z *Zeus) Pending(ctx context.Context) ([]PendingApproval, error) {
 }
 
 // Approve はアイテムを承認
+// 承認対象のエンティティが記録されている場合は、その内容をそのまま書き込んでから承認済みにする
+// 承認は人が行うため、エージェント（--agent）による承認は拒否する
 func (z *Zeus) Approve(ctx context.Context, id string) (*ApprovalResult, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
-	return z.approvalStore.Approve(ctx, id)
+	approval, err := z.approvalStore.Get(ctx, id)
+	if z.agent != "" {
+		entry := AuditEntry{Op: "approve", Entity: "approval", ID: id, Result: AuditResultDenied, ApprovalID: id, Reason: ErrAgentApprovalForbidden.Error()}
+		if err == nil && approval.Entity != nil {
+			entry.Op, entry.Entity, entry.ID = approval.Entity.Operation(), approval.Entity.Type, approval.Entity.ID
+		}
+		z.audit(ctx, entry)
+		return nil, fmt.Errorf("%w: %s (agent %s)", ErrAgentApprovalForbidden, id, z.agent)
+	}
+	if err == nil && approval.Status == ApprovalStatusPending && approval.Entity != nil {
+		if err := z.applyApprovalEntity(ctx, approval.Entity); err != nil {
+			return nil, fmt.Errorf("承認内容の反映に失敗しました: %w", err)
+		}
+	}
+
+	result, err := z.approvalStore.Approve(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if approval != nil && approval.Entity != nil {
+		result.EntityID = approval.Entity.ID
+		for _, e := range append([]ApprovalEntity{*approval.Entity}, approval.Entity.Related...) {
+			z.audit(ctx, AuditEntry{Op: e.Operation(), Entity: e.Type, ID: e.ID, Result: AuditResultApproved, ApprovalID: id})
+		}
+	}
+	return result, nil
+}
+
+// applyApprovalEntity は承認待ちに記録したエンティティ（と Related）の内容をまとめて反映する
+// update, delete は対象ファイルがキュー追加時点から変更されていない場合のみ反映し、1 件でも反映できなければ何も書き込まない
+func (z *Zeus) applyApprovalEntity(ctx context.Context, entity *ApprovalEntity) error {
+	err := z.Transaction(ctx, func(tx *Zeus) error {
+		for _, e := range append([]ApprovalEntity{*entity}, entity.Related...) {
+			if err := tx.applyApprovalFile(ctx, &e); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return z.updateState(ctx)
+}
+
+// applyApprovalFile は承認待ちに記録したエンティティ 1 件のファイルを反映する
+func (z *Zeus) applyApprovalFile(ctx context.Context, entity *ApprovalEntity) error {
+	exists := z.fileStore.Exists(ctx, entity.Path)
+	switch entity.Operation() {
+	case ChangeOpCreate:
+		if exists {
+			return fmt.Errorf("%s %s already exists (%s)", entity.Type, entity.ID, entity.Path)
+		}
+		if err := z.fileStore.EnsureDir(ctx, filepath.Dir(entity.Path)); err != nil {
+			return err
+		}
+	default:
+		if !exists {
+			return fmt.Errorf("%s %s no longer exists (%s)", entity.Type, entity.ID, entity.Path)
+		}
+		if contentRevision(readStoreFile(z.fileStore, entity.Path)) != entity.BaseRevision {
+			return fmt.Errorf("%s %s was modified after the approval was requested (%s)", entity.Type, entity.ID, entity.Path)
+		}
+	}
+
+	if entity.Operation() == ChangeOpDelete {
+		return z.fileStore.Delete(ctx, entity.Path)
+	}
+	return z.fileStore.WriteFile(ctx, entity.Path, []byte(entity.Content))
 }
 
 // Reject はアイテムを却下
@@ -371,6 +568,13 @@ func (z *Zeus) CreateSnapshot(ctx context.Context, label string) (*Snapshot, err
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
+	// リスクは YAML の直接編集でも変わるため、スナップショットの前にリスクの配置を最新化する
+	if state, err := z.stateStore.GetCurrentState(ctx); err == nil {
+		state.RiskCells = z.riskCells(ctx)
+		if err := z.stateStore.SaveCurrentState(ctx, state); err != nil {
+			return nil, err
+		}
+	}
 	return z.stateStore.CreateSnapshot(ctx, label)
 }
 
@@ -407,9 +611,32 @@ func (z *Zeus) getDirectoryStructure() []string {
 	}
 }
 
+// zeusGitignoreFile は .zeus 直下の .gitignore
+const zeusGitignoreFile = ".gitignore"
+
+// zeusGitignore は .zeus/.gitignore の内容
+// 一覧用インデックス（ファイルの mtime/size を含む）・ログ・ロック・トランザクションの一時ファイルはマシンごとに異なるため除く
+const zeusGitignore = `# zeus が生成（マシンごとに異なる実行時ファイル）
+index/
+logs/
+*.lock
+*.tmp
+*.txn
+state/transaction-journal.yaml
+`
+
+// ensureGitignore は .zeus/.gitignore が無い場合に作成する（既存のファイルは利用者の編集を保つため変更しない）
+// init と旧形式からの移行（インデックスを書き込む）で使う
+func ensureGitignore(ctx context.Context, fs FileStore) error {
+	if fs.Exists(ctx, zeusGitignoreFile) {
+		return nil
+	}
+	return fs.WriteFile(ctx, zeusGitignoreFile, []byte(zeusGitignore))
+}
+
 func (z *Zeus) generateInitialConfig() *ZeusConfig {
 	return &ZeusConfig{
-		Version: "1.0",
+		Version: SchemaVersion,
 		Project: ProjectInfo{
 			ID:          fmt.Sprintf("zeus-%d", time.Now().Unix()),
 			Name:        "New Zeus Project",
@@ -454,6 +681,7 @@ func (z *Zeus) updateState(ctx context.Context) error {
 	}
 
 	state := z.stateStore.CalculateState(tasks)
+	state.RiskCells = z.riskCells(ctx)
 	return z.stateStore.SaveCurrentState(ctx, state)
 }
 
@@ -524,6 +752,7 @@ func (z *Zeus) GenerateSuggestions(ctx context.Context, status *StatusResult, li
 
 // ApplySuggestion は提案を適用
 // 部分的な成功をサポート: 一部の提案が失敗しても、成功した分は適用される
+// 成功した提案の変更と提案ストアのステータス更新は 1 トランザクションで反映する
 func (z *Zeus) ApplySuggestion(ctx context.Context, suggestionID string, applyAll bool, dryRun bool) (*ApplyResult, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
@@ -585,6 +814,17 @@ func (z *Zeus) ApplySuggestion(ctx context.Context, suggestionID string, applyAl
 	}
 
 	// 適用実行: 部分的な成功をサポート
+	err := z.Transaction(ctx, func(tx *Zeus) error {
+		return tx.applySuggestions(ctx, &store, toApply, result)
+	})
+	if err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// applySuggestions は toApply の提案を適用し、成功した場合は提案ストアを保存（トランザクション内で実行）
+func (z *Zeus) applySuggestions(ctx context.Context, store *SuggestionStore, toApply []int, result *ApplyResult) error {
 	for _, idx := range toApply {
 		suggestion := &store.Suggestions[idx]
 		if suggestion.Status != SuggestionPending {
@@ -625,12 +865,11 @@ func (z *Zeus) ApplySuggestion(ctx context.Context, suggestionID string, applyAl
 
 	// 成功した提案がある場合、ストアを保存
 	if result.Applied > 0 {
-		if err := z.fileStore.WriteYaml(ctx, "suggestions/active.yaml", &store); err != nil {
-			return result, fmt.Errorf("提案は適用されましたが、ストア保存に失敗しました: %w", err)
+		if err := z.fileStore.WriteYaml(ctx, "suggestions/active.yaml", store); err != nil {
+			return fmt.Errorf("提案ストアの保存に失敗しました: %w", err)
 		}
 	}
-
-	return result, nil
+	return nil
 }
 
 // saveSuggestions は提案を保存
@@ -924,6 +1163,12 @@ func toReportProjectState(state *ProjectState) *report.ProjectState {
 
 // BuildDependencyGraph は依存関係グラフを構築
 func (z *Zeus) BuildDependencyGraph(ctx context.Context) (*analysis.DependencyGraph, error) {
+	return z.BuildFilteredDependencyGraph(ctx, analysis.DependencyGraphFilter{})
+}
+
+// BuildFilteredDependencyGraph は絞り込み条件に合う Activity のみで依存関係グラフを構築
+// filter.RootID の Activity が存在しない場合は ErrEntityNotFound
+func (z *Zeus) BuildFilteredDependencyGraph(ctx context.Context, filter analysis.DependencyGraphFilter) (*analysis.DependencyGraph, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
@@ -951,6 +1196,10 @@ func (z *Zeus) BuildDependencyGraph(ctx context.Context) (*analysis.DependencyGr
 		}, nil
 	}
 
+	if filter.RootID != "" && !slices.ContainsFunc(activities, func(a ActivityEntity) bool { return a.ID == filter.RootID }) {
+		return nil, fmt.Errorf("%w: %s", ErrEntityNotFound, filter.RootID)
+	}
+
 	if len(activities) == 0 {
 		return &analysis.DependencyGraph{
 			Nodes:    make(map[string]*analysis.GraphNode),
@@ -966,11 +1215,17 @@ func (z *Zeus) BuildDependencyGraph(ctx context.Context) (*analysis.DependencyGr
 
 	// グラフを構築
 	builder := analysis.NewGraphBuilder(taskInfos)
-	return builder.Build(ctx)
+	return builder.BuildFiltered(ctx, filter)
 }
 
-// GenerateReport はレポートを生成
+// GenerateReport はレポートを生成（セクションは zeus.yaml の reports.sections に従う）
 func (z *Zeus) GenerateReport(ctx context.Context, format string) (string, error) {
+	return z.GenerateReportWithSections(ctx, format, nil)
+}
+
+// GenerateReportWithSections は指定したセクションでレポートを生成
+// sections が nil の場合は zeus.yaml の reports.sections（未設定なら全セクション）を使う
+func (z *Zeus) GenerateReportWithSections(ctx context.Context, format string, sections []string) (string, error) {
 	if err := ctx.Err(); err != nil {
 		return "", err
 	}
@@ -980,6 +1235,39 @@ func (z *Zeus) GenerateReport(ctx context.Context, format string) (string, error
 	if err := z.fileStore.ReadYaml(ctx, "zeus.yaml", &config); err != nil {
 		return "", ErrConfigNotFound
 	}
+	if sections == nil {
+		sections, err := enabledReportSections(config.Reports.Sections)
+		if err != nil {
+			return "", err
+		}
+		return z.generateReport(ctx, &config, format, sections)
+	}
+	if err := report.ValidateSections(sections); err != nil {
+		return "", err
+	}
+	return z.generateReport(ctx, &config, format, sections)
+}
+
+// enabledReportSections は reports.sections の設定から有効なセクションを返す
+func enabledReportSections(toggles map[string]bool) ([]string, error) {
+	keys := make([]string, 0, len(toggles))
+	for key := range toggles {
+		keys = append(keys, key)
+	}
+	if err := report.ValidateSections(keys); err != nil {
+		return nil, fmt.Errorf("reports.sections: %w", err)
+	}
+	sections := []string{}
+	for _, key := range report.AllSections {
+		if enabled, ok := toggles[key]; !ok || enabled {
+			sections = append(sections, key)
+		}
+	}
+	return sections, nil
+}
+
+// generateReport は設定とセクションを指定してレポートを生成
+func (z *Zeus) generateReport(ctx context.Context, config *ZeusConfig, format string, sections []string) (string, error) {
 
 	// 状態を取得
 	state, err := z.stateStore.GetCurrentState(ctx)
@@ -999,11 +1287,12 @@ func (z *Zeus) GenerateReport(ctx context.Context, format string) (string, error
 	}
 
 	// 型変換
-	reportConfig := toReportConfig(&config)
+	reportConfig := toReportConfig(config)
 	reportState := toReportProjectState(state)
 
 	// レポートを生成
 	gen := report.NewGenerator(reportConfig, reportState, analysisResult)
+	gen.SetSections(z.buildReportSections(ctx, sections, time.Now()))
 
 	switch format {
 	case "text", "":
@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -379,6 +380,75 @@ func TestApprove(t *testing.T) {
 	}
 }
 
+// Approve は承認待ちに記録したエンティティの内容をそのまま書き込む
+func TestApprove_AppliesEntity(t *testing.T) {
+	z := New(t.TempDir())
+	ctx := context.Background()
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	config, _ := z.LoadConfig(ctx)
+	config.Settings.AutomationLevel = "approve"
+	config.Settings.ApprovalMode = "strict"
+	if err := z.fileStore.WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("WriteYaml failed: %v", err)
+	}
+
+	added, err := z.Add(ctx, "objective", "Launch", WithObjectiveOwner("alice"))
+	if err != nil || !added.NeedsApproval {
+		t.Fatalf("Add should be queued: result=%+v err=%v", added, err)
+	}
+	approval, err := z.approvalStore.Get(ctx, added.ApprovalID)
+	if err != nil {
+		t.Fatalf("Get approval failed: %v", err)
+	}
+	entity := approval.Entity
+	if entity == nil || entity.Type != "objective" || approval.EntityID != entity.ID {
+		t.Fatalf("approval should carry the entity: %+v", approval)
+	}
+	if !strings.Contains(entity.Content, "owner: alice") || entity.Path != filepath.Join("objectives", entity.ID+".yaml") {
+		t.Errorf("unexpected entity content: %+v", entity)
+	}
+	if z.fileStore.Exists(ctx, entity.Path) {
+		t.Fatal("entity should not be written before approval")
+	}
+
+	result, err := z.Approve(ctx, added.ApprovalID)
+	if err != nil {
+		t.Fatalf("Approve failed: %v", err)
+	}
+	if result.EntityID != entity.ID {
+		t.Errorf("EntityID = %q, want %q", result.EntityID, entity.ID)
+	}
+	data, err := os.ReadFile(filepath.Join(z.ZeusPath, entity.Path))
+	if err != nil {
+		t.Fatalf("entity should be written on approve: %v", err)
+	}
+	if string(data) != entity.Content {
+		t.Errorf("entity should be written verbatim:\n%s\nwant\n%s", data, entity.Content)
+	}
+	obj, err := z.Get(ctx, "objective", entity.ID)
+	if err != nil || obj.(*ObjectiveEntity).Title != "Launch" {
+		t.Errorf("approved objective should be readable: %+v err=%v", obj, err)
+	}
+
+	// 既に同じファイルがある場合は承認しない
+	added, err = z.Add(ctx, "objective", "Grow")
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	approval, _ = z.approvalStore.Get(ctx, added.ApprovalID)
+	if err := z.fileStore.WriteFile(ctx, approval.Entity.Path, []byte("id: taken\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := z.Approve(ctx, added.ApprovalID); err == nil {
+		t.Error("expected error when the entity file already exists")
+	}
+	if pending, _ := z.Pending(ctx); len(pending) != 1 {
+		t.Errorf("failed approval should stay pending: %+v", pending)
+	}
+}
+
 // Approve コンテキストキャンセルテスト
 func TestApproveContextTimeout(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "zeus-test")
@@ -923,6 +993,41 @@ func TestInit(t *testing.T) {
 	// Level フィールドは削除されたので確認しない
 }
 
+func TestInit_WritesGitignore(t *testing.T) {
+	tmpDir := t.TempDir()
+	z := New(tmpDir)
+	ctx := context.Background()
+
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	path := filepath.Join(z.ZeusPath, ".gitignore")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf(".gitignore should be written: %v", err)
+	}
+	lines := map[string]bool{}
+	for _, line := range strings.Split(string(data), "\n") {
+		lines[line] = true
+	}
+	for _, want := range []string{"index/", "logs/", "*.lock", "state/transaction-journal.yaml"} {
+		if !lines[want] {
+			t.Errorf(".gitignore should contain %q:\n%s", want, data)
+		}
+	}
+
+	// 利用者が編集した .gitignore は再初期化で上書きしない
+	if err := os.WriteFile(path, []byte("index/\ncustom/\n"), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	if _, err := z.Init(ctx); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "index/\ncustom/\n" {
+		t.Errorf("edited .gitignore should be kept, got %q", data)
+	}
+}
+
 // ===== DI オプション関数テスト =====
 
 // WithFileStore テスト
@@ -0,0 +1,154 @@
+package dashboard
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+const (
+	// responseCacheTTL はレスポンスキャッシュの有効期間
+	// キーにストアの更新状態を含むため、短時間のポーリング重複を吸収できれば十分
+	responseCacheTTL = 5 * time.Second
+
+	// responseCacheMaxEntries はキャッシュエントリの上限（超過時は全破棄）
+	responseCacheMaxEntries = 64
+)
+
+// cacheEntry はキャッシュされたレスポンス
+type cacheEntry struct {
+	body        []byte
+	contentType string
+	expiresAt   time.Time
+}
+
+// responseCache は重いエンドポイント向けの短命レスポンスキャッシュ
+type responseCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cacheEntry
+}
+
+// newResponseCache は responseCache を作成
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// get は有効なエントリを返す
+func (c *responseCache) get(key string) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return cacheEntry{}, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return cacheEntry{}, false
+	}
+	return entry, true
+}
+
+// set はエントリを保存
+func (c *responseCache) set(key string, body []byte, contentType string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= responseCacheMaxEntries {
+		c.entries = make(map[string]cacheEntry)
+	}
+	c.entries[key] = cacheEntry{
+		body:        body,
+		contentType: contentType,
+		expiresAt:   time.Now().Add(c.ttl),
+	}
+}
+
+// makeETag はストアの更新状態とリクエスト URI から ETag を生成
+func makeETag(version, requestURI string) string {
+	sum := sha256.Sum256([]byte(version + "|" + requestURI))
+	return `"` + hex.EncodeToString(sum[:8]) + `"`
+}
+
+// etagMatches は If-None-Match ヘッダーが ETag に一致するか判定
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferedResponseWriter はレスポンスをキャッシュするためにバッファリングする
+type bufferedResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponseWriter) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponseWriter) Write(p []byte) (int, error) {
+	return b.body.Write(p)
+}
+
+func (b *bufferedResponseWriter) WriteHeader(status int) {
+	b.status = status
+}
+
+// cacheMiddleware は ETag/If-None-Match と短命キャッシュを付与するミドルウェア
+// ストアが変更されていなければ 304 またはキャッシュ済みレスポンスを返し、再計算しない
+func (s *Server) cacheMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			next(w, r)
+			return
+		}
+
+		version := core.StoreVersion(s.zeus.FileStore().BasePath())
+		etag := makeETag(version, r.URL.RequestURI())
+		w.Header().Set("Cache-Control", "no-cache")
+
+		if etagMatches(r.Header.Get("If-None-Match"), etag) {
+			w.Header().Set("ETag", etag)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		if entry, ok := s.cache.get(etag); ok {
+			w.Header().Set("ETag", etag)
+			w.Header().Set("Content-Type", entry.contentType)
+			w.WriteHeader(http.StatusOK)
+			w.Write(entry.body)
+			return
+		}
+
+		rec := &bufferedResponseWriter{header: w.Header(), status: http.StatusOK}
+		next(rec, r.WithContext(withStoreVersion(r.Context(), version)))
+
+		// 成功レスポンスのみキャッシュ対象
+		if rec.status == http.StatusOK {
+			w.Header().Set("ETag", etag)
+			s.cache.set(etag, rec.body.Bytes(), w.Header().Get("Content-Type"))
+		}
+		w.WriteHeader(rec.status)
+		w.Write(rec.body.Bytes())
+	}
+}
@@ -0,0 +1,113 @@
+package dashboard
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCacheMiddleware_ETag(t *testing.T) {
+	zeus := setupTestZeus(t)
+	server := NewServer(zeus, 0)
+
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/api/graph")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	resp.Body.Close()
+
+	etag := resp.Header.Get("ETag")
+	if etag == "" {
+		t.Fatal("ETag ヘッダーが設定されていません")
+	}
+
+	// 同じ ETag で再リクエストすると 304
+	req, _ := http.NewRequest(http.MethodGet, ts.URL+"/api/graph", nil)
+	req.Header.Set("If-None-Match", etag)
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("ステータスコードが正しくありません: got %d, want %d", resp.StatusCode, http.StatusNotModified)
+	}
+
+	// ストアが変更されると ETag が変わる
+	time.Sleep(10 * time.Millisecond)
+	if _, err := zeus.Add(context.Background(), "activity", "New Activity"); err != nil {
+		t.Fatalf("Activity の追加に失敗: %v", err)
+	}
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("変更後は 200 を返すべき: got %d", resp.StatusCode)
+	}
+	if resp.Header.Get("ETag") == etag {
+		t.Error("ストア変更後も ETag が変わっていません")
+	}
+}
+
+func TestCacheMiddleware_QueryKeyed(t *testing.T) {
+	zeus := setupTestZeus(t)
+	server := NewServer(zeus, 0)
+
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	a, err := http.Get(ts.URL + "/api/affinity?min_score=0.1")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	a.Body.Close()
+	b, err := http.Get(ts.URL + "/api/affinity?min_score=0.5")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	b.Body.Close()
+
+	if a.Header.Get("ETag") == b.Header.Get("ETag") {
+		t.Error("クエリが異なる場合は ETag も異なるべきです")
+	}
+}
+
+func TestEtagMatches(t *testing.T) {
+	tests := []struct {
+		header string
+		etag   string
+		want   bool
+	}{
+		{"", `"abc"`, false},
+		{`"abc"`, `"abc"`, true},
+		{`W/"abc"`, `"abc"`, true},
+		{`"xyz", "abc"`, `"abc"`, true},
+		{"*", `"abc"`, true},
+		{`"xyz"`, `"abc"`, false},
+	}
+	for _, tt := range tests {
+		if got := etagMatches(tt.header, tt.etag); got != tt.want {
+			t.Errorf("etagMatches(%q, %q) = %v, want %v", tt.header, tt.etag, got, tt.want)
+		}
+	}
+}
+
+func TestResponseCache_Expiry(t *testing.T) {
+	cache := newResponseCache(10 * time.Millisecond)
+	cache.set("key", []byte("body"), "application/json")
+
+	if _, ok := cache.get("key"); !ok {
+		t.Fatal("キャッシュが取得できません")
+	}
+	time.Sleep(20 * time.Millisecond)
+	if _, ok := cache.get("key"); ok {
+		t.Error("期限切れのキャッシュが返されました")
+	}
+}
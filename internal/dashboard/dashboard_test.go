@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -422,6 +423,92 @@ func TestHandleAPIUseCaseDiagram(t *testing.T) {
 	}
 }
 
+// TestHandleAPIUseCaseDiagramSubsystem は /api/uml/usecase の ?subsystem= と ?group=subsystem をテストします
+func TestHandleAPIUseCaseDiagramSubsystem(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+
+	obj, err := zeus.Add(ctx, "objective", "テスト目標")
+	if err != nil {
+		t.Fatalf("Objective 追加に失敗: %v", err)
+	}
+	sub, err := zeus.Add(ctx, "subsystem", "認証基盤")
+	if err != nil {
+		t.Fatalf("Subsystem 追加に失敗: %v", err)
+	}
+	user, err := zeus.Add(ctx, "actor", "利用者", core.WithActorType(core.ActorTypeHuman))
+	if err != nil {
+		t.Fatalf("Actor 追加に失敗: %v", err)
+	}
+	if _, err := zeus.Add(ctx, "actor", "管理者", core.WithActorType(core.ActorTypeHuman)); err != nil {
+		t.Fatalf("Actor 追加に失敗: %v", err)
+	}
+	if _, err := zeus.Add(ctx, "usecase", "ログイン",
+		core.WithUseCaseObjective(obj.ID),
+		core.WithUseCaseSubsystem(sub.ID),
+		core.WithUseCaseActor(user.ID, core.ActorRolePrimary),
+	); err != nil {
+		t.Fatalf("UseCase 追加に失敗: %v", err)
+	}
+	if _, err := zeus.Add(ctx, "usecase", "レポート出力", core.WithUseCaseObjective(obj.ID)); err != nil {
+		t.Fatalf("UseCase 追加に失敗: %v", err)
+	}
+
+	server := NewServer(zeus, 0)
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	get := func(query string) (*http.Response, UseCaseDiagramResponse) {
+		t.Helper()
+		resp, err := http.Get(ts.URL + "/api/uml/usecase" + query)
+		if err != nil {
+			t.Fatalf("リクエストに失敗: %v", err)
+		}
+		defer resp.Body.Close()
+		var result UseCaseDiagramResponse
+		if resp.StatusCode == http.StatusOK {
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatalf("JSON デコードに失敗: %v", err)
+			}
+		}
+		return resp, result
+	}
+
+	// サブシステムで絞り込み: そのサブシステムの UseCase と関連する Actor だけ
+	resp, result := get("?subsystem=" + sub.ID)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if len(result.UseCases) != 1 || result.UseCases[0].Title != "ログイン" {
+		t.Errorf("UseCases = %+v", result.UseCases)
+	}
+	if len(result.Actors) != 1 || result.Actors[0].ID != user.ID {
+		t.Errorf("Actors = %+v", result.Actors)
+	}
+	if result.Boundary != "認証基盤" {
+		t.Errorf("Boundary = %q, want 認証基盤", result.Boundary)
+	}
+
+	// サブシステムごとのサブグラフ
+	resp, result = get("?group=subsystem")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if len(result.UseCases) != 2 || len(result.Actors) != 2 {
+		t.Errorf("UseCases = %d, Actors = %d, want 2, 2", len(result.UseCases), len(result.Actors))
+	}
+	if !strings.Contains(result.Mermaid, "[認証基盤]") {
+		t.Errorf("Mermaid にサブシステムのサブグラフがありません:\n%s", result.Mermaid)
+	}
+
+	if resp, _ := get("?subsystem=sub-00000000"); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("未知のサブシステム: got %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+	if resp, _ := get("?group=objective"); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("未対応の group: got %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+}
+
 // TestHandleAPIActorsEmpty は Actor がない場合の /api/actors をテストします
 func TestHandleAPIActorsEmpty(t *testing.T) {
 	zeus := setupTestZeus(t)
@@ -691,6 +778,133 @@ func TestHandleAPIActivityDiagram_MissingID(t *testing.T) {
 	}
 }
 
+// TestHandleAPIActivityDiagramSource は /api/activities/{id}/diagram をテストします
+func TestHandleAPIActivityDiagramSource(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+
+	result, err := zeus.Add(ctx, "activity", "並列フロー",
+		core.WithActivityNodes([]core.ActivityNode{
+			{ID: "n1", Type: core.ActivityNodeTypeInitial},
+			{ID: "n2", Type: core.ActivityNodeTypeFork},
+			{ID: "n3", Type: core.ActivityNodeTypeAction, Name: "処理A"},
+			{ID: "n4", Type: core.ActivityNodeTypeAction, Name: "処理B"},
+			{ID: "n5", Type: core.ActivityNodeTypeJoin},
+			{ID: "n6", Type: core.ActivityNodeTypeFinal},
+		}),
+		core.WithActivityTransitions([]core.ActivityTransition{
+			{ID: "t1", Source: "n1", Target: "n2"},
+			{ID: "t2", Source: "n2", Target: "n3"},
+			{ID: "t3", Source: "n2", Target: "n4"},
+			{ID: "t4", Source: "n3", Target: "n5"},
+			{ID: "t5", Source: "n4", Target: "n5"},
+			{ID: "t6", Source: "n5", Target: "n6"},
+		}),
+	)
+	if err != nil {
+		t.Fatalf("Activity 追加に失敗: %v", err)
+	}
+
+	server := NewServer(zeus, 0)
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"", "flowchart TD"},
+		{"plantuml", "===n2==="},
+	}
+	for _, tt := range tests {
+		resp, err := http.Get(ts.URL + "/api/activities/" + result.ID + "/diagram?format=" + tt.format)
+		if err != nil {
+			t.Fatalf("リクエストに失敗: %v", err)
+		}
+		var body ActivityDiagramSourceResponse
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			t.Fatalf("JSON デコードに失敗: %v", err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("format=%q: ステータスコードが正しくありません: got %d", tt.format, resp.StatusCode)
+		}
+		if !containsString(body.Diagram, tt.want) {
+			t.Errorf("format=%q: 図に %q が含まれていません:\n%s", tt.format, tt.want, body.Diagram)
+		}
+	}
+
+	// 存在しない Activity / 不正な形式
+	resp, err := http.Get(ts.URL + "/api/activities/act-00000000/diagram")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("存在しない Activity: got %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+
+	resp, err = http.Get(ts.URL + "/api/activities/" + result.ID + "/diagram?format=svg")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("不正な形式: got %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestHandleAPIDiagramEngine(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+
+	if _, err := zeus.Add(ctx, "actor", "利用者"); err != nil {
+		t.Fatalf("Actor 追加に失敗: %v", err)
+	}
+
+	server := NewServer(zeus, 0)
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	// engine=plantuml 指定時は diagram に PlantUML ソースを返す（mermaid は互換のため維持）
+	for _, path := range []string{"/api/uml/usecase", "/api/graph", "/api/unified-graph"} {
+		resp, err := http.Get(ts.URL + path + "?engine=plantuml")
+		if err != nil {
+			t.Fatalf("リクエストに失敗: %v", err)
+		}
+		var body struct {
+			Mermaid string `json:"mermaid"`
+			Engine  string `json:"engine"`
+			Diagram string `json:"diagram"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			t.Fatalf("%s: レスポンスのデコードに失敗: %v", path, err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("%s: ステータスコードが正しくありません: got %d", path, resp.StatusCode)
+		}
+		if body.Engine != "plantuml" || !containsString(body.Diagram, "@startuml") {
+			t.Errorf("%s: PlantUML が返されていません: engine=%q diagram=%q", path, body.Engine, body.Diagram)
+		}
+		if body.Mermaid == "" {
+			t.Errorf("%s: mermaid フィールドが空です", path)
+		}
+	}
+
+	// 未対応エンジンは 400
+	resp, err := http.Get(ts.URL + "/api/uml/usecase?engine=svg")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("未対応エンジンのステータスコードが正しくありません: got %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+}
+
 // containsString は文字列に部分文字列が含まれるかチェックするヘルパー
 func containsString(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > 0 && containsStringHelper(s, substr))
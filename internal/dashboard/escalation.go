@@ -0,0 +1,35 @@
+package dashboard
+
+import (
+	"context"
+	"time"
+)
+
+// escalationInterval は期限を過ぎた Consideration のエスカレーションを評価する間隔
+const escalationInterval = time.Hour
+
+// runEscalations は起動時と escalationInterval ごとにエスカレーションを評価する（ctx のキャンセルで停止）
+func (s *Server) runEscalations(ctx context.Context) {
+	ticker := time.NewTicker(escalationInterval)
+	defer ticker.Stop()
+	for {
+		s.escalateConsiderations(ctx, time.Now())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// escalateConsiderations は期限を過ぎた Consideration をエスカレーションし、新たなエスカレーションと作成した Problem を配信する
+func (s *Server) escalateConsiderations(ctx context.Context, now time.Time) {
+	// 失敗した場合（設定の誤りなど）は次回の評価で再試行する。エラーは zeus considerations escalate で確認できる
+	result, err := s.zeus.EscalateConsiderations(ctx, now)
+	if err != nil {
+		return
+	}
+	for _, e := range append(result.Escalated, result.Problems...) {
+		s.broadcaster.Broadcast(SSEEvent{Type: EventEscalation, Data: e})
+	}
+}
@@ -0,0 +1,41 @@
+package dashboard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+func TestEscalateConsiderations_Broadcast(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+	if _, err := zeus.Add(ctx, "consideration", "認証方式", core.WithConsiderationDueDate("2026-03-10")); err != nil {
+		t.Fatalf("Consideration の追加に失敗: %v", err)
+	}
+
+	server := NewServer(zeus, 0)
+	client := server.broadcaster.AddClient("test")
+	defer server.broadcaster.RemoveClient("test")
+
+	now := time.Date(2026, 3, 20, 10, 0, 0, 0, time.Local)
+	server.escalateConsiderations(ctx, now)
+	select {
+	case event := <-client.Events:
+		overdue, ok := event.Data.(core.OverdueConsideration)
+		if event.Type != EventEscalation || !ok || overdue.DaysOverdue != 10 {
+			t.Errorf("エスカレーションのイベントが正しくありません: %+v", event)
+		}
+	default:
+		t.Fatal("期限超過の Consideration のイベントが配信されるべき")
+	}
+
+	// 2 回目は配信しない
+	server.escalateConsiderations(ctx, now.Add(time.Hour))
+	select {
+	case event := <-client.Events:
+		t.Errorf("エスカレーション済みのイベントは配信しないはず: %+v", event)
+	default:
+	}
+}
@@ -61,6 +61,8 @@ type AffinityStatsResponse struct {
 	TotalEdges     int     `json:"total_edges"`
 	ClusterCount   int     `json:"cluster_count"`
 	AvgConnections float64 `json:"avg_connections"`
+	FilteredEdges  int     `json:"filtered_edges,omitempty"` // min_score・max_edges で除外したエッジ数
+	DroppedEdges   int     `json:"dropped_edges,omitempty"`  // うち max_edges を超えたために除外したエッジ数
 }
 
 // =============================================================================
@@ -72,6 +74,7 @@ type AffinityStatsResponse struct {
 //   - max_siblings: ハブモードに切り替える兄弟数の閾値（デフォルト: 20）
 //   - min_score: 最小スコア閾値（デフォルト: 0.0）
 //   - max_edges: 最大エッジ数（デフォルト: 0 = 無制限）
+//   - approximate: true で大きな兄弟グループをバケットに分けて近似計算
 func (s *Server) handleAPIAffinity(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
 		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
@@ -80,8 +83,12 @@ func (s *Server) handleAPIAffinity(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	// クエリパラメータを解析
-	options := analysis.DefaultAffinityOptions()
+	// zeus.yaml の analysis.affinity を基準に、クエリパラメータで上書き
+	options, err := s.zeus.AffinityOptions(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "アフィニティ設定エラー: "+err.Error())
+		return
+	}
 	if v := r.URL.Query().Get("max_siblings"); v != "" {
 		if n, err := strconv.Atoi(v); err == nil && n > 0 {
 			options.MaxSiblings = n
@@ -97,6 +104,11 @@ func (s *Server) handleAPIAffinity(w http.ResponseWriter, r *http.Request) {
 			options.MaxEdges = n
 		}
 	}
+	if v := r.URL.Query().Get("approximate"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			options.Approximate = b
+		}
+	}
 
 	// エンティティを並列に読み込み
 	visionInfo, objectives, tasks, quality, risks := s.loadAffinityDataParallel(ctx)
@@ -167,6 +179,8 @@ func (s *Server) handleAPIAffinity(w http.ResponseWriter, r *http.Request) {
 			TotalEdges:     result.Stats.TotalEdges,
 			ClusterCount:   result.Stats.ClusterCount,
 			AvgConnections: result.Stats.AvgConnections,
+			FilteredEdges:  result.Stats.FilteredEdges,
+			DroppedEdges:   result.Stats.DroppedEdges,
 		},
 	}
 
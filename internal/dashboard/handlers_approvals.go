@@ -0,0 +1,87 @@
+package dashboard
+
+import (
+	"net/http"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// ApprovalsResponse は承認待ち API のレスポンス
+type ApprovalsResponse struct {
+	Approvals []ApprovalResponse `json:"approvals"`
+	Total     int                `json:"total"`
+}
+
+// ApprovalResponse は承認待ちアイテム
+type ApprovalResponse struct {
+	ID            string                  `json:"id"`
+	Type          string                  `json:"type"`
+	Description   string                  `json:"description"`
+	Level         string                  `json:"level"`
+	Status        string                  `json:"status"`
+	EntityID      string                  `json:"entity_id,omitempty"`
+	RequestedBy   string                  `json:"requested_by,omitempty"`
+	Agent         string                  `json:"agent,omitempty"`
+	Approver      string                  `json:"approver,omitempty"`
+	DelegatedFrom string                  `json:"delegated_from,omitempty"`
+	ExpiresAt     string                  `json:"expires_at,omitempty"`
+	Entity        *ApprovalEntityResponse `json:"entity,omitempty"`
+	Changes       []core.FileChange       `json:"changes"`
+	CreatedAt     string                  `json:"created_at"`
+}
+
+// ApprovalEntityResponse は承認時に書き込まれるエンティティの内容
+type ApprovalEntityResponse struct {
+	Type    string `json:"type"`
+	ID      string `json:"id"`
+	Op      string `json:"op"` // create, update, delete
+	Path    string `json:"path"`
+	Content string `json:"content"` // delete では空
+}
+
+// handleAPIApprovals は承認待ちアイテム（承認時に書き込まれる内容と差分を含む）を返す
+func (s *Server) handleAPIApprovals(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+
+	pending, err := s.zeus.Pending(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "承認待ちの取得に失敗しました: "+err.Error())
+		return
+	}
+
+	response := ApprovalsResponse{Approvals: make([]ApprovalResponse, len(pending)), Total: len(pending)}
+	for i, a := range pending {
+		item := ApprovalResponse{
+			ID:            a.ID,
+			Type:          a.Type,
+			Description:   a.Description,
+			Level:         string(a.Level),
+			Status:        string(a.Status),
+			EntityID:      a.EntityID,
+			RequestedBy:   a.RequestedBy,
+			Agent:         a.Agent,
+			Approver:      a.Approver,
+			DelegatedFrom: a.DelegatedFrom,
+			ExpiresAt:     a.ExpiresAt,
+			Changes:       a.Changes,
+			CreatedAt:     a.CreatedAt,
+		}
+		if item.Changes == nil {
+			item.Changes = []core.FileChange{}
+		}
+		if a.Entity != nil {
+			item.Entity = &ApprovalEntityResponse{
+				Type:    a.Entity.Type,
+				ID:      a.Entity.ID,
+				Op:      a.Entity.Operation(),
+				Path:    a.Entity.Path,
+				Content: a.Entity.Content,
+			}
+		}
+		response.Approvals[i] = item
+	}
+	writeJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,75 @@
+package dashboard
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// maxChangesLimit は /api/changes の limit の上限
+const maxChangesLimit = 1000
+
+// ChangesResponse は変更フィード API のレスポンス
+type ChangesResponse struct {
+	Changes    []ChangeRecordResponse `json:"changes"`
+	NextCursor string                 `json:"next_cursor"`
+	HasMore    bool                   `json:"has_more"`
+}
+
+// ChangeRecordResponse はエンティティ単位の変更
+type ChangeRecordResponse struct {
+	Cursor    string `json:"cursor"`
+	Type      string `json:"type"`
+	ID        string `json:"id"`
+	Op        string `json:"op"`
+	Timestamp string `json:"timestamp"`
+}
+
+// handleAPIChanges は変更フィード API を処理
+// クエリパラメータ:
+//   - since: 前回のレスポンスの next_cursor（省略時は先頭から）
+//   - limit: 1 ページあたりの変更数（デフォルト: 100、最大: 1000）
+func (s *Server) handleAPIChanges(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+
+	since, err := core.ParseChangeCursor(r.URL.Query().Get("since"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	limit := core.DefaultChangeFeedLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			writeError(w, http.StatusBadRequest, "limit は正の整数で指定してください")
+			return
+		}
+		limit = min(n, maxChangesLimit)
+	}
+
+	page, err := s.zeus.Changes(r.Context(), since, limit)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "変更フィードの取得に失敗しました: "+err.Error())
+		return
+	}
+
+	response := ChangesResponse{
+		Changes:    make([]ChangeRecordResponse, len(page.Changes)),
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
+	}
+	for i, c := range page.Changes {
+		response.Changes[i] = ChangeRecordResponse{
+			Cursor:    c.Cursor,
+			Type:      c.Type,
+			ID:        c.ID,
+			Op:        c.Op,
+			Timestamp: c.Timestamp,
+		}
+	}
+	writeJSON(w, http.StatusOK, response)
+}
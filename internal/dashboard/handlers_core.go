@@ -1,7 +1,13 @@
 package dashboard
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+	"github.com/biwakonbu/zeus/internal/core"
 )
 
 // =============================================================================
@@ -38,10 +44,29 @@ type SummaryStats struct {
 
 // GraphResponse はグラフ API のレスポンス
 type GraphResponse struct {
-	Mermaid  string     `json:"mermaid"`
-	Stats    GraphStats `json:"stats"`
-	Cycles   [][]string `json:"cycles"`
-	Isolated []string   `json:"isolated"`
+	Mermaid string `json:"mermaid"`
+	// MermaidSummarized はノード数が max_nodes を超えたため Mermaid を要約表示にした場合に true
+	MermaidSummarized bool       `json:"mermaid_summarized,omitempty"`
+	Engine            string     `json:"engine,omitempty"`  // ?engine= 指定時のみ
+	Diagram           string     `json:"diagram,omitempty"` // ?engine= 指定時のみ
+	Stats             GraphStats `json:"stats"`
+	Cycles            [][]string `json:"cycles"`
+	Isolated          []string   `json:"isolated"`
+}
+
+// ConceptGraphResponse は概念グラフ API のレスポンス
+type ConceptGraphResponse struct {
+	Nodes   []analysis.RelationNode `json:"nodes"`
+	Edges   []analysis.RelationEdge `json:"edges"`
+	Mermaid string                  `json:"mermaid"`
+	Stats   ConceptGraphStats       `json:"stats"`
+}
+
+// ConceptGraphStats は概念グラフの統計
+type ConceptGraphStats struct {
+	TotalNodes  int            `json:"total_nodes"`
+	TotalEdges  int            `json:"total_edges"`
+	NodesByType map[string]int `json:"nodes_by_type"`
 }
 
 // GraphStats はグラフ統計
@@ -99,15 +124,29 @@ func (s *Server) handleAPIGraph(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	renderer, ok := diagramRenderer(r)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "engine は mermaid または plantuml を指定してください")
+		return
+	}
+
+	maxNodes, ok := s.mermaidMaxNodes(r)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "max_nodes は 0 以上の整数を指定してください")
+		return
+	}
+
 	ctx := r.Context()
-	graph, err := s.zeus.BuildDependencyGraph(ctx)
+	graph, status, err := s.buildFilteredDependencyGraph(r)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, err.Error())
+		writeError(w, status, err.Error())
 		return
 	}
 
+	mermaid, summarized := s.dependencyMermaid(ctx, graph, maxNodes, r.URL.RawQuery)
 	response := GraphResponse{
-		Mermaid: graph.ToMermaid(),
+		Mermaid:           mermaid,
+		MermaidSummarized: summarized,
 		Stats: GraphStats{
 			TotalNodes:       graph.Stats.TotalNodes,
 			WithDependencies: graph.Stats.WithDependencies,
@@ -126,9 +165,176 @@ func (s *Server) handleAPIGraph(w http.ResponseWriter, r *http.Request) {
 		response.Isolated = []string{}
 	}
 
+	if renderer != nil {
+		response.Engine = string(renderer.Format())
+		response.Diagram = renderer.DependencyGraph(graph)
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleAPIGraphPath は 2 つのエンティティを結ぶ参照関係の最短経路を返す（?from=X&to=Y）
+func (s *Server) handleAPIGraphPath(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+
+	from, to := r.URL.Query().Get("from"), r.URL.Query().Get("to")
+	if from == "" || to == "" {
+		writeError(w, http.StatusBadRequest, "from と to を指定してください")
+		return
+	}
+
+	path, err := s.zeus.FindRelationPath(r.Context(), from, to)
+	if err != nil {
+		if errors.Is(err, analysis.ErrRelationNodeNotFound) {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, path)
+}
+
+// handleAPICoverage は UseCase と Activity の紐づけのカバレッジ分析を返す
+func (s *Server) handleAPICoverage(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+
+	result, err := s.zeus.AnalyzeCoverage(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "カバレッジ分析エラー: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// handleAPIRiskHeatmap はリスクの発生確率 × 影響度のマトリクスと、直近のスナップショットからの増減を返す
+func (s *Server) handleAPIRiskHeatmap(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+
+	heatmap, err := s.zeus.RiskHeatmap(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "リスクヒートマップの取得エラー: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, heatmap)
+}
+
+// handleAPIRiskExposure はコスト・遅延の見積もりを持つリスクの露出（期待値と P90）をモンテカルロ法で求めて返す
+// ?iterations=N で試行回数を指定（上限 analysis.MaxRiskExposureIterations）
+func (s *Server) handleAPIRiskExposure(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+
+	iterations := analysis.DefaultRiskExposureIterations
+	if v := r.URL.Query().Get("iterations"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			writeError(w, http.StatusBadRequest, "iterations は正の整数で指定してください")
+			return
+		}
+		iterations = min(n, analysis.MaxRiskExposureIterations)
+	}
+
+	exposure, err := s.zeus.AnalyzeRiskExposure(r.Context(), iterations)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "リスク露出の分析エラー: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, exposure)
+}
+
+// handleAPIConceptGraph は Vision・Objective・Consideration・Decision・Risk・Problem・Assumption の関係グラフを返す
+// ?types=objective,risk でエンティティ種別、?status=open,identified で状態を絞り込む
+func (s *Server) handleAPIConceptGraph(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+
+	filter := core.ConceptGraphFilter{
+		Types:    splitQueryList(r.URL.Query().Get("types")),
+		Statuses: splitQueryList(r.URL.Query().Get("status")),
+	}
+	graph, err := s.zeus.BuildConceptGraph(r.Context(), filter)
+	if err != nil {
+		if errors.Is(err, core.ErrUnknownEntity) {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := ConceptGraphResponse{
+		Nodes:   graph.SortedNodes(),
+		Edges:   graph.ResolvedEdges(),
+		Mermaid: graph.ToMermaid(),
+		Stats:   ConceptGraphStats{NodesByType: map[string]int{}},
+	}
+	for _, node := range response.Nodes {
+		response.Stats.NodesByType[node.Type]++
+	}
+	response.Stats.TotalNodes = len(response.Nodes)
+	response.Stats.TotalEdges = len(response.Edges)
+
 	writeJSON(w, http.StatusOK, response)
 }
 
+// buildFilteredDependencyGraph はクエリ（root, status, depth, include_completed）で絞り込んだ依存グラフを構築
+// エラー時は返すべきステータスコードを合わせて返す
+func (s *Server) buildFilteredDependencyGraph(r *http.Request) (*analysis.DependencyGraph, int, error) {
+	query := r.URL.Query()
+	filter := analysis.DependencyGraphFilter{
+		RootID:   query.Get("root"),
+		Statuses: splitQueryList(query.Get("status")),
+	}
+	if value := query.Get("depth"); value != "" {
+		depth, err := strconv.Atoi(value)
+		if err != nil || depth < 0 {
+			return nil, http.StatusBadRequest, errors.New("depth は 0 以上の整数を指定してください")
+		}
+		filter.Depth = depth
+	}
+	if value := query.Get("include_completed"); value != "" {
+		include, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, http.StatusBadRequest, errors.New("include_completed は true または false を指定してください")
+		}
+		filter.HideCompleted = !include
+	}
+
+	graph, err := s.zeus.BuildFilteredDependencyGraph(r.Context(), filter)
+	if err != nil {
+		if errors.Is(err, core.ErrEntityNotFound) {
+			return nil, http.StatusNotFound, errors.New("root の Activity が見つかりません: " + filter.RootID)
+		}
+		return nil, http.StatusInternalServerError, err
+	}
+	return graph, http.StatusOK, nil
+}
+
+// splitQueryList はカンマ区切りのクエリパラメータを小文字の値のリストにする（空要素は除く）
+func splitQueryList(value string) []string {
+	var list []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(strings.ToLower(v)); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
 // handleSSE は Server-Sent Events 接続を処理
 func (s *Server) handleSSE(w http.ResponseWriter, r *http.Request) {
 	// SSE に必要なヘッダーを設定
@@ -136,11 +342,6 @@ func (s *Server) handleSSE(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Cache-Control", "no-cache")
 	w.Header().Set("Connection", "keep-alive")
 
-	// CORS ヘッダー（開発モード時）
-	if s.devMode {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-	}
-
 	// Flusher を取得
 	flusher, ok := w.(http.Flusher)
 	if !ok {
@@ -165,6 +366,28 @@ func (s *Server) handleSSE(w http.ResponseWriter, r *http.Request) {
 	// クライアントの切断を検知
 	ctx := r.Context()
 
+	// 再接続時は Last-Event-ID 以降のイベントをログからリプレイ
+	// （登録後に読み込むため取りこぼしは無く、リプレイ済みのイベントはライブ配信で読み飛ばす）
+	var replayed uint64
+	if lastID, ok := lastEventID(r); ok {
+		if log := s.broadcaster.EventLog(); log != nil {
+			records, err := log.Since(ctx, lastID)
+			if err == nil {
+				for _, record := range records {
+					frame, err := FormatSSEFrame(eventFromRecord(record))
+					if err != nil {
+						continue
+					}
+					if _, err := w.Write(frame); err != nil {
+						return
+					}
+					replayed = record.Seq
+				}
+				flusher.Flush()
+			}
+		}
+	}
+
 	// イベントループ
 	for {
 		select {
@@ -176,19 +399,35 @@ func (s *Server) handleSSE(w http.ResponseWriter, r *http.Request) {
 				// チャネルがクローズ
 				return
 			}
-
-			// イベントデータを JSON にエンコード
-			data, err := FormatSSEMessage(event)
-			if err != nil {
+			if event.ID > 0 && event.ID <= replayed {
 				continue
 			}
 
 			// SSE 形式で送信
-			_, err = w.Write([]byte("event: " + string(event.Type) + "\ndata: " + string(data) + "\n\n"))
+			frame, err := FormatSSEFrame(event)
 			if err != nil {
+				continue
+			}
+			if _, err := w.Write(frame); err != nil {
 				return
 			}
 			flusher.Flush()
 		}
 	}
 }
+
+// lastEventID は再接続時の Last-Event-ID ヘッダー（または last_event_id クエリ）を返す
+func lastEventID(r *http.Request) (uint64, bool) {
+	value := r.Header.Get("Last-Event-ID")
+	if value == "" {
+		value = r.URL.Query().Get("last_event_id")
+	}
+	if value == "" {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
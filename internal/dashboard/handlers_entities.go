@@ -0,0 +1,186 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// EntityResponse は単一エンティティ API のレスポンス
+type EntityResponse struct {
+	Type       string `json:"type"`
+	ID         string `json:"id"`
+	Revision   string `json:"revision"`
+	Entity     any    `json:"entity,omitempty"`
+	ApprovalID string `json:"approval_id,omitempty"` // 承認待ちキューに追加された場合（202）
+}
+
+// EntityPatchRequest はエンティティ更新のリクエスト（revision は If-Match ヘッダーでも指定可）
+type EntityPatchRequest struct {
+	Revision string         `json:"revision,omitempty"`
+	Fields   map[string]any `json:"fields"`
+}
+
+// RevisionConflictResponse はリビジョン競合（409）のレスポンス
+type RevisionConflictResponse struct {
+	Error           string                  `json:"error"`
+	Message         string                  `json:"message"`
+	CurrentRevision string                  `json:"current_revision"`
+	Fields          []FieldConflictResponse `json:"fields"`
+}
+
+// FieldConflictResponse は競合したフィールドの現在の値と更新しようとした値
+type FieldConflictResponse struct {
+	Field    string `json:"field"`
+	Current  any    `json:"current"`
+	Proposed any    `json:"proposed"`
+}
+
+// handleAPIEntities はエンティティの一覧を返す（q パラメータは zeus list -q と同じクエリ式）
+func (s *Server) handleAPIEntities(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+
+	var query *core.Query
+	if source := r.URL.Query().Get("q"); source != "" {
+		q, err := core.ParseQuery(source)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		query = q
+	}
+	result, err := s.zeus.QueryEntities(r.Context(), r.PathValue("type"), query)
+	if err != nil {
+		writeEntityError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// handleAPIEntity は単一エンティティの取得（GET）と楽観的排他制御付きの更新（PATCH）を処理
+//   - GET: エンティティとリビジョンを返す（ETag ヘッダーにもリビジョンを設定）
+//   - PATCH: If-Match（またはボディの revision）が現在のリビジョンと一致する場合のみ fields を反映
+//     未指定は 428、不一致は 409（フィールドごとの差分付き）、承認が必要な場合は承認待ちキューに追加して 202
+func (s *Server) handleAPIEntity(w http.ResponseWriter, r *http.Request) {
+	entityType := r.PathValue("type")
+	id := r.PathValue("id")
+	ctx := r.Context()
+
+	switch r.Method {
+	case http.MethodGet:
+		handler, ok := s.zeus.GetRegistry().Get(entityType)
+		if !ok {
+			writeError(w, http.StatusNotFound, "不明なエンティティタイプです: "+entityType)
+			return
+		}
+		entity, err := handler.Get(ctx, id)
+		if err != nil {
+			writeEntityError(w, err)
+			return
+		}
+		revision, err := s.zeus.EntityRevision(ctx, entityType, id)
+		if err != nil {
+			writeEntityError(w, err)
+			return
+		}
+		w.Header().Set("ETag", quoteETag(revision))
+		writeJSON(w, http.StatusOK, EntityResponse{Type: entityType, ID: id, Revision: revision, Entity: entity})
+
+	case http.MethodPatch:
+		var req EntityPatchRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "リクエストボディが不正です: "+err.Error())
+			return
+		}
+		revision := strings.Trim(strings.TrimPrefix(r.Header.Get("If-Match"), "W/"), `"`)
+		if revision == "" {
+			revision = req.Revision
+		}
+		if revision == "" {
+			writeError(w, http.StatusPreconditionRequired, "If-Match ヘッダー（または revision）で更新元のリビジョンを指定してください")
+			return
+		}
+
+		newRevision, err := s.zeus.UpdateEntity(ctx, entityType, id, req.Fields, revision)
+		var conflict *core.RevisionConflictError
+		if errors.As(err, &conflict) {
+			response := RevisionConflictResponse{
+				Error:           http.StatusText(http.StatusConflict),
+				Message:         conflict.Error(),
+				CurrentRevision: conflict.Current,
+				Fields:          make([]FieldConflictResponse, len(conflict.Fields)),
+			}
+			for i, f := range conflict.Fields {
+				response.Fields[i] = FieldConflictResponse{Field: f.Field, Current: f.Current, Proposed: f.Proposed}
+			}
+			w.Header().Set("ETag", quoteETag(conflict.Current))
+			writeJSON(w, http.StatusConflict, response)
+			return
+		}
+		var required *core.ApprovalRequiredError
+		if errors.As(err, &required) {
+			writeJSON(w, http.StatusAccepted, EntityResponse{Type: entityType, ID: id, Revision: revision, ApprovalID: required.ApprovalID})
+			return
+		}
+		if err != nil {
+			writeEntityError(w, err)
+			return
+		}
+		w.Header().Set("ETag", quoteETag(newRevision))
+		writeJSON(w, http.StatusOK, EntityResponse{Type: entityType, ID: id, Revision: newRevision})
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "GET / PATCH メソッドのみ許可されています")
+	}
+}
+
+// BulkStatusRequest は Activity の一括ステータス変更のリクエスト
+type BulkStatusRequest struct {
+	IDs       []string          `json:"ids"`
+	Status    string            `json:"status"`
+	Revisions map[string]string `json:"revisions,omitempty"` // Activity ID → 更新元のリビジョン（省略時は現在のリビジョン）
+}
+
+// handleAPIActivitiesBulkStatus は複数の Activity のステータスを変更し、Activity ごとの結果を返す
+// 個々の失敗（存在しない、品質ゲート、リビジョン競合）は結果の items に含め、全体は 200 を返す
+func (s *Server) handleAPIActivitiesBulkStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "POST メソッドのみ許可されています")
+		return
+	}
+	var req BulkStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "リクエストボディが不正です: "+err.Error())
+		return
+	}
+	result, err := s.zeus.BulkUpdateActivityStatus(r.Context(), req.IDs, core.ActivityStatus(req.Status), req.Revisions)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// writeEntityError はエンティティ操作のエラーをステータスコードに変換して書き込む
+func writeEntityError(w http.ResponseWriter, err error) {
+	var validation *core.ValidationError
+	switch {
+	case errors.Is(err, core.ErrEntityNotFound), errors.Is(err, core.ErrUnknownEntity):
+		writeError(w, http.StatusNotFound, err.Error())
+	case errors.As(err, &validation):
+		writeError(w, http.StatusBadRequest, err.Error())
+	default:
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+	}
+}
+
+// quoteETag はリビジョンを ETag の形式（ダブルクォート付き）にする
+func quoteETag(revision string) string {
+	return `"` + revision + `"`
+}
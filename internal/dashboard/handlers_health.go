@@ -0,0 +1,88 @@
+package dashboard
+
+import (
+	"net/http"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// =============================================================================
+// Health API 型定義
+// =============================================================================
+
+// HealthResponse はヘルスチェック API のレスポンス
+type HealthResponse struct {
+	Status string            `json:"status"` // ok, unavailable
+	Checks []HealthCheckItem `json:"checks,omitempty"`
+}
+
+// HealthCheckItem は個別チェックの結果
+type HealthCheckItem struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"` // pass, fail
+	Message string `json:"message,omitempty"`
+}
+
+// =============================================================================
+// Health API ハンドラー
+// =============================================================================
+
+// handleHealthz は liveness チェックを処理
+// プロセスが応答できることのみを示し、ストアにはアクセスしない
+func (s *Server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, HealthResponse{Status: "ok"})
+}
+
+// handleReadyz は readiness チェックを処理
+// .zeus ディレクトリの読み取り、zeus.yaml のパース、SSE Broadcaster の状態を確認する
+func (s *Server) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+
+	ctx := r.Context()
+	fileStore := s.zeus.FileStore()
+	checks := make([]HealthCheckItem, 0, 3)
+
+	// .zeus ディレクトリの読み取り
+	if _, err := fileStore.ListDir(ctx, "."); err != nil {
+		checks = append(checks, HealthCheckItem{Name: "store", Status: "fail", Message: err.Error()})
+	} else {
+		checks = append(checks, HealthCheckItem{Name: "store", Status: "pass"})
+	}
+
+	// zeus.yaml のパース
+	var config core.ZeusConfig
+	if err := fileStore.ReadYaml(ctx, "zeus.yaml", &config); err != nil {
+		checks = append(checks, HealthCheckItem{Name: "config", Status: "fail", Message: err.Error()})
+	} else {
+		checks = append(checks, HealthCheckItem{Name: "config", Status: "pass"})
+	}
+
+	// SSE Broadcaster
+	if s.broadcaster == nil {
+		checks = append(checks, HealthCheckItem{Name: "broadcaster", Status: "fail", Message: "broadcaster is not initialized"})
+	} else {
+		// ロックを取得できることを確認（デッドロック時は応答せずプローブのタイムアウトで検知される）
+		s.broadcaster.ClientCount()
+		checks = append(checks, HealthCheckItem{Name: "broadcaster", Status: "pass"})
+	}
+
+	response := HealthResponse{Status: "ok", Checks: checks}
+	status := http.StatusOK
+	for _, check := range checks {
+		if check.Status == "fail" {
+			response.Status = "unavailable"
+			status = http.StatusServiceUnavailable
+			break
+		}
+	}
+
+	writeJSON(w, status, response)
+}
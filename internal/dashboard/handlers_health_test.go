@@ -0,0 +1,81 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestHandleHealthz(t *testing.T) {
+	zeus := setupTestZeus(t)
+	server := NewServer(zeus, 0)
+
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/healthz")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("ステータスコードが正しくありません: got %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+}
+
+func TestHandleReadyz(t *testing.T) {
+	zeus := setupTestZeus(t)
+	server := NewServer(zeus, 0)
+
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/readyz")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("ステータスコードが正しくありません: got %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	var result HealthResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("JSON のデコードに失敗: %v", err)
+	}
+	if result.Status != "ok" {
+		t.Errorf("status が正しくありません: got %s, want ok", result.Status)
+	}
+	if len(result.Checks) != 3 {
+		t.Errorf("チェック数が正しくありません: got %d, want 3", len(result.Checks))
+	}
+}
+
+func TestHandleReadyz_BrokenConfig(t *testing.T) {
+	zeus := setupTestZeus(t)
+	server := NewServer(zeus, 0)
+
+	// zeus.yaml を壊す
+	configPath := filepath.Join(zeus.FileStore().BasePath(), "zeus.yaml")
+	if err := os.WriteFile(configPath, []byte("project: [unclosed"), 0644); err != nil {
+		t.Fatalf("zeus.yaml の書き込みに失敗: %v", err)
+	}
+
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/readyz")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("ステータスコードが正しくありません: got %d, want %d", resp.StatusCode, http.StatusServiceUnavailable)
+	}
+}
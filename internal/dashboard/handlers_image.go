@@ -0,0 +1,110 @@
+package dashboard
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/biwakonbu/zeus/internal/diagram"
+)
+
+// =============================================================================
+// 図の画像レンダリング API ハンドラー
+// =============================================================================
+
+// handleAPIGraphImage は依存グラフを画像（PNG / SVG）で返す
+// 絞り込みは /api/graph と同じクエリを受け付ける
+func (s *Server) handleAPIGraphImage(w http.ResponseWriter, r *http.Request) {
+	s.serveDiagramImage(w, r, func(renderer diagram.Renderer) (string, int, error) {
+		graph, status, err := s.buildFilteredDependencyGraph(r)
+		if err != nil {
+			return "", status, err
+		}
+		return renderer.DependencyGraph(graph), http.StatusOK, nil
+	})
+}
+
+// handleAPIUnifiedGraphImage は統合グラフを画像（PNG / SVG）で返す
+// フィルターは /api/unified-graph と同じクエリを受け付ける
+func (s *Server) handleAPIUnifiedGraphImage(w http.ResponseWriter, r *http.Request) {
+	s.serveDiagramImage(w, r, func(renderer diagram.Renderer) (string, int, error) {
+		graph, err := s.zeus.BuildUnifiedGraph(r.Context(), buildGraphFilter(r))
+		if err != nil {
+			return "", http.StatusInternalServerError, err
+		}
+		return renderer.UnifiedGraph(graph), http.StatusOK, nil
+	})
+}
+
+// handleAPIActivityImage はアクティビティ図を画像（PNG / SVG）で返す
+func (s *Server) handleAPIActivityImage(w http.ResponseWriter, r *http.Request) {
+	s.serveDiagramImage(w, r, func(renderer diagram.Renderer) (string, int, error) {
+		activityID := r.PathValue("id")
+		entity, err := s.zeus.Get(r.Context(), "activity", activityID)
+		if err != nil {
+			if errors.Is(err, core.ErrEntityNotFound) {
+				return "", http.StatusNotFound, errors.New("アクティビティが見つかりません: " + activityID)
+			}
+			return "", http.StatusBadRequest, err
+		}
+		act, ok := entity.(*core.ActivityEntity)
+		if !ok {
+			return "", http.StatusInternalServerError, errors.New("アクティビティの読み込みに失敗しました")
+		}
+		return renderer.Activity(act), http.StatusOK, nil
+	})
+}
+
+// serveDiagramImage は図のソースを生成し、zeus.yaml の rendering 設定で画像化して返す
+// クエリ: format（png / svg、デフォルト svg）、engine（mermaid / plantuml、デフォルト mermaid）
+func (s *Server) serveDiagramImage(w http.ResponseWriter, r *http.Request, source func(diagram.Renderer) (string, int, error)) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+
+	imageFormat, ok := diagram.ParseImageFormat(r.URL.Query().Get("format"))
+	if !ok {
+		writeError(w, http.StatusBadRequest, "format は png または svg を指定してください")
+		return
+	}
+	renderer, ok := diagramRenderer(r)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "engine は mermaid または plantuml を指定してください")
+		return
+	}
+	if renderer == nil {
+		renderer, _ = diagram.NewRenderer(diagram.FormatMermaid)
+	}
+
+	ctx := r.Context()
+	var settings core.RenderingSettings
+	if config, err := s.zeus.LoadConfig(ctx); err == nil {
+		settings = config.Rendering
+	}
+	imageRenderer, err := diagram.NewImageRenderer(settings)
+	if err != nil {
+		if errors.Is(err, diagram.ErrRenderingNotConfigured) {
+			writeError(w, http.StatusNotImplemented, "画像レンダリングが設定されていません（zeus.yaml の rendering.engine を設定してください）")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	src, status, err := source(renderer)
+	if err != nil {
+		writeError(w, status, err.Error())
+		return
+	}
+
+	data, err := imageRenderer.Render(ctx, src, renderer.Format(), imageFormat)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, "画像レンダリングに失敗しました: "+err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", imageFormat.ContentType())
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
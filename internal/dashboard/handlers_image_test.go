@@ -0,0 +1,86 @@
+package dashboard
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandleAPIGraphImage(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+
+	server := NewServer(zeus, 0)
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	// rendering 未設定時は 501
+	resp, err := http.Get(ts.URL + "/api/graph/image?format=svg")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotImplemented {
+		t.Errorf("未設定時のステータスコードが正しくありません: got %d, want %d", resp.StatusCode, http.StatusNotImplemented)
+	}
+
+	// Kroki 互換のスタブサーバーを設定
+	var gotPath string
+	kroki := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Write([]byte("PNGDATA"))
+	}))
+	defer kroki.Close()
+
+	config, err := zeus.LoadConfig(ctx)
+	if err != nil {
+		t.Fatalf("設定の読み込みに失敗: %v", err)
+	}
+	config.Rendering.Engine = "kroki"
+	config.Rendering.KrokiURL = kroki.URL
+	if err := zeus.FileStore().WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("設定の書き込みに失敗: %v", err)
+	}
+
+	resp, err = http.Get(ts.URL + "/api/graph/image?format=png&engine=plantuml")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, body=%s", resp.StatusCode, body)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "image/png" {
+		t.Errorf("Content-Type が正しくありません: %s", ct)
+	}
+	if string(body) != "PNGDATA" {
+		t.Errorf("画像データが正しくありません: %q", body)
+	}
+	if gotPath != "/plantuml/png" {
+		t.Errorf("Kroki のパスが正しくありません: %s", gotPath)
+	}
+
+	// 未対応形式は 400、存在しないアクティビティは 404
+	tests := []struct {
+		path string
+		want int
+	}{
+		{"/api/graph/image?format=pdf", http.StatusBadRequest},
+		{"/api/unified-graph/image?engine=svg", http.StatusBadRequest},
+		{"/api/activities/act-00000000/image", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		resp, err := http.Get(ts.URL + tt.path)
+		if err != nil {
+			t.Fatalf("リクエストに失敗: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != tt.want {
+			t.Errorf("%s: ステータスコードが正しくありません: got %d, want %d", tt.path, resp.StatusCode, tt.want)
+		}
+	}
+}
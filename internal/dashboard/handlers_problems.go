@@ -0,0 +1,37 @@
+package dashboard
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// ProblemAgingResponse は未解決 Problem の経過日数と SLA 違反 API のレスポンス
+type ProblemAgingResponse struct {
+	Problems []core.ProblemAging `json:"problems"` // SLA 違反（超過日数の多い順）が先頭
+	Total    int                 `json:"total"`
+	Breached int                 `json:"breached"`
+}
+
+// handleAPIProblemAging は未解決 Problem の経過日数と SLA（escalation.problems.sla_days）の状況を返す
+// 経過日数は日付で変わるため、ストアの変更に基づくキャッシュは使わない
+func (s *Server) handleAPIProblemAging(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+
+	problems, err := s.zeus.ProblemAging(r.Context(), time.Now())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "Problem の SLA の取得エラー: "+err.Error())
+		return
+	}
+	response := ProblemAgingResponse{Problems: problems, Total: len(problems)}
+	for _, p := range problems {
+		if p.Breached {
+			response.Breached++
+		}
+	}
+	writeJSON(w, http.StatusOK, response)
+}
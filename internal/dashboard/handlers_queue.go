@@ -0,0 +1,125 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// QueueClaimRequest は作業の割り当て要求
+type QueueClaimRequest struct {
+	Worker     string `json:"worker"`
+	ActivityID string `json:"activity_id,omitempty"` // 省略時は優先度の高い順に空いている Activity
+	TTLSeconds int    `json:"ttl_seconds,omitempty"` // 省略時は 900 秒
+}
+
+// QueueLeaseRequest はリースの延長・解放の要求
+type QueueLeaseRequest struct {
+	LeaseID    string `json:"lease_id"`
+	TTLSeconds int    `json:"ttl_seconds,omitempty"` // 延長する有効期間（省略時は割り当て時の有効期間）
+}
+
+// QueueResponse は有効なリース一覧のレスポンス
+type QueueResponse struct {
+	Claims []core.WorkClaim `json:"claims"`
+	Total  int              `json:"total"`
+}
+
+// handleAPIQueue は有効なリース一覧を返す
+func (s *Server) handleAPIQueue(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+	claims, err := s.zeus.Claims(r.Context(), time.Now())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "リースの取得に失敗: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, QueueResponse{Claims: claims, Total: len(claims)})
+}
+
+// handleAPIQueueClaim は空いている Activity をワーカーに割り当てる
+// 割り当てた場合は 200、空いている Activity が無い場合は 204、指定した Activity が割り当て済みの場合は 409
+func (s *Server) handleAPIQueueClaim(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "POST メソッドのみ許可されています")
+		return
+	}
+	var req QueueClaimRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "リクエストボディが不正です: "+err.Error())
+		return
+	}
+	if req.Worker == "" {
+		writeError(w, http.StatusBadRequest, "worker を指定してください")
+		return
+	}
+
+	claim, err := s.zeus.ClaimWork(r.Context(), core.ClaimRequest{
+		Worker:     req.Worker,
+		ActivityID: req.ActivityID,
+		TTL:        time.Duration(req.TTLSeconds) * time.Second,
+	}, time.Now())
+	switch {
+	case errors.Is(err, core.ErrNoAvailableWork):
+		w.WriteHeader(http.StatusNoContent)
+	case errors.Is(err, core.ErrActivityClaimed):
+		writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, core.ErrEntityNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case err != nil:
+		writeError(w, http.StatusBadRequest, err.Error())
+	default:
+		writeJSON(w, http.StatusOK, claim)
+	}
+}
+
+// handleAPIQueueHeartbeat はリースの期限を延長する（期限切れ・不明なリースは 404）
+func (s *Server) handleAPIQueueHeartbeat(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "POST メソッドのみ許可されています")
+		return
+	}
+	var req QueueLeaseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "リクエストボディが不正です: "+err.Error())
+		return
+	}
+
+	claim, err := s.zeus.RenewClaim(r.Context(), req.LeaseID, time.Duration(req.TTLSeconds)*time.Second, time.Now())
+	switch {
+	case errors.Is(err, core.ErrLeaseNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case err != nil:
+		writeError(w, http.StatusBadRequest, err.Error())
+	default:
+		writeJSON(w, http.StatusOK, claim)
+	}
+}
+
+// handleAPIQueueRelease はリースを解放する（期限切れ・不明なリースは 404）
+func (s *Server) handleAPIQueueRelease(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "POST メソッドのみ許可されています")
+		return
+	}
+	var req QueueLeaseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "リクエストボディが不正です: "+err.Error())
+		return
+	}
+
+	err := s.zeus.ReleaseClaim(r.Context(), req.LeaseID, time.Now())
+	switch {
+	case errors.Is(err, core.ErrLeaseNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case err != nil:
+		writeError(w, http.StatusInternalServerError, err.Error())
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,117 @@
+package dashboard
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// TestHandleAPIQueue は作業キュー API の割り当て・延長・解放のテスト
+func TestHandleAPIQueue(t *testing.T) {
+	zeus := setupTestZeus(t)
+	result, err := zeus.Add(context.Background(), "activity", "キュー対象")
+	if err != nil {
+		t.Fatalf("Activity の追加に失敗: %v", err)
+	}
+
+	server := NewServer(zeus, 0)
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	post := func(path, body string) *http.Response {
+		t.Helper()
+		resp, err := http.Post(ts.URL+path, "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("リクエストに失敗: %v", err)
+		}
+		return resp
+	}
+
+	// text/plain の単純リクエスト・許可されていないオリジンからのリクエストは拒否し、割り当てない
+	for _, path := range []string{"/api/queue/claim", "/api/queue/heartbeat", "/api/queue/release"} {
+		resp, err := http.Post(ts.URL+path, "text/plain", strings.NewReader(`{"worker":"evil","lease_id":"lease-00000000"}`))
+		if err != nil {
+			t.Fatalf("リクエストに失敗: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusUnsupportedMediaType {
+			t.Errorf("%s: text/plain のステータスコードが正しくありません: got %d", path, resp.StatusCode)
+		}
+
+		req, _ := http.NewRequest(http.MethodPost, ts.URL+path, strings.NewReader(`{"worker":"evil","lease_id":"lease-00000000"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Origin", "http://evil.example.com")
+		resp, err = http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("リクエストに失敗: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusForbidden {
+			t.Errorf("%s: 許可されていないオリジンのステータスコードが正しくありません: got %d", path, resp.StatusCode)
+		}
+	}
+
+	// worker が無い場合は 400
+	resp := post("/api/queue/claim", `{}`)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("worker 無しのステータスコードが正しくありません: got %d", resp.StatusCode)
+	}
+
+	resp = post("/api/queue/claim", `{"worker":"agent-1","ttl_seconds":60}`)
+	var claim core.WorkClaim
+	if err := json.NewDecoder(resp.Body).Decode(&claim); err != nil {
+		t.Fatalf("JSON デコードに失敗: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || claim.ActivityID != result.ID || claim.TTLSeconds != 60 {
+		t.Fatalf("割り当てが正しくありません: %d %+v", resp.StatusCode, claim)
+	}
+
+	// 割り当て済みの Activity の指定は 409、空きが無い場合は 204
+	resp = post("/api/queue/claim", `{"worker":"agent-2","activity_id":"`+result.ID+`"}`)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusConflict {
+		t.Errorf("割り当て済みのステータスコードが正しくありません: got %d", resp.StatusCode)
+	}
+	resp = post("/api/queue/claim", `{"worker":"agent-2"}`)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("空きが無い場合のステータスコードが正しくありません: got %d", resp.StatusCode)
+	}
+
+	resp = post("/api/queue/heartbeat", `{"lease_id":"`+claim.LeaseID+`"}`)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("延長のステータスコードが正しくありません: got %d", resp.StatusCode)
+	}
+
+	listResp, err := http.Get(ts.URL + "/api/queue")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	var list QueueResponse
+	if err := json.NewDecoder(listResp.Body).Decode(&list); err != nil {
+		t.Fatalf("JSON デコードに失敗: %v", err)
+	}
+	listResp.Body.Close()
+	if list.Total != 1 || list.Claims[0].Worker != "agent-1" {
+		t.Errorf("リース一覧が正しくありません: %+v", list)
+	}
+
+	resp = post("/api/queue/release", `{"lease_id":"`+claim.LeaseID+`"}`)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("解放のステータスコードが正しくありません: got %d", resp.StatusCode)
+	}
+	resp = post("/api/queue/heartbeat", `{"lease_id":"`+claim.LeaseID+`"}`)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("解放済みリースのステータスコードが正しくありません: got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,43 @@
+package dashboard
+
+import (
+	"net/http"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// ReleasesResponse はリリース一覧 API のレスポンス
+type ReleasesResponse struct {
+	Releases []core.ReleaseReadiness `json:"releases"` // 目標日の近い順（目標日なしは最後）
+	Total    int                     `json:"total"`
+}
+
+// handleAPIReleases は全リリースの準備状況（go / no-go）を返す
+func (s *Server) handleAPIReleases(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+
+	releases, err := s.zeus.Releases(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "リリースの取得エラー: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, ReleasesResponse{Releases: releases, Total: len(releases)})
+}
+
+// handleAPIRelease は ID またはバージョン名で指定したリリースの準備状況を返す
+func (s *Server) handleAPIRelease(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+
+	release, err := s.zeus.ReleaseStatus(r.Context(), r.PathValue("ref"))
+	if err != nil {
+		writeEntityError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, release)
+}
@@ -0,0 +1,56 @@
+package dashboard
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// scoreQuery は一覧 API の優先度スコアのクエリ（?sort=score&method=rice|wsjf）
+type scoreQuery struct {
+	method core.ScoringMethod // 空の場合は評価値を持つ項目が多い方式
+	sort   bool               // スコアの高い順に並べ替える
+}
+
+// parseScoreQuery は一覧 API の sort / method クエリを解析
+func parseScoreQuery(r *http.Request) (scoreQuery, error) {
+	var q scoreQuery
+	method, err := core.ParseScoringMethod(r.URL.Query().Get("method"))
+	if err != nil {
+		return q, err
+	}
+	q.method = method
+	switch v := r.URL.Query().Get("sort"); v {
+	case "":
+	case "score":
+		q.sort = true
+	default:
+		return q, fmt.Errorf("unsupported sort: %s (expected score)", v)
+	}
+	return q, nil
+}
+
+// apply は items のスコアの算出方式を決定し、必要に応じてスコアの高い順に並べ替える（スコアの無い項目は後ろ）
+func (q scoreQuery) apply(items []core.Scorable) core.ScoringMethod {
+	method := q.method
+	if method == "" {
+		method = core.DetectScoringMethod(items)
+	}
+	if q.sort {
+		sort.SliceStable(items, func(i, j int) bool {
+			return core.HigherPriority(items[i], items[j], method)
+		})
+	}
+	return method
+}
+
+// scoreOf は method の方式でのスコア（評価値が無い場合は nil）
+func scoreOf(item core.Scorable, method core.ScoringMethod) *float64 {
+	score, ok := core.PriorityScore(item, method)
+	if !ok {
+		return nil
+	}
+	return &score
+}
@@ -0,0 +1,91 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// handleAPISettings はプロジェクト設定（zeus.yaml の settings と analysis セクション）の取得・更新を処理
+//   - GET: 設定とリビジョンを返す（ETag ヘッダーにもリビジョンを設定）
+//   - PATCH: ボディに含めた項目のみ現在の設定に上書きする（operations は指定したキーのみ、値が空のキーは削除）
+//     If-Match（またはボディの revision）必須。未指定は 428、不一致は 409、不正な値は 400。
+//     承認を緩める変更は承認待ちに追加して 202（approval_id）を返す
+func (s *Server) handleAPISettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	switch r.Method {
+	case http.MethodGet:
+		settings, err := s.zeus.ProjectSettings(ctx)
+		if err != nil {
+			writeSettingsError(w, err)
+			return
+		}
+		w.Header().Set("ETag", quoteETag(settings.Revision))
+		writeJSON(w, http.StatusOK, settings)
+
+	case http.MethodPatch:
+		settings, err := s.zeus.ProjectSettings(ctx)
+		if err != nil {
+			writeSettingsError(w, err)
+			return
+		}
+		settings.Revision = ""
+		if err := json.NewDecoder(r.Body).Decode(settings); err != nil {
+			writeError(w, http.StatusBadRequest, "リクエストボディが不正です: "+err.Error())
+			return
+		}
+		for action, level := range settings.Operations {
+			if level == "" {
+				delete(settings.Operations, action)
+			}
+		}
+		revision := strings.Trim(strings.TrimPrefix(r.Header.Get("If-Match"), "W/"), `"`)
+		if revision == "" {
+			revision = settings.Revision
+		}
+		if revision == "" {
+			writeError(w, http.StatusPreconditionRequired, "If-Match ヘッダー（または revision）で更新元のリビジョンを指定してください")
+			return
+		}
+
+		updated, err := s.zeus.UpdateProjectSettings(ctx, *settings, revision)
+		var conflict *core.RevisionConflictError
+		if errors.As(err, &conflict) {
+			w.Header().Set("ETag", quoteETag(conflict.Current))
+			writeJSON(w, http.StatusConflict, RevisionConflictResponse{
+				Error:           http.StatusText(http.StatusConflict),
+				Message:         conflict.Error(),
+				CurrentRevision: conflict.Current,
+				Fields:          []FieldConflictResponse{},
+			})
+			return
+		}
+		var required *core.ApprovalRequiredError
+		if errors.As(err, &required) {
+			writeJSON(w, http.StatusAccepted, EntityResponse{Type: "settings", ID: "zeus.yaml", Revision: revision, ApprovalID: required.ApprovalID})
+			return
+		}
+		if err != nil {
+			writeSettingsError(w, err)
+			return
+		}
+		w.Header().Set("ETag", quoteETag(updated.Revision))
+		writeJSON(w, http.StatusOK, updated)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "GET / PATCH メソッドのみ許可されています")
+	}
+}
+
+// writeSettingsError は設定操作のエラーをステータスコードに変換して書き込む
+func writeSettingsError(w http.ResponseWriter, err error) {
+	if errors.Is(err, core.ErrConfigNotFound) {
+		writeError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	writeEntityError(w, err)
+}
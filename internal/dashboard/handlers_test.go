@@ -1,12 +1,17 @@
 package dashboard
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/biwakonbu/zeus/internal/analysis"
 	"github.com/biwakonbu/zeus/internal/core"
 )
 
@@ -160,6 +165,229 @@ func TestHandleAPIGraph_WithTasks(t *testing.T) {
 	}
 }
 
+// TestHandleAPIGraph_Filter は root / status / depth / include_completed による絞り込みのテスト
+func TestHandleAPIGraph_Filter(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+	var ids []string
+	for _, status := range []core.ActivityStatus{core.ActivityStatusActive, core.ActivityStatusDraft, core.ActivityStatusDeprecated} {
+		added, err := zeus.Add(ctx, "activity", "Activity "+string(status), core.WithActivityStatus(status))
+		if err != nil {
+			t.Fatalf("Activity の追加に失敗: %v", err)
+		}
+		ids = append(ids, added.ID)
+	}
+	server := NewServer(zeus, 0)
+
+	get := func(query string) (int, GraphResponse) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		server.handleAPIGraph(rec, httptest.NewRequest(http.MethodGet, "/api/graph"+query, nil))
+		var resp GraphResponse
+		if rec.Code == http.StatusOK {
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("レスポンスのデコードに失敗: %v", err)
+			}
+		}
+		return rec.Code, resp
+	}
+
+	tests := []struct {
+		query string
+		nodes int
+	}{
+		{"", 3},
+		{"?include_completed=false", 2},
+		{"?status=draft,active", 2},
+		{"?root=" + ids[2] + "&include_completed=false", 1},
+		{"?depth=1", 3},
+	}
+	for _, tt := range tests {
+		code, resp := get(tt.query)
+		if code != http.StatusOK || resp.Stats.TotalNodes != tt.nodes {
+			t.Errorf("%s: code=%d nodes=%d, want %d", tt.query, code, resp.Stats.TotalNodes, tt.nodes)
+		}
+	}
+	if _, resp := get("?status=draft"); strings.Contains(resp.Mermaid, "Activity active") {
+		t.Errorf("除外した Activity が Mermaid に含まれています:\n%s", resp.Mermaid)
+	}
+
+	for query, want := range map[string]int{
+		"?root=act-999":            http.StatusNotFound,
+		"?depth=-1":                http.StatusBadRequest,
+		"?include_completed=maybe": http.StatusBadRequest,
+	} {
+		if code, _ := get(query); code != want {
+			t.Errorf("%s: code=%d, want %d", query, code, want)
+		}
+	}
+}
+
+// TestHandleAPICoverage は UseCase と Activity の紐づけのカバレッジ API のテスト
+func TestHandleAPICoverage(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+	obj, err := zeus.Add(ctx, "objective", "目標")
+	if err != nil {
+		t.Fatalf("Objective の追加に失敗: %v", err)
+	}
+	uc, err := zeus.Add(ctx, "usecase", "ログイン", core.WithUseCaseObjective(obj.ID), core.WithUseCaseStatus(core.UseCaseStatusActive))
+	if err != nil {
+		t.Fatalf("UseCase の追加に失敗: %v", err)
+	}
+	act, err := zeus.Add(ctx, "activity", "未分類")
+	if err != nil {
+		t.Fatalf("Activity の追加に失敗: %v", err)
+	}
+
+	server := NewServer(zeus, 0)
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/api/coverage")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	var result struct {
+		Issues []struct {
+			Type     string `json:"type"`
+			EntityID string `json:"entity_id"`
+		} `json:"issues"`
+		UseCasesActive int `json:"usecases_active"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("JSON のデコードに失敗: %v", err)
+	}
+	got := map[string]string{}
+	for _, issue := range result.Issues {
+		got[issue.EntityID] = issue.Type
+	}
+	if got[uc.ID] != "usecase_no_activities" || got[act.ID] != "activity_untraced" || result.UseCasesActive != 1 {
+		t.Errorf("カバレッジ分析の結果が正しくありません: %+v", result)
+	}
+}
+
+// TestHandleAPIConceptGraph は概念グラフ API のテスト
+func TestHandleAPIConceptGraph(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+	obj, err := zeus.Add(ctx, "objective", "目標")
+	if err != nil {
+		t.Fatalf("Objective の追加に失敗: %v", err)
+	}
+	risk, err := zeus.Add(ctx, "risk", "リスク", core.WithRiskObjective(obj.ID))
+	if err != nil {
+		t.Fatalf("Risk の追加に失敗: %v", err)
+	}
+	if _, err := zeus.Add(ctx, "consideration", "検討", core.WithConsiderationObjective(obj.ID)); err != nil {
+		t.Fatalf("Consideration の追加に失敗: %v", err)
+	}
+	// タスク系のエンティティは含めない
+	if _, err := zeus.Add(ctx, "activity", "作業"); err != nil {
+		t.Fatalf("Activity の追加に失敗: %v", err)
+	}
+
+	server := NewServer(zeus, 0)
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	get := func(query string) (int, ConceptGraphResponse) {
+		t.Helper()
+		resp, err := http.Get(ts.URL + "/api/concept-graph" + query)
+		if err != nil {
+			t.Fatalf("リクエストに失敗: %v", err)
+		}
+		defer resp.Body.Close()
+		var result ConceptGraphResponse
+		if resp.StatusCode == http.StatusOK {
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatalf("JSON のデコードに失敗: %v", err)
+			}
+		}
+		return resp.StatusCode, result
+	}
+
+	status, result := get("")
+	if status != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", status, http.StatusOK)
+	}
+	if result.Stats.TotalNodes != 3 || result.Stats.TotalEdges != 2 || result.Stats.NodesByType["activity"] != 0 {
+		t.Errorf("概念グラフの統計が正しくありません: %+v", result.Stats)
+	}
+	if !strings.Contains(result.Mermaid, "graph BT") || !strings.Contains(result.Mermaid, strings.ReplaceAll(risk.ID, "-", "_")+" -->|objective_id|") {
+		t.Errorf("Mermaid が正しくありません:\n%s", result.Mermaid)
+	}
+
+	// 種別と状態で絞り込み（除いたノードへのエッジは含めない）
+	_, result = get("?types=risk,objective&status=identified")
+	if result.Stats.TotalNodes != 1 || result.Nodes[0].ID != risk.ID || result.Stats.TotalEdges != 0 {
+		t.Errorf("絞り込みの結果が正しくありません: %+v", result)
+	}
+
+	if status, _ := get("?types=activity"); status != http.StatusBadRequest {
+		t.Errorf("対象外の種別: got %d, want %d", status, http.StatusBadRequest)
+	}
+}
+
+// TestHandleAPIGraphPath はエンティティ間の経路探索 API のテスト
+func TestHandleAPIGraphPath(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+	obj, err := zeus.Add(ctx, "objective", "目標")
+	if err != nil {
+		t.Fatalf("Objective の追加に失敗: %v", err)
+	}
+	risk, err := zeus.Add(ctx, "risk", "リスク", core.WithRiskObjective(obj.ID))
+	if err != nil {
+		t.Fatalf("Risk の追加に失敗: %v", err)
+	}
+
+	server := NewServer(zeus, 0)
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/api/graph/path?from=" + obj.ID + "&to=" + risk.ID)
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	var result struct {
+		Found    bool `json:"found"`
+		Directed bool `json:"directed"`
+		Steps    []struct {
+			Relation string `json:"relation"`
+			Forward  bool   `json:"forward"`
+		} `json:"steps"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("JSON のデコードに失敗: %v", err)
+	}
+	if !result.Found || result.Directed || len(result.Steps) != 1 || result.Steps[0].Relation != "objective_id" || result.Steps[0].Forward {
+		t.Errorf("経路が正しくありません: %+v", result)
+	}
+
+	for query, want := range map[string]int{
+		"?from=" + obj.ID:                 http.StatusBadRequest,
+		"?from=" + obj.ID + "&to=obj-999": http.StatusNotFound,
+	} {
+		resp, err := http.Get(ts.URL + "/api/graph/path" + query)
+		if err != nil {
+			t.Fatalf("リクエストに失敗: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != want {
+			t.Errorf("%s: ステータスコードが正しくありません: got %d, want %d", query, resp.StatusCode, want)
+		}
+	}
+}
+
 // TestServerDevMode は開発モードのテスト
 func TestServerDevMode(t *testing.T) {
 	zeus := setupTestZeus(t)
@@ -242,3 +470,664 @@ func TestSSEDevModeHeaders(t *testing.T) {
 		t.Errorf("Access-Control-Allow-Origin が正しくありません: got %s, want *", corsHeader)
 	}
 }
+
+// TestSSEReplay は Last-Event-ID 以降のイベントのリプレイテスト
+func TestSSEReplay(t *testing.T) {
+	zeus := setupTestZeus(t)
+	server := NewServer(zeus, 0)
+
+	// 切断中に配信されたイベント
+	server.Broadcaster().BroadcastStatus(map[string]int{"n": 1})
+	server.Broadcaster().BroadcastStatus(map[string]int{"n": 2})
+	server.Broadcaster().BroadcastReport(ReportEvent{Name: "weekly"})
+
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, ts.URL+"/api/events", nil)
+	req.Header.Set("Last-Event-ID", "1")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var ids []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() && len(ids) < 2 {
+		if id, ok := strings.CutPrefix(scanner.Text(), "id: "); ok {
+			ids = append(ids, id)
+		}
+	}
+	if strings.Join(ids, ",") != "2,3" {
+		t.Errorf("リプレイされたイベント ID が正しくありません: got %v, want [2 3]", ids)
+	}
+}
+
+func TestLastEventID(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/events?last_event_id=5", nil)
+	if id, ok := lastEventID(req); !ok || id != 5 {
+		t.Errorf("クエリの last_event_id: got %d, %v", id, ok)
+	}
+	req.Header.Set("Last-Event-ID", "9")
+	if id, ok := lastEventID(req); !ok || id != 9 {
+		t.Errorf("Last-Event-ID ヘッダーが優先されません: got %d, %v", id, ok)
+	}
+	if _, ok := lastEventID(httptest.NewRequest(http.MethodGet, "/api/events?last_event_id=x", nil)); ok {
+		t.Error("不正な値は無視されるべきです")
+	}
+}
+
+func TestHandleAPIChanges(t *testing.T) {
+	zeus, activityID := setupTestZeusWithActivity(t)
+	server := NewServer(zeus, 0)
+
+	get := func(query string) (*httptest.ResponseRecorder, ChangesResponse) {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/api/changes"+query, nil)
+		rec := httptest.NewRecorder()
+		server.handleAPIChanges(rec, req)
+		var resp ChangesResponse
+		if rec.Code == http.StatusOK {
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("レスポンスのデコードに失敗: %v", err)
+			}
+		}
+		return rec, resp
+	}
+
+	rec, resp := get("")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", rec.Code, http.StatusOK)
+	}
+	found := false
+	for _, c := range resp.Changes {
+		if c.Type == "activity" && c.ID == activityID && c.Op == "create" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Activity の作成が含まれていません: %+v", resp.Changes)
+	}
+
+	// next_cursor 以降は変更なし
+	_, resp = get("?since=" + resp.NextCursor)
+	if len(resp.Changes) != 0 || resp.HasMore {
+		t.Errorf("変更なしのはずです: %+v", resp)
+	}
+
+	// limit 指定時の next_cursor は最後の変更の位置
+	_, resp = get("?limit=1")
+	if len(resp.Changes) != 1 || resp.NextCursor != resp.Changes[0].Cursor {
+		t.Errorf("limit=1 のレスポンスが正しくありません: %+v", resp)
+	}
+
+	for _, query := range []string{"?since=abc", "?limit=0"} {
+		if rec, _ := get(query); rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: ステータスコードが正しくありません: got %d, want %d", query, rec.Code, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestHandleAPIEntity(t *testing.T) {
+	zeus, activityID := setupTestZeusWithActivity(t)
+	server := NewServer(zeus, 0)
+	handler := server.handler()
+	path := "/api/entities/activity/" + activityID
+
+	patch := func(ifMatch, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPatch, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", rec.Code, http.StatusOK)
+	}
+	var got EntityResponse
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("レスポンスのデコードに失敗: %v", err)
+	}
+	etag := rec.Header().Get("ETag")
+	if got.Revision == "" || etag != `"`+got.Revision+`"` {
+		t.Fatalf("ETag が正しくありません: etag=%q revision=%q", etag, got.Revision)
+	}
+
+	// リビジョン未指定は 428
+	if rec := patch("", `{"fields":{"title":"Updated"}}`); rec.Code != http.StatusPreconditionRequired {
+		t.Errorf("ステータスコードが正しくありません: got %d, want %d", rec.Code, http.StatusPreconditionRequired)
+	}
+
+	rec = patch(etag, `{"fields":{"title":"Updated"}}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d (%s)", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if rec.Header().Get("ETag") == etag {
+		t.Error("更新後の ETag が変わっていません")
+	}
+
+	// 古いリビジョンは 409（フィールドごとの差分付き）
+	rec = patch("", `{"revision":"`+got.Revision+`","fields":{"title":"Mine"}}`)
+	if rec.Code != http.StatusConflict {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", rec.Code, http.StatusConflict)
+	}
+	var conflict RevisionConflictResponse
+	if err := json.NewDecoder(rec.Body).Decode(&conflict); err != nil {
+		t.Fatalf("レスポンスのデコードに失敗: %v", err)
+	}
+	if len(conflict.Fields) != 1 || conflict.Fields[0].Current != "Updated" || conflict.Fields[0].Proposed != "Mine" {
+		t.Errorf("競合の差分が正しくありません: %+v", conflict)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/entities/activity/act-00000000", nil)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("ステータスコードが正しくありません: got %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestHandleAPIActivitiesBulkStatus(t *testing.T) {
+	zeus, activityID := setupTestZeusWithActivity(t)
+	server := NewServer(zeus, 0)
+	handler := server.handler()
+
+	post := func(body string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, "/api/activities/bulk-status", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := post(`{"ids":["` + activityID + `","act-00000000"],"status":"active"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d (%s)", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var got core.BulkStatusResult
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("レスポンスのデコードに失敗: %v", err)
+	}
+	if len(got.Items) != 2 || got.Updated != 1 || got.Failed != 1 {
+		t.Fatalf("結果が正しくありません: %+v", got)
+	}
+	if got.Items[0].Result != core.BulkStatusUpdated || got.Items[0].From != "draft" || got.Items[1].Result != core.BulkStatusFailed {
+		t.Errorf("項目ごとの結果が正しくありません: %+v", got.Items)
+	}
+
+	// 変更済みの Activity は unchanged
+	rec = post(`{"ids":["` + activityID + `"],"status":"active"}`)
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("レスポンスのデコードに失敗: %v", err)
+	}
+	if got.Items[0].Result != core.BulkStatusUnchanged {
+		t.Errorf("結果が正しくありません: %+v", got.Items[0])
+	}
+
+	// 不正なステータス・ボディは 400
+	for _, body := range []string{`{"ids":["` + activityID + `"],"status":"done"}`, `{"ids":[],"status":"active"}`, `{`} {
+		if rec := post(body); rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: ステータスコードが正しくありません: got %d, want %d", body, rec.Code, http.StatusBadRequest)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/activities/bulk-status", nil)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("ステータスコードが正しくありません: got %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestHandleAPIAffinityConfig(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+
+	config, err := zeus.LoadConfig(ctx)
+	if err != nil {
+		t.Fatalf("設定の読み込みに失敗: %v", err)
+	}
+	category := 0.25
+	config.Analysis.Affinity.Weights.Category = &category
+	if err := zeus.FileStore().WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("設定の書き込みに失敗: %v", err)
+	}
+
+	server := NewServer(zeus, 0)
+	req := httptest.NewRequest(http.MethodGet, "/api/affinity", nil)
+	rec := httptest.NewRecorder()
+	server.handleAPIAffinity(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", rec.Code, http.StatusOK)
+	}
+	var resp AffinityResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("レスポンスのデコードに失敗: %v", err)
+	}
+	if resp.Weights.Category != 0.25 {
+		t.Errorf("設定の重みが反映されていません: got %f", resp.Weights.Category)
+	}
+
+	// 範囲外の設定は 500
+	invalid := 2.0
+	config.Analysis.Affinity.Weights.Category = &invalid
+	if err := zeus.FileStore().WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("設定の書き込みに失敗: %v", err)
+	}
+	rec = httptest.NewRecorder()
+	server.handleAPIAffinity(rec, httptest.NewRequest(http.MethodGet, "/api/affinity", nil))
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("不正な設定のステータスコードが正しくありません: got %d, want %d", rec.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestHandleAPIAffinityMaxEdges(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+	obj, err := zeus.Add(ctx, "objective", "決済刷新")
+	if err != nil {
+		t.Fatalf("Objective の追加に失敗: %v", err)
+	}
+	for _, title := range []string{"遅延", "障害", "予算超過"} {
+		if _, err := zeus.Add(ctx, "risk", title, core.WithRiskObjective(obj.ID)); err != nil {
+			t.Fatalf("Risk の追加に失敗: %v", err)
+		}
+	}
+
+	server := NewServer(zeus, 0)
+	rec := httptest.NewRecorder()
+	server.handleAPIAffinity(rec, httptest.NewRequest(http.MethodGet, "/api/affinity?max_edges=1", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", rec.Code, http.StatusOK)
+	}
+	var resp AffinityResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("レスポンスのデコードに失敗: %v", err)
+	}
+	if len(resp.Edges) != 1 || resp.Stats.DroppedEdges != 2 || resp.Stats.FilteredEdges != 2 {
+		t.Errorf("max_edges で除外したエッジ数が正しくありません: edges=%d stats=%+v", len(resp.Edges), resp.Stats)
+	}
+}
+
+func TestHandleAPIApprovals(t *testing.T) {
+	zeus, _ := setupTestZeusWithActivity(t)
+	ctx := context.Background()
+	config, err := zeus.LoadConfig(ctx)
+	if err != nil {
+		t.Fatalf("設定の読み込みに失敗: %v", err)
+	}
+	config.Settings.AutomationLevel = "approve"
+	config.Settings.ApprovalMode = "strict"
+	if err := zeus.FileStore().WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("設定の書き込みに失敗: %v", err)
+	}
+	added, err := zeus.Add(ctx, "risk", "Delay")
+	if err != nil || !added.NeedsApproval {
+		t.Fatalf("承認待ちへの追加に失敗: result=%+v err=%v", added, err)
+	}
+
+	server := NewServer(zeus, 0)
+	rec := httptest.NewRecorder()
+	server.handleAPIApprovals(rec, httptest.NewRequest(http.MethodGet, "/api/approvals", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", rec.Code, http.StatusOK)
+	}
+	var resp ApprovalsResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("レスポンスのデコードに失敗: %v", err)
+	}
+	if resp.Total != 1 || resp.Approvals[0].ID != added.ApprovalID {
+		t.Fatalf("承認待ちが正しくありません: %+v", resp)
+	}
+	approval := resp.Approvals[0]
+	if approval.Entity == nil || approval.Entity.Type != "risk" || !strings.Contains(approval.Entity.Content, "title: Delay") {
+		t.Errorf("エンティティの内容が含まれていません: %+v", approval.Entity)
+	}
+	if len(approval.Changes) == 0 {
+		t.Error("差分が含まれていません")
+	}
+
+	rec = httptest.NewRecorder()
+	server.handleAPIApprovals(rec, httptest.NewRequest(http.MethodPost, "/api/approvals", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST のステータスコードが正しくありません: got %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestHandleAPIEntities_Query(t *testing.T) {
+	zeus, activityID := setupTestZeusWithActivity(t)
+	if _, err := zeus.Add(context.Background(), "activity", "Another Activity"); err != nil {
+		t.Fatalf("Activity の追加に失敗: %v", err)
+	}
+	handler := NewServer(zeus, 0).handler()
+
+	get := func(rawQuery string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/api/entities/activity?"+rawQuery, nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := get("q=" + url.QueryEscape("title = 'Test*' and status in (draft, active)"))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", rec.Code, http.StatusOK)
+	}
+	var got core.QueryResult
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("レスポンスのデコードに失敗: %v", err)
+	}
+	if got.Total != 1 || got.Items[0]["id"] != activityID {
+		t.Errorf("クエリの結果が正しくありません: %+v", got)
+	}
+
+	rec = get("")
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil || got.Total != 2 {
+		t.Errorf("q を省略した場合はすべて返す: %d %+v", rec.Code, got)
+	}
+	if rec := get("q=" + url.QueryEscape("status in (draft")); rec.Code != http.StatusBadRequest {
+		t.Errorf("不正なクエリは 400: got %d", rec.Code)
+	}
+	req := httptest.NewRequest(http.MethodGet, "/api/entities/unknown", nil)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("不明なエンティティは 404: got %d", rec.Code)
+	}
+}
+
+func TestHandleAPIRiskHeatmap(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+	risk, err := zeus.Add(ctx, "risk", "リスク",
+		core.WithRiskProbability(core.RiskProbabilityHigh), core.WithRiskImpact(core.RiskImpactLow))
+	if err != nil {
+		t.Fatalf("Risk の追加に失敗: %v", err)
+	}
+	if _, err := zeus.CreateSnapshot(ctx, ""); err != nil {
+		t.Fatalf("スナップショットの作成に失敗: %v", err)
+	}
+
+	server := NewServer(zeus, 0)
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/api/risks/heatmap")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	var result core.RiskHeatmap
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("JSON のデコードに失敗: %v", err)
+	}
+	if len(result.Cells) != 12 || result.Total != 1 || result.Baseline == nil {
+		t.Fatalf("ヒートマップが正しくありません: %+v", result)
+	}
+	first := result.Cells[0]
+	if first.Probability != core.RiskProbabilityHigh || first.Impact != core.RiskImpactLow ||
+		len(first.RiskIDs) != 1 || first.RiskIDs[0] != risk.ID || first.Delta != 0 {
+		t.Errorf("high/low のマスが正しくありません: %+v", first)
+	}
+
+	resp2, err := http.Post(ts.URL+"/api/risks/heatmap", "application/json", nil)
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	resp2.Body.Close()
+	if resp2.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("POST: got %d, want %d", resp2.StatusCode, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestHandleAPIReleases(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+	act, err := zeus.Add(ctx, "activity", "リリースノート")
+	if err != nil {
+		t.Fatalf("Activity の追加に失敗: %v", err)
+	}
+	rel, err := zeus.Add(ctx, "release", "v1.2",
+		core.WithReleaseTargetDate("2026-12-01"), core.WithReleaseActivities([]string{act.ID}))
+	if err != nil {
+		t.Fatalf("Release の追加に失敗: %v", err)
+	}
+
+	server := NewServer(zeus, 0)
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/api/releases")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	var list ReleasesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		t.Fatalf("JSON のデコードに失敗: %v", err)
+	}
+	if list.Total != 1 || list.Releases[0].ID != rel.ID || list.Releases[0].Go || len(list.Releases[0].Activities) != 1 {
+		t.Fatalf("リリース一覧が正しくありません: %+v", list)
+	}
+
+	// バージョン名で取得
+	resp2, err := http.Get(ts.URL + "/api/releases/v1.2")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	defer resp2.Body.Close()
+	var release core.ReleaseReadiness
+	if err := json.NewDecoder(resp2.Body).Decode(&release); err != nil {
+		t.Fatalf("JSON のデコードに失敗: %v", err)
+	}
+	if resp2.StatusCode != http.StatusOK || release.ID != rel.ID || len(release.Blockers) != 1 {
+		t.Errorf("リリースが正しくありません: %d %+v", resp2.StatusCode, release)
+	}
+
+	resp3, err := http.Get(ts.URL + "/api/releases/v9.9")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	resp3.Body.Close()
+	if resp3.StatusCode != http.StatusNotFound {
+		t.Errorf("不明なリリース: got %d, want %d", resp3.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestHandleAPIRiskExposure(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+	if _, err := zeus.Add(ctx, "risk", "リスク",
+		core.WithRiskStatus(core.RiskStatusOccurred),
+		core.WithRiskDelayImpact(&core.RiskEstimate{Min: 3, Likely: 3, Max: 3})); err != nil {
+		t.Fatalf("Risk の追加に失敗: %v", err)
+	}
+
+	server := NewServer(zeus, 0)
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/api/risks/exposure?iterations=100")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	var result analysis.RiskExposure
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("JSON のデコードに失敗: %v", err)
+	}
+	if result.Iterations != 100 || result.Risks != 1 || result.Delay.Expected != 3 || result.Delay.P90 != 3 {
+		t.Errorf("リスク露出が正しくありません: %+v", result)
+	}
+
+	bad, err := http.Get(ts.URL + "/api/risks/exposure?iterations=abc")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	bad.Body.Close()
+	if bad.StatusCode != http.StatusBadRequest {
+		t.Errorf("不正な iterations: got %d, want %d", bad.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestHandleAPISettings(t *testing.T) {
+	zeus, _ := setupTestZeusWithActivity(t)
+	server := NewServer(zeus, 0)
+	handler := server.handler()
+
+	patch := func(ifMatch, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPatch, "/api/settings", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/settings", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", rec.Code, http.StatusOK)
+	}
+	var got core.ProjectSettings
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("レスポンスのデコードに失敗: %v", err)
+	}
+	etag := rec.Header().Get("ETag")
+	if got.Revision == "" || etag != `"`+got.Revision+`"` || got.ApprovalMode != "default" {
+		t.Fatalf("設定が正しくありません: etag=%q settings=%+v", etag, got)
+	}
+
+	// リビジョン未指定は 428、不正な値は 400
+	if rec := patch("", `{"approval_mode":"strict"}`); rec.Code != http.StatusPreconditionRequired {
+		t.Errorf("ステータスコードが正しくありません: got %d, want %d", rec.Code, http.StatusPreconditionRequired)
+	}
+	if rec := patch(etag, `{"approval_mode":"lenient"}`); rec.Code != http.StatusBadRequest {
+		t.Errorf("ステータスコードが正しくありません: got %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+
+	// 指定した項目のみ上書きする
+	rec = patch(etag, `{"approval_mode":"strict","operations":{"objective_delete":"approve"},"analysis":{"stale":{"completed_days":14}}}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d (%s)", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("レスポンスのデコードに失敗: %v", err)
+	}
+	if got.ApprovalMode != "strict" || got.AutomationLevel != "auto" || got.Operations["objective_delete"] != core.ApprovalApprove || got.Analysis.Stale.CompletedDays != 14 {
+		t.Errorf("更新後の設定が正しくありません: %+v", got)
+	}
+
+	// 古いリビジョンは 409
+	if rec := patch(etag, `{"approval_mode":"loose"}`); rec.Code != http.StatusConflict || rec.Header().Get("ETag") != `"`+got.Revision+`"` {
+		t.Errorf("ステータスコードが正しくありません: got %d, want %d", rec.Code, http.StatusConflict)
+	}
+
+	// 値が空の operations は削除（承認レベルが下がるため承認待ちの 202）
+	rec = patch(`"`+got.Revision+`"`, `{"operations":{"objective_delete":""}}`)
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d (%s)", rec.Code, http.StatusAccepted, rec.Body.String())
+	}
+	var queued EntityResponse
+	if err := json.NewDecoder(rec.Body).Decode(&queued); err != nil {
+		t.Fatalf("レスポンスのデコードに失敗: %v", err)
+	}
+	if queued.ApprovalID == "" || queued.Revision != got.Revision {
+		t.Fatalf("承認待ちのレスポンスが正しくありません: %+v", queued)
+	}
+	ctx := context.Background()
+	if current, _ := zeus.ProjectSettings(ctx); current.Operations["objective_delete"] != core.ApprovalApprove {
+		t.Fatalf("承認前に設定が変更されています: %+v", current.Operations)
+	}
+	if _, err := zeus.Approve(ctx, queued.ApprovalID); err != nil {
+		t.Fatalf("承認に失敗: %v", err)
+	}
+	current, err := zeus.ProjectSettings(ctx)
+	if err != nil {
+		t.Fatalf("設定の取得に失敗: %v", err)
+	}
+	if len(current.Operations) != 0 {
+		t.Errorf("operations が削除されていません: %+v", current.Operations)
+	}
+}
+
+func TestHandleAPIProblemAging(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+	config, err := zeus.LoadConfig(ctx)
+	if err != nil {
+		t.Fatalf("設定の読み込みに失敗: %v", err)
+	}
+	config.Escalation.Problems.SLADays = map[core.ProblemSeverity]int{core.ProblemSeverityHigh: 3}
+	if err := zeus.FileStore().WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("設定の書き込みに失敗: %v", err)
+	}
+	old, err := zeus.Add(ctx, "problem", "古い障害", core.WithProblemSeverity(core.ProblemSeverityHigh))
+	if err != nil {
+		t.Fatalf("Problem の追加に失敗: %v", err)
+	}
+	if _, err := zeus.Add(ctx, "problem", "新しい問題", core.WithProblemSeverity(core.ProblemSeverityLow)); err != nil {
+		t.Fatalf("Problem の追加に失敗: %v", err)
+	}
+	// 報告日を 10 日前にする
+	path := "problems/" + old.ID + ".yaml"
+	var prob core.ProblemEntity
+	if err := zeus.FileStore().ReadYaml(ctx, path, &prob); err != nil {
+		t.Fatalf("Problem の読み込みに失敗: %v", err)
+	}
+	prob.Metadata.CreatedAt = time.Now().AddDate(0, 0, -10).Format(time.RFC3339)
+	if err := zeus.FileStore().WriteYaml(ctx, path, &prob); err != nil {
+		t.Fatalf("Problem の書き込みに失敗: %v", err)
+	}
+
+	server := NewServer(zeus, 0)
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/api/problems/aging")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	var body ProblemAgingResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("JSON のデコードに失敗: %v", err)
+	}
+	if body.Total != 2 || body.Breached != 1 {
+		t.Fatalf("SLA 違反の集計が正しくありません: %+v", body)
+	}
+	first := body.Problems[0]
+	if first.ID != old.ID || !first.Breached || first.AgeDays != 10 || first.DaysOverdue != 7 {
+		t.Errorf("SLA 違反が先頭に並ぶべき: %+v", first)
+	}
+	if body.Problems[1].SLADays != nil || body.Problems[1].Breached {
+		t.Errorf("SLA の無い重大度は違反にならないべき: %+v", body.Problems[1])
+	}
+}
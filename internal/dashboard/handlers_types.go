@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"strings"
+
+	"github.com/biwakonbu/zeus/internal/diagram"
 )
 
 // =============================================================================
@@ -86,10 +88,20 @@ func riskScoreToSeverity(score string) string {
 	}
 }
 
-// escapeForMermaidDiagram は Mermaid 用にエスケープ
-func escapeForMermaidDiagram(s string) string {
-	s = strings.ReplaceAll(s, "\"", "'")
-	s = strings.ReplaceAll(s, "<", "&lt;")
-	s = strings.ReplaceAll(s, ">", "&gt;")
-	return s
+// diagramRenderer は ?engine= クエリから図の Renderer を返す
+// 未指定の場合は nil（レスポンスに diagram フィールドを含めない）、不正な値は ok=false
+func diagramRenderer(r *http.Request) (diagram.Renderer, bool) {
+	engine := r.URL.Query().Get("engine")
+	if engine == "" {
+		return nil, true
+	}
+	format, ok := diagram.ParseFormat(engine)
+	if !ok {
+		return nil, false
+	}
+	renderer, err := diagram.NewRenderer(format)
+	if err != nil {
+		return nil, false
+	}
+	return renderer, true
 }
@@ -1,10 +1,11 @@
 package dashboard
 
 import (
+	"errors"
 	"net/http"
-	"strings"
 
 	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/biwakonbu/zeus/internal/diagram"
 )
 
 // =============================================================================
@@ -92,6 +93,8 @@ type UseCaseDiagramResponse struct {
 	UseCases []UseCaseItem `json:"usecases"`
 	Boundary string        `json:"boundary"`
 	Mermaid  string        `json:"mermaid"`
+	Engine   string        `json:"engine,omitempty"`  // ?engine= 指定時のみ
+	Diagram  string        `json:"diagram,omitempty"` // ?engine= 指定時のみ
 }
 
 // SubsystemItem はサブシステム API のアイテム
@@ -136,6 +139,9 @@ type ActivityItem struct {
 	Status       string                   `json:"status"`
 	Nodes        []ActivityNodeItem       `json:"nodes"`
 	Transitions  []ActivityTransitionItem `json:"transitions"`
+	Score        *float64                 `json:"score,omitempty"` // 優先度スコア（method の評価値が無い場合は省略）
+	RICE         *core.RICEScore          `json:"rice,omitempty"`
+	WSJF         *core.WSJFScore          `json:"wsjf,omitempty"`
 	CreatedAt    string                   `json:"created_at"`
 	UpdatedAt    string                   `json:"updated_at"`
 }
@@ -150,6 +156,15 @@ type ActivitiesResponse struct {
 type ActivityDiagramResponse struct {
 	Activity *ActivityItem `json:"activity,omitempty"`
 	Mermaid  string        `json:"mermaid"`
+	Engine   string        `json:"engine,omitempty"`  // ?engine= 指定時のみ
+	Diagram  string        `json:"diagram,omitempty"` // ?engine= 指定時のみ
+}
+
+// ActivityDiagramSourceResponse はアクティビティ図ソース API のレスポンス
+type ActivityDiagramSourceResponse struct {
+	ID      string `json:"id"`
+	Format  string `json:"format"`
+	Diagram string `json:"diagram"`
 }
 
 // =============================================================================
@@ -272,36 +287,37 @@ func (s *Server) handleAPIUseCaseDiagram(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	renderer, ok := diagramRenderer(r)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "engine は mermaid または plantuml を指定してください")
+		return
+	}
+
 	ctx := r.Context()
 	fileStore := s.zeus.FileStore()
+	query := r.URL.Query()
 
 	// クエリパラメータからシステム境界名を取得
-	boundary := r.URL.Query().Get("boundary")
+	boundary := query.Get("boundary")
 	if boundary == "" {
 		boundary = "System"
 	}
 
+	group := query.Get("group")
+	if group != "" && group != "subsystem" {
+		writeError(w, http.StatusBadRequest, "group は subsystem を指定してください")
+		return
+	}
+
 	// アクターを取得
 	var actorsFile core.ActorsFile
 	if err := fileStore.ReadYaml(ctx, "actors.yaml", &actorsFile); err != nil {
 		actorsFile = core.ActorsFile{Actors: []core.ActorEntity{}}
 	}
 
-	actors := make([]ActorItem, len(actorsFile.Actors))
-	for i, a := range actorsFile.Actors {
-		actors[i] = ActorItem{
-			ID:          a.ID,
-			Title:       a.Title,
-			Type:        string(a.Type),
-			Description: a.Description,
-		}
-	}
-
 	// ユースケースを取得
 	files, _ := fileStore.ListDir(ctx, "usecases")
-	usecases := make([]UseCaseItem, 0)
 	ucEntities := make([]core.UseCaseEntity, 0)
-
 	for _, file := range files {
 		if !hasYamlSuffix(file) {
 			continue
@@ -310,9 +326,53 @@ func (s *Server) handleAPIUseCaseDiagram(w http.ResponseWriter, r *http.Request)
 		if err := fileStore.ReadYaml(ctx, "usecases/"+file, &uc); err != nil {
 			continue
 		}
-
 		ucEntities = append(ucEntities, uc)
+	}
 
+	// ユースケース図を生成
+	ucDiagram := diagram.UseCaseDiagram{
+		Actors:   actorsFile.Actors,
+		UseCases: ucEntities,
+		Boundary: boundary,
+	}
+
+	// ?subsystem= はそのサブシステムのユースケースだけ、?group=subsystem はサブシステムごとに描く
+	subsystemID := query.Get("subsystem")
+	if subsystemID != "" || group == "subsystem" {
+		var subsystemsFile core.SubsystemsFile
+		if err := fileStore.ReadYaml(ctx, "subsystems.yaml", &subsystemsFile); err != nil {
+			subsystemsFile = core.SubsystemsFile{Subsystems: []core.SubsystemEntity{}}
+		}
+		if subsystemID != "" {
+			found := false
+			for _, sub := range subsystemsFile.Subsystems {
+				if sub.ID == subsystemID {
+					ucDiagram = ucDiagram.ForSubsystem(sub)
+					found = true
+					break
+				}
+			}
+			if !found {
+				writeError(w, http.StatusNotFound, "サブシステムが見つかりません: "+subsystemID)
+				return
+			}
+		} else {
+			ucDiagram.Subsystems = subsystemsFile.Subsystems
+		}
+	}
+
+	actors := make([]ActorItem, len(ucDiagram.Actors))
+	for i, a := range ucDiagram.Actors {
+		actors[i] = ActorItem{
+			ID:          a.ID,
+			Title:       a.Title,
+			Type:        string(a.Type),
+			Description: a.Description,
+		}
+	}
+
+	usecases := make([]UseCaseItem, 0, len(ucDiagram.UseCases))
+	for _, uc := range ucDiagram.UseCases {
 		// アクター参照の変換
 		ucActors := make([]UseCaseActorRefItem, len(uc.Actors))
 		for j, ar := range uc.Actors {
@@ -349,14 +409,15 @@ func (s *Server) handleAPIUseCaseDiagram(w http.ResponseWriter, r *http.Request)
 		})
 	}
 
-	// Mermaid 形式でユースケース図を生成
-	mermaid := generateUseCaseMermaid(actorsFile.Actors, ucEntities, boundary)
-
 	response := UseCaseDiagramResponse{
 		Actors:   actors,
 		UseCases: usecases,
-		Boundary: boundary,
-		Mermaid:  mermaid,
+		Boundary: ucDiagram.Boundary,
+		Mermaid:  diagram.UseCaseMermaid(ucDiagram),
+	}
+	if renderer != nil {
+		response.Engine = string(renderer.Format())
+		response.Diagram = renderer.UseCase(ucDiagram)
 	}
 
 	writeJSON(w, http.StatusOK, response)
@@ -407,6 +468,12 @@ func (s *Server) handleAPIActivities(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	scoreQ, err := parseScoreQuery(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	ctx := r.Context()
 	fileStore := s.zeus.FileStore()
 
@@ -443,27 +510,28 @@ func (s *Server) handleAPIActivities(w http.ResponseWriter, r *http.Request) {
 	// ユースケースID→タイトルのマップを作成
 	usecaseTitles := make(map[string]string)
 	if len(usecaseIDs) > 0 {
-		ucFiles, err := fileStore.ListDir(ctx, "usecases")
-		if err == nil {
-			for _, ucFile := range ucFiles {
-				if !hasYamlSuffix(ucFile) {
-					continue
-				}
-				var uc core.UseCaseEntity
-				if err := fileStore.ReadYaml(ctx, "usecases/"+ucFile, &uc); err != nil {
-					continue
-				}
+		// タイトルのみ必要なため、インデックス経由の一覧を使用（本体はパースしない）
+		if ucList, err := s.zeus.List(ctx, "usecase"); err == nil {
+			for _, item := range ucList.Items {
 				// 使用されているIDのみマップに追加
-				if _, needed := usecaseIDs[uc.ID]; needed {
-					usecaseTitles[uc.ID] = uc.Title
+				if _, needed := usecaseIDs[item.ID]; needed {
+					usecaseTitles[item.ID] = item.Title
 				}
 			}
 		}
 	}
 
+	// 優先度スコアの算出（?sort=score の場合はスコアの高い順）
+	scorables := make([]core.Scorable, len(actEntities))
+	for i := range actEntities {
+		scorables[i] = &actEntities[i]
+	}
+	method := scoreQ.apply(scorables)
+
 	// ActivityItem に変換
 	activities := make([]ActivityItem, 0, len(actEntities))
-	for _, act := range actEntities {
+	for _, scorable := range scorables {
+		act := scorable.(*core.ActivityEntity)
 		// ノードの変換
 		nodes := make([]ActivityNodeItem, len(act.Nodes))
 		for j, n := range act.Nodes {
@@ -500,6 +568,9 @@ func (s *Server) handleAPIActivities(w http.ResponseWriter, r *http.Request) {
 			Status:       string(act.Status),
 			Nodes:        nodes,
 			Transitions:  transitions,
+			Score:        scoreOf(act, method),
+			RICE:         act.RICE,
+			WSJF:         act.WSJF,
 			CreatedAt:    act.Metadata.CreatedAt,
 			UpdatedAt:    act.Metadata.UpdatedAt,
 		})
@@ -520,6 +591,12 @@ func (s *Server) handleAPIActivityDiagram(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	renderer, ok := diagramRenderer(r)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "engine は mermaid または plantuml を指定してください")
+		return
+	}
+
 	ctx := r.Context()
 	fileStore := s.zeus.FileStore()
 
@@ -583,11 +660,62 @@ func (s *Server) handleAPIActivityDiagram(w http.ResponseWriter, r *http.Request
 	}
 
 	response.Activity = activityItem
-	response.Mermaid = generateActivityMermaid(&act)
+	response.Mermaid = diagram.ActivityMermaid(&act)
+	if renderer != nil {
+		response.Engine = string(renderer.Format())
+		response.Diagram = renderer.Activity(&act)
+	}
 
 	writeJSON(w, http.StatusOK, response)
 }
 
+// handleAPIActivityDiagramSource は /api/activities/{id}/diagram を処理
+// engine（または format）クエリで mermaid（デフォルト）または plantuml を指定する
+func (s *Server) handleAPIActivityDiagramSource(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+
+	engine := r.URL.Query().Get("engine")
+	if engine == "" {
+		engine = r.URL.Query().Get("format")
+	}
+	format, ok := diagram.ParseFormat(engine)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "format は mermaid または plantuml を指定してください")
+		return
+	}
+
+	activityID := r.PathValue("id")
+	entity, err := s.zeus.Get(r.Context(), "activity", activityID)
+	if err != nil {
+		if errors.Is(err, core.ErrEntityNotFound) {
+			writeError(w, http.StatusNotFound, "アクティビティが見つかりません: "+activityID)
+			return
+		}
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	act, ok := entity.(*core.ActivityEntity)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, "アクティビティの読み込みに失敗しました")
+		return
+	}
+
+	source, err := diagram.Activity(act, format)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, ActivityDiagramSourceResponse{
+		ID:      act.ID,
+		Format:  string(format),
+		Diagram: source,
+	})
+}
+
 // =============================================================================
 // UML ヘルパー関数
 // =============================================================================
@@ -638,139 +766,3 @@ func convertUseCaseScenario(scenario *core.UseCaseScenario) *UseCaseScenarioItem
 		Postconditions:   scenario.Postconditions,
 	}
 }
-
-// generateUseCaseMermaid は Mermaid 形式でユースケース図を生成
-func generateUseCaseMermaid(actors []core.ActorEntity, usecases []core.UseCaseEntity, boundary string) string {
-	var sb strings.Builder
-
-	sb.WriteString("flowchart LR\n")
-
-	// アクター定義
-	sb.WriteString("    %% Actors\n")
-	for _, actor := range actors {
-		mermaidID := strings.ReplaceAll(actor.ID, "-", "_")
-		typeEmoji := actorTypeEmoji(actor.Type)
-		sb.WriteString("    " + mermaidID + "[" + typeEmoji + " " + escapeForMermaidDiagram(actor.Title) + "]\n")
-	}
-
-	// システム境界サブグラフ
-	sb.WriteString("\n    subgraph boundary[" + escapeForMermaidDiagram(boundary) + "]\n")
-
-	// ユースケース定義
-	sb.WriteString("        %% UseCases\n")
-	for _, uc := range usecases {
-		mermaidID := strings.ReplaceAll(uc.ID, "-", "_")
-		sb.WriteString("        " + mermaidID + "((" + escapeForMermaidDiagram(uc.Title) + "))\n")
-	}
-
-	sb.WriteString("    end\n")
-
-	// アクターとユースケースの関連
-	sb.WriteString("\n    %% Actor-UseCase Relations\n")
-	for _, uc := range usecases {
-		ucID := strings.ReplaceAll(uc.ID, "-", "_")
-		for _, actorRef := range uc.Actors {
-			actorID := strings.ReplaceAll(actorRef.ActorID, "-", "_")
-			if actorRef.Role == core.ActorRolePrimary {
-				sb.WriteString("    " + actorID + " ==> " + ucID + "\n")
-			} else {
-				sb.WriteString("    " + actorID + " --> " + ucID + "\n")
-			}
-		}
-	}
-
-	// ユースケース間のリレーション
-	sb.WriteString("\n    %% UseCase Relations\n")
-	for _, uc := range usecases {
-		ucID := strings.ReplaceAll(uc.ID, "-", "_")
-		for _, rel := range uc.Relations {
-			targetID := strings.ReplaceAll(rel.TargetID, "-", "_")
-			switch rel.Type {
-			case core.RelationTypeInclude:
-				sb.WriteString("    " + ucID + " -.->|include| " + targetID + "\n")
-			case core.RelationTypeExtend:
-				label := "extend"
-				if rel.Condition != "" {
-					label = "extend [" + rel.Condition + "]"
-				}
-				sb.WriteString("    " + targetID + " -.->|" + escapeForMermaidDiagram(label) + "| " + ucID + "\n")
-			case core.RelationTypeGeneralize:
-				sb.WriteString("    " + ucID + " -->|generalize| " + targetID + "\n")
-			}
-		}
-	}
-
-	return sb.String()
-}
-
-// actorTypeEmoji はアクタータイプの絵文字を返す
-func actorTypeEmoji(t core.ActorType) string {
-	switch t {
-	case core.ActorTypeHuman:
-		return "👤"
-	case core.ActorTypeSystem:
-		return "🖥️"
-	case core.ActorTypeTime:
-		return "⏰"
-	case core.ActorTypeDevice:
-		return "📱"
-	case core.ActorTypeExternal:
-		return "🌐"
-	default:
-		return "❓"
-	}
-}
-
-// generateActivityMermaid は Mermaid 形式でアクティビティ図を生成
-func generateActivityMermaid(act *core.ActivityEntity) string {
-	var sb strings.Builder
-
-	sb.WriteString("flowchart TD\n")
-	sb.WriteString("    %% " + escapeForMermaidDiagram(act.Title) + "\n\n")
-
-	// ノード定義
-	sb.WriteString("    %% Nodes\n")
-	for _, node := range act.Nodes {
-		mermaidID := strings.ReplaceAll(node.ID, "-", "_")
-		label := node.Name
-		if label == "" {
-			label = string(node.Type)
-		}
-
-		switch node.Type {
-		case core.ActivityNodeTypeInitial:
-			// 開始ノード（黒丸）→ 円形
-			sb.WriteString("    " + mermaidID + "((●))\n")
-		case core.ActivityNodeTypeFinal:
-			// 終了ノード（二重丸）→ 二重円形
-			sb.WriteString("    " + mermaidID + "(((◉)))\n")
-		case core.ActivityNodeTypeAction:
-			// アクション（角丸四角形）
-			sb.WriteString("    " + mermaidID + "[" + escapeForMermaidDiagram(label) + "]\n")
-		case core.ActivityNodeTypeDecision, core.ActivityNodeTypeMerge:
-			// 分岐/合流（ひし形）
-			sb.WriteString("    " + mermaidID + "{" + escapeForMermaidDiagram(label) + "}\n")
-		case core.ActivityNodeTypeFork, core.ActivityNodeTypeJoin:
-			// 並列分岐/合流（太い横線）→ サブグラフで表現
-			sb.WriteString("    " + mermaidID + "[/━━━/]\n")
-		default:
-			sb.WriteString("    " + mermaidID + "[" + escapeForMermaidDiagram(label) + "]\n")
-		}
-	}
-
-	// 遷移定義
-	sb.WriteString("\n    %% Transitions\n")
-	for _, trans := range act.Transitions {
-		sourceID := strings.ReplaceAll(trans.Source, "-", "_")
-		targetID := strings.ReplaceAll(trans.Target, "-", "_")
-
-		if trans.Guard != "" {
-			// ガード条件付き
-			sb.WriteString("    " + sourceID + " -->|" + escapeForMermaidDiagram(trans.Guard) + "| " + targetID + "\n")
-		} else {
-			sb.WriteString("    " + sourceID + " --> " + targetID + "\n")
-		}
-	}
-
-	return sb.String()
-}
@@ -68,6 +68,10 @@ type UnifiedGraphResponse struct {
 	Cycles   [][]string              `json:"cycles"`
 	Isolated []string                `json:"isolated"`
 	Mermaid  string                  `json:"mermaid"`
+	// MermaidSummarized はノード数が max_nodes を超えたため Mermaid を要約表示にした場合に true
+	MermaidSummarized bool   `json:"mermaid_summarized,omitempty"`
+	Engine            string `json:"engine,omitempty"`  // ?engine= 指定時のみ
+	Diagram           string `json:"diagram,omitempty"` // ?engine= 指定時のみ
 
 	// フィルター情報
 	Filter *UnifiedGraphFilterInfo `json:"filter,omitempty"`
@@ -95,6 +99,18 @@ func (s *Server) handleAPIUnifiedGraph(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	renderer, ok := diagramRenderer(r)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "engine は mermaid または plantuml を指定してください")
+		return
+	}
+
+	maxNodes, ok := s.mermaidMaxNodes(r)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "max_nodes は 0 以上の整数を指定してください")
+		return
+	}
+
 	ctx := r.Context()
 
 	// クエリパラメータからフィルターを構築
@@ -109,6 +125,15 @@ func (s *Server) handleAPIUnifiedGraph(w http.ResponseWriter, r *http.Request) {
 
 	// レスポンスを構築
 	response := convertUnifiedGraphToResponse(graph, filter)
+	// フィルター条件ごとに図が異なるため、クエリ全体をキーに含める
+	key := "unified|" + strconv.Itoa(maxNodes) + "|" + r.URL.RawQuery
+	response.Mermaid, response.MermaidSummarized = s.mermaid.get(s.storeVersion(ctx), key, func() (string, bool) {
+		return graph.ToMermaidLimited(maxNodes)
+	})
+	if renderer != nil {
+		response.Engine = string(renderer.Format())
+		response.Diagram = renderer.UnifiedGraph(graph)
+	}
 
 	writeJSON(w, http.StatusOK, response)
 }
@@ -381,7 +406,6 @@ func convertUnifiedGraphToResponse(graph *analysis.UnifiedGraph, filter *analysi
 		Stats:    stats,
 		Cycles:   cycles,
 		Isolated: isolated,
-		Mermaid:  graph.ToMermaid(),
 		Filter:   filterInfo,
 	}
 }
@@ -0,0 +1,58 @@
+package dashboard
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// =============================================================================
+// Version API 型定義
+// =============================================================================
+
+// VersionResponse はバージョン API のレスポンス
+type VersionResponse struct {
+	Version       string `json:"version"`        // バイナリのバージョン
+	SchemaVersion string `json:"schema_version"` // バイナリが扱うデータスキーマのバージョン
+	DataVersion   string `json:"data_version"`   // zeus.yaml に記録されたデータスキーマのバージョン
+}
+
+// =============================================================================
+// Version API ハンドラー
+// =============================================================================
+
+// SetVersion はバイナリのバージョンを設定
+func (s *Server) SetVersion(version string) {
+	s.version = version
+}
+
+// handleAPIVersion はバージョン API を処理
+// フロントエンドは version の変化を検知して古いバンドルを再読み込みできる
+func (s *Server) handleAPIVersion(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+
+	response := VersionResponse{
+		Version:       s.version,
+		SchemaVersion: core.SchemaVersion,
+	}
+	if config, err := s.zeus.LoadConfig(r.Context()); err == nil {
+		response.DataVersion = config.Version
+	}
+
+	w.Header().Set("Cache-Control", "no-cache")
+	writeJSON(w, http.StatusOK, response)
+}
+
+// assetCacheControl は静的ファイルのパスに応じた Cache-Control を返す
+// SvelteKit のビルド成果物のうち _app/immutable/ 配下はファイル名にコンテンツハッシュを含むため
+// 長期キャッシュし、それ以外（index.html 等）は毎回再検証させる
+func assetCacheControl(path string) string {
+	if strings.HasPrefix(path, "/_app/immutable/") {
+		return "public, max-age=31536000, immutable"
+	}
+	return "no-cache"
+}
@@ -0,0 +1,57 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+func TestHandleAPIVersion(t *testing.T) {
+	zeus := setupTestZeus(t)
+	server := NewServer(zeus, 0)
+	server.SetVersion("1.2.3")
+
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/api/version")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	var result VersionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("JSON のデコードに失敗: %v", err)
+	}
+	if result.Version != "1.2.3" {
+		t.Errorf("version が正しくありません: got %s, want 1.2.3", result.Version)
+	}
+	if result.SchemaVersion != core.SchemaVersion {
+		t.Errorf("schema_version が正しくありません: got %s, want %s", result.SchemaVersion, core.SchemaVersion)
+	}
+	if result.DataVersion != core.SchemaVersion {
+		t.Errorf("data_version が正しくありません: got %s, want %s", result.DataVersion, core.SchemaVersion)
+	}
+}
+
+func TestAssetCacheControl(t *testing.T) {
+	tests := map[string]string{
+		"/_app/immutable/chunks/app.abc123.js": "public, max-age=31536000, immutable",
+		"/index.html":                          "no-cache",
+		"/_app/version.json":                   "no-cache",
+		"/favicon.png":                         "no-cache",
+	}
+	for path, want := range tests {
+		if got := assetCacheControl(path); got != want {
+			t.Errorf("assetCacheControl(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
@@ -27,18 +27,37 @@ type VisionResponse struct {
 	Vision *VisionItem `json:"vision"`
 }
 
+// VisionRevisionItem は Vision 履歴 API の版
+type VisionRevisionItem struct {
+	Version   int         `json:"version"`
+	ChangedAt string      `json:"changed_at"`
+	ChangedBy string      `json:"changed_by,omitempty"`
+	Rationale string      `json:"rationale,omitempty"`
+	Vision    *VisionItem `json:"vision"`
+	Diff      string      `json:"diff,omitempty"` // 1 つ前の版からの差分（unified diff）
+}
+
+// VisionHistoryResponse は Vision 履歴 API のレスポンス
+type VisionHistoryResponse struct {
+	Revisions []VisionRevisionItem `json:"revisions"`
+	Total     int                  `json:"total"`
+}
+
 // ObjectiveItem は Objective API のアイテム
 type ObjectiveItem struct {
-	ID           string   `json:"id"`
-	Title        string   `json:"title"`
-	Description  string   `json:"description,omitempty"`
-	Goals        []string `json:"goals,omitempty"`
-	Status       string   `json:"status"`
-	Owner        string   `json:"owner,omitempty"`
-	Tags         []string `json:"tags,omitempty"`
-	UseCaseCount int      `json:"usecase_count"`
-	CreatedAt    string   `json:"created_at"`
-	UpdatedAt    string   `json:"updated_at"`
+	ID           string          `json:"id"`
+	Title        string          `json:"title"`
+	Description  string          `json:"description,omitempty"`
+	Goals        []string        `json:"goals,omitempty"`
+	Status       string          `json:"status"`
+	Owner        string          `json:"owner,omitempty"`
+	Tags         []string        `json:"tags,omitempty"`
+	UseCaseCount int             `json:"usecase_count"`
+	Score        *float64        `json:"score,omitempty"` // 優先度スコア（method の評価値が無い場合は省略）
+	RICE         *core.RICEScore `json:"rice,omitempty"`
+	WSJF         *core.WSJFScore `json:"wsjf,omitempty"`
+	CreatedAt    string          `json:"created_at"`
+	UpdatedAt    string          `json:"updated_at"`
 }
 
 // ObjectivesResponse は Objective 一覧 API のレスポンス
@@ -74,23 +93,62 @@ func (s *Server) handleAPIVision(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response := VisionResponse{
-		Vision: &VisionItem{
-			ID:              vision.ID,
-			Title:           vision.Title,
-			Statement:       vision.Statement,
-			SuccessCriteria: vision.SuccessCriteria,
-			Status:          string(vision.Status),
-			CreatedAt:       vision.Metadata.CreatedAt,
-			UpdatedAt:       vision.Metadata.UpdatedAt,
-		},
+	writeJSON(w, http.StatusOK, VisionResponse{Vision: toVisionItem(&vision)})
+}
+
+// handleAPIVisionHistory は Vision の版の履歴 API を処理（古い順、各版に 1 つ前の版からの差分を付与）
+func (s *Server) handleAPIVisionHistory(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
 	}
 
-	if response.Vision.SuccessCriteria == nil {
-		response.Vision.SuccessCriteria = []string{}
+	ctx := r.Context()
+	revisions, err := s.zeus.VisionHistory(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "Vision の履歴の取得に失敗: "+err.Error())
+		return
 	}
 
-	writeJSON(w, http.StatusOK, response)
+	items := make([]VisionRevisionItem, 0, len(revisions))
+	for i := range revisions {
+		rev := &revisions[i]
+		item := VisionRevisionItem{
+			Version:   rev.Version,
+			ChangedAt: rev.ChangedAt,
+			ChangedBy: rev.ChangedBy,
+			Rationale: rev.Rationale,
+			Vision:    toVisionItem(&rev.Vision),
+		}
+		if i > 0 {
+			diff, err := s.zeus.VisionDiff(ctx, revisions[i-1].Version, rev.Version)
+			if err != nil {
+				writeError(w, http.StatusInternalServerError, "Vision の差分の取得に失敗: "+err.Error())
+				return
+			}
+			item.Diff = diff
+		}
+		items = append(items, item)
+	}
+
+	writeJSON(w, http.StatusOK, VisionHistoryResponse{Revisions: items, Total: len(items)})
+}
+
+// toVisionItem は Vision を API のアイテムに変換
+func toVisionItem(vision *core.Vision) *VisionItem {
+	item := &VisionItem{
+		ID:              vision.ID,
+		Title:           vision.Title,
+		Statement:       vision.Statement,
+		SuccessCriteria: vision.SuccessCriteria,
+		Status:          string(vision.Status),
+		CreatedAt:       vision.Metadata.CreatedAt,
+		UpdatedAt:       vision.Metadata.UpdatedAt,
+	}
+	if item.SuccessCriteria == nil {
+		item.SuccessCriteria = []string{}
+	}
+	return item
 }
 
 // handleAPIObjectives は Objective 一覧 API を処理
@@ -100,6 +158,12 @@ func (s *Server) handleAPIObjectives(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	scoreQ, err := parseScoreQuery(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	ctx := r.Context()
 	fileStore := s.zeus.FileStore()
 
@@ -145,9 +209,17 @@ func (s *Server) handleAPIObjectives(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// 優先度スコアの算出（?sort=score の場合はスコアの高い順）
+	scorables := make([]core.Scorable, len(objEntities))
+	for i := range objEntities {
+		scorables[i] = &objEntities[i]
+	}
+	method := scoreQ.apply(scorables)
+
 	// ObjectiveItem に変換
 	objectives := make([]ObjectiveItem, 0, len(objEntities))
-	for _, obj := range objEntities {
+	for _, scorable := range scorables {
+		obj := scorable.(*core.ObjectiveEntity)
 		item := ObjectiveItem{
 			ID:           obj.ID,
 			Title:        obj.Title,
@@ -157,6 +229,9 @@ func (s *Server) handleAPIObjectives(w http.ResponseWriter, r *http.Request) {
 			Owner:        obj.Owner,
 			Tags:         obj.Tags,
 			UseCaseCount: usecaseCounts[obj.ID],
+			Score:        scoreOf(obj, method),
+			RICE:         obj.RICE,
+			WSJF:         obj.WSJF,
 			CreatedAt:    obj.Metadata.CreatedAt,
 			UpdatedAt:    obj.Metadata.UpdatedAt,
 		}
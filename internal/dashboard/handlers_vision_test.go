@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/biwakonbu/zeus/internal/core"
@@ -376,3 +377,113 @@ func TestHandleAPIObjectives_MinimalFields(t *testing.T) {
 		t.Error("CreatedAt が空です")
 	}
 }
+
+// TestHandleAPIVisionHistory は Vision の版の履歴と差分のテスト
+func TestHandleAPIVisionHistory(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+
+	if _, err := zeus.Add(ctx, "vision", "テストビジョン", core.WithVisionStatement("旧ステートメント")); err != nil {
+		t.Fatalf("Vision 追加に失敗: %v", err)
+	}
+	if _, err := zeus.Add(ctx, "vision", "テストビジョン",
+		core.WithVisionStatement("新ステートメント"), core.WithVisionRationale("方針変更")); err != nil {
+		t.Fatalf("Vision 更新に失敗: %v", err)
+	}
+
+	server := NewServer(zeus, 0)
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/api/vision/history")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	var result VisionHistoryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("JSON デコードに失敗: %v", err)
+	}
+
+	if result.Total != 2 || len(result.Revisions) != 2 {
+		t.Fatalf("版の数が正しくありません: %+v", result)
+	}
+	if result.Revisions[0].Diff != "" {
+		t.Errorf("最初の版に差分があってはいけません: %q", result.Revisions[0].Diff)
+	}
+	latest := result.Revisions[1]
+	if latest.Rationale != "方針変更" || latest.Vision.Statement != "新ステートメント" {
+		t.Errorf("最新の版が正しくありません: %+v", latest)
+	}
+	if !strings.Contains(latest.Diff, "+statement: 新ステートメント") {
+		t.Errorf("差分に変更が含まれていません: %q", latest.Diff)
+	}
+}
+
+// TestHandleAPIObjectives_SortByScore は ?sort=score による優先度スコア順のテスト
+func TestHandleAPIObjectives_SortByScore(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+
+	for _, obj := range []struct {
+		title string
+		wsjf  *core.WSJFScore
+	}{
+		{"低", &core.WSJFScore{BusinessValue: 1, TimeCriticality: 1, RiskReduction: 1, JobSize: 3}},
+		{"未評価", nil},
+		{"高", &core.WSJFScore{BusinessValue: 8, TimeCriticality: 5, RiskReduction: 3, JobSize: 2}},
+	} {
+		var opts []core.EntityOption
+		if obj.wsjf != nil {
+			opts = append(opts, core.WithWSJFScore(obj.wsjf))
+		}
+		if _, err := zeus.Add(ctx, "objective", obj.title, opts...); err != nil {
+			t.Fatalf("Objective 追加に失敗: %v", err)
+		}
+	}
+
+	server := NewServer(zeus, 0)
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/api/objectives?sort=score")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var result ObjectivesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("JSON デコードに失敗: %v", err)
+	}
+	if len(result.Objectives) != 3 {
+		t.Fatalf("Objective の数が正しくありません: got %d", len(result.Objectives))
+	}
+	var titles []string
+	for _, obj := range result.Objectives {
+		titles = append(titles, obj.Title)
+	}
+	if titles[0] != "高" || titles[1] != "低" || titles[2] != "未評価" {
+		t.Errorf("スコアの高い順に並んでいません: %v", titles)
+	}
+	if score := result.Objectives[0].Score; score == nil || *score != 8 {
+		t.Errorf("スコアが正しくありません: %v", score)
+	}
+	if result.Objectives[2].Score != nil {
+		t.Error("未評価の Objective にスコアがあってはいけません")
+	}
+
+	bad, err := http.Get(ts.URL + "/api/objectives?sort=score&method=moscow")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	bad.Body.Close()
+	if bad.StatusCode != http.StatusBadRequest {
+		t.Errorf("不明な方式は 400 であるべきです: got %d", bad.StatusCode)
+	}
+}
@@ -0,0 +1,109 @@
+package dashboard
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// mermaidCacheMaxEntries は同じデータ版で保持する Mermaid 図の上限（超過時は全破棄）
+const mermaidCacheMaxEntries = 32
+
+// mermaidEntry はキャッシュされた Mermaid 図
+type mermaidEntry struct {
+	text       string
+	summarized bool
+}
+
+// mermaidCache は生成した Mermaid 図をストアの更新状態（データ版）ごとに保持する
+// 大規模プロジェクトでは図の生成自体が重いため、レスポンスキャッシュの TTL 切れや SSE 配信でも再生成しない。
+// データ版が変わった時点で全エントリを破棄する
+type mermaidCache struct {
+	mu      sync.Mutex
+	version string
+	entries map[string]mermaidEntry
+}
+
+// newMermaidCache は mermaidCache を作成
+func newMermaidCache() *mermaidCache {
+	return &mermaidCache{entries: make(map[string]mermaidEntry)}
+}
+
+// get はデータ版とキーに対応する図を返し、なければ build で生成して保存する
+func (c *mermaidCache) get(version, key string, build func() (string, bool)) (string, bool) {
+	c.mu.Lock()
+	if c.version == version {
+		if entry, ok := c.entries[key]; ok {
+			c.mu.Unlock()
+			return entry.text, entry.summarized
+		}
+	}
+	c.mu.Unlock()
+
+	// 生成中はロックを保持しない（同時に生成された場合は後勝ち）
+	text, summarized := build()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.version != version || len(c.entries) >= mermaidCacheMaxEntries {
+		c.version = version
+		c.entries = make(map[string]mermaidEntry)
+	}
+	c.entries[key] = mermaidEntry{text: text, summarized: summarized}
+	return text, summarized
+}
+
+// storeVersionKey はリクエストコンテキストに保存するデータ版のキー
+type storeVersionKey struct{}
+
+// withStoreVersion は cacheMiddleware で計算したデータ版をコンテキストに保存する
+func withStoreVersion(ctx context.Context, version string) context.Context {
+	return context.WithValue(ctx, storeVersionKey{}, version)
+}
+
+// storeVersion は現在のデータ版を返す（cacheMiddleware を経由していればその値を再利用する）
+func (s *Server) storeVersion(ctx context.Context) string {
+	if version, ok := ctx.Value(storeVersionKey{}).(string); ok {
+		return version
+	}
+	return core.StoreVersion(s.zeus.FileStore().BasePath())
+}
+
+// mermaidMaxNodes は Mermaid を要約表示に切り替えるノード数を返す（0 は要約しない）
+// ?max_nodes= が zeus.yaml の server.mermaid_max_nodes より優先される。不正な値の場合は false
+func (s *Server) mermaidMaxNodes(r *http.Request) (int, bool) {
+	value := r.URL.Query().Get("max_nodes")
+	if value == "" {
+		return s.defaultMermaidMaxNodes(), true
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	return n, true
+}
+
+// defaultMermaidMaxNodes は server.mermaid_max_nodes に基づく要約の閾値（未設定は既定値、負の値は 0 = 要約しない）
+func (s *Server) defaultMermaidMaxNodes() int {
+	switch n := s.settings.MermaidMaxNodes; {
+	case n < 0:
+		return 0
+	case n == 0:
+		return analysis.DefaultMermaidMaxNodes
+	default:
+		return n
+	}
+}
+
+// dependencyMermaid は依存関係グラフの Mermaid 図をデータ版ごとにキャッシュして返す
+// 絞り込み条件ごとに図が異なるため、クエリ（SSE 配信では空）をキーに含める
+func (s *Server) dependencyMermaid(ctx context.Context, graph *analysis.DependencyGraph, maxNodes int, query string) (string, bool) {
+	key := "dependency|" + strconv.Itoa(maxNodes) + "|" + query
+	return s.mermaid.get(s.storeVersion(ctx), key, func() (string, bool) {
+		return graph.ToMermaidLimited(maxNodes)
+	})
+}
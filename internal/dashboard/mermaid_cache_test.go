@@ -0,0 +1,100 @@
+package dashboard
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+func TestMermaidCache(t *testing.T) {
+	cache := newMermaidCache()
+	builds := 0
+	build := func() (string, bool) {
+		builds++
+		return "graph", true
+	}
+
+	cache.get("v1", "unified", build)
+	if text, summarized := cache.get("v1", "unified", build); text != "graph" || !summarized || builds != 1 {
+		t.Errorf("同じデータ版では再生成しないはずです: builds=%d", builds)
+	}
+	cache.get("v1", "dependency", build)
+	if builds != 2 {
+		t.Errorf("キーが異なれば生成するはずです: builds=%d", builds)
+	}
+	cache.get("v2", "unified", build)
+	if builds != 3 || len(cache.entries) != 1 {
+		t.Errorf("データ版が変わったら破棄するはずです: builds=%d entries=%d", builds, len(cache.entries))
+	}
+}
+
+func TestHandleAPIGraphMermaidMaxNodes(t *testing.T) {
+	zeus := setupTestZeusWithMultipleActivities(t)
+	server := NewServer(zeus, 0)
+
+	get := func(path string) GraphResponse {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		server.handleAPIGraph(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("ステータスコードが正しくありません: got %d, want %d", rec.Code, http.StatusOK)
+		}
+		var resp GraphResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("レスポンスのデコードに失敗: %v", err)
+		}
+		return resp
+	}
+
+	if resp := get("/api/graph"); resp.MermaidSummarized {
+		t.Error("既定の閾値以下では要約しないはずです")
+	}
+	summarized := get("/api/graph?max_nodes=1")
+	if !summarized.MermaidSummarized || summarized.Stats.TotalNodes != 3 {
+		t.Errorf("max_nodes を超えたら要約するはずです: %+v", summarized)
+	}
+	if again := get("/api/graph?max_nodes=1"); again.Mermaid != summarized.Mermaid {
+		t.Error("同じデータ版ではキャッシュした図を返すはずです")
+	}
+
+	// zeus.yaml の server.mermaid_max_nodes を閾値に使う
+	server.SetServerSettings(core.ServerSettings{MermaidMaxNodes: 2})
+	if resp := get("/api/graph"); !resp.MermaidSummarized {
+		t.Error("server.mermaid_max_nodes を超えたら要約するはずです")
+	}
+	if resp := get("/api/graph?max_nodes=0"); resp.MermaidSummarized {
+		t.Error("max_nodes=0 では要約しないはずです")
+	}
+
+	rec := httptest.NewRecorder()
+	server.handleAPIGraph(rec, httptest.NewRequest(http.MethodGet, "/api/graph?max_nodes=-1", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("不正な max_nodes は 400 のはずです: got %d", rec.Code)
+	}
+}
+
+func TestHandleAPIUnifiedGraphMermaidMaxNodes(t *testing.T) {
+	zeus := setupTestZeusWithMultipleActivities(t)
+	server := NewServer(zeus, 0)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/unified-graph?max_nodes=1", nil)
+	server.handleAPIUnifiedGraph(rec, req.WithContext(withStoreVersion(context.Background(), "v1")))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("ステータスコードが正しくありません: got %d, want %d", rec.Code, http.StatusOK)
+	}
+	var resp UnifiedGraphResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("レスポンスのデコードに失敗: %v", err)
+	}
+	if !resp.MermaidSummarized || len(resp.Nodes) != 3 {
+		t.Errorf("Mermaid のみ要約し、ノード一覧はそのまま返すはずです: summarized=%v nodes=%d", resp.MermaidSummarized, len(resp.Nodes))
+	}
+	if _, ok := server.mermaid.entries["unified|1|max_nodes=1"]; !ok || server.mermaid.version != "v1" {
+		t.Errorf("コンテキストのデータ版でキャッシュするはずです: version=%q entries=%v", server.mermaid.version, len(server.mermaid.entries))
+	}
+}
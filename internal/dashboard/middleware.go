@@ -0,0 +1,211 @@
+package dashboard
+
+import (
+	"crypto/subtle"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// rateLimitWindow はレート制限の集計単位
+const rateLimitWindow = time.Minute
+
+// maxRequestBodyBytes は状態を変更するリクエストのボディの上限
+const maxRequestBodyBytes = 1 << 20
+
+// SetServerSettings は zeus.yaml の server セクションを適用する
+// 未設定の場合は従来どおり（開発モードのみ CORS 全許可、認証・レート制限なし）
+func (s *Server) SetServerSettings(settings core.ServerSettings) {
+	s.settings = settings
+	s.limiter = newRateLimiter(settings.RateLimit, rateLimitWindow)
+}
+
+// apiMiddleware は /api/* に共通のミドルウェア（CORS → レート制限 → トークン認証）を適用
+func (s *Server) apiMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return s.corsMiddleware(s.rateLimitMiddleware(s.authMiddleware(next)))
+}
+
+// mutatingAPIMiddleware は状態を変更する /api/* に apiMiddleware と mutationMiddleware を適用
+func (s *Server) mutatingAPIMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return s.apiMiddleware(s.mutationMiddleware(next))
+}
+
+// mutationMiddleware は状態を変更するリクエスト（GET / HEAD / OPTIONS 以外）を検証するミドルウェア
+// 他のサイトのページからプリフライトなしで送れる単純リクエスト（text/plain の POST など）による
+// クロスサイトリクエストフォージェリを防ぐため、Content-Type: application/json を必須にし（415）、
+// 同一オリジンでも allowed_origins でもない Origin は拒否する（403）。ボディは maxRequestBodyBytes までに制限する
+func (s *Server) mutationMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			next(w, r)
+			return
+		}
+		if origin := r.Header.Get("Origin"); origin != "" && !sameOrigin(r, origin) {
+			if _, ok := s.allowedOrigin(origin); !ok {
+				writeError(w, http.StatusForbidden, "許可されていないオリジンからのリクエストです: "+origin)
+				return
+			}
+		}
+		if mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil || mediaType != "application/json" {
+			writeError(w, http.StatusUnsupportedMediaType, "Content-Type: application/json を指定してください")
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodyBytes)
+		next(w, r)
+	}
+}
+
+// sameOrigin は Origin がリクエスト先（Host ヘッダー）と同じホストか
+func sameOrigin(r *http.Request, origin string) bool {
+	u, err := url.Parse(origin)
+	return err == nil && u.Host != "" && strings.EqualFold(u.Host, r.Host)
+}
+
+// corsMiddleware は CORS ヘッダーを追加するミドルウェア
+// allowed_origins が設定されていればそれに従い、未設定なら開発モードのみ全オリジンを許可する
+func (s *Server) corsMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if allowOrigin, ok := s.allowedOrigin(r.Header.Get("Origin")); ok {
+			w.Header().Set("Access-Control-Allow-Origin", allowOrigin)
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PATCH, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, If-Match")
+			w.Header().Set("Access-Control-Expose-Headers", "ETag")
+			if allowOrigin != "*" {
+				w.Header().Add("Vary", "Origin")
+			}
+
+			if r.Method == http.MethodOptions {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+		}
+		next(w, r)
+	}
+}
+
+// allowedOrigin は Access-Control-Allow-Origin に設定する値を返す
+func (s *Server) allowedOrigin(origin string) (string, bool) {
+	if len(s.settings.AllowedOrigins) == 0 {
+		if s.devMode {
+			return "*", true
+		}
+		return "", false
+	}
+
+	for _, allowed := range s.settings.AllowedOrigins {
+		if allowed == "*" {
+			return "*", true
+		}
+		if origin != "" && strings.EqualFold(allowed, origin) {
+			return origin, true
+		}
+	}
+	return "", false
+}
+
+// authMiddleware は api_token が設定されている場合にトークンを検証するミドルウェア
+// Authorization: Bearer <token> ヘッダー、または EventSource 用に ?token= クエリを受け付ける
+func (s *Server) authMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.settings.APIToken == "" {
+			next(w, r)
+			return
+		}
+
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "" {
+			token = r.URL.Query().Get("token")
+		}
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.settings.APIToken)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="zeus"`)
+			writeError(w, http.StatusUnauthorized, "API トークンが無効です")
+			return
+		}
+		next(w, r)
+	}
+}
+
+// rateLimitMiddleware はクライアント単位のレート制限を行うミドルウェア
+func (s *Server) rateLimitMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.limiter == nil {
+			next(w, r)
+			return
+		}
+
+		if retryAfter, ok := s.limiter.allow(clientKey(r)); !ok {
+			w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())+1))
+			writeError(w, http.StatusTooManyRequests, "リクエストが多すぎます")
+			return
+		}
+		next(w, r)
+	}
+}
+
+// clientKey はレート制限の単位となるクライアントキー（リモートホスト）を返す
+func clientKey(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// rateLimiter は固定ウィンドウ方式のレートリミッター
+type rateLimiter struct {
+	mu      sync.Mutex
+	limit   int
+	window  time.Duration
+	clients map[string]*rateWindow
+}
+
+// rateWindow はクライアントごとのウィンドウ状態
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+// newRateLimiter は rateLimiter を作成（limit が 0 以下なら nil を返し制限なし）
+func newRateLimiter(limit int, window time.Duration) *rateLimiter {
+	if limit <= 0 {
+		return nil
+	}
+	return &rateLimiter{
+		limit:   limit,
+		window:  window,
+		clients: make(map[string]*rateWindow),
+	}
+}
+
+// allow はリクエストを許可するか判定し、拒否時は次のウィンドウまでの時間を返す
+func (l *rateLimiter) allow(key string) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	win, ok := l.clients[key]
+	if !ok || now.Sub(win.start) >= l.window {
+		// 期限切れのウィンドウを掃除してから新しいウィンドウを開始
+		for k, w := range l.clients {
+			if now.Sub(w.start) >= l.window {
+				delete(l.clients, k)
+			}
+		}
+		l.clients[key] = &rateWindow{start: now, count: 1}
+		return 0, true
+	}
+
+	if win.count >= l.limit {
+		return l.window - now.Sub(win.start), false
+	}
+	win.count++
+	return 0, true
+}
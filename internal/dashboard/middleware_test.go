@@ -0,0 +1,220 @@
+package dashboard
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+func TestCORSMiddleware_DevModeDefault(t *testing.T) {
+	zeus := setupTestZeus(t)
+	server := NewServerWithDevMode(zeus, 0, true)
+
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/api/status")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	resp.Body.Close()
+
+	if got := resp.Header.Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("Access-Control-Allow-Origin が正しくありません: got %q, want *", got)
+	}
+}
+
+func TestCORSMiddleware_AllowedOrigins(t *testing.T) {
+	zeus := setupTestZeus(t)
+	server := NewServer(zeus, 0)
+	server.SetServerSettings(core.ServerSettings{
+		AllowedOrigins: []string{"http://localhost:5173"},
+	})
+
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	tests := []struct {
+		origin string
+		want   string
+	}{
+		{"http://localhost:5173", "http://localhost:5173"},
+		{"http://evil.example.com", ""},
+	}
+	for _, tt := range tests {
+		req, _ := http.NewRequest(http.MethodGet, ts.URL+"/api/status", nil)
+		req.Header.Set("Origin", tt.origin)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("リクエストに失敗: %v", err)
+		}
+		resp.Body.Close()
+		if got := resp.Header.Get("Access-Control-Allow-Origin"); got != tt.want {
+			t.Errorf("Origin %s: got %q, want %q", tt.origin, got, tt.want)
+		}
+	}
+}
+
+func TestMutationMiddleware(t *testing.T) {
+	zeus, activityID := setupTestZeusWithActivity(t)
+	server := NewServer(zeus, 0)
+	server.SetServerSettings(core.ServerSettings{
+		AllowedOrigins: []string{"http://localhost:5173"},
+	})
+	handler := server.handler()
+	body := `{"ids":["` + activityID + `"],"status":"active"}`
+
+	tests := []struct {
+		name        string
+		contentType string
+		origin      string
+		body        string
+		want        int
+	}{
+		{"text/plain の単純リクエスト", "text/plain", "", body, http.StatusUnsupportedMediaType},
+		{"Content-Type なし", "", "", body, http.StatusUnsupportedMediaType},
+		{"許可されていないオリジン", "application/json", "http://evil.example.com", body, http.StatusForbidden},
+		{"text/plain かつ許可されていないオリジン", "text/plain", "http://evil.example.com", body, http.StatusForbidden},
+		{"上限を超えるボディ", "application/json", "", `{"ids":["` + strings.Repeat("a", maxRequestBodyBytes) + `"],"status":"active"}`, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/api/activities/bulk-status", strings.NewReader(tt.body))
+		if tt.contentType != "" {
+			req.Header.Set("Content-Type", tt.contentType)
+		}
+		if tt.origin != "" {
+			req.Header.Set("Origin", tt.origin)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != tt.want {
+			t.Errorf("%s: ステータスコードが正しくありません: got %d, want %d (%s)", tt.name, rec.Code, tt.want, rec.Body.String())
+		}
+	}
+
+	// 拒否されたリクエストでは状態が変わらない
+	entity, err := zeus.Get(context.Background(), "activity", activityID)
+	if err != nil {
+		t.Fatalf("Activity の取得に失敗: %v", err)
+	}
+	if status := entity.(*core.ActivityEntity).Status; status != core.ActivityStatusDraft {
+		t.Errorf("拒否されたリクエストで状態が変わっています: %s", status)
+	}
+
+	// 同一オリジン・許可されたオリジンからの JSON リクエストは通る
+	for _, origin := range []string{"", "http://example.com", "http://localhost:5173"} {
+		req := httptest.NewRequest(http.MethodPost, "/api/activities/bulk-status", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Errorf("Origin %q: ステータスコードが正しくありません: got %d, want %d (%s)", origin, rec.Code, http.StatusOK, rec.Body.String())
+		}
+	}
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	zeus := setupTestZeus(t)
+	server := NewServer(zeus, 0)
+	server.SetServerSettings(core.ServerSettings{APIToken: "secret"})
+
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	// トークンなし
+	resp, err := http.Get(ts.URL + "/api/status")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("トークンなし: got %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+
+	// Authorization ヘッダー
+	req, _ := http.NewRequest(http.MethodGet, ts.URL+"/api/status", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Bearer トークン: got %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	// クエリパラメータ（EventSource 用）
+	resp, err = http.Get(ts.URL + "/api/status?token=secret")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("クエリトークン: got %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	// ヘルスチェックは認証不要
+	resp, err = http.Get(ts.URL + "/healthz")
+	if err != nil {
+		t.Fatalf("リクエストに失敗: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("/healthz: got %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	zeus := setupTestZeus(t)
+	server := NewServer(zeus, 0)
+	server.SetServerSettings(core.ServerSettings{RateLimit: 2})
+
+	ts := httptest.NewServer(server.handler())
+	defer ts.Close()
+
+	codes := make([]int, 0, 3)
+	for i := 0; i < 3; i++ {
+		resp, err := http.Get(ts.URL + "/api/status")
+		if err != nil {
+			t.Fatalf("リクエストに失敗: %v", err)
+		}
+		resp.Body.Close()
+		codes = append(codes, resp.StatusCode)
+		if i == 2 && resp.Header.Get("Retry-After") == "" {
+			t.Error("Retry-After ヘッダーが設定されていません")
+		}
+	}
+
+	if codes[0] != http.StatusOK || codes[1] != http.StatusOK || codes[2] != http.StatusTooManyRequests {
+		t.Errorf("ステータスコード列が正しくありません: got %v", codes)
+	}
+}
+
+func TestRateLimiter_WindowReset(t *testing.T) {
+	limiter := newRateLimiter(1, 10*time.Millisecond)
+
+	if _, ok := limiter.allow("client"); !ok {
+		t.Fatal("最初のリクエストは許可されるべきです")
+	}
+	if _, ok := limiter.allow("client"); ok {
+		t.Error("上限超過のリクエストは拒否されるべきです")
+	}
+	time.Sleep(15 * time.Millisecond)
+	if _, ok := limiter.allow("client"); !ok {
+		t.Error("ウィンドウ経過後は許可されるべきです")
+	}
+}
+
+func TestNewRateLimiter_Disabled(t *testing.T) {
+	if newRateLimiter(0, time.Minute) != nil {
+		t.Error("limit 0 の場合は nil を返すべきです")
+	}
+}
@@ -0,0 +1,142 @@
+package dashboard
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// =============================================================================
+// Projects API 型定義
+// =============================================================================
+
+// ProjectsResponse はプロジェクト一覧 API のレスポンス
+type ProjectsResponse struct {
+	Projects []ProjectEntry `json:"projects"` // 登録順（先頭が既定のプロジェクト）
+	Total    int            `json:"total"`
+}
+
+// ProjectEntry はダッシュボードで切り替えられるプロジェクト
+type ProjectEntry struct {
+	Name        string `json:"name"` // ?project= に指定する名前
+	Path        string `json:"path"`
+	ProjectID   string `json:"project_id,omitempty"` // zeus.yaml の project.id
+	ProjectName string `json:"project_name,omitempty"`
+	Default     bool   `json:"default"`
+}
+
+// =============================================================================
+// 複数プロジェクト
+// =============================================================================
+
+// SetProjectName は既定のプロジェクトの名前を設定（?project= で指定する名前）
+func (s *Server) SetProjectName(name string) {
+	s.name = name
+}
+
+// projectName は ?project= で選択する名前（未設定の場合はプロジェクトのディレクトリ名）
+func (s *Server) projectName() string {
+	if s.name != "" || s.zeus == nil {
+		return s.name
+	}
+	path := s.zeus.ProjectPath
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	return filepath.Base(path)
+}
+
+// AddProject は切り替えて表示するプロジェクトを追加し、そのプロジェクトのサーバーを返す（Start 前に呼び出す）
+// 追加したプロジェクトの API は ?project=<name> で選択する。CORS・トークン・レート制限は既定のプロジェクトの設定を共有し、
+// SSE・キャッシュ・定期レポート（返したサーバーの SetReportSchedules で設定）・エスカレーションはプロジェクトごとに動作する
+func (s *Server) AddProject(name string, zeus *core.Zeus) (*Server, error) {
+	if name == "" || name == s.projectName() {
+		return nil, fmt.Errorf("プロジェクト名が不正または重複しています: %q", name)
+	}
+	for _, p := range s.projects {
+		if p.name == name {
+			return nil, fmt.Errorf("プロジェクト名が不正または重複しています: %q", name)
+		}
+	}
+	project := NewServerWithDevMode(zeus, s.port, s.devMode)
+	project.name = name
+	project.root = s
+	s.projects = append(s.projects, project)
+	return project, nil
+}
+
+// projectHandler は ?project= でプロジェクトを選択するハンドラー
+// 未指定・既定のプロジェクト名は mux、追加したプロジェクトはそのプロジェクトのハンドラー、不明な名前の /api/* は 404
+func (s *Server) projectHandler(mux http.Handler) http.Handler {
+	if len(s.projects) == 0 {
+		return mux
+	}
+	handlers := make(map[string]http.Handler, len(s.projects))
+	for _, p := range s.projects {
+		p.settings, p.limiter, p.version = s.settings, s.limiter, s.version
+		handlers[p.name] = p.handler()
+	}
+	unknown := s.apiMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "不明なプロジェクトです: "+r.URL.Query().Get("project"))
+	})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Query().Get("project")
+		if name == "" || name == s.projectName() || !strings.HasPrefix(r.URL.Path, "/api/") {
+			mux.ServeHTTP(w, r)
+			return
+		}
+		if h, ok := handlers[name]; ok {
+			h.ServeHTTP(w, r)
+			return
+		}
+		unknown(w, r)
+	})
+}
+
+// handleAPIProjects はダッシュボードで切り替えられるプロジェクトの一覧を返す
+func (s *Server) handleAPIProjects(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+	root := s
+	if s.root != nil {
+		root = s.root
+	}
+	response := ProjectsResponse{Projects: []ProjectEntry{}}
+	for _, p := range append([]*Server{root}, root.projects...) {
+		entry := ProjectEntry{Name: p.projectName(), Path: p.zeus.ProjectPath, Default: p == root}
+		if config, err := p.zeus.LoadConfig(r.Context()); err == nil {
+			entry.ProjectID, entry.ProjectName = config.Project.ID, config.Project.Name
+		}
+		response.Projects = append(response.Projects, entry)
+	}
+	response.Total = len(response.Projects)
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleAPIPortfolio は全プロジェクトの健全性・完了見込み・Top Risks・ボトルネックを集約したポートフォリオを返す
+// 複数プロジェクトを跨ぐため、プロジェクトごとのキャッシュは使わない
+func (s *Server) handleAPIPortfolio(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET メソッドのみ許可されています")
+		return
+	}
+	root := s
+	if s.root != nil {
+		root = s.root
+	}
+	var members []core.PortfolioMember
+	for _, p := range append([]*Server{root}, root.projects...) {
+		members = append(members, core.PortfolioMember{Name: p.projectName(), Zeus: p.zeus})
+	}
+	portfolio, err := core.BuildPortfolio(r.Context(), members)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "ポートフォリオの生成エラー: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, portfolio)
+}
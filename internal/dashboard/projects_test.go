@@ -0,0 +1,103 @@
+package dashboard
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+func TestProjectSwitching(t *testing.T) {
+	zeus, activityID := setupTestZeusWithActivity(t)
+	other := core.New(t.TempDir())
+	ctx := context.Background()
+	if _, err := other.Init(ctx); err != nil {
+		t.Fatalf("Zeus の初期化に失敗: %v", err)
+	}
+	if _, err := other.Add(ctx, "activity", "Other 1"); err != nil {
+		t.Fatalf("Activity の追加に失敗: %v", err)
+	}
+	if _, err := other.Add(ctx, "activity", "Other 2"); err != nil {
+		t.Fatalf("Activity の追加に失敗: %v", err)
+	}
+
+	server := NewServer(zeus, 0)
+	server.SetProjectName("main")
+	if _, err := server.AddProject("other", other); err != nil {
+		t.Fatalf("AddProject に失敗: %v", err)
+	}
+	if _, err := server.AddProject("main", other); err == nil {
+		t.Error("既定のプロジェクトと同じ名前は追加できないべき")
+	}
+	handler := server.handler()
+
+	get := func(path string) *httptest.ResponseRecorder {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+	total := func(path string) int {
+		t.Helper()
+		rec := get(path)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: ステータスコードが正しくありません: got %d, want %d", path, rec.Code, http.StatusOK)
+		}
+		var body struct {
+			Total int `json:"total"`
+		}
+		if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+			t.Fatalf("レスポンスのデコードに失敗: %v", err)
+		}
+		return body.Total
+	}
+
+	if n := total("/api/activities"); n != 1 {
+		t.Errorf("既定のプロジェクトの Activity 数 = %d, want 1", n)
+	}
+	if n := total("/api/activities?project=main"); n != 1 {
+		t.Errorf("main の Activity 数 = %d, want 1", n)
+	}
+	if n := total("/api/activities?project=other"); n != 2 {
+		t.Errorf("other の Activity 数 = %d, want 2", n)
+	}
+	if rec := get("/api/entities/activity/" + activityID + "?project=other"); rec.Code != http.StatusNotFound {
+		t.Errorf("他のプロジェクトのエンティティは 404: got %d", rec.Code)
+	}
+	if rec := get("/api/activities?project=unknown"); rec.Code != http.StatusNotFound {
+		t.Errorf("不明なプロジェクトは 404: got %d", rec.Code)
+	}
+
+	var projects ProjectsResponse
+	if err := json.NewDecoder(get("/api/projects?project=other").Body).Decode(&projects); err != nil {
+		t.Fatalf("レスポンスのデコードに失敗: %v", err)
+	}
+	if projects.Total != 2 || projects.Projects[0].Name != "main" || !projects.Projects[0].Default ||
+		projects.Projects[1].Name != "other" || projects.Projects[1].Default || projects.Projects[1].ProjectID == "" {
+		t.Errorf("プロジェクト一覧が正しくありません: %+v", projects)
+	}
+
+	// ポートフォリオはどのプロジェクトを選択していても全プロジェクトを集約する
+	for _, path := range []string{"/api/portfolio", "/api/portfolio?project=other"} {
+		rec := get(path)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: ステータスコードが正しくありません: got %d, want %d", path, rec.Code, http.StatusOK)
+		}
+		var portfolio core.Portfolio
+		if err := json.NewDecoder(rec.Body).Decode(&portfolio); err != nil {
+			t.Fatalf("レスポンスのデコードに失敗: %v", err)
+		}
+		if len(portfolio.Projects) != 2 || portfolio.Projects[0].Name != "main" || portfolio.Projects[1].Name != "other" ||
+			portfolio.Totals.Activities != 3 {
+			t.Errorf("%s: ポートフォリオが正しくありません: %+v", path, portfolio)
+		}
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/portfolio", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST は 405: got %d", rec.Code)
+	}
+}
@@ -0,0 +1,98 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/core"
+	"github.com/biwakonbu/zeus/internal/schedule"
+)
+
+// ReportEvent は定期レポート生成時に SSE で配信するデータ
+type ReportEvent struct {
+	Name  string `json:"name"`
+	Path  string `json:"path,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// scheduledReport は cron 式を解析済みの定期レポート設定
+type scheduledReport struct {
+	config core.ReportSchedule
+	cron   *schedule.Cron
+}
+
+// reportScheduler はダッシュボード起動中に定期レポートを生成する
+type reportScheduler struct {
+	zeus    *core.Zeus
+	reports []scheduledReport
+	notify  func(ReportEvent)
+}
+
+// newReportScheduler は設定を検証して reportScheduler を作成
+func newReportScheduler(zeus *core.Zeus, schedules []core.ReportSchedule, notify func(ReportEvent)) (*reportScheduler, error) {
+	reports := make([]scheduledReport, 0, len(schedules))
+	for _, s := range schedules {
+		if err := s.Validate(); err != nil {
+			return nil, err
+		}
+		cron, err := schedule.Parse(s.Cron)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, scheduledReport{config: s, cron: cron})
+	}
+	return &reportScheduler{zeus: zeus, reports: reports, notify: notify}, nil
+}
+
+// run は毎分の境界でスケジュールを評価する（ctx のキャンセルで停止）
+func (rs *reportScheduler) run(ctx context.Context) {
+	for {
+		now := time.Now()
+		next := now.Truncate(time.Minute).Add(time.Minute)
+		timer := time.NewTimer(next.Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case t := <-timer.C:
+			rs.runDue(ctx, t.Truncate(time.Minute))
+		}
+	}
+}
+
+// runDue は now に一致するスケジュールのレポートを生成し、生成結果を返す
+func (rs *reportScheduler) runDue(ctx context.Context, now time.Time) []ReportEvent {
+	events := []ReportEvent{}
+	for _, r := range rs.reports {
+		if !r.cron.Matches(now) {
+			continue
+		}
+		event := ReportEvent{Name: r.config.Name}
+		path, err := rs.zeus.WriteScheduledReport(ctx, r.config, now)
+		// メール送信のみ失敗した場合はパスとエラーの両方を通知する
+		event.Path = path
+		if err != nil {
+			event.Error = err.Error()
+		}
+		if rs.notify != nil {
+			rs.notify(event)
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+// SetReportSchedules は定期レポートを設定（Start 前に呼び出す）
+func (s *Server) SetReportSchedules(schedules []core.ReportSchedule) error {
+	if len(schedules) == 0 {
+		s.scheduler = nil
+		return nil
+	}
+	scheduler, err := newReportScheduler(s.zeus, schedules, s.broadcaster.BroadcastReport)
+	if err != nil {
+		return fmt.Errorf("定期レポート設定エラー: %w", err)
+	}
+	s.scheduler = scheduler
+	return nil
+}
@@ -0,0 +1,75 @@
+package dashboard
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+func TestReportScheduler_RunDue(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+
+	var notified []ReportEvent
+	scheduler, err := newReportScheduler(zeus, []core.ReportSchedule{
+		{Name: "weekly", Cron: "0 9 * * 1", Format: "markdown"},
+		{Name: "daily", Cron: "@daily", Format: "html", Output: "reports/daily"},
+	}, func(e ReportEvent) { notified = append(notified, e) })
+	if err != nil {
+		t.Fatalf("スケジューラーの作成に失敗: %v", err)
+	}
+
+	// 2026-03-09 09:00 は月曜日（weekly のみ一致）
+	monday := time.Date(2026, 3, 9, 9, 0, 0, 0, time.Local)
+	events := scheduler.runDue(ctx, monday)
+	if len(events) != 1 || events[0].Name != "weekly" || events[0].Error != "" {
+		t.Fatalf("weekly のみ生成されるべき: %+v", events)
+	}
+	if events[0].Path != "reports/weekly-20260309-0900.md" {
+		t.Errorf("出力パスが正しくありません: %s", events[0].Path)
+	}
+	data, err := os.ReadFile(filepath.Join(zeus.ZeusPath, events[0].Path))
+	if err != nil {
+		t.Fatalf("レポートの読み込みに失敗: %v", err)
+	}
+	if !strings.Contains(string(data), "#") {
+		t.Errorf("Markdown レポートが書き込まれていません: %s", data)
+	}
+	if len(notified) != 1 {
+		t.Errorf("生成結果が通知されるべき: %+v", notified)
+	}
+
+	// 0:00 は daily のみ一致
+	events = scheduler.runDue(ctx, time.Date(2026, 3, 10, 0, 0, 0, 0, time.Local))
+	if len(events) != 1 || events[0].Path != "reports/daily/daily-20260310-0000.html" {
+		t.Errorf("daily のみ生成されるべき: %+v", events)
+	}
+
+	// 一致しない時刻は何もしない
+	if events := scheduler.runDue(ctx, monday.Add(time.Minute)); len(events) != 0 {
+		t.Errorf("一致しない時刻では生成しないはず: %+v", events)
+	}
+}
+
+func TestSetReportSchedules_Invalid(t *testing.T) {
+	server := NewServer(setupTestZeus(t), 0)
+
+	tests := []core.ReportSchedule{
+		{Name: "bad cron", Cron: "0 9 * * 1"},
+		{Name: "weekly", Cron: "0 25 * * *"},
+		{Name: "weekly", Cron: "0 9 * * 1", Format: "pdf"},
+	}
+	for _, s := range tests {
+		if err := server.SetReportSchedules([]core.ReportSchedule{s}); err == nil {
+			t.Errorf("不正な設定でエラーになるべき: %+v", s)
+		}
+	}
+	if err := server.SetReportSchedules(nil); err != nil || server.scheduler != nil {
+		t.Errorf("空の設定ではスケジューラーなし: %v", err)
+	}
+}
@@ -23,6 +23,16 @@ type Server struct {
 	port        int
 	devMode     bool
 	broadcaster *SSEBroadcaster
+	cache       *responseCache
+	mermaid     *mermaidCache
+	settings    core.ServerSettings
+	limiter     *rateLimiter
+	version     string
+	scheduler   *reportScheduler
+	stopTasks   context.CancelFunc // バックグラウンド処理（定期レポート、エスカレーション、自動スナップショット）の停止
+	name        string             // ?project= で選択する名前
+	projects    []*Server          // AddProject で追加したプロジェクト
+	root        *Server            // 追加したプロジェクトの場合は既定のプロジェクトのサーバー
 }
 
 // NewServer は新しい Server を作成
@@ -31,7 +41,10 @@ func NewServer(zeus *core.Zeus, port int) *Server {
 		zeus:        zeus,
 		port:        port,
 		devMode:     false,
-		broadcaster: NewSSEBroadcaster(),
+		broadcaster: newProjectBroadcaster(zeus),
+		cache:       newResponseCache(responseCacheTTL),
+		mermaid:     newMermaidCache(),
+		version:     "dev",
 	}
 }
 
@@ -41,10 +54,22 @@ func NewServerWithDevMode(zeus *core.Zeus, port int, devMode bool) *Server {
 		zeus:        zeus,
 		port:        port,
 		devMode:     devMode,
-		broadcaster: NewSSEBroadcaster(),
+		broadcaster: newProjectBroadcaster(zeus),
+		cache:       newResponseCache(responseCacheTTL),
+		mermaid:     newMermaidCache(),
+		version:     "dev",
 	}
 }
 
+// newProjectBroadcaster はプロジェクトのイベントログに記録する Broadcaster を作成
+func newProjectBroadcaster(zeus *core.Zeus) *SSEBroadcaster {
+	broadcaster := NewSSEBroadcaster()
+	if zeus != nil {
+		broadcaster.SetEventLog(zeus.EventLog())
+	}
+	return broadcaster
+}
+
 // Start はサーバーを起動
 // 127.0.0.1 にバインドしてローカルアクセスのみ許可
 func (s *Server) Start(ctx context.Context) error {
@@ -75,12 +100,26 @@ func (s *Server) Start(ctx context.Context) error {
 		return err
 	case <-time.After(100 * time.Millisecond):
 		// 起動成功
-		return nil
 	}
+
+	// 定期レポート、期限超過のエスカレーション、自動スナップショットを追加したプロジェクトを含めて開始（Shutdown で停止）
+	taskCtx, cancel := context.WithCancel(ctx)
+	s.stopTasks = cancel
+	for _, p := range append([]*Server{s}, s.projects...) {
+		if p.scheduler != nil {
+			go p.scheduler.run(taskCtx)
+		}
+		go p.runEscalations(taskCtx)
+		go p.runSnapshots(taskCtx)
+	}
+	return nil
 }
 
 // Shutdown はサーバーを停止
 func (s *Server) Shutdown(ctx context.Context) error {
+	if s.stopTasks != nil {
+		s.stopTasks()
+	}
 	if s.server == nil {
 		return nil
 	}
@@ -107,50 +146,77 @@ func (s *Server) DevMode() bool {
 	return s.devMode
 }
 
-// corsMiddleware は CORS ヘッダーを追加するミドルウェア（開発モード用）
-func (s *Server) corsMiddleware(next http.HandlerFunc) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		if s.devMode {
-			w.Header().Set("Access-Control-Allow-Origin", "*")
-			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
-
-			if r.Method == "OPTIONS" {
-				w.WriteHeader(http.StatusOK)
-				return
-			}
-		}
-		next(w, r)
-	}
-}
-
 // handler は http.Handler を構築
 func (s *Server) handler() http.Handler {
 	mux := http.NewServeMux()
 
-	// API エンドポイント（CORS 対応）
-	mux.HandleFunc("/api/status", s.corsMiddleware(s.handleAPIStatus))
-	mux.HandleFunc("/api/graph", s.corsMiddleware(s.handleAPIGraph))
-	mux.HandleFunc("/api/affinity", s.corsMiddleware(s.handleAPIAffinity)) // Phase 7: Affinity Canvas
+	// API エンドポイント（CORS / レート制限 / トークン認証）
+	// 状態を変更するエンドポイントは mutatingAPIMiddleware（Content-Type と Origin の検証）を使う
+	mux.HandleFunc("/api/status", s.apiMiddleware(s.handleAPIStatus))
+	mux.HandleFunc("/api/version", s.apiMiddleware(s.handleAPIVersion))
+	mux.HandleFunc("/api/projects", s.apiMiddleware(s.handleAPIProjects))
+	mux.HandleFunc("/api/portfolio", s.apiMiddleware(s.handleAPIPortfolio))
+	// 計算コストの高いエンドポイントは ETag/キャッシュ対応
+	mux.HandleFunc("/api/graph", s.apiMiddleware(s.cacheMiddleware(s.handleAPIGraph)))
+	mux.HandleFunc("/api/graph/image", s.apiMiddleware(s.cacheMiddleware(s.handleAPIGraphImage)))
+	mux.HandleFunc("/api/graph/path", s.apiMiddleware(s.cacheMiddleware(s.handleAPIGraphPath)))
+	mux.HandleFunc("/api/concept-graph", s.apiMiddleware(s.cacheMiddleware(s.handleAPIConceptGraph)))
+	mux.HandleFunc("/api/affinity", s.apiMiddleware(s.cacheMiddleware(s.handleAPIAffinity))) // Phase 7: Affinity Canvas
+	mux.HandleFunc("/api/coverage", s.apiMiddleware(s.cacheMiddleware(s.handleAPICoverage)))
+	mux.HandleFunc("/api/risks/heatmap", s.apiMiddleware(s.cacheMiddleware(s.handleAPIRiskHeatmap)))
+	mux.HandleFunc("/api/risks/exposure", s.apiMiddleware(s.cacheMiddleware(s.handleAPIRiskExposure)))
+	mux.HandleFunc("/api/releases", s.apiMiddleware(s.cacheMiddleware(s.handleAPIReleases)))
+	mux.HandleFunc("/api/releases/{ref}", s.apiMiddleware(s.cacheMiddleware(s.handleAPIRelease)))
+	mux.HandleFunc("/api/problems/aging", s.apiMiddleware(s.handleAPIProblemAging))
 
 	// UML UseCase API エンドポイント
-	mux.HandleFunc("/api/actors", s.corsMiddleware(s.handleAPIActors))
-	mux.HandleFunc("/api/usecases", s.corsMiddleware(s.handleAPIUseCases))
-	mux.HandleFunc("/api/subsystems", s.corsMiddleware(s.handleAPISubsystems))
-	mux.HandleFunc("/api/uml/usecase", s.corsMiddleware(s.handleAPIUseCaseDiagram))
+	mux.HandleFunc("/api/actors", s.apiMiddleware(s.handleAPIActors))
+	mux.HandleFunc("/api/usecases", s.apiMiddleware(s.handleAPIUseCases))
+	mux.HandleFunc("/api/subsystems", s.apiMiddleware(s.handleAPISubsystems))
+	mux.HandleFunc("/api/uml/usecase", s.apiMiddleware(s.handleAPIUseCaseDiagram))
 
 	// UML Activity API エンドポイント
-	mux.HandleFunc("/api/activities", s.corsMiddleware(s.handleAPIActivities))
-	mux.HandleFunc("/api/uml/activity", s.corsMiddleware(s.handleAPIActivityDiagram))
+	mux.HandleFunc("/api/activities", s.apiMiddleware(s.handleAPIActivities))
+	mux.HandleFunc("/api/uml/activity", s.apiMiddleware(s.handleAPIActivityDiagram))
+	mux.HandleFunc("/api/activities/bulk-status", s.mutatingAPIMiddleware(s.handleAPIActivitiesBulkStatus))
+	mux.HandleFunc("/api/activities/{id}/diagram", s.apiMiddleware(s.handleAPIActivityDiagramSource))
+	mux.HandleFunc("/api/activities/{id}/image", s.apiMiddleware(s.cacheMiddleware(s.handleAPIActivityImage)))
 
 	// Vision/Objective API エンドポイント
-	mux.HandleFunc("/api/vision", s.corsMiddleware(s.handleAPIVision))
-	mux.HandleFunc("/api/objectives", s.corsMiddleware(s.handleAPIObjectives))
+	mux.HandleFunc("/api/vision", s.apiMiddleware(s.handleAPIVision))
+	mux.HandleFunc("/api/vision/history", s.apiMiddleware(s.handleAPIVisionHistory))
+	mux.HandleFunc("/api/objectives", s.apiMiddleware(s.handleAPIObjectives))
+
+	// 作業キュー API エンドポイント（エージェントへの Activity の割り当て）
+	mux.HandleFunc("/api/queue", s.apiMiddleware(s.handleAPIQueue))
+	mux.HandleFunc("/api/queue/claim", s.mutatingAPIMiddleware(s.handleAPIQueueClaim))
+	mux.HandleFunc("/api/queue/heartbeat", s.mutatingAPIMiddleware(s.handleAPIQueueHeartbeat))
+	mux.HandleFunc("/api/queue/release", s.mutatingAPIMiddleware(s.handleAPIQueueRelease))
 
 	// UnifiedGraph API エンドポイント（Task/Activity 統合）
-	mux.HandleFunc("/api/unified-graph", s.corsMiddleware(s.handleAPIUnifiedGraph))
+	mux.HandleFunc("/api/unified-graph", s.apiMiddleware(s.cacheMiddleware(s.handleAPIUnifiedGraph)))
+	mux.HandleFunc("/api/unified-graph/image", s.apiMiddleware(s.cacheMiddleware(s.handleAPIUnifiedGraphImage)))
+
+	mux.HandleFunc("/api/events", s.apiMiddleware(s.handleSSE)) // SSE エンドポイント
+
+	// エンティティの一覧（q でクエリ式による絞り込み）
+	mux.HandleFunc("/api/entities/{type}", s.apiMiddleware(s.handleAPIEntities))
+
+	// 単一エンティティの取得・更新（PATCH は If-Match によるリビジョン確認必須）
+	mux.HandleFunc("/api/entities/{type}/{id}", s.mutatingAPIMiddleware(s.handleAPIEntity))
+
+	// プロジェクト設定（PATCH は If-Match によるリビジョン確認必須）
+	mux.HandleFunc("/api/settings", s.mutatingAPIMiddleware(s.handleAPISettings))
+
+	// 変更フィード（外部同期ツールの差分取得用）
+	mux.HandleFunc("/api/changes", s.apiMiddleware(s.handleAPIChanges))
+
+	// 承認待ち（承認時に書き込まれるエンティティの内容と差分を含む）
+	mux.HandleFunc("/api/approvals", s.apiMiddleware(s.handleAPIApprovals))
 
-	mux.HandleFunc("/api/events", s.handleSSE) // SSE エンドポイント
+	// ヘルスチェック（systemd / Kubernetes の liveness・readiness プローブ用）
+	mux.HandleFunc("/healthz", s.handleHealthz)
+	mux.HandleFunc("/readyz", s.handleReadyz)
 
 	// 静的ファイルを提供（本番モード）
 	if !s.devMode {
@@ -196,16 +262,18 @@ func (s *Server) handler() http.Handler {
 						return
 					}
 					w.Header().Set("Content-Type", "text/html; charset=utf-8")
+					w.Header().Set("Cache-Control", "no-cache")
 					w.Write(data)
 					return
 				}
 
+				w.Header().Set("Cache-Control", assetCacheControl(path))
 				fileServer.ServeHTTP(w, r)
 			})
 		}
 	}
 
-	return mux
+	return s.projectHandler(mux)
 }
 
 // BroadcastAllUpdates は全データの更新を SSE クライアントに通知
@@ -234,8 +302,10 @@ func (s *Server) BroadcastAllUpdates(ctx context.Context) {
 
 	// グラフ
 	if graph, err := s.zeus.BuildDependencyGraph(ctx); err == nil {
+		mermaid, summarized := s.dependencyMermaid(ctx, graph, s.defaultMermaidMaxNodes(), "")
 		response := GraphResponse{
-			Mermaid: graph.ToMermaid(),
+			Mermaid:           mermaid,
+			MermaidSummarized: summarized,
 			Stats: GraphStats{
 				TotalNodes:       graph.Stats.TotalNodes,
 				WithDependencies: graph.Stats.WithDependencies,
@@ -0,0 +1,263 @@
+package dashboard
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// =============================================================================
+// 静的サイトのエクスポート（zeus export site）
+// =============================================================================
+
+// SiteFile は静的サイトの 1 ファイル（Path は出力ディレクトリからの相対パス）
+type SiteFile struct {
+	Path    string
+	Content []byte
+}
+
+// siteSnapshots は静的サイトに含める API のスナップショット（API のパス → 出力先）
+var siteSnapshots = []struct {
+	api, path string
+}{
+	{"/api/status", "data/status.json"},
+	{"/api/objectives", "data/objectives.json"},
+	{"/api/usecases", "data/usecases.json"},
+	{"/api/activities", "data/activities.json"},
+	{"/api/unified-graph", "data/graph.json"},
+}
+
+// siteData はページのテンプレートに渡すデータ
+type siteData struct {
+	Page        string
+	GeneratedAt string
+	Status      StatusResponse
+	Plan        []sitePlanObjective
+	Unassigned  []ActivityItem // UseCase に属さない Activity
+	Timeline    []core.TimelineEvent
+	Graph       UnifiedGraphResponse
+}
+
+// sitePlanObjective は計画ページの Objective > UseCase > Activity の階層
+type sitePlanObjective struct {
+	ObjectiveItem
+	UseCases []sitePlanUseCase
+}
+
+// sitePlanUseCase は計画ページの UseCase と Activity
+type sitePlanUseCase struct {
+	UseCaseItem
+	Activities []ActivityItem
+}
+
+// ExportSite はダッシュボードの状態・計画・タイムライン・グラフ・レポートを静的な HTML と JSON に出力する
+// JSON は同名の API のレスポンスそのもの（data/*.json）で、サーバーを起動せずに GitHub Pages などで公開できる
+func ExportSite(ctx context.Context, zeus *core.Zeus) ([]SiteFile, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	handler := NewServer(zeus, 0).handler()
+
+	var files []SiteFile
+	snapshots := map[string][]byte{}
+	for _, snap := range siteSnapshots {
+		rec := &bufferedResponseWriter{header: http.Header{}, status: http.StatusOK}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, snap.api, nil)
+		if err != nil {
+			return nil, err
+		}
+		handler.ServeHTTP(rec, req)
+		if rec.status != http.StatusOK {
+			return nil, fmt.Errorf("%s のスナップショットに失敗: %d %s", snap.api, rec.status, bytes.TrimSpace(rec.body.Bytes()))
+		}
+		snapshots[snap.api] = rec.body.Bytes()
+		files = append(files, SiteFile{Path: snap.path, Content: rec.body.Bytes()})
+	}
+
+	data := siteData{GeneratedAt: time.Now().Format("2006-01-02 15:04")}
+	var (
+		objectives ObjectivesResponse
+		usecases   UseCasesResponse
+		activities ActivitiesResponse
+	)
+	for api, v := range map[string]any{
+		"/api/status":        &data.Status,
+		"/api/objectives":    &objectives,
+		"/api/usecases":      &usecases,
+		"/api/activities":    &activities,
+		"/api/unified-graph": &data.Graph,
+	} {
+		if err := json.Unmarshal(snapshots[api], v); err != nil {
+			return nil, fmt.Errorf("%s のスナップショットの読み込みに失敗: %w", api, err)
+		}
+	}
+	data.Plan, data.Unassigned = buildSitePlan(objectives.Objectives, usecases.UseCases, activities.Activities)
+
+	timeline, err := zeus.Timeline(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data.Timeline = timeline
+	content, err := json.MarshalIndent(timeline, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, SiteFile{Path: "data/timeline.json", Content: content})
+
+	for _, page := range []string{"index", "plan", "timeline", "graph"} {
+		data.Page = page
+		var buf bytes.Buffer
+		if err := siteTemplate.ExecuteTemplate(&buf, page, data); err != nil {
+			return nil, fmt.Errorf("%s.html の生成に失敗: %w", page, err)
+		}
+		files = append(files, SiteFile{Path: page + ".html", Content: buf.Bytes()})
+	}
+
+	report, err := zeus.GenerateReport(ctx, "html")
+	if err != nil {
+		return nil, fmt.Errorf("レポートの生成に失敗: %w", err)
+	}
+	files = append(files, SiteFile{Path: "report.html", Content: []byte(report)})
+	return files, nil
+}
+
+// buildSitePlan は Objective > UseCase > Activity の階層を組み立てる
+// Objective が存在しない UseCase は「Objective 未設定」にまとめ、UseCase が存在しない Activity は別に返す
+func buildSitePlan(objectives []ObjectiveItem, usecases []UseCaseItem, activities []ActivityItem) ([]sitePlanObjective, []ActivityItem) {
+	objectiveIDs := map[string]bool{}
+	for _, o := range objectives {
+		objectiveIDs[o.ID] = true
+	}
+	usecaseIDs := map[string]bool{}
+	for _, u := range usecases {
+		usecaseIDs[u.ID] = true
+	}
+	usecasesOf := func(match func(u UseCaseItem) bool) []sitePlanUseCase {
+		var result []sitePlanUseCase
+		for _, u := range usecases {
+			if !match(u) {
+				continue
+			}
+			entry := sitePlanUseCase{UseCaseItem: u}
+			for _, a := range activities {
+				if a.UseCaseID == u.ID {
+					entry.Activities = append(entry.Activities, a)
+				}
+			}
+			result = append(result, entry)
+		}
+		return result
+	}
+
+	plan := make([]sitePlanObjective, 0, len(objectives)+1)
+	for _, o := range objectives {
+		plan = append(plan, sitePlanObjective{
+			ObjectiveItem: o,
+			UseCases:      usecasesOf(func(u UseCaseItem) bool { return u.ObjectiveID == o.ID }),
+		})
+	}
+	if orphans := usecasesOf(func(u UseCaseItem) bool { return !objectiveIDs[u.ObjectiveID] }); len(orphans) > 0 {
+		plan = append(plan, sitePlanObjective{ObjectiveItem: ObjectiveItem{Title: "Objective 未設定"}, UseCases: orphans})
+	}
+	var unassigned []ActivityItem
+	for _, a := range activities {
+		if !usecaseIDs[a.UseCaseID] {
+			unassigned = append(unassigned, a)
+		}
+	}
+	return plan, unassigned
+}
+
+// siteTemplate は静的サイトのページ（各ページを header と footer で囲む）
+var siteTemplate = template.Must(template.New("site").Parse(`
+{{define "header"}}<!DOCTYPE html>
+<html lang="ja">
+<head>
+<meta charset="UTF-8">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<title>{{.Status.Project.Name}} - Zeus</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, sans-serif; margin: 0; color: #333; background: #f5f5f5; line-height: 1.6; }
+header { background: #1f2933; color: #fff; padding: 12px 24px; }
+header a { color: #cbd2d9; margin-right: 16px; text-decoration: none; }
+header a.current { color: #fff; font-weight: bold; }
+main { max-width: 1100px; margin: 24px auto; padding: 0 24px; }
+section { background: #fff; border-radius: 6px; padding: 16px 24px; margin-bottom: 16px; }
+table { border-collapse: collapse; width: 100%; }
+th, td { text-align: left; padding: 4px 8px; border-bottom: 1px solid #e4e7eb; }
+.status { font-size: 0.85em; color: #616e7c; }
+footer { text-align: center; color: #9aa5b1; font-size: 0.85em; margin: 24px; }
+</style>
+</head>
+<body>
+<header>
+<strong>{{.Status.Project.Name}}</strong>&nbsp;&nbsp;
+<a href="index.html"{{if eq .Page "index"}} class="current"{{end}}>Status</a>
+<a href="plan.html"{{if eq .Page "plan"}} class="current"{{end}}>Plan</a>
+<a href="timeline.html"{{if eq .Page "timeline"}} class="current"{{end}}>Timeline</a>
+<a href="graph.html"{{if eq .Page "graph"}} class="current"{{end}}>Graph</a>
+<a href="report.html">Report</a>
+</header>
+<main>
+{{end}}
+
+{{define "footer"}}</main>
+<footer>Generated by zeus export site at {{.GeneratedAt}} (snapshot, data/*.json)</footer>
+</body>
+</html>
+{{end}}
+
+{{define "index"}}{{template "header" .}}
+<section>
+<h2>{{.Status.Project.Name}}</h2>
+{{if .Status.Project.Description}}<p>{{.Status.Project.Description}}</p>{{end}}
+<p>Health: <strong>{{.Status.State.Health}}</strong> / Pending approvals: {{.Status.PendingApprovals}}</p>
+<table>
+<tr><th>Activities</th><th>Completed</th><th>In Progress</th><th>Pending</th></tr>
+<tr><td>{{.Status.State.Summary.TotalActivities}}</td><td>{{.Status.State.Summary.Completed}}</td><td>{{.Status.State.Summary.InProgress}}</td><td>{{.Status.State.Summary.Pending}}</td></tr>
+</table>
+</section>
+{{template "footer" .}}{{end}}
+
+{{define "plan"}}{{template "header" .}}
+{{range .Plan}}<section>
+<h2>{{.Title}} {{if .ID}}<span class="status">{{.ID}} [{{.Status}}]</span>{{end}}</h2>
+{{range .UseCases}}<h3>{{.Title}} <span class="status">{{.ID}} [{{.Status}}]</span></h3>
+<ul>{{range .Activities}}<li>{{.Title}} <span class="status">{{.ID}} [{{.Status}}]</span></li>{{else}}<li class="status">(Activity なし)</li>{{end}}</ul>
+{{else}}<p class="status">(UseCase なし)</p>{{end}}
+</section>
+{{end}}{{if .Unassigned}}<section>
+<h2>UseCase 未設定の Activity</h2>
+<ul>{{range .Unassigned}}<li>{{.Title}} <span class="status">{{.ID}} [{{.Status}}]</span></li>{{end}}</ul>
+</section>
+{{end}}{{template "footer" .}}{{end}}
+
+{{define "timeline"}}{{template "header" .}}
+<section>
+<h2>Timeline</h2>
+<table>
+<tr><th>Date</th><th>Event</th><th>ID</th><th>Title</th><th>Status</th></tr>
+{{range .Timeline}}<tr><td>{{.Date}}</td><td>{{.Event}}</td><td>{{.ID}}</td><td>{{.Title}}</td><td>{{.Status}}</td></tr>
+{{else}}<tr><td colspan="5" class="status">(日付を持つ項目なし)</td></tr>
+{{end}}</table>
+</section>
+{{template "footer" .}}{{end}}
+
+{{define "graph"}}{{template "header" .}}
+<section>
+<h2>Graph</h2>
+<p class="status">{{.Graph.Stats.TotalNodes}} nodes / {{.Graph.Stats.TotalEdges}} edges</p>
+<pre class="mermaid">{{.Graph.Mermaid}}</pre>
+</section>
+<script type="module">
+import mermaid from "https://cdn.jsdelivr.net/npm/mermaid@11/dist/mermaid.esm.min.mjs";
+mermaid.initialize({ startOnLoad: true });
+</script>
+{{template "footer" .}}{{end}}
+`))
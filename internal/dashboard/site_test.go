@@ -0,0 +1,63 @@
+package dashboard
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+func TestExportSite(t *testing.T) {
+	zeus, _ := setupTestZeusWithActivity(t)
+	ctx := context.Background()
+	obj, err := zeus.Add(ctx, "objective", "認証刷新")
+	if err != nil {
+		t.Fatalf("Objective の追加に失敗: %v", err)
+	}
+	uc, err := zeus.Add(ctx, "usecase", "ログインする", core.WithUseCaseObjective(obj.ID))
+	if err != nil {
+		t.Fatalf("UseCase の追加に失敗: %v", err)
+	}
+	if _, err := zeus.Add(ctx, "activity", "<ログイン画面>", core.WithActivityUseCase(uc.ID)); err != nil {
+		t.Fatalf("Activity の追加に失敗: %v", err)
+	}
+	if _, err := zeus.Add(ctx, "consideration", "認証方式", core.WithConsiderationDueDate("2026-04-01")); err != nil {
+		t.Fatalf("Consideration の追加に失敗: %v", err)
+	}
+
+	files, err := ExportSite(ctx, zeus)
+	if err != nil {
+		t.Fatalf("ExportSite に失敗: %v", err)
+	}
+	byPath := map[string]string{}
+	for _, f := range files {
+		byPath[f.Path] = string(f.Content)
+	}
+	for _, path := range []string{"index.html", "plan.html", "timeline.html", "graph.html", "report.html",
+		"data/status.json", "data/activities.json", "data/graph.json", "data/timeline.json"} {
+		if _, ok := byPath[path]; !ok {
+			t.Errorf("%s が出力されていません", path)
+		}
+	}
+
+	// JSON は API のレスポンスと同じ形式
+	var activities ActivitiesResponse
+	if err := json.Unmarshal([]byte(byPath["data/activities.json"]), &activities); err != nil || activities.Total != 2 {
+		t.Errorf("activities.json が正しくありません: total=%d err=%v", activities.Total, err)
+	}
+
+	// 計画ページは Objective > UseCase > Activity の階層（タイトルはエスケープ）
+	plan := byPath["plan.html"]
+	objIdx, ucIdx, actIdx := strings.Index(plan, "認証刷新"), strings.Index(plan, "ログインする"), strings.Index(plan, "&lt;ログイン画面&gt;")
+	if objIdx < 0 || ucIdx < objIdx || actIdx < ucIdx {
+		t.Errorf("計画ページの階層が正しくありません:\n%s", plan)
+	}
+	if !strings.Contains(plan, "UseCase 未設定の Activity") || !strings.Contains(plan, "Test Activity") {
+		t.Error("UseCase に属さない Activity が表示されていません")
+	}
+	if !strings.Contains(byPath["timeline.html"], "2026-04-01") {
+		t.Error("タイムラインに Consideration の期限が表示されていません")
+	}
+}
@@ -0,0 +1,39 @@
+package dashboard
+
+import (
+	"context"
+	"time"
+)
+
+// snapshotInterval は自動スナップショットの作成時期を評価する間隔
+const snapshotInterval = time.Hour
+
+// runSnapshots は起動時と snapshotInterval ごとに自動スナップショットを評価する（ctx のキャンセルで停止）
+func (s *Server) runSnapshots(ctx context.Context) {
+	ticker := time.NewTicker(snapshotInterval)
+	defer ticker.Stop()
+	for {
+		s.autoSnapshot(ctx, time.Now())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// autoSnapshot は zeus.yaml の snapshots に従ってスナップショットを作成・削除し、変更があれば配信する
+func (s *Server) autoSnapshot(ctx context.Context, now time.Time) {
+	// 失敗した場合（設定の誤りなど）は次回の評価で再試行する。設定の誤りは zeus snapshot prune で確認できる
+	settings, err := s.zeus.SnapshotSettings(ctx)
+	if err != nil || settings.IntervalDays == 0 {
+		return
+	}
+	result, err := s.zeus.AutoSnapshot(ctx, settings, now)
+	if err != nil {
+		return
+	}
+	if result.Created != nil || len(result.Pruned) > 0 {
+		s.broadcaster.Broadcast(SSEEvent{Type: EventSnapshot, Data: result})
+	}
+}
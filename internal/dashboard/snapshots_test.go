@@ -0,0 +1,55 @@
+package dashboard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+func TestAutoSnapshot_Broadcast(t *testing.T) {
+	zeus := setupTestZeus(t)
+	ctx := context.Background()
+
+	server := NewServer(zeus, 0)
+	client := server.broadcaster.AddClient("test")
+	defer server.broadcaster.RemoveClient("test")
+
+	// snapshots.interval_days が未設定の場合は作成しない
+	server.autoSnapshot(ctx, time.Now())
+	if history, _ := zeus.GetHistory(ctx, 0); len(history) != 0 {
+		t.Fatalf("自動スナップショットは無効のはず: %+v", history)
+	}
+
+	config, err := zeus.LoadConfig(ctx)
+	if err != nil {
+		t.Fatalf("設定の読み込みに失敗: %v", err)
+	}
+	config.Snapshots.IntervalDays = 7
+	if err := zeus.FileStore().WriteYaml(ctx, "zeus.yaml", config); err != nil {
+		t.Fatalf("設定の書き込みに失敗: %v", err)
+	}
+
+	server.autoSnapshot(ctx, time.Now())
+	select {
+	case event := <-client.Events:
+		result, ok := event.Data.(*core.AutoSnapshotResult)
+		if event.Type != EventSnapshot || !ok || result.Created == nil {
+			t.Errorf("自動スナップショットのイベントが正しくありません: %+v", event)
+		}
+	default:
+		t.Fatal("作成したスナップショットのイベントが配信されるべき")
+	}
+
+	// 間隔に達していなければ作成も配信もしない
+	server.autoSnapshot(ctx, time.Now().Add(time.Hour))
+	select {
+	case event := <-client.Events:
+		t.Errorf("間隔内はイベントを配信しないはず: %+v", event)
+	default:
+	}
+	if history, _ := zeus.GetHistory(ctx, 0); len(history) != 1 || history[0].Label != core.AutoSnapshotLabel {
+		t.Errorf("自動スナップショットは 1 件のはず: %+v", history)
+	}
+}
@@ -2,8 +2,12 @@
 package dashboard
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"sync"
+
+	"github.com/biwakonbu/zeus/internal/core"
 )
 
 // EventType は SSE イベントの種類
@@ -13,10 +17,16 @@ const (
 	EventStatus   EventType = "status"
 	EventApproval EventType = "approval"
 	EventGraph    EventType = "graph"
+	EventReport   EventType = "report"
+	// EventSnapshot は自動スナップショットの作成・削除
+	EventSnapshot EventType = "snapshot"
+	// EventEscalation は期限を過ぎた Consideration のエスカレーション（イベントログの種類と同じ）
+	EventEscalation EventType = core.EscalationEventType
 )
 
 // SSEEvent は SSE で送信するイベント
 type SSEEvent struct {
+	ID   uint64      `json:"id,omitempty"` // イベントログのシーケンス番号（ログ未設定時は 0）
 	Type EventType   `json:"type"`
 	Data interface{} `json:"data"`
 }
@@ -30,6 +40,7 @@ type SSEClient struct {
 // SSEBroadcaster は複数クライアントへの SSE 配信を管理
 type SSEBroadcaster struct {
 	clients map[string]*SSEClient
+	log     *core.EventLog // 再接続時のリプレイ用（nil の場合は記録しない）
 	mu      sync.RWMutex
 }
 
@@ -64,6 +75,20 @@ func (b *SSEBroadcaster) RemoveClient(id string) {
 	}
 }
 
+// SetEventLog は配信したイベントを記録するログを設定
+func (b *SSEBroadcaster) SetEventLog(log *core.EventLog) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.log = log
+}
+
+// EventLog は設定されているイベントログを返す
+func (b *SSEBroadcaster) EventLog() *core.EventLog {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.log
+}
+
 // ClientCount はアクティブなクライアント数を返す
 func (b *SSEBroadcaster) ClientCount() int {
 	b.mu.RLock()
@@ -72,9 +97,17 @@ func (b *SSEBroadcaster) ClientCount() int {
 }
 
 // Broadcast は全クライアントにイベントを配信
+// イベントログが設定されている場合は記録してシーケンス番号を付与する
+// （採番と配信の順序を揃えるため書き込みロックで直列化）
 func (b *SSEBroadcaster) Broadcast(event SSEEvent) {
-	b.mu.RLock()
-	defer b.mu.RUnlock()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.log != nil {
+		if record, err := b.log.Append(context.Background(), string(event.Type), event.Data); err == nil {
+			event.ID = record.Seq
+		}
+	}
 
 	for _, client := range b.clients {
 		select {
@@ -102,6 +135,14 @@ func (b *SSEBroadcaster) BroadcastGraph(data interface{}) {
 	})
 }
 
+// BroadcastReport は定期レポートの生成結果を配信
+func (b *SSEBroadcaster) BroadcastReport(event ReportEvent) {
+	b.Broadcast(SSEEvent{
+		Type: EventReport,
+		Data: event,
+	})
+}
+
 // FormatSSEMessage は SSE メッセージ形式にフォーマット
 func FormatSSEMessage(event SSEEvent) ([]byte, error) {
 	data, err := json.Marshal(event.Data)
@@ -110,3 +151,21 @@ func FormatSSEMessage(event SSEEvent) ([]byte, error) {
 	}
 	return data, nil
 }
+
+// FormatSSEFrame は SSE のフレーム（id / event / data）を組み立てる
+func FormatSSEFrame(event SSEEvent) ([]byte, error) {
+	data, err := FormatSSEMessage(event)
+	if err != nil {
+		return nil, err
+	}
+	frame := fmt.Sprintf("event: %s\ndata: %s\n\n", event.Type, data)
+	if event.ID > 0 {
+		frame = fmt.Sprintf("id: %d\n", event.ID) + frame
+	}
+	return []byte(frame), nil
+}
+
+// eventFromRecord はイベントログのレコードを SSE イベントに変換
+func eventFromRecord(record core.EventRecord) SSEEvent {
+	return SSEEvent{ID: record.Seq, Type: EventType(record.Type), Data: record.Data}
+}
@@ -1,10 +1,14 @@
 package dashboard
 
 import (
+	"context"
 	"encoding/json"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
+
+	"github.com/biwakonbu/zeus/internal/core"
 )
 
 // ===== NewSSEBroadcaster テスト =====
@@ -543,3 +547,51 @@ func TestSSEBroadcaster_ComplexScenario(t *testing.T) {
 		t.Errorf("expected 1 client remaining, got %d", broadcaster.ClientCount())
 	}
 }
+
+// ===== イベントログ テスト =====
+
+func TestSSEBroadcaster_EventLog(t *testing.T) {
+	broadcaster := NewSSEBroadcaster()
+	log := core.NewEventLog(filepath.Join(t.TempDir(), "events.jsonl"), 0)
+	broadcaster.SetEventLog(log)
+	client := broadcaster.AddClient("client-001")
+
+	broadcaster.BroadcastStatus(map[string]string{"status": "active"})
+	broadcaster.BroadcastGraph(map[string]int{"nodes": 1})
+
+	for want := uint64(1); want <= 2; want++ {
+		select {
+		case event := <-client.Events:
+			if event.ID != want {
+				t.Errorf("expected event ID %d, got %d", want, event.ID)
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatalf("timeout waiting for event %d", want)
+		}
+	}
+
+	records, err := log.Since(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("Since failed: %v", err)
+	}
+	if len(records) != 2 || records[0].Type != "status" || records[1].Type != "graph" {
+		t.Errorf("unexpected log records: %+v", records)
+	}
+}
+
+func TestFormatSSEFrame(t *testing.T) {
+	frame, err := FormatSSEFrame(SSEEvent{ID: 7, Type: EventReport, Data: map[string]string{"name": "weekly"}})
+	if err != nil {
+		t.Fatalf("FormatSSEFrame failed: %v", err)
+	}
+	want := "id: 7\nevent: report\ndata: {\"name\":\"weekly\"}\n\n"
+	if string(frame) != want {
+		t.Errorf("frame = %q, want %q", frame, want)
+	}
+
+	// ID が無い場合は id 行を出力しない
+	frame, _ = FormatSSEFrame(SSEEvent{Type: EventStatus, Data: 1})
+	if string(frame) != "event: status\ndata: 1\n\n" {
+		t.Errorf("frame without ID = %q", frame)
+	}
+}
@@ -0,0 +1,146 @@
+package diagram
+
+import (
+	"strings"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// Activity は指定形式でアクティビティ図を生成
+func Activity(act *core.ActivityEntity, format Format) (string, error) {
+	renderer, err := NewRenderer(format)
+	if err != nil {
+		return "", err
+	}
+	return renderer.Activity(act), nil
+}
+
+// ActivityMermaid はアクティビティ図を Mermaid flowchart で生成
+//
+// ノードの表現:
+//   - initial: 黒丸 / final: 二重丸
+//   - action: 角丸四角形
+//   - decision / merge: ひし形
+//   - fork / join: 太い横線（bar クラス）
+//
+// ガード条件は遷移ラベルとして出力する。
+func ActivityMermaid(act *core.ActivityEntity) string {
+	var sb strings.Builder
+
+	sb.WriteString("flowchart TD\n")
+	sb.WriteString("    %% " + escapeMermaid(act.Title) + "\n\n")
+
+	// ノード定義
+	sb.WriteString("    %% Nodes\n")
+	bars := []string{}
+	for _, node := range act.Nodes {
+		id := mermaidID(node.ID)
+		label := node.Name
+		if label == "" {
+			label = string(node.Type)
+		}
+
+		switch node.Type {
+		case core.ActivityNodeTypeInitial:
+			sb.WriteString("    " + id + "((●))\n")
+		case core.ActivityNodeTypeFinal:
+			sb.WriteString("    " + id + "(((◉)))\n")
+		case core.ActivityNodeTypeAction:
+			sb.WriteString("    " + id + "(\"" + escapeMermaid(label) + "\")\n")
+		case core.ActivityNodeTypeDecision, core.ActivityNodeTypeMerge:
+			// 名前のない分岐/合流は空のひし形
+			if node.Name == "" {
+				label = " "
+			}
+			sb.WriteString("    " + id + "{\"" + escapeMermaid(label) + "\"}\n")
+		case core.ActivityNodeTypeFork, core.ActivityNodeTypeJoin:
+			sb.WriteString("    " + id + "[\" \"]\n")
+			bars = append(bars, id)
+		default:
+			sb.WriteString("    " + id + "[\"" + escapeMermaid(label) + "\"]\n")
+		}
+	}
+
+	// 遷移定義
+	sb.WriteString("\n    %% Transitions\n")
+	for _, trans := range act.Transitions {
+		source := mermaidID(trans.Source)
+		target := mermaidID(trans.Target)
+
+		if trans.Guard != "" {
+			sb.WriteString("    " + source + " -->|\"" + escapeMermaid(trans.Guard) + "\"| " + target + "\n")
+		} else {
+			sb.WriteString("    " + source + " --> " + target + "\n")
+		}
+	}
+
+	// fork/join を太い横線で表示
+	if len(bars) > 0 {
+		sb.WriteString("\n    classDef bar fill:#000,stroke:#000,color:#000,font-size:2px\n")
+		sb.WriteString("    class " + strings.Join(bars, ",") + " bar\n")
+	}
+
+	return sb.String()
+}
+
+// ActivityPlantUML はアクティビティ図を PlantUML（グラフ記法）で生成
+//
+// initial/final は (*)、fork/join は同期バー ===ID===、
+// action と decision/merge は名前付きアクティビティとして出力する。
+// ガード条件は -->[guard] として出力する。
+func ActivityPlantUML(act *core.ActivityEntity) string {
+	var sb strings.Builder
+
+	sb.WriteString("@startuml\n")
+	if act.Title != "" {
+		sb.WriteString("title " + escapePlantUML(act.Title) + "\n")
+	}
+	sb.WriteString("\n")
+
+	nodes := make(map[string]core.ActivityNode, len(act.Nodes))
+	for _, node := range act.Nodes {
+		nodes[node.ID] = node
+	}
+
+	// 初出時のみ "ラベル" as alias で宣言し、以降は alias で参照する
+	declared := make(map[string]bool)
+	ref := func(nodeID string) string {
+		node, ok := nodes[nodeID]
+		if !ok {
+			return "\"" + escapePlantUML(nodeID) + "\""
+		}
+
+		alias := mermaidID(node.ID)
+		switch node.Type {
+		case core.ActivityNodeTypeInitial, core.ActivityNodeTypeFinal:
+			return "(*)"
+		case core.ActivityNodeTypeFork, core.ActivityNodeTypeJoin:
+			return "===" + alias + "==="
+		}
+
+		if declared[nodeID] {
+			return alias
+		}
+		declared[nodeID] = true
+
+		label := node.Name
+		if label == "" {
+			label = string(node.Type)
+		}
+		if node.Type == core.ActivityNodeTypeDecision || node.Type == core.ActivityNodeTypeMerge {
+			label = "◇ " + label
+		}
+		return "\"" + escapePlantUML(label) + "\" as " + alias
+	}
+
+	for _, trans := range act.Transitions {
+		arrow := " --> "
+		if trans.Guard != "" {
+			arrow = " -->[" + escapePlantUML(trans.Guard) + "] "
+		}
+		sb.WriteString(ref(trans.Source) + arrow + ref(trans.Target) + "\n")
+	}
+
+	sb.WriteString("\n@enduml\n")
+	return sb.String()
+}
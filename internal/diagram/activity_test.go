@@ -0,0 +1,106 @@
+package diagram
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+func sampleActivity() *core.ActivityEntity {
+	return &core.ActivityEntity{
+		ID:    "act-001",
+		Title: "ログイン \"フロー\"",
+		Nodes: []core.ActivityNode{
+			{ID: "n-start", Type: core.ActivityNodeTypeInitial},
+			{ID: "n-input", Type: core.ActivityNodeTypeAction, Name: "認証情報入力"},
+			{ID: "n-check", Type: core.ActivityNodeTypeDecision, Name: "認証成功？"},
+			{ID: "n-fork", Type: core.ActivityNodeTypeFork},
+			{ID: "n-log", Type: core.ActivityNodeTypeAction, Name: "ログ記録"},
+			{ID: "n-home", Type: core.ActivityNodeTypeAction, Name: "ホーム表示"},
+			{ID: "n-join", Type: core.ActivityNodeTypeJoin},
+			{ID: "n-end", Type: core.ActivityNodeTypeFinal},
+		},
+		Transitions: []core.ActivityTransition{
+			{ID: "t1", Source: "n-start", Target: "n-input"},
+			{ID: "t2", Source: "n-input", Target: "n-check"},
+			{ID: "t3", Source: "n-check", Target: "n-fork", Guard: "[成功]"},
+			{ID: "t4", Source: "n-check", Target: "n-input", Guard: "[失敗]"},
+			{ID: "t5", Source: "n-fork", Target: "n-log"},
+			{ID: "t6", Source: "n-fork", Target: "n-home"},
+			{ID: "t7", Source: "n-log", Target: "n-join"},
+			{ID: "t8", Source: "n-home", Target: "n-join"},
+			{ID: "t9", Source: "n-join", Target: "n-end"},
+		},
+	}
+}
+
+func TestActivityMermaid(t *testing.T) {
+	out := ActivityMermaid(sampleActivity())
+
+	wants := []string{
+		"flowchart TD",
+		"n_start((●))",
+		"n_end(((◉)))",
+		`n_input("認証情報入力")`,
+		`n_check{"認証成功？"}`,
+		`n_check -->|"[成功]"| n_fork`,
+		"class n_fork,n_join bar",
+	}
+	for _, want := range wants {
+		if !strings.Contains(out, want) {
+			t.Errorf("Mermaid 出力に %q が含まれていません:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, `"フロー"`) {
+		t.Error("タイトルのダブルクォートがエスケープされていません")
+	}
+}
+
+func TestActivityPlantUML(t *testing.T) {
+	out := ActivityPlantUML(sampleActivity())
+
+	wants := []string{
+		"@startuml",
+		"(*) --> \"認証情報入力\" as n_input",
+		"n_input --> \"◇ 認証成功？\" as n_check",
+		"n_check -->[[成功]] ===n_fork===",
+		"n_check -->[[失敗]] n_input",
+		"===n_join=== --> (*)",
+		"@enduml",
+	}
+	for _, want := range wants {
+		if !strings.Contains(out, want) {
+			t.Errorf("PlantUML 出力に %q が含まれていません:\n%s", want, out)
+		}
+	}
+	// 宣言は初出の 1 回のみ
+	if strings.Count(out, "as n_input") != 1 {
+		t.Errorf("n_input の宣言が重複しています:\n%s", out)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Format
+		ok    bool
+	}{
+		{"", FormatMermaid, true},
+		{"mermaid", FormatMermaid, true},
+		{"PlantUML", FormatPlantUML, true},
+		{"svg", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseFormat(tt.input)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("ParseFormat(%q) = (%q, %v), want (%q, %v)", tt.input, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestActivity_UnsupportedFormat(t *testing.T) {
+	if _, err := Activity(sampleActivity(), Format("svg")); err == nil {
+		t.Error("未対応の形式でエラーが返されるべきです")
+	}
+}
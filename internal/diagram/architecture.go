@@ -0,0 +1,117 @@
+package diagram
+
+import (
+	"strings"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// Architecture は C4 スタイルのアーキテクチャ図の入力
+type Architecture struct {
+	Subsystems []core.SubsystemEntity
+	Containers []core.ContainerEntity
+	Components []core.ComponentEntity
+}
+
+// ArchitectureMermaid はアーキテクチャ図を Mermaid flowchart で生成
+//
+// Mermaid の C4 図は実験的機能のため、flowchart の subgraph で C4 の境界を表現する:
+//   - サブシステム: 境界（subgraph）
+//   - コンポーネントを持つコンテナ: 境界（subgraph）、持たないコンテナ: ノード
+//   - 関係: ラベル付きの矢印（"label [technology]"）
+func ArchitectureMermaid(arch Architecture) string {
+	var sb strings.Builder
+
+	sb.WriteString("flowchart LR\n")
+
+	// コンテナごとのコンポーネント
+	componentsByContainer := make(map[string][]core.ComponentEntity)
+	for _, comp := range arch.Components {
+		componentsByContainer[comp.ContainerID] = append(componentsByContainer[comp.ContainerID], comp)
+	}
+
+	// サブシステムごとのコンテナ（存在しないサブシステムへの所属は境界外）
+	subsystemIDs := make(map[string]bool, len(arch.Subsystems))
+	for _, sub := range arch.Subsystems {
+		subsystemIDs[sub.ID] = true
+	}
+	containersBySubsystem := make(map[string][]core.ContainerEntity)
+	for _, ctr := range arch.Containers {
+		key := ctr.SubsystemID
+		if !subsystemIDs[key] {
+			key = ""
+		}
+		containersBySubsystem[key] = append(containersBySubsystem[key], ctr)
+	}
+
+	for _, sub := range arch.Subsystems {
+		containers := containersBySubsystem[sub.ID]
+		if len(containers) == 0 {
+			continue
+		}
+		sb.WriteString("\n    subgraph " + mermaidID(sub.ID) + "[\"" + escapeMermaid(sub.Name) + "\"]\n")
+		for _, ctr := range containers {
+			writeMermaidContainer(&sb, ctr, componentsByContainer[ctr.ID], "        ")
+		}
+		sb.WriteString("    end\n")
+	}
+	if containers := containersBySubsystem[""]; len(containers) > 0 {
+		sb.WriteString("\n")
+		for _, ctr := range containers {
+			writeMermaidContainer(&sb, ctr, componentsByContainer[ctr.ID], "    ")
+		}
+	}
+
+	// 関係
+	sb.WriteString("\n")
+	for _, ctr := range arch.Containers {
+		writeMermaidRelations(&sb, ctr.ID, ctr.Relations)
+	}
+	for _, comp := range arch.Components {
+		writeMermaidRelations(&sb, comp.ID, comp.Relations)
+	}
+
+	sb.WriteString("\n    classDef container fill:#438dd5,stroke:#3c7fc0,color:#fff\n")
+	sb.WriteString("    classDef component fill:#85bbf0,stroke:#78a8d8,color:#000\n")
+
+	return sb.String()
+}
+
+// writeMermaidContainer はコンテナ（とその配下のコンポーネント）を出力
+func writeMermaidContainer(sb *strings.Builder, ctr core.ContainerEntity, components []core.ComponentEntity, indent string) {
+	label := architectureLabel(ctr.Name, ctr.Technology)
+	if len(components) == 0 {
+		sb.WriteString(indent + mermaidID(ctr.ID) + "[\"" + label + "\"]:::container\n")
+		return
+	}
+
+	sb.WriteString(indent + "subgraph " + mermaidID(ctr.ID) + "[\"" + label + "\"]\n")
+	for _, comp := range components {
+		sb.WriteString(indent + "    " + mermaidID(comp.ID) + "[\"" + architectureLabel(comp.Name, comp.Technology) + "\"]:::component\n")
+	}
+	sb.WriteString(indent + "end\n")
+}
+
+// writeMermaidRelations は関係を矢印として出力
+func writeMermaidRelations(sb *strings.Builder, sourceID string, relations []core.ArchitectureRelation) {
+	for _, rel := range relations {
+		label := rel.Label
+		if rel.Technology != "" {
+			label = strings.TrimSpace(label + " [" + rel.Technology + "]")
+		}
+		if label == "" {
+			sb.WriteString("    " + mermaidID(sourceID) + " --> " + mermaidID(rel.TargetID) + "\n")
+			continue
+		}
+		sb.WriteString("    " + mermaidID(sourceID) + " -->|\"" + escapeMermaid(label) + "\"| " + mermaidID(rel.TargetID) + "\n")
+	}
+}
+
+// architectureLabel は名前と技術スタックからラベルを生成
+func architectureLabel(name, technology string) string {
+	label := escapeMermaid(name)
+	if technology != "" {
+		label += "<br/>[" + escapeMermaid(technology) + "]"
+	}
+	return label
+}
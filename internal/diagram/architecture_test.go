@@ -0,0 +1,42 @@
+package diagram
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+func TestArchitectureMermaid(t *testing.T) {
+	arch := Architecture{
+		Subsystems: []core.SubsystemEntity{{ID: "sub-core", Name: "コア"}},
+		Containers: []core.ContainerEntity{
+			{ID: "ctr-web", Name: "Web", Technology: "SvelteKit",
+				Relations: []core.ArchitectureRelation{{TargetID: "cmp-auth", Label: "ログイン", Technology: "HTTPS"}}},
+			{ID: "ctr-api", Name: "API", Technology: "Go", SubsystemID: "sub-core"},
+			{ID: "ctr-db", Name: "DB", SubsystemID: "sub-core"},
+		},
+		Components: []core.ComponentEntity{
+			{ID: "cmp-auth", Name: "認証", ContainerID: "ctr-api",
+				Relations: []core.ArchitectureRelation{{TargetID: "ctr-db"}}},
+		},
+	}
+
+	out := ArchitectureMermaid(arch)
+
+	wants := []string{
+		"flowchart LR",
+		`subgraph sub_core["コア"]`,
+		`subgraph ctr_api["API<br/>[Go]"]`,
+		`cmp_auth["認証"]:::component`,
+		`ctr_db["DB"]:::container`,
+		`ctr_web["Web<br/>[SvelteKit]"]:::container`,
+		`ctr_web -->|"ログイン [HTTPS]"| cmp_auth`,
+		"cmp_auth --> ctr_db",
+	}
+	for _, want := range wants {
+		if !strings.Contains(out, want) {
+			t.Errorf("Mermaid 出力に %q が含まれていません:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// Package diagram は Zeus のエンティティから図のソース（Mermaid / PlantUML）を生成する。
+// CLI とダッシュボードの双方から利用される。
+package diagram
+
+import "strings"
+
+// Format は図の出力形式
+type Format string
+
+const (
+	FormatMermaid  Format = "mermaid"
+	FormatPlantUML Format = "plantuml"
+)
+
+// ParseFormat は文字列を Format に変換（空文字は Mermaid）
+func ParseFormat(s string) (Format, bool) {
+	switch Format(strings.ToLower(s)) {
+	case "", FormatMermaid:
+		return FormatMermaid, true
+	case FormatPlantUML:
+		return FormatPlantUML, true
+	default:
+		return "", false
+	}
+}
+
+// mermaidID は Mermaid のノード ID として使える形式に変換
+func mermaidID(id string) string {
+	return strings.ReplaceAll(id, "-", "_")
+}
+
+// escapeMermaid は Mermaid ラベル用にエスケープ
+func escapeMermaid(s string) string {
+	s = strings.ReplaceAll(s, "\"", "'")
+	s = strings.ReplaceAll(s, "<", "&lt;")
+	s = strings.ReplaceAll(s, ">", "&gt;")
+	return s
+}
+
+// escapePlantUML は PlantUML の文字列リテラル用にエスケープ
+func escapePlantUML(s string) string {
+	s = strings.ReplaceAll(s, "\"", "'")
+	s = strings.ReplaceAll(s, "\r", "")
+	s = strings.ReplaceAll(s, "\n", " ")
+	return s
+}
@@ -0,0 +1,133 @@
+package diagram
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// Structure は図を構成するノードとエッジ（テキストではなく構造で比較するための表現）
+// キーはノード ID または "source -> target"、値は表示ラベル
+type Structure struct {
+	Nodes map[string]string
+	Edges map[string]string
+}
+
+// ElementChange は追加・削除・変更されたノードまたはエッジ
+type ElementChange struct {
+	Key    string `json:"key"`
+	Before string `json:"before,omitempty"` // 削除・変更の場合の変更前のラベル
+	After  string `json:"after,omitempty"`  // 追加・変更の場合の変更後のラベル
+}
+
+// StructureDiff は 2 つの図の構造の差分（各リストはキー順）
+type StructureDiff struct {
+	AddedNodes   []ElementChange `json:"added_nodes"`
+	RemovedNodes []ElementChange `json:"removed_nodes"`
+	ChangedNodes []ElementChange `json:"changed_nodes"`
+	AddedEdges   []ElementChange `json:"added_edges"`
+	RemovedEdges []ElementChange `json:"removed_edges"`
+	ChangedEdges []ElementChange `json:"changed_edges"`
+}
+
+// Empty は差分が無いか
+func (d StructureDiff) Empty() bool {
+	return len(d.AddedNodes)+len(d.RemovedNodes)+len(d.ChangedNodes)+
+		len(d.AddedEdges)+len(d.RemovedEdges)+len(d.ChangedEdges) == 0
+}
+
+// DiffStructure は before から after への構造の差分を返す
+func DiffStructure(before, after Structure) StructureDiff {
+	var d StructureDiff
+	d.AddedNodes, d.RemovedNodes, d.ChangedNodes = diffElements(before.Nodes, after.Nodes)
+	d.AddedEdges, d.RemovedEdges, d.ChangedEdges = diffElements(before.Edges, after.Edges)
+	return d
+}
+
+func diffElements(before, after map[string]string) (added, removed, changed []ElementChange) {
+	added, removed, changed = []ElementChange{}, []ElementChange{}, []ElementChange{}
+	for _, key := range sortedKeys(after) {
+		old, ok := before[key]
+		switch {
+		case !ok:
+			added = append(added, ElementChange{Key: key, After: after[key]})
+		case old != after[key]:
+			changed = append(changed, ElementChange{Key: key, Before: old, After: after[key]})
+		}
+	}
+	for _, key := range sortedKeys(before) {
+		if _, ok := after[key]; !ok {
+			removed = append(removed, ElementChange{Key: key, Before: before[key]})
+		}
+	}
+	return added, removed, changed
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// edgeKey はエッジのキー（同じノード間に複数のエッジがある場合は 2 つ目以降に "#2" などを付ける）
+func edgeKey(edges map[string]string, source, target string) string {
+	key := source + " -> " + target
+	for i := 2; ; i++ {
+		if _, ok := edges[key]; !ok {
+			return key
+		}
+		key = fmt.Sprintf("%s -> %s #%d", source, target, i)
+	}
+}
+
+// ActivityStructure はアクティビティ図の構造（ノード: 種別と名前、エッジ: ガード条件）
+func ActivityStructure(act *core.ActivityEntity) Structure {
+	s := Structure{Nodes: map[string]string{}, Edges: map[string]string{}}
+	for _, node := range act.Nodes {
+		s.Nodes[node.ID] = joinLabel(string(node.Type), node.Name)
+	}
+	for _, trans := range act.Transitions {
+		s.Edges[edgeKey(s.Edges, trans.Source, trans.Target)] = trans.Guard
+	}
+	return s
+}
+
+// StateMachineStructure はステートマシン図の構造（ノード: 種別と名前、エッジ: トリガーとガード条件）
+func StateMachineStructure(sm *core.StateMachineEntity) Structure {
+	s := Structure{Nodes: map[string]string{}, Edges: map[string]string{}}
+	for _, state := range sm.States {
+		s.Nodes[state.ID] = joinLabel(string(state.Type), state.Name)
+	}
+	for _, trans := range sm.Transitions {
+		s.Edges[edgeKey(s.Edges, trans.Source, trans.Target)] = strings.TrimSpace(trans.Trigger + " " + trans.Guard)
+	}
+	return s
+}
+
+// UseCaseStructure はユースケース単体のユースケース図の構造
+// ノード: ユースケース・アクター・関係先のユースケース、エッジ: アクターの役割と UseCase 間の関係
+func UseCaseStructure(uc *core.UseCaseEntity) Structure {
+	s := Structure{Nodes: map[string]string{}, Edges: map[string]string{}}
+	s.Nodes[uc.ID] = joinLabel("usecase", uc.Title)
+	for _, ref := range uc.Actors {
+		s.Nodes[ref.ActorID] = "actor"
+		s.Edges[edgeKey(s.Edges, ref.ActorID, uc.ID)] = string(ref.Role)
+	}
+	for _, rel := range uc.Relations {
+		s.Nodes[rel.TargetID] = "usecase"
+		s.Edges[edgeKey(s.Edges, uc.ID, rel.TargetID)] = strings.TrimSpace("<<" + string(rel.Type) + ">> " + rel.Condition)
+	}
+	return s
+}
+
+func joinLabel(kind, name string) string {
+	if name == "" {
+		return kind
+	}
+	return kind + ": " + name
+}
@@ -0,0 +1,84 @@
+package diagram
+
+import (
+	"testing"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+func TestDiffStructure_Activity(t *testing.T) {
+	before := &core.ActivityEntity{
+		Nodes: []core.ActivityNode{
+			{ID: "node-001", Type: core.ActivityNodeTypeInitial},
+			{ID: "node-002", Type: core.ActivityNodeTypeAction, Name: "入力"},
+			{ID: "node-003", Type: core.ActivityNodeTypeAction, Name: "確認"},
+			{ID: "node-004", Type: core.ActivityNodeTypeFinal},
+		},
+		Transitions: []core.ActivityTransition{
+			{ID: "trans-001", Source: "node-001", Target: "node-002"},
+			{ID: "trans-002", Source: "node-002", Target: "node-003"},
+			{ID: "trans-003", Source: "node-003", Target: "node-004"},
+		},
+	}
+	after := &core.ActivityEntity{
+		Nodes: []core.ActivityNode{
+			{ID: "node-001", Type: core.ActivityNodeTypeInitial},
+			{ID: "node-002", Type: core.ActivityNodeTypeAction, Name: "入力する"},
+			{ID: "node-004", Type: core.ActivityNodeTypeFinal},
+			{ID: "node-005", Type: core.ActivityNodeTypeDecision, Name: "妥当？"},
+		},
+		// 遷移 ID の付け替えは差分にならない
+		Transitions: []core.ActivityTransition{
+			{ID: "trans-010", Source: "node-001", Target: "node-002"},
+			{ID: "trans-011", Source: "node-002", Target: "node-005"},
+			{ID: "trans-012", Source: "node-005", Target: "node-004", Guard: "[OK]"},
+		},
+	}
+
+	d := DiffStructure(ActivityStructure(before), ActivityStructure(after))
+	if len(d.AddedNodes) != 1 || d.AddedNodes[0].Key != "node-005" || d.AddedNodes[0].After != "decision: 妥当？" {
+		t.Errorf("AddedNodes = %+v", d.AddedNodes)
+	}
+	if len(d.RemovedNodes) != 1 || d.RemovedNodes[0].Key != "node-003" {
+		t.Errorf("RemovedNodes = %+v", d.RemovedNodes)
+	}
+	if len(d.ChangedNodes) != 1 || d.ChangedNodes[0].Before != "action: 入力" || d.ChangedNodes[0].After != "action: 入力する" {
+		t.Errorf("ChangedNodes = %+v", d.ChangedNodes)
+	}
+	if len(d.AddedEdges) != 2 || len(d.RemovedEdges) != 2 || len(d.ChangedEdges) != 0 {
+		t.Errorf("edges: added %+v, removed %+v, changed %+v", d.AddedEdges, d.RemovedEdges, d.ChangedEdges)
+	}
+	if d.AddedEdges[1].Key != "node-005 -> node-004" || d.AddedEdges[1].After != "[OK]" {
+		t.Errorf("AddedEdges = %+v", d.AddedEdges)
+	}
+
+	if !DiffStructure(ActivityStructure(before), ActivityStructure(before)).Empty() {
+		t.Error("同じ図の差分が空になっていません")
+	}
+}
+
+func TestDiffStructure_StateMachineAndUseCase(t *testing.T) {
+	sm := func(trigger string) *core.StateMachineEntity {
+		return &core.StateMachineEntity{
+			States: []core.StateMachineState{
+				{ID: "st-1", Type: core.StateTypeInitial},
+				{ID: "st-2", Type: core.StateTypeState, Name: "申請中"},
+			},
+			Transitions: []core.StateMachineTransition{
+				{ID: "tr-1", Source: "st-1", Target: "st-2", Trigger: trigger},
+			},
+		}
+	}
+	d := DiffStructure(StateMachineStructure(sm("submit")), StateMachineStructure(sm("apply")))
+	if len(d.ChangedEdges) != 1 || d.ChangedEdges[0].Before != "submit" || d.ChangedEdges[0].After != "apply" {
+		t.Errorf("ChangedEdges = %+v", d.ChangedEdges)
+	}
+
+	uc := sampleUseCaseDiagram().UseCases[0]
+	changed := uc
+	changed.Actors = nil
+	d = DiffStructure(UseCaseStructure(&uc), UseCaseStructure(&changed))
+	if len(d.RemovedNodes) != 1 || d.RemovedNodes[0].Key != "actor-user" || len(d.RemovedEdges) != 1 {
+		t.Errorf("diff = %+v", d)
+	}
+}
@@ -0,0 +1,199 @@
+package diagram
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// 画像レンダリングのデフォルト設定
+const (
+	defaultKrokiURL        = "https://kroki.io"
+	defaultMermaidCLI      = "mmdc"
+	defaultRenderTimeout   = 30 * time.Second
+	maxKrokiErrorBodyBytes = 512
+)
+
+// ErrRenderingNotConfigured は zeus.yaml に rendering.engine が設定されていないことを示す
+var ErrRenderingNotConfigured = errors.New("image rendering is not configured (set rendering.engine in zeus.yaml)")
+
+// ImageFormat は画像の出力形式
+type ImageFormat string
+
+const (
+	ImageFormatPNG ImageFormat = "png"
+	ImageFormatSVG ImageFormat = "svg"
+)
+
+// ParseImageFormat は文字列を ImageFormat に変換（空文字は SVG）
+func ParseImageFormat(s string) (ImageFormat, bool) {
+	switch ImageFormat(strings.ToLower(s)) {
+	case "", ImageFormatSVG:
+		return ImageFormatSVG, true
+	case ImageFormatPNG:
+		return ImageFormatPNG, true
+	default:
+		return "", false
+	}
+}
+
+// IsImageFormat は文字列が画像形式（png / svg）か判定
+func IsImageFormat(s string) bool {
+	switch ImageFormat(strings.ToLower(s)) {
+	case ImageFormatPNG, ImageFormatSVG:
+		return true
+	default:
+		return false
+	}
+}
+
+// ContentType は画像形式の MIME タイプを返す
+func (f ImageFormat) ContentType() string {
+	if f == ImageFormatPNG {
+		return "image/png"
+	}
+	return "image/svg+xml"
+}
+
+// ImageRenderer は図のソースを外部ツール（Kroki / mermaid-cli）で画像化する
+type ImageRenderer struct {
+	settings core.RenderingSettings
+	client   *http.Client
+	// runCommand は mermaid-cli の実行関数（テストで差し替え可能）
+	runCommand func(ctx context.Context, name string, args ...string) ([]byte, error)
+}
+
+// NewImageRenderer は zeus.yaml の rendering セクションから ImageRenderer を生成
+func NewImageRenderer(settings core.RenderingSettings) (*ImageRenderer, error) {
+	switch settings.Engine {
+	case "":
+		return nil, ErrRenderingNotConfigured
+	case "kroki", "mermaid-cli":
+		// 有効
+	default:
+		return nil, fmt.Errorf("unsupported rendering engine: %s (kroki or mermaid-cli)", settings.Engine)
+	}
+
+	if settings.KrokiURL == "" {
+		settings.KrokiURL = defaultKrokiURL
+	}
+	if settings.MermaidCLI == "" {
+		settings.MermaidCLI = defaultMermaidCLI
+	}
+
+	return &ImageRenderer{
+		settings:   settings,
+		client:     &http.Client{},
+		runCommand: runExternalCommand,
+	}, nil
+}
+
+// Render は図のソースを画像に変換
+func (r *ImageRenderer) Render(ctx context.Context, source string, format Format, image ImageFormat) ([]byte, error) {
+	timeout := defaultRenderTimeout
+	if r.settings.Timeout > 0 {
+		timeout = time.Duration(r.settings.Timeout) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	source = stripCodeFence(source)
+
+	switch r.settings.Engine {
+	case "kroki":
+		return r.renderKroki(ctx, source, format, image)
+	default:
+		return r.renderMermaidCLI(ctx, source, format, image)
+	}
+}
+
+// renderKroki は Kroki の POST API（/{diagram_type}/{output_format}）で画像化
+func (r *ImageRenderer) renderKroki(ctx context.Context, source string, format Format, image ImageFormat) ([]byte, error) {
+	url := strings.TrimRight(r.settings.KrokiURL, "/") + "/" + string(format) + "/" + string(image)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(source))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kroki request: %w", err)
+	}
+	req.Header.Set("Content-Type", "text/plain")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("kroki request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxKrokiErrorBodyBytes))
+		return nil, fmt.Errorf("kroki returned %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kroki response: %w", err)
+	}
+	return data, nil
+}
+
+// renderMermaidCLI は mermaid-cli（mmdc）で画像化（Mermaid のみ対応）
+func (r *ImageRenderer) renderMermaidCLI(ctx context.Context, source string, format Format, image ImageFormat) ([]byte, error) {
+	if format != FormatMermaid {
+		return nil, fmt.Errorf("mermaid-cli supports only mermaid diagrams (got %s)", format)
+	}
+
+	dir, err := os.MkdirTemp("", "zeus-diagram-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "input.mmd")
+	output := filepath.Join(dir, "output."+string(image))
+	if err := os.WriteFile(input, []byte(source), 0600); err != nil {
+		return nil, fmt.Errorf("failed to write diagram source: %w", err)
+	}
+
+	if out, err := r.runCommand(ctx, r.settings.MermaidCLI, "-i", input, "-o", output); err != nil {
+		return nil, fmt.Errorf("mermaid-cli failed: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	data, err := os.ReadFile(output)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rendered image: %w", err)
+	}
+	return data, nil
+}
+
+// runExternalCommand は外部コマンドを実行し、標準出力と標準エラーを返す
+func runExternalCommand(ctx context.Context, name string, args ...string) ([]byte, error) {
+	var out bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	err := cmd.Run()
+	return out.Bytes(), err
+}
+
+// stripCodeFence は Markdown のコードブロック（```mermaid ... ```）を取り除く
+func stripCodeFence(source string) string {
+	trimmed := strings.TrimSpace(source)
+	if !strings.HasPrefix(trimmed, "```") {
+		return source
+	}
+	lines := strings.Split(trimmed, "\n")
+	lines = lines[1:]
+	if n := len(lines); n > 0 && strings.TrimSpace(lines[n-1]) == "```" {
+		lines = lines[:n-1]
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
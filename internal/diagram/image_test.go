@@ -0,0 +1,116 @@
+package diagram
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+func TestParseImageFormat(t *testing.T) {
+	tests := []struct {
+		in   string
+		want ImageFormat
+		ok   bool
+	}{
+		{"", ImageFormatSVG, true},
+		{"svg", ImageFormatSVG, true},
+		{"PNG", ImageFormatPNG, true},
+		{"pdf", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseImageFormat(tt.in)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("ParseImageFormat(%q) = (%q, %v), want (%q, %v)", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+	if ImageFormatPNG.ContentType() != "image/png" || ImageFormatSVG.ContentType() != "image/svg+xml" {
+		t.Error("ContentType が正しくありません")
+	}
+}
+
+func TestNewImageRenderer_NotConfigured(t *testing.T) {
+	if _, err := NewImageRenderer(core.RenderingSettings{}); !errors.Is(err, ErrRenderingNotConfigured) {
+		t.Errorf("未設定時は ErrRenderingNotConfigured を返すべき: %v", err)
+	}
+	if _, err := NewImageRenderer(core.RenderingSettings{Engine: "graphviz"}); err == nil {
+		t.Error("未対応エンジンでエラーになっていません")
+	}
+}
+
+func TestImageRenderer_Kroki(t *testing.T) {
+	var gotPath, gotBody string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotPath = r.URL.Path
+		gotBody = string(body)
+		if strings.Contains(gotBody, "invalid") {
+			http.Error(w, "syntax error", http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte("<svg></svg>"))
+	}))
+	defer ts.Close()
+
+	r, err := NewImageRenderer(core.RenderingSettings{Engine: "kroki", KrokiURL: ts.URL + "/"})
+	if err != nil {
+		t.Fatalf("NewImageRenderer でエラー: %v", err)
+	}
+
+	data, err := r.Render(context.Background(), "```mermaid\ngraph TD\n  a --> b\n```\n", FormatMermaid, ImageFormatSVG)
+	if err != nil {
+		t.Fatalf("Render でエラー: %v", err)
+	}
+	if string(data) != "<svg></svg>" {
+		t.Errorf("画像データが正しくありません: %q", data)
+	}
+	if gotPath != "/mermaid/svg" {
+		t.Errorf("Kroki のパスが正しくありません: %s", gotPath)
+	}
+	if strings.Contains(gotBody, "```") {
+		t.Errorf("コードフェンスが除去されていません: %q", gotBody)
+	}
+
+	if _, err := r.Render(context.Background(), "invalid", FormatPlantUML, ImageFormatPNG); err == nil || !strings.Contains(err.Error(), "syntax error") {
+		t.Errorf("Kroki のエラーが伝搬されていません: %v", err)
+	}
+}
+
+func TestImageRenderer_MermaidCLI(t *testing.T) {
+	r, err := NewImageRenderer(core.RenderingSettings{Engine: "mermaid-cli"})
+	if err != nil {
+		t.Fatalf("NewImageRenderer でエラー: %v", err)
+	}
+
+	var gotName string
+	r.runCommand = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		gotName = name
+		// mmdc -i <input> -o <output> を模倣
+		src, err := os.ReadFile(args[1])
+		if err != nil {
+			return nil, err
+		}
+		return nil, os.WriteFile(args[3], append([]byte("PNG:"), src...), 0600)
+	}
+
+	data, err := r.Render(context.Background(), "graph TD\n", FormatMermaid, ImageFormatPNG)
+	if err != nil {
+		t.Fatalf("Render でエラー: %v", err)
+	}
+	if gotName != "mmdc" {
+		t.Errorf("デフォルトの実行ファイルが mmdc ではありません: %s", gotName)
+	}
+	if string(data) != "PNG:graph TD\n" {
+		t.Errorf("画像データが正しくありません: %q", data)
+	}
+
+	if _, err := r.Render(context.Background(), "@startuml\n@enduml\n", FormatPlantUML, ImageFormatPNG); err == nil {
+		t.Error("mermaid-cli で PlantUML を受け付けています")
+	}
+}
@@ -0,0 +1,69 @@
+package diagram
+
+import (
+	"fmt"
+
+	"github.com/biwakonbu/zeus/internal/analysis"
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// Renderer は図の出力エンジン（Mermaid / PlantUML）
+// CLI の --engine フラグと API の ?engine= クエリで選択する
+type Renderer interface {
+	// Format は出力形式を返す
+	Format() Format
+	// UseCase はユースケース図を生成
+	UseCase(d UseCaseDiagram) string
+	// Activity はアクティビティ図を生成
+	Activity(act *core.ActivityEntity) string
+	// DependencyGraph は依存関係グラフを生成
+	DependencyGraph(graph *analysis.DependencyGraph) string
+	// UnifiedGraph は統合グラフを生成
+	UnifiedGraph(graph *analysis.UnifiedGraph) string
+}
+
+// NewRenderer は指定形式の Renderer を返す
+func NewRenderer(format Format) (Renderer, error) {
+	switch format {
+	case FormatMermaid:
+		return mermaidRenderer{}, nil
+	case FormatPlantUML:
+		return plantUMLRenderer{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported diagram format: %s", format)
+	}
+}
+
+// mermaidRenderer は Mermaid バックエンド
+type mermaidRenderer struct{}
+
+func (mermaidRenderer) Format() Format { return FormatMermaid }
+
+func (mermaidRenderer) UseCase(d UseCaseDiagram) string { return UseCaseMermaid(d) }
+
+func (mermaidRenderer) Activity(act *core.ActivityEntity) string { return ActivityMermaid(act) }
+
+func (mermaidRenderer) DependencyGraph(graph *analysis.DependencyGraph) string {
+	return graph.ToMermaid()
+}
+
+func (mermaidRenderer) UnifiedGraph(graph *analysis.UnifiedGraph) string {
+	return graph.ToMermaid()
+}
+
+// plantUMLRenderer は PlantUML バックエンド
+type plantUMLRenderer struct{}
+
+func (plantUMLRenderer) Format() Format { return FormatPlantUML }
+
+func (plantUMLRenderer) UseCase(d UseCaseDiagram) string { return UseCasePlantUML(d) }
+
+func (plantUMLRenderer) Activity(act *core.ActivityEntity) string { return ActivityPlantUML(act) }
+
+func (plantUMLRenderer) DependencyGraph(graph *analysis.DependencyGraph) string {
+	return graph.ToPlantUML()
+}
+
+func (plantUMLRenderer) UnifiedGraph(graph *analysis.UnifiedGraph) string {
+	return graph.ToPlantUML()
+}
@@ -0,0 +1,150 @@
+package diagram
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+func sampleUseCaseDiagram() UseCaseDiagram {
+	return UseCaseDiagram{
+		Actors: []core.ActorEntity{
+			{ID: "actor-user", Title: "利用者", Type: core.ActorTypeHuman},
+		},
+		UseCases: []core.UseCaseEntity{
+			{
+				ID:    "uc-login",
+				Title: "ログイン",
+				Actors: []core.UseCaseActorRef{
+					{ActorID: "actor-user", Role: core.ActorRolePrimary},
+				},
+				Relations: []core.UseCaseRelation{
+					{Type: core.RelationTypeInclude, TargetID: "uc-auth"},
+				},
+			},
+			{ID: "uc-auth", Title: "認証"},
+		},
+	}
+}
+
+func TestNewRenderer(t *testing.T) {
+	for _, format := range []Format{FormatMermaid, FormatPlantUML} {
+		r, err := NewRenderer(format)
+		if err != nil {
+			t.Fatalf("NewRenderer(%s) でエラー: %v", format, err)
+		}
+		if r.Format() != format {
+			t.Errorf("Format() = %s, want %s", r.Format(), format)
+		}
+	}
+
+	if _, err := NewRenderer(Format("svg")); err == nil {
+		t.Error("未対応形式でエラーになっていません")
+	}
+}
+
+func TestRenderer_UseCase(t *testing.T) {
+	d := sampleUseCaseDiagram()
+
+	mermaid, _ := NewRenderer(FormatMermaid)
+	out := mermaid.UseCase(d)
+	for _, want := range []string{"flowchart LR", "subgraph boundary[System]", "uc_login -.->|include| uc_auth"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Mermaid 出力に %q が含まれていません:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "```") {
+		t.Error("Mermaid 出力にコードフェンスが含まれています")
+	}
+
+	plantuml, _ := NewRenderer(FormatPlantUML)
+	out = plantuml.UseCase(d)
+	wants := []string{
+		"@startuml",
+		`actor "利用者" as actor_user`,
+		`rectangle "System" {`,
+		`usecase "ログイン" as uc_login`,
+		"actor_user --> uc_login",
+		"uc_login ..> uc_auth : <<include>>",
+		"@enduml",
+	}
+	for _, want := range wants {
+		if !strings.Contains(out, want) {
+			t.Errorf("PlantUML 出力に %q が含まれていません:\n%s", want, out)
+		}
+	}
+}
+
+func TestUseCaseDiagram_SubsystemGroups(t *testing.T) {
+	d := sampleUseCaseDiagram()
+	d.UseCases[0].SubsystemID = "sub-auth"
+	d.UseCases = append(d.UseCases, core.UseCaseEntity{ID: "uc-other", Title: "その他", SubsystemID: "sub-missing"})
+	d.Subsystems = []core.SubsystemEntity{
+		{ID: "sub-auth", Name: "認証基盤"},
+		{ID: "sub-empty", Name: "空"},
+	}
+
+	groups, rest := d.SubsystemGroups()
+	if len(groups) != 2 || len(groups[0].UseCases) != 1 || groups[0].UseCases[0].ID != "uc-login" || len(groups[1].UseCases) != 0 {
+		t.Errorf("groups = %+v", groups)
+	}
+	// サブシステム未設定・未知のサブシステムは境界の直下
+	if len(rest) != 2 || rest[0].ID != "uc-auth" || rest[1].ID != "uc-other" {
+		t.Errorf("rest = %+v", rest)
+	}
+
+	mermaid, _ := NewRenderer(FormatMermaid)
+	out := mermaid.UseCase(d)
+	for _, want := range []string{"subgraph sub_auth[認証基盤]", "            uc_login((ログイン))", "subgraph sub_empty[空]", "        uc_auth((認証))"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Mermaid 出力に %q が含まれていません:\n%s", want, out)
+		}
+	}
+
+	plantuml, _ := NewRenderer(FormatPlantUML)
+	out = plantuml.UseCase(d)
+	for _, want := range []string{`  rectangle "認証基盤" as sub_auth {`, `    usecase "ログイン" as uc_login`, `  usecase "認証" as uc_auth`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("PlantUML 出力に %q が含まれていません:\n%s", want, out)
+		}
+	}
+}
+
+func TestUseCaseDiagram_ForSubsystem(t *testing.T) {
+	d := sampleUseCaseDiagram()
+	d.Actors = append(d.Actors, core.ActorEntity{ID: "actor-admin", Title: "管理者", Type: core.ActorTypeHuman})
+	d.UseCases[0].SubsystemID = "sub-auth"
+
+	got := d.ForSubsystem(core.SubsystemEntity{ID: "sub-auth", Name: "認証基盤"})
+	if got.Boundary != "認証基盤" {
+		t.Errorf("Boundary = %q, want 認証基盤", got.Boundary)
+	}
+	if len(got.UseCases) != 1 || got.UseCases[0].ID != "uc-login" {
+		t.Fatalf("UseCases = %+v", got.UseCases)
+	}
+	// 図に含まれない UseCase への関係は描かない
+	if len(got.UseCases[0].Relations) != 0 {
+		t.Errorf("Relations = %+v", got.UseCases[0].Relations)
+	}
+	if len(got.Actors) != 1 || got.Actors[0].ID != "actor-user" {
+		t.Errorf("Actors = %+v", got.Actors)
+	}
+	// 元の図は変更しない
+	if len(d.UseCases[0].Relations) != 1 {
+		t.Error("元の図の関係が変更されています")
+	}
+
+	mermaid, _ := NewRenderer(FormatMermaid)
+	out := mermaid.UseCase(got)
+	if !strings.Contains(out, "subgraph boundary[認証基盤]") || strings.Contains(out, "uc_auth") {
+		t.Errorf("Mermaid 出力が不正です:\n%s", out)
+	}
+}
+
+func TestRenderer_Activity(t *testing.T) {
+	plantuml, _ := NewRenderer(FormatPlantUML)
+	if out := plantuml.Activity(sampleActivity()); !strings.Contains(out, "@startuml") {
+		t.Errorf("PlantUML アクティビティ図が生成されていません:\n%s", out)
+	}
+}
@@ -0,0 +1,73 @@
+package diagram
+
+import (
+	"strings"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// StateMachineMermaid はステートマシン図を Mermaid stateDiagram-v2 で生成
+//
+// initial / final は [*] として出力する。遷移ラベルは "trigger [guard]" 形式。
+// Activity に紐づく状態にはノートで Activity ID を付記する。
+func StateMachineMermaid(machine *core.StateMachineEntity) string {
+	var sb strings.Builder
+
+	sb.WriteString("stateDiagram-v2\n")
+	sb.WriteString("    %% " + escapeMermaid(machine.Title) + "\n\n")
+
+	// 状態定義（initial/final は [*] で表現するため宣言しない）
+	types := make(map[string]core.StateType, len(machine.States))
+	for _, state := range machine.States {
+		types[state.ID] = state.Type
+		if state.Type != core.StateTypeState {
+			continue
+		}
+		sb.WriteString("    state \"" + escapeMermaid(state.Name) + "\" as " + mermaidID(state.ID) + "\n")
+	}
+
+	// 遷移
+	sb.WriteString("\n")
+	for _, trans := range machine.Transitions {
+		source := mermaidID(trans.Source)
+		if types[trans.Source] == core.StateTypeInitial {
+			source = "[*]"
+		}
+		target := mermaidID(trans.Target)
+		if types[trans.Target] == core.StateTypeFinal {
+			target = "[*]"
+		}
+
+		line := "    " + source + " --> " + target
+		if label := transitionLabel(trans); label != "" {
+			line += " : " + escapeMermaid(label)
+		}
+		sb.WriteString(line + "\n")
+	}
+
+	// Activity への紐付け
+	for _, state := range machine.States {
+		if state.Type != core.StateTypeState || state.ActivityID == "" {
+			continue
+		}
+		sb.WriteString("    note right of " + mermaidID(state.ID) + " : " + state.ActivityID + "\n")
+	}
+
+	return sb.String()
+}
+
+// transitionLabel は遷移のラベル（トリガーとガード条件）を生成
+func transitionLabel(trans core.StateMachineTransition) string {
+	parts := make([]string, 0, 2)
+	if trans.Trigger != "" {
+		parts = append(parts, trans.Trigger)
+	}
+	if trans.Guard != "" {
+		guard := trans.Guard
+		if !strings.HasPrefix(guard, "[") {
+			guard = "[" + guard + "]"
+		}
+		parts = append(parts, guard)
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,45 @@
+package diagram
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+func TestStateMachineMermaid(t *testing.T) {
+	machine := &core.StateMachineEntity{
+		ID:    "sm-001",
+		Title: "注文ライフサイクル",
+		States: []core.StateMachineState{
+			{ID: "s-start", Type: core.StateTypeInitial},
+			{ID: "s-draft", Type: core.StateTypeState, Name: "下書き"},
+			{ID: "s-approved", Type: core.StateTypeState, Name: "承認済み", ActivityID: "act-001"},
+			{ID: "s-end", Type: core.StateTypeFinal},
+		},
+		Transitions: []core.StateMachineTransition{
+			{ID: "t1", Source: "s-start", Target: "s-draft"},
+			{ID: "t2", Source: "s-draft", Target: "s-approved", Trigger: "approve", Guard: "権限あり"},
+			{ID: "t3", Source: "s-approved", Target: "s-end", Trigger: "close"},
+		},
+	}
+
+	out := StateMachineMermaid(machine)
+
+	wants := []string{
+		"stateDiagram-v2",
+		`state "下書き" as s_draft`,
+		"[*] --> s_draft",
+		"s_draft --> s_approved : approve [権限あり]",
+		"s_approved --> [*] : close",
+		"note right of s_approved : act-001",
+	}
+	for _, want := range wants {
+		if !strings.Contains(out, want) {
+			t.Errorf("Mermaid 出力に %q が含まれていません:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "as s_start") {
+		t.Errorf("開始状態は宣言されるべきではありません:\n%s", out)
+	}
+}
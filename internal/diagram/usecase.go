@@ -0,0 +1,238 @@
+package diagram
+
+import (
+	"strings"
+
+	"github.com/biwakonbu/zeus/internal/core"
+)
+
+// UseCaseDiagram はユースケース図の入力
+type UseCaseDiagram struct {
+	Actors   []core.ActorEntity
+	UseCases []core.UseCaseEntity
+	Boundary string // システム境界名（空の場合は "System"）
+
+	// Subsystems を指定した場合は、システム境界の中にサブシステムごとのサブグラフを描く
+	// （どのサブシステムにも属さない UseCase はシステム境界の直下に描く）
+	Subsystems []core.SubsystemEntity
+}
+
+// UseCaseGroup はサブシステムと、そのサブシステムに属する UseCase
+type UseCaseGroup struct {
+	Subsystem core.SubsystemEntity
+	UseCases  []core.UseCaseEntity
+}
+
+// SubsystemGroups は UseCase をサブシステムごとに分ける（Subsystems の順）
+// どのサブシステムにも属さない UseCase は rest に返す（Subsystems が空の場合はすべて rest）
+func (d UseCaseDiagram) SubsystemGroups() (groups []UseCaseGroup, rest []core.UseCaseEntity) {
+	index := make(map[string]int, len(d.Subsystems))
+	for i, sub := range d.Subsystems {
+		index[sub.ID] = i
+		groups = append(groups, UseCaseGroup{Subsystem: sub})
+	}
+	for _, uc := range d.UseCases {
+		if i, ok := index[uc.SubsystemID]; ok && uc.SubsystemID != "" {
+			groups[i].UseCases = append(groups[i].UseCases, uc)
+		} else {
+			rest = append(rest, uc)
+		}
+	}
+	return groups, rest
+}
+
+// ForSubsystem は sub に属する UseCase と、それらに関連する Actor だけの図を返す
+// システム境界名はサブシステム名とし、図に含まれない UseCase との関係は描かない
+func (d UseCaseDiagram) ForSubsystem(sub core.SubsystemEntity) UseCaseDiagram {
+	included := map[string]bool{}
+	for _, uc := range d.UseCases {
+		if uc.SubsystemID == sub.ID {
+			included[uc.ID] = true
+		}
+	}
+
+	usecases := []core.UseCaseEntity{}
+	actorIDs := map[string]bool{}
+	for _, uc := range d.UseCases {
+		if !included[uc.ID] {
+			continue
+		}
+		relations := make([]core.UseCaseRelation, 0, len(uc.Relations))
+		for _, rel := range uc.Relations {
+			if included[rel.TargetID] {
+				relations = append(relations, rel)
+			}
+		}
+		uc.Relations = relations
+		for _, ref := range uc.Actors {
+			actorIDs[ref.ActorID] = true
+		}
+		usecases = append(usecases, uc)
+	}
+
+	actors := []core.ActorEntity{}
+	for _, actor := range d.Actors {
+		if actorIDs[actor.ID] {
+			actors = append(actors, actor)
+		}
+	}
+	return UseCaseDiagram{Actors: actors, UseCases: usecases, Boundary: sub.Name}
+}
+
+// boundaryName はシステム境界名を返す
+func (d UseCaseDiagram) boundaryName() string {
+	if d.Boundary == "" {
+		return "System"
+	}
+	return d.Boundary
+}
+
+// UseCaseMermaid はユースケース図を Mermaid flowchart で生成
+// Mermaid は標準でユースケース図をサポートしていないため、flowchart で近似的に表現する
+func UseCaseMermaid(d UseCaseDiagram) string {
+	var sb strings.Builder
+
+	sb.WriteString("flowchart LR\n")
+
+	// アクター定義
+	sb.WriteString("    %% Actors\n")
+	for _, actor := range d.Actors {
+		sb.WriteString("    " + mermaidID(actor.ID) + "[" + actorTypeEmoji(actor.Type) + " " + escapeMermaid(actor.Title) + "]\n")
+	}
+
+	// システム境界サブグラフ
+	sb.WriteString("\n    subgraph boundary[" + escapeMermaid(d.boundaryName()) + "]\n")
+
+	// ユースケース定義（サブシステムごとのサブグラフ、属さないものは境界の直下）
+	groups, rest := d.SubsystemGroups()
+	for _, g := range groups {
+		sb.WriteString("        subgraph " + mermaidID(g.Subsystem.ID) + "[" + escapeMermaid(g.Subsystem.Name) + "]\n")
+		for _, uc := range g.UseCases {
+			sb.WriteString("            " + mermaidID(uc.ID) + "((" + escapeMermaid(uc.Title) + "))\n")
+		}
+		sb.WriteString("        end\n")
+	}
+	sb.WriteString("        %% UseCases\n")
+	for _, uc := range rest {
+		sb.WriteString("        " + mermaidID(uc.ID) + "((" + escapeMermaid(uc.Title) + "))\n")
+	}
+
+	sb.WriteString("    end\n")
+
+	// アクターとユースケースの関連
+	sb.WriteString("\n    %% Actor-UseCase Relations\n")
+	for _, uc := range d.UseCases {
+		ucID := mermaidID(uc.ID)
+		for _, actorRef := range uc.Actors {
+			if actorRef.Role == core.ActorRolePrimary {
+				sb.WriteString("    " + mermaidID(actorRef.ActorID) + " ==> " + ucID + "\n")
+			} else {
+				sb.WriteString("    " + mermaidID(actorRef.ActorID) + " --> " + ucID + "\n")
+			}
+		}
+	}
+
+	// ユースケース間のリレーション
+	sb.WriteString("\n    %% UseCase Relations\n")
+	for _, uc := range d.UseCases {
+		ucID := mermaidID(uc.ID)
+		for _, rel := range uc.Relations {
+			targetID := mermaidID(rel.TargetID)
+			switch rel.Type {
+			case core.RelationTypeInclude:
+				sb.WriteString("    " + ucID + " -.->|include| " + targetID + "\n")
+			case core.RelationTypeExtend:
+				sb.WriteString("    " + targetID + " -.->|" + escapeMermaid(extendLabel(rel)) + "| " + ucID + "\n")
+			case core.RelationTypeGeneralize:
+				sb.WriteString("    " + ucID + " -->|generalize| " + targetID + "\n")
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// UseCasePlantUML はユースケース図を PlantUML で生成
+func UseCasePlantUML(d UseCaseDiagram) string {
+	var sb strings.Builder
+
+	sb.WriteString("@startuml\n")
+	sb.WriteString("left to right direction\n\n")
+
+	// アクター定義
+	for _, actor := range d.Actors {
+		sb.WriteString("actor \"" + escapePlantUML(actor.Title) + "\" as " + mermaidID(actor.ID) + "\n")
+	}
+
+	// システム境界
+	sb.WriteString("\nrectangle \"" + escapePlantUML(d.boundaryName()) + "\" {\n")
+	groups, rest := d.SubsystemGroups()
+	for _, g := range groups {
+		sb.WriteString("  rectangle \"" + escapePlantUML(g.Subsystem.Name) + "\" as " + mermaidID(g.Subsystem.ID) + " {\n")
+		for _, uc := range g.UseCases {
+			sb.WriteString("    usecase \"" + escapePlantUML(uc.Title) + "\" as " + mermaidID(uc.ID) + "\n")
+		}
+		sb.WriteString("  }\n")
+	}
+	for _, uc := range rest {
+		sb.WriteString("  usecase \"" + escapePlantUML(uc.Title) + "\" as " + mermaidID(uc.ID) + "\n")
+	}
+	sb.WriteString("}\n\n")
+
+	// アクターとユースケースの関連（primary は実線、secondary は点線）
+	for _, uc := range d.UseCases {
+		ucID := mermaidID(uc.ID)
+		for _, actorRef := range uc.Actors {
+			if actorRef.Role == core.ActorRolePrimary {
+				sb.WriteString(mermaidID(actorRef.ActorID) + " --> " + ucID + "\n")
+			} else {
+				sb.WriteString(mermaidID(actorRef.ActorID) + " ..> " + ucID + "\n")
+			}
+		}
+	}
+
+	// ユースケース間のリレーション
+	for _, uc := range d.UseCases {
+		ucID := mermaidID(uc.ID)
+		for _, rel := range uc.Relations {
+			targetID := mermaidID(rel.TargetID)
+			switch rel.Type {
+			case core.RelationTypeInclude:
+				sb.WriteString(ucID + " ..> " + targetID + " : <<include>>\n")
+			case core.RelationTypeExtend:
+				sb.WriteString(targetID + " ..> " + ucID + " : <<" + escapePlantUML(extendLabel(rel)) + ">>\n")
+			case core.RelationTypeGeneralize:
+				sb.WriteString(ucID + " --|> " + targetID + "\n")
+			}
+		}
+	}
+
+	sb.WriteString("@enduml\n")
+	return sb.String()
+}
+
+// extendLabel は extend 関係のラベルを生成
+func extendLabel(rel core.UseCaseRelation) string {
+	if rel.Condition != "" {
+		return "extend [" + rel.Condition + "]"
+	}
+	return "extend"
+}
+
+// actorTypeEmoji はアクタータイプの絵文字を返す
+func actorTypeEmoji(t core.ActorType) string {
+	switch t {
+	case core.ActorTypeHuman:
+		return "👤"
+	case core.ActorTypeSystem:
+		return "🖥️"
+	case core.ActorTypeTime:
+		return "⏰"
+	case core.ActorTypeDevice:
+		return "📱"
+	case core.ActorTypeExternal:
+		return "🌐"
+	default:
+		return "❓"
+	}
+}
@@ -2,8 +2,11 @@ package doctor
 
 import (
 	"context"
+	"fmt"
 	"path/filepath"
+	"time"
 
+	"github.com/biwakonbu/zeus/internal/analysis"
 	"github.com/biwakonbu/zeus/internal/core"
 	"github.com/biwakonbu/zeus/internal/yaml"
 )
@@ -13,6 +16,7 @@ type CheckResult struct {
 	Check   string
 	Status  string // pass, warn, fail
 	Message string
+	Entity  string // 指摘の対象エンティティ ID（抑制の照合に使用、無い場合は空）
 	Fixable bool
 	FixFunc func(ctx context.Context) error
 }
@@ -22,12 +26,14 @@ type DiagnosisResult struct {
 	Overall      string // healthy, degraded, unhealthy
 	Checks       []CheckResult
 	FixableCount int
+	Suppressed   []CheckResult // 抑制した指摘（suppressions.yaml、zeus:ignore 注釈、重大度 ignore）
 }
 
 // FixResult は修復結果
 type FixResult struct {
-	Fixes  []FixAction
-	DryRun bool
+	Fixes   []FixAction
+	DryRun  bool
+	Changes []core.FileChange // dry-run 時に変更されるはずのファイル
 }
 
 // FixAction は修復アクション
@@ -39,18 +45,22 @@ type FixAction struct {
 // Doctor は診断・修復を行う
 type Doctor struct {
 	zeusPath         string
-	fileManager      *yaml.FileManager
+	fileStore        core.FileStore
 	integrityChecker *core.IntegrityChecker
 	lintChecker      *core.LintChecker
+	coverage         CoverageFunc
 }
 
+// CoverageFunc は UseCase と Activity の紐づけのカバレッジを分析する関数（core.Zeus.AnalyzeCoverage）
+type CoverageFunc func(ctx context.Context) (*analysis.CoverageAnalysis, error)
+
 // New は新しい Doctor を作成
 func New(projectPath string) *Doctor {
 	zeusPath := filepath.Join(projectPath, ".zeus")
 	fm := yaml.NewFileManager(zeusPath)
 	return &Doctor{
 		zeusPath:    zeusPath,
-		fileManager: fm,
+		fileStore:   fm,
 		lintChecker: core.NewLintChecker(fm),
 	}
 }
@@ -61,12 +71,17 @@ func NewWithIntegrity(projectPath string, checker *core.IntegrityChecker) *Docto
 	fm := yaml.NewFileManager(zeusPath)
 	return &Doctor{
 		zeusPath:         zeusPath,
-		fileManager:      fm,
+		fileStore:        fm,
 		integrityChecker: checker,
 		lintChecker:      core.NewLintChecker(fm),
 	}
 }
 
+// SetCoverage はカバレッジ分析を設定（設定した場合のみ UseCase と Activity の紐づけをチェックする）
+func (d *Doctor) SetCoverage(fn CoverageFunc) {
+	d.coverage = fn
+}
+
 // Diagnose はシステムを診断（Context対応）
 func (d *Doctor) Diagnose(ctx context.Context) (*DiagnosisResult, error) {
 	if err := ctx.Err(); err != nil {
@@ -84,6 +99,11 @@ func (d *Doctor) Diagnose(ctx context.Context) (*DiagnosisResult, error) {
 	// 状態ファイル存在チェック
 	checks = append(checks, d.checkStateExists(ctx))
 
+	// 旧形式 tasks/active.yaml の残存チェック
+	if check, ok := d.checkLegacyTasks(ctx); ok {
+		checks = append(checks, check)
+	}
+
 	// 参照整合性チェック（IntegrityChecker が設定されている場合）
 	if d.integrityChecker != nil {
 		checks = append(checks, d.checkIntegrity(ctx)...)
@@ -94,6 +114,14 @@ func (d *Doctor) Diagnose(ctx context.Context) (*DiagnosisResult, error) {
 		checks = append(checks, d.checkLint(ctx)...)
 	}
 
+	// UseCase と Activity の紐づけチェック（カバレッジ分析が設定されている場合）
+	if d.coverage != nil {
+		checks = append(checks, d.checkCoverage(ctx)...)
+	}
+
+	// 抑制と重大度の上書きを適用
+	checks, suppressed := d.applyCheckPolicy(ctx, checks, time.Now())
+
 	// 全体の健全性を計算
 	overall := d.calculateOverall(checks)
 	fixableCount := 0
@@ -107,6 +135,7 @@ func (d *Doctor) Diagnose(ctx context.Context) (*DiagnosisResult, error) {
 		Overall:      overall,
 		Checks:       checks,
 		FixableCount: fixableCount,
+		Suppressed:   suppressed,
 	}, nil
 }
 
@@ -116,6 +145,25 @@ func (d *Doctor) Fix(ctx context.Context, dryRun bool) (*FixResult, error) {
 		return nil, err
 	}
 
+	// dry-run: 書き込みをステージする FileStore で修復を実行し、変更されるはずのファイルを返す
+	if dryRun {
+		var result *FixResult
+		changes, err := core.DryRunFileStore(ctx, d.fileStore, func(fs core.FileStore) error {
+			var err error
+			result, err = d.withFileStore(fs).Fix(ctx, false)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i := range result.Fixes {
+			result.Fixes[i].Executed = false
+		}
+		result.DryRun = true
+		result.Changes = changes
+		return result, nil
+	}
+
 	diagnosis, err := d.Diagnose(ctx)
 	if err != nil {
 		return nil, err
@@ -124,22 +172,35 @@ func (d *Doctor) Fix(ctx context.Context, dryRun bool) (*FixResult, error) {
 	fixes := []FixAction{}
 	for _, check := range diagnosis.Checks {
 		if check.Status == "fail" && check.Fixable && check.FixFunc != nil {
-			if dryRun {
-				fixes = append(fixes, FixAction{Action: check.Message, Executed: false})
-			} else {
-				if err := check.FixFunc(ctx); err != nil {
-					return nil, err
-				}
-				fixes = append(fixes, FixAction{Action: check.Message, Executed: true})
+			if err := check.FixFunc(ctx); err != nil {
+				return nil, err
 			}
+			fixes = append(fixes, FixAction{Action: check.Message, Executed: true})
 		}
 	}
 
-	return &FixResult{Fixes: fixes, DryRun: dryRun}, nil
+	return &FixResult{Fixes: fixes}, nil
+}
+
+// withFileStore は fileStore を差し替えた Doctor を作成
+func (d *Doctor) withFileStore(fs core.FileStore) *Doctor {
+	return &Doctor{
+		zeusPath:         d.zeusPath,
+		fileStore:        fs,
+		integrityChecker: d.integrityChecker,
+		lintChecker:      core.NewLintChecker(fs),
+		coverage:         d.coverage,
+	}
+}
+
+// dirExists はディレクトリが存在するか確認
+func (d *Doctor) dirExists(ctx context.Context, path string) bool {
+	_, err := d.fileStore.ListDir(ctx, path)
+	return err == nil
 }
 
 func (d *Doctor) checkConfigExists(ctx context.Context) CheckResult {
-	if d.fileManager.Exists(ctx, "zeus.yaml") {
+	if d.fileStore.Exists(ctx, "zeus.yaml") {
 		return CheckResult{
 			Check:   "config_exists",
 			Status:  "pass",
@@ -154,7 +215,7 @@ func (d *Doctor) checkConfigExists(ctx context.Context) CheckResult {
 		Message: "zeus.yaml not found - run 'zeus init' to create",
 		Fixable: true,
 		FixFunc: func(ctx context.Context) error {
-			zeus := core.New(filepath.Dir(d.zeusPath))
+			zeus := core.New(filepath.Dir(d.zeusPath), core.WithFileStore(d.fileStore))
 			_, err := zeus.Init(ctx)
 			return err
 		},
@@ -164,8 +225,8 @@ func (d *Doctor) checkConfigExists(ctx context.Context) CheckResult {
 func (d *Doctor) checkActivitiesExists(ctx context.Context) CheckResult {
 	// activities ディレクトリの存在をチェック
 	// ディレクトリが存在するか、zeus.yaml が存在すれば OK（初期化済み）
-	activitiesDirExists := d.fileManager.DirExists(ctx, "activities")
-	zeusConfigExists := d.fileManager.Exists(ctx, "zeus.yaml")
+	activitiesDirExists := d.dirExists(ctx, "activities")
+	zeusConfigExists := d.fileStore.Exists(ctx, "zeus.yaml")
 
 	if activitiesDirExists {
 		return CheckResult{
@@ -185,7 +246,7 @@ func (d *Doctor) checkActivitiesExists(ctx context.Context) CheckResult {
 			Fixable: true,
 			FixFunc: func(ctx context.Context) error {
 				// activities ディレクトリを作成
-				return d.fileManager.EnsureDir(ctx, "activities")
+				return d.fileStore.EnsureDir(ctx, "activities")
 			},
 		}
 	}
@@ -204,7 +265,7 @@ func (d *Doctor) checkActivitiesExists(ctx context.Context) CheckResult {
 }
 
 func (d *Doctor) checkStateExists(ctx context.Context) CheckResult {
-	if d.fileManager.Exists(ctx, "state/current.yaml") {
+	if d.fileStore.Exists(ctx, "state/current.yaml") {
 		return CheckResult{
 			Check:   "state_exists",
 			Status:  "pass",
@@ -225,14 +286,33 @@ func (d *Doctor) checkStateExists(ctx context.Context) CheckResult {
 				Health:    core.HealthUnknown,
 				Risks:     []string{},
 			}
-			if err := d.fileManager.EnsureDir(ctx, "state"); err != nil {
+			if err := d.fileStore.EnsureDir(ctx, "state"); err != nil {
 				return err
 			}
-			return d.fileManager.WriteYaml(ctx, "state/current.yaml", state)
+			return d.fileStore.WriteYaml(ctx, "state/current.yaml", state)
 		},
 	}
 }
 
+// checkLegacyTasks は旧形式 tasks/active.yaml が残っていないかチェック
+// 旧ファイルが無い場合はチェック結果を返さない（ok=false）
+func (d *Doctor) checkLegacyTasks(ctx context.Context) (CheckResult, bool) {
+	if !core.HasLegacyTasks(ctx, d.fileStore) {
+		return CheckResult{}, false
+	}
+
+	return CheckResult{
+		Check:   "legacy_tasks",
+		Status:  "fail",
+		Message: "Legacy tasks/active.yaml found - migrate to activities/act-*.yaml",
+		Fixable: true,
+		FixFunc: func(ctx context.Context) error {
+			_, err := core.MigrateLegacyTasks(ctx, d.fileStore)
+			return err
+		},
+	}, true
+}
+
 func (d *Doctor) calculateOverall(checks []CheckResult) string {
 	failed := 0
 	warned := 0
@@ -277,6 +357,7 @@ func (d *Doctor) checkIntegrity(ctx context.Context) []CheckResult {
 				Check:   "reference_integrity",
 				Status:  "fail",
 				Message: refErr.Error(),
+				Entity:  refErr.SourceID,
 				Fixable: false, // 参照エラーは自動修復不可
 			})
 		}
@@ -315,6 +396,7 @@ func (d *Doctor) checkIntegrity(ctx context.Context) []CheckResult {
 				Check:   "subsystem_reference",
 				Status:  "warn",
 				Message: warn.Warning(),
+				Entity:  warn.SourceID,
 				Fixable: false, // 警告は自動修復不可（参照先の作成は手動で行う）
 			})
 		}
@@ -352,6 +434,7 @@ func (d *Doctor) checkLint(ctx context.Context) []CheckResult {
 				Check:   "lint_id_format",
 				Status:  "fail",
 				Message: lintErr.Error(),
+				Entity:  lintErr.EntityID,
 				Fixable: false, // ID フォーマットエラーは自動修復不可
 			})
 		}
@@ -364,15 +447,150 @@ func (d *Doctor) checkLint(ctx context.Context) []CheckResult {
 		})
 	}
 
-	// その他の警告（ディレクトリ読み取りエラーなど）
+	// その他の警告（ルール ID の無いものはディレクトリ読み取りエラーなど）
 	for _, warn := range result.Warnings {
+		check := "lint_directory"
+		if warn.Rule != "" {
+			check = warn.Rule
+		}
 		checks = append(checks, CheckResult{
-			Check:   "lint_directory",
+			Check:   check,
 			Status:  "warn",
 			Message: warn.Warning(),
+			Entity:  warn.EntityID,
 			Fixable: false,
 		})
 	}
 
 	return checks
 }
+
+// checkCoverage は UseCase と Activity の紐づけをチェックする
+// - usecase_coverage: 有効な UseCase に Activity が紐づいていない（警告）
+// - activity_traceability: Activity が UseCase に紐づいていない（警告）
+func (d *Doctor) checkCoverage(ctx context.Context) []CheckResult {
+	result, err := d.coverage(ctx)
+	if err != nil {
+		return []CheckResult{{
+			Check:   "coverage_check",
+			Status:  "fail",
+			Message: "Coverage check failed: " + err.Error(),
+			Fixable: false,
+		}}
+	}
+
+	rules := []struct {
+		check     string
+		issueType analysis.CoverageIssueType
+		pass      string
+	}{
+		{"usecase_coverage", analysis.CoverageIssueUseCaseNoActivities, "All active UseCases have linked Activities"},
+		{"activity_traceability", analysis.CoverageIssueActivityUntraced, "All Activities trace to a UseCase"},
+	}
+	var checks []CheckResult
+	for _, rule := range rules {
+		found := false
+		for _, issue := range result.Issues {
+			if issue.Type != rule.issueType {
+				continue
+			}
+			found = true
+			checks = append(checks, CheckResult{
+				Check:   rule.check,
+				Status:  "warn",
+				Message: fmt.Sprintf("%s %s (%s): %s", issue.EntityType, issue.EntityID, issue.EntityTitle, issue.Message),
+				Entity:  issue.EntityID,
+				Fixable: false, // 紐づけは手動で行う（zeus adopt で候補を提案）
+			})
+		}
+		if !found {
+			checks = append(checks, CheckResult{
+				Check:   rule.check,
+				Status:  "pass",
+				Message: rule.pass,
+				Fixable: false,
+			})
+		}
+	}
+	return checks
+}
+
+// applyCheckPolicy は指摘の抑制と重大度の上書きを適用する
+// - suppressions.yaml の有効な抑制、または対象エンティティの zeus:ignore 注釈に一致する指摘は抑制する
+// - zeus.yaml の checks.severity でルールごとの重大度（fail / warn）を上書きし、ignore のルールは抑制する
+// 期限切れの抑制は適用せず、見直しを促す警告（suppression_expired）を追加する
+// 抑制した指摘は checks から除き、suppressed として返す
+func (d *Doctor) applyCheckPolicy(ctx context.Context, checks []CheckResult, now time.Time) ([]CheckResult, []CheckResult) {
+	var settings core.CheckSettings
+	if d.fileStore.Exists(ctx, "zeus.yaml") {
+		var config core.ZeusConfig
+		if err := d.fileStore.ReadYaml(ctx, "zeus.yaml", &config); err == nil {
+			settings = config.Checks
+		}
+	}
+	if err := settings.Validate(); err != nil {
+		checks = append(checks, CheckResult{Check: "check_policy", Status: "fail", Message: err.Error()})
+		settings = core.CheckSettings{}
+	}
+
+	suppressions, err := core.LoadSuppressions(ctx, d.fileStore)
+	if err != nil {
+		checks = append(checks, CheckResult{Check: "check_policy", Status: "fail", Message: err.Error()})
+	}
+	var active []core.Suppression
+	for _, s := range suppressions {
+		if s.Active(now) {
+			active = append(active, s)
+			continue
+		}
+		target := s.Rule
+		if s.Entity != "" {
+			target += " (" + s.Entity + ")"
+		}
+		checks = append(checks, CheckResult{
+			Check:   "suppression_expired",
+			Status:  "warn",
+			Message: fmt.Sprintf("Suppression for %s expired on %s - remove it from %s or extend the expiry", target, s.Expires, core.SuppressionsFile),
+		})
+	}
+
+	inline := map[string]map[string]bool{}
+	suppressedBy := func(check CheckResult) bool {
+		for _, s := range active {
+			if s.Matches(check.Check, check.Entity) {
+				return true
+			}
+		}
+		if check.Entity == "" {
+			return false
+		}
+		rules, ok := inline[check.Entity]
+		if !ok {
+			// 注釈を読めない場合は抑制しない（参照切れの検出を妨げないため）
+			rules, _ = core.InlineIgnores(ctx, d.fileStore, check.Entity)
+			inline[check.Entity] = rules
+		}
+		return rules[check.Check]
+	}
+
+	kept := make([]CheckResult, 0, len(checks))
+	suppressed := []CheckResult{}
+	for _, check := range checks {
+		severity := settings.Severity[check.Check]
+		if check.Status == "pass" {
+			if severity != core.SeverityIgnore {
+				kept = append(kept, check)
+			}
+			continue
+		}
+		if severity == core.SeverityIgnore || suppressedBy(check) {
+			suppressed = append(suppressed, check)
+			continue
+		}
+		if severity != "" {
+			check.Status = severity
+		}
+		kept = append(kept, check)
+	}
+	return kept, suppressed
+}
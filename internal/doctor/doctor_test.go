@@ -4,8 +4,11 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/biwakonbu/zeus/internal/analysis"
 	"github.com/biwakonbu/zeus/internal/core"
 	"github.com/biwakonbu/zeus/internal/yaml"
 )
@@ -148,6 +151,15 @@ func TestFix_DryRun(t *testing.T) {
 	if !result.DryRun {
 		t.Error("expected DryRun to be true")
 	}
+	found := false
+	for _, c := range result.Changes {
+		if c.Path == "zeus.yaml" && c.Op == core.ChangeOpCreate {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected zeus.yaml creation in changes: %+v", result.Changes)
+	}
 
 	// DryRun なので実際には修復されていない
 	if _, err := os.Stat(filepath.Join(zeusDir, "zeus.yaml")); !os.IsNotExist(err) {
@@ -707,3 +719,175 @@ func TestNewWithIntegrity(t *testing.T) {
 		t.Errorf("expected zeusPath %q, got %q", filepath.Join(tmpDir, ".zeus"), d.zeusPath)
 	}
 }
+
+func TestCheckLegacyTasks(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "doctor-test")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	ctx := context.Background()
+	d := New(tmpDir)
+
+	// 旧ファイルが無ければチェック結果は出ない
+	if _, ok := d.checkLegacyTasks(ctx); ok {
+		t.Error("expected no legacy_tasks check without tasks/active.yaml")
+	}
+
+	tasksDir := filepath.Join(tmpDir, ".zeus", "tasks")
+	if err := os.MkdirAll(tasksDir, 0755); err != nil {
+		t.Fatalf("failed to create tasks dir: %v", err)
+	}
+	legacy := "tasks:\n  - id: task-0000abcd\n    title: Old\n    status: pending\n"
+	if err := os.WriteFile(filepath.Join(tasksDir, "active.yaml"), []byte(legacy), 0644); err != nil {
+		t.Fatalf("failed to write legacy file: %v", err)
+	}
+
+	check, ok := d.checkLegacyTasks(ctx)
+	if !ok {
+		t.Fatal("expected legacy_tasks check")
+	}
+	if check.Status != "fail" || !check.Fixable {
+		t.Errorf("expected fixable fail, got %+v", check)
+	}
+	if err := check.FixFunc(ctx); err != nil {
+		t.Fatalf("FixFunc failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, ".zeus", "activities", "act-0000abcd.yaml")); err != nil {
+		t.Errorf("migrated activity should exist: %v", err)
+	}
+}
+
+func TestApplyCheckPolicy(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "doctor-test")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	zeusPath := filepath.Join(tmpDir, ".zeus")
+	if err := os.MkdirAll(filepath.Join(zeusPath, "usecases"), 0755); err != nil {
+		t.Fatalf("failed to create usecases dir: %v", err)
+	}
+	files := map[string]string{
+		"zeus.yaml": "version: \"1.0\"\nchecks:\n  severity:\n    lint_directory: ignore\n    reference_integrity: warn\n",
+		"suppressions.yaml": "suppressions:\n" +
+			"  - rule: subsystem_reference\n    entity: uc-11111111\n    reason: 移行中\n    expires: \"2026-12-31\"\n" +
+			"  - rule: subsystem_reference\n    entity: uc-22222222\n    expires: \"2026-01-31\"\n",
+		"usecases/uc-33333333.yaml": "# zeus:ignore subsystem_reference\nid: uc-33333333\ntitle: 外部連携\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(zeusPath, name), []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	checks := []CheckResult{
+		{Check: "reference_integrity", Status: "fail", Message: "broken", Entity: "dec-00000001"},
+		{Check: "subsystem_reference", Status: "warn", Message: "suppressed by file", Entity: "uc-11111111"},
+		{Check: "subsystem_reference", Status: "warn", Message: "expired suppression", Entity: "uc-22222222"},
+		{Check: "subsystem_reference", Status: "warn", Message: "inline annotation", Entity: "uc-33333333"},
+		{Check: "lint_directory", Status: "warn", Message: "ignored rule"},
+		{Check: "lint_id_format", Status: "pass", Message: "ok"},
+	}
+	d := New(tmpDir)
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	kept, suppressed := d.applyCheckPolicy(context.Background(), checks, now)
+
+	got := map[string]string{}
+	for _, c := range kept {
+		got[c.Message] = c.Status
+	}
+	if got["broken"] != "warn" {
+		t.Errorf("expected reference_integrity downgraded to warn, got %q", got["broken"])
+	}
+	if got["expired suppression"] != "warn" {
+		t.Error("expected finding with expired suppression to be reported")
+	}
+	if got["ok"] != "pass" {
+		t.Error("expected pass result to be kept")
+	}
+	if len(suppressed) != 3 {
+		t.Errorf("expected 3 suppressed findings, got %+v", suppressed)
+	}
+	for _, c := range kept {
+		if c.Message == "suppressed by file" || c.Message == "inline annotation" || c.Message == "ignored rule" {
+			t.Errorf("expected %q to be suppressed", c.Message)
+		}
+	}
+
+	expired := false
+	for _, c := range kept {
+		if c.Check == "suppression_expired" {
+			expired = true
+			if !strings.Contains(c.Message, "uc-22222222") {
+				t.Errorf("expected expired suppression message to name the entity, got %q", c.Message)
+			}
+		}
+	}
+	if !expired {
+		t.Error("expected suppression_expired warning")
+	}
+}
+
+func TestApplyCheckPolicy_InvalidSeverity(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "doctor-test")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	zeusPath := filepath.Join(tmpDir, ".zeus")
+	if err := os.MkdirAll(zeusPath, 0755); err != nil {
+		t.Fatalf("failed to create .zeus dir: %v", err)
+	}
+	config := "version: \"1.0\"\nchecks:\n  severity:\n    subsystem_reference: info\n"
+	if err := os.WriteFile(filepath.Join(zeusPath, "zeus.yaml"), []byte(config), 0644); err != nil {
+		t.Fatalf("failed to write zeus.yaml: %v", err)
+	}
+
+	d := New(tmpDir)
+	checks := []CheckResult{{Check: "subsystem_reference", Status: "warn", Message: "w"}}
+	kept, _ := d.applyCheckPolicy(context.Background(), checks, time.Now())
+
+	found := false
+	for _, c := range kept {
+		if c.Check == "check_policy" && c.Status == "fail" {
+			found = true
+		}
+		if c.Check == "subsystem_reference" && c.Status != "warn" {
+			t.Errorf("invalid override should not change status, got %q", c.Status)
+		}
+	}
+	if !found {
+		t.Error("expected check_policy failure for unknown severity")
+	}
+}
+
+func TestCheckCoverage(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "doctor-test")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	d := New(tmpDir)
+	d.SetCoverage(func(ctx context.Context) (*analysis.CoverageAnalysis, error) {
+		return &analysis.CoverageAnalysis{Issues: []analysis.CoverageIssue{
+			{Type: analysis.CoverageIssueUseCaseNoActivities, EntityID: "uc-11111111", EntityTitle: "ログアウト", EntityType: "usecase", Message: "no activity"},
+		}}, nil
+	})
+
+	checks := d.checkCoverage(context.Background())
+	got := map[string]CheckResult{}
+	for _, c := range checks {
+		got[c.Check] = c
+	}
+	if c := got["usecase_coverage"]; c.Status != "warn" || c.Entity != "uc-11111111" {
+		t.Errorf("expected usecase_coverage warning for uc-11111111, got %+v", c)
+	}
+	if c := got["activity_traceability"]; c.Status != "pass" {
+		t.Errorf("expected activity_traceability to pass, got %+v", c)
+	}
+}
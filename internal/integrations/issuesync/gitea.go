@@ -0,0 +1,116 @@
+package issuesync
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// giteaDriver は Gitea（および Forgejo）REST API v1 のドライバー
+type giteaDriver struct {
+	api  *apiClient
+	repo string // URL エンコード済みの owner/repo
+}
+
+// giteaIssue は Gitea の Issue 応答
+type giteaIssue struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+	State  string `json:"state"` // open, closed
+	Labels []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	HTMLURL     string    `json:"html_url"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	PullRequest *struct{} `json:"pull_request"`
+}
+
+func newGiteaDriver(config Config, client *http.Client) *giteaDriver {
+	owner, repo, _ := strings.Cut(config.Project, "/")
+	return &giteaDriver{
+		api: &apiClient{
+			client:  client,
+			baseURL: config.BaseURL,
+			auth: func(req *http.Request) {
+				if config.Token != "" {
+					req.Header.Set("Authorization", "token "+config.Token)
+				}
+			},
+		},
+		repo: url.PathEscape(owner) + "/" + url.PathEscape(repo),
+	}
+}
+
+// Provider はプロバイダー名を返す
+func (d *giteaDriver) Provider() string {
+	return ProviderGitea
+}
+
+// List は全 Issue をページングして取得（プルリクエストは除外）
+func (d *giteaDriver) List(ctx context.Context) ([]Issue, error) {
+	var issues []Issue
+	for page := 1; ; page++ {
+		var batch []giteaIssue
+		path := fmt.Sprintf("/api/v1/repos/%s/issues?state=all&type=issues&limit=%d&page=%d", d.repo, pageSize, page)
+		if err := d.api.do(ctx, http.MethodGet, path, nil, &batch); err != nil {
+			return nil, err
+		}
+		for _, gi := range batch {
+			if gi.PullRequest == nil {
+				issues = append(issues, gi.issue())
+			}
+		}
+		// サーバー設定（MAX_RESPONSE_ITEMS）で limit より少ない件数に制限されるため、空のページまで取得する
+		if len(batch) == 0 {
+			return issues, nil
+		}
+	}
+}
+
+// Create は Issue を作成
+func (d *giteaDriver) Create(ctx context.Context, issue Issue) (*Issue, error) {
+	var created giteaIssue
+	path := fmt.Sprintf("/api/v1/repos/%s/issues", d.repo)
+	body := map[string]any{"title": issue.Title, "body": issue.Body, "closed": issue.Closed}
+	if err := d.api.do(ctx, http.MethodPost, path, body, &created); err != nil {
+		return nil, err
+	}
+	result := created.issue()
+	return &result, nil
+}
+
+// Update は Issue のタイトル・本文・開閉状態を更新
+func (d *giteaDriver) Update(ctx context.Context, issue Issue) (*Issue, error) {
+	state := "open"
+	if issue.Closed {
+		state = "closed"
+	}
+	var updated giteaIssue
+	path := fmt.Sprintf("/api/v1/repos/%s/issues/%d", d.repo, issue.Number)
+	body := map[string]any{"title": issue.Title, "body": issue.Body, "state": state}
+	if err := d.api.do(ctx, http.MethodPatch, path, body, &updated); err != nil {
+		return nil, err
+	}
+	result := updated.issue()
+	return &result, nil
+}
+
+func (gi giteaIssue) issue() Issue {
+	labels := make([]string, 0, len(gi.Labels))
+	for _, l := range gi.Labels {
+		labels = append(labels, l.Name)
+	}
+	return Issue{
+		Number:    gi.Number,
+		Title:     gi.Title,
+		Body:      gi.Body,
+		Closed:    gi.State == "closed",
+		Labels:    labels,
+		URL:       gi.HTMLURL,
+		UpdatedAt: gi.UpdatedAt,
+	}
+}
@@ -0,0 +1,108 @@
+package issuesync
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// gitLabDriver は GitLab REST API v4 のドライバー
+type gitLabDriver struct {
+	api     *apiClient
+	project string // URL エンコード済みのプロジェクトパス
+}
+
+// gitLabIssue は GitLab の Issue 応答
+type gitLabIssue struct {
+	IID         int       `json:"iid"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	State       string    `json:"state"` // opened, closed
+	Labels      []string  `json:"labels"`
+	WebURL      string    `json:"web_url"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func newGitLabDriver(config Config, client *http.Client) *gitLabDriver {
+	return &gitLabDriver{
+		api: &apiClient{
+			client:  client,
+			baseURL: config.BaseURL,
+			auth: func(req *http.Request) {
+				if config.Token != "" {
+					req.Header.Set("PRIVATE-TOKEN", config.Token)
+				}
+			},
+		},
+		project: url.PathEscape(config.Project),
+	}
+}
+
+// Provider はプロバイダー名を返す
+func (d *gitLabDriver) Provider() string {
+	return ProviderGitLab
+}
+
+// List は全 Issue をページングして取得
+func (d *gitLabDriver) List(ctx context.Context) ([]Issue, error) {
+	var issues []Issue
+	for page := 1; ; page++ {
+		var batch []gitLabIssue
+		path := fmt.Sprintf("/api/v4/projects/%s/issues?state=all&per_page=%d&page=%d", d.project, pageSize, page)
+		if err := d.api.do(ctx, http.MethodGet, path, nil, &batch); err != nil {
+			return nil, err
+		}
+		for _, gi := range batch {
+			issues = append(issues, gi.issue())
+		}
+		if len(batch) < pageSize {
+			return issues, nil
+		}
+	}
+}
+
+// Create は Issue を作成（Closed の場合は作成後にクローズ）
+func (d *gitLabDriver) Create(ctx context.Context, issue Issue) (*Issue, error) {
+	var created gitLabIssue
+	path := fmt.Sprintf("/api/v4/projects/%s/issues", d.project)
+	body := map[string]any{"title": issue.Title, "description": issue.Body}
+	if err := d.api.do(ctx, http.MethodPost, path, body, &created); err != nil {
+		return nil, err
+	}
+	if !issue.Closed {
+		result := created.issue()
+		return &result, nil
+	}
+	issue.Number = created.IID
+	return d.Update(ctx, issue)
+}
+
+// Update は Issue のタイトル・本文・開閉状態を更新
+func (d *gitLabDriver) Update(ctx context.Context, issue Issue) (*Issue, error) {
+	stateEvent := "reopen"
+	if issue.Closed {
+		stateEvent = "close"
+	}
+	var updated gitLabIssue
+	path := fmt.Sprintf("/api/v4/projects/%s/issues/%d", d.project, issue.Number)
+	body := map[string]any{"title": issue.Title, "description": issue.Body, "state_event": stateEvent}
+	if err := d.api.do(ctx, http.MethodPut, path, body, &updated); err != nil {
+		return nil, err
+	}
+	result := updated.issue()
+	return &result, nil
+}
+
+func (gi gitLabIssue) issue() Issue {
+	return Issue{
+		Number:    gi.IID,
+		Title:     gi.Title,
+		Body:      gi.Description,
+		Closed:    gi.State == "closed",
+		Labels:    gi.Labels,
+		URL:       gi.WebURL,
+		UpdatedAt: gi.UpdatedAt,
+	}
+}
@@ -0,0 +1,136 @@
+// Package issuesync は課題トラッカー（GitLab / Gitea）との Issue 同期ドライバーを提供する。
+// ドライバーは Issue の一覧・作成・更新のみを扱い、Activity との対応付けは呼び出し側が行う。
+package issuesync
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// 対応プロバイダー
+const (
+	ProviderGitLab = "gitlab"
+	ProviderGitea  = "gitea"
+)
+
+// defaultGitLabURL は base_url 未設定時の GitLab エンドポイント
+const defaultGitLabURL = "https://gitlab.com"
+
+// pageSize は一覧取得の 1 ページあたりの件数
+const pageSize = 50
+
+// maxErrorBodyBytes はエラー応答から読み取る最大バイト数
+const maxErrorBodyBytes = 1024
+
+// Issue はプロバイダー共通の Issue 表現
+// Number は GitLab では iid、Gitea では number（プロジェクト内の番号）
+type Issue struct {
+	Number    int
+	Title     string
+	Body      string
+	Closed    bool
+	Labels    []string // 読み取り専用（書き込み時は無視）
+	URL       string
+	UpdatedAt time.Time
+}
+
+// Driver は課題トラッカーごとの Issue 操作
+type Driver interface {
+	// Provider はプロバイダー名（gitlab, gitea）
+	Provider() string
+	// List はプルリクエストを除く全 Issue（open / closed）を返す
+	List(ctx context.Context) ([]Issue, error)
+	// Create は Issue を作成（Title, Body, Closed を使用）
+	Create(ctx context.Context, issue Issue) (*Issue, error)
+	// Update は Number の Issue のタイトル・本文・開閉状態を更新
+	Update(ctx context.Context, issue Issue) (*Issue, error)
+}
+
+// Config はドライバーの接続設定
+type Config struct {
+	Provider string // gitlab, gitea
+	BaseURL  string // GitLab は省略時 https://gitlab.com、Gitea は必須
+	Project  string // GitLab: group/project または数値 ID、Gitea: owner/repo
+	Token    string // アクセストークン
+}
+
+// NewDriver は設定からドライバーを作成（client が nil の場合は 30 秒タイムアウトのクライアント）
+func NewDriver(config Config, client *http.Client) (Driver, error) {
+	if config.Project == "" {
+		return nil, fmt.Errorf("issue sync project is required")
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	switch config.Provider {
+	case ProviderGitLab:
+		if config.BaseURL == "" {
+			config.BaseURL = defaultGitLabURL
+		}
+		return newGitLabDriver(config, client), nil
+	case ProviderGitea:
+		if config.BaseURL == "" {
+			return nil, fmt.Errorf("gitea base_url is required")
+		}
+		if strings.Count(config.Project, "/") != 1 {
+			return nil, fmt.Errorf("gitea project must be owner/repo: %q", config.Project)
+		}
+		return newGiteaDriver(config, client), nil
+	default:
+		return nil, fmt.Errorf("unsupported issue sync provider: %q (gitlab, gitea)", config.Provider)
+	}
+}
+
+// apiClient はトークン付きで JSON API を呼び出す
+type apiClient struct {
+	client  *http.Client
+	baseURL string
+	auth    func(*http.Request)
+}
+
+// do はリクエストを送信し、2xx の応答を out にデコード（out が nil の場合は読み捨て）
+func (c *apiClient) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimRight(c.baseURL, "/")+path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	c.auth(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s failed: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+		return fmt.Errorf("%s %s returned %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode %s response: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package issuesync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewDriver(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		want    string
+		wantErr bool
+	}{
+		{"gitlab default url", Config{Provider: ProviderGitLab, Project: "group/app"}, ProviderGitLab, false},
+		{"gitea", Config{Provider: ProviderGitea, BaseURL: "https://git.example.com", Project: "team/app"}, ProviderGitea, false},
+		{"gitea without url", Config{Provider: ProviderGitea, Project: "team/app"}, "", true},
+		{"gitea bad project", Config{Provider: ProviderGitea, BaseURL: "https://git.example.com", Project: "app"}, "", true},
+		{"missing project", Config{Provider: ProviderGitLab}, "", true},
+		{"unknown provider", Config{Provider: "jira", Project: "APP"}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			driver, err := NewDriver(tt.config, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewDriver() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && driver.Provider() != tt.want {
+				t.Errorf("Provider() = %s, want %s", driver.Provider(), tt.want)
+			}
+		})
+	}
+}
+
+func TestGitLabDriver(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("PRIVATE-TOKEN") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		requests = append(requests, fmt.Sprintf("%s %s %v", r.Method, r.URL.EscapedPath(), body["state_event"]))
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Query().Get("page") == "1":
+			issues := make([]map[string]any, pageSize)
+			for i := range issues {
+				issues[i] = map[string]any{"iid": i + 1, "title": "t", "state": "opened", "updated_at": "2026-01-05T09:00:00Z"}
+			}
+			_ = json.NewEncoder(w).Encode(issues)
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`[{"iid":51,"title":"Last","description":"d","state":"closed","labels":["bug"],"web_url":"https://gitlab/51","updated_at":"2026-01-06T09:00:00Z"}]`))
+		case r.Method == http.MethodPost:
+			_, _ = w.Write([]byte(`{"iid":52,"title":"New","state":"opened","updated_at":"2026-01-07T09:00:00Z"}`))
+		case r.Method == http.MethodPut:
+			_, _ = w.Write([]byte(`{"iid":52,"title":"New","state":"closed","updated_at":"2026-01-07T09:01:00Z"}`))
+		}
+	}))
+	defer server.Close()
+
+	driver, err := NewDriver(Config{Provider: ProviderGitLab, BaseURL: server.URL, Project: "group/app", Token: "secret"}, server.Client())
+	if err != nil {
+		t.Fatalf("NewDriver failed: %v", err)
+	}
+	ctx := context.Background()
+
+	issues, err := driver.List(ctx)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(issues) != pageSize+1 {
+		t.Fatalf("expected %d issues across pages, got %d", pageSize+1, len(issues))
+	}
+	last := issues[pageSize]
+	if last.Number != 51 || !last.Closed || last.Body != "d" || last.Labels[0] != "bug" || last.UpdatedAt.Day() != 6 {
+		t.Errorf("unexpected issue: %+v", last)
+	}
+	if !strings.HasPrefix(requests[0], "GET /api/v4/projects/group%2Fapp/issues") {
+		t.Errorf("project path should be URL-encoded: %s", requests[0])
+	}
+
+	// クローズ状態で作成すると作成後にクローズする
+	created, err := driver.Create(ctx, Issue{Title: "New", Closed: true})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if created.Number != 52 || !created.Closed {
+		t.Errorf("unexpected created issue: %+v", created)
+	}
+	if got := requests[len(requests)-1]; got != "PUT /api/v4/projects/group%2Fapp/issues/52 close" {
+		t.Errorf("unexpected update request: %s", got)
+	}
+}
+
+func TestGiteaDriver(t *testing.T) {
+	var lastPatch map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "token secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"token is required"}`))
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Path != "/api/v1/repos/team/app/issues" || r.URL.Query().Get("type") != "issues" {
+				t.Errorf("unexpected list request: %s", r.URL)
+			}
+			// MAX_RESPONSE_ITEMS で limit より少ない件数に制限されたサーバー
+			switch r.URL.Query().Get("page") {
+			case "1":
+				_, _ = w.Write([]byte(`[
+					{"number":1,"title":"Bug","body":"b","state":"open","labels":[{"name":"priority/high"}],"html_url":"https://gitea/1","updated_at":"2026-01-05T09:00:00Z"},
+					{"number":2,"title":"PR","state":"open","pull_request":{},"updated_at":"2026-01-05T09:00:00Z"}
+				]`))
+			case "2":
+				_, _ = w.Write([]byte(`[{"number":3,"title":"Later","state":"closed","updated_at":"2026-01-06T09:00:00Z"}]`))
+			default:
+				_, _ = w.Write([]byte(`[]`))
+			}
+		case http.MethodPatch:
+			_ = json.NewDecoder(r.Body).Decode(&lastPatch)
+			_, _ = w.Write([]byte(`{"number":1,"title":"Bug","state":"closed","updated_at":"2026-01-06T09:00:00Z"}`))
+		}
+	}))
+	defer server.Close()
+
+	driver, err := NewDriver(Config{Provider: ProviderGitea, BaseURL: server.URL + "/", Project: "team/app", Token: "secret"}, server.Client())
+	if err != nil {
+		t.Fatalf("NewDriver failed: %v", err)
+	}
+	ctx := context.Background()
+
+	issues, err := driver.List(ctx)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(issues) != 2 || issues[0].Labels[0] != "priority/high" || issues[0].URL != "https://gitea/1" {
+		t.Fatalf("pull requests should be excluded: %+v", issues)
+	}
+	if issues[1].Number != 3 || !issues[1].Closed {
+		t.Errorf("short pages should not stop paging: %+v", issues[1])
+	}
+
+	updated, err := driver.Update(ctx, Issue{Number: 1, Title: "Bug", Closed: true})
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if !updated.Closed || lastPatch["state"] != "closed" {
+		t.Errorf("issue should be closed: %+v %v", updated, lastPatch)
+	}
+
+	bad, _ := NewDriver(Config{Provider: ProviderGitea, BaseURL: server.URL, Project: "team/app"}, server.Client())
+	if _, err := bad.List(ctx); err == nil || !strings.Contains(err.Error(), "401") || !strings.Contains(err.Error(), "token is required") {
+		t.Errorf("expected 401 error with message, got %v", err)
+	}
+}
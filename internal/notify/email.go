@@ -0,0 +1,137 @@
+// Package notify は外部チャネルへの通知送信を提供する。
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultSMTPPort は SMTP のデフォルトポート（submission, STARTTLS）
+const DefaultSMTPPort = 587
+
+// EmailConfig は SMTP 送信の設定
+type EmailConfig struct {
+	Host     string
+	Port     int // 0 の場合は DefaultSMTPPort
+	Username string
+	Password string
+	From     string
+	To       []string
+}
+
+// Validate は送信設定を検証
+func (c EmailConfig) Validate() error {
+	if c.Host == "" {
+		return fmt.Errorf("smtp host is required")
+	}
+	if c.Port < 0 || c.Port > 65535 {
+		return fmt.Errorf("invalid smtp port: %d", c.Port)
+	}
+	if _, err := mail.ParseAddress(c.From); err != nil {
+		return fmt.Errorf("invalid from address %q: %w", c.From, err)
+	}
+	if len(c.To) == 0 {
+		return fmt.Errorf("at least one recipient is required")
+	}
+	for _, to := range c.To {
+		if _, err := mail.ParseAddress(to); err != nil {
+			return fmt.Errorf("invalid recipient address %q: %w", to, err)
+		}
+	}
+	return nil
+}
+
+// Email は送信するメール
+type Email struct {
+	Subject string
+	Body    string
+	HTML    bool // true の場合は text/html、false は text/plain
+}
+
+// SendFunc は SMTP 送信関数（smtp.SendMail と同じシグネチャ）
+type SendFunc func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+
+// EmailSender は SMTP でメールを送信する
+// smtp.SendMail を使用するため、サーバーが対応していれば STARTTLS で暗号化される
+type EmailSender struct {
+	config EmailConfig
+	send   SendFunc
+	now    func() time.Time
+}
+
+// NewEmailSender は設定を検証して EmailSender を作成（send が nil の場合は smtp.SendMail）
+func NewEmailSender(config EmailConfig, send SendFunc) (*EmailSender, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	if config.Port == 0 {
+		config.Port = DefaultSMTPPort
+	}
+	if send == nil {
+		send = smtp.SendMail
+	}
+	return &EmailSender{config: config, send: send, now: time.Now}, nil
+}
+
+// Send は設定された宛先全員にメールを送信
+func (s *EmailSender) Send(ctx context.Context, email Email) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if s.config.Username != "" {
+		auth = smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)
+	}
+
+	from, _ := mail.ParseAddress(s.config.From)
+	to := make([]string, len(s.config.To))
+	for i, addr := range s.config.To {
+		parsed, _ := mail.ParseAddress(addr)
+		to[i] = parsed.Address
+	}
+
+	msg := BuildMessage(s.config.From, s.config.To, email, s.now())
+	addr := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+	if err := s.send(addr, auth, from.Address, to, msg); err != nil {
+		return fmt.Errorf("failed to send email via %s: %w", addr, err)
+	}
+	return nil
+}
+
+// BuildMessage は UTF-8 の MIME メッセージを組み立てる（件名は B エンコード、本文は base64）
+func BuildMessage(from string, to []string, email Email, date time.Time) []byte {
+	contentType := "text/plain"
+	if email.HTML {
+		contentType = "text/html"
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", email.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: %s; charset=UTF-8\r\n", contentType)
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n")
+	buf.WriteString("\r\n")
+
+	encoded := base64.StdEncoding.EncodeToString([]byte(email.Body))
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	if encoded != "" {
+		buf.WriteString(encoded + "\r\n")
+	}
+	return buf.Bytes()
+}
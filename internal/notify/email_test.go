@@ -0,0 +1,129 @@
+package notify
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEmailConfig_Validate(t *testing.T) {
+	valid := EmailConfig{Host: "smtp.example.com", From: "zeus@example.com", To: []string{"team@example.com"}}
+	tests := []struct {
+		name    string
+		modify  func(*EmailConfig)
+		wantErr bool
+	}{
+		{"valid", func(c *EmailConfig) {}, false},
+		{"named address", func(c *EmailConfig) { c.From = "Zeus <zeus@example.com>" }, false},
+		{"missing host", func(c *EmailConfig) { c.Host = "" }, true},
+		{"bad port", func(c *EmailConfig) { c.Port = 70000 }, true},
+		{"bad from", func(c *EmailConfig) { c.From = "zeus" }, true},
+		{"no recipients", func(c *EmailConfig) { c.To = nil }, true},
+		{"bad recipient", func(c *EmailConfig) { c.To = []string{"team@example.com", "oops"} }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid
+			tt.modify(&config)
+			if err := config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEmailSender_Send(t *testing.T) {
+	var gotAddr, gotFrom string
+	var gotTo []string
+	var gotAuth smtp.Auth
+	var gotMsg []byte
+	send := func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr, gotAuth, gotFrom, gotTo, gotMsg = addr, a, from, to, msg
+		return nil
+	}
+
+	sender, err := NewEmailSender(EmailConfig{
+		Host:     "smtp.example.com",
+		Username: "zeus",
+		Password: "secret",
+		From:     "Zeus <zeus@example.com>",
+		To:       []string{"Team <team@example.com>", "lead@example.com"},
+	}, send)
+	if err != nil {
+		t.Fatalf("NewEmailSender failed: %v", err)
+	}
+	if err := sender.Send(context.Background(), Email{Subject: "週次レポート", Body: "本文"}); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+
+	if gotAddr != "smtp.example.com:587" {
+		t.Errorf("addr = %s, want default port 587", gotAddr)
+	}
+	if gotAuth == nil {
+		t.Error("auth should be set when username is configured")
+	}
+	if gotFrom != "zeus@example.com" || strings.Join(gotTo, ",") != "team@example.com,lead@example.com" {
+		t.Errorf("envelope should use bare addresses: from=%s to=%v", gotFrom, gotTo)
+	}
+	if !strings.Contains(string(gotMsg), "To: Team <team@example.com>, lead@example.com\r\n") {
+		t.Errorf("header should keep display names:\n%s", gotMsg)
+	}
+}
+
+func TestEmailSender_SendError(t *testing.T) {
+	sender, err := NewEmailSender(EmailConfig{Host: "smtp.example.com", Port: 25, From: "zeus@example.com", To: []string{"team@example.com"}},
+		func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+			if a != nil {
+				t.Error("auth should be nil without username")
+			}
+			return errors.New("connection refused")
+		})
+	if err != nil {
+		t.Fatalf("NewEmailSender failed: %v", err)
+	}
+	err = sender.Send(context.Background(), Email{Subject: "s", Body: "b"})
+	if err == nil || !strings.Contains(err.Error(), "smtp.example.com:25") {
+		t.Errorf("expected wrapped send error, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := sender.Send(ctx, Email{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestBuildMessage(t *testing.T) {
+	body := strings.Repeat("レポート本文 ", 20)
+	msg := string(BuildMessage("zeus@example.com", []string{"team@example.com"}, Email{Subject: "承認待ち", Body: body, HTML: true}, time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)))
+
+	for _, want := range []string{
+		"Subject: =?UTF-8?b?",
+		"Date: Mon, 05 Jan 2026 09:00:00 +0000\r\n",
+		"Content-Type: text/html; charset=UTF-8\r\n",
+		"Content-Transfer-Encoding: base64\r\n",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("message should contain %q:\n%s", want, msg)
+		}
+	}
+
+	parts := strings.SplitN(msg, "\r\n\r\n", 2)
+	if len(parts) != 2 {
+		t.Fatalf("message should have header and body:\n%s", msg)
+	}
+	lines := strings.Split(strings.TrimSuffix(parts[1], "\r\n"), "\r\n")
+	for _, line := range lines {
+		if len(line) > 76 {
+			t.Errorf("body line too long (%d)", len(line))
+		}
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.Join(lines, ""))
+	if err != nil || string(decoded) != body {
+		t.Errorf("body should round-trip through base64: err=%v", err)
+	}
+}
@@ -42,6 +42,7 @@ type Generator struct {
 	config   *ZeusConfig
 	state    *ProjectState
 	analysis *analysis.AnalysisResult
+	sections []Section
 }
 
 // NewGenerator は新しい Generator を作成
@@ -75,6 +76,9 @@ type ReportData struct {
 
 	// 推奨事項
 	Recommendations []string
+
+	// 10 概念モデルのセクション
+	Sections []Section
 }
 
 // GenerateText は TEXT 形式でレポートを生成
@@ -106,6 +110,7 @@ func (g *Generator) GenerateHTML(ctx context.Context) (string, error) {
 	}
 
 	data := g.buildReportData()
+	data.Sections = escapeSections(data.Sections)
 
 	tmpl, err := template.New("html").Parse(HTMLTemplate)
 	if err != nil {
@@ -156,6 +161,7 @@ func (g *Generator) buildReportData() *ReportData {
 		HealthClass:     strings.ToLower(g.state.Health),
 		TaskStats:       g.state.Summary,
 		Recommendations: []string{},
+		Sections:        g.sections,
 	}
 
 	// 完了率を計算
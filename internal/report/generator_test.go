@@ -447,3 +447,94 @@ func TestGenerator_ZeroTasks(t *testing.T) {
 		t.Error("expected non-empty markdown report")
 	}
 }
+
+func TestGenerator_Sections(t *testing.T) {
+	ctx := context.Background()
+
+	config := &ZeusConfig{Project: ProjectInfo{Name: "Section Project"}}
+	state := &ProjectState{Health: "Good"}
+
+	generator := NewGenerator(config, state, nil)
+	generator.SetSections([]Section{
+		NewSection(SectionRisks, []SectionItem{
+			{ID: "risk-001", Title: "<Vendor> delay", Status: "identified", Detail: "score: high"},
+		}),
+		NewSection(SectionAssumptions, nil),
+	})
+
+	text, err := generator.GenerateText(ctx)
+	if err != nil {
+		t.Fatalf("GenerateText returned error: %v", err)
+	}
+	if !strings.Contains(text, "Active Risks (1)") || !strings.Contains(text, "risk-001 <Vendor> delay [identified] score: high") {
+		t.Errorf("text report should contain risk section:\n%s", text)
+	}
+	if !strings.Contains(text, "Invalidated Assumptions (0)") {
+		t.Errorf("text report should contain empty section:\n%s", text)
+	}
+
+	md, err := generator.GenerateMarkdown(ctx)
+	if err != nil {
+		t.Fatalf("GenerateMarkdown returned error: %v", err)
+	}
+	if !strings.Contains(md, "## Active Risks") || !strings.Contains(md, "| risk-001 | <Vendor> delay | identified | score: high |") {
+		t.Errorf("markdown report should contain risk table:\n%s", md)
+	}
+
+	html, err := generator.GenerateHTML(ctx)
+	if err != nil {
+		t.Fatalf("GenerateHTML returned error: %v", err)
+	}
+	if !strings.Contains(html, "&lt;Vendor&gt; delay") || strings.Contains(html, "<Vendor>") {
+		t.Error("HTML report should escape entity titles")
+	}
+	if strings.Contains(html, "Open Considerations") {
+		t.Error("sections not set should not be rendered")
+	}
+}
+
+func TestGenerator_SectionCauses(t *testing.T) {
+	ctx := context.Background()
+
+	generator := NewGenerator(&ZeusConfig{Project: ProjectInfo{Name: "Cause Project"}}, &ProjectState{Health: "Good"}, nil)
+	generator.SetSections([]Section{
+		NewSection(SectionProblems, []SectionItem{{
+			ID: "prob-001", Title: "Release delay", Status: "open", Detail: "severity: high",
+			Causes:  []string{"- <Flaky> tests", "  - external API (root cause)"},
+			Mermaid: "```mermaid\ngraph TD\n```\n",
+		}}),
+	})
+
+	text, err := generator.GenerateText(ctx)
+	if err != nil {
+		t.Fatalf("GenerateText returned error: %v", err)
+	}
+	if !strings.Contains(text, "      - <Flaky> tests\n        - external API (root cause)") {
+		t.Errorf("text report should contain the cause outline:\n%s", text)
+	}
+
+	md, err := generator.GenerateMarkdown(ctx)
+	if err != nil {
+		t.Fatalf("GenerateMarkdown returned error: %v", err)
+	}
+	if !strings.Contains(md, "### prob-001 Cause Tree\n\n```mermaid") {
+		t.Errorf("markdown report should contain the cause tree diagram:\n%s", md)
+	}
+
+	html, err := generator.GenerateHTML(ctx)
+	if err != nil {
+		t.Fatalf("GenerateHTML returned error: %v", err)
+	}
+	if !strings.Contains(html, `<div class="cause-tree">- &lt;Flaky&gt; tests`) || strings.Contains(html, "```mermaid") {
+		t.Errorf("HTML report should contain the escaped cause outline only:\n%s", html)
+	}
+}
+
+func TestValidateSections(t *testing.T) {
+	if err := ValidateSections(AllSections); err != nil {
+		t.Errorf("AllSections should be valid: %v", err)
+	}
+	if err := ValidateSections([]string{"tasks"}); err == nil {
+		t.Error("unknown section should be rejected")
+	}
+}
@@ -0,0 +1,101 @@
+package report
+
+import (
+	"fmt"
+	"html"
+)
+
+// セクションキー（zeus.yaml の reports.sections と --sections で指定）
+const (
+	SectionConsiderations = "considerations"
+	SectionDecisions      = "decisions"
+	SectionRisks          = "risks"
+	SectionProblems       = "problems"
+	SectionAssumptions    = "assumptions"
+	SectionBudget         = "budget"
+)
+
+// AllSections は全セクションのキー（出力順）
+var AllSections = []string{
+	SectionConsiderations,
+	SectionDecisions,
+	SectionRisks,
+	SectionProblems,
+	SectionAssumptions,
+	SectionBudget,
+}
+
+// sectionTitles はセクションの見出し
+var sectionTitles = map[string]string{
+	SectionConsiderations: "Open Considerations",
+	SectionDecisions:      "Recent Decisions",
+	SectionRisks:          "Active Risks",
+	SectionProblems:       "Unresolved Problems",
+	SectionAssumptions:    "Invalidated Assumptions",
+	SectionBudget:         "Budget vs Actual",
+}
+
+// SectionItem はセクション内の 1 項目
+type SectionItem struct {
+	ID      string
+	Title   string
+	Status  string
+	Detail  string
+	Causes  []string // 原因ツリーの字下げした行（Problem のみ）
+	Mermaid string   // 原因ツリーの Mermaid（Markdown のみで出力）
+}
+
+// Section は 10 概念モデルのエンティティをまとめたレポートセクション
+type Section struct {
+	Key     string
+	Title   string
+	Summary []string // 項目の前に出力する要約行（risks の露出など）
+	Items   []SectionItem
+}
+
+// NewSection は見出し付きのセクションを作成
+func NewSection(key string, items []SectionItem) Section {
+	return Section{Key: key, Title: sectionTitles[key], Items: items}
+}
+
+// ValidateSections はセクションキーを検証
+func ValidateSections(keys []string) error {
+	for _, key := range keys {
+		if _, ok := sectionTitles[key]; !ok {
+			return fmt.Errorf("unknown report section: %s (considerations, decisions, risks, problems, assumptions, budget)", key)
+		}
+	}
+	return nil
+}
+
+// escapeSections は HTML 出力用にエンティティの文字列をエスケープしたコピーを返す
+func escapeSections(sections []Section) []Section {
+	escaped := make([]Section, len(sections))
+	for i, s := range sections {
+		items := make([]SectionItem, len(s.Items))
+		for j, item := range s.Items {
+			causes := make([]string, len(item.Causes))
+			for k, line := range item.Causes {
+				causes[k] = html.EscapeString(line)
+			}
+			items[j] = SectionItem{
+				ID:     html.EscapeString(item.ID),
+				Title:  html.EscapeString(item.Title),
+				Status: html.EscapeString(item.Status),
+				Detail: html.EscapeString(item.Detail),
+				Causes: causes,
+			}
+		}
+		summary := make([]string, len(s.Summary))
+		for k, line := range s.Summary {
+			summary[k] = html.EscapeString(line)
+		}
+		escaped[i] = Section{Key: s.Key, Title: s.Title, Summary: summary, Items: items}
+	}
+	return escaped
+}
+
+// SetSections はレポートに含めるセクションを設定
+func (g *Generator) SetSections(sections []Section) {
+	g.sections = sections
+}
@@ -17,6 +17,14 @@ TASK SUMMARY
   In Progress: {{.TaskStats.InProgress}}
   Pending:     {{.TaskStats.Pending}}
 
+{{range .Sections}}
+{{.Title}} ({{len .Items}})
+------------
+{{range .Summary}}  {{.}}
+{{end}}{{range .Items}}  - {{.ID}} {{.Title}} [{{.Status}}]{{if .Detail}} {{.Detail}}{{end}}
+{{range .Causes}}      {{.}}
+{{end}}{{else}}  (none)
+{{end}}{{end}}
 {{if .Recommendations}}
 RECOMMENDATIONS
 ---------------
@@ -106,6 +114,10 @@ const HTMLTemplate = `<!DOCTYPE html>
             border-bottom: 1px solid #eee;
         }
         .recommendations li:last-child { border-bottom: none; }
+        .entities { width: 100%; border-collapse: collapse; }
+        .entities th, .entities td { text-align: left; padding: 6px 8px; border-bottom: 1px solid #eee; }
+        .cause-tree { white-space: pre; font-size: 0.9em; color: #666; margin-top: 4px; }
+        .section-summary { color: #666; margin-bottom: 8px; }
         .footer {
             text-align: center;
             padding: 20px;
@@ -149,6 +161,25 @@ const HTMLTemplate = `<!DOCTYPE html>
             {{end}}
         </div>
 
+        {{range .Sections}}
+        <div class="card section-{{.Key}}">
+            <h2>{{.Title}} ({{len .Items}})</h2>
+            {{range .Summary}}<p class="section-summary">{{.}}</p>
+            {{end}}
+            {{if .Items}}
+            <table class="entities">
+                <tr><th>ID</th><th>Title</th><th>Status</th><th>Detail</th></tr>
+                {{range .Items}}
+                <tr><td>{{.ID}}</td><td>{{.Title}}</td><td>{{.Status}}</td><td>{{.Detail}}{{if .Causes}}<div class="cause-tree">{{range .Causes}}{{.}}
+{{end}}</div>{{end}}</td></tr>
+                {{end}}
+            </table>
+            {{else}}
+            <p style="color: #666;">None</p>
+            {{end}}
+        </div>
+        {{end}}
+
         {{if .Recommendations}}
         <div class="card">
             <h2>Recommendations</h2>
@@ -192,6 +223,20 @@ const MarkdownTemplate = `# Zeus Project Report
 {{.GraphMermaid}}
 {{end}}
 
+{{range .Sections}}
+## {{.Title}}
+
+{{range .Summary}}- {{.}}
+{{end}}{{if .Summary}}
+{{end}}{{if .Items}}| ID | Title | Status | Detail |
+|----|-------|--------|--------|
+{{range .Items}}| {{.ID}} | {{.Title}} | {{.Status}} | {{.Detail}} |
+{{end}}{{range .Items}}{{if .Mermaid}}
+### {{.ID}} Cause Tree
+
+{{.Mermaid}}{{end}}{{end}}{{else}}None
+{{end}}
+{{end}}
 {{if .Recommendations}}
 ## Recommendations
 
@@ -0,0 +1,172 @@
+// Package schedule は cron 式によるスケジュール判定を提供する。
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cron は 5 フィールド（分 時 日 月 曜日）の cron 式
+type Cron struct {
+	expr    string
+	minute  fieldSet
+	hour    fieldSet
+	dom     fieldSet
+	month   fieldSet
+	dow     fieldSet
+	domStar bool // 日フィールドが * か
+	dowStar bool // 曜日フィールドが * か
+}
+
+// fieldSet はフィールドで許可される値の集合
+type fieldSet map[int]bool
+
+// aliases は定義済みスケジュールの別名
+var aliases = map[string]string{
+	"@hourly":  "0 * * * *",
+	"@daily":   "0 0 * * *",
+	"@weekly":  "0 0 * * 0",
+	"@monthly": "0 0 1 * *",
+	"@yearly":  "0 0 1 1 *",
+}
+
+// Parse は cron 式を解析
+// 各フィールドは *, 数値, 範囲（1-5）, リスト（1,3）, ステップ（*/15, 0-30/10）に対応する。
+// 曜日は 0-7（0 と 7 は日曜）。@hourly / @daily / @weekly / @monthly / @yearly も指定可能
+func Parse(expr string) (*Cron, error) {
+	spec := strings.TrimSpace(expr)
+	if alias, ok := aliases[spec]; ok {
+		spec = alias
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression must have 5 fields: %q", expr)
+	}
+
+	c := &Cron{expr: expr}
+	var err error
+	if c.minute, err = parseField(fields[0], 0, 59); err != nil {
+		return nil, fmt.Errorf("minute: %w", err)
+	}
+	if c.hour, err = parseField(fields[1], 0, 23); err != nil {
+		return nil, fmt.Errorf("hour: %w", err)
+	}
+	if c.dom, err = parseField(fields[2], 1, 31); err != nil {
+		return nil, fmt.Errorf("day of month: %w", err)
+	}
+	if c.month, err = parseField(fields[3], 1, 12); err != nil {
+		return nil, fmt.Errorf("month: %w", err)
+	}
+	if c.dow, err = parseField(fields[4], 0, 7); err != nil {
+		return nil, fmt.Errorf("day of week: %w", err)
+	}
+	if c.dow[7] {
+		c.dow[0] = true
+	}
+	c.domStar = fields[2] == "*"
+	c.dowStar = fields[4] == "*"
+
+	return c, nil
+}
+
+// String は元の cron 式を返す
+func (c *Cron) String() string {
+	return c.expr
+}
+
+// Matches は時刻（分単位）が cron 式に一致するか判定
+// 日と曜日の両方が指定されている場合は、従来の cron と同様にどちらかに一致すればよい
+func (c *Cron) Matches(t time.Time) bool {
+	if !c.minute[t.Minute()] || !c.hour[t.Hour()] || !c.month[int(t.Month())] {
+		return false
+	}
+	domMatch := c.dom[t.Day()]
+	dowMatch := c.dow[int(t.Weekday())]
+	switch {
+	case c.domStar && c.dowStar:
+		return true
+	case c.domStar:
+		return dowMatch
+	case c.dowStar:
+		return domMatch
+	default:
+		return domMatch || dowMatch
+	}
+}
+
+// Next は t より後で最初に一致する時刻を返す（4 年以内に一致しなければゼロ値）
+func (c *Cron) Next(t time.Time) time.Time {
+	next := t.Truncate(time.Minute).Add(time.Minute)
+	limit := next.AddDate(4, 0, 0)
+	for next.Before(limit) {
+		if c.Matches(next) {
+			return next
+		}
+		next = next.Add(time.Minute)
+	}
+	return time.Time{}
+}
+
+// parseField は 1 フィールドを解析
+func parseField(field string, min, max int) (fieldSet, error) {
+	set := fieldSet{}
+	for _, part := range strings.Split(field, ",") {
+		if part == "" {
+			return nil, fmt.Errorf("empty value in %q", field)
+		}
+
+		rangePart, step := part, 1
+		if before, after, ok := strings.Cut(part, "/"); ok {
+			n, err := strconv.Atoi(after)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("invalid step %q", part)
+			}
+			rangePart, step = before, n
+		}
+
+		lo, hi := min, max
+		if rangePart != "*" {
+			if before, after, ok := strings.Cut(rangePart, "-"); ok {
+				var err error
+				if lo, err = parseValue(before, min, max); err != nil {
+					return nil, err
+				}
+				if hi, err = parseValue(after, min, max); err != nil {
+					return nil, err
+				}
+				if lo > hi {
+					return nil, fmt.Errorf("invalid range %q", rangePart)
+				}
+			} else {
+				v, err := parseValue(rangePart, min, max)
+				if err != nil {
+					return nil, err
+				}
+				lo = v
+				if step == 1 {
+					hi = v
+				}
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			set[v] = true
+		}
+	}
+	return set, nil
+}
+
+// parseValue は数値を解析し範囲を検証
+func parseValue(s string, min, max int) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if v < min || v > max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", v, min, max)
+	}
+	return v, nil
+}
@@ -0,0 +1,67 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+	} {
+		if _, err := Parse(expr); err == nil {
+			t.Errorf("Parse(%q) should fail", expr)
+		}
+	}
+}
+
+func TestCron_Matches(t *testing.T) {
+	// 2026-03-09 は月曜日
+	monday9 := time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		t    time.Time
+		want bool
+	}{
+		{"0 9 * * 1", monday9, true},
+		{"0 9 * * 1", monday9.Add(time.Minute), false},
+		{"0 9 * * 1-5", monday9.AddDate(0, 0, 5), false}, // 土曜
+		{"*/15 * * * *", monday9.Add(45 * time.Minute), true},
+		{"*/15 * * * *", monday9.Add(50 * time.Minute), false},
+		{"0 9,17 * * *", monday9.Add(8 * time.Hour), true},
+		{"0 0 * * 7", time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC), true}, // 7 は日曜
+		{"@daily", time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), true},
+		// 日と曜日の両方指定は OR
+		{"0 9 1 * 1", monday9, true},
+		{"0 9 1 * 2", monday9, false},
+	}
+	for _, tt := range tests {
+		c, err := Parse(tt.expr)
+		if err != nil {
+			t.Fatalf("Parse(%q) failed: %v", tt.expr, err)
+		}
+		if got := c.Matches(tt.t); got != tt.want {
+			t.Errorf("%q.Matches(%v) = %v, want %v", tt.expr, tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestCron_Next(t *testing.T) {
+	c, err := Parse("30 8 * * 1")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	from := time.Date(2026, 3, 9, 8, 30, 0, 0, time.UTC) // 月曜 8:30 ちょうど
+	want := time.Date(2026, 3, 16, 8, 30, 0, 0, time.UTC)
+	if got := c.Next(from); !got.Equal(want) {
+		t.Errorf("Next() = %v, want %v", got, want)
+	}
+}
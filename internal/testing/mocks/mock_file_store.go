@@ -207,6 +207,29 @@ func (m *MockFileStore) Copy(ctx context.Context, src, dest string) error {
 	return nil
 }
 
+// Rename はファイルを移動
+func (m *MockFileStore) Rename(ctx context.Context, src, dest string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err, ok := m.errors[src]; ok {
+		return err
+	}
+
+	content, ok := m.files[src]
+	if !ok {
+		return &FileNotFoundError{Path: src}
+	}
+
+	m.files[dest] = content
+	delete(m.files, src)
+	return nil
+}
+
 // ListDir はディレクトリ内のファイルを列挙
 func (m *MockFileStore) ListDir(ctx context.Context, path string) ([]string, error) {
 	if err := ctx.Err(); err != nil {
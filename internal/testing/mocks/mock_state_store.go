@@ -126,6 +126,21 @@ func (m *MockStateStore) RestoreSnapshot(ctx context.Context, timestamp string)
 	return fmt.Errorf("snapshot not found: %s", timestamp)
 }
 
+// DeleteSnapshot はスナップショットを削除
+func (m *MockStateStore) DeleteSnapshot(ctx context.Context, timestamp string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for i, snapshot := range m.snapshots {
+		if snapshot.Timestamp == timestamp {
+			m.snapshots = append(m.snapshots[:i], m.snapshots[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("snapshot not found: %s", timestamp)
+}
+
 // CalculateState はリスト項目から状態を計算
 func (m *MockStateStore) CalculateState(items []core.ListItem) *core.ProjectState {
 	stats := core.SummaryStats{
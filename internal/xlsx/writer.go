@@ -0,0 +1,189 @@
+// Package xlsx は外部依存なしで最小限の XLSX（Office Open XML）ブックを書き出す。
+// 文字列セル（インライン文字列）と先頭行の太字見出しのみに対応する。
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxSheetNameLen はシート名の最大長（Excel の制限）
+const maxSheetNameLen = 31
+
+// Sheet は 1 枚のワークシート（Rows[0] を見出し行として太字にする）
+type Sheet struct {
+	Name string
+	Rows [][]string
+}
+
+// Workbook はワークシートの集合
+type Workbook struct {
+	Sheets []Sheet
+}
+
+// Validate はシート名を検証（空、長さ超過、使用不可文字、重複）
+func (wb *Workbook) Validate() error {
+	if len(wb.Sheets) == 0 {
+		return fmt.Errorf("workbook must have at least one sheet")
+	}
+	seen := map[string]bool{}
+	for _, s := range wb.Sheets {
+		if s.Name == "" || utf8.RuneCountInString(s.Name) > maxSheetNameLen {
+			return fmt.Errorf("invalid sheet name %q (1-%d characters)", s.Name, maxSheetNameLen)
+		}
+		if strings.ContainsAny(s.Name, `[]:*?/\`) {
+			return fmt.Errorf("invalid sheet name %q (must not contain []:*?/\\)", s.Name)
+		}
+		key := strings.ToLower(s.Name)
+		if seen[key] {
+			return fmt.Errorf("duplicate sheet name %q", s.Name)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// Write はブックを XLSX として w に書き出す
+func (wb *Workbook) Write(w io.Writer) error {
+	if err := wb.Validate(); err != nil {
+		return err
+	}
+
+	zw := zip.NewWriter(w)
+	files := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", wb.contentTypes()},
+		{"_rels/.rels", rootRels},
+		{"xl/workbook.xml", wb.workbook()},
+		{"xl/_rels/workbook.xml.rels", wb.workbookRels()},
+		{"xl/styles.xml", styles},
+	}
+	for _, f := range files {
+		if err := writeZipFile(zw, f.name, f.content); err != nil {
+			return err
+		}
+	}
+	for i, s := range wb.Sheets {
+		if err := writeZipFile(zw, fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), worksheet(s)); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// writeZipFile は zip にファイルを追加
+func writeZipFile(zw *zip.Writer, name, content string) error {
+	f, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", name, err)
+	}
+	if _, err := io.WriteString(f, content); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+const rootRels = xmlHeader + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+// styles はデフォルト書式（0）と太字（1）のみを定義
+const styles = xmlHeader + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/><xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>` +
+	`</styleSheet>`
+
+func (wb *Workbook) contentTypes() string {
+	var sb strings.Builder
+	sb.WriteString(xmlHeader)
+	sb.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	sb.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	sb.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	sb.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	sb.WriteString(`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := range wb.Sheets {
+		fmt.Fprintf(&sb, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i+1)
+	}
+	sb.WriteString(`</Types>`)
+	return sb.String()
+}
+
+func (wb *Workbook) workbook() string {
+	var sb strings.Builder
+	sb.WriteString(xmlHeader)
+	sb.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+	for i, s := range wb.Sheets {
+		fmt.Fprintf(&sb, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, escape(s.Name), i+1, i+1)
+	}
+	sb.WriteString(`</sheets></workbook>`)
+	return sb.String()
+}
+
+func (wb *Workbook) workbookRels() string {
+	var sb strings.Builder
+	sb.WriteString(xmlHeader)
+	sb.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i := range wb.Sheets {
+		fmt.Fprintf(&sb, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i+1, i+1)
+	}
+	fmt.Fprintf(&sb, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, len(wb.Sheets)+1)
+	sb.WriteString(`</Relationships>`)
+	return sb.String()
+}
+
+// worksheet はシートの XML を生成（見出し行は固定表示）
+func worksheet(s Sheet) string {
+	var sb strings.Builder
+	sb.WriteString(xmlHeader)
+	sb.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	if len(s.Rows) > 1 {
+		sb.WriteString(`<sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>`)
+	}
+	sb.WriteString(`<sheetData>`)
+	for r, row := range s.Rows {
+		fmt.Fprintf(&sb, `<row r="%d">`, r+1)
+		for c, value := range row {
+			style := ""
+			if r == 0 {
+				style = ` s="1"`
+			}
+			fmt.Fprintf(&sb, `<c r="%s%d" t="inlineStr"%s><is><t xml:space="preserve">%s</t></is></c>`, ColumnName(c), r+1, style, escape(value))
+		}
+		sb.WriteString(`</row>`)
+	}
+	sb.WriteString(`</sheetData></worksheet>`)
+	return sb.String()
+}
+
+// ColumnName は 0 始まりの列番号を列名（A, B, ..., Z, AA, ...）に変換
+func ColumnName(index int) string {
+	name := ""
+	for n := index + 1; n > 0; n = (n - 1) / 26 {
+		name = string(rune('A'+(n-1)%26)) + name
+	}
+	return name
+}
+
+// escape は XML の特殊文字をエスケープ（XML で使用できない制御文字は除去）
+func escape(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 0x20 && r != '\t' && r != '\n' && r != '\r' {
+			return -1
+		}
+		return r
+	}, s)
+	var sb strings.Builder
+	_ = xml.EscapeText(&sb, []byte(s))
+	return sb.String()
+}
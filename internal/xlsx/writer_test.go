@@ -0,0 +1,99 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestColumnName(t *testing.T) {
+	tests := map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 51: "AZ", 52: "BA", 701: "ZZ", 702: "AAA"}
+	for index, want := range tests {
+		if got := ColumnName(index); got != want {
+			t.Errorf("ColumnName(%d) = %q, want %q", index, got, want)
+		}
+	}
+}
+
+func TestWorkbook_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		sheets  []Sheet
+		wantErr bool
+	}{
+		{"valid", []Sheet{{Name: "Activities"}, {Name: "Risks"}}, false},
+		{"no sheets", nil, true},
+		{"empty name", []Sheet{{Name: ""}}, true},
+		{"too long", []Sheet{{Name: strings.Repeat("a", 32)}}, true},
+		{"invalid char", []Sheet{{Name: "a/b"}}, true},
+		{"duplicate", []Sheet{{Name: "Risks"}, {Name: "risks"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wb := &Workbook{Sheets: tt.sheets}
+			if err := wb.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWorkbook_Write(t *testing.T) {
+	wb := &Workbook{Sheets: []Sheet{
+		{Name: "Activities", Rows: [][]string{{"ID", "Title"}, {"act-1", "A & <B>\x01"}}},
+		{Name: "リスク", Rows: [][]string{{"ID"}}},
+	}}
+
+	var buf bytes.Buffer
+	if err := wb.Write(&buf); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("output is not a zip: %v", err)
+	}
+	files := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("Open %s failed: %v", f.Name, err)
+		}
+		data, _ := io.ReadAll(rc)
+		_ = rc.Close()
+		files[f.Name] = string(data)
+
+		// 全パーツが整形式の XML であること
+		dec := xml.NewDecoder(bytes.NewReader(data))
+		for {
+			if _, err := dec.Token(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("%s is not well-formed XML: %v", f.Name, err)
+			}
+		}
+	}
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/styles.xml", "xl/worksheets/sheet1.xml", "xl/worksheets/sheet2.xml"} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("missing part %s", name)
+		}
+	}
+	if !strings.Contains(files["xl/workbook.xml"], `name="リスク"`) {
+		t.Error("workbook should list sheet names")
+	}
+	sheet1 := files["xl/worksheets/sheet1.xml"]
+	if !strings.Contains(sheet1, `<c r="B2" t="inlineStr"><is><t xml:space="preserve">A &amp; &lt;B&gt;</t></is></c>`) {
+		t.Errorf("cell should be escaped without control characters:\n%s", sheet1)
+	}
+	if !strings.Contains(sheet1, `<c r="A1" t="inlineStr" s="1">`) {
+		t.Error("header row should be bold")
+	}
+
+	if err := (&Workbook{}).Write(&buf); err == nil {
+		t.Error("expected error for empty workbook")
+	}
+}
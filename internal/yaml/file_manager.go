@@ -203,6 +203,27 @@ func (fm *FileManager) Copy(ctx context.Context, src, dest string) error {
 	return os.WriteFile(destPath, data, 0644)
 }
 
+// Rename はファイルを移動（Context対応）
+// dest が存在する場合はアトミックに置き換える（トランザクションのコミットで使用）
+func (fm *FileManager) Rename(ctx context.Context, src, dest string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	srcPath, err := fm.ResolvePath(src)
+	if err != nil {
+		return err
+	}
+	destPath, err := fm.ResolvePath(dest)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(destPath), 0755); err != nil {
+		return err
+	}
+	return os.Rename(srcPath, destPath)
+}
+
 // Delete はファイルを削除（Context対応）
 func (fm *FileManager) Delete(ctx context.Context, relativePath string) error {
 	if err := ctx.Err(); err != nil {
@@ -515,6 +515,39 @@ func TestCopy_SourceNotExists(t *testing.T) {
 	}
 }
 
+func TestRename_ReplacesDest(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "filemanager-test")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	fm := NewFileManager(tmpDir)
+	ctx := context.Background()
+
+	if err := fm.WriteFile(ctx, "dir/a.yaml.tmp", []byte("new")); err != nil {
+		t.Fatalf("failed to create source file: %v", err)
+	}
+	if err := fm.WriteFile(ctx, "dir/a.yaml", []byte("old")); err != nil {
+		t.Fatalf("failed to create dest file: %v", err)
+	}
+
+	if err := fm.Rename(ctx, "dir/a.yaml.tmp", "dir/a.yaml"); err != nil {
+		t.Fatalf("Rename() error = %v", err)
+	}
+	if fm.Exists(ctx, "dir/a.yaml.tmp") {
+		t.Error("source should not exist after rename")
+	}
+	content, err := os.ReadFile(filepath.Join(tmpDir, "dir", "a.yaml"))
+	if err != nil || string(content) != "new" {
+		t.Errorf("expected 'new', got %q (%v)", string(content), err)
+	}
+
+	if err := fm.Rename(ctx, "dir/a.yaml", "../outside.yaml"); err != ErrPathTraversal {
+		t.Errorf("expected ErrPathTraversal, got %v", err)
+	}
+}
+
 func TestDelete_Success(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "filemanager-test")
 	if err != nil {
@@ -55,19 +55,25 @@ func (fl *FileLock) Lock() error {
 	return nil
 }
 
+// lockPollInterval は LockWithTimeout でロックの取得を再試行する間隔
+const lockPollInterval = 10 * time.Millisecond
+
 // LockWithTimeout はタイムアウト付きでロックを取得
+// 非ブロッキングの取得を繰り返すため、タイムアウト後にロックを取得したまま残ることはない
 func (fl *FileLock) LockWithTimeout(timeout time.Duration) error {
-	done := make(chan error, 1)
-
-	go func() {
-		done <- fl.Lock()
-	}()
-
-	select {
-	case err := <-done:
-		return err
-	case <-time.After(timeout):
-		return ErrLockTimeout
+	deadline := time.Now().Add(timeout)
+	for {
+		acquired, err := fl.TryLock()
+		if err != nil {
+			return err
+		}
+		if acquired {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return ErrLockTimeout
+		}
+		time.Sleep(lockPollInterval)
 	}
 }
 
@@ -85,12 +91,9 @@ func (fl *FileLock) Unlock() error {
 		return err
 	}
 
+	// ロックファイルは削除しない（削除すると、待機中のプロセスと新たに作成したプロセスが別のファイルをロックしてしまう）
 	err := fl.file.Close()
 	fl.file = nil
-
-	// ロックファイルを削除（ベストエフォート）
-	os.Remove(fl.path)
-
 	return err
 }
 
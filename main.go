@@ -6,7 +6,11 @@ import (
 	"github.com/biwakonbu/zeus/cmd"
 )
 
+// version はビルド時に -ldflags "-X main.version=..." で埋め込まれる
+var version = "dev"
+
 func main() {
+	cmd.SetVersion(version)
 	if err := cmd.Execute(); err != nil {
 		os.Exit(1)
 	}
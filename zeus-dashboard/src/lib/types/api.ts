@@ -37,6 +37,7 @@ export type EntityStatus = 'draft' | 'active' | 'deprecated';
 // グラフ API レスポンス
 export interface GraphResponse {
 	mermaid: string;
+	mermaid_summarized?: boolean;
 	stats: GraphStats;
 	cycles: string[][];
 	isolated: string[];
@@ -538,6 +539,7 @@ export interface UnifiedGraphResponse {
 	cycles: string[][];
 	isolated: string[];
 	mermaid: string;
+	mermaid_summarized?: boolean;
 }
 
 // グラフデータ型（GraphNode/Edge + Groups の組み合わせ）